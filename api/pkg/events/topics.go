@@ -0,0 +1,65 @@
+package events
+
+import (
+	"maps"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Topic constants
+const (
+	TopicCatalogProductEvents = "catalog.product.events"
+	// TopicCatalogProductPriceEvents carries price changes keyed by product ID, apart from the product
+	// updates so that their version sequence stays gapless
+	TopicCatalogProductPriceEvents = "catalog.product.price.events"
+	// TopicCatalogProductBulkEvents carries the summaries of bulk product writes keyed by batch ID
+	TopicCatalogProductBulkEvents = "catalog.product.bulk.events"
+	TopicCatalogCategoryEvents    = "catalog.category.events"
+	TopicCatalogAttributeEvents   = "catalog.attribute.events"
+	// TopicCatalogReservationEvents carries stock reservations keyed by reservation ID
+	TopicCatalogReservationEvents = "catalog.reservation.events"
+)
+
+// Topics lists every topic the catalog service publishes to.
+func Topics() []string {
+	return []string{
+		TopicCatalogProductEvents,
+		TopicCatalogProductPriceEvents,
+		TopicCatalogProductBulkEvents,
+		TopicCatalogCategoryEvents,
+		TopicCatalogAttributeEvents,
+		TopicCatalogReservationEvents,
+	}
+}
+
+// topicMap maps proto message full names to their Kafka topics.
+var topicMap = map[protoreflect.FullName]string{
+	(&eventsv1.ProductUpdatedEvent{}).ProtoReflect().Descriptor().FullName():          TopicCatalogProductEvents,
+	(&eventsv1.ProductDeletedEvent{}).ProtoReflect().Descriptor().FullName():          TopicCatalogProductEvents,
+	(&eventsv1.ProductPriceChangedEvent{}).ProtoReflect().Descriptor().FullName():     TopicCatalogProductPriceEvents,
+	(&eventsv1.BulkProductsImportedEvent{}).ProtoReflect().Descriptor().FullName():    TopicCatalogProductBulkEvents,
+	(&eventsv1.CategoryUpdatedEvent{}).ProtoReflect().Descriptor().FullName():         TopicCatalogCategoryEvents,
+	(&eventsv1.AttributeUpdatedEvent{}).ProtoReflect().Descriptor().FullName():        TopicCatalogAttributeEvents,
+	(&eventsv1.StockReservedEvent{}).ProtoReflect().Descriptor().FullName():           TopicCatalogReservationEvents,
+	(&eventsv1.StockReleasedEvent{}).ProtoReflect().Descriptor().FullName():           TopicCatalogReservationEvents,
+	(&eventsv1.StockReservationExpiredEvent{}).ProtoReflect().Descriptor().FullName(): TopicCatalogReservationEvents,
+}
+
+// TopicsByEvent returns the default topic of every event type, by proto message full name.
+// Services may publish to other topics, see their routing configuration.
+func TopicsByEvent() map[protoreflect.FullName]string {
+	return maps.Clone(topicMap)
+}
+
+// TopicFor returns the Kafka topic for the given proto message.
+// Panics if the message type is not registered in topicMap.
+func TopicFor(msg proto.Message) string {
+	fullName := msg.ProtoReflect().Descriptor().FullName()
+	topic, ok := topicMap[fullName]
+	if !ok {
+		panic("events: no topic registered for " + string(fullName))
+	}
+	return topic
+}
@@ -0,0 +1,67 @@
+// Command loadtest runs a bench scenario against a locally started catalog service.
+//
+// The service must be started with the test token validator (or a real token passed via -token):
+//
+//	go run ./bench/cmd/loadtest -url http://localhost:8080 -tenant acme -scenario read-heavy -duration 1m -c 16
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/bench"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+func main() {
+	var (
+		baseURL     = flag.String("url", "http://localhost:8080", "base URL of the catalog service")
+		tenantSlug  = flag.String("tenant", "", "tenant slug sent in the X-Tenant-Slug header")
+		token       = flag.String("token", "", "bearer token (defaults to an admin test token)")
+		scenario    = flag.String("scenario", "mixed", "scenario to run: "+strings.Join(bench.ScenarioNames(), ", "))
+		duration    = flag.Duration("duration", 30*time.Second, "test duration")
+		concurrency = flag.Int("c", 8, "number of concurrent workers")
+		seed        = flag.Int64("seed", 1, "seed for request generation")
+		pageSize    = flag.Int("page-size", 20, "page size for list requests")
+	)
+	flag.Parse()
+
+	s, err := bench.ScenarioByName(*scenario)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *token == "" {
+		*token = validation.GenerateAdminTestToken()
+	}
+
+	runner, err := bench.NewRunner(bench.Config{
+		BaseURL:     *baseURL,
+		TenantSlug:  *tenantSlug,
+		Token:       *token,
+		Scenario:    s,
+		Concurrency: *concurrency,
+		Duration:    *duration,
+		Seed:        *seed,
+		PageSize:    int32(*pageSize), //nolint:gosec // flag value, overflow is the caller's problem
+	}, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	report := runner.Run(ctx)
+	if err := report.Write(os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,74 @@
+package bench
+
+import (
+	"fmt"
+	"math/rand"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+)
+
+var sortFields = []string{"name", "price", "createdAt", "modifiedAt"}
+
+// generator produces deterministic request payloads for a single worker
+type generator struct {
+	rnd      *rand.Rand
+	worker   int
+	seq      int
+	pageSize int32
+}
+
+func newGenerator(seed int64, worker int, pageSize int32) *generator {
+	return &generator{
+		rnd:      rand.New(rand.NewSource(seed + int64(worker))), //nolint:gosec // reproducibility matters, not randomness quality
+		worker:   worker,
+		pageSize: pageSize,
+	}
+}
+
+func (g *generator) createRequest() *catalogv1.CreateProductRequest {
+	g.seq++
+	description := fmt.Sprintf("load test product %d created by worker %d", g.seq, g.worker)
+	return &catalogv1.CreateProductRequest{
+		Name:        fmt.Sprintf("bench-%d-%d", g.worker, g.seq),
+		Description: &description,
+		Price:       g.price(),
+		Quantity:    int32(g.rnd.Intn(1000)), //nolint:gosec // bounded by Intn
+	}
+}
+
+func (g *generator) updateRequest(p *catalogv1.Product) *catalogv1.UpdateProductRequest {
+	return &catalogv1.UpdateProductRequest{
+		Id:          p.GetId(),
+		Version:     p.GetVersion(),
+		Name:        p.GetName(),
+		Description: p.Description,
+		Price:       g.price(),
+		Quantity:    int32(g.rnd.Intn(1000)), //nolint:gosec // bounded by Intn
+		ImageId:     p.ImageId,
+		CategoryId:  p.CategoryId,
+		Enabled:     p.GetEnabled(),
+	}
+}
+
+func (g *generator) listRequest() *catalogv1.GetProductListRequest {
+	sort := sortFields[g.rnd.Intn(len(sortFields))]
+	order := "asc"
+	if g.rnd.Intn(2) == 0 {
+		order = "desc"
+	}
+	return &catalogv1.GetProductListRequest{
+		Page:  int32(g.rnd.Intn(5) + 1), //nolint:gosec // bounded by Intn
+		Size:  g.pageSize,
+		Sort:  &sort,
+		Order: &order,
+	}
+}
+
+// pickIndex returns a random index in [0, n)
+func (g *generator) pickIndex(n int) int {
+	return g.rnd.Intn(n)
+}
+
+func (g *generator) price() float64 {
+	return float64(g.rnd.Intn(100000)) / 100
+}
@@ -0,0 +1,103 @@
+package bench
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// OperationStats aggregates the outcome of a single operation kind
+type OperationStats struct {
+	Count     int
+	Errors    int
+	latencies []time.Duration
+}
+
+// Percentile returns the latency at the given percentile (0-100) of successful requests
+func (s OperationStats) Percentile(p float64) time.Duration {
+	if len(s.latencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(s.latencies)
+	slices.Sort(sorted)
+
+	idx := int(float64(len(sorted)-1) * p / 100)
+	return sorted[idx]
+}
+
+// Report is the result of a load test run
+type Report struct {
+	Scenario string
+	Elapsed  time.Duration
+
+	mu  sync.Mutex
+	ops map[Operation]*OperationStats
+}
+
+func newReport(scenario string) *Report {
+	return &Report{
+		Scenario: scenario,
+		ops:      make(map[Operation]*OperationStats),
+	}
+}
+
+func (r *Report) record(op Operation, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.ops[op]
+	if !ok {
+		stats = &OperationStats{}
+		r.ops[op] = stats
+	}
+	stats.Count++
+	if err != nil {
+		stats.Errors++
+		return
+	}
+	stats.latencies = append(stats.latencies, latency)
+}
+
+// Stats returns the collected statistics for the operation
+func (r *Report) Stats(op Operation) OperationStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stats, ok := r.ops[op]; ok {
+		return OperationStats{Count: stats.Count, Errors: stats.Errors, latencies: slices.Clone(stats.latencies)}
+	}
+	return OperationStats{}
+}
+
+// Write prints a human-readable summary of the report
+func (r *Report) Write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ops := make([]Operation, 0, len(r.ops))
+	total := 0
+	for op, stats := range r.ops {
+		ops = append(ops, op)
+		total += stats.Count
+	}
+	slices.Sort(ops)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "scenario: %s, elapsed: %s, requests: %d, rps: %.1f\n", r.Scenario, r.Elapsed.Round(time.Millisecond), total, rps(total, r.Elapsed))
+	fmt.Fprintln(tw, "operation\tcount\terrors\tp50\tp95\tp99")
+	for _, op := range ops {
+		s := r.ops[op]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\n", op, s.Count, s.Errors, s.Percentile(50), s.Percentile(95), s.Percentile(99))
+	}
+	return tw.Flush()
+}
+
+func rps(count int, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(count) / elapsed.Seconds()
+}
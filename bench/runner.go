@@ -0,0 +1,161 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Config holds the load test parameters
+type Config struct {
+	BaseURL     string
+	TenantSlug  string
+	Token       string
+	Scenario    Scenario
+	Concurrency int
+	Duration    time.Duration
+	Seed        int64
+	PageSize    int32
+}
+
+func (c *Config) applyDefaults() {
+	if c.Concurrency <= 0 {
+		c.Concurrency = 1
+	}
+	if c.Duration <= 0 {
+		c.Duration = 30 * time.Second
+	}
+	if c.PageSize <= 0 {
+		c.PageSize = 20
+	}
+}
+
+func (c *Config) validate() error {
+	if c.BaseURL == "" {
+		return errors.New("base url is required")
+	}
+	if len(c.Scenario.Mix) == 0 {
+		return errors.New("scenario is required")
+	}
+	return nil
+}
+
+// Runner drives the configured scenario against a running service
+type Runner struct {
+	cfg    Config
+	client catalogv1connect.ProductServiceClient
+}
+
+// NewRunner creates a runner using the given HTTP client for all requests
+func NewRunner(cfg Config, httpClient connect.HTTPClient) (*Runner, error) {
+	cfg.applyDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	client := catalogv1connect.NewProductServiceClient(
+		httpClient,
+		cfg.BaseURL,
+		connect.WithInterceptors(newHeaderInterceptor(cfg.Token, cfg.TenantSlug)),
+	)
+
+	return &Runner{cfg: cfg, client: client}, nil
+}
+
+// Run executes the scenario until the configured duration elapses or ctx is canceled
+func (r *Runner) Run(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Duration)
+	defer cancel()
+
+	report := newReport(r.cfg.Scenario.Name)
+	started := time.Now()
+
+	var wg sync.WaitGroup
+	for i := range r.cfg.Concurrency {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			r.runWorker(ctx, worker, report)
+		}(i)
+	}
+	wg.Wait()
+
+	report.Elapsed = time.Since(started)
+	return report
+}
+
+func (r *Runner) runWorker(ctx context.Context, worker int, report *Report) {
+	gen := newGenerator(r.cfg.Seed, worker, r.cfg.PageSize)
+	var owned []*catalogv1.Product
+
+	for ctx.Err() == nil {
+		op := r.cfg.Scenario.pick(gen.rnd)
+		if len(owned) == 0 && (op == OpGet || op == OpUpdate) {
+			// Nothing to read or update yet, seed the worker with its first product
+			op = OpCreate
+		}
+
+		start := time.Now()
+		err := r.execute(ctx, op, gen, &owned)
+		if ctx.Err() != nil {
+			// Requests interrupted by the deadline are not representative
+			return
+		}
+		report.record(op, time.Since(start), err)
+	}
+}
+
+func (r *Runner) execute(ctx context.Context, op Operation, gen *generator, owned *[]*catalogv1.Product) error {
+	switch op {
+	case OpCreate:
+		resp, err := r.client.CreateProduct(ctx, connect.NewRequest(gen.createRequest()))
+		if err != nil {
+			return err
+		}
+		*owned = append(*owned, resp.Msg.GetProduct())
+		return nil
+	case OpList:
+		_, err := r.client.GetProductList(ctx, connect.NewRequest(gen.listRequest()))
+		return err
+	case OpGet:
+		p := (*owned)[gen.pickIndex(len(*owned))]
+		_, err := r.client.GetProductById(ctx, connect.NewRequest(&catalogv1.GetProductByIdRequest{Id: p.GetId()}))
+		return err
+	case OpUpdate:
+		i := gen.pickIndex(len(*owned))
+		resp, err := r.client.UpdateProduct(ctx, connect.NewRequest(gen.updateRequest((*owned)[i])))
+		if err != nil {
+			return err
+		}
+		(*owned)[i] = resp.Msg.GetProduct()
+		return nil
+	default:
+		return fmt.Errorf("unsupported operation: %s", op)
+	}
+}
+
+func newHeaderInterceptor(token, tenantSlug string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if token != "" {
+				req.Header().Set("Authorization", "Bearer "+token)
+			}
+			if tenantSlug != "" {
+				req.Header().Set(tenant.TenantSlugHeader, tenantSlug)
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package bench contains reproducible load scenarios that are run against a locally started
+// catalog service. Operations are picked from a weighted mix using a seeded random source,
+// so two runs with the same scenario, seed and concurrency issue the same request sequence.
+package bench
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// Operation is a single kind of request issued by the load generator
+type Operation string
+
+const (
+	OpCreate Operation = "create"
+	OpList   Operation = "list"
+	OpGet    Operation = "get"
+	OpUpdate Operation = "update"
+)
+
+// WeightedOperation is an operation together with its relative share in a scenario
+type WeightedOperation struct {
+	Op     Operation
+	Weight int
+}
+
+// Scenario describes a named request mix
+type Scenario struct {
+	Name string
+	Mix  []WeightedOperation
+}
+
+var scenarios = []Scenario{
+	{
+		Name: "read-heavy",
+		Mix: []WeightedOperation{
+			{Op: OpCreate, Weight: 5},
+			{Op: OpList, Weight: 60},
+			{Op: OpGet, Weight: 30},
+			{Op: OpUpdate, Weight: 5},
+		},
+	},
+	{
+		Name: "write-heavy",
+		Mix: []WeightedOperation{
+			{Op: OpCreate, Weight: 40},
+			{Op: OpList, Weight: 20},
+			{Op: OpGet, Weight: 10},
+			{Op: OpUpdate, Weight: 30},
+		},
+	},
+	{
+		Name: "mixed",
+		Mix: []WeightedOperation{
+			{Op: OpCreate, Weight: 25},
+			{Op: OpList, Weight: 25},
+			{Op: OpGet, Weight: 25},
+			{Op: OpUpdate, Weight: 25},
+		},
+	},
+}
+
+// ScenarioByName returns the predefined scenario with the given name
+func ScenarioByName(name string) (Scenario, error) {
+	for _, s := range scenarios {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return Scenario{}, fmt.Errorf("unknown scenario %q, available: %v", name, ScenarioNames())
+}
+
+// ScenarioNames returns the names of all predefined scenarios in sorted order
+func ScenarioNames() []string {
+	names := make([]string, len(scenarios))
+	for i, s := range scenarios {
+		names[i] = s.Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+// pick selects the next operation according to the scenario weights
+func (s Scenario) pick(r *rand.Rand) Operation {
+	total := 0
+	for _, w := range s.Mix {
+		total += w.Weight
+	}
+	if total <= 0 {
+		return OpList
+	}
+
+	n := r.Intn(total)
+	for _, w := range s.Mix {
+		if n < w.Weight {
+			return w.Op
+		}
+		n -= w.Weight
+	}
+	return s.Mix[len(s.Mix)-1].Op
+}
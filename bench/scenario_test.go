@@ -0,0 +1,67 @@
+package bench
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioByName(t *testing.T) {
+	s, err := ScenarioByName("read-heavy")
+	require.NoError(t, err)
+	assert.Equal(t, "read-heavy", s.Name)
+
+	_, err = ScenarioByName("unknown")
+	require.Error(t, err)
+}
+
+func TestScenario_Pick_Reproducible(t *testing.T) {
+	s, err := ScenarioByName("mixed")
+	require.NoError(t, err)
+
+	sequence := func(seed int64) []Operation {
+		r := rand.New(rand.NewSource(seed)) //nolint:gosec // test
+		ops := make([]Operation, 100)
+		for i := range ops {
+			ops[i] = s.pick(r)
+		}
+		return ops
+	}
+
+	assert.Equal(t, sequence(42), sequence(42))
+	assert.NotEqual(t, sequence(42), sequence(43))
+}
+
+func TestScenario_Pick_RespectsWeights(t *testing.T) {
+	s := Scenario{Name: "only-list", Mix: []WeightedOperation{
+		{Op: OpCreate, Weight: 0},
+		{Op: OpList, Weight: 1},
+	}}
+	r := rand.New(rand.NewSource(1)) //nolint:gosec // test
+
+	for range 50 {
+		assert.Equal(t, OpList, s.pick(r))
+	}
+}
+
+func TestOperationStats_Percentile(t *testing.T) {
+	r := newReport("test")
+	for i := 1; i <= 100; i++ {
+		r.record(OpGet, timeMs(i), nil)
+	}
+	r.record(OpGet, timeMs(1000), assert.AnError)
+
+	stats := r.Stats(OpGet)
+	assert.Equal(t, 101, stats.Count)
+	assert.Equal(t, 1, stats.Errors)
+	assert.Equal(t, timeMs(50), stats.Percentile(50))
+	assert.Equal(t, timeMs(99), stats.Percentile(99))
+	assert.Zero(t, r.Stats(OpUpdate).Percentile(50))
+}
+
+func timeMs(n int) time.Duration {
+	return time.Duration(n) * time.Millisecond
+}
@@ -0,0 +1,144 @@
+// Command demo runs the catalog service without any infrastructure, for product demos and frontend development.
+//
+// Data is kept in memory and lost on exit, events are discarded, and any bearer token is accepted with
+// admin permissions. The tenant is still taken from the X-Tenant-Slug header, but all tenants share the same data. Configuration is optional and read as by the service, from CONFIG_FILE and the environment.
+//
+// Swagger UI for the Connect API is served at /swagger, with the calls made to the demo as examples.
+//
+// With -mock, the demo starts as a mock server for frontends and the contract tests of consumer services:
+// it is seeded with the canned catalog shipped with the service, or with the fixtures of the -fixtures file.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"connectrpc.com/connect"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/fixtures"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/apiexplorer"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/cachewarming"
+	internalconnect "github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/cronrunner"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/featureflags"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/info"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/jobevents"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/maintenancemode"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/reservationexpiry"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/sitemap"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/storefront"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/kafka"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/memory"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/quotaplans"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/sourcecatalog"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/validationlimits"
+	commons_core "github.com/Sokol111/ecommerce-commons/pkg/core"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	commons_http "github.com/Sokol111/ecommerce-commons/pkg/http"
+	commons_httpclient "github.com/Sokol111/ecommerce-commons/pkg/http/client"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+	commons_observability "github.com/Sokol111/ecommerce-commons/pkg/observability"
+	commons_validation "github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+var AppModules = fx.Options(
+	// Commons
+	commons_core.NewCoreModule(
+		commons_core.WithAppConfig(config.AppConfig{
+			ServiceName:    "ecommerce-catalog-service",
+			Environment:    "demo",
+			ServiceVersion: "demo",
+		}),
+	),
+	commons_http.NewHTTPModule(commons_http.WithH2C()),
+	commons_observability.NewObservabilityModule(
+		commons_observability.WithoutTracing(),
+		commons_observability.WithoutMetrics(),
+	),
+	commons_validation.NewModule(commons_validation.WithDisableValidation()),
+
+	// Tenant resolution only: there is no tenant registry to sync
+	fx.Provide(
+		fx.Annotate(provideTenantResolver, fx.ResultTags(`group:"connect_interceptor"`)),
+		provideTenantSlugs,
+	),
+
+	// Domain & Application
+	memory.DemoModule(),
+	application.Module(),
+	kafka.Module(),
+	quotaplans.Module(),
+	validationlimits.Module(),
+	commons_httpclient.RegistryModule(),
+	sourcecatalog.Module(),
+	signing.Module(),
+	compression.Module(),
+	reservationexpiry.Module(),
+	cachewarming.Module(),
+	cronrunner.Module(),
+	featureflags.Module(),
+
+	// Connect (gRPC/Connect-RPC)
+	internalconnect.Module(),
+
+	// Plain HTTP endpoints outside the Connect API contract
+	sitemap.Module(),
+	storefront.Module(),
+	jobevents.Module(),
+	maintenancemode.Module(),
+	info.Module(),
+	apiexplorer.Module(),
+)
+
+func provideTenantResolver() interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: tenant.ResolverInterceptorPriority,
+		Handler:  connect.UnaryInterceptorFunc(tenant.NewResolverInterceptor()),
+	}
+}
+
+// demoSlugs lists a single tenant for the background workers, which see the data of every tenant through it
+type demoSlugs struct{}
+
+func (demoSlugs) GetSlugs(context.Context) ([]string, error) {
+	return []string{"demo"}, nil
+}
+
+func provideTenantSlugs() tenant.SlugsProvider {
+	return demoSlugs{}
+}
+
+func main() {
+	mock := flag.Bool("mock", false, "seed the catalog with fixtures on start")
+	fixturesPath := flag.String("fixtures", "", "fixtures file seeded by -mock, the canned catalog when empty")
+	flag.Parse()
+
+	seed := fx.Options()
+	if *mock {
+		seed = fixtures.Module("demo", *fixturesPath)
+	}
+
+	app := fx.New(
+		AppModules,
+		seed,
+		fx.Invoke(func(lc fx.Lifecycle, log *zap.Logger) {
+			lc.Append(fx.Hook{
+				OnStart: func(ctx context.Context) error {
+					log.Warn("running in demo mode: data is kept in memory, events are discarded and requests aren't authenticated")
+					return nil
+				},
+				OnStop: func(ctx context.Context) error {
+					log.Info("Application stopping...")
+					return nil
+				},
+			})
+		}),
+	)
+	app.Run()
+}
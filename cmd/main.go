@@ -4,11 +4,32 @@ import (
 	"context"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/cachewarming"
 	internalconnect "github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/cronrunner"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/featureflags"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/info"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/jobevents"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/maintenancemode"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/reservationexpiry"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/salesanalytics"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/sitemap"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/storefront"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/breaker"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/cdn"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/kafka"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/media"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/mongo"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/outboxretry"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/quotaplans"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/sourcecatalog"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/preflight"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/validationlimits"
 	commons_core "github.com/Sokol111/ecommerce-commons/pkg/core"
 	commons_http "github.com/Sokol111/ecommerce-commons/pkg/http"
+	commons_httpclient "github.com/Sokol111/ecommerce-commons/pkg/http/client"
 	commons_messaging "github.com/Sokol111/ecommerce-commons/pkg/messaging"
 	commons_observability "github.com/Sokol111/ecommerce-commons/pkg/observability"
 	commons_persistence "github.com/Sokol111/ecommerce-commons/pkg/persistence"
@@ -23,10 +44,14 @@ import (
 )
 
 var AppModules = fx.Options(
+	// Reports every configuration problem at once, before the modules fail on theirs
+	preflight.Module(),
+
 	// Commons
 	commons_core.NewCoreModule(),
 	commons_persistence.NewPersistenceModule(),
 	commons_http.NewHTTPModule(commons_http.WithH2C()),
+	commons_httpclient.RegistryModule(),
 	commons_observability.NewObservabilityModule(),
 	commons_messaging.NewMessagingModule(),
 	commons_validation.NewModule(),
@@ -42,9 +67,31 @@ var AppModules = fx.Options(
 	mongo.Module(),
 	application.Module(),
 	kafka.Module(),
+	kafka.TopicsModule(),
+	media.Module(),
+	sourcecatalog.Module(),
+	outboxretry.Module(),
+	breaker.Module(),
+	cdn.Module(),
+	quotaplans.Module(),
+	validationlimits.Module(),
+	signing.Module(),
+	compression.Module(),
+	reservationexpiry.Module(),
+	cachewarming.Module(),
+	cronrunner.Module(),
+	featureflags.Module(),
+	salesanalytics.Module(),
 
 	// Connect (gRPC/Connect-RPC)
 	internalconnect.Module(),
+
+	// Plain HTTP endpoints outside the Connect API contract
+	sitemap.Module(),
+	storefront.Module(),
+	jobevents.Module(),
+	maintenancemode.Module(),
+	info.Module(),
 )
 
 func main() {
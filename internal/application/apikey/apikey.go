@@ -0,0 +1,153 @@
+// Package apikey manages static API keys for server-to-server integrations that can't obtain OAuth tokens.
+//
+// A key is shown once, when it is created or rotated; only a SHA-256 hash of its secret is stored.
+// Keys belong to a tenant and carry the permissions they were created with, which are checked like
+// the permissions of a bearer token.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// Prefix starts every key, so keys can be told from JWTs and found by secret scanners
+const Prefix = "ck_"
+
+const (
+	maxNameLength  = 100
+	maxPermissions = 50
+	// secretBytes is the entropy of a secret; at 256 bits a plain hash is as safe as a slow one
+	secretBytes = 32
+	// wildcardPermission grants everything to tokens; keys are always scoped
+	wildcardPermission = "*"
+)
+
+// APIKey is a key of a tenant. The key given to the integrator is Prefix, the ID, a dot and the secret.
+type APIKey struct {
+	ID          string
+	Version     int
+	Tenant      string
+	Name        string
+	Permissions []string
+	// SecretHash is the hex SHA-256 of the secret
+	SecretHash string
+	CreatedAt  time.Time
+	// ExpiresAt is set on keys replaced by a rotation; they keep working until then
+	ExpiresAt *time.Time
+}
+
+// NewAPIKey creates a key of the tenant and returns it with the key to hand out
+func NewAPIKey(tenant, name string, permissions []string) (*APIKey, string, error) {
+	if tenant == "" {
+		return nil, "", fmt.Errorf("%w: tenant is required", ErrInvalidAPIKeyData)
+	}
+	if err := validateAPIKey(name, permissions); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	k := &APIKey{
+		ID:          uuid.New().String(),
+		Version:     1,
+		Tenant:      tenant,
+		Name:        name,
+		Permissions: slices.Clone(permissions),
+		SecretHash:  hashSecret(secret),
+		CreatedAt:   utc.Now(),
+	}
+	return k, Prefix + k.ID + "." + secret, nil
+}
+
+// Reconstruct rebuilds a key from persistence without validation
+func Reconstruct(id string, version int, tenant, name string, permissions []string, secretHash string, createdAt time.Time, expiresAt *time.Time) *APIKey {
+	return &APIKey{
+		ID:          id,
+		Version:     version,
+		Tenant:      tenant,
+		Name:        name,
+		Permissions: permissions,
+		SecretHash:  secretHash,
+		CreatedAt:   createdAt,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+// Verify reports whether the secret is the one of the key, in constant time
+func (k *APIKey) Verify(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(k.SecretHash)) == 1
+}
+
+// Expired reports whether a rotation replaced the key and its grace period is over
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// expireAt ends the key at the given time, unless it already ends earlier
+func (k *APIKey) expireAt(t time.Time) {
+	if k.ExpiresAt == nil || t.Before(*k.ExpiresAt) {
+		k.ExpiresAt = &t
+	}
+}
+
+// ParseKey splits a key into the ID and the secret
+func ParseKey(key string) (id, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(key, Prefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, ".")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+func validateAPIKey(name string, permissions []string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAPIKeyData)
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidAPIKeyData, maxNameLength)
+	}
+	if len(permissions) == 0 {
+		return fmt.Errorf("%w: at least one permission is required", ErrInvalidAPIKeyData)
+	}
+	if len(permissions) > maxPermissions {
+		return fmt.Errorf("%w: too many permissions (max %d)", ErrInvalidAPIKeyData, maxPermissions)
+	}
+	for _, p := range permissions {
+		if p == "" || p == wildcardPermission {
+			return fmt.Errorf("%w: permission %q can't be granted to a key", ErrInvalidAPIKeyData, p)
+		}
+	}
+	return nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate API key secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,65 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	k, key, err := NewAPIKey("acme", "ERP feed", []string{"products:write"})
+	require.NoError(t, err)
+
+	id, secret, ok := ParseKey(key)
+	require.True(t, ok)
+	assert.Equal(t, k.ID, id)
+	assert.True(t, strings.HasPrefix(key, Prefix))
+	assert.True(t, k.Verify(secret))
+	assert.False(t, k.Verify(secret+"x"))
+	assert.Len(t, k.SecretHash, 64, "only the SHA-256 of the secret is stored")
+}
+
+func TestNewAPIKey_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		tenant      string
+		keyName     string
+		permissions []string
+	}{
+		{name: "no tenant", keyName: "feed", permissions: []string{"products:read"}},
+		{name: "no name", tenant: "acme", permissions: []string{"products:read"}},
+		{name: "name too long", tenant: "acme", keyName: strings.Repeat("a", maxNameLength+1), permissions: []string{"products:read"}},
+		{name: "no permissions", tenant: "acme", keyName: "feed"},
+		{name: "wildcard", tenant: "acme", keyName: "feed", permissions: []string{"*"}},
+		{name: "empty permission", tenant: "acme", keyName: "feed", permissions: []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := NewAPIKey(tt.tenant, tt.keyName, tt.permissions)
+			require.ErrorIs(t, err, ErrInvalidAPIKeyData)
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	for _, key := range []string{"", "eyJhbGciOi.x.y", Prefix, Prefix + "id", Prefix + ".secret", Prefix + "id."} {
+		_, _, ok := ParseKey(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestAPIKey_ExpireAt(t *testing.T) {
+	k, _, err := NewAPIKey("acme", "feed", []string{"products:read"})
+	require.NoError(t, err)
+	now := time.Now()
+	assert.False(t, k.Expired(now))
+
+	k.expireAt(now.Add(time.Hour))
+	k.expireAt(now.Add(2 * time.Hour))
+	assert.Equal(t, now.Add(time.Hour), *k.ExpiresAt, "a later expiry doesn't extend the key")
+	assert.False(t, k.Expired(now))
+	assert.True(t, k.Expired(now.Add(time.Hour)))
+}
@@ -0,0 +1,44 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type Authenticator interface {
+	// Authenticate returns the key matching the given one, or ErrInvalidAPIKey when the key is unknown,
+	// malformed, expired or has a wrong secret
+	Authenticate(ctx context.Context, key string) (*APIKey, error)
+}
+
+type authenticator struct {
+	repo Repository
+}
+
+func NewAuthenticator(repo Repository) Authenticator {
+	return &authenticator{repo: repo}
+}
+
+func (a *authenticator) Authenticate(ctx context.Context, key string) (*APIKey, error) {
+	id, secret, ok := ParseKey(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	k, err := a.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if !k.Verify(secret) || k.Expired(time.Now()) {
+		return nil, ErrInvalidAPIKey
+	}
+	return k, nil
+}
@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type CreateAPIKeyCommand struct {
+	Tenant      string
+	Name        string
+	Permissions []string
+}
+
+// CreateAPIKeyResult is the stored key and the key to hand out, which can't be retrieved later
+type CreateAPIKeyResult struct {
+	APIKey *APIKey
+	Key    string
+}
+
+type CreateAPIKeyCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateAPIKeyCommand) (*CreateAPIKeyResult, error)
+}
+
+type createAPIKeyHandler struct {
+	repo Repository
+}
+
+func NewCreateAPIKeyHandler(repo Repository) CreateAPIKeyCommandHandler {
+	return &createAPIKeyHandler{repo: repo}
+}
+
+func (h *createAPIKeyHandler) Handle(ctx context.Context, cmd CreateAPIKeyCommand) (*CreateAPIKeyResult, error) {
+	k, key, err := NewAPIKey(cmd.Tenant, cmd.Name, cmd.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Insert(ctx, k); err != nil {
+		return nil, fmt.Errorf("failed to insert API key: %w", err)
+	}
+
+	h.log(ctx).Info("API key created", zap.String("id", k.ID), zap.String("tenant", k.Tenant), zap.Strings("permissions", k.Permissions))
+	return &CreateAPIKeyResult{APIKey: k, Key: key}, nil
+}
+
+func (h *createAPIKeyHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "create-api-key-handler"))
+}
@@ -0,0 +1,10 @@
+package apikey
+
+import "errors"
+
+var (
+	ErrInvalidAPIKeyData = errors.New("invalid API key data")
+	ErrAPIKeyNotFound    = errors.New("API key not found")
+	// ErrInvalidAPIKey is returned for unknown, expired and malformed keys alike, so callers can't probe for key IDs
+	ErrInvalidAPIKey = errors.New("invalid API key")
+)
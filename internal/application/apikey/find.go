@@ -0,0 +1,24 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// findOfTenant loads a key of the tenant; keys of other tenants are reported as not found
+func findOfTenant(ctx context.Context, repo Repository, tenant, id string) (*APIKey, error) {
+	k, err := repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	if k.Tenant != tenant {
+		return nil, ErrAPIKeyNotFound
+	}
+	return k, nil
+}
@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+)
+
+type GetAPIKeyListQuery struct {
+	Tenant string
+}
+
+type GetAPIKeyListQueryHandler interface {
+	Handle(ctx context.Context, query GetAPIKeyListQuery) ([]*APIKey, error)
+}
+
+type getAPIKeyListHandler struct {
+	repo Repository
+}
+
+func NewGetAPIKeyListHandler(repo Repository) GetAPIKeyListQueryHandler {
+	return &getAPIKeyListHandler{repo: repo}
+}
+
+func (h *getAPIKeyListHandler) Handle(ctx context.Context, query GetAPIKeyListQuery) ([]*APIKey, error) {
+	keys, err := h.repo.FindByTenant(ctx, query.Tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %w", err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,17 @@
+package apikey
+
+import "context"
+
+type Repository interface {
+	Insert(ctx context.Context, key *APIKey) error
+
+	// FindByID returns a key of any tenant or commonsmongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*APIKey, error)
+
+	// FindByTenant returns the keys of a tenant, oldest first
+	FindByTenant(ctx context.Context, tenant string) ([]*APIKey, error)
+
+	Update(ctx context.Context, key *APIKey) (*APIKey, error)
+
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,43 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type RevokeAPIKeyCommand struct {
+	Tenant string
+	ID     string
+}
+
+type RevokeAPIKeyCommandHandler interface {
+	Handle(ctx context.Context, cmd RevokeAPIKeyCommand) error
+}
+
+type revokeAPIKeyHandler struct {
+	repo Repository
+}
+
+func NewRevokeAPIKeyHandler(repo Repository) RevokeAPIKeyCommandHandler {
+	return &revokeAPIKeyHandler{repo: repo}
+}
+
+func (h *revokeAPIKeyHandler) Handle(ctx context.Context, cmd RevokeAPIKeyCommand) error {
+	if _, err := findOfTenant(ctx, h.repo, cmd.Tenant, cmd.ID); err != nil {
+		return err
+	}
+
+	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("failed to delete API key: %w", err)
+	}
+
+	h.log(ctx).Info("API key revoked", zap.String("id", cmd.ID), zap.String("tenant", cmd.Tenant))
+	return nil
+}
+
+func (h *revokeAPIKeyHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "revoke-api-key-handler"))
+}
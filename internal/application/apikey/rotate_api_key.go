@@ -0,0 +1,84 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+// maxGracePeriod bounds how long a rotated key keeps working
+const maxGracePeriod = 30 * 24 * time.Hour
+
+type RotateAPIKeyCommand struct {
+	Tenant string
+	ID     string
+	// GracePeriod is how long the old key keeps working, so integrators can switch without downtime;
+	// zero revokes it at once
+	GracePeriod time.Duration
+}
+
+type RotateAPIKeyCommandHandler interface {
+	// Handle creates a key with the name and permissions of the given one and expires the given one
+	Handle(ctx context.Context, cmd RotateAPIKeyCommand) (*CreateAPIKeyResult, error)
+}
+
+type rotateAPIKeyHandler struct {
+	repo      Repository
+	txManager mongo.TxManager
+}
+
+func NewRotateAPIKeyHandler(repo Repository, txManager mongo.TxManager) RotateAPIKeyCommandHandler {
+	return &rotateAPIKeyHandler{repo: repo, txManager: txManager}
+}
+
+func (h *rotateAPIKeyHandler) Handle(ctx context.Context, cmd RotateAPIKeyCommand) (*CreateAPIKeyResult, error) {
+	if cmd.GracePeriod < 0 || cmd.GracePeriod > maxGracePeriod {
+		return nil, fmt.Errorf("%w: grace period must be between 0 and %s", ErrInvalidAPIKeyData, maxGracePeriod)
+	}
+
+	old, err := findOfTenant(ctx, h.repo, cmd.Tenant, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old.Expired(time.Now()) {
+		return nil, fmt.Errorf("%w: expired keys can't be rotated", ErrInvalidAPIKeyData)
+	}
+
+	k, key, err := NewAPIKey(old.Tenant, old.Name, old.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*APIKey, error) {
+		if err := h.repo.Insert(txCtx, k); err != nil {
+			return nil, fmt.Errorf("failed to insert API key: %w", err)
+		}
+		if cmd.GracePeriod == 0 {
+			if err := h.repo.Delete(txCtx, old.ID); err != nil {
+				return nil, fmt.Errorf("failed to delete API key: %w", err)
+			}
+			return k, nil
+		}
+		old.expireAt(utc.Now().Add(cmd.GracePeriod))
+		if _, err := h.repo.Update(txCtx, old); err != nil {
+			return nil, fmt.Errorf("failed to update API key: %w", err)
+		}
+		return k, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Info("API key rotated", zap.String("id", old.ID), zap.String("newId", k.ID),
+		zap.String("tenant", k.Tenant), zap.Duration("gracePeriod", cmd.GracePeriod))
+	return &CreateAPIKeyResult{APIKey: k, Key: key}, nil
+}
+
+func (h *rotateAPIKeyHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "rotate-api-key-handler"))
+}
@@ -3,9 +3,13 @@ package attribute
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
 )
 
 // AttributeType represents the type of attribute
@@ -24,21 +28,29 @@ type Option struct {
 	Name      string
 	Slug      string
 	ColorCode *string
+	ImageID   *string // swatch image in the media service
 	SortOrder int
+	// Deprecated options stay valid on stored products, which are flagged until they are remapped
+	// to ReplacementSlug, if any; see DeprecateOption
+	Deprecated      bool
+	ReplacementSlug *string
 }
 
 // Attribute - domain aggregate root
 type Attribute struct {
-	ID         string
-	Version    int
-	Name       string
-	Slug       string
-	Type       AttributeType
-	Unit       *string
-	Enabled    bool
-	Options    []Option
-	CreatedAt  time.Time
-	ModifiedAt time.Time
+	ID          string
+	Version     int
+	Name        string
+	Slug        string
+	Type        AttributeType
+	DisplayType DisplayType
+	PaletteID   *string // Palette the swatch colors are picked from
+	Unit        *string // Canonical unit, values are stored in it
+	InputUnits  []UnitConversion
+	Enabled     bool
+	Options     []Option
+	CreatedAt   time.Time
+	ModifiedAt  time.Time
 }
 
 var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
@@ -46,6 +58,7 @@ var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 // NewAttribute creates a new attribute with validation.
 // If id is empty, a new UUID will be generated.
 func NewAttribute(
+	l limits.Attribute,
 	id string,
 	name string,
 	slug string,
@@ -54,11 +67,11 @@ func NewAttribute(
 	enabled bool,
 	options []Option,
 ) (*Attribute, error) {
-	if err := validateAttributeData(name, slug, attrType); err != nil {
+	if err := validateAttributeData(l, name, slug, attrType); err != nil {
 		return nil, err
 	}
 
-	if err := validateOptions(options); err != nil {
+	if err := validateOptions(l, options); err != nil {
 		return nil, err
 	}
 
@@ -66,22 +79,29 @@ func NewAttribute(
 		id = uuid.New().String()
 	}
 
-	now := time.Now().UTC()
+	displayType := DefaultDisplayType(attrType)
+	if err := validateDisplay(attrType, displayType, options); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
 	return &Attribute{
-		ID:         id,
-		Version:    1,
-		Name:       name,
-		Slug:       slug,
-		Type:       attrType,
-		Unit:       unit,
-		Enabled:    enabled,
-		Options:    options,
-		CreatedAt:  now,
-		ModifiedAt: now,
+		ID:          id,
+		Version:     1,
+		Name:        name,
+		Slug:        slug,
+		Type:        attrType,
+		DisplayType: displayType,
+		Unit:        unit,
+		Enabled:     enabled,
+		Options:     options,
+		CreatedAt:   now,
+		ModifiedAt:  now,
 	}, nil
 }
 
-// Reconstruct rebuilds an attribute from persistence (no validation)
+// Reconstruct rebuilds an attribute from persistence (no validation).
+// An empty display type, stored before display types were introduced, falls back to the default.
 func Reconstruct(
 	id string,
 	version int,
@@ -91,26 +111,40 @@ func Reconstruct(
 	unit *string,
 	enabled bool,
 	options []Option,
+	displayType DisplayType,
+	paletteID *string,
+	inputUnits []UnitConversion,
 	createdAt time.Time,
 	modifiedAt time.Time,
 ) *Attribute {
+	if displayType == "" {
+		displayType = DefaultDisplayType(attrType)
+	}
+
 	return &Attribute{
-		ID:         id,
-		Version:    version,
-		Name:       name,
-		Slug:       slug,
-		Type:       attrType,
-		Unit:       unit,
-		Enabled:    enabled,
-		Options:    options,
-		CreatedAt:  createdAt,
-		ModifiedAt: modifiedAt,
+		ID:          id,
+		Version:     version,
+		Name:        name,
+		Slug:        slug,
+		Type:        attrType,
+		DisplayType: displayType,
+		PaletteID:   paletteID,
+		Unit:        unit,
+		InputUnits:  inputUnits,
+		Enabled:     enabled,
+		Options:     options,
+		CreatedAt:   createdAt,
+		ModifiedAt:  modifiedAt,
 	}
 }
 
 // Update modifies attribute data with validation
-// Note: slug and type are immutable and cannot be changed after creation
+// Note: slug and type are immutable and cannot be changed after creation.
+// Changing the unit drops the input units, their factors convert to the previous unit.
+// Option images are managed with ChangeDisplay: an option without an image keeps the image of the
+// existing option with the same slug. Deprecations are managed with DeprecateOption and kept the same way.
 func (a *Attribute) Update(
+	l limits.Attribute,
 	name string,
 	unit *string,
 	enabled bool,
@@ -120,39 +154,114 @@ func (a *Attribute) Update(
 		return fmt.Errorf("%w: name is required", ErrInvalidAttributeData)
 	}
 
-	if len(name) > 100 {
-		return fmt.Errorf("%w: name is too long (max 100 characters)", ErrInvalidAttributeData)
+	if maxLength := l.NameLength; len(name) > maxLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidAttributeData, maxLength)
 	}
 
-	if err := validateOptions(options); err != nil {
+	if err := validateOptions(l, options); err != nil {
 		return err
 	}
 
+	options = keepOptionImages(a.Options, options)
+	if err := validateDisplay(a.Type, a.DisplayType, options); err != nil {
+		return err
+	}
+
+	options = keepOptionDeprecations(a.Options, options)
+	if err := validateReplacements(options); err != nil {
+		return err
+	}
+
+	if !equalUnits(a.Unit, unit) {
+		a.InputUnits = nil
+	}
+
 	a.Name = name
 	a.Unit = unit
 	a.Enabled = enabled
 	a.Options = options
-	a.ModifiedAt = time.Now().UTC()
+	a.ModifiedAt = utc.NowAfter(a.ModifiedAt)
+
+	return nil
+}
+
+// ChangeDisplay sets how frontends render the attribute; optionImages maps option slugs to
+// swatch images and replaces all existing option images. Swatches can be bound to a palette,
+// the caller checks the option colors against it with CheckPaletteColors.
+func (a *Attribute) ChangeDisplay(displayType DisplayType, paletteID *string, optionImages map[string]string) error {
+	if paletteID != nil && displayType != DisplayTypeSwatch {
+		return fmt.Errorf("%w: only swatches can use a palette", ErrInvalidAttributeData)
+	}
+
+	for slug := range optionImages {
+		if !slices.ContainsFunc(a.Options, func(opt Option) bool { return opt.Slug == slug }) {
+			return fmt.Errorf("%w: unknown option %s", ErrInvalidAttributeData, slug)
+		}
+	}
+
+	options := make([]Option, len(a.Options))
+	for i, opt := range a.Options {
+		opt.ImageID = nil
+		if imageID, ok := optionImages[opt.Slug]; ok {
+			opt.ImageID = &imageID
+		}
+		options[i] = opt
+	}
 
+	if err := validateDisplay(a.Type, displayType, options); err != nil {
+		return err
+	}
+
+	a.DisplayType = displayType
+	a.PaletteID = paletteID
+	a.Options = options
+	a.ModifiedAt = utc.NowAfter(a.ModifiedAt)
 	return nil
 }
 
+// keepOptionImages copies the images of previous options to updated options with the same slug
+func keepOptionImages(previous, updated []Option) []Option {
+	images := make(map[string]*string, len(previous))
+	for _, opt := range previous {
+		if opt.ImageID != nil {
+			images[opt.Slug] = opt.ImageID
+		}
+	}
+	if len(images) == 0 {
+		return updated
+	}
+
+	result := make([]Option, len(updated))
+	for i, opt := range updated {
+		if opt.ImageID == nil {
+			opt.ImageID = images[opt.Slug]
+		}
+		result[i] = opt
+	}
+	return result
+}
+
+// HasOptions reports whether values of the attribute are picked from its options
+func (a *Attribute) HasOptions() bool {
+	return a.Type == AttributeTypeSingle || a.Type == AttributeTypeMultiple
+}
+
 // validateAttributeData validates business rules
-func validateAttributeData(name string, slug string, attrType AttributeType) error {
+func validateAttributeData(l limits.Attribute, name string, slug string, attrType AttributeType) error {
 	if name == "" {
 		return fmt.Errorf("%w: name is required", ErrInvalidAttributeData)
 	}
 
-	if len(name) > 100 {
-		return fmt.Errorf("%w: name is too long (max 100 characters)", ErrInvalidAttributeData)
+	if maxLength := l.NameLength; len(name) > maxLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidAttributeData, maxLength)
 	}
 
 	if slug == "" {
 		return fmt.Errorf("%w: slug is required", ErrInvalidAttributeData)
 	}
 
-	if len(slug) > 50 {
-		return fmt.Errorf("%w: slug is too long (max 50 characters)", ErrInvalidAttributeData)
+	if maxLength := l.SlugLength; len(slug) > maxLength {
+		return fmt.Errorf("%w: slug is too long (max %d characters)", ErrInvalidAttributeData, maxLength)
 	}
 
 	if !slugRegex.MatchString(slug) {
@@ -175,24 +284,28 @@ func isValidAttributeType(t AttributeType) bool {
 }
 
 // validateOptions validates option data
-func validateOptions(options []Option) error {
+func validateOptions(l limits.Attribute, options []Option) error {
 	if len(options) == 0 {
 		return nil
 	}
 
+	if len(options) > l.Options {
+		return fmt.Errorf("%w: too many options (max %d)", ErrInvalidAttributeData, l.Options)
+	}
+
 	slugs := make(map[string]bool)
 	for _, opt := range options {
 		if opt.Name == "" {
 			return fmt.Errorf("%w: option name is required", ErrInvalidAttributeData)
 		}
-		if len(opt.Name) > 100 {
-			return fmt.Errorf("%w: option name is too long (max 100 characters)", ErrInvalidAttributeData)
+		if len(opt.Name) > l.OptionNameLength {
+			return fmt.Errorf("%w: option name is too long (max %d characters)", ErrInvalidAttributeData, l.OptionNameLength)
 		}
 		if opt.Slug == "" {
 			return fmt.Errorf("%w: option slug is required", ErrInvalidAttributeData)
 		}
-		if len(opt.Slug) > 50 {
-			return fmt.Errorf("%w: option slug is too long (max 50 characters)", ErrInvalidAttributeData)
+		if len(opt.Slug) > l.OptionSlugLength {
+			return fmt.Errorf("%w: option slug is too long (max %d characters)", ErrInvalidAttributeData, l.OptionSlugLength)
 		}
 		if !slugRegex.MatchString(opt.Slug) {
 			return fmt.Errorf("%w: option slug must contain only lowercase letters, numbers, and hyphens", ErrInvalidAttributeData)
@@ -204,6 +317,9 @@ func validateOptions(options []Option) error {
 		if opt.SortOrder < 0 {
 			return fmt.Errorf("%w: option sortOrder cannot be negative", ErrInvalidAttributeData)
 		}
+		if opt.ColorCode != nil && !IsColorCode(*opt.ColorCode) {
+			return fmt.Errorf("%w: option %s color code must be a hex color like #FF0000", ErrInvalidAttributeData, opt.Slug)
+		}
 	}
 	return nil
 }
@@ -7,8 +7,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 )
 
+// defaultLimits are the attribute limits the tests validate against
+var defaultLimits = limits.Default().Attribute
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -186,6 +191,7 @@ func TestNewAttribute(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			attr, err := NewAttribute(
+				defaultLimits,
 				tt.id,
 				tt.attrName,
 				tt.slug,
@@ -236,7 +242,7 @@ func TestNewAttribute_ValidSlugs(t *testing.T) {
 
 	for _, slug := range validSlugs {
 		t.Run("valid slug: "+slug, func(t *testing.T) {
-			attr, err := NewAttribute("", "Test", slug, AttributeTypeSingle, nil, true, nil)
+			attr, err := NewAttribute(defaultLimits, "", "Test", slug, AttributeTypeSingle, nil, true, nil)
 			require.NoError(t, err)
 			assert.Equal(t, slug, attr.Slug)
 		})
@@ -257,7 +263,7 @@ func TestAttribute_Update(t *testing.T) {
 		{
 			name: "successful update",
 			setup: func() *Attribute {
-				a, _ := NewAttribute("", "Original", "original", AttributeTypeSingle, nil, false, nil)
+				a, _ := NewAttribute(defaultLimits, "", "Original", "original", AttributeTypeSingle, nil, false, nil)
 				return a
 			},
 			newName: "Updated Name",
@@ -271,7 +277,7 @@ func TestAttribute_Update(t *testing.T) {
 		{
 			name: "error when updating with empty name",
 			setup: func() *Attribute {
-				a, _ := NewAttribute("", "Original", "original", AttributeTypeSingle, nil, false, nil)
+				a, _ := NewAttribute(defaultLimits, "", "Original", "original", AttributeTypeSingle, nil, false, nil)
 				return a
 			},
 			newName:     "",
@@ -281,7 +287,7 @@ func TestAttribute_Update(t *testing.T) {
 		{
 			name: "error when updating with too long name",
 			setup: func() *Attribute {
-				a, _ := NewAttribute("", "Original", "original", AttributeTypeSingle, nil, false, nil)
+				a, _ := NewAttribute(defaultLimits, "", "Original", "original", AttributeTypeSingle, nil, false, nil)
 				return a
 			},
 			newName:     strings.Repeat("a", 101),
@@ -291,7 +297,7 @@ func TestAttribute_Update(t *testing.T) {
 		{
 			name: "slug and type remain unchanged after update",
 			setup: func() *Attribute {
-				a, _ := NewAttribute("", "Original", "original-slug", AttributeTypeRange, nil, false, nil)
+				a, _ := NewAttribute(defaultLimits, "", "Original", "original-slug", AttributeTypeRange, nil, false, nil)
 				return a
 			},
 			newName: "New Name",
@@ -311,7 +317,7 @@ func TestAttribute_Update(t *testing.T) {
 
 			time.Sleep(time.Millisecond)
 
-			err := attr.Update(tt.newName, tt.unit, tt.enabled, tt.options)
+			err := attr.Update(defaultLimits, tt.newName, tt.unit, tt.enabled, tt.options)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -332,6 +338,110 @@ func TestAttribute_Update(t *testing.T) {
 	}
 }
 
+func TestNewAttribute_DefaultDisplayType(t *testing.T) {
+	tests := []struct {
+		attrType AttributeType
+		want     DisplayType
+	}{
+		{AttributeTypeSingle, DisplayTypeDropdown},
+		{AttributeTypeMultiple, DisplayTypeCheckbox},
+		{AttributeTypeRange, DisplayTypeSlider},
+		{AttributeTypeBoolean, DisplayTypeCheckbox},
+		{AttributeTypeText, DisplayTypeText},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.attrType), func(t *testing.T) {
+			attr, err := NewAttribute(defaultLimits, "", "Attr", "attr", tt.attrType, nil, true, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attr.DisplayType)
+		})
+	}
+}
+
+func TestAttribute_ChangeDisplay(t *testing.T) {
+	newColor := func() *Attribute {
+		attr, err := NewAttribute(defaultLimits, "", "Color", "color", AttributeTypeSingle, nil, true, []Option{
+			{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000")},
+			{Name: "Denim", Slug: "denim"},
+		})
+		require.NoError(t, err)
+		return attr
+	}
+
+	tests := []struct {
+		name         string
+		displayType  DisplayType
+		optionImages map[string]string
+		errContains  string
+	}{
+		{
+			name:         "swatch with colors and images",
+			displayType:  DisplayTypeSwatch,
+			optionImages: map[string]string{"denim": "image-denim"},
+		},
+		{
+			name:        "swatch option without color or image",
+			displayType: DisplayTypeSwatch,
+			errContains: "swatch option denim needs a color code or an image",
+		},
+		{
+			name:        "slider for single attribute",
+			displayType: DisplayTypeSlider,
+			errContains: "not supported for single attributes",
+		},
+		{
+			name:         "images for dropdown",
+			displayType:  DisplayTypeDropdown,
+			optionImages: map[string]string{"denim": "image-denim"},
+			errContains:  "option images are only supported for swatches",
+		},
+		{
+			name:         "image for unknown option",
+			displayType:  DisplayTypeSwatch,
+			optionImages: map[string]string{"green": "image-green"},
+			errContains:  "unknown option green",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attr := newColor()
+
+			err := attr.ChangeDisplay(tt.displayType, nil, tt.optionImages)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidAttributeData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Equal(t, DisplayTypeDropdown, attr.DisplayType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.displayType, attr.DisplayType)
+			assert.Nil(t, attr.Options[0].ImageID)
+			assert.Equal(t, ptr("image-denim"), attr.Options[1].ImageID)
+		})
+	}
+}
+
+func TestAttribute_Update_KeepsOptionImages(t *testing.T) {
+	attr, err := NewAttribute(defaultLimits, "", "Color", "color", AttributeTypeSingle, nil, true, []Option{
+		{Name: "Denim", Slug: "denim"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, attr.ChangeDisplay(DisplayTypeSwatch, nil, map[string]string{"denim": "image-denim"}))
+
+	err = attr.Update(defaultLimits, "Color", nil, true, []Option{
+		{Name: "Dark denim", Slug: "denim"},
+		{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ptr("image-denim"), attr.Options[0].ImageID)
+
+	err = attr.Update(defaultLimits, "Color", nil, true, []Option{{Name: "Green", Slug: "green"}})
+	require.ErrorIs(t, err, ErrInvalidAttributeData, "swatch options need a color code or an image")
+}
+
 func TestValidateOptions(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -425,7 +535,7 @@ func TestValidateOptions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateOptions(tt.options)
+			err := validateOptions(defaultLimits, tt.options)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -437,6 +547,22 @@ func TestValidateOptions(t *testing.T) {
 	}
 }
 
+func TestValidate_ConfiguredLimits(t *testing.T) {
+	l := limits.Default().Attribute
+	l.NameLength = 10
+	l.Options = 2
+
+	_, err := NewAttribute(l, "", "Screen size", "screen-size", AttributeTypeRange, nil, true, nil)
+	require.ErrorIs(t, err, ErrInvalidAttributeData)
+	assert.Contains(t, err.Error(), "max 10 characters")
+
+	options := []Option{{Name: "S", Slug: "s"}, {Name: "M", Slug: "m"}, {Name: "L", Slug: "l"}}
+	err = validateOptions(l, options)
+	require.ErrorIs(t, err, ErrInvalidAttributeData)
+	assert.Contains(t, err.Error(), "too many options (max 2)")
+	require.NoError(t, validateOptions(l, options[:2]))
+}
+
 func TestReconstruct(t *testing.T) {
 	t.Run("reconstructs attribute without validation", func(t *testing.T) {
 		createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -455,6 +581,9 @@ func TestReconstruct(t *testing.T) {
 			ptr("unit"),
 			true,
 			options,
+			"",
+			nil,
+			nil,
 			createdAt,
 			modifiedAt,
 		)
@@ -523,3 +652,100 @@ func TestOption(t *testing.T) {
 	assert.Equal(t, "#FF0000", *opt.ColorCode)
 	assert.Equal(t, 1, opt.SortOrder)
 }
+
+func TestAttribute_ChangeInputUnits(t *testing.T) {
+	tests := []struct {
+		name        string
+		attrType    AttributeType
+		unit        *string
+		inputUnits  []UnitConversion
+		errContains string
+	}{
+		{name: "accepted units", attrType: AttributeTypeRange, unit: ptr("cm"), inputUnits: []UnitConversion{{Unit: "mm", Factor: 0.1}, {Unit: "m", Factor: 100}}},
+		{name: "no units", attrType: AttributeTypeText, unit: nil, inputUnits: nil},
+		{name: "not a range", attrType: AttributeTypeText, unit: ptr("cm"), inputUnits: []UnitConversion{{Unit: "mm", Factor: 0.1}}, errContains: "only supported for range"},
+		{name: "no canonical unit", attrType: AttributeTypeRange, unit: nil, inputUnits: []UnitConversion{{Unit: "mm", Factor: 0.1}}, errContains: "need a canonical unit"},
+		{name: "canonical unit", attrType: AttributeTypeRange, unit: ptr("cm"), inputUnits: []UnitConversion{{Unit: "cm", Factor: 1}}, errContains: "is the canonical unit"},
+		{name: "duplicate unit", attrType: AttributeTypeRange, unit: ptr("cm"), inputUnits: []UnitConversion{{Unit: "mm", Factor: 0.1}, {Unit: "mm", Factor: 0.1}}, errContains: "duplicate input unit"},
+		{name: "zero factor", attrType: AttributeTypeRange, unit: ptr("cm"), inputUnits: []UnitConversion{{Unit: "mm"}}, errContains: "positive conversion factor"},
+		{name: "empty unit", attrType: AttributeTypeRange, unit: ptr("cm"), inputUnits: []UnitConversion{{Factor: 2}}, errContains: "input unit is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attr, err := NewAttribute(defaultLimits, "", "Length", "length", tt.attrType, tt.unit, true, nil)
+			require.NoError(t, err)
+
+			err = attr.ChangeInputUnits(defaultLimits, tt.inputUnits)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidAttributeData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Empty(t, attr.InputUnits)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.inputUnits, attr.InputUnits)
+		})
+	}
+}
+
+func TestAttribute_ToCanonical(t *testing.T) {
+	attr, err := NewAttribute(defaultLimits, "", "Weight", "weight", AttributeTypeRange, ptr("kg"), true, nil)
+	require.NoError(t, err)
+	require.NoError(t, attr.ChangeInputUnits(defaultLimits, []UnitConversion{{Unit: "g", Factor: 0.001}}))
+
+	value, ok := attr.ToCanonical(2500, "g")
+	require.True(t, ok)
+	assert.InDelta(t, 2.5, value, 1e-9)
+
+	value, ok = attr.ToCanonical(3, "kg")
+	require.True(t, ok)
+	assert.InDelta(t, 3.0, value, 1e-9)
+
+	_, ok = attr.ToCanonical(1, "lb")
+	assert.False(t, ok)
+}
+
+func TestAttribute_Update_UnitChangeDropsInputUnits(t *testing.T) {
+	attr, err := NewAttribute(defaultLimits, "", "Weight", "weight", AttributeTypeRange, ptr("kg"), true, nil)
+	require.NoError(t, err)
+	require.NoError(t, attr.ChangeInputUnits(defaultLimits, []UnitConversion{{Unit: "g", Factor: 0.001}}))
+
+	require.NoError(t, attr.Update(defaultLimits, "Weight", ptr("kg"), false, nil))
+	assert.Len(t, attr.InputUnits, 1, "same unit keeps the input units")
+
+	require.NoError(t, attr.Update(defaultLimits, "Weight", ptr("g"), false, nil))
+	assert.Empty(t, attr.InputUnits)
+}
+
+func TestValidateOptions_ColorCode(t *testing.T) {
+	for _, code := range []string{"#FF0000", "#ff0000", "#F00"} {
+		assert.NoError(t, validateOptions(defaultLimits, []Option{{Name: "Red", Slug: "red", ColorCode: ptr(code)}}), code)
+	}
+	for _, code := range []string{"red", "FF0000", "#FF00", "#GG0000", "#FF0000FF", ""} {
+		err := validateOptions(defaultLimits, []Option{{Name: "Red", Slug: "red", ColorCode: ptr(code)}})
+		require.ErrorIs(t, err, ErrInvalidAttributeData, code)
+		assert.Contains(t, err.Error(), "color code must be a hex color")
+	}
+}
+
+func TestAttribute_ChangeDisplay_Palette(t *testing.T) {
+	attr, err := NewAttribute(defaultLimits, "", "Color", "color", AttributeTypeSingle, nil, true, []Option{
+		{Name: "Red", Slug: "red", ColorCode: ptr("#ff0000")},
+		{Name: "Denim", Slug: "denim"},
+	})
+	require.NoError(t, err)
+
+	err = attr.ChangeDisplay(DisplayTypeDropdown, ptr("palette-1"), nil)
+	require.ErrorIs(t, err, ErrInvalidAttributeData, "only swatches use palettes")
+
+	require.NoError(t, attr.ChangeDisplay(DisplayTypeSwatch, ptr("palette-1"), map[string]string{"denim": "image-denim"}))
+	assert.Equal(t, ptr("palette-1"), attr.PaletteID)
+
+	assert.NoError(t, attr.CheckPaletteColors([]string{"#FF0000", "#0000FF"}), "codes compare case-insensitively")
+	require.ErrorIs(t, attr.CheckPaletteColors([]string{"#0000FF"}), ErrInvalidAttributeData)
+
+	require.NoError(t, attr.ChangeDisplay(DisplayTypeSwatch, nil, map[string]string{"denim": "image-denim"}))
+	assert.Nil(t, attr.PaletteID)
+}
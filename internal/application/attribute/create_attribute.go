@@ -7,6 +7,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/slug"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -17,17 +20,26 @@ type OptionInput struct {
 	Name      string
 	Slug      string
 	ColorCode *string
+	ImageID   *string
 	SortOrder int
 }
 
+// toOption builds a new option; deprecations are set with DeprecateOption
+func (in OptionInput) toOption() Option {
+	return Option{Name: in.Name, Slug: in.Slug, ColorCode: in.ColorCode, ImageID: in.ImageID, SortOrder: in.SortOrder}
+}
+
 type CreateAttributeCommand struct {
-	ID      *uuid.UUID
-	Name    string
+	ID   *uuid.UUID
+	Name string
+	// Slug is derived from the name when empty
 	Slug    string
 	Type    string
 	Unit    *string
 	Enabled bool
 	Options []OptionInput
+	// InputUnits are accepted besides Unit and converted to it
+	InputUnits []UnitConversion
 }
 
 type CreateAttributeCommandHandler interface {
@@ -39,6 +51,8 @@ type createAttributeHandler struct {
 	outbox       outbox.Outbox
 	txManager    mongo.TxManager
 	eventFactory AttributeEventFactory
+	quotas       quota.Enforcer
+	limits       limits.Attribute
 }
 
 func NewCreateAttributeHandler(
@@ -46,18 +60,43 @@ func NewCreateAttributeHandler(
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory AttributeEventFactory,
+	quotas quota.Enforcer,
+	l limits.Limits,
 ) CreateAttributeCommandHandler {
 	return &createAttributeHandler{
 		repo:         repo,
 		outbox:       outbox,
 		txManager:    txManager,
 		eventFactory: eventFactory,
+		quotas:       quotas,
+		limits:       l.Attribute,
 	}
 }
 
 func (h *createAttributeHandler) Handle(ctx context.Context, cmd CreateAttributeCommand) (*Attribute, error) {
+	if cmd.Slug != "" {
+		return h.create(ctx, cmd, cmd.Slug)
+	}
+
+	// An empty slug is derived from the name; losing it to a concurrent create moves on to the next free one
+	var a *Attribute
+	err := slug.WithRetry(ctx, ErrSlugAlreadyExists, func(ctx context.Context) error {
+		s, err := uniqueSlug(ctx, h.repo, h.limits, cmd.Name)
+		if err != nil {
+			return err
+		}
+		a, err = h.create(ctx, cmd, s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (h *createAttributeHandler) create(ctx context.Context, cmd CreateAttributeCommand, slug string) (*Attribute, error) {
 	options := lo.Map(cmd.Options, func(opt OptionInput, _ int) Option {
-		return Option(opt)
+		return opt.toOption()
 	})
 
 	var id string
@@ -66,9 +105,10 @@ func (h *createAttributeHandler) Handle(ctx context.Context, cmd CreateAttribute
 	}
 
 	a, err := NewAttribute(
+		h.limits,
 		id,
 		cmd.Name,
-		cmd.Slug,
+		slug,
 		AttributeType(cmd.Type),
 		cmd.Unit,
 		cmd.Enabled,
@@ -78,15 +118,18 @@ func (h *createAttributeHandler) Handle(ctx context.Context, cmd CreateAttribute
 		return nil, fmt.Errorf("failed to create attribute: %w", err)
 	}
 
-	msg := h.eventFactory.NewAttributeUpdatedOutboxMessage(ctx, a)
+	if len(cmd.InputUnits) > 0 {
+		if err := a.ChangeInputUnits(h.limits, cmd.InputUnits); err != nil {
+			return nil, fmt.Errorf("failed to create attribute: %w", err)
+		}
+	}
 
-	return h.persistAndPublish(ctx, a, msg)
+	return h.persistAndPublish(ctx, a)
 }
 
 func (h *createAttributeHandler) persistAndPublish(
 	ctx context.Context,
 	a *Attribute,
-	msg outbox.Message,
 ) (*Attribute, error) {
 	type createResult struct {
 		Attribute *Attribute
@@ -94,11 +137,14 @@ func (h *createAttributeHandler) persistAndPublish(
 	}
 
 	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*createResult, error) {
+		if err := h.quotas.Check(txCtx, quota.Attributes, 1); err != nil {
+			return nil, err
+		}
 		if err := h.repo.Insert(txCtx, a); err != nil {
 			return nil, fmt.Errorf("failed to insert attribute: %w", err)
 		}
 
-		send, err := h.outbox.Create(txCtx, msg)
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, a, DiffOptions(nil, a.Options)))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox: %w", err)
 		}
@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
@@ -39,7 +41,7 @@ func setupCreateAttributeHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockAttributeEventFactory(t)
 
-	handler := NewCreateAttributeHandler(repo, outboxMock, txManager, eventFactory)
+	handler := NewCreateAttributeHandler(repo, outboxMock, txManager, eventFactory, quota.Unlimited(), limits.Default())
 
 	return repo, outboxMock, txManager, eventFactory, handler
 }
@@ -62,7 +64,7 @@ func TestCreateAttributeHandler_Handle_Success(t *testing.T) {
 
 	// Mock event factory
 	eventFactory.EXPECT().
-		NewAttributeUpdatedOutboxMessage(mock.Anything, mock.AnythingOfType("*attribute.Attribute")).
+		NewAttributeUpdatedOutboxMessage(mock.Anything, mock.AnythingOfType("*attribute.Attribute"), mock.Anything).
 		Return(outbox.Message{})
 
 	// Mock transaction
@@ -108,7 +110,7 @@ func TestCreateAttributeHandler_Handle_WithCustomID(t *testing.T) {
 		Enabled: false,
 	}
 
-	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -124,6 +126,65 @@ func TestCreateAttributeHandler_Handle_WithCustomID(t *testing.T) {
 	assert.Equal(t, customID.String(), result.ID)
 }
 
+func TestCreateAttributeHandler_Handle_DerivesSlug(t *testing.T) {
+	repo, outboxMock, txManager, eventFactory, handler := setupCreateAttributeHandler(t)
+
+	ctx := testCtx()
+	cmd := CreateAttributeCommand{Name: "Розмір екрана", Type: "range"}
+	candidates := []string{"rozmir-ekrana", "rozmir-ekrana-2", "rozmir-ekrana-3"}
+
+	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+	// The first check finds the slug free, but a concurrent create takes it before the insert
+	repo.EXPECT().FindBySlugs(mock.Anything, mock.MatchedBy(func(slugs []string) bool { return slugs[0] == candidates[0] })).
+		Return(nil, nil).Once()
+	repo.EXPECT().Insert(mock.Anything, mock.MatchedBy(func(a *Attribute) bool { return a.Slug == candidates[0] })).
+		Return(ErrSlugAlreadyExists).Once()
+	repo.EXPECT().FindBySlugs(mock.Anything, mock.Anything).
+		Return([]*Attribute{{Slug: candidates[0]}, {Slug: candidates[2]}}, nil).Once()
+	repo.EXPECT().Insert(mock.Anything, mock.MatchedBy(func(a *Attribute) bool { return a.Slug == candidates[1] })).
+		Return(nil).Once()
+	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil)
+
+	result, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, candidates[1], result.Slug)
+}
+
+func TestCreateAttributeHandler_Handle_GivenSlugTaken(t *testing.T) {
+	repo, _, txManager, _, handler := setupCreateAttributeHandler(t)
+
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(ErrSlugAlreadyExists).Once()
+
+	_, err := handler.Handle(testCtx(), CreateAttributeCommand{Name: "Color", Slug: "color", Type: "single"})
+
+	require.ErrorIs(t, err, ErrSlugAlreadyExists, "a given slug is not replaced")
+}
+
+func TestGenerateSlugHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGenerateSlugHandler(repo, limits.Default())
+
+	repo.EXPECT().FindBySlugs(mock.Anything, mock.Anything).Return([]*Attribute{{Slug: "color"}}, nil)
+
+	slug, err := handler.Handle(testCtx(), GenerateSlugQuery{Name: "Color"})
+	require.NoError(t, err)
+	assert.Equal(t, "color-2", slug)
+
+	_, err = handler.Handle(testCtx(), GenerateSlugQuery{Name: "!!!"})
+	require.ErrorIs(t, err, ErrInvalidAttributeData)
+}
+
 func TestCreateAttributeHandler_Handle_InvalidName(t *testing.T) {
 	_, _, _, _, handler := setupCreateAttributeHandler(t)
 
@@ -179,7 +240,7 @@ func TestCreateAttributeHandler_Handle_InvalidType(t *testing.T) {
 }
 
 func TestCreateAttributeHandler_Handle_InsertError(t *testing.T) {
-	repo, _, txManager, eventFactory, handler := setupCreateAttributeHandler(t)
+	repo, _, txManager, _, handler := setupCreateAttributeHandler(t)
 
 	ctx := testCtx()
 	cmd := CreateAttributeCommand{
@@ -189,7 +250,6 @@ func TestCreateAttributeHandler_Handle_InsertError(t *testing.T) {
 		Enabled: false,
 	}
 
-	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -215,7 +275,7 @@ func TestCreateAttributeHandler_Handle_OutboxError(t *testing.T) {
 		Enabled: false,
 	}
 
-	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -255,7 +315,7 @@ func TestCreateAttributeHandler_Handle_AllAttributeTypes(t *testing.T) {
 				Enabled: false,
 			}
 
-			eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+			eventFactory.EXPECT().NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
 			txManager.EXPECT().
 				WithTransaction(mock.Anything, mock.Anything).
 				RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -0,0 +1,107 @@
+package attribute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+// DeprecateOptionCommand deprecates an option of an attribute, or ends its deprecation when Deprecated is false.
+// ReplacementSlug names the option products should move to; remapping them is a separate product command.
+type DeprecateOptionCommand struct {
+	ID              string
+	Version         int
+	OptionSlug      string
+	Deprecated      bool
+	ReplacementSlug *string
+}
+
+type DeprecateOptionCommandHandler interface {
+	Handle(ctx context.Context, cmd DeprecateOptionCommand) (*Attribute, error)
+}
+
+type deprecateOptionHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory AttributeEventFactory
+}
+
+func NewDeprecateOptionHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory AttributeEventFactory,
+) DeprecateOptionCommandHandler {
+	return &deprecateOptionHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *deprecateOptionHandler) Handle(ctx context.Context, cmd DeprecateOptionCommand) (*Attribute, error) {
+	a, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	if a.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := a.DeprecateOption(cmd.OptionSlug, cmd.Deprecated, cmd.ReplacementSlug); err != nil {
+		return nil, fmt.Errorf("failed to deprecate option: %w", err)
+	}
+
+	type updateResult struct {
+		Attribute *Attribute
+		Send      outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*updateResult, error) {
+		updated, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, mongo.ErrOptimisticLocking
+			}
+			return nil, fmt.Errorf("failed to update attribute: %w", err)
+		}
+
+		// A deprecated option stays an option, so product facets stay valid
+		msg := h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, updated, OptionsDelta{})
+
+		send, err := h.outbox.Create(txCtx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return &updateResult{
+			Attribute: updated,
+			Send:      send,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("attribute option deprecation changed", zap.String("id", res.Attribute.ID),
+		zap.String("option", cmd.OptionSlug), zap.Bool("deprecated", cmd.Deprecated))
+
+	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return res.Attribute, nil
+}
+
+func (h *deprecateOptionHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "deprecate-option-handler"))
+}
@@ -0,0 +1,58 @@
+package attribute
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DisplayType tells frontends which control renders the attribute in filters
+type DisplayType string
+
+const (
+	DisplayTypeSwatch   DisplayType = "swatch"
+	DisplayTypeDropdown DisplayType = "dropdown"
+	DisplayTypeCheckbox DisplayType = "checkbox"
+	DisplayTypeSlider   DisplayType = "slider"
+	DisplayTypeText     DisplayType = "text"
+)
+
+// displayTypesByAttributeType lists the display types each attribute type can be rendered with,
+// the first one is the default
+var displayTypesByAttributeType = map[AttributeType][]DisplayType{
+	AttributeTypeSingle:   {DisplayTypeDropdown, DisplayTypeSwatch},
+	AttributeTypeMultiple: {DisplayTypeCheckbox, DisplayTypeSwatch, DisplayTypeDropdown},
+	AttributeTypeRange:    {DisplayTypeSlider},
+	AttributeTypeBoolean:  {DisplayTypeCheckbox},
+	AttributeTypeText:     {DisplayTypeText},
+}
+
+// DefaultDisplayType returns the display type used for attributes of the given type unless configured otherwise
+func DefaultDisplayType(t AttributeType) DisplayType {
+	if types, ok := displayTypesByAttributeType[t]; ok {
+		return types[0]
+	}
+	return DisplayTypeText
+}
+
+// validateDisplay checks the display type against the attribute type and the options against the display type
+func validateDisplay(attrType AttributeType, displayType DisplayType, options []Option) error {
+	if !slices.Contains(displayTypesByAttributeType[attrType], displayType) {
+		return fmt.Errorf("%w: display type %q is not supported for %s attributes", ErrInvalidAttributeData, displayType, attrType)
+	}
+
+	for _, opt := range options {
+		if opt.ImageID != nil {
+			if displayType != DisplayTypeSwatch {
+				return fmt.Errorf("%w: option images are only supported for swatches", ErrInvalidAttributeData)
+			}
+			if *opt.ImageID == "" {
+				return fmt.Errorf("%w: option %s image must not be empty", ErrInvalidAttributeData, opt.Slug)
+			}
+		}
+		if displayType == DisplayTypeSwatch && opt.ColorCode == nil && opt.ImageID == nil {
+			return fmt.Errorf("%w: swatch option %s needs a color code or an image", ErrInvalidAttributeData, opt.Slug)
+		}
+	}
+
+	return nil
+}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrSlugAlreadyExists    = errors.New("attribute with this slug already exists")
 	ErrInvalidAttributeData = errors.New("invalid attribute data")
+	ErrImageNotFound        = errors.New("image not found")
+	ErrPaletteNotFound      = errors.New("palette not found")
 )
@@ -8,5 +8,7 @@ import (
 
 // AttributeEventFactory defines the port for creating attribute event outbox messages.
 type AttributeEventFactory interface {
-	NewAttributeUpdatedOutboxMessage(ctx context.Context, a *Attribute) outbox.Message
+	// NewAttributeUpdatedOutboxMessage builds the snapshot event for a; delta lists the option
+	// changes of this write so consumers can invalidate only the affected facets
+	NewAttributeUpdatedOutboxMessage(ctx context.Context, a *Attribute, delta OptionsDelta) outbox.Message
 }
@@ -3,6 +3,7 @@ package attribute
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type GetAttributeListQuery struct {
@@ -12,6 +13,8 @@ type GetAttributeListQuery struct {
 	Type    *string
 	Sort    string
 	Order   string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
 }
 
 type ListAttributesResult struct {
@@ -34,7 +37,15 @@ func NewGetAttributeListHandler(repo Repository) GetAttributeListQueryHandler {
 }
 
 func (h *getAttributeListHandler) Handle(ctx context.Context, query GetAttributeListQuery) (*ListAttributesResult, error) {
-	listQuery := ListQuery(query)
+	listQuery := ListQuery{
+		Page:          query.Page,
+		Size:          query.Size,
+		Enabled:       query.Enabled,
+		ModifiedAfter: query.ModifiedAfter,
+		Type:          query.Type,
+		Sort:          query.Sort,
+		Order:         query.Order,
+	}
 
 	result, err := h.repo.FindList(ctx, listQuery)
 	if err != nil {
@@ -0,0 +1,9 @@
+package attribute
+
+import "context"
+
+// ImageChecker verifies option swatch images against the media service
+type ImageChecker interface {
+	// MissingImages returns the IDs from imageIDs that the media service doesn't know
+	MissingImages(ctx context.Context, imageIDs []string) ([]string, error)
+}
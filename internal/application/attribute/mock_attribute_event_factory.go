@@ -39,16 +39,16 @@ func (_m *MockAttributeEventFactory) EXPECT() *MockAttributeEventFactory_Expecte
 }
 
 // NewAttributeUpdatedOutboxMessage provides a mock function for the type MockAttributeEventFactory
-func (_mock *MockAttributeEventFactory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *Attribute) outbox.Message {
-	ret := _mock.Called(ctx, a)
+func (_mock *MockAttributeEventFactory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *Attribute, delta OptionsDelta) outbox.Message {
+	ret := _mock.Called(ctx, a, delta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewAttributeUpdatedOutboxMessage")
 	}
 
 	var r0 outbox.Message
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *Attribute) outbox.Message); ok {
-		r0 = returnFunc(ctx, a)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Attribute, OptionsDelta) outbox.Message); ok {
+		r0 = returnFunc(ctx, a, delta)
 	} else {
 		r0 = ret.Get(0).(outbox.Message)
 	}
@@ -63,11 +63,12 @@ type MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call struct {
 // NewAttributeUpdatedOutboxMessage is a helper method to define mock.On call
 //   - ctx context.Context
 //   - a *attribute.Attribute
-func (_e *MockAttributeEventFactory_Expecter) NewAttributeUpdatedOutboxMessage(ctx interface{}, a interface{}) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
-	return &MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call{Call: _e.mock.On("NewAttributeUpdatedOutboxMessage", ctx, a)}
+//   - delta attribute.OptionsDelta
+func (_e *MockAttributeEventFactory_Expecter) NewAttributeUpdatedOutboxMessage(ctx interface{}, a interface{}, delta interface{}) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
+	return &MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call{Call: _e.mock.On("NewAttributeUpdatedOutboxMessage", ctx, a, delta)}
 }
 
-func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) Run(run func(ctx context.Context, a *Attribute)) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
+func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) Run(run func(ctx context.Context, a *Attribute, delta OptionsDelta)) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -77,9 +78,14 @@ func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) Run(r
 		if args[1] != nil {
 			arg1 = args[1].(*Attribute)
 		}
+		var arg2 OptionsDelta
+		if args[2] != nil {
+			arg2 = args[2].(OptionsDelta)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -90,7 +96,7 @@ func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) Retur
 	return _c
 }
 
-func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, a *Attribute) outbox.Message) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
+func (_c *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, a *Attribute, delta OptionsDelta) outbox.Message) *MockAttributeEventFactory_NewAttributeUpdatedOutboxMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
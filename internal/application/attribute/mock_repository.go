@@ -308,6 +308,142 @@ func (_c *MockRepository_FindByIDsOrFail_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// FindByPaletteID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByPaletteID(ctx context.Context, paletteID string) ([]*Attribute, error) {
+	ret := _mock.Called(ctx, paletteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPaletteID")
+	}
+
+	var r0 []*Attribute
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*Attribute, error)); ok {
+		return returnFunc(ctx, paletteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*Attribute); ok {
+		r0 = returnFunc(ctx, paletteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Attribute)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, paletteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByPaletteID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPaletteID'
+type MockRepository_FindByPaletteID_Call struct {
+	*mock.Call
+}
+
+// FindByPaletteID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paletteID string
+func (_e *MockRepository_Expecter) FindByPaletteID(ctx interface{}, paletteID interface{}) *MockRepository_FindByPaletteID_Call {
+	return &MockRepository_FindByPaletteID_Call{Call: _e.mock.On("FindByPaletteID", ctx, paletteID)}
+}
+
+func (_c *MockRepository_FindByPaletteID_Call) Run(run func(ctx context.Context, paletteID string)) *MockRepository_FindByPaletteID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByPaletteID_Call) Return(attributes []*Attribute, err error) *MockRepository_FindByPaletteID_Call {
+	_c.Call.Return(attributes, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByPaletteID_Call) RunAndReturn(run func(ctx context.Context, paletteID string) ([]*Attribute, error)) *MockRepository_FindByPaletteID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlugs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBySlugs(ctx context.Context, slugs []string) ([]*Attribute, error) {
+	ret := _mock.Called(ctx, slugs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlugs")
+	}
+
+	var r0 []*Attribute
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*Attribute, error)); ok {
+		return returnFunc(ctx, slugs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*Attribute); ok {
+		r0 = returnFunc(ctx, slugs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Attribute)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, slugs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBySlugs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlugs'
+type MockRepository_FindBySlugs_Call struct {
+	*mock.Call
+}
+
+// FindBySlugs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slugs []string
+func (_e *MockRepository_Expecter) FindBySlugs(ctx interface{}, slugs interface{}) *MockRepository_FindBySlugs_Call {
+	return &MockRepository_FindBySlugs_Call{Call: _e.mock.On("FindBySlugs", ctx, slugs)}
+}
+
+func (_c *MockRepository_FindBySlugs_Call) Run(run func(ctx context.Context, slugs []string)) *MockRepository_FindBySlugs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBySlugs_Call) Return(attributes []*Attribute, err error) *MockRepository_FindBySlugs_Call {
+	_c.Call.Return(attributes, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBySlugs_Call) RunAndReturn(run func(ctx context.Context, slugs []string) ([]*Attribute, error)) *MockRepository_FindBySlugs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindList provides a mock function for the type MockRepository
 func (_mock *MockRepository) FindList(ctx context.Context, query ListQuery) (*mongo.PageResult[Attribute], error) {
 	ret := _mock.Called(ctx, query)
@@ -0,0 +1,87 @@
+package attribute
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// DeprecateOption marks the option as deprecated, with the option its products should move to, or
+// ends its deprecation. The replacement must be another option that isn't deprecated, and an option
+// replacing others can't be deprecated itself, so remapping never lands on a deprecated option.
+func (a *Attribute) DeprecateOption(slug string, deprecated bool, replacementSlug *string) error {
+	i := slices.IndexFunc(a.Options, func(opt Option) bool { return opt.Slug == slug })
+	if i < 0 {
+		return fmt.Errorf("%w: unknown option %s", ErrInvalidAttributeData, slug)
+	}
+	if !deprecated && replacementSlug != nil {
+		return fmt.Errorf("%w: only deprecated options have a replacement", ErrInvalidAttributeData)
+	}
+
+	options := slices.Clone(a.Options)
+	options[i].Deprecated = deprecated
+	options[i].ReplacementSlug = replacementSlug
+	if err := validateReplacements(options); err != nil {
+		return err
+	}
+
+	a.Options = options
+	a.ModifiedAt = utc.NowAfter(a.ModifiedAt)
+	return nil
+}
+
+// FindOption returns the option with the given slug
+func (a *Attribute) FindOption(slug string) (Option, bool) {
+	i := slices.IndexFunc(a.Options, func(opt Option) bool { return opt.Slug == slug })
+	if i < 0 {
+		return Option{}, false
+	}
+	return a.Options[i], true
+}
+
+// keepOptionDeprecations copies the deprecation of previous options to updated options with the same slug
+func keepOptionDeprecations(previous, updated []Option) []Option {
+	deprecated := make(map[string]Option, len(previous))
+	for _, opt := range previous {
+		if opt.Deprecated {
+			deprecated[opt.Slug] = opt
+		}
+	}
+	if len(deprecated) == 0 {
+		return updated
+	}
+
+	result := make([]Option, len(updated))
+	for i, opt := range updated {
+		if old, ok := deprecated[opt.Slug]; ok {
+			opt.Deprecated, opt.ReplacementSlug = true, old.ReplacementSlug
+		}
+		result[i] = opt
+	}
+	return result
+}
+
+// validateReplacements checks that every replacement is another option that isn't deprecated
+func validateReplacements(options []Option) error {
+	bySlug := make(map[string]Option, len(options))
+	for _, opt := range options {
+		bySlug[opt.Slug] = opt
+	}
+
+	for _, opt := range options {
+		if opt.ReplacementSlug == nil {
+			continue
+		}
+		replacement, ok := bySlug[*opt.ReplacementSlug]
+		switch {
+		case *opt.ReplacementSlug == opt.Slug:
+			return fmt.Errorf("%w: option %s can't replace itself", ErrInvalidAttributeData, opt.Slug)
+		case !ok:
+			return fmt.Errorf("%w: replacement %s of option %s is not an option", ErrInvalidAttributeData, *opt.ReplacementSlug, opt.Slug)
+		case replacement.Deprecated:
+			return fmt.Errorf("%w: replacement %s of option %s is deprecated", ErrInvalidAttributeData, *opt.ReplacementSlug, opt.Slug)
+		}
+	}
+	return nil
+}
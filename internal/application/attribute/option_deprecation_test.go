@@ -0,0 +1,79 @@
+package attribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSizeAttribute(t *testing.T) *Attribute {
+	t.Helper()
+	attr, err := NewAttribute(defaultLimits, "", "Size", "size", AttributeTypeSingle, nil, true, []Option{
+		{Name: "XL", Slug: "xl"},
+		{Name: "Extra large", Slug: "extra-large"},
+		{Name: "L", Slug: "l"},
+	})
+	require.NoError(t, err)
+	return attr
+}
+
+func TestAttribute_DeprecateOption(t *testing.T) {
+	attr := newSizeAttribute(t)
+
+	require.NoError(t, attr.DeprecateOption("extra-large", true, ptr("xl")))
+	opt, ok := attr.FindOption("extra-large")
+	require.True(t, ok)
+	assert.True(t, opt.Deprecated)
+	assert.Equal(t, ptr("xl"), opt.ReplacementSlug)
+
+	require.NoError(t, attr.DeprecateOption("extra-large", false, nil))
+	opt, _ = attr.FindOption("extra-large")
+	assert.False(t, opt.Deprecated)
+	assert.Nil(t, opt.ReplacementSlug)
+}
+
+func TestAttribute_DeprecateOption_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		slug        string
+		deprecated  bool
+		replacement *string
+	}{
+		{name: "unknown option", slug: "xxl", deprecated: true},
+		{name: "replaces itself", slug: "xl", deprecated: true, replacement: ptr("xl")},
+		{name: "unknown replacement", slug: "xl", deprecated: true, replacement: ptr("xxl")},
+		{name: "deprecated replacement", slug: "extra-large", deprecated: true, replacement: ptr("l")},
+		{name: "replacement without deprecation", slug: "extra-large", replacement: ptr("xl")},
+		{name: "option replacing another", slug: "xl", deprecated: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attr := newSizeAttribute(t)
+			require.NoError(t, attr.DeprecateOption("l", true, nil))
+			require.NoError(t, attr.DeprecateOption("extra-large", true, ptr("xl")))
+			before := attr.Options
+
+			err := attr.DeprecateOption(tt.slug, tt.deprecated, tt.replacement)
+			require.ErrorIs(t, err, ErrInvalidAttributeData)
+			assert.Equal(t, before, attr.Options)
+		})
+	}
+}
+
+func TestAttribute_Update_KeepsOptionDeprecations(t *testing.T) {
+	attr := newSizeAttribute(t)
+	require.NoError(t, attr.DeprecateOption("extra-large", true, ptr("xl")))
+
+	err := attr.Update(defaultLimits, "Size", nil, true, []Option{
+		{Name: "XL", Slug: "xl"},
+		{Name: "Extra large (old)", Slug: "extra-large"},
+	})
+	require.NoError(t, err)
+	opt, _ := attr.FindOption("extra-large")
+	assert.True(t, opt.Deprecated)
+	assert.Equal(t, ptr("xl"), opt.ReplacementSlug)
+
+	err = attr.Update(defaultLimits, "Size", nil, true, []Option{{Name: "Extra large", Slug: "extra-large"}})
+	require.ErrorIs(t, err, ErrInvalidAttributeData, "the replacement can't be removed")
+}
@@ -0,0 +1,52 @@
+package attribute
+
+// OptionRename describes an option whose display name changed; the slug identifies the option
+type OptionRename struct {
+	Slug    string
+	OldName string
+	NewName string
+}
+
+// OptionsDelta describes how the options of an attribute changed in a single write
+type OptionsDelta struct {
+	Added   []Option
+	Removed []Option
+	Renamed []OptionRename
+}
+
+// IsEmpty reports whether no option was added, removed or renamed
+func (d OptionsDelta) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0
+}
+
+// DiffOptions compares two option lists by slug.
+// Only changes visible to product facets are reported: color code and sort order changes are not.
+func DiffOptions(before, after []Option) OptionsDelta {
+	var delta OptionsDelta
+
+	previous := make(map[string]Option, len(before))
+	for _, opt := range before {
+		previous[opt.Slug] = opt
+	}
+
+	current := make(map[string]struct{}, len(after))
+	for _, opt := range after {
+		current[opt.Slug] = struct{}{}
+
+		old, ok := previous[opt.Slug]
+		switch {
+		case !ok:
+			delta.Added = append(delta.Added, opt)
+		case old.Name != opt.Name:
+			delta.Renamed = append(delta.Renamed, OptionRename{Slug: opt.Slug, OldName: old.Name, NewName: opt.Name})
+		}
+	}
+
+	for _, opt := range before {
+		if _, ok := current[opt.Slug]; !ok {
+			delta.Removed = append(delta.Removed, opt)
+		}
+	}
+
+	return delta
+}
@@ -0,0 +1,42 @@
+package attribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffOptions(t *testing.T) {
+	before := []Option{
+		{Name: "Red", Slug: "red", SortOrder: 1},
+		{Name: "Blue", Slug: "blue", SortOrder: 2},
+		{Name: "Green", Slug: "green", SortOrder: 3},
+	}
+	after := []Option{
+		{Name: "Crimson", Slug: "red", SortOrder: 1},
+		{Name: "Blue", Slug: "blue", SortOrder: 5},
+		{Name: "Black", Slug: "black", SortOrder: 3},
+	}
+
+	delta := DiffOptions(before, after)
+
+	assert.Equal(t, []Option{{Name: "Black", Slug: "black", SortOrder: 3}}, delta.Added)
+	assert.Equal(t, []Option{{Name: "Green", Slug: "green", SortOrder: 3}}, delta.Removed)
+	assert.Equal(t, []OptionRename{{Slug: "red", OldName: "Red", NewName: "Crimson"}}, delta.Renamed)
+	assert.False(t, delta.IsEmpty())
+}
+
+func TestDiffOptions_NoChanges(t *testing.T) {
+	options := []Option{{Name: "Red", Slug: "red"}}
+
+	assert.True(t, DiffOptions(options, options).IsEmpty())
+	assert.True(t, DiffOptions(nil, nil).IsEmpty())
+}
+
+func TestDiffOptions_Created(t *testing.T) {
+	delta := DiffOptions(nil, []Option{{Name: "Red", Slug: "red"}})
+
+	assert.Len(t, delta.Added, 1)
+	assert.Empty(t, delta.Removed)
+	assert.Empty(t, delta.Renamed)
+}
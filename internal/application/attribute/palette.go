@@ -0,0 +1,50 @@
+package attribute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var colorCodeRegex = regexp.MustCompile(`^#(?:[0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// IsColorCode reports whether code is a hex color in the #RGB or #RRGGBB form
+func IsColorCode(code string) bool {
+	return colorCodeRegex.MatchString(code)
+}
+
+// PaletteColors resolves the palettes swatch attributes are bound to
+type PaletteColors interface {
+	// ColorCodes returns the color codes of a palette or ErrPaletteNotFound
+	ColorCodes(ctx context.Context, paletteID string) ([]string, error)
+}
+
+// CheckPaletteColors verifies that every option color code is one of the palette colors.
+// Codes are compared case-insensitively; options showing only an image are not checked.
+func (a *Attribute) CheckPaletteColors(paletteCodes []string) error {
+	allowed := make(map[string]bool, len(paletteCodes))
+	for _, code := range paletteCodes {
+		allowed[strings.ToUpper(code)] = true
+	}
+
+	for _, opt := range a.Options {
+		if opt.ColorCode != nil && !allowed[strings.ToUpper(*opt.ColorCode)] {
+			return fmt.Errorf("%w: option %s color %s is not in the palette", ErrInvalidAttributeData, opt.Slug, *opt.ColorCode)
+		}
+	}
+	return nil
+}
+
+// checkPalette checks the option colors of a palette-bound attribute against its palette
+func checkPalette(ctx context.Context, paletteColors PaletteColors, a *Attribute) error {
+	if a.PaletteID == nil {
+		return nil
+	}
+
+	codes, err := paletteColors.ColorCodes(ctx, *a.PaletteID)
+	if err != nil {
+		return err
+	}
+	return a.CheckPaletteColors(codes)
+}
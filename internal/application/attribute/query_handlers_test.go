@@ -27,6 +27,9 @@ func createTestAttributeWithParams(id, name, slug string, attrType AttributeType
 			{Name: "Option 1", Slug: "option-1"},
 			{Name: "Option 2", Slug: "option-2"},
 		},
+		"",
+		nil,
+		nil,
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -2,6 +2,7 @@ package attribute
 
 import (
 	"context"
+	"time"
 
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
@@ -11,8 +12,12 @@ type ListQuery struct {
 	Size    int
 	Enabled *bool
 	Type    *string
+	// AfterID restricts the list to IDs greater than the given one, for keyset pagination sorted by "_id"
+	AfterID string
 	Sort    string
 	Order   string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
 }
 
 type Repository interface {
@@ -25,6 +30,12 @@ type Repository interface {
 	// FindByIDsOrFail returns attributes by IDs or error if any ID is not found
 	FindByIDsOrFail(ctx context.Context, ids []string) ([]*Attribute, error)
 
+	// FindBySlugs returns the attributes with the given slugs that exist, in no particular order
+	FindBySlugs(ctx context.Context, slugs []string) ([]*Attribute, error)
+
+	// FindByPaletteID returns the swatch attributes bound to a palette
+	FindByPaletteID(ctx context.Context, paletteID string) ([]*Attribute, error)
+
 	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[Attribute], error)
 
 	Update(ctx context.Context, attribute *Attribute) (*Attribute, error)
@@ -0,0 +1,148 @@
+package attribute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+// SetAttributeDisplayCommand sets how frontends render an attribute in filters.
+// OptionImages maps option slugs to swatch images and replaces all existing option images.
+// PaletteID binds a swatch to a palette, its option colors must then be palette colors.
+type SetAttributeDisplayCommand struct {
+	ID           string
+	Version      int
+	DisplayType  string
+	PaletteID    *string
+	OptionImages map[string]string
+}
+
+type SetAttributeDisplayCommandHandler interface {
+	Handle(ctx context.Context, cmd SetAttributeDisplayCommand) (*Attribute, error)
+}
+
+type setAttributeDisplayHandler struct {
+	repo          Repository
+	imageChecker  ImageChecker
+	paletteColors PaletteColors
+	outbox        outbox.Outbox
+	txManager     mongo.TxManager
+	eventFactory  AttributeEventFactory
+}
+
+func NewSetAttributeDisplayHandler(
+	repo Repository,
+	imageChecker ImageChecker,
+	paletteColors PaletteColors,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory AttributeEventFactory,
+) SetAttributeDisplayCommandHandler {
+	return &setAttributeDisplayHandler{
+		repo:          repo,
+		imageChecker:  imageChecker,
+		paletteColors: paletteColors,
+		outbox:        outbox,
+		txManager:     txManager,
+		eventFactory:  eventFactory,
+	}
+}
+
+func (h *setAttributeDisplayHandler) Handle(ctx context.Context, cmd SetAttributeDisplayCommand) (*Attribute, error) {
+	a, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	if a.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := a.ChangeDisplay(DisplayType(cmd.DisplayType), cmd.PaletteID, cmd.OptionImages); err != nil {
+		return nil, fmt.Errorf("failed to change attribute display: %w", err)
+	}
+
+	if err := checkPalette(ctx, h.paletteColors, a); err != nil {
+		return nil, err
+	}
+
+	if err := h.checkImages(ctx, cmd.OptionImages); err != nil {
+		return nil, err
+	}
+
+	return h.persistAndPublish(ctx, a)
+}
+
+func (h *setAttributeDisplayHandler) checkImages(ctx context.Context, optionImages map[string]string) error {
+	if len(optionImages) == 0 {
+		return nil
+	}
+
+	imageIDs := make([]string, 0, len(optionImages))
+	for _, imageID := range optionImages {
+		imageIDs = append(imageIDs, imageID)
+	}
+	slices.Sort(imageIDs)
+
+	missing, err := h.imageChecker.MissingImages(ctx, slices.Compact(imageIDs))
+	if err != nil {
+		return fmt.Errorf("failed to check images: %w", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrImageNotFound, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (h *setAttributeDisplayHandler) persistAndPublish(ctx context.Context, a *Attribute) (*Attribute, error) {
+	type updateResult struct {
+		Attribute *Attribute
+		Send      outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*updateResult, error) {
+		updated, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, mongo.ErrOptimisticLocking
+			}
+			return nil, fmt.Errorf("failed to update attribute: %w", err)
+		}
+
+		// Display changes don't add, remove or rename options, so product facets stay valid
+		msg := h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, updated, OptionsDelta{})
+
+		send, err := h.outbox.Create(txCtx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return &updateResult{
+			Attribute: updated,
+			Send:      send,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("attribute display updated", zap.String("id", res.Attribute.ID))
+
+	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return res.Attribute, nil
+}
+
+func (h *setAttributeDisplayHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "set-attribute-display-handler"))
+}
@@ -0,0 +1,191 @@
+package attribute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// setupSetAttributeDisplayHandler creates handler with mocked dependencies
+func setupSetAttributeDisplayHandler(t *testing.T) (
+	*MockRepository,
+	*MockImageChecker,
+	*mocks.MockOutbox,
+	*mocks.MockTxManager,
+	*MockAttributeEventFactory,
+	SetAttributeDisplayCommandHandler,
+) {
+	repo := NewMockRepository(t)
+	imageChecker := NewMockImageChecker(t)
+	outboxMock := mocks.NewMockOutbox(t)
+	txManager := mocks.NewMockTxManager(t)
+	eventFactory := NewMockAttributeEventFactory(t)
+
+	handler := NewSetAttributeDisplayHandler(repo, imageChecker, NewMockPaletteColors(t), outboxMock, txManager, eventFactory)
+
+	return repo, imageChecker, outboxMock, txManager, eventFactory, handler
+}
+
+func TestSetAttributeDisplayHandler_Handle_Success(t *testing.T) {
+	repo, imageChecker, outboxMock, txManager, eventFactory, handler := setupSetAttributeDisplayHandler(t)
+
+	existingAttr := createTestAttribute()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	imageChecker.EXPECT().
+		MissingImages(mock.Anything, []string{"image-1"}).
+		Return(nil, nil)
+
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+
+	repo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*attribute.Attribute")).
+		RunAndReturn(func(_ context.Context, a *Attribute) (*Attribute, error) {
+			return a, nil
+		})
+
+	eventFactory.EXPECT().
+		NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, OptionsDelta{}).
+		Return(outbox.Message{})
+
+	outboxMock.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(mockSendFunc, nil)
+
+	result, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:           existingAttr.ID,
+		Version:      existingAttr.Version,
+		DisplayType:  string(DisplayTypeSwatch),
+		OptionImages: map[string]string{"option-1": "image-1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, DisplayTypeSwatch, result.DisplayType)
+	assert.Equal(t, ptr("image-1"), result.Options[0].ImageID)
+}
+
+func TestSetAttributeDisplayHandler_Handle_InvalidDisplay(t *testing.T) {
+	repo, _, _, _, _, handler := setupSetAttributeDisplayHandler(t)
+
+	existingAttr := createTestAttribute()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	_, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:          existingAttr.ID,
+		Version:     existingAttr.Version,
+		DisplayType: string(DisplayTypeSlider),
+	})
+
+	require.ErrorIs(t, err, ErrInvalidAttributeData)
+}
+
+func TestSetAttributeDisplayHandler_Handle_StaleVersion(t *testing.T) {
+	repo, _, _, _, _, handler := setupSetAttributeDisplayHandler(t)
+
+	existingAttr := createTestAttribute()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	_, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:          existingAttr.ID,
+		Version:     existingAttr.Version + 1,
+		DisplayType: string(DisplayTypeDropdown),
+	})
+
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+}
+
+func TestSetAttributeDisplayHandler_Handle_ImageNotFound(t *testing.T) {
+	repo, imageChecker, _, _, _, handler := setupSetAttributeDisplayHandler(t)
+
+	existingAttr := createTestAttribute()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	imageChecker.EXPECT().
+		MissingImages(mock.Anything, []string{"image-1"}).
+		Return([]string{"image-1"}, nil)
+
+	_, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:           existingAttr.ID,
+		Version:      existingAttr.Version,
+		DisplayType:  string(DisplayTypeSwatch),
+		OptionImages: map[string]string{"option-1": "image-1"},
+	})
+
+	require.ErrorIs(t, err, ErrImageNotFound)
+}
+
+func TestSetAttributeDisplayHandler_Handle_ColorNotInPalette(t *testing.T) {
+	repo := NewMockRepository(t)
+	paletteColors := NewMockPaletteColors(t)
+	handler := NewSetAttributeDisplayHandler(repo, NewMockImageChecker(t), paletteColors, mocks.NewMockOutbox(t), mocks.NewMockTxManager(t), NewMockAttributeEventFactory(t))
+
+	existingAttr := createTestAttribute()
+	existingAttr.Options[0].ColorCode = ptr("#FF0000")
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	paletteColors.EXPECT().
+		ColorCodes(mock.Anything, "palette-1").
+		Return([]string{"#0000FF"}, nil)
+
+	_, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:          existingAttr.ID,
+		Version:     existingAttr.Version,
+		DisplayType: string(DisplayTypeSwatch),
+		PaletteID:   ptr("palette-1"),
+	})
+
+	require.ErrorIs(t, err, ErrInvalidAttributeData)
+	assert.Contains(t, err.Error(), "option-1 color #FF0000 is not in the palette")
+}
+
+func TestSetAttributeDisplayHandler_Handle_PaletteNotFound(t *testing.T) {
+	repo := NewMockRepository(t)
+	paletteColors := NewMockPaletteColors(t)
+	handler := NewSetAttributeDisplayHandler(repo, NewMockImageChecker(t), paletteColors, mocks.NewMockOutbox(t), mocks.NewMockTxManager(t), NewMockAttributeEventFactory(t))
+
+	existingAttr := createTestAttribute()
+	existingAttr.Options[0].ColorCode = ptr("#FF0000")
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingAttr.ID).
+		Return(existingAttr, nil)
+
+	paletteColors.EXPECT().
+		ColorCodes(mock.Anything, "missing").
+		Return(nil, ErrPaletteNotFound)
+
+	_, err := handler.Handle(testCtx(), SetAttributeDisplayCommand{
+		ID:          existingAttr.ID,
+		Version:     existingAttr.Version,
+		DisplayType: string(DisplayTypeSwatch),
+		PaletteID:   ptr("missing"),
+	})
+
+	require.ErrorIs(t, err, ErrPaletteNotFound)
+}
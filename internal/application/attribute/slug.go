@@ -0,0 +1,55 @@
+package attribute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/slug"
+)
+
+// GenerateSlugQuery asks for the slug an attribute created with the name would get
+type GenerateSlugQuery struct {
+	Name string
+}
+
+type GenerateSlugQueryHandler interface {
+	Handle(ctx context.Context, query GenerateSlugQuery) (string, error)
+}
+
+type generateSlugHandler struct {
+	repo   Repository
+	limits limits.Attribute
+}
+
+func NewGenerateSlugHandler(repo Repository, l limits.Limits) GenerateSlugQueryHandler {
+	return &generateSlugHandler{repo: repo, limits: l.Attribute}
+}
+
+// Handle returns a slug no attribute uses yet. It isn't reserved: an attribute created meanwhile may take it.
+func (h *generateSlugHandler) Handle(ctx context.Context, query GenerateSlugQuery) (string, error) {
+	return uniqueSlug(ctx, h.repo, h.limits, query.Name)
+}
+
+// uniqueSlug derives a slug from the name, suffixed with -2, -3, ... when attributes use it
+func uniqueSlug(ctx context.Context, repo Repository, l limits.Attribute, name string) (string, error) {
+	s, err := slug.Unique(ctx, name, l.SlugLength, func(ctx context.Context, candidates []string) ([]string, error) {
+		taken, err := repo.FindBySlugs(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+		return lo.Map(taken, func(a *Attribute, _ int) string { return a.Slug }), nil
+	})
+	switch {
+	case errors.Is(err, slug.ErrEmpty):
+		return "", fmt.Errorf("%w: %w", ErrInvalidAttributeData, err)
+	case errors.Is(err, slug.ErrExhausted):
+		return "", fmt.Errorf("%w: %w", ErrSlugAlreadyExists, err)
+	case err != nil:
+		return "", fmt.Errorf("failed to generate slug: %w", err)
+	}
+	return s, nil
+}
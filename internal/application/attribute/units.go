@@ -0,0 +1,89 @@
+package attribute
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// UnitConversion is a unit accepted for values of a range attribute besides its canonical Unit.
+// Values submitted in the unit are multiplied by Factor to get the canonical value,
+// e.g. mm for an attribute in cm has the factor 0.1.
+type UnitConversion struct {
+	Unit   string
+	Factor float64
+}
+
+// ChangeInputUnits replaces the units accepted besides the canonical unit
+func (a *Attribute) ChangeInputUnits(l limits.Attribute, inputUnits []UnitConversion) error {
+	if err := validateInputUnits(l, a.Type, a.Unit, inputUnits); err != nil {
+		return err
+	}
+
+	a.InputUnits = inputUnits
+	a.ModifiedAt = utc.NowAfter(a.ModifiedAt)
+	return nil
+}
+
+// ToCanonical converts a value submitted in the given unit to the canonical unit of the attribute.
+// It returns false if the attribute doesn't accept the unit.
+func (a *Attribute) ToCanonical(value float64, unit string) (float64, bool) {
+	if a.Unit == nil {
+		return 0, false
+	}
+	if unit == *a.Unit {
+		return value, true
+	}
+	for _, c := range a.InputUnits {
+		if c.Unit == unit {
+			return value * c.Factor, true
+		}
+	}
+	return 0, false
+}
+
+func validateInputUnits(l limits.Attribute, attrType AttributeType, unit *string, inputUnits []UnitConversion) error {
+	if len(inputUnits) == 0 {
+		return nil
+	}
+
+	if attrType != AttributeTypeRange {
+		return fmt.Errorf("%w: input units are only supported for range attributes", ErrInvalidAttributeData)
+	}
+	if unit == nil || *unit == "" {
+		return fmt.Errorf("%w: input units need a canonical unit", ErrInvalidAttributeData)
+	}
+	if maxUnits := l.InputUnits; len(inputUnits) > maxUnits {
+		return fmt.Errorf("%w: too many input units (max %d)", ErrInvalidAttributeData, maxUnits)
+	}
+
+	seen := make(map[string]bool, len(inputUnits))
+	for _, c := range inputUnits {
+		if c.Unit == "" {
+			return fmt.Errorf("%w: input unit is required", ErrInvalidAttributeData)
+		}
+		if len(c.Unit) > 20 {
+			return fmt.Errorf("%w: input unit is too long (max 20 characters)", ErrInvalidAttributeData)
+		}
+		if c.Unit == *unit {
+			return fmt.Errorf("%w: input unit %s is the canonical unit", ErrInvalidAttributeData, c.Unit)
+		}
+		if seen[c.Unit] {
+			return fmt.Errorf("%w: duplicate input unit: %s", ErrInvalidAttributeData, c.Unit)
+		}
+		seen[c.Unit] = true
+		if c.Factor <= 0 || math.IsInf(c.Factor, 0) || math.IsNaN(c.Factor) {
+			return fmt.Errorf("%w: input unit %s needs a positive conversion factor", ErrInvalidAttributeData, c.Unit)
+		}
+	}
+	return nil
+}
+
+func equalUnits(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/samber/lo"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -20,6 +21,8 @@ type UpdateAttributeCommand struct {
 	Unit    *string
 	Enabled bool
 	Options []OptionInput
+	// InputUnits replaces the units accepted besides Unit
+	InputUnits []UnitConversion
 }
 
 type UpdateAttributeCommandHandler interface {
@@ -27,23 +30,29 @@ type UpdateAttributeCommandHandler interface {
 }
 
 type updateAttributeHandler struct {
-	repo         Repository
-	outbox       outbox.Outbox
-	txManager    mongo.TxManager
-	eventFactory AttributeEventFactory
+	repo          Repository
+	paletteColors PaletteColors
+	outbox        outbox.Outbox
+	txManager     mongo.TxManager
+	eventFactory  AttributeEventFactory
+	limits        limits.Attribute
 }
 
 func NewUpdateAttributeHandler(
 	repo Repository,
+	paletteColors PaletteColors,
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory AttributeEventFactory,
+	l limits.Limits,
 ) UpdateAttributeCommandHandler {
 	return &updateAttributeHandler{
-		repo:         repo,
-		outbox:       outbox,
-		txManager:    txManager,
-		eventFactory: eventFactory,
+		repo:          repo,
+		paletteColors: paletteColors,
+		outbox:        outbox,
+		txManager:     txManager,
+		eventFactory:  eventFactory,
+		limits:        l.Attribute,
 	}
 }
 
@@ -61,10 +70,12 @@ func (h *updateAttributeHandler) Handle(ctx context.Context, cmd UpdateAttribute
 	}
 
 	options := lo.Map(cmd.Options, func(opt OptionInput, _ int) Option {
-		return Option(opt)
+		return opt.toOption()
 	})
+	previousOptions := a.Options
 
 	if err := a.Update(
+		h.limits,
 		cmd.Name,
 		cmd.Unit,
 		cmd.Enabled,
@@ -73,12 +84,22 @@ func (h *updateAttributeHandler) Handle(ctx context.Context, cmd UpdateAttribute
 		return nil, fmt.Errorf("failed to update attribute: %w", err)
 	}
 
-	return h.persistAndPublish(ctx, a)
+	if err := a.ChangeInputUnits(h.limits, cmd.InputUnits); err != nil {
+		return nil, fmt.Errorf("failed to update attribute: %w", err)
+	}
+
+	// Options of a palette-bound swatch must keep using palette colors
+	if err := checkPalette(ctx, h.paletteColors, a); err != nil {
+		return nil, err
+	}
+
+	return h.persistAndPublish(ctx, a, DiffOptions(previousOptions, a.Options))
 }
 
 func (h *updateAttributeHandler) persistAndPublish(
 	ctx context.Context,
 	a *Attribute,
+	delta OptionsDelta,
 ) (*Attribute, error) {
 	type updateResult struct {
 		Attribute *Attribute
@@ -94,7 +115,7 @@ func (h *updateAttributeHandler) persistAndPublish(
 			return nil, fmt.Errorf("failed to update attribute: %w", err)
 		}
 
-		msg := h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, updated)
+		msg := h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, updated, delta)
 
 		send, err := h.outbox.Create(txCtx, msg)
 		if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -28,6 +29,9 @@ func createTestAttribute() *Attribute {
 		[]Option{
 			{Name: "Option 1", Slug: "option-1", SortOrder: 1},
 		},
+		"",
+		nil,
+		nil,
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -46,7 +50,7 @@ func setupUpdateAttributeHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockAttributeEventFactory(t)
 
-	handler := NewUpdateAttributeHandler(repo, outboxMock, txManager, eventFactory)
+	handler := NewUpdateAttributeHandler(repo, NewMockPaletteColors(t), outboxMock, txManager, eventFactory, limits.Default())
 
 	return repo, outboxMock, txManager, eventFactory, handler
 }
@@ -87,9 +91,12 @@ func TestUpdateAttributeHandler_Handle_Success(t *testing.T) {
 			return a, nil
 		})
 
-	// Mock event factory
+	// Mock event factory, option-1 was replaced by new-option
 	eventFactory.EXPECT().
-		NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything).
+		NewAttributeUpdatedOutboxMessage(mock.Anything, mock.Anything, OptionsDelta{
+			Added:   []Option{{Name: "New Option", Slug: "new-option", SortOrder: 1}},
+			Removed: []Option{{Name: "Option 1", Slug: "option-1", SortOrder: 1}},
+		}).
 		Return(outbox.Message{})
 
 	// Mock outbox
@@ -0,0 +1,80 @@
+package availability
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxRangeDays bounds a single availability query
+const MaxRangeDays = 92
+
+// TimeRange is a concrete bookable window
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// DayAvailability lists the bookable windows of a calendar date in the schedule's timezone
+type DayAvailability struct {
+	Date      time.Time
+	Blackout  bool
+	Reason    string
+	TimeSlots []TimeRange
+}
+
+// ParseDate parses a calendar date in DateLayout
+func ParseDate(s string) (time.Time, error) {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q must be in %s format", ErrInvalidDateRange, s, DateLayout)
+	}
+	return d, nil
+}
+
+// Availability expands the weekly slots over the dates from..to (inclusive) and removes blackout dates.
+// Slots are resolved in the schedule's timezone, so DST changes shift them in UTC as expected.
+func (s *Schedule) Availability(from, to time.Time) ([]DayAvailability, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: to is before from", ErrInvalidDateRange)
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > MaxRangeDays {
+		return nil, fmt.Errorf("%w: at most %d days can be queried", ErrInvalidDateRange, MaxRangeDays)
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone %q: %w", s.Timezone, err)
+	}
+
+	blackouts := make(map[time.Time]string, len(s.Blackouts))
+	for _, b := range s.Blackouts {
+		blackouts[b.Date] = b.Reason
+	}
+
+	var result []DayAvailability
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		day := DayAvailability{Date: date, TimeSlots: []TimeRange{}}
+		if reason, ok := blackouts[date]; ok {
+			day.Blackout = true
+			day.Reason = reason
+			result = append(result, day)
+			continue
+		}
+
+		for _, slot := range s.WeeklySlots {
+			if slot.Weekday != date.Weekday() {
+				continue
+			}
+			day.TimeSlots = append(day.TimeSlots, TimeRange{
+				Start: wallClock(date, slot.Start, loc),
+				End:   wallClock(date, slot.End, loc),
+			})
+		}
+		result = append(result, day)
+	}
+	return result, nil
+}
+
+func wallClock(date time.Time, t TimeOfDay, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, int(t), 0, 0, loc)
+}
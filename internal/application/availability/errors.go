@@ -0,0 +1,11 @@
+package availability
+
+import "errors"
+
+var (
+	ErrInvalidScheduleData = errors.New("invalid availability schedule data")
+	ErrInvalidDateRange    = errors.New("invalid date range")
+	ErrScheduleNotFound    = errors.New("availability schedule not found")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrNotServiceProduct   = errors.New("only service products can have an availability schedule")
+)
@@ -0,0 +1,59 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// GetAvailabilityQuery asks for the bookable windows of a product between two dates (inclusive, YYYY-MM-DD)
+type GetAvailabilityQuery struct {
+	ProductID string
+	From      string
+	To        string
+}
+
+type AvailabilityResult struct {
+	Schedule *Schedule
+	Days     []DayAvailability
+}
+
+type GetAvailabilityQueryHandler interface {
+	Handle(ctx context.Context, query GetAvailabilityQuery) (*AvailabilityResult, error)
+}
+
+type getAvailabilityHandler struct {
+	repo Repository
+}
+
+func NewGetAvailabilityHandler(repo Repository) GetAvailabilityQueryHandler {
+	return &getAvailabilityHandler{repo: repo}
+}
+
+func (h *getAvailabilityHandler) Handle(ctx context.Context, query GetAvailabilityQuery) (*AvailabilityResult, error) {
+	from, err := ParseDate(query.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := ParseDate(query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := h.repo.FindByProductID(ctx, query.ProductID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get availability schedule: %w", err)
+	}
+
+	days, err := s.Availability(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilityResult{Schedule: s, Days: days}, nil
+}
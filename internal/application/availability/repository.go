@@ -0,0 +1,14 @@
+package availability
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, schedule *Schedule) error
+
+	// FindByProductID returns the schedule of a product or mongo.ErrEntityNotFound
+	FindByProductID(ctx context.Context, productID string) (*Schedule, error)
+
+	Update(ctx context.Context, schedule *Schedule) (*Schedule, error)
+}
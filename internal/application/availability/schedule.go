@@ -0,0 +1,163 @@
+package availability
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// DateLayout is the format of calendar dates (blackouts, query ranges)
+const DateLayout = "2006-01-02"
+
+// TimeOfDay is a wall clock time in minutes since midnight
+type TimeOfDay int
+
+const endOfDay TimeOfDay = 24 * 60
+
+// ParseTimeOfDay parses an "HH:MM" wall clock time; "24:00" is accepted as the end of the day
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	if len(s) != 5 || s[2] != ':' || !isDigit(s[0]) || !isDigit(s[1]) || !isDigit(s[3]) || !isDigit(s[4]) {
+		return 0, fmt.Errorf("%w: time %q must be in HH:MM format", ErrInvalidScheduleData, s)
+	}
+	h := int(s[0]-'0')*10 + int(s[1]-'0')
+	m := int(s[3]-'0')*10 + int(s[4]-'0')
+	t := TimeOfDay(h*60 + m)
+	if m >= 60 || t > endOfDay {
+		return 0, fmt.Errorf("%w: time %q is out of range", ErrInvalidScheduleData, s)
+	}
+	return t, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t/60, t%60)
+}
+
+// WeeklySlot is a recurring bookable time window on a weekday
+type WeeklySlot struct {
+	Weekday time.Weekday
+	Start   TimeOfDay
+	End     TimeOfDay
+}
+
+// Blackout removes all slots of a calendar date, e.g. a public holiday
+type Blackout struct {
+	Date   time.Time // midnight UTC of the calendar date
+	Reason string
+}
+
+// Schedule describes when a bookable service product is available.
+// It is stored per product; a product with a schedule is treated as a bookable service.
+type Schedule struct {
+	ProductID   string
+	Version     int
+	Timezone    string
+	WeeklySlots []WeeklySlot
+	Blackouts   []Blackout
+	CreatedAt   time.Time
+	ModifiedAt  time.Time
+}
+
+// NewSchedule creates a schedule with validation
+func NewSchedule(productID, timezone string, slots []WeeklySlot, blackouts []Blackout) (*Schedule, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidScheduleData)
+	}
+	if err := validate(timezone, slots, blackouts); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
+	return &Schedule{
+		ProductID:   productID,
+		Version:     1,
+		Timezone:    timezone,
+		WeeklySlots: sortSlots(slots),
+		Blackouts:   sortBlackouts(blackouts),
+		CreatedAt:   now,
+		ModifiedAt:  now,
+	}, nil
+}
+
+// Reconstruct rebuilds a schedule from persistence without validation
+func Reconstruct(
+	productID string,
+	version int,
+	timezone string,
+	slots []WeeklySlot,
+	blackouts []Blackout,
+	createdAt, modifiedAt time.Time,
+) *Schedule {
+	return &Schedule{
+		ProductID:   productID,
+		Version:     version,
+		Timezone:    timezone,
+		WeeklySlots: slots,
+		Blackouts:   blackouts,
+		CreatedAt:   createdAt,
+		ModifiedAt:  modifiedAt,
+	}
+}
+
+// Update replaces the schedule with validation
+func (s *Schedule) Update(timezone string, slots []WeeklySlot, blackouts []Blackout) error {
+	if err := validate(timezone, slots, blackouts); err != nil {
+		return err
+	}
+
+	s.Timezone = timezone
+	s.WeeklySlots = sortSlots(slots)
+	s.Blackouts = sortBlackouts(blackouts)
+	s.ModifiedAt = utc.NowAfter(s.ModifiedAt)
+	return nil
+}
+
+func validate(timezone string, slots []WeeklySlot, blackouts []Blackout) error {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidScheduleData, timezone)
+	}
+
+	sorted := sortSlots(slots)
+	for i, slot := range sorted {
+		if slot.Weekday < time.Sunday || slot.Weekday > time.Saturday {
+			return fmt.Errorf("%w: invalid weekday %d", ErrInvalidScheduleData, slot.Weekday)
+		}
+		if slot.Start < 0 || slot.End > endOfDay || slot.Start >= slot.End {
+			return fmt.Errorf("%w: slot %s-%s on %s must end after it starts", ErrInvalidScheduleData, slot.Start, slot.End, slot.Weekday)
+		}
+		if i > 0 && sorted[i-1].Weekday == slot.Weekday && sorted[i-1].End > slot.Start {
+			return fmt.Errorf("%w: slots on %s overlap", ErrInvalidScheduleData, slot.Weekday)
+		}
+	}
+
+	seen := make(map[time.Time]struct{}, len(blackouts))
+	for _, b := range blackouts {
+		if _, ok := seen[b.Date]; ok {
+			return fmt.Errorf("%w: duplicate blackout date %s", ErrInvalidScheduleData, b.Date.Format(DateLayout))
+		}
+		seen[b.Date] = struct{}{}
+	}
+	return nil
+}
+
+func sortSlots(slots []WeeklySlot) []WeeklySlot {
+	sorted := slices.Clone(slots)
+	slices.SortFunc(sorted, func(a, b WeeklySlot) int {
+		return cmp.Or(cmp.Compare(a.Weekday, b.Weekday), cmp.Compare(a.Start, b.Start))
+	})
+	return sorted
+}
+
+func sortBlackouts(blackouts []Blackout) []Blackout {
+	sorted := slices.Clone(blackouts)
+	slices.SortFunc(sorted, func(a, b Blackout) int {
+		return a.Date.Compare(b.Date)
+	})
+	return sorted
+}
@@ -0,0 +1,124 @@
+package availability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := ParseDate(s)
+	require.NoError(t, err)
+	return d
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    TimeOfDay
+		wantErr bool
+	}{
+		{in: "09:30", want: 570},
+		{in: "00:00", want: 0},
+		{in: "24:00", want: endOfDay},
+		{in: "9:30", wantErr: true},
+		{in: "24:01", wantErr: true},
+		{in: "10:60", wantErr: true},
+		{in: "noon", wantErr: true},
+		{in: "+1:00", wantErr: true},
+		{in: "-1:00", wantErr: true},
+		{in: " 1:00", wantErr: true},
+		{in: "01:0a", wantErr: true},
+		{in: "01-00", wantErr: true},
+		{in: "12:60", wantErr: true},
+		{in: "09:30:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseTimeOfDay(tt.in)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidScheduleData)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.in, got.String())
+		})
+	}
+}
+
+func TestNewSchedule_Validation(t *testing.T) {
+	christmas := Blackout{Date: mustDate(t, "2026-12-25")}
+
+	tests := []struct {
+		name      string
+		timezone  string
+		slots     []WeeklySlot
+		blackouts []Blackout
+	}{
+		{name: "unknown timezone", timezone: "Mars/Olympus"},
+		{name: "empty timezone", timezone: ""},
+		{name: "slot ends before start", timezone: "UTC", slots: []WeeklySlot{{Weekday: time.Monday, Start: 600, End: 540}}},
+		{name: "overlapping slots", timezone: "UTC", slots: []WeeklySlot{
+			{Weekday: time.Monday, Start: 540, End: 720},
+			{Weekday: time.Monday, Start: 660, End: 780},
+		}},
+		{name: "duplicate blackout", timezone: "UTC", blackouts: []Blackout{christmas, christmas}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSchedule("product-1", tt.timezone, tt.slots, tt.blackouts)
+			require.ErrorIs(t, err, ErrInvalidScheduleData)
+		})
+	}
+}
+
+func TestSchedule_Availability(t *testing.T) {
+	s, err := NewSchedule("product-1", "Europe/Kyiv",
+		[]WeeklySlot{
+			{Weekday: time.Monday, Start: 13 * 60, End: 17 * 60},
+			{Weekday: time.Monday, Start: 9 * 60, End: 12 * 60},
+			{Weekday: time.Wednesday, Start: 10 * 60, End: 11 * 60},
+		},
+		[]Blackout{{Date: mustDate(t, "2026-10-28"), Reason: "Holiday"}},
+	)
+	require.NoError(t, err)
+
+	// Monday 2026-10-19 to Wednesday 2026-10-28; DST ends on Sunday 2026-10-25
+	days, err := s.Availability(mustDate(t, "2026-10-19"), mustDate(t, "2026-10-28"))
+	require.NoError(t, err)
+	require.Len(t, days, 10)
+
+	monday := days[0]
+	require.Len(t, monday.TimeSlots, 2)
+	assert.Equal(t, "2026-10-19T06:00:00Z", monday.TimeSlots[0].Start.UTC().Format(time.RFC3339))
+	assert.Equal(t, "2026-10-19T14:00:00Z", monday.TimeSlots[1].End.UTC().Format(time.RFC3339))
+
+	assert.Empty(t, days[1].TimeSlots)
+	require.Len(t, days[2].TimeSlots, 1)
+
+	nextMonday := days[7]
+	require.Len(t, nextMonday.TimeSlots, 2)
+	assert.Equal(t, "2026-10-26T07:00:00Z", nextMonday.TimeSlots[0].Start.UTC().Format(time.RFC3339))
+
+	holiday := days[9]
+	assert.True(t, holiday.Blackout)
+	assert.Equal(t, "Holiday", holiday.Reason)
+	assert.Empty(t, holiday.TimeSlots)
+}
+
+func TestSchedule_Availability_InvalidRange(t *testing.T) {
+	s, err := NewSchedule("product-1", "UTC", nil, nil)
+	require.NoError(t, err)
+
+	_, err = s.Availability(mustDate(t, "2026-10-20"), mustDate(t, "2026-10-19"))
+	require.ErrorIs(t, err, ErrInvalidDateRange)
+
+	_, err = s.Availability(mustDate(t, "2026-01-01"), mustDate(t, "2026-12-31"))
+	require.ErrorIs(t, err, ErrInvalidDateRange)
+}
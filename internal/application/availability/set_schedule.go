@@ -0,0 +1,153 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type WeeklySlotInput struct {
+	Weekday string // english weekday name, e.g. "monday"
+	Start   string // HH:MM
+	End     string // HH:MM
+}
+
+type BlackoutInput struct {
+	Date   string // YYYY-MM-DD
+	Reason string
+}
+
+// SetScheduleCommand creates the schedule of a product (Version 0) or replaces it (current Version)
+type SetScheduleCommand struct {
+	ProductID   string
+	Version     int
+	Timezone    string
+	WeeklySlots []WeeklySlotInput
+	Blackouts   []BlackoutInput
+}
+
+type SetScheduleCommandHandler interface {
+	Handle(ctx context.Context, cmd SetScheduleCommand) (*Schedule, error)
+}
+
+type setScheduleHandler struct {
+	repo        Repository
+	productRepo product.Repository
+}
+
+func NewSetScheduleHandler(repo Repository, productRepo product.Repository) SetScheduleCommandHandler {
+	return &setScheduleHandler{
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+func (h *setScheduleHandler) Handle(ctx context.Context, cmd SetScheduleCommand) (*Schedule, error) {
+	slots, blackouts, err := parseInputs(cmd.WeeklySlots, cmd.Blackouts)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := h.productRepo.FindByID(ctx, cmd.ProductID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if !p.IsService() {
+		return nil, ErrNotServiceProduct
+	}
+
+	existing, err := h.repo.FindByProductID(ctx, cmd.ProductID)
+	switch {
+	case errors.Is(err, mongo.ErrEntityNotFound):
+		return h.create(ctx, cmd, slots, blackouts)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get availability schedule: %w", err)
+	}
+
+	if existing.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+	if err := existing.Update(cmd.Timezone, slots, blackouts); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.Update(ctx, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update availability schedule: %w", err)
+	}
+
+	h.log(ctx).Debug("availability schedule updated", zap.String("productId", cmd.ProductID))
+	return updated, nil
+}
+
+func (h *setScheduleHandler) create(ctx context.Context, cmd SetScheduleCommand, slots []WeeklySlot, blackouts []Blackout) (*Schedule, error) {
+	if cmd.Version != 0 {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	s, err := NewSchedule(cmd.ProductID, cmd.Timezone, slots, blackouts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Insert(ctx, s); err != nil {
+		return nil, fmt.Errorf("failed to insert availability schedule: %w", err)
+	}
+
+	h.log(ctx).Debug("availability schedule created", zap.String("productId", cmd.ProductID))
+	return s, nil
+}
+
+func (h *setScheduleHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "set-availability-schedule-handler"))
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func parseInputs(slotInputs []WeeklySlotInput, blackoutInputs []BlackoutInput) ([]WeeklySlot, []Blackout, error) {
+	slots := make([]WeeklySlot, 0, len(slotInputs))
+	for _, in := range slotInputs {
+		weekday, ok := weekdays[strings.ToLower(in.Weekday)]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: unknown weekday %q", ErrInvalidScheduleData, in.Weekday)
+		}
+		start, err := ParseTimeOfDay(in.Start)
+		if err != nil {
+			return nil, nil, err
+		}
+		end, err := ParseTimeOfDay(in.End)
+		if err != nil {
+			return nil, nil, err
+		}
+		slots = append(slots, WeeklySlot{Weekday: weekday, Start: start, End: end})
+	}
+
+	blackouts := make([]Blackout, 0, len(blackoutInputs))
+	for _, in := range blackoutInputs {
+		date, err := time.Parse(DateLayout, in.Date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: blackout date %q must be in %s format", ErrInvalidScheduleData, in.Date, DateLayout)
+		}
+		blackouts = append(blackouts, Blackout{Date: date, Reason: in.Reason})
+	}
+
+	return slots, blackouts, nil
+}
@@ -0,0 +1,150 @@
+package availability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+func setupSetScheduleHandler(t *testing.T) (*MockRepository, *product.MockRepository, SetScheduleCommandHandler) {
+	repo := NewMockRepository(t)
+	productRepo := product.NewMockRepository(t)
+	return repo, productRepo, NewSetScheduleHandler(repo, productRepo)
+}
+
+func serviceProduct() *product.Product {
+	return &product.Product{ID: "product-1", Type: product.ProductTypeService}
+}
+
+func validCommand() SetScheduleCommand {
+	return SetScheduleCommand{
+		ProductID:   "product-1",
+		Timezone:    "Europe/Kyiv",
+		WeeklySlots: []WeeklySlotInput{{Weekday: "Monday", Start: "09:00", End: "12:00"}},
+		Blackouts:   []BlackoutInput{{Date: "2026-12-25", Reason: "Christmas"}},
+	}
+}
+
+func TestSetScheduleHandler_Handle_Create(t *testing.T) {
+	repo, productRepo, handler := setupSetScheduleHandler(t)
+
+	productRepo.EXPECT().FindByID(mock.Anything, "product-1").Return(serviceProduct(), nil)
+	repo.EXPECT().FindByProductID(mock.Anything, "product-1").Return(nil, mongo.ErrEntityNotFound)
+	repo.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*availability.Schedule")).Return(nil)
+
+	result, err := handler.Handle(testCtx(), validCommand())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Version)
+	assert.Equal(t, []WeeklySlot{{Weekday: time.Monday, Start: 540, End: 720}}, result.WeeklySlots)
+	require.Len(t, result.Blackouts, 1)
+	assert.Equal(t, "2026-12-25", result.Blackouts[0].Date.Format(DateLayout))
+}
+
+func TestSetScheduleHandler_Handle_Update(t *testing.T) {
+	repo, productRepo, handler := setupSetScheduleHandler(t)
+	existing, err := NewSchedule("product-1", "UTC", nil, nil)
+	require.NoError(t, err)
+
+	productRepo.EXPECT().FindByID(mock.Anything, "product-1").Return(serviceProduct(), nil)
+	repo.EXPECT().FindByProductID(mock.Anything, "product-1").Return(existing, nil)
+	repo.EXPECT().Update(mock.Anything, existing).RunAndReturn(func(_ context.Context, s *Schedule) (*Schedule, error) {
+		s.Version++
+		return s, nil
+	})
+
+	cmd := validCommand()
+	cmd.Version = 1
+	result, err := handler.Handle(testCtx(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Version)
+	assert.Equal(t, "Europe/Kyiv", result.Timezone)
+}
+
+func TestSetScheduleHandler_Handle_StaleVersion(t *testing.T) {
+	repo, productRepo, handler := setupSetScheduleHandler(t)
+	existing, err := NewSchedule("product-1", "UTC", nil, nil)
+	require.NoError(t, err)
+
+	productRepo.EXPECT().FindByID(mock.Anything, "product-1").Return(serviceProduct(), nil)
+	repo.EXPECT().FindByProductID(mock.Anything, "product-1").Return(existing, nil)
+
+	_, err = handler.Handle(testCtx(), validCommand())
+
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+}
+
+func TestSetScheduleHandler_Handle_ProductNotFound(t *testing.T) {
+	_, productRepo, handler := setupSetScheduleHandler(t)
+
+	productRepo.EXPECT().FindByID(mock.Anything, "product-1").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(testCtx(), validCommand())
+
+	require.ErrorIs(t, err, ErrProductNotFound)
+}
+
+func TestSetScheduleHandler_Handle_NotServiceProduct(t *testing.T) {
+	_, productRepo, handler := setupSetScheduleHandler(t)
+
+	productRepo.EXPECT().FindByID(mock.Anything, "product-1").Return(&product.Product{ID: "product-1", Type: product.ProductTypePhysical}, nil)
+
+	_, err := handler.Handle(testCtx(), validCommand())
+
+	require.ErrorIs(t, err, ErrNotServiceProduct)
+}
+
+func TestSetScheduleHandler_Handle_InvalidInput(t *testing.T) {
+	_, _, handler := setupSetScheduleHandler(t)
+
+	cmd := validCommand()
+	cmd.WeeklySlots[0].Weekday = "someday"
+	_, err := handler.Handle(testCtx(), cmd)
+	require.ErrorIs(t, err, ErrInvalidScheduleData)
+
+	cmd = validCommand()
+	cmd.Blackouts[0].Date = "25.12.2026"
+	_, err = handler.Handle(testCtx(), cmd)
+	require.ErrorIs(t, err, ErrInvalidScheduleData)
+}
+
+func TestGetAvailabilityHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetAvailabilityHandler(repo)
+	s, err := NewSchedule("product-1", "UTC", []WeeklySlot{{Weekday: time.Monday, Start: 540, End: 600}}, nil)
+	require.NoError(t, err)
+
+	repo.EXPECT().FindByProductID(mock.Anything, "product-1").Return(s, nil)
+
+	result, err := handler.Handle(testCtx(), GetAvailabilityQuery{ProductID: "product-1", From: "2026-10-19", To: "2026-10-25"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Days, 7)
+	assert.Len(t, result.Days[0].TimeSlots, 1)
+}
+
+func TestGetAvailabilityHandler_Handle_Errors(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetAvailabilityHandler(repo)
+
+	_, err := handler.Handle(testCtx(), GetAvailabilityQuery{ProductID: "product-1", From: "tomorrow", To: "2026-10-25"})
+	require.ErrorIs(t, err, ErrInvalidDateRange)
+
+	repo.EXPECT().FindByProductID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+	_, err = handler.Handle(testCtx(), GetAvailabilityQuery{ProductID: "missing", From: "2026-10-19", To: "2026-10-25"})
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+}
@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/richtext"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
 )
 
 // AttributeRole defines how an attribute is used in a category
@@ -15,8 +19,41 @@ const (
 	AttributeRoleVariant AttributeRole = "variant"
 	// AttributeRoleSpecification - describes the product (processor, screen) - shown in specs
 	AttributeRoleSpecification AttributeRole = "specification"
+	// AttributeRoleDescription - long-form product facts (material, care) - shown in the description block
+	AttributeRoleDescription AttributeRole = "description"
+	// AttributeRoleFilterOnly - narrows listings (season, collection) - hidden on the product page
+	AttributeRoleFilterOnly AttributeRole = "filter-only"
 )
 
+// IsValid reports whether the role is known
+func (r AttributeRole) IsValid() bool {
+	switch r {
+	case AttributeRoleVariant, AttributeRoleSpecification, AttributeRoleDescription, AttributeRoleFilterOnly:
+		return true
+	}
+	return false
+}
+
+// CreatesVariants reports whether buyers choose between product variants by the attribute
+func (r AttributeRole) CreatesVariants() bool {
+	return r == AttributeRoleVariant
+}
+
+// ShownInSpecs reports whether the attribute is listed in the specification table of the product page
+func (r AttributeRole) ShownInSpecs() bool {
+	return r == AttributeRoleVariant || r == AttributeRoleSpecification
+}
+
+// ShownOnProductPage reports whether storefronts render the attribute on the product page at all
+func (r AttributeRole) ShownOnProductPage() bool {
+	return r != AttributeRoleFilterOnly
+}
+
+// RequiresFilterable reports whether attributes of the role must be filterable
+func (r AttributeRole) RequiresFilterable() bool {
+	return r == AttributeRoleFilterOnly
+}
+
 // CategoryAttribute represents an attribute assigned to a category
 type CategoryAttribute struct {
 	AttributeID string
@@ -25,6 +62,7 @@ type CategoryAttribute struct {
 	SortOrder   int
 	Filterable  bool
 	Searchable  bool
+	Required    bool // enabled products of the category must have a value for the attribute
 }
 
 // Category - domain aggregate root
@@ -34,17 +72,22 @@ type Category struct {
 	Name       string
 	Enabled    bool
 	Attributes []CategoryAttribute
+	Display    Display
 	CreatedAt  time.Time
 	ModifiedAt time.Time
 }
 
 // NewCategory creates a new category with validation
-func NewCategory(name string, enabled bool, attributes []CategoryAttribute) (*Category, error) {
-	if err := validateCategoryData(name); err != nil {
+func NewCategory(l limits.Category, name string, enabled bool, attributes []CategoryAttribute) (*Category, error) {
+	if err := validateCategoryData(l, name); err != nil {
 		return nil, err
 	}
 
-	now := time.Now().UTC()
+	if err := validateAttributes(attributes); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
 	return &Category{
 		ID:         uuid.New().String(),
 		Version:    1,
@@ -57,12 +100,16 @@ func NewCategory(name string, enabled bool, attributes []CategoryAttribute) (*Ca
 }
 
 // NewCategoryWithID creates a category with a specific ID (for idempotency)
-func NewCategoryWithID(id, name string, enabled bool, attributes []CategoryAttribute) (*Category, error) {
-	if err := validateCategoryData(name); err != nil {
+func NewCategoryWithID(l limits.Category, id, name string, enabled bool, attributes []CategoryAttribute) (*Category, error) {
+	if err := validateCategoryData(l, name); err != nil {
+		return nil, err
+	}
+
+	if err := validateAttributes(attributes); err != nil {
 		return nil, err
 	}
 
-	now := time.Now().UTC()
+	now := utc.Now()
 	return &Category{
 		ID:         id,
 		Version:    1,
@@ -75,53 +122,88 @@ func NewCategoryWithID(id, name string, enabled bool, attributes []CategoryAttri
 }
 
 // Reconstruct rebuilds a category from persistence (no validation)
-func Reconstruct(id string, version int, name string, enabled bool, attributes []CategoryAttribute, createdAt, modifiedAt time.Time) *Category {
+func Reconstruct(id string, version int, name string, enabled bool, attributes []CategoryAttribute, display Display, createdAt, modifiedAt time.Time) *Category {
 	return &Category{
 		ID:         id,
 		Version:    version,
 		Name:       name,
 		Enabled:    enabled,
 		Attributes: attributes,
+		Display:    display,
 		CreatedAt:  createdAt,
 		ModifiedAt: modifiedAt,
 	}
 }
 
 // Update modifies category data with validation
-func (c *Category) Update(name string, enabled bool, attributes []CategoryAttribute) error {
-	if err := validateCategoryData(name); err != nil {
+func (c *Category) Update(l limits.Category, name string, enabled bool, attributes []CategoryAttribute) error {
+	if err := validateCategoryData(l, name); err != nil {
+		return err
+	}
+
+	if err := validateAttributes(attributes); err != nil {
 		return err
 	}
 
 	c.Name = name
 	c.Enabled = enabled
 	c.Attributes = attributes
-	c.ModifiedAt = time.Now().UTC()
+	c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
 
 	return nil
 }
 
 // ChangeName updates the name with validation
-func (c *Category) ChangeName(newName string) error {
-	if err := validateCategoryData(newName); err != nil {
+func (c *Category) ChangeName(l limits.Category, newName string) error {
+	if err := validateCategoryData(l, newName); err != nil {
 		return err
 	}
 
 	c.Name = newName
-	c.ModifiedAt = time.Now().UTC()
+	c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
 	return nil
 }
 
+// ChangeDisplay replaces the storefront display metadata with validation; the description is sanitized
+// and dropped when nothing is left of it
+func (c *Category) ChangeDisplay(display Display) error {
+	if display.Description != nil {
+		sanitized := richtext.Sanitize(*display.Description)
+		display.Description = nil
+		if sanitized != "" {
+			display.Description = &sanitized
+		}
+	}
+	if err := validateDisplay(display); err != nil {
+		return err
+	}
+
+	c.Display = display
+	c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
+	return nil
+}
+
+// RequiredAttributes returns the attributes enabled products of the category must have a value for
+func (c *Category) RequiredAttributes() []CategoryAttribute {
+	var required []CategoryAttribute
+	for _, attr := range c.Attributes {
+		if attr.Required {
+			required = append(required, attr)
+		}
+	}
+	return required
+}
+
 // Enable activates the category
 func (c *Category) Enable() {
 	c.Enabled = true
-	c.ModifiedAt = time.Now().UTC()
+	c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
 }
 
 // Disable deactivates the category
 func (c *Category) Disable() {
 	c.Enabled = false
-	c.ModifiedAt = time.Now().UTC()
+	c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
 }
 
 // IncrementVersion increments version for optimistic locking
@@ -130,14 +212,27 @@ func (c *Category) IncrementVersion() {
 }
 
 // validateCategoryData validates business rules
-func validateCategoryData(name string) error {
+func validateCategoryData(l limits.Category, name string) error {
 	if name == "" {
 		return fmt.Errorf("%w: name is required", ErrInvalidCategoryData)
 	}
 
-	if len(name) > 255 {
-		return fmt.Errorf("%w: name is too long (max 255 characters)", ErrInvalidCategoryData)
+	if maxLength := l.NameLength; len(name) > maxLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidCategoryData, maxLength)
 	}
 
 	return nil
 }
+
+// validateAttributes validates the roles of category attributes
+func validateAttributes(attributes []CategoryAttribute) error {
+	for _, attr := range attributes {
+		if !attr.Role.IsValid() {
+			return fmt.Errorf("%w: attribute %s has unknown role %q", ErrInvalidCategoryData, attr.AttributeID, attr.Role)
+		}
+		if attr.Role.RequiresFilterable() && !attr.Filterable {
+			return fmt.Errorf("%w: attribute %s has role %s and must be filterable", ErrInvalidCategoryData, attr.AttributeID, attr.Role)
+		}
+	}
+	return nil
+}
@@ -5,10 +5,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 )
 
+// defaultLimits are the category limits the tests validate against
+var defaultLimits = limits.Default().Category
+
 func TestNewCategory(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -72,11 +79,40 @@ func TestNewCategory(t *testing.T) {
 			attributes: nil,
 			wantErr:    false,
 		},
+		{
+			name:        "error when role is unknown",
+			catName:     "Clothing",
+			attributes:  []CategoryAttribute{{AttributeID: "attr-1", Role: "primary"}},
+			wantErr:     true,
+			errContains: "unknown role",
+		},
+		{
+			name:        "error when role is missing",
+			catName:     "Clothing",
+			attributes:  []CategoryAttribute{{AttributeID: "attr-1"}},
+			wantErr:     true,
+			errContains: "unknown role",
+		},
+		{
+			name:        "error when filter-only attribute is not filterable",
+			catName:     "Clothing",
+			attributes:  []CategoryAttribute{{AttributeID: "attr-1", Role: AttributeRoleFilterOnly}},
+			wantErr:     true,
+			errContains: "must be filterable",
+		},
+		{
+			name:    "valid description and filter-only attributes",
+			catName: "Clothing",
+			attributes: []CategoryAttribute{
+				{AttributeID: "attr-1", Role: AttributeRoleDescription},
+				{AttributeID: "attr-2", Role: AttributeRoleFilterOnly, Filterable: true},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			category, err := NewCategory(tt.catName, tt.enabled, tt.attributes)
+			category, err := NewCategory(defaultLimits, tt.catName, tt.enabled, tt.attributes)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -124,7 +160,7 @@ func TestNewCategoryWithID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			category, err := NewCategoryWithID(tt.id, tt.catName, tt.enabled, nil)
+			category, err := NewCategoryWithID(defaultLimits, tt.id, tt.catName, tt.enabled, nil)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -151,7 +187,7 @@ func TestCategory_Update(t *testing.T) {
 		{
 			name: "successful update",
 			setup: func() *Category {
-				c, _ := NewCategory("Original", false, nil)
+				c, _ := NewCategory(defaultLimits, "Original", false, nil)
 				return c
 			},
 			newName: "Updated Name",
@@ -164,7 +200,7 @@ func TestCategory_Update(t *testing.T) {
 		{
 			name: "error when updating with empty name",
 			setup: func() *Category {
-				c, _ := NewCategory("Original", false, nil)
+				c, _ := NewCategory(defaultLimits, "Original", false, nil)
 				return c
 			},
 			newName: "",
@@ -174,7 +210,7 @@ func TestCategory_Update(t *testing.T) {
 		{
 			name: "error when updating with too long name",
 			setup: func() *Category {
-				c, _ := NewCategory("Original", false, nil)
+				c, _ := NewCategory(defaultLimits, "Original", false, nil)
 				return c
 			},
 			newName: strings.Repeat("a", 256),
@@ -191,7 +227,7 @@ func TestCategory_Update(t *testing.T) {
 			// Small delay to ensure ModifiedAt changes
 			time.Sleep(time.Millisecond)
 
-			err := category.Update(tt.newName, tt.enabled, tt.attributes)
+			err := category.Update(defaultLimits, tt.newName, tt.enabled, tt.attributes)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -235,12 +271,12 @@ func TestCategory_ChangeName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			category, _ := NewCategory("Original", false, nil)
+			category, _ := NewCategory(defaultLimits, "Original", false, nil)
 			originalModifiedAt := category.ModifiedAt
 
 			time.Sleep(time.Millisecond)
 
-			err := category.ChangeName(tt.newName)
+			err := category.ChangeName(defaultLimits, tt.newName)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -255,8 +291,83 @@ func TestCategory_ChangeName(t *testing.T) {
 	}
 }
 
+func TestCategory_ChangeDisplay(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		display     Display
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "full display",
+			display: Display{ImageID: ptr("image-1"), BannerImageID: ptr("banner-1"), Description: ptr("<p>Phones</p>"), Template: DisplayTemplateGrid},
+		},
+		{
+			name:    "empty display",
+			display: Display{},
+		},
+		{
+			name:        "empty image id",
+			display:     Display{ImageID: ptr("")},
+			wantErr:     true,
+			errContains: "imageID must not be empty",
+		},
+		{
+			name:        "description too long",
+			display:     Display{Description: ptr(strings.Repeat("a", MaxDescriptionLength+1))},
+			wantErr:     true,
+			errContains: "description is too long",
+		},
+		{
+			name:        "unknown template",
+			display:     Display{Template: "carousel"},
+			wantErr:     true,
+			errContains: "unknown display template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, _ := NewCategory(defaultLimits, "Phones", true, nil)
+
+			err := category.ChangeDisplay(tt.display)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidCategoryData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.True(t, category.Display.IsEmpty())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.display, category.Display)
+			}
+		})
+	}
+}
+
+func TestCategory_ChangeDisplay_SanitizesDescription(t *testing.T) {
+	category, _ := NewCategory(defaultLimits, "Phones", true, nil)
+	description := `<p onmouseover="alert(1)">All <b>phones</b></p><script>alert(1)</script>`
+
+	require.NoError(t, category.ChangeDisplay(Display{Description: &description}))
+	assert.Equal(t, "<p>All <b>phones</b></p>", *category.Display.Description)
+
+	onlyScript := "<script>alert(1)</script>"
+	require.NoError(t, category.ChangeDisplay(Display{Description: &onlyScript}))
+	assert.Nil(t, category.Display.Description, "nothing is left of the description")
+}
+
+func TestDisplay_ImageIDs(t *testing.T) {
+	image, banner := "image-1", "banner-1"
+
+	assert.Empty(t, Display{}.ImageIDs())
+	assert.Equal(t, []string{"image-1", "banner-1"}, Display{ImageID: &image, BannerImageID: &banner}.ImageIDs())
+	assert.Equal(t, []string{"image-1"}, Display{ImageID: &image, BannerImageID: &image}.ImageIDs(), "same image used twice")
+}
+
 func TestCategory_Enable(t *testing.T) {
-	category, _ := NewCategory("Test", false, nil)
+	category, _ := NewCategory(defaultLimits, "Test", false, nil)
 	assert.False(t, category.Enabled)
 
 	originalModifiedAt := category.ModifiedAt
@@ -269,7 +380,7 @@ func TestCategory_Enable(t *testing.T) {
 }
 
 func TestCategory_Disable(t *testing.T) {
-	category, _ := NewCategory("Test", true, nil)
+	category, _ := NewCategory(defaultLimits, "Test", true, nil)
 	assert.True(t, category.Enabled)
 
 	originalModifiedAt := category.ModifiedAt
@@ -282,7 +393,7 @@ func TestCategory_Disable(t *testing.T) {
 }
 
 func TestCategory_IncrementVersion(t *testing.T) {
-	category, _ := NewCategory("Test", false, nil)
+	category, _ := NewCategory(defaultLimits, "Test", false, nil)
 	assert.Equal(t, 1, category.Version)
 
 	category.IncrementVersion()
@@ -307,6 +418,7 @@ func TestReconstruct(t *testing.T) {
 			"", // Empty name would fail validation in NewCategory
 			true,
 			attributes,
+			Display{},
 			createdAt,
 			modifiedAt,
 		)
@@ -325,6 +437,36 @@ func TestReconstruct(t *testing.T) {
 func TestAttributeRoleConstants(t *testing.T) {
 	assert.Equal(t, AttributeRole("variant"), AttributeRoleVariant)
 	assert.Equal(t, AttributeRole("specification"), AttributeRoleSpecification)
+	assert.Equal(t, AttributeRole("description"), AttributeRoleDescription)
+	assert.Equal(t, AttributeRole("filter-only"), AttributeRoleFilterOnly)
+}
+
+func TestAttributeRole_Behavior(t *testing.T) {
+	tests := []struct {
+		role               AttributeRole
+		createsVariants    bool
+		shownInSpecs       bool
+		shownOnProductPage bool
+		requiresFilterable bool
+	}{
+		{role: AttributeRoleVariant, createsVariants: true, shownInSpecs: true, shownOnProductPage: true},
+		{role: AttributeRoleSpecification, shownInSpecs: true, shownOnProductPage: true},
+		{role: AttributeRoleDescription, shownOnProductPage: true},
+		{role: AttributeRoleFilterOnly, requiresFilterable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			assert.True(t, tt.role.IsValid())
+			assert.Equal(t, tt.createsVariants, tt.role.CreatesVariants())
+			assert.Equal(t, tt.shownInSpecs, tt.role.ShownInSpecs())
+			assert.Equal(t, tt.shownOnProductPage, tt.role.ShownOnProductPage())
+			assert.Equal(t, tt.requiresFilterable, tt.role.RequiresFilterable())
+		})
+	}
+
+	assert.False(t, AttributeRole("").IsValid())
+	assert.False(t, AttributeRole("Variant").IsValid())
 }
 
 func TestCategoryAttribute(t *testing.T) {
@@ -344,3 +486,22 @@ func TestCategoryAttribute(t *testing.T) {
 	assert.True(t, attr.Filterable)
 	assert.False(t, attr.Searchable)
 }
+
+func TestCategory_ResyncAttributeSlugs(t *testing.T) {
+	modified := time.Now().UTC().Add(-time.Hour)
+	c := Reconstruct("shirts", 1, "Shirts", true, []CategoryAttribute{
+		{AttributeID: "color", Slug: "colour"},
+		{AttributeID: "size", Slug: "size"},
+		{AttributeID: "deleted", Slug: "deleted"},
+	}, Display{}, modified, modified)
+	attrs := map[string]*attribute.Attribute{
+		"color": {ID: "color", Slug: "color"},
+		"size":  {ID: "size", Slug: "size"},
+	}
+
+	assert.True(t, c.ResyncAttributeSlugs(attrs))
+	assert.Equal(t, []string{"color", "size", "deleted"}, lo.Map(c.Attributes, func(a CategoryAttribute, _ int) string { return a.Slug }))
+	assert.True(t, c.ModifiedAt.After(modified))
+
+	assert.False(t, c.ResyncAttributeSlugs(attrs), "slugs are current")
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -21,6 +23,22 @@ type CategoryAttributeInput struct {
 	SortOrder   int
 	Filterable  bool
 	Searchable  bool
+	Required    bool
+}
+
+// validateVariantRoles rejects the variant role on attributes without options:
+// product variants are told apart by option values, not by free text or numbers
+func validateVariantRoles(inputs []CategoryAttributeInput, attrMap map[string]*attribute.Attribute) error {
+	for _, input := range inputs {
+		if !AttributeRole(input.Role).CreatesVariants() {
+			continue
+		}
+		if a, ok := attrMap[input.AttributeID]; ok && !a.HasOptions() {
+			return fmt.Errorf("%w: attribute %s has type %s and can't be a variant, only single and multiple attributes can",
+				ErrInvalidCategoryData, a.Slug, a.Type)
+		}
+	}
+	return nil
 }
 
 // CreateCategoryCommand represents the input for creating a category
@@ -42,6 +60,8 @@ type createCategoryHandler struct {
 	outbox       outbox.Outbox
 	txManager    mongo.TxManager
 	eventFactory CategoryEventFactory
+	quotas       quota.Enforcer
+	limits       limits.Category
 }
 
 func NewCreateCategoryHandler(
@@ -50,6 +70,8 @@ func NewCreateCategoryHandler(
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory CategoryEventFactory,
+	quotas quota.Enforcer,
+	l limits.Limits,
 ) CreateCategoryCommandHandler {
 	return &createCategoryHandler{
 		repo:         repo,
@@ -57,11 +79,13 @@ func NewCreateCategoryHandler(
 		outbox:       outbox,
 		txManager:    txManager,
 		eventFactory: eventFactory,
+		quotas:       quotas,
+		limits:       l.Category,
 	}
 }
 
 func (h *createCategoryHandler) Handle(ctx context.Context, cmd CreateCategoryCommand) (*Category, error) {
-	categoryAttrs, err := h.buildCategoryAttributes(ctx, cmd.Attributes)
+	categoryAttrs, attrs, err := h.buildCategoryAttributes(ctx, cmd.Attributes)
 	if err != nil {
 		return nil, err
 	}
@@ -71,25 +95,27 @@ func (h *createCategoryHandler) Handle(ctx context.Context, cmd CreateCategoryCo
 		return nil, fmt.Errorf("failed to create category: %w", err)
 	}
 
-	msg := h.eventFactory.NewCategoryUpdatedOutboxMessage(ctx, c)
-
-	return h.persistAndPublish(ctx, c, msg)
+	return h.persistAndPublish(ctx, c, attrs)
 }
 
-func (h *createCategoryHandler) buildCategoryAttributes(ctx context.Context, inputs []CategoryAttributeInput) ([]CategoryAttribute, error) {
+func (h *createCategoryHandler) buildCategoryAttributes(ctx context.Context, inputs []CategoryAttributeInput) ([]CategoryAttribute, []*attribute.Attribute, error) {
 	attrIDs := lo.Map(inputs, func(attr CategoryAttributeInput, _ int) string {
 		return attr.AttributeID
 	})
 
 	attrs, err := h.attrRepo.FindByIDsOrFail(ctx, attrIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
 		return a.ID
 	})
 
+	if err := validateVariantRoles(inputs, attrMap); err != nil {
+		return nil, nil, err
+	}
+
 	return lo.Map(inputs, func(attr CategoryAttributeInput, _ int) CategoryAttribute {
 		slug := ""
 		if a, ok := attrMap[attr.AttributeID]; ok {
@@ -102,21 +128,22 @@ func (h *createCategoryHandler) buildCategoryAttributes(ctx context.Context, inp
 			SortOrder:   attr.SortOrder,
 			Filterable:  attr.Filterable,
 			Searchable:  attr.Searchable,
+			Required:    attr.Required,
 		}
-	}), nil
+	}), attrs, nil
 }
 
 func (h *createCategoryHandler) createCategory(cmd CreateCategoryCommand, attrs []CategoryAttribute) (*Category, error) {
 	if cmd.ID != nil {
-		return NewCategoryWithID(cmd.ID.String(), cmd.Name, cmd.Enabled, attrs)
+		return NewCategoryWithID(h.limits, cmd.ID.String(), cmd.Name, cmd.Enabled, attrs)
 	}
-	return NewCategory(cmd.Name, cmd.Enabled, attrs)
+	return NewCategory(h.limits, cmd.Name, cmd.Enabled, attrs)
 }
 
 func (h *createCategoryHandler) persistAndPublish(
 	ctx context.Context,
 	c *Category,
-	msg outbox.Message,
+	attrs []*attribute.Attribute,
 ) (*Category, error) {
 	type createResult struct {
 		Category *Category
@@ -124,11 +151,14 @@ func (h *createCategoryHandler) persistAndPublish(
 	}
 
 	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*createResult, error) {
+		if err := h.quotas.Check(txCtx, quota.Categories, 1); err != nil {
+			return nil, err
+		}
 		if err := h.repo.Insert(txCtx, c); err != nil {
 			return nil, fmt.Errorf("failed to insert category: %w", err)
 		}
 
-		send, err := h.outbox.Create(txCtx, msg)
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryUpdatedOutboxMessage(txCtx, c, attrs))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox: %w", err)
 		}
@@ -13,6 +13,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
@@ -43,7 +45,7 @@ func setupCreateCategoryHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockCategoryEventFactory(t)
 
-	handler := NewCreateCategoryHandler(repo, attrRepo, outboxMock, txManager, eventFactory)
+	handler := NewCreateCategoryHandler(repo, attrRepo, outboxMock, txManager, eventFactory, quota.Unlimited(), limits.Default())
 
 	return repo, attrRepo, outboxMock, txManager, eventFactory, handler
 }
@@ -67,15 +69,14 @@ func TestCreateCategoryHandler_Handle_Success(t *testing.T) {
 	}
 
 	// Mock attribute lookup
+	color := attribute.Reconstruct("attr-1", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, time.Now(), time.Now())
 	attrRepo.EXPECT().
 		FindByIDsOrFail(mock.Anything, []string{"attr-1"}).
-		Return([]*attribute.Attribute{
-			attribute.Reconstruct("attr-1", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, time.Now(), time.Now()),
-		}, nil)
+		Return([]*attribute.Attribute{color}, nil)
 
-	// Mock event factory
+	// Mock event factory, it gets the attached attributes to publish their names and types
 	eventFactory.EXPECT().
-		NewCategoryUpdatedOutboxMessage(mock.Anything, mock.AnythingOfType("*category.Category")).
+		NewCategoryUpdatedOutboxMessage(mock.Anything, mock.AnythingOfType("*category.Category"), []*attribute.Attribute{color}).
 		Return(outbox.Message{})
 
 	// Mock transaction
@@ -124,7 +125,7 @@ func TestCreateCategoryHandler_Handle_WithCustomID(t *testing.T) {
 		FindByIDsOrFail(mock.Anything, []string{}).
 		Return([]*attribute.Attribute{}, nil)
 
-	eventFactory.EXPECT().NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	eventFactory.EXPECT().NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -183,8 +184,30 @@ func TestCreateCategoryHandler_Handle_AttributeNotFound(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestCreateCategoryHandler_Handle_VariantRoleOnTextAttribute(t *testing.T) {
+	_, attrRepo, _, _, _, handler := setupCreateCategoryHandler(t)
+
+	cmd := CreateCategoryCommand{
+		Name: "Books",
+		Attributes: []CategoryAttributeInput{
+			{AttributeID: "attr-1", Role: string(AttributeRoleVariant)},
+		},
+	}
+
+	isbn := attribute.Reconstruct("attr-1", 1, "ISBN", "isbn", attribute.AttributeTypeText, nil, true, nil, "", nil, nil, time.Now(), time.Now())
+	attrRepo.EXPECT().
+		FindByIDsOrFail(mock.Anything, []string{"attr-1"}).
+		Return([]*attribute.Attribute{isbn}, nil)
+
+	result, err := handler.Handle(testCtx(), cmd)
+
+	require.ErrorIs(t, err, ErrInvalidCategoryData)
+	assert.Contains(t, err.Error(), "isbn")
+	assert.Nil(t, result)
+}
+
 func TestCreateCategoryHandler_Handle_InsertError(t *testing.T) {
-	repo, attrRepo, _, txManager, eventFactory, handler := setupCreateCategoryHandler(t)
+	repo, attrRepo, _, txManager, _, handler := setupCreateCategoryHandler(t)
 
 	ctx := testCtx()
 	cmd := CreateCategoryCommand{
@@ -197,7 +220,6 @@ func TestCreateCategoryHandler_Handle_InsertError(t *testing.T) {
 		FindByIDsOrFail(mock.Anything, []string{}).
 		Return([]*attribute.Attribute{}, nil)
 
-	eventFactory.EXPECT().NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -226,7 +248,7 @@ func TestCreateCategoryHandler_Handle_OutboxError(t *testing.T) {
 		FindByIDsOrFail(mock.Anything, []string{}).
 		Return([]*attribute.Attribute{}, nil)
 
-	eventFactory.EXPECT().NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	eventFactory.EXPECT().NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
@@ -0,0 +1,69 @@
+package category
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+// DisplayTemplate hints the storefront which layout to render a category page with
+type DisplayTemplate string
+
+const (
+	DisplayTemplateDefault DisplayTemplate = "default"
+	DisplayTemplateGrid    DisplayTemplate = "grid"
+	DisplayTemplateList    DisplayTemplate = "list"
+	DisplayTemplateLanding DisplayTemplate = "landing"
+)
+
+// MaxDescriptionLength is the maximum length of a category description in characters
+const MaxDescriptionLength = 20000
+
+var displayTemplates = []DisplayTemplate{
+	DisplayTemplateDefault,
+	DisplayTemplateGrid,
+	DisplayTemplateList,
+	DisplayTemplateLanding,
+}
+
+// Display holds the storefront presentation of a category page, so the storefront
+// doesn't need a CMS lookup to render it
+type Display struct {
+	ImageID       *string
+	BannerImageID *string
+	Description   *string // HTML, sanitized on write so the storefront can render it as is
+	Template      DisplayTemplate
+}
+
+// ImageIDs returns the media service images referenced by the display metadata
+func (d Display) ImageIDs() []string {
+	var ids []string
+	if d.ImageID != nil {
+		ids = append(ids, *d.ImageID)
+	}
+	if d.BannerImageID != nil && (d.ImageID == nil || *d.BannerImageID != *d.ImageID) {
+		ids = append(ids, *d.BannerImageID)
+	}
+	return ids
+}
+
+// IsEmpty reports whether no display metadata is set
+func (d Display) IsEmpty() bool {
+	return d.ImageID == nil && d.BannerImageID == nil && d.Description == nil && d.Template == ""
+}
+
+func validateDisplay(d Display) error {
+	if d.ImageID != nil && *d.ImageID == "" {
+		return fmt.Errorf("%w: imageID must not be empty", ErrInvalidCategoryData)
+	}
+	if d.BannerImageID != nil && *d.BannerImageID == "" {
+		return fmt.Errorf("%w: bannerImageID must not be empty", ErrInvalidCategoryData)
+	}
+	if d.Description != nil && utf8.RuneCountInString(*d.Description) > MaxDescriptionLength {
+		return fmt.Errorf("%w: description is too long (max %d characters)", ErrInvalidCategoryData, MaxDescriptionLength)
+	}
+	if d.Template != "" && !slices.Contains(displayTemplates, d.Template) {
+		return fmt.Errorf("%w: unknown display template %q", ErrInvalidCategoryData, d.Template)
+	}
+	return nil
+}
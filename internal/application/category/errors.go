@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrInvalidCategoryData = errors.New("invalid category data")
+	ErrImageNotFound       = errors.New("image not found")
 )
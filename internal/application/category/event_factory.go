@@ -3,10 +3,13 @@ package category
 import (
 	"context"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 )
 
 // CategoryEventFactory creates category events
 type CategoryEventFactory interface {
-	NewCategoryUpdatedOutboxMessage(ctx context.Context, c *Category) outbox.Message
+	// NewCategoryUpdatedOutboxMessage builds the event for c; attrs are the attributes
+	// attached to the category, used to enrich the event so consumers don't have to look them up
+	NewCategoryUpdatedOutboxMessage(ctx context.Context, c *Category, attrs []*attribute.Attribute) outbox.Message
 }
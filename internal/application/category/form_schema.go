@@ -0,0 +1,129 @@
+package category
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// FormField describes the input of a category attribute on the product form
+type FormField struct {
+	AttributeID string
+	Slug        string
+	Name        string
+	Type        attribute.AttributeType
+	DisplayType attribute.DisplayType
+	Role        AttributeRole
+	// Required fields must be set before the product is enabled
+	Required bool
+	// Options of single and multiple attributes by sort order; deprecated ones should only stay
+	// selectable on products already using them
+	Options []attribute.Option
+	// Unit and InputUnits are the units range values may be entered in, Unit being the canonical one
+	Unit       *string
+	InputUnits []attribute.UnitConversion
+}
+
+// FormConstraints are the limits of the product fields shared by every category
+type FormConstraints struct {
+	NameMaxLength          int
+	SlugMaxLength          int
+	MaxAttributeValues     int
+	MaxMetadataKeys        int
+	MetadataValueMaxLength int
+}
+
+// ProductFormSchema describes the product form of a category, so admin UIs can render it from
+// the category and attribute definitions
+type ProductFormSchema struct {
+	CategoryID      string
+	CategoryVersion int
+	// Fields follow the sort order of the category attributes
+	Fields      []FormField
+	Constraints FormConstraints
+}
+
+type GetProductFormSchemaQuery struct {
+	CategoryID string
+}
+
+type GetProductFormSchemaQueryHandler interface {
+	// Handle returns the product form of the category; attributes deleted since they were added to the
+	// category are left out. It returns mongo.ErrEntityNotFound for an unknown category.
+	Handle(ctx context.Context, query GetProductFormSchemaQuery) (*ProductFormSchema, error)
+}
+
+type getProductFormSchemaHandler struct {
+	repo     Repository
+	attrRepo attribute.Repository
+	limits   limits.Product
+}
+
+func NewGetProductFormSchemaHandler(repo Repository, attrRepo attribute.Repository, l limits.Limits) GetProductFormSchemaQueryHandler {
+	return &getProductFormSchemaHandler{repo: repo, attrRepo: attrRepo, limits: l.Product}
+}
+
+func (h *getProductFormSchemaHandler) Handle(ctx context.Context, query GetProductFormSchemaQuery) (*ProductFormSchema, error) {
+	c, err := h.repo.FindByID(ctx, query.CategoryID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	attrs, err := h.attrRepo.FindByIDs(ctx, lo.Map(c.Attributes, func(a CategoryAttribute, _ int) string { return a.AttributeID }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	return buildProductFormSchema(c, lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID }), h.limits), nil
+}
+
+func buildProductFormSchema(c *Category, attrs map[string]*attribute.Attribute, l limits.Product) *ProductFormSchema {
+	categoryAttrs := slices.Clone(c.Attributes)
+	slices.SortStableFunc(categoryAttrs, func(a, b CategoryAttribute) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+
+	fields := make([]FormField, 0, len(categoryAttrs))
+	for _, ca := range categoryAttrs {
+		a, ok := attrs[ca.AttributeID]
+		if !ok {
+			continue
+		}
+		options := slices.Clone(a.Options)
+		slices.SortStableFunc(options, func(x, y attribute.Option) int { return cmp.Compare(x.SortOrder, y.SortOrder) })
+		fields = append(fields, FormField{
+			AttributeID: a.ID,
+			Slug:        a.Slug,
+			Name:        a.Name,
+			Type:        a.Type,
+			DisplayType: a.DisplayType,
+			Role:        ca.Role,
+			Required:    ca.Required,
+			Options:     options,
+			Unit:        a.Unit,
+			InputUnits:  a.InputUnits,
+		})
+	}
+
+	return &ProductFormSchema{
+		CategoryID:      c.ID,
+		CategoryVersion: c.Version,
+		Fields:          fields,
+		Constraints: FormConstraints{
+			NameMaxLength:          l.NameLength,
+			SlugMaxLength:          l.SlugLength,
+			MaxAttributeValues:     l.AttributeValues,
+			MaxMetadataKeys:        l.MetadataKeys,
+			MetadataValueMaxLength: l.MetadataValueLength,
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package category
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestGetProductFormSchemaHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	attrRepo := attribute.NewMockRepository(t)
+	handler := NewGetProductFormSchemaHandler(repo, attrRepo, limits.Default())
+
+	now := time.Now()
+	kg := "kg"
+	color := attribute.Reconstruct("color", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, []attribute.Option{
+		{Name: "Red", Slug: "red", SortOrder: 2},
+		{Name: "Blue", Slug: "blue", SortOrder: 1, Deprecated: true},
+	}, "", nil, nil, now, now)
+	weight := attribute.Reconstruct("weight", 1, "Weight", "weight", attribute.AttributeTypeRange, &kg, true, nil, "", nil,
+		[]attribute.UnitConversion{{Unit: "g", Factor: 0.001}}, now, now)
+	c := Reconstruct("shirts", 3, "Shirts", true, []CategoryAttribute{
+		{AttributeID: "weight", Slug: "weight", Role: AttributeRoleSpecification, SortOrder: 2},
+		{AttributeID: "deleted", Slug: "deleted", Role: AttributeRoleSpecification, SortOrder: 3},
+		{AttributeID: "color", Slug: "color", Role: AttributeRoleVariant, SortOrder: 1, Required: true},
+	}, Display{}, now, now)
+
+	repo.EXPECT().FindByID(mock.Anything, "shirts").Return(c, nil)
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"weight", "deleted", "color"}).Return([]*attribute.Attribute{color, weight}, nil)
+
+	schema, err := handler.Handle(context.Background(), GetProductFormSchemaQuery{CategoryID: "shirts"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "shirts", schema.CategoryID)
+	assert.Equal(t, 3, schema.CategoryVersion)
+	require.Len(t, schema.Fields, 2, "deleted attributes are left out")
+
+	assert.Equal(t, FormField{
+		AttributeID: "color",
+		Slug:        "color",
+		Name:        "Color",
+		Type:        attribute.AttributeTypeSingle,
+		DisplayType: attribute.DisplayTypeDropdown,
+		Role:        AttributeRoleVariant,
+		Required:    true,
+		Options: []attribute.Option{
+			{Name: "Blue", Slug: "blue", SortOrder: 1, Deprecated: true},
+			{Name: "Red", Slug: "red", SortOrder: 2},
+		},
+	}, schema.Fields[0])
+	assert.Equal(t, "weight", schema.Fields[1].Slug)
+	assert.Equal(t, &kg, schema.Fields[1].Unit)
+	assert.Equal(t, []attribute.UnitConversion{{Unit: "g", Factor: 0.001}}, schema.Fields[1].InputUnits)
+
+	l := limits.Default().Product
+	assert.Equal(t, FormConstraints{
+		NameMaxLength:          l.NameLength,
+		SlugMaxLength:          l.SlugLength,
+		MaxAttributeValues:     l.AttributeValues,
+		MaxMetadataKeys:        l.MetadataKeys,
+		MetadataValueMaxLength: l.MetadataValueLength,
+	}, schema.Constraints)
+}
+
+func TestGetProductFormSchemaHandler_Handle_NotFound(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetProductFormSchemaHandler(repo, attribute.NewMockRepository(t), limits.Default())
+
+	repo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), GetProductFormSchemaQuery{CategoryID: "missing"})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
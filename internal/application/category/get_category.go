@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
 
 type GetCategoryByIDQuery struct {
 	ID string
+	// AsOf reads the category as it was at the given time instead of its current state
+	AsOf *time.Time
 }
 
 type GetCategoryByIDQueryHandler interface {
@@ -25,7 +28,13 @@ func NewGetCategoryByIDHandler(repo Repository) GetCategoryByIDQueryHandler {
 }
 
 func (h *getCategoryByIDHandler) Handle(ctx context.Context, query GetCategoryByIDQuery) (*Category, error) {
-	c, err := h.repo.FindByID(ctx, query.ID)
+	var c *Category
+	var err error
+	if query.AsOf != nil {
+		c, err = h.repo.FindAsOf(ctx, query.ID, *query.AsOf)
+	} else {
+		c, err = h.repo.FindByID(ctx, query.ID)
+	}
 	if err != nil {
 		if errors.Is(err, mongo.ErrEntityNotFound) {
 			return nil, mongo.ErrEntityNotFound
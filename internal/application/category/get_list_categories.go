@@ -3,6 +3,7 @@ package category
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type GetListCategoriesQuery struct {
@@ -11,6 +12,8 @@ type GetListCategoriesQuery struct {
 	Enabled *bool
 	Sort    string
 	Order   string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
 }
 
 type ListCategoriesResult struct {
@@ -33,7 +36,14 @@ func NewGetListCategoriesHandler(repo Repository) GetListCategoriesQueryHandler
 }
 
 func (h *getListCategoriesHandler) Handle(ctx context.Context, query GetListCategoriesQuery) (*ListCategoriesResult, error) {
-	listQuery := ListQuery(query)
+	listQuery := ListQuery{
+		Page:          query.Page,
+		Size:          query.Size,
+		Enabled:       query.Enabled,
+		ModifiedAfter: query.ModifiedAfter,
+		Sort:          query.Sort,
+		Order:         query.Order,
+	}
 
 	result, err := h.repo.FindList(ctx, listQuery)
 	if err != nil {
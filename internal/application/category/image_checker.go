@@ -0,0 +1,9 @@
+package category
+
+import "context"
+
+// ImageChecker verifies images referenced by categories against the media service
+type ImageChecker interface {
+	// MissingImages returns the IDs from imageIDs that the media service doesn't know
+	MissingImages(ctx context.Context, imageIDs []string) ([]string, error)
+}
@@ -7,6 +7,7 @@ package category
 import (
 	"context"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,16 +40,16 @@ func (_m *MockCategoryEventFactory) EXPECT() *MockCategoryEventFactory_Expecter
 }
 
 // NewCategoryUpdatedOutboxMessage provides a mock function for the type MockCategoryEventFactory
-func (_mock *MockCategoryEventFactory) NewCategoryUpdatedOutboxMessage(ctx context.Context, c *Category) outbox.Message {
-	ret := _mock.Called(ctx, c)
+func (_mock *MockCategoryEventFactory) NewCategoryUpdatedOutboxMessage(ctx context.Context, c *Category, attrs []*attribute.Attribute) outbox.Message {
+	ret := _mock.Called(ctx, c, attrs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewCategoryUpdatedOutboxMessage")
 	}
 
 	var r0 outbox.Message
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *Category) outbox.Message); ok {
-		r0 = returnFunc(ctx, c)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Category, []*attribute.Attribute) outbox.Message); ok {
+		r0 = returnFunc(ctx, c, attrs)
 	} else {
 		r0 = ret.Get(0).(outbox.Message)
 	}
@@ -63,11 +64,12 @@ type MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call struct {
 // NewCategoryUpdatedOutboxMessage is a helper method to define mock.On call
 //   - ctx context.Context
 //   - c *Category
-func (_e *MockCategoryEventFactory_Expecter) NewCategoryUpdatedOutboxMessage(ctx interface{}, c interface{}) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
-	return &MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call{Call: _e.mock.On("NewCategoryUpdatedOutboxMessage", ctx, c)}
+//   - attrs []*attribute.Attribute
+func (_e *MockCategoryEventFactory_Expecter) NewCategoryUpdatedOutboxMessage(ctx interface{}, c interface{}, attrs interface{}) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
+	return &MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call{Call: _e.mock.On("NewCategoryUpdatedOutboxMessage", ctx, c, attrs)}
 }
 
-func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) Run(run func(ctx context.Context, c *Category)) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
+func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) Run(run func(ctx context.Context, c *Category, attrs []*attribute.Attribute)) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -77,9 +79,14 @@ func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) Run(run
 		if args[1] != nil {
 			arg1 = args[1].(*Category)
 		}
+		var arg2 []*attribute.Attribute
+		if args[2] != nil {
+			arg2 = args[2].([]*attribute.Attribute)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -90,7 +97,7 @@ func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) Return(
 	return _c
 }
 
-func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, c *Category) outbox.Message) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
+func (_c *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, c *Category, attrs []*attribute.Attribute) outbox.Message) *MockCategoryEventFactory_NewCategoryUpdatedOutboxMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
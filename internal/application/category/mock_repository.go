@@ -6,6 +6,7 @@ package category
 
 import (
 	"context"
+	"time"
 
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	mock "github.com/stretchr/testify/mock"
@@ -104,6 +105,80 @@ func (_c *MockRepository_Exists_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// FindAsOf provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindAsOf(ctx context.Context, id string, asOf time.Time) (*Category, error) {
+	ret := _mock.Called(ctx, id, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAsOf")
+	}
+
+	var r0 *Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (*Category, error)); ok {
+		return returnFunc(ctx, id, asOf)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) *Category); ok {
+		r0 = returnFunc(ctx, id, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAsOf'
+type MockRepository_FindAsOf_Call struct {
+	*mock.Call
+}
+
+// FindAsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - asOf time.Time
+func (_e *MockRepository_Expecter) FindAsOf(ctx interface{}, id interface{}, asOf interface{}) *MockRepository_FindAsOf_Call {
+	return &MockRepository_FindAsOf_Call{Call: _e.mock.On("FindAsOf", ctx, id, asOf)}
+}
+
+func (_c *MockRepository_FindAsOf_Call) Run(run func(ctx context.Context, id string, asOf time.Time)) *MockRepository_FindAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAsOf_Call) Return(category1 *Category, err error) *MockRepository_FindAsOf_Call {
+	_c.Call.Return(category1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAsOf_Call) RunAndReturn(run func(ctx context.Context, id string, asOf time.Time) (*Category, error)) *MockRepository_FindAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function for the type MockRepository
 func (_mock *MockRepository) FindByID(ctx context.Context, id string) (*Category, error) {
 	ret := _mock.Called(ctx, id)
@@ -207,7 +282,7 @@ type MockRepository_FindList_Call struct {
 
 // FindList is a helper method to define mock.On call
 //   - ctx context.Context
-//   - query category.ListQuery
+//   - query ListQuery
 func (_e *MockRepository_Expecter) FindList(ctx interface{}, query interface{}) *MockRepository_FindList_Call {
 	return &MockRepository_FindList_Call{Call: _e.mock.On("FindList", ctx, query)}
 }
@@ -264,7 +339,7 @@ type MockRepository_Insert_Call struct {
 
 // Insert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - category1 *category.Category
+//   - category1 *Category
 func (_e *MockRepository_Expecter) Insert(ctx interface{}, category1 interface{}) *MockRepository_Insert_Call {
 	return &MockRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, category1)}
 }
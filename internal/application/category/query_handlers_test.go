@@ -30,6 +30,7 @@ func createTestCategoryWithParams(id, name string, enabled bool) *Category {
 				Searchable:  true,
 			},
 		},
+		Display{},
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -91,6 +92,23 @@ func TestGetCategoryByIDHandler_Handle_RepositoryError(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGetCategoryByIDHandler_Handle_AsOf(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetCategoryByIDHandler(repo)
+
+	asOf := time.Now().UTC().Add(-time.Hour)
+	past := createTestCategoryWithParams("category-123", "Gadgets", false)
+
+	repo.EXPECT().
+		FindAsOf(mock.Anything, "category-123", asOf).
+		Return(past, nil)
+
+	result, err := handler.Handle(context.Background(), GetCategoryByIDQuery{ID: "category-123", AsOf: &asOf})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Gadgets", result.Name)
+}
+
 // === GetListCategoriesHandler Tests ===
 
 func TestGetListCategoriesHandler_Handle_Success(t *testing.T) {
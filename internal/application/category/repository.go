@@ -2,6 +2,7 @@ package category
 
 import (
 	"context"
+	"time"
 
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
@@ -10,8 +11,12 @@ type ListQuery struct {
 	Page    int
 	Size    int
 	Enabled *bool
+	// AfterID restricts the list to IDs greater than the given one, for keyset pagination sorted by "_id"
+	AfterID string
 	Sort    string
 	Order   string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
 }
 
 type Repository interface {
@@ -19,6 +24,10 @@ type Repository interface {
 
 	FindByID(ctx context.Context, id string) (*Category, error)
 
+	// FindAsOf returns the state the category had at the given time, from its revision history.
+	// It returns commonsmongo.ErrEntityNotFound if it didn't exist at that time.
+	FindAsOf(ctx context.Context, id string, asOf time.Time) (*Category, error)
+
 	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[Category], error)
 
 	Update(ctx context.Context, category *Category) (*Category, error)
@@ -0,0 +1,162 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// resyncPageSize is the number of categories loaded per page while scanning them
+const resyncPageSize = 100
+
+// ResyncAttributeSlugs copies the slugs of the attributes into the category, as they are copied when an
+// attribute is linked, and reports whether a copy was stale. Attributes missing from attrs are left alone.
+func (c *Category) ResyncAttributeSlugs(attrs map[string]*attribute.Attribute) bool {
+	changed := false
+	for i, ca := range c.Attributes {
+		if a, ok := attrs[ca.AttributeID]; ok && a.Slug != ca.Slug {
+			c.Attributes[i].Slug = a.Slug
+			changed = true
+		}
+	}
+	if changed {
+		c.ModifiedAt = utc.NowAfter(c.ModifiedAt)
+	}
+	return changed
+}
+
+type ResyncAttributeSlugsCommand struct {
+	// ItemFailed, if set, is called for a category that couldn't be rewritten and the scan goes on;
+	// otherwise the first failure stops it
+	ItemFailed func(categoryID string, err error)
+}
+
+type ResyncAttributeSlugsCommandHandler interface {
+	// Handle scans the categories of the current tenant and rewrites those holding a stale copy of an attribute
+	// slug, with their update events. It returns the number of categories rewritten.
+	Handle(ctx context.Context, cmd ResyncAttributeSlugsCommand) (int, error)
+}
+
+type resyncAttributeSlugsHandler struct {
+	repo         Repository
+	attrRepo     attribute.Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory CategoryEventFactory
+}
+
+func NewResyncAttributeSlugsHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory CategoryEventFactory,
+) ResyncAttributeSlugsCommandHandler {
+	return &resyncAttributeSlugsHandler{
+		repo:         repo,
+		attrRepo:     attrRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *resyncAttributeSlugsHandler) Handle(ctx context.Context, cmd ResyncAttributeSlugsCommand) (int, error) {
+	count := 0
+	afterID := ""
+	for {
+		page, err := h.repo.FindList(ctx, ListQuery{AfterID: afterID, Size: resyncPageSize, Sort: "_id"})
+		if err != nil {
+			return count, fmt.Errorf("failed to list categories: %w", err)
+		}
+
+		resynced, err := h.resync(ctx, page.Items, cmd.ItemFailed)
+		count += resynced
+		if err != nil {
+			return count, err
+		}
+
+		if len(page.Items) < resyncPageSize {
+			return count, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+// resync rewrites the categories of a page with stale slugs one by one. It returns the number of categories
+// rewritten. A category changed concurrently is skipped: the change copied the slugs again.
+func (h *resyncAttributeSlugsHandler) resync(ctx context.Context, page []*Category, itemFailed func(string, error)) (int, error) {
+	ids := lo.Uniq(lo.FlatMap(page, func(c *Category, _ int) []string {
+		return lo.Map(c.Attributes, func(ca CategoryAttribute, _ int) string { return ca.AttributeID })
+	}))
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	attrs, err := h.attrRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID })
+
+	count := 0
+	for _, c := range page {
+		if !c.ResyncAttributeSlugs(attrMap) {
+			continue
+		}
+		err := h.persistAndPublish(ctx, c, attrMap)
+		switch {
+		case err == nil:
+			count++
+			h.log(ctx).Debug("attribute slugs resynced", zap.String("id", c.ID))
+		case errors.Is(err, mongo.ErrOptimisticLocking):
+			h.log(ctx).Debug("category changed concurrently, skipped", zap.String("id", c.ID))
+		case itemFailed != nil:
+			itemFailed(c.ID, err)
+		default:
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+func (h *resyncAttributeSlugsHandler) persistAndPublish(ctx context.Context, c *Category, attrMap map[string]*attribute.Attribute) error {
+	attrs := lo.FilterMap(c.Attributes, func(ca CategoryAttribute, _ int) (*attribute.Attribute, bool) {
+		a, ok := attrMap[ca.AttributeID]
+		return a, ok
+	})
+
+	send, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (outbox.SendFunc, error) {
+		updated, err := h.repo.Update(txCtx, c)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, mongo.ErrOptimisticLocking
+			}
+			return nil, fmt.Errorf("failed to update category: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryUpdatedOutboxMessage(txCtx, updated, attrs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+		return send, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	return nil
+}
+
+func (h *resyncAttributeSlugsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "resync-attribute-slugs-handler"))
+}
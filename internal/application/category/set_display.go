@@ -0,0 +1,157 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+// SetCategoryDisplayCommand replaces the storefront display metadata of a category
+type SetCategoryDisplayCommand struct {
+	ID            string
+	Version       int
+	ImageID       *string
+	BannerImageID *string
+	Description   *string
+	Template      string
+}
+
+// SetCategoryDisplayCommandHandler defines the interface for changing category display metadata
+type SetCategoryDisplayCommandHandler interface {
+	Handle(ctx context.Context, cmd SetCategoryDisplayCommand) (*Category, error)
+}
+
+type setCategoryDisplayHandler struct {
+	repo         Repository
+	attrRepo     attribute.Repository
+	imageChecker ImageChecker
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory CategoryEventFactory
+}
+
+func NewSetCategoryDisplayHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	imageChecker ImageChecker,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory CategoryEventFactory,
+) SetCategoryDisplayCommandHandler {
+	return &setCategoryDisplayHandler{
+		repo:         repo,
+		attrRepo:     attrRepo,
+		imageChecker: imageChecker,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *setCategoryDisplayHandler) Handle(ctx context.Context, cmd SetCategoryDisplayCommand) (*Category, error) {
+	c, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	if c.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := c.ChangeDisplay(Display{
+		ImageID:       cmd.ImageID,
+		BannerImageID: cmd.BannerImageID,
+		Description:   cmd.Description,
+		Template:      DisplayTemplate(cmd.Template),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to change category display: %w", err)
+	}
+
+	if err := h.checkImages(ctx, c.Display); err != nil {
+		return nil, err
+	}
+
+	// The event is enriched with the attached attributes like any other category update
+	attrs, err := h.attrRepo.FindByIDsOrFail(ctx, lo.Map(c.Attributes, func(a CategoryAttribute, _ int) string {
+		return a.AttributeID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return h.persistAndPublish(ctx, c, attrs)
+}
+
+func (h *setCategoryDisplayHandler) checkImages(ctx context.Context, d Display) error {
+	imageIDs := d.ImageIDs()
+	if len(imageIDs) == 0 {
+		return nil
+	}
+
+	missing, err := h.imageChecker.MissingImages(ctx, imageIDs)
+	if err != nil {
+		return fmt.Errorf("failed to check images: %w", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrImageNotFound, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (h *setCategoryDisplayHandler) persistAndPublish(
+	ctx context.Context,
+	c *Category,
+	attrs []*attribute.Attribute,
+) (*Category, error) {
+	type updateResult struct {
+		Category *Category
+		Send     outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*updateResult, error) {
+		updated, err := h.repo.Update(txCtx, c)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, mongo.ErrOptimisticLocking
+			}
+			return nil, fmt.Errorf("failed to update category: %w", err)
+		}
+
+		msg := h.eventFactory.NewCategoryUpdatedOutboxMessage(txCtx, updated, attrs)
+
+		send, err := h.outbox.Create(txCtx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return &updateResult{
+			Category: updated,
+			Send:     send,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("category display updated", zap.String("id", res.Category.ID))
+
+	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return res.Category, nil
+}
+
+func (h *setCategoryDisplayHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "set-category-display-handler"))
+}
@@ -0,0 +1,177 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// setupSetCategoryDisplayHandler creates handler with mocked dependencies
+func setupSetCategoryDisplayHandler(t *testing.T) (
+	*MockRepository,
+	*attribute.MockRepository,
+	*MockImageChecker,
+	*mocks.MockOutbox,
+	*mocks.MockTxManager,
+	*MockCategoryEventFactory,
+	SetCategoryDisplayCommandHandler,
+) {
+	repo := NewMockRepository(t)
+	attrRepo := attribute.NewMockRepository(t)
+	imageChecker := NewMockImageChecker(t)
+	outboxMock := mocks.NewMockOutbox(t)
+	txManager := mocks.NewMockTxManager(t)
+	eventFactory := NewMockCategoryEventFactory(t)
+
+	handler := NewSetCategoryDisplayHandler(repo, attrRepo, imageChecker, outboxMock, txManager, eventFactory)
+
+	return repo, attrRepo, imageChecker, outboxMock, txManager, eventFactory, handler
+}
+
+func TestSetCategoryDisplayHandler_Handle_Success(t *testing.T) {
+	repo, attrRepo, imageChecker, outboxMock, txManager, eventFactory, handler := setupSetCategoryDisplayHandler(t)
+
+	ctx := testCtx()
+	existingCategory := createTestCategory()
+	imageID, description := "image-1", "<p>Phones</p>"
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	imageChecker.EXPECT().
+		MissingImages(mock.Anything, []string{imageID}).
+		Return(nil, nil)
+
+	attrRepo.EXPECT().
+		FindByIDsOrFail(mock.Anything, []string{"attr-1"}).
+		Return([]*attribute.Attribute{
+			attribute.Reconstruct("attr-1", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, time.Now(), time.Now()),
+		}, nil)
+
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+
+	repo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*category.Category")).
+		RunAndReturn(func(_ context.Context, c *Category) (*Category, error) {
+			return c, nil
+		})
+
+	eventFactory.EXPECT().
+		NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything, mock.Anything).
+		Return(outbox.Message{})
+
+	outboxMock.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(mockSendFunc, nil)
+
+	result, err := handler.Handle(ctx, SetCategoryDisplayCommand{
+		ID:          existingCategory.ID,
+		Version:     existingCategory.Version,
+		ImageID:     &imageID,
+		Description: &description,
+		Template:    string(DisplayTemplateGrid),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &imageID, result.Display.ImageID)
+	assert.Equal(t, DisplayTemplateGrid, result.Display.Template)
+	assert.Equal(t, "Original Category", result.Name, "the rest of the category is kept")
+}
+
+func TestSetCategoryDisplayHandler_Handle_ImageNotFound(t *testing.T) {
+	repo, _, imageChecker, _, _, _, handler := setupSetCategoryDisplayHandler(t)
+
+	existingCategory := createTestCategory()
+	bannerID := "banner-1"
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	imageChecker.EXPECT().
+		MissingImages(mock.Anything, []string{bannerID}).
+		Return([]string{bannerID}, nil)
+
+	_, err := handler.Handle(testCtx(), SetCategoryDisplayCommand{
+		ID:            existingCategory.ID,
+		Version:       existingCategory.Version,
+		BannerImageID: &bannerID,
+	})
+
+	require.ErrorIs(t, err, ErrImageNotFound)
+	assert.Contains(t, err.Error(), bannerID)
+}
+
+func TestSetCategoryDisplayHandler_Handle_MediaServiceError(t *testing.T) {
+	repo, _, imageChecker, _, _, _, handler := setupSetCategoryDisplayHandler(t)
+
+	existingCategory := createTestCategory()
+	imageID := "image-1"
+	mediaErr := errors.New("media service unavailable")
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	imageChecker.EXPECT().
+		MissingImages(mock.Anything, []string{imageID}).
+		Return(nil, mediaErr)
+
+	_, err := handler.Handle(testCtx(), SetCategoryDisplayCommand{
+		ID:      existingCategory.ID,
+		Version: existingCategory.Version,
+		ImageID: &imageID,
+	})
+
+	require.ErrorIs(t, err, mediaErr)
+}
+
+func TestSetCategoryDisplayHandler_Handle_InvalidTemplate(t *testing.T) {
+	repo, _, _, _, _, _, handler := setupSetCategoryDisplayHandler(t)
+
+	existingCategory := createTestCategory()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	_, err := handler.Handle(testCtx(), SetCategoryDisplayCommand{
+		ID:       existingCategory.ID,
+		Version:  existingCategory.Version,
+		Template: "carousel",
+	})
+
+	require.ErrorIs(t, err, ErrInvalidCategoryData)
+}
+
+func TestSetCategoryDisplayHandler_Handle_StaleVersion(t *testing.T) {
+	repo, _, _, _, _, _, handler := setupSetCategoryDisplayHandler(t)
+
+	existingCategory := createTestCategory()
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	_, err := handler.Handle(testCtx(), SetCategoryDisplayCommand{
+		ID:      existingCategory.ID,
+		Version: existingCategory.Version + 1,
+	})
+
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+}
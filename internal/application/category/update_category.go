@@ -8,6 +8,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -34,6 +35,7 @@ type updateCategoryHandler struct {
 	outbox       outbox.Outbox
 	txManager    mongo.TxManager
 	eventFactory CategoryEventFactory
+	limits       limits.Category
 }
 
 func NewUpdateCategoryHandler(
@@ -42,6 +44,7 @@ func NewUpdateCategoryHandler(
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory CategoryEventFactory,
+	l limits.Limits,
 ) UpdateCategoryCommandHandler {
 	return &updateCategoryHandler{
 		repo:         repo,
@@ -49,6 +52,7 @@ func NewUpdateCategoryHandler(
 		outbox:       outbox,
 		txManager:    txManager,
 		eventFactory: eventFactory,
+		limits:       l.Category,
 	}
 }
 
@@ -58,16 +62,16 @@ func (h *updateCategoryHandler) Handle(ctx context.Context, cmd UpdateCategoryCo
 		return nil, err
 	}
 
-	categoryAttrs, err := h.buildCategoryAttributes(ctx, cmd.Attributes)
+	categoryAttrs, attrs, err := h.buildCategoryAttributes(ctx, cmd.Attributes)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := c.Update(cmd.Name, cmd.Enabled, categoryAttrs); err != nil {
+	if err := c.Update(h.limits, cmd.Name, cmd.Enabled, categoryAttrs); err != nil {
 		return nil, fmt.Errorf("failed to update category: %w", err)
 	}
 
-	return h.persistAndPublish(ctx, c)
+	return h.persistAndPublish(ctx, c, attrs)
 }
 
 func (h *updateCategoryHandler) findAndValidateCategory(ctx context.Context, id string, version int) (*Category, error) {
@@ -86,20 +90,24 @@ func (h *updateCategoryHandler) findAndValidateCategory(ctx context.Context, id
 	return c, nil
 }
 
-func (h *updateCategoryHandler) buildCategoryAttributes(ctx context.Context, inputs []CategoryAttributeInput) ([]CategoryAttribute, error) {
+func (h *updateCategoryHandler) buildCategoryAttributes(ctx context.Context, inputs []CategoryAttributeInput) ([]CategoryAttribute, []*attribute.Attribute, error) {
 	attrIDs := lo.Map(inputs, func(attr CategoryAttributeInput, _ int) string {
 		return attr.AttributeID
 	})
 
 	attrs, err := h.attrRepo.FindByIDsOrFail(ctx, attrIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
 		return a.ID
 	})
 
+	if err := validateVariantRoles(inputs, attrMap); err != nil {
+		return nil, nil, err
+	}
+
 	return lo.Map(inputs, func(attr CategoryAttributeInput, _ int) CategoryAttribute {
 		slug := ""
 		if a, ok := attrMap[attr.AttributeID]; ok {
@@ -112,13 +120,15 @@ func (h *updateCategoryHandler) buildCategoryAttributes(ctx context.Context, inp
 			SortOrder:   attr.SortOrder,
 			Filterable:  attr.Filterable,
 			Searchable:  attr.Searchable,
+			Required:    attr.Required,
 		}
-	}), nil
+	}), attrs, nil
 }
 
 func (h *updateCategoryHandler) persistAndPublish(
 	ctx context.Context,
 	c *Category,
+	attrs []*attribute.Attribute,
 ) (*Category, error) {
 	type updateResult struct {
 		Category *Category
@@ -134,7 +144,7 @@ func (h *updateCategoryHandler) persistAndPublish(
 			return nil, fmt.Errorf("failed to update category: %w", err)
 		}
 
-		msg := h.eventFactory.NewCategoryUpdatedOutboxMessage(txCtx, updated)
+		msg := h.eventFactory.NewCategoryUpdatedOutboxMessage(txCtx, updated, attrs)
 
 		send, err := h.outbox.Create(txCtx, msg)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -33,6 +34,7 @@ func createTestCategory() *Category {
 				Searchable:  true,
 			},
 		},
+		Display{},
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -53,7 +55,7 @@ func setupUpdateCategoryHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockCategoryEventFactory(t)
 
-	handler := NewUpdateCategoryHandler(repo, attrRepo, outboxMock, txManager, eventFactory)
+	handler := NewUpdateCategoryHandler(repo, attrRepo, outboxMock, txManager, eventFactory, limits.Default())
 
 	return repo, attrRepo, outboxMock, txManager, eventFactory, handler
 }
@@ -86,11 +88,10 @@ func TestUpdateCategoryHandler_Handle_Success(t *testing.T) {
 		Return(existingCategory, nil)
 
 	// Mock attribute lookup
+	size := attribute.Reconstruct("attr-2", 1, "Size", "size", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, time.Now(), time.Now())
 	attrRepo.EXPECT().
 		FindByIDsOrFail(mock.Anything, []string{"attr-2"}).
-		Return([]*attribute.Attribute{
-			attribute.Reconstruct("attr-2", 1, "Size", "size", attribute.AttributeTypeSingle, nil, true, nil, time.Now(), time.Now()),
-		}, nil)
+		Return([]*attribute.Attribute{size}, nil)
 
 	// Mock transaction
 	txManager.EXPECT().
@@ -106,9 +107,9 @@ func TestUpdateCategoryHandler_Handle_Success(t *testing.T) {
 			return c, nil
 		})
 
-	// Mock event factory
+	// Mock event factory, it gets the attached attributes to publish their names and types
 	eventFactory.EXPECT().
-		NewCategoryUpdatedOutboxMessage(mock.Anything, mock.Anything).
+		NewCategoryUpdatedOutboxMessage(mock.Anything, mock.AnythingOfType("*category.Category"), []*attribute.Attribute{size}).
 		Return(outbox.Message{})
 
 	// Mock outbox
@@ -229,6 +230,34 @@ func TestUpdateCategoryHandler_Handle_AttributeNotFound(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestUpdateCategoryHandler_Handle_VariantRoleOnRangeAttribute(t *testing.T) {
+	repo, attrRepo, _, _, _, handler := setupUpdateCategoryHandler(t)
+
+	existingCategory := createTestCategory()
+	cmd := UpdateCategoryCommand{
+		ID:      existingCategory.ID,
+		Version: existingCategory.Version,
+		Name:    "Updated Category",
+		Attributes: []CategoryAttributeInput{
+			{AttributeID: "attr-weight", Role: string(AttributeRoleVariant)},
+		},
+	}
+
+	repo.EXPECT().
+		FindByID(mock.Anything, existingCategory.ID).
+		Return(existingCategory, nil)
+
+	weight := attribute.Reconstruct("attr-weight", 1, "Weight", "weight", attribute.AttributeTypeRange, nil, true, nil, "", nil, nil, time.Now(), time.Now())
+	attrRepo.EXPECT().
+		FindByIDsOrFail(mock.Anything, []string{"attr-weight"}).
+		Return([]*attribute.Attribute{weight}, nil)
+
+	result, err := handler.Handle(testCtx(), cmd)
+
+	require.ErrorIs(t, err, ErrInvalidCategoryData)
+	assert.Nil(t, result)
+}
+
 func TestUpdateCategoryHandler_Handle_UpdateRepositoryError(t *testing.T) {
 	repo, attrRepo, _, txManager, _, handler := setupUpdateCategoryHandler(t)
 
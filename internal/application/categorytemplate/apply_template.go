@@ -0,0 +1,202 @@
+package categorytemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ApplyTemplateCommand struct {
+	Template string
+	// CategoryName names the created category; the template name is used when empty
+	CategoryName string
+	Enabled      bool
+}
+
+type ApplyTemplateResult struct {
+	Category *category.Category
+	// Attributes lists the attributes of the category in template order
+	Attributes []*attribute.Attribute
+	// CreatedAttributes is the number of attributes created; the others already existed
+	CreatedAttributes int
+}
+
+type ApplyTemplateCommandHandler interface {
+	// Handle creates the attributes of the template and a category using them in one transaction.
+	// Attributes whose slug already exists are reused unchanged, so verticals can share attributes such as brand.
+	Handle(ctx context.Context, cmd ApplyTemplateCommand) (*ApplyTemplateResult, error)
+}
+
+type applyTemplateHandler struct {
+	categoryRepo    category.Repository
+	attributeRepo   attribute.Repository
+	outbox          outbox.Outbox
+	txManager       mongo.TxManager
+	categoryEvents  category.CategoryEventFactory
+	attributeEvents attribute.AttributeEventFactory
+	quotas          quota.Enforcer
+	limits          limits.Limits
+}
+
+func NewApplyTemplateHandler(
+	categoryRepo category.Repository,
+	attributeRepo attribute.Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	categoryEvents category.CategoryEventFactory,
+	attributeEvents attribute.AttributeEventFactory,
+	quotas quota.Enforcer,
+	l limits.Limits,
+) ApplyTemplateCommandHandler {
+	return &applyTemplateHandler{
+		categoryRepo:    categoryRepo,
+		attributeRepo:   attributeRepo,
+		outbox:          outbox,
+		txManager:       txManager,
+		categoryEvents:  categoryEvents,
+		attributeEvents: attributeEvents,
+		quotas:          quotas,
+		limits:          l,
+	}
+}
+
+func (h *applyTemplateHandler) Handle(ctx context.Context, cmd ApplyTemplateCommand) (*ApplyTemplateResult, error) {
+	t, err := findTemplate(cmd.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	name := cmd.CategoryName
+	if name == "" {
+		name = t.Name
+	}
+
+	type applyResult struct {
+		Result *ApplyTemplateResult
+		Sends  []outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*applyResult, error) {
+		if err := h.quotas.Check(txCtx, quota.Categories, 1); err != nil {
+			return nil, err
+		}
+		attrs, sends, err := h.ensureAttributes(txCtx, t.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := newCategory(h.limits.Category, name, cmd.Enabled, t.Attributes, attrs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create category: %w", err)
+		}
+		if err := h.categoryRepo.Insert(txCtx, c); err != nil {
+			return nil, fmt.Errorf("failed to insert category: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.categoryEvents.NewCategoryUpdatedOutboxMessage(txCtx, c, attrs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return &applyResult{
+			Result: &ApplyTemplateResult{Category: c, Attributes: attrs, CreatedAttributes: len(sends)},
+			Sends:  append(sends, send),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("category template applied",
+		zap.String("template", t.Key),
+		zap.String("categoryId", res.Result.Category.ID),
+		zap.Int("createdAttributes", res.Result.CreatedAttributes))
+
+	for _, send := range res.Sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	return res.Result, nil
+}
+
+// ensureAttributes returns the attributes of the template in order, inserting the missing ones
+// together with their events
+func (h *applyTemplateHandler) ensureAttributes(ctx context.Context, templates []AttributeTemplate) ([]*attribute.Attribute, []outbox.SendFunc, error) {
+	slugs := lo.Map(templates, func(at AttributeTemplate, _ int) string { return at.Slug })
+	existing, err := h.attributeRepo.FindBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	bySlug := lo.KeyBy(existing, func(a *attribute.Attribute) string { return a.Slug })
+	if err := h.quotas.Check(ctx, quota.Attributes, len(templates)-len(existing)); err != nil {
+		return nil, nil, err
+	}
+
+	attrs := make([]*attribute.Attribute, len(templates))
+	var sends []outbox.SendFunc
+	for i, at := range templates {
+		if a, ok := bySlug[at.Slug]; ok {
+			attrs[i] = a
+			continue
+		}
+
+		a, err := newAttribute(h.limits.Attribute, at)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create attribute %s: %w", at.Slug, err)
+		}
+		if err := h.attributeRepo.Insert(ctx, a); err != nil {
+			return nil, nil, fmt.Errorf("failed to insert attribute %s: %w", at.Slug, err)
+		}
+
+		send, err := h.outbox.Create(ctx, h.attributeEvents.NewAttributeUpdatedOutboxMessage(ctx, a, attribute.DiffOptions(nil, a.Options)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+		attrs[i] = a
+		sends = append(sends, send)
+	}
+	return attrs, sends, nil
+}
+
+func newAttribute(l limits.Attribute, at AttributeTemplate) (*attribute.Attribute, error) {
+	options := make([]attribute.Option, len(at.Options))
+	for i, opt := range at.Options {
+		options[i] = attribute.Option{Name: opt.Name, Slug: opt.Slug, ColorCode: opt.ColorCode, SortOrder: i}
+	}
+	return attribute.NewAttribute(l, "", at.Name, at.Slug, attribute.AttributeType(at.Type), at.Unit, true, options)
+}
+
+func newCategory(l limits.Category, name string, enabled bool, templates []AttributeTemplate, attrs []*attribute.Attribute) (*category.Category, error) {
+	categoryAttrs := make([]category.CategoryAttribute, len(templates))
+	for i, at := range templates {
+		role := category.AttributeRole(at.Role)
+		if role.CreatesVariants() && !attrs[i].HasOptions() {
+			return nil, fmt.Errorf("%w: attribute %s has type %s and can't be a variant, only single and multiple attributes can",
+				category.ErrInvalidCategoryData, attrs[i].Slug, attrs[i].Type)
+		}
+		categoryAttrs[i] = category.CategoryAttribute{
+			AttributeID: attrs[i].ID,
+			Slug:        attrs[i].Slug,
+			Role:        role,
+			SortOrder:   i,
+			Filterable:  at.Filterable,
+			Searchable:  at.Searchable,
+			Required:    at.Required,
+		}
+	}
+	return category.NewCategory(l, name, enabled, categoryAttrs)
+}
+
+func (h *applyTemplateHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "apply-category-template-handler"))
+}
@@ -0,0 +1,5 @@
+package categorytemplate
+
+import "errors"
+
+var ErrTemplateNotFound = errors.New("category template not found")
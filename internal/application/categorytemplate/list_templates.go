@@ -0,0 +1,27 @@
+package categorytemplate
+
+import (
+	"context"
+	"slices"
+)
+
+type ListTemplatesQuery struct{}
+
+type ListTemplatesQueryHandler interface {
+	// Handle returns the built-in templates sorted by key
+	Handle(ctx context.Context, query ListTemplatesQuery) ([]Template, error)
+}
+
+type listTemplatesHandler struct{}
+
+func NewListTemplatesHandler() ListTemplatesQueryHandler {
+	return &listTemplatesHandler{}
+}
+
+func (h *listTemplatesHandler) Handle(_ context.Context, _ ListTemplatesQuery) ([]Template, error) {
+	templates, err := builtinTemplates()
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(templates), nil
+}
@@ -0,0 +1,88 @@
+package categorytemplate
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// templateFiles holds the built-in templates, one JSON file per vertical
+//
+//go:embed templates/*.json
+var templateFiles embed.FS
+
+// Template is a predefined set of attributes for a vertical, such as fashion or grocery
+type Template struct {
+	Key         string              `json:"key"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Attributes  []AttributeTemplate `json:"attributes"`
+}
+
+// AttributeTemplate describes an attribute of a template and how the category uses it
+type AttributeTemplate struct {
+	Name       string           `json:"name"`
+	Slug       string           `json:"slug"`
+	Type       string           `json:"type"`
+	Unit       *string          `json:"unit,omitempty"`
+	Options    []OptionTemplate `json:"options,omitempty"`
+	Role       string           `json:"role"`
+	Filterable bool             `json:"filterable,omitempty"`
+	Searchable bool             `json:"searchable,omitempty"`
+	Required   bool             `json:"required,omitempty"`
+}
+
+type OptionTemplate struct {
+	Name      string  `json:"name"`
+	Slug      string  `json:"slug"`
+	ColorCode *string `json:"colorCode,omitempty"`
+}
+
+// builtinTemplates parses the embedded templates once, sorted by key
+var builtinTemplates = sync.OnceValues(func() ([]Template, error) {
+	return loadTemplates(templateFiles, "templates")
+})
+
+func loadTemplates(files embed.FS, dir string) ([]Template, error) {
+	entries, err := files.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category templates: %w", err)
+	}
+
+	templates := make([]Template, 0, len(entries))
+	for _, entry := range entries {
+		data, err := files.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read category template %s: %w", entry.Name(), err)
+		}
+
+		var t Template
+		if err := json.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("failed to parse category template %s: %w", entry.Name(), err)
+		}
+		if t.Key != strings.TrimSuffix(entry.Name(), ".json") {
+			return nil, fmt.Errorf("category template %s has key %q, expected the file name", entry.Name(), t.Key)
+		}
+		templates = append(templates, t)
+	}
+
+	slices.SortFunc(templates, func(a, b Template) int { return strings.Compare(a.Key, b.Key) })
+	return templates, nil
+}
+
+func findTemplate(key string) (Template, error) {
+	templates, err := builtinTemplates()
+	if err != nil {
+		return Template{}, err
+	}
+
+	i := slices.IndexFunc(templates, func(t Template) bool { return t.Key == key })
+	if i < 0 {
+		return Template{}, fmt.Errorf("%w: %s", ErrTemplateNotFound, key)
+	}
+	return templates[i], nil
+}
@@ -0,0 +1,48 @@
+package categorytemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+// TestBuiltinTemplates_Valid fails when a template can't be applied to an empty catalog
+func TestBuiltinTemplates_Valid(t *testing.T) {
+	templates, err := builtinTemplates()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"electronics", "fashion", "grocery"}, keys(templates))
+
+	for _, tmpl := range templates {
+		t.Run(tmpl.Key, func(t *testing.T) {
+			attrs := make([]*attribute.Attribute, len(tmpl.Attributes))
+			for i, at := range tmpl.Attributes {
+				attrs[i], err = newAttribute(limits.Default().Attribute, at)
+				require.NoError(t, err, at.Slug)
+			}
+
+			_, err := newCategory(limits.Default().Category, tmpl.Name, true, tmpl.Attributes, attrs)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFindTemplate(t *testing.T) {
+	tmpl, err := findTemplate("grocery")
+	require.NoError(t, err)
+	assert.Equal(t, "Grocery", tmpl.Name)
+
+	_, err = findTemplate("furniture")
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func keys(templates []Template) []string {
+	result := make([]string, len(templates))
+	for i, tmpl := range templates {
+		result[i] = tmpl.Key
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+// Package change lists the writes of products and categories in commit order, so downstream caches
+// can catch up after downtime without consuming the event stream
+package change
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// EntityType names the aggregate a change belongs to
+type EntityType string
+
+const (
+	EntityProduct  EntityType = "product"
+	EntityCategory EntityType = "category"
+)
+
+// Kind tells what a change did with the entity
+type Kind string
+
+const (
+	// KindUpserted means the entity was created or updated; readers fetch its current state
+	KindUpserted Kind = "upserted"
+	// KindDeleted means the entity was deleted or archived and should be evicted
+	KindDeleted Kind = "deleted"
+)
+
+var ErrInvalidCursor = errors.New("invalid change cursor")
+
+// Change is a recorded write. It carries no state: readers fetch the entity when they need it.
+type Change struct {
+	Entity EntityType
+	ID     string
+	// Version is the entity version written, zero for deletions
+	Version int
+	Kind    Kind
+	At      time.Time
+	// Cursor resumes the feed after this change
+	Cursor string
+}
+
+// Feed reads the changes recorded by the repositories
+type Feed interface {
+	// FindAfter returns up to limit changes recorded after the cursor, in commit order;
+	// an empty cursor starts at the oldest change. It returns ErrInvalidCursor for a malformed cursor.
+	FindAfter(ctx context.Context, cursor string, limit int) ([]Change, error)
+}
@@ -0,0 +1,61 @@
+package change
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+)
+
+type GetChangesQuery struct {
+	// After is the cursor returned by the previous page; empty starts at the oldest change
+	After string
+	Limit int
+}
+
+type GetChangesResult struct {
+	Changes []Change
+	// Cursor resumes the feed after the returned changes; it is the requested one when there were none
+	Cursor  string
+	HasMore bool
+}
+
+type GetChangesQueryHandler interface {
+	// Handle returns the changes after the cursor in commit order, up to the limit.
+	// A reader stores the returned cursor with its cache and polls with it until HasMore is false.
+	Handle(ctx context.Context, query GetChangesQuery) (*GetChangesResult, error)
+}
+
+type getChangesHandler struct {
+	feed Feed
+}
+
+func NewGetChangesHandler(feed Feed) GetChangesQueryHandler {
+	return &getChangesHandler{feed: feed}
+}
+
+func (h *getChangesHandler) Handle(ctx context.Context, query GetChangesQuery) (*GetChangesResult, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultChangesLimit
+	}
+	limit = min(limit, maxChangesLimit)
+
+	// One more than requested tells whether there is a next page
+	changes, err := h.feed.FindAfter(ctx, query.After, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	result := &GetChangesResult{Changes: changes, Cursor: query.After}
+	if len(changes) > limit {
+		result.Changes, result.HasMore = changes[:limit], true
+	}
+	if len(result.Changes) > 0 {
+		result.Cursor = result.Changes[len(result.Changes)-1].Cursor
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package change
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFeed numbers its changes from one, the cursor is the number of the change
+type fakeFeed struct {
+	changes []Change
+	limits  []int
+}
+
+func newFakeFeed(n int) *fakeFeed {
+	f := &fakeFeed{}
+	for i := 1; i <= n; i++ {
+		f.changes = append(f.changes, Change{Entity: EntityProduct, ID: "p" + strconv.Itoa(i), Version: 1, Kind: KindUpserted, Cursor: strconv.Itoa(i)})
+	}
+	return f
+}
+
+func (f *fakeFeed) FindAfter(_ context.Context, cursor string, limit int) ([]Change, error) {
+	f.limits = append(f.limits, limit)
+	after := 0
+	if cursor != "" {
+		var err error
+		if after, err = strconv.Atoi(cursor); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	after = min(after, len(f.changes))
+	return f.changes[after:min(after+limit, len(f.changes))], nil
+}
+
+func TestGetChanges_Pages(t *testing.T) {
+	h := NewGetChangesHandler(newFakeFeed(5))
+
+	first, err := h.Handle(context.Background(), GetChangesQuery{Limit: 3})
+	require.NoError(t, err)
+	assert.Len(t, first.Changes, 3)
+	assert.Equal(t, "3", first.Cursor)
+	assert.True(t, first.HasMore)
+
+	second, err := h.Handle(context.Background(), GetChangesQuery{After: first.Cursor, Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p4", "p5"}, []string{second.Changes[0].ID, second.Changes[1].ID})
+	assert.Equal(t, "5", second.Cursor)
+	assert.False(t, second.HasMore)
+}
+
+func TestGetChanges_NoNewChangesKeepsCursor(t *testing.T) {
+	h := NewGetChangesHandler(newFakeFeed(2))
+
+	result, err := h.Handle(context.Background(), GetChangesQuery{After: "2"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.Equal(t, "2", result.Cursor)
+	assert.False(t, result.HasMore)
+}
+
+func TestGetChanges_Limit(t *testing.T) {
+	feed := newFakeFeed(0)
+	h := NewGetChangesHandler(feed)
+
+	_, err := h.Handle(context.Background(), GetChangesQuery{})
+	require.NoError(t, err)
+	_, err = h.Handle(context.Background(), GetChangesQuery{Limit: 5000})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{defaultChangesLimit + 1, maxChangesLimit + 1}, feed.limits)
+}
+
+func TestGetChanges_InvalidCursor(t *testing.T) {
+	h := NewGetChangesHandler(newFakeFeed(1))
+
+	_, err := h.Handle(context.Background(), GetChangesQuery{After: "not-a-cursor"})
+
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
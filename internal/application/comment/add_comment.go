@@ -0,0 +1,59 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type AddCommentCommand struct {
+	ProductID string
+	Author    string
+	Text      string
+}
+
+type AddCommentCommandHandler interface {
+	Handle(ctx context.Context, cmd AddCommentCommand) (*Comment, error)
+}
+
+type addCommentHandler struct {
+	repo        Repository
+	productRepo product.Repository
+}
+
+func NewAddCommentHandler(repo Repository, productRepo product.Repository) AddCommentCommandHandler {
+	return &addCommentHandler{
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+func (h *addCommentHandler) Handle(ctx context.Context, cmd AddCommentCommand) (*Comment, error) {
+	c, err := NewComment(cmd.ProductID, cmd.Author, cmd.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.productRepo.FindByID(ctx, cmd.ProductID); err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := h.repo.Insert(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to insert comment: %w", err)
+	}
+
+	h.log(ctx).Debug("comment added", zap.String("id", c.ID), zap.String("productId", c.ProductID))
+	return c, nil
+}
+
+func (h *addCommentHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "add-comment-handler"))
+}
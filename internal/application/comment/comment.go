@@ -0,0 +1,69 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	maxAuthorLength = 100
+	maxTextLength   = 4000
+)
+
+// Comment is an internal note of the merchandising team on a product.
+// Comments are never updated and are kept out of product events and responses.
+type Comment struct {
+	ID        string
+	ProductID string
+	// Author is the display name of the user who wrote the comment
+	Author    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// NewComment creates a comment with validation; author and text are trimmed
+func NewComment(productID, author, text string) (*Comment, error) {
+	author = strings.TrimSpace(author)
+	text = strings.TrimSpace(text)
+
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidCommentData)
+	}
+	if author == "" {
+		return nil, fmt.Errorf("%w: author is required", ErrInvalidCommentData)
+	}
+	if utf8.RuneCountInString(author) > maxAuthorLength {
+		return nil, fmt.Errorf("%w: author is too long (max %d characters)", ErrInvalidCommentData, maxAuthorLength)
+	}
+	if text == "" {
+		return nil, fmt.Errorf("%w: text is required", ErrInvalidCommentData)
+	}
+	if utf8.RuneCountInString(text) > maxTextLength {
+		return nil, fmt.Errorf("%w: text is too long (max %d characters)", ErrInvalidCommentData, maxTextLength)
+	}
+
+	return &Comment{
+		ID:        uuid.New().String(),
+		ProductID: productID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: utc.Now(),
+	}, nil
+}
+
+// Reconstruct rebuilds a comment from persistence (no validation)
+func Reconstruct(id, productID, author, text string, createdAt time.Time) *Comment {
+	return &Comment{
+		ID:        id,
+		ProductID: productID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,51 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		productID   string
+		author      string
+		text        string
+		errContains string
+	}{
+		{name: "valid comment", productID: "p1", author: "Maria", text: "Price is below the competitors, check with purchasing"},
+		{name: "unicode text at limit", productID: "p1", author: "Maria", text: strings.Repeat("ї", 4000)},
+		{name: "missing product", productID: "", author: "Maria", text: "text", errContains: "product ID is required"},
+		{name: "blank author", productID: "p1", author: "  ", text: "text", errContains: "author is required"},
+		{name: "long author", productID: "p1", author: strings.Repeat("a", 101), text: "text", errContains: "author is too long"},
+		{name: "blank text", productID: "p1", author: "Maria", text: "\n\t", errContains: "text is required"},
+		{name: "long text", productID: "p1", author: "Maria", text: strings.Repeat("t", 4001), errContains: "text is too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewComment(tt.productID, tt.author, tt.text)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidCommentData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, c.ID)
+			assert.Equal(t, tt.productID, c.ProductID)
+			assert.False(t, c.CreatedAt.IsZero())
+		})
+	}
+}
+
+func TestNewComment_TrimsInput(t *testing.T) {
+	c, err := NewComment("p1", " Maria ", "  Needs a better photo\n")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Maria", c.Author)
+	assert.Equal(t, "Needs a better photo", c.Text)
+}
@@ -0,0 +1,8 @@
+package comment
+
+import "errors"
+
+var (
+	ErrInvalidCommentData = errors.New("invalid comment data")
+	ErrProductNotFound    = errors.New("product not found")
+)
@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+)
+
+type GetCommentListQuery struct {
+	ProductID string
+	Page      int
+	Size      int
+}
+
+type ListCommentsResult struct {
+	Items []*Comment
+	Page  int
+	Size  int
+	Total int64
+}
+
+type GetCommentListQueryHandler interface {
+	Handle(ctx context.Context, query GetCommentListQuery) (*ListCommentsResult, error)
+}
+
+type getCommentListHandler struct {
+	repo Repository
+}
+
+func NewGetCommentListHandler(repo Repository) GetCommentListQueryHandler {
+	return &getCommentListHandler{repo: repo}
+}
+
+func (h *getCommentListHandler) Handle(ctx context.Context, query GetCommentListQuery) (*ListCommentsResult, error) {
+	if query.ProductID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidCommentData)
+	}
+
+	result, err := h.repo.FindByProductID(ctx, query.ProductID, query.Page, query.Size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	return &ListCommentsResult{
+		Items: result.Items,
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package comment
+
+import (
+	"context"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, comment *Comment) error
+
+	// FindByProductID returns a page of the comments of a product, newest first
+	FindByProductID(ctx context.Context, productID string, page, size int) (*commonsmongo.PageResult[Comment], error)
+
+	// ReplaceAuthor sets the author of the comments written by author and returns their number
+	ReplaceAuthor(ctx context.Context, author, replacement string) (int64, error)
+
+	CountByAuthor(ctx context.Context, author string) (int64, error)
+}
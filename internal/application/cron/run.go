@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// RunStatus is the outcome of a task run
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "running"
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+// Run is the history record of a single run of a task
+type Run struct {
+	ID   string
+	Task string
+	// Owner is the replica that ran the task
+	Owner string
+	// ScheduledAt is the occurrence of the schedule the run is for
+	ScheduledAt time.Time
+	Status      RunStatus
+	Error       string
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// NewRun records the start of a run
+func NewRun(task, owner string, scheduledAt time.Time) *Run {
+	return &Run{
+		ID:          uuid.New().String(),
+		Task:        task,
+		Owner:       owner,
+		ScheduledAt: scheduledAt.UTC(),
+		Status:      RunStatusRunning,
+		StartedAt:   utc.Now(),
+	}
+}
+
+// Finish records the outcome of the run
+func (r *Run) Finish(err error) {
+	finishedAt := utc.Now()
+	r.FinishedAt = &finishedAt
+	r.Status = RunStatusSucceeded
+	if err != nil {
+		r.Status = RunStatusFailed
+		r.Error = err.Error()
+	}
+}
+
+// Duration is the time the run took, or has taken so far
+func (r *Run) Duration() time.Duration {
+	if r.FinishedAt == nil {
+		return time.Since(r.StartedAt)
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// RunRepository stores the run history. Runs are shared by all tenants.
+type RunRepository interface {
+	Insert(ctx context.Context, run *Run) error
+	Save(ctx context.Context, run *Run) error
+}
@@ -0,0 +1,17 @@
+package cron
+
+import (
+	"context"
+)
+
+// Task is a recurring operation run by the cron module on the leader replica.
+// Tasks are provided to the fx group "cron_task"; the module config may disable them or override their schedule.
+type Task struct {
+	// Name identifies the task in the config and the run history
+	Name string
+	// Schedule is the default cron expression, see ParseSchedule
+	Schedule string
+	// Enabled is the default of the enable flag
+	Enabled bool
+	Run     func(ctx context.Context) error
+}
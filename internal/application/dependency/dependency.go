@@ -0,0 +1,30 @@
+// Package dependency reports failures of the stores and services the catalog depends on.
+//
+// A dependency that keeps failing is cut off by a circuit breaker for a while. Calls made in the
+// meantime fail fast with an UnavailableError instead of waiting for the dependency, so API callers
+// get a hint when to retry and a degraded dependency doesn't hold up every request.
+package dependency
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrUnavailable matches every UnavailableError
+var ErrUnavailable = errors.New("dependency unavailable")
+
+// UnavailableError is returned instead of calling a dependency that is cut off
+type UnavailableError struct {
+	Dependency string
+	// RetryAfter is the time left until the dependency is tried again
+	RetryAfter time.Duration
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s is unavailable, retry in %s", e.Dependency, e.RetryAfter.Round(time.Second))
+}
+
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
@@ -0,0 +1,42 @@
+package edgecache
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Kind is the entity type a surrogate key refers to
+type Kind string
+
+const (
+	Product   Kind = "product"
+	Category  Kind = "category"
+	Attribute Kind = "attribute"
+)
+
+// EntityKey returns the surrogate key of the responses that include the entity,
+// such as "acme:product/42" for a product of the tenant acme
+func EntityKey(ctx context.Context, kind Kind, id string) string {
+	return prefix(ctx) + string(kind) + "/" + id
+}
+
+// ListKey returns the surrogate key of the responses listing entities of the kind,
+// which change whenever one of them is added, changed or removed
+func ListKey(ctx context.Context, kind Kind) string {
+	return prefix(ctx) + string(kind) + "-list"
+}
+
+// CategoryProductsKey returns the surrogate key of the responses computed from the products of the category,
+// such as its facets
+func CategoryProductsKey(ctx context.Context, categoryID string) string {
+	return prefix(ctx) + string(Category) + "/" + categoryID + "/products"
+}
+
+// prefix scopes keys to the tenant of the context, so a purge never reaches another tenant
+func prefix(ctx context.Context) string {
+	if slug, ok := tenant.SlugFromContext(ctx); ok {
+		return slug + ":"
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+package edgecache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+func TestKeys(t *testing.T) {
+	acme := tenant.ContextWithSlug(context.Background(), "acme")
+
+	assert.Equal(t, "acme:product/42", EntityKey(acme, Product, "42"))
+	assert.Equal(t, "acme:category-list", ListKey(acme, Category))
+	assert.Equal(t, "acme:category/3/products", CategoryProductsKey(acme, "3"))
+	assert.Equal(t, "attribute/7", EntityKey(context.Background(), Attribute, "7"))
+}
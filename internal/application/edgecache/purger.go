@@ -0,0 +1,18 @@
+package edgecache
+
+import "context"
+
+// Purger drops the cached responses tagged with any of the surrogate keys from the CDN in front of the catalog.
+// Purges are best effort: a failed one leaves the responses cached until their max-age runs out.
+type Purger interface {
+	Purge(ctx context.Context, keys []string)
+}
+
+// NewPurger returns the purger used when no CDN is configured, which drops nothing
+func NewPurger() Purger {
+	return noopPurger{}
+}
+
+type noopPurger struct{}
+
+func (noopPurger) Purge(context.Context, []string) {}
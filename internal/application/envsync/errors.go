@@ -0,0 +1,11 @@
+package envsync
+
+import "errors"
+
+var (
+	ErrInvalidSyncRequest = errors.New("invalid sync request")
+	// ErrSourceNotConfigured means no source environment is configured to copy from
+	ErrSourceNotConfigured = errors.New("source environment is not configured")
+	// ErrSourceUnavailable wraps the failures of the source environment API
+	ErrSourceUnavailable = errors.New("source environment unavailable")
+)
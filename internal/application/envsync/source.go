@@ -0,0 +1,21 @@
+package envsync
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+)
+
+// Source reads the catalog of another environment through its API, in the tenant of the context.
+// An unknown ID fails with an error wrapping mongo.ErrEntityNotFound, other failures wrap ErrSourceUnavailable.
+type Source interface {
+	// Taxonomy returns the bundle of the categories and of the attributes they use, as ExportTaxonomy does
+	Taxonomy(ctx context.Context, categoryIDs []string) (*taxonomy.Bundle, error)
+	// Attributes returns the bundle of the attributes, without their bindings
+	Attributes(ctx context.Context, ids []string) (*taxonomy.Bundle, error)
+	// Products returns the products with the IDs as commands creating them with the same IDs
+	Products(ctx context.Context, ids []string) ([]product.CreateProductCommand, error)
+	// ProductsInCategory returns up to limit products of the category, like Products
+	ProductsInCategory(ctx context.Context, categoryID string, limit int) ([]product.CreateProductCommand, error)
+}
@@ -0,0 +1,267 @@
+package envsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const (
+	// maxSyncProducts bounds the products copied by one sync
+	maxSyncProducts = 1000
+	// defaultProductLimit is the number of products copied per category when the command sets none
+	defaultProductLimit = 100
+)
+
+// SyncCommand selects what to copy from the source environment. The categories and attributes
+// the selected products and categories depend on are copied along with them.
+type SyncCommand struct {
+	AttributeIDs []string
+	CategoryIDs  []string
+	ProductIDs   []string
+	// ProductCategoryIDs selects the products of the categories, up to ProductLimit per category
+	ProductCategoryIDs []string
+	// ProductLimit defaults to 100, at most 1000
+	ProductLimit int
+}
+
+// ProductResult is the outcome of a copied product: the stored product and whether it was created
+// or updated, or the reason it wasn't stored
+type ProductResult struct {
+	// ID is the ID of the product in both environments
+	ID      string
+	Product *product.Product
+	Action  product.ImportAction
+	Err     error
+}
+
+type SyncResult struct {
+	// Taxonomy holds the changes of the copied attributes and categories, as ImportTaxonomy returns them
+	Taxonomy []taxonomy.Change
+	// Products holds the outcomes of the copied products, in the order they were read
+	Products []ProductResult
+}
+
+type SyncCommandHandler interface {
+	// Handle copies the selected entities from the source environment, for seeding a pre-production
+	// environment with realistic data.
+	//
+	// The categories and attributes go first, in one transaction as by ImportTaxonomy: categories keep
+	// their IDs, attributes are matched by slug and keep their IDs when created. Products keep their IDs:
+	// a stored product with the same ID is overwritten whatever its version, others are created, and the
+	// values of attributes stored under another ID are moved to it. Supplier links are left out since
+	// suppliers aren't copied. A product failing the checks is reported and the others are stored.
+	Handle(ctx context.Context, cmd SyncCommand) (*SyncResult, error)
+}
+
+type syncHandler struct {
+	source         Source
+	importTaxonomy taxonomy.ImportTaxonomyCommandHandler
+	productRepo    product.Repository
+	createProduct  product.CreateProductCommandHandler
+	updateProduct  product.UpdateProductCommandHandler
+}
+
+func NewSyncHandler(
+	source Source,
+	importTaxonomy taxonomy.ImportTaxonomyCommandHandler,
+	productRepo product.Repository,
+	createProduct product.CreateProductCommandHandler,
+	updateProduct product.UpdateProductCommandHandler,
+) SyncCommandHandler {
+	return &syncHandler{
+		source:         source,
+		importTaxonomy: importTaxonomy,
+		productRepo:    productRepo,
+		createProduct:  createProduct,
+		updateProduct:  updateProduct,
+	}
+}
+
+func (h *syncHandler) Handle(ctx context.Context, cmd SyncCommand) (*SyncResult, error) {
+	if err := validate(&cmd); err != nil {
+		return nil, err
+	}
+
+	products, err := h.findProducts(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := h.findTaxonomy(ctx, cmd, products)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []taxonomy.Change
+	if len(bundle.Attributes) > 0 || len(bundle.Categories) > 0 {
+		changes, err = h.importTaxonomy.Handle(ctx, taxonomy.ImportTaxonomyCommand{Bundle: *bundle})
+		if err != nil {
+			return nil, fmt.Errorf("failed to import taxonomy: %w", err)
+		}
+	}
+
+	attributeIDs := storedAttributeIDs(bundle, changes)
+	results := make([]ProductResult, len(products))
+	for i, p := range products {
+		results[i] = h.writeProduct(ctx, p, attributeIDs)
+	}
+
+	h.log(ctx).Info("environment synced", zap.Int("taxonomyChanges", len(changes)), zap.Int("products", len(products)),
+		zap.Int("failedProducts", lo.CountBy(results, func(r ProductResult) bool { return r.Err != nil })))
+
+	return &SyncResult{Taxonomy: changes, Products: results}, nil
+}
+
+func validate(cmd *SyncCommand) error {
+	if len(cmd.AttributeIDs) == 0 && len(cmd.CategoryIDs) == 0 && len(cmd.ProductIDs) == 0 && len(cmd.ProductCategoryIDs) == 0 {
+		return fmt.Errorf("%w: nothing selected", ErrInvalidSyncRequest)
+	}
+	if cmd.ProductLimit < 0 || cmd.ProductLimit > maxSyncProducts {
+		return fmt.Errorf("%w: product limit must be between 1 and %d", ErrInvalidSyncRequest, maxSyncProducts)
+	}
+	if cmd.ProductLimit == 0 {
+		cmd.ProductLimit = defaultProductLimit
+	}
+	if len(cmd.ProductIDs) > maxSyncProducts {
+		return fmt.Errorf("%w: at most %d products can be copied at once", ErrInvalidSyncRequest, maxSyncProducts)
+	}
+	return nil
+}
+
+// findProducts reads the selected products, each once
+func (h *syncHandler) findProducts(ctx context.Context, cmd SyncCommand) ([]product.CreateProductCommand, error) {
+	var products []product.CreateProductCommand
+	if len(cmd.ProductIDs) > 0 {
+		found, err := h.source.Products(ctx, lo.Uniq(cmd.ProductIDs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source products: %w", err)
+		}
+		products = append(products, found...)
+	}
+	for _, categoryID := range lo.Uniq(cmd.ProductCategoryIDs) {
+		found, err := h.source.ProductsInCategory(ctx, categoryID, cmd.ProductLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source products of category %s: %w", categoryID, err)
+		}
+		products = append(products, found...)
+	}
+
+	for _, p := range products {
+		if p.ID == nil {
+			return nil, fmt.Errorf("%w: source product %s has no ID", ErrSourceUnavailable, p.Name)
+		}
+	}
+	products = lo.UniqBy(products, func(p product.CreateProductCommand) string { return p.ID.String() })
+	if len(products) > maxSyncProducts {
+		return nil, fmt.Errorf("%w: the selection holds %d products, at most %d can be copied at once",
+			ErrInvalidSyncRequest, len(products), maxSyncProducts)
+	}
+	return products, nil
+}
+
+// findTaxonomy reads the selected categories and attributes along with the ones the products depend on
+func (h *syncHandler) findTaxonomy(ctx context.Context, cmd SyncCommand, products []product.CreateProductCommand) (*taxonomy.Bundle, error) {
+	categoryIDs := append(append([]string{}, cmd.CategoryIDs...), cmd.ProductCategoryIDs...)
+	attributeIDs := append([]string{}, cmd.AttributeIDs...)
+	for _, p := range products {
+		if p.CategoryID != nil {
+			categoryIDs = append(categoryIDs, *p.CategoryID)
+		}
+		for _, v := range p.Attributes {
+			attributeIDs = append(attributeIDs, v.AttributeID)
+		}
+	}
+
+	bundle := &taxonomy.Bundle{Version: taxonomy.BundleVersion}
+	if categoryIDs = lo.Uniq(categoryIDs); len(categoryIDs) > 0 {
+		found, err := h.source.Taxonomy(ctx, categoryIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source taxonomy: %w", err)
+		}
+		bundle = found
+	}
+
+	exported := lo.SliceToMap(bundle.Attributes, func(e taxonomy.AttributeEntry) (string, bool) { return e.ID, true })
+	attributeIDs = lo.Filter(lo.Uniq(attributeIDs), func(id string, _ int) bool { return !exported[id] })
+	if len(attributeIDs) > 0 {
+		found, err := h.source.Attributes(ctx, attributeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source attributes: %w", err)
+		}
+		bundle.Attributes = append(bundle.Attributes, found.Attributes...)
+	}
+	return bundle, nil
+}
+
+// storedAttributeIDs maps the source IDs of the imported attributes to the IDs they are stored under
+func storedAttributeIDs(bundle *taxonomy.Bundle, changes []taxonomy.Change) map[string]string {
+	bySlug := make(map[string]string)
+	for _, c := range changes {
+		if c.Entity == taxonomy.EntityAttribute {
+			bySlug[c.Key] = c.ID
+		}
+	}
+	ids := make(map[string]string, len(bundle.Attributes))
+	for _, e := range bundle.Attributes {
+		if id, ok := bySlug[e.Slug]; ok {
+			ids[e.ID] = id
+		}
+	}
+	return ids
+}
+
+// writeProduct creates the product with its source ID, or overwrites the stored product with that ID
+func (h *syncHandler) writeProduct(ctx context.Context, cmd product.CreateProductCommand, attributeIDs map[string]string) ProductResult {
+	res := ProductResult{ID: cmd.ID.String()}
+
+	cmd.Supplier = nil
+	cmd.Attributes = lo.Map(cmd.Attributes, func(v product.AttributeValue, _ int) product.AttributeValue {
+		if id, ok := attributeIDs[v.AttributeID]; ok {
+			v.AttributeID = id
+		}
+		return v
+	})
+
+	stored, err := h.productRepo.FindByID(ctx, res.ID)
+	switch {
+	case err == nil:
+		res.Product, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+			ID:          stored.ID,
+			Version:     stored.Version,
+			Name:        cmd.Name,
+			Slug:        cmd.Slug,
+			Description: cmd.Description,
+			Price:       cmd.Price,
+			Quantity:    cmd.Quantity,
+			ImageID:     cmd.ImageID,
+			CategoryID:  cmd.CategoryID,
+			Enabled:     cmd.Enabled,
+			Attributes:  cmd.Attributes,
+			Metadata:    cmd.Metadata,
+			ExternalID:  cmd.ExternalID,
+		})
+		res.Action = product.ImportUpdated
+	case errors.Is(err, mongo.ErrEntityNotFound):
+		res.Product, err = h.createProduct.Handle(ctx, cmd)
+		res.Action = product.ImportCreated
+	default:
+		err = fmt.Errorf("failed to get product: %w", err)
+	}
+	if err != nil {
+		return ProductResult{ID: res.ID, Err: err}
+	}
+	return res
+}
+
+func (h *syncHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "environment-sync-handler"))
+}
@@ -0,0 +1,66 @@
+// Package feature gates new behaviors behind flags, so they can be rolled out per environment without a redeploy.
+//
+// Every flag has a default from the configuration of the environment. A setting stored in the service database
+// overrides the default and is picked up by all replicas when the flags are reloaded.
+package feature
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+type Flag string
+
+const (
+	// StrictAttributeValidation rejects product values for attributes the category of the product doesn't use
+	StrictAttributeValidation Flag = "strict-attribute-validation"
+	// PriceChangedEvents publishes ProductPriceChangedEvent when the price of a product changes
+	PriceChangedEvents Flag = "price-changed-events"
+)
+
+var knownFlags = []Flag{
+	StrictAttributeValidation,
+	PriceChangedEvents,
+}
+
+// Known lists the flags the service checks
+func Known() []Flag {
+	return slices.Clone(knownFlags)
+}
+
+// IsKnown reports whether the service checks the flag
+func IsKnown(flag Flag) bool {
+	return slices.Contains(knownFlags, flag)
+}
+
+// Flags tells whether a flag is enabled. Flags without a default or a stored setting are disabled.
+type Flags interface {
+	Enabled(flag Flag) bool
+}
+
+// Setting is a stored flag state overriding the configured default
+type Setting struct {
+	Flag       Flag
+	Enabled    bool
+	ModifiedAt time.Time
+}
+
+type Repository interface {
+	FindAll(ctx context.Context) ([]*Setting, error)
+}
+
+type staticFlags map[Flag]bool
+
+// Static returns flags that never change with the given flags enabled, e.g. for tests
+func Static(enabled ...Flag) Flags {
+	flags := make(staticFlags, len(enabled))
+	for _, flag := range enabled {
+		flags[flag] = true
+	}
+	return flags
+}
+
+func (f staticFlags) Enabled(flag Flag) bool {
+	return f[flag]
+}
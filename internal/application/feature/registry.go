@@ -0,0 +1,78 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+// Defaults holds the flag states configured for the environment
+type Defaults map[Flag]bool
+
+// Registry holds the current flag states. It starts with the defaults and is reloaded while the service runs.
+type Registry struct {
+	repo     Repository
+	defaults Defaults
+	states   atomic.Pointer[map[Flag]bool]
+}
+
+func NewRegistry(repo Repository, defaults Defaults) *Registry {
+	r := &Registry{repo: repo, defaults: defaults}
+	states := maps.Clone(map[Flag]bool(defaults))
+	if states == nil {
+		states = map[Flag]bool{}
+	}
+	r.states.Store(&states)
+	return r
+}
+
+// NewFlags exposes the registry to the handlers, which only read the flags
+func NewFlags(r *Registry) Flags {
+	return r
+}
+
+func (r *Registry) Enabled(flag Flag) bool {
+	return (*r.states.Load())[flag]
+}
+
+// Reload replaces the states with the defaults overridden by the stored settings and returns the flags that
+// changed, sorted. Stored settings of unknown flags are ignored; they may belong to a newer version of the service.
+func (r *Registry) Reload(ctx context.Context) ([]Flag, error) {
+	settings, err := r.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature flags: %w", err)
+	}
+
+	states := maps.Clone(map[Flag]bool(r.defaults))
+	if states == nil {
+		states = make(map[Flag]bool, len(settings))
+	}
+	for _, s := range settings {
+		if !IsKnown(s.Flag) {
+			r.log(ctx).Debug("ignoring unknown feature flag", zap.String("flag", string(s.Flag)))
+			continue
+		}
+		states[s.Flag] = s.Enabled
+	}
+
+	previous := r.states.Swap(&states)
+
+	var changed []Flag
+	for _, flag := range knownFlags {
+		if (*previous)[flag] != states[flag] {
+			changed = append(changed, flag)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+func (r *Registry) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "feature-registry"))
+}
@@ -0,0 +1,73 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+type stubRepository struct {
+	settings []*Setting
+	err      error
+}
+
+func (r *stubRepository) FindAll(context.Context) ([]*Setting, error) {
+	return r.settings, r.err
+}
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+func TestRegistry_StartsWithDefaults(t *testing.T) {
+	r := NewRegistry(&stubRepository{}, Defaults{StrictAttributeValidation: true})
+
+	assert.True(t, r.Enabled(StrictAttributeValidation))
+	assert.False(t, r.Enabled(PriceChangedEvents))
+}
+
+func TestRegistry_ReloadOverridesDefaults(t *testing.T) {
+	repo := &stubRepository{}
+	r := NewRegistry(repo, Defaults{StrictAttributeValidation: true})
+
+	repo.settings = []*Setting{
+		{Flag: StrictAttributeValidation, Enabled: false},
+		{Flag: PriceChangedEvents, Enabled: true},
+		{Flag: "not-yet-released", Enabled: true},
+	}
+	changed, err := r.Reload(testCtx())
+	require.NoError(t, err)
+
+	assert.Equal(t, []Flag{PriceChangedEvents, StrictAttributeValidation}, changed)
+	assert.False(t, r.Enabled(StrictAttributeValidation))
+	assert.True(t, r.Enabled(PriceChangedEvents))
+	assert.False(t, r.Enabled("not-yet-released"))
+
+	// A removed setting falls back to the default
+	repo.settings = nil
+	changed, err = r.Reload(testCtx())
+	require.NoError(t, err)
+
+	assert.Equal(t, []Flag{PriceChangedEvents, StrictAttributeValidation}, changed)
+	assert.True(t, r.Enabled(StrictAttributeValidation))
+	assert.False(t, r.Enabled(PriceChangedEvents))
+}
+
+func TestRegistry_ReloadFailureKeepsStates(t *testing.T) {
+	repo := &stubRepository{settings: []*Setting{{Flag: PriceChangedEvents, Enabled: true}}}
+	r := NewRegistry(repo, nil)
+	_, err := r.Reload(testCtx())
+	require.NoError(t, err)
+
+	repo.err = errors.New("connection refused")
+	_, err = r.Reload(testCtx())
+
+	require.Error(t, err)
+	assert.True(t, r.Enabled(PriceChangedEvents))
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+// staleBatchSize is the number of stale jobs loaded at once
+const staleBatchSize = 100
+
+type FailStaleJobsCommandHandler interface {
+	// Handle stores the jobs of the current tenant whose replica died as failed, so they show as finished
+	// without the read-time check and expire like the others. It returns the number of jobs failed.
+	Handle(ctx context.Context) (int, error)
+}
+
+type failStaleJobsHandler struct {
+	repo Repository
+}
+
+func NewFailStaleJobsHandler(repo Repository) FailStaleJobsCommandHandler {
+	return &failStaleJobsHandler{repo: repo}
+}
+
+func (h *failStaleJobsHandler) Handle(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		now := utc.Now()
+		stale, err := h.repo.FindUnfinished(ctx, now.Add(-staleAfter), staleBatchSize)
+		if err != nil {
+			return count, fmt.Errorf("failed to find stale jobs: %w", err)
+		}
+
+		for _, j := range stale {
+			j.Status = StatusFailed
+			j.Error = errInterrupted
+			j.FinishedAt = &now
+			j.UpdatedAt = now
+			if err := h.repo.Save(ctx, j); err != nil {
+				return count, fmt.Errorf("failed to save job %s: %w", j.ID, err)
+			}
+			h.log(ctx).Info("stale job failed", zap.String("id", j.ID), zap.String("type", string(j.Type)))
+			count++
+		}
+
+		if len(stale) < staleBatchSize {
+			return count, nil
+		}
+	}
+}
+
+func (h *failStaleJobsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "fail-stale-jobs-handler"))
+}
@@ -0,0 +1,38 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetJobByIDQuery struct {
+	ID string
+}
+
+type GetJobByIDQueryHandler interface {
+	Handle(ctx context.Context, query GetJobByIDQuery) (*Job, error)
+}
+
+type getJobByIDHandler struct {
+	repo Repository
+}
+
+func NewGetJobByIDHandler(repo Repository) GetJobByIDQueryHandler {
+	return &getJobByIDHandler{repo: repo}
+}
+
+func (h *getJobByIDHandler) Handle(ctx context.Context, query GetJobByIDQuery) (*Job, error) {
+	j, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	markStale(j)
+	return j, nil
+}
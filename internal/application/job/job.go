@@ -0,0 +1,153 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Type names the operation a job runs
+type Type string
+
+const (
+	TypeMergeDuplicateAttributes Type = "merge-duplicate-attributes"
+	TypeFindDuplicateProducts    Type = "find-duplicate-products"
+	TypeInventoryValuation       Type = "inventory-valuation"
+	TypeRemapOption              Type = "remap-option"
+	TypeResyncAttributeSlugs     Type = "resync-attribute-slugs"
+)
+
+// Status is the lifecycle state of a job
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	ErrQueueFull   = errors.New("too many jobs are waiting, try again later")
+	// ErrNotLeader is returned by the replicas that don't lead the jobs; another replica accepts the job
+	ErrNotLeader = errors.New("jobs run on another replica, try again")
+	// ErrJobNotSucceeded is returned for the result of a job that is still running or has failed
+	ErrJobNotSucceeded = errors.New("job has not succeeded")
+)
+
+// Job is a long-running operation executed in the background by the worker pool.
+// A queued or running job refreshes UpdatedAt while its replica is alive; one that stopped refreshing it
+// died with its replica and is reported as failed.
+type Job struct {
+	ID     string
+	Type   Type
+	Status Status
+	// Done and Total count the items processed so far and the items to process; Total is 0 while unknown
+	Done  int64
+	Total int64
+	// Failed counts the items that could not be processed; ItemErrors keeps the first maxItemErrors of them
+	Failed     int64
+	ItemErrors []ItemError
+	// Result is the JSON document returned by a succeeded job, its shape depends on the type
+	Result     json.RawMessage
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+// Finished reports whether the job has succeeded or failed
+func (j *Job) Finished() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed
+}
+
+// Percent is the share of the items processed, 100 once the job has succeeded
+func (j *Job) Percent() int {
+	return percent(j.Status, j.Done, j.Total)
+}
+
+// ItemError is an item a job could not process; the job goes on with the others
+type ItemError struct {
+	// Index is the position of the item among the failed items of the job
+	Index int64
+	Item  string
+	Error string
+}
+
+// Progress reports what a running task has done so far; tasks call it as they go
+type Progress interface {
+	// Advance records the items processed so far out of total
+	Advance(done, total int64)
+	// ItemFailed records an item that could not be processed
+	ItemFailed(item string, err error)
+}
+
+// Task is the work of a job. The returned result is stored as JSON.
+type Task func(ctx context.Context, progress Progress) (any, error)
+
+// Scheduler runs tasks on a bounded pool of workers
+type Scheduler interface {
+	// Submit stores a queued job and runs task in the background, keeping the values of ctx (tenant, logger)
+	// but not its cancellation. It fails with ErrQueueFull when the pool has too many waiting jobs
+	// and with ErrNotLeader on a replica that doesn't lead the jobs.
+	Submit(ctx context.Context, jobType Type, task Task) (*Job, error)
+	// Subscribe returns the events of a job run by this replica until the returned func is called.
+	// Events are dropped for subscribers that don't keep up.
+	Subscribe(jobID string) (<-chan Event, func())
+}
+
+// Event is a change of a running job
+type Event struct {
+	Status Status
+	Done   int64
+	Total  int64
+	// ItemError is set when an item failed
+	ItemError *ItemError
+	// Result and Error are set once the job has finished
+	Result json.RawMessage
+	Error  string
+}
+
+// Percent is the share of the items processed, 100 once the job has succeeded
+func (e Event) Percent() int {
+	return percent(e.Status, e.Done, e.Total)
+}
+
+// Finished reports whether the event is the last one of the job
+func (e Event) Finished() bool {
+	return e.Status == StatusSucceeded || e.Status == StatusFailed
+}
+
+func eventOf(j *Job) Event {
+	return Event{Status: j.Status, Done: j.Done, Total: j.Total, Result: j.Result, Error: j.Error}
+}
+
+func percent(status Status, done, total int64) int {
+	if status == StatusSucceeded {
+		return 100
+	}
+	if total <= 0 {
+		return 0
+	}
+	return int(min(done*100/total, 100))
+}
+
+func snapshot(j *Job) *Job {
+	s := *j
+	s.ItemErrors = slices.Clone(j.ItemErrors)
+	return &s
+}
+
+// markStale reports an unfinished job that stopped saving as failed, its replica is gone
+func markStale(j *Job) {
+	if !j.Finished() && time.Since(j.UpdatedAt) > staleAfter {
+		j.Status = StatusFailed
+		j.Error = errInterrupted
+	}
+}
+
+const errInterrupted = "job was interrupted"
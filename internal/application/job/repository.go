@@ -0,0 +1,19 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, job *Job) error
+
+	// Save stores the current state of the job
+	Save(ctx context.Context, job *Job) error
+
+	// FindByID returns a job or mongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*Job, error)
+
+	// FindUnfinished returns up to limit queued or running jobs last saved before the given time
+	FindUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]*Job, error)
+}
@@ -0,0 +1,379 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+const (
+	// workers is the number of jobs run in parallel by a replica
+	workers = 4
+	// queueSize bounds the jobs waiting for a worker
+	queueSize = 100
+	// saveInterval is how often a running job persists its progress
+	saveInterval = 2 * time.Second
+	// staleAfter is how long an unfinished job may go without saving before it is considered dead
+	staleAfter = time.Minute
+	// maxItemErrors bounds the item errors stored with a job, the others are only counted
+	maxItemErrors = 100
+	// subscriberBuffer is the number of events a slow subscriber may lag behind before events are dropped
+	subscriberBuffer = 64
+	// leaderWait is how long a submission waits for this replica to lead the jobs, so the submissions
+	// right after start or during a handover go through
+	leaderWait = time.Second
+	// readOnlyPollInterval is how often idle workers check whether the service left the read-only mode
+	readOnlyPollInterval = time.Second
+)
+
+type queuedJob struct {
+	ctx  context.Context
+	job  *Job
+	task Task
+}
+
+// scheduler runs the jobs on the replica leading them, so two replicas never run bulk jobs over the
+// same aggregates at once. The other replicas refuse submissions with ErrNotLeader.
+// While the service is read-only for maintenance, the workers hold the queued jobs until it is writable again.
+type scheduler struct {
+	repo    Repository
+	elector leader.Elector
+	mode    maintenance.Service
+	queue   chan queuedJob
+
+	// stopCtx is cancelled on shutdown to abort running jobs
+	stopCtx context.Context
+	wg      sync.WaitGroup
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan Event]struct{}
+	// leading is closed while this replica leads the jobs and replaced when it stops
+	leading chan struct{}
+}
+
+func NewScheduler(lc fx.Lifecycle, repo Repository, elector leader.Elector, mode maintenance.Service, log *zap.Logger) Scheduler {
+	stopCtx, stop := context.WithCancel(logger.With(context.Background(), log))
+	s := &scheduler{
+		repo:        repo,
+		elector:     elector,
+		mode:        mode,
+		queue:       make(chan queuedJob, queueSize),
+		stopCtx:     stopCtx,
+		subscribers: make(map[string]map[chan Event]struct{}),
+		leading:     make(chan struct{}),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			s.wg.Go(func() {
+				s.elector.Run(s.stopCtx, "jobs", s.lead)
+			})
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			stop()
+			s.wg.Wait()
+			return nil
+		},
+	})
+
+	return s
+}
+
+func (s *scheduler) Submit(ctx context.Context, jobType Type, task Task) (*Job, error) {
+	if err := s.awaitLeadership(ctx); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
+	j := &Job{
+		ID:        uuid.New().String(),
+		Type:      jobType,
+		Status:    StatusQueued,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.Insert(ctx, j); err != nil {
+		return nil, fmt.Errorf("failed to insert job: %w", err)
+	}
+
+	queued := snapshot(j)
+	select {
+	case s.queue <- queuedJob{ctx: context.WithoutCancel(ctx), job: j, task: task}:
+	default:
+		s.fail(ctx, j, ErrQueueFull)
+		return nil, ErrQueueFull
+	}
+
+	s.log(ctx).Debug("job queued", zap.String("id", j.ID), zap.String("type", string(jobType)))
+	return queued, nil
+}
+
+func (s *scheduler) Subscribe(jobID string) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	s.mu.Lock()
+	if s.subscribers[jobID] == nil {
+		s.subscribers[jobID] = make(map[chan Event]struct{})
+	}
+	s.subscribers[jobID][ch] = struct{}{}
+	s.mu.Unlock()
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers[jobID], ch)
+		if len(s.subscribers[jobID]) == 0 {
+			delete(s.subscribers, jobID)
+		}
+	}
+}
+
+func (s *scheduler) publish(jobID string, e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers[jobID] {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// awaitLeadership waits up to leaderWait for this replica to lead the jobs
+func (s *scheduler) awaitLeadership(ctx context.Context) error {
+	s.mu.Lock()
+	leading := s.leading
+	s.mu.Unlock()
+
+	timer := time.NewTimer(leaderWait)
+	defer timer.Stop()
+	select {
+	case <-leading:
+		return nil
+	case <-timer.C:
+		return ErrNotLeader
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// lead runs the workers while this replica leads the jobs. Losing the leadership cancels the running jobs
+// and fails the waiting ones, as a shutdown does.
+func (s *scheduler) lead(ctx context.Context) {
+	s.mu.Lock()
+	close(s.leading)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() { s.work(ctx) })
+	}
+	<-ctx.Done()
+
+	s.mu.Lock()
+	s.leading = make(chan struct{})
+	s.mu.Unlock()
+
+	wg.Wait()
+	s.drain()
+}
+
+func (s *scheduler) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case q := <-s.queue:
+			if !s.awaitWritable(ctx) {
+				s.fail(q.ctx, q.job, s.cancelCause())
+				return
+			}
+			s.run(ctx, q)
+		}
+	}
+}
+
+// awaitWritable waits while the service is read-only for maintenance and reports false when ctx is done first
+func (s *scheduler) awaitWritable(ctx context.Context) bool {
+	if !s.mode.Current().ReadOnly {
+		return true
+	}
+	s.log(ctx).Info("service is read-only, holding jobs")
+
+	ticker := time.NewTicker(readOnlyPollInterval)
+	defer ticker.Stop()
+	for s.mode.Current().ReadOnly {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
+
+// drain fails the jobs that were still waiting when the replica stopped leading or shut down
+func (s *scheduler) drain() {
+	cause := s.cancelCause()
+	for {
+		select {
+		case q := <-s.queue:
+			s.fail(q.ctx, q.job, cause)
+		default:
+			return
+		}
+	}
+}
+
+// cancelCause tells why the waiting jobs are cancelled once the workers stop
+func (s *scheduler) cancelCause() error {
+	if s.stopCtx.Err() != nil {
+		return errors.New("job was cancelled by shutdown")
+	}
+	return errors.New("job was cancelled by a leadership handover")
+}
+
+// run runs a job until it finishes or leadCtx is done
+func (s *scheduler) run(leadCtx context.Context, q queuedJob) {
+	ctx, cancel := context.WithCancel(q.ctx)
+	defer cancel()
+	stop := context.AfterFunc(leadCtx, cancel)
+	defer stop()
+
+	j := q.job
+	startedAt := utc.Now()
+	j.Status = StatusRunning
+	j.StartedAt = &startedAt
+	if err := s.save(ctx, j); err != nil {
+		s.log(ctx).Error("failed to save job", zap.String("id", j.ID), zap.Error(err))
+	}
+	s.publish(j.ID, eventOf(j))
+	s.log(ctx).Info("job started", zap.String("id", j.ID), zap.String("type", string(j.Type)))
+
+	progress := &runProgress{scheduler: s, jobID: j.ID}
+	stopHeartbeat := s.heartbeat(ctx, j, progress)
+	result, err := q.task(ctx, progress)
+	stopHeartbeat()
+
+	progress.copyTo(j)
+	if err == nil {
+		j.Result, err = json.Marshal(result)
+	}
+	if err != nil {
+		s.fail(ctx, j, err)
+		return
+	}
+
+	finishedAt := utc.Now()
+	j.Status = StatusSucceeded
+	j.FinishedAt = &finishedAt
+	// Record the outcome even if the job was aborted by shutdown
+	if err := s.save(context.WithoutCancel(ctx), j); err != nil {
+		s.log(ctx).Error("failed to save job", zap.String("id", j.ID), zap.Error(err))
+	}
+	s.publish(j.ID, eventOf(j))
+	s.log(ctx).Info("job finished", zap.String("id", j.ID), zap.Duration("duration", finishedAt.Sub(startedAt)),
+		zap.Int64("failedItems", j.Failed))
+}
+
+// heartbeat saves the progress of the running job every saveInterval until the returned func is called
+func (s *scheduler) heartbeat(ctx context.Context, j *Job, progress *runProgress) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		ticker := time.NewTicker(saveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				progress.copyTo(j)
+				if err := s.save(ctx, j); err != nil {
+					s.log(ctx).Warn("failed to save job progress", zap.String("id", j.ID), zap.Error(err))
+				}
+			}
+		}
+	})
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+func (s *scheduler) fail(ctx context.Context, j *Job, cause error) {
+	finishedAt := utc.Now()
+	j.Status = StatusFailed
+	j.Error = cause.Error()
+	j.FinishedAt = &finishedAt
+	if err := s.save(context.WithoutCancel(ctx), j); err != nil {
+		s.log(ctx).Error("failed to save job", zap.String("id", j.ID), zap.Error(err))
+	}
+	s.publish(j.ID, eventOf(j))
+	s.log(ctx).Error("job failed", zap.String("id", j.ID), zap.String("type", string(j.Type)), zap.Error(cause))
+}
+
+func (s *scheduler) save(ctx context.Context, j *Job) error {
+	j.UpdatedAt = utc.Now()
+	return s.repo.Save(ctx, j)
+}
+
+func (s *scheduler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "job-scheduler"))
+}
+
+// runProgress collects the progress reported by a running task and publishes it to subscribers
+type runProgress struct {
+	scheduler *scheduler
+	jobID     string
+
+	mu         sync.Mutex
+	done       int64
+	total      int64
+	failed     int64
+	itemErrors []ItemError
+}
+
+func (p *runProgress) Advance(done, total int64) {
+	p.mu.Lock()
+	p.done, p.total = done, total
+	p.mu.Unlock()
+
+	p.scheduler.publish(p.jobID, Event{Status: StatusRunning, Done: done, Total: total})
+}
+
+func (p *runProgress) ItemFailed(item string, err error) {
+	p.mu.Lock()
+	itemErr := ItemError{Index: p.failed, Item: item, Error: err.Error()}
+	p.failed++
+	if len(p.itemErrors) < maxItemErrors {
+		p.itemErrors = append(p.itemErrors, itemErr)
+	}
+	done, total := p.done, p.total
+	p.mu.Unlock()
+
+	p.scheduler.publish(p.jobID, Event{Status: StatusRunning, Done: done, Total: total, ItemError: &itemErr})
+}
+
+func (p *runProgress) copyTo(j *Job) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	j.Done, j.Total = p.done, p.total
+	j.Failed = p.failed
+	j.ItemErrors = slices.Clone(p.itemErrors)
+}
@@ -0,0 +1,50 @@
+package job
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunProgress_PublishesToSubscribers(t *testing.T) {
+	s := &scheduler{subscribers: make(map[string]map[chan Event]struct{})}
+	events, unsubscribe := s.Subscribe("job-1")
+	other, unsubscribeOther := s.Subscribe("job-2")
+	defer unsubscribeOther()
+
+	p := &runProgress{scheduler: s, jobID: "job-1"}
+	p.Advance(1, 4)
+	p.ItemFailed("product-2", errors.New("boom"))
+
+	require.Len(t, events, 2)
+	assert.Equal(t, Event{Status: StatusRunning, Done: 1, Total: 4}, <-events)
+	itemEvent := <-events
+	assert.Equal(t, &ItemError{Index: 0, Item: "product-2", Error: "boom"}, itemEvent.ItemError)
+	assert.Empty(t, other)
+
+	unsubscribe()
+	p.Advance(2, 4)
+	assert.Empty(t, events)
+	assert.NotContains(t, s.subscribers, "job-1")
+}
+
+func TestRunProgress_KeepsFirstItemErrors(t *testing.T) {
+	p := &runProgress{scheduler: &scheduler{}, jobID: "job-1"}
+	for range maxItemErrors + 5 {
+		p.ItemFailed("product", errors.New("boom"))
+	}
+
+	j := &Job{}
+	p.copyTo(j)
+	assert.Equal(t, int64(maxItemErrors+5), j.Failed)
+	require.Len(t, j.ItemErrors, maxItemErrors)
+	assert.Equal(t, int64(maxItemErrors-1), j.ItemErrors[maxItemErrors-1].Index)
+}
+
+func TestJob_Percent(t *testing.T) {
+	assert.Equal(t, 0, (&Job{Status: StatusRunning}).Percent())
+	assert.Equal(t, 33, (&Job{Status: StatusRunning, Done: 1, Total: 3}).Percent())
+	assert.Equal(t, 100, (&Job{Status: StatusSucceeded}).Percent())
+}
@@ -0,0 +1,136 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// pollInterval is how often a watched job is reloaded, in case it runs on another replica
+const pollInterval = saveInterval
+
+type WatchJobQuery struct {
+	ID string
+}
+
+type WatchJobQueryHandler interface {
+	// Handle returns the events of the job, starting with its current state, and closes the channel
+	// after the job has finished or ctx is done. Jobs run by this replica report their progress and item errors
+	// as they occur; those run by another replica are reloaded every pollInterval.
+	Handle(ctx context.Context, query WatchJobQuery) (<-chan Event, error)
+}
+
+type watchJobHandler struct {
+	repo      Repository
+	scheduler Scheduler
+}
+
+func NewWatchJobHandler(repo Repository, scheduler Scheduler) WatchJobQueryHandler {
+	return &watchJobHandler{repo: repo, scheduler: scheduler}
+}
+
+func (h *watchJobHandler) Handle(ctx context.Context, query WatchJobQuery) (<-chan Event, error) {
+	// Subscribe before loading the job so no event falls in between
+	live, unsubscribe := h.scheduler.Subscribe(query.ID)
+
+	j, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		unsubscribe()
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		defer unsubscribe()
+
+		w := &watcher{ctx: ctx, events: events}
+		h.watch(w, j, live)
+	}()
+
+	return events, nil
+}
+
+func (h *watchJobHandler) watch(w *watcher, j *Job, live <-chan Event) {
+	markStale(j)
+	if !w.stored(j) || j.Finished() {
+		return
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case e := <-live:
+			if !w.emit(e) || e.Finished() {
+				return
+			}
+		case <-ticker.C:
+			reloaded, err := h.repo.FindByID(w.ctx, j.ID)
+			if err != nil {
+				h.log(w.ctx).Warn("failed to reload watched job", zap.String("id", j.ID), zap.Error(err))
+				continue
+			}
+			markStale(reloaded)
+			if !w.stored(reloaded) || reloaded.Finished() {
+				return
+			}
+		}
+	}
+}
+
+// watcher forwards the events of a job once, whether they arrive live or are reloaded from the repository
+type watcher struct {
+	ctx    context.Context
+	events chan<- Event
+	// sentErrors is the number of item errors sent, by index
+	sentErrors int64
+	last       *Event
+}
+
+// stored emits the item errors and the state of a loaded job that were not sent yet
+func (w *watcher) stored(j *Job) bool {
+	for _, itemErr := range j.ItemErrors {
+		if !w.emit(Event{Status: StatusRunning, Done: j.Done, Total: j.Total, ItemError: &itemErr}) {
+			return false
+		}
+	}
+	return w.emit(eventOf(j))
+}
+
+func (w *watcher) emit(e Event) bool {
+	if e.ItemError != nil {
+		if e.ItemError.Index < w.sentErrors {
+			return true
+		}
+		w.sentErrors = e.ItemError.Index + 1
+	} else if w.last != nil && !e.Finished() && e.Status == w.last.Status && e.Total == w.last.Total && e.Done <= w.last.Done {
+		// Reloaded progress lags behind the live one
+		return true
+	}
+
+	select {
+	case <-w.ctx.Done():
+		return false
+	case w.events <- e:
+	}
+	if e.ItemError == nil {
+		w.last = &e
+	}
+	return true
+}
+
+func (h *watchJobHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "watch-job-handler"))
+}
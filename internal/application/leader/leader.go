@@ -0,0 +1,148 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+const (
+	// leaseTTL is how long a lease lasts without renewal, it bounds the time until a dead leader is replaced
+	leaseTTL = 15 * time.Second
+	// renewInterval is how often the leader renews its lease and the others try to take it
+	renewInterval = 5 * time.Second
+)
+
+// Lock is a named lease shared by the replicas
+type Lock interface {
+	// Acquire takes the lease for owner until the given time if it is free, has expired or is already held by owner,
+	// and reports whether it got it
+	Acquire(ctx context.Context, name, owner string, until time.Time) (bool, error)
+	// Release gives up the lease if owner holds it
+	Release(ctx context.Context, name, owner string) error
+}
+
+// Elector runs background work on a single replica at a time
+type Elector interface {
+	// Run calls fn while this replica leads name, with a context cancelled when the leadership is lost.
+	// It competes for the leadership until ctx is done and hands it over right away on return,
+	// so a restarting replica doesn't leave the work idle until the lease expires.
+	Run(ctx context.Context, name string, fn func(ctx context.Context))
+	// Owner identifies this replica as lease holder
+	Owner() string
+}
+
+type elector struct {
+	lock          Lock
+	owner         string
+	leaseTTL      time.Duration
+	renewInterval time.Duration
+}
+
+func NewElector(lock Lock) Elector {
+	return &elector{
+		lock:          lock,
+		owner:         replicaName(),
+		leaseTTL:      leaseTTL,
+		renewInterval: renewInterval,
+	}
+}
+
+func (e *elector) Owner() string {
+	return e.owner
+}
+
+func (e *elector) Run(ctx context.Context, name string, fn func(ctx context.Context)) {
+	lockName := "leader:" + name
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		acquired, err := e.lock.Acquire(ctx, lockName, e.owner, time.Now().Add(e.leaseTTL))
+		if err != nil && ctx.Err() == nil {
+			e.log(ctx).Warn("failed to acquire leadership", zap.String("name", name), zap.Error(err))
+		}
+		if acquired {
+			e.lead(ctx, lockName, name, fn)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs fn and renews the lease until fn returns, the lease is lost or ctx is done, then releases it
+func (e *elector) lead(ctx context.Context, lockName, name string, fn func(ctx context.Context)) {
+	e.log(ctx).Info("leadership acquired", zap.String("name", name), zap.String("owner", e.owner))
+
+	leadCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		defer cancel()
+		fn(leadCtx)
+	})
+
+	e.renew(leadCtx, lockName, name)
+	cancel()
+	wg.Wait()
+
+	if err := e.lock.Release(context.WithoutCancel(ctx), lockName, e.owner); err != nil {
+		e.log(ctx).Warn("failed to release leadership", zap.String("name", name), zap.Error(err))
+	}
+	e.log(ctx).Info("leadership released", zap.String("name", name))
+}
+
+// renew extends the lease until ctx is done or the lease is lost. A leader that can't reach the lock
+// steps down before its lease expires, so two replicas never lead at once.
+func (e *elector) renew(ctx context.Context, lockName, name string) {
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		acquired, err := e.lock.Acquire(ctx, lockName, e.owner, now.Add(e.leaseTTL))
+		switch {
+		case acquired:
+			renewedAt = now
+		case err == nil:
+			e.log(ctx).Warn("leadership taken over", zap.String("name", name))
+			return
+		case ctx.Err() != nil:
+			return
+		default:
+			e.log(ctx).Warn("failed to renew leadership", zap.String("name", name), zap.Error(err))
+			if now.Sub(renewedAt) >= e.leaseTTL-e.renewInterval {
+				return
+			}
+		}
+	}
+}
+
+// replicaName identifies this replica as lease owner
+func replicaName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return host + "-" + uuid.New().String()[:8]
+}
+
+func (e *elector) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "leader-elector"))
+}
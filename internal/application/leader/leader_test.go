@@ -0,0 +1,120 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+// fakeLock is an in-process Lock
+type fakeLock struct {
+	mu     sync.Mutex
+	owners map[string]string
+	until  map[string]time.Time
+}
+
+func newFakeLock() *fakeLock {
+	return &fakeLock{owners: map[string]string{}, until: map[string]time.Time{}}
+}
+
+func (l *fakeLock) Acquire(_ context.Context, name, owner string, until time.Time) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if held, ok := l.owners[name]; ok && held != owner && l.until[name].After(time.Now()) {
+		return false, nil
+	}
+	l.owners[name], l.until[name] = owner, until
+	return true, nil
+}
+
+func (l *fakeLock) Release(_ context.Context, name, owner string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.owners[name] == owner {
+		delete(l.owners, name)
+	}
+	return nil
+}
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+func newTestElector(lock Lock, owner string) *elector {
+	return &elector{lock: lock, owner: owner, leaseTTL: 300 * time.Millisecond, renewInterval: 20 * time.Millisecond}
+}
+
+func TestElector_SingleLeaderAndHandover(t *testing.T) {
+	lock := newFakeLock()
+	var leading atomic.Int32
+	var led sync.Map
+	work := func(owner string) func(ctx context.Context) {
+		return func(ctx context.Context) {
+			assert.Equal(t, int32(1), leading.Add(1), "only one replica leads at a time")
+			led.Store(owner, true)
+			<-ctx.Done()
+			leading.Add(-1)
+		}
+	}
+
+	ctxA, stopA := context.WithCancel(testCtx())
+	ctxB, stopB := context.WithCancel(testCtx())
+	defer stopB()
+	var wg sync.WaitGroup
+	wg.Go(func() { newTestElector(lock, "a").Run(ctxA, "work", work("a")) })
+	require.Eventually(t, func() bool { return leading.Load() == 1 }, time.Second, 5*time.Millisecond)
+	wg.Go(func() { newTestElector(lock, "b").Run(ctxB, "work", work("b")) })
+
+	// The leader keeps renewing its lease past the TTL
+	time.Sleep(500 * time.Millisecond)
+	_, bLed := led.Load("b")
+	assert.False(t, bLed)
+
+	// A is shut down and hands over right away, well before its lease would expire
+	stopA()
+	require.Eventually(t, func() bool {
+		_, ok := led.Load("b")
+		return ok
+	}, 200*time.Millisecond, 5*time.Millisecond)
+
+	stopB()
+	wg.Wait()
+	assert.Empty(t, lock.owners, "the lease is released on shutdown")
+}
+
+func TestElector_StepsDownWhenTakenOver(t *testing.T) {
+	lock := newFakeLock()
+	e := newTestElector(lock, "a")
+	lost := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(testCtx())
+	defer cancel()
+	go e.Run(ctx, "work", func(ctx context.Context) {
+		// Another replica took the lease, e.g. after this one stalled past the TTL
+		lock.mu.Lock()
+		lock.owners["leader:work"], lock.until["leader:work"] = "b", time.Now().Add(time.Hour)
+		lock.mu.Unlock()
+
+		<-ctx.Done()
+		close(lost)
+	})
+
+	select {
+	case <-lost:
+	case <-time.After(time.Second):
+		t.Fatal("leader didn't step down")
+	}
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	assert.Equal(t, "b", lock.owners["leader:work"], "stepping down leaves the new lease alone")
+}
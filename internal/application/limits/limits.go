@@ -0,0 +1,63 @@
+// Package limits holds the sizes the catalog aggregates are validated against. The defaults suit
+// most shops; a deployment adjusts them through the validation configuration, and the handlers pass
+// them to the aggregates they create and change.
+// Counts are bounded as well as lengths: a product or attribute stays a single document, which
+// every query reads whole and Mongo caps at 16MB.
+package limits
+
+// Limits bounds the lengths and counts of the catalog aggregates
+type Limits struct {
+	Product   Product
+	Category  Category
+	Attribute Attribute
+}
+
+type Product struct {
+	NameLength int
+	// SlugLength also bounds derived slugs, which keep room for the ID suffix of a taken slug
+	SlugLength int
+	// AttributeValues is the number of attributes a product has values for
+	AttributeValues     int
+	MetadataKeys        int
+	MetadataValueLength int
+	// ImportItems is the number of products a single import can carry
+	ImportItems int
+}
+
+type Category struct {
+	NameLength int
+}
+
+type Attribute struct {
+	NameLength       int
+	SlugLength       int
+	Options          int
+	OptionNameLength int
+	OptionSlugLength int
+	InputUnits       int
+}
+
+// Default returns the limits the service applies unless it is configured otherwise
+func Default() Limits {
+	return Limits{
+		Product: Product{
+			NameLength:          255,
+			SlugLength:          255,
+			AttributeValues:     100,
+			MetadataKeys:        50,
+			MetadataValueLength: 1024,
+			ImportItems:         1000,
+		},
+		Category: Category{
+			NameLength: 255,
+		},
+		Attribute: Attribute{
+			NameLength:       100,
+			SlugLength:       50,
+			Options:          500,
+			OptionNameLength: 100,
+			OptionSlugLength: 50,
+			InputUnits:       20,
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// Package maintenance switches the service into read-only mode for planned database maintenance.
+//
+// While the service is read-only, mutating API calls are rejected with a hint when to retry and the
+// background publishers that write to the database pause. The mode is stored in the service database,
+// so it survives restarts and applies to every replica.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	// DefaultRetryAfter is suggested to rejected callers when the mode doesn't set a retry delay
+	DefaultRetryAfter = 5 * time.Minute
+
+	maxRetryAfter   = 24 * time.Hour
+	maxReasonLength = 500
+)
+
+var ErrInvalidMode = errors.New("invalid maintenance mode")
+
+// Mode is the maintenance state of the service; the zero value is the normal read-write mode
+type Mode struct {
+	ReadOnly bool
+	// Reason is shown to rejected callers
+	Reason string
+	// RetryAfter is the delay suggested to rejected callers
+	RetryAfter time.Duration
+	ModifiedAt time.Time
+}
+
+type Repository interface {
+	// Find returns the stored mode, or mongo.ErrEntityNotFound when it was never set
+	Find(ctx context.Context) (*Mode, error)
+	Save(ctx context.Context, mode *Mode) error
+}
+
+func newMode(readOnly bool, reason string, retryAfter time.Duration) (*Mode, error) {
+	if !readOnly {
+		return &Mode{ModifiedAt: utc.Now()}, nil
+	}
+
+	if len(reason) > maxReasonLength {
+		return nil, fmt.Errorf("%w: reason is too long (max %d characters)", ErrInvalidMode, maxReasonLength)
+	}
+	if retryAfter < 0 || retryAfter > maxRetryAfter {
+		return nil, fmt.Errorf("%w: retry after must be between 0 and %s", ErrInvalidMode, maxRetryAfter)
+	}
+	if retryAfter == 0 {
+		retryAfter = DefaultRetryAfter
+	}
+
+	return &Mode{
+		ReadOnly:   true,
+		Reason:     reason,
+		RetryAfter: retryAfter,
+		ModifiedAt: utc.Now(),
+	}, nil
+}
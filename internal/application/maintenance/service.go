@@ -0,0 +1,78 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type SetModeCommand struct {
+	ReadOnly bool
+	Reason   string
+	// RetryAfter is suggested to rejected callers; DefaultRetryAfter is used when zero
+	RetryAfter time.Duration
+}
+
+type Service interface {
+	// Current returns the mode this replica applies
+	Current() Mode
+	// Set stores the mode and applies it on this replica right away; the other replicas pick it up on their next reload
+	Set(ctx context.Context, cmd SetModeCommand) (Mode, error)
+	// Reload applies the stored mode and reports whether it changed
+	Reload(ctx context.Context) (bool, error)
+}
+
+type service struct {
+	repo    Repository
+	current atomic.Pointer[Mode]
+}
+
+// NewService starts in the read-write mode until the stored mode is loaded
+func NewService(repo Repository) Service {
+	s := &service{repo: repo}
+	s.current.Store(&Mode{})
+	return s
+}
+
+func (s *service) Current() Mode {
+	return *s.current.Load()
+}
+
+func (s *service) Set(ctx context.Context, cmd SetModeCommand) (Mode, error) {
+	mode, err := newMode(cmd.ReadOnly, cmd.Reason, cmd.RetryAfter)
+	if err != nil {
+		return Mode{}, err
+	}
+
+	if err := s.repo.Save(ctx, mode); err != nil {
+		return Mode{}, fmt.Errorf("failed to save maintenance mode: %w", err)
+	}
+	s.current.Store(mode)
+
+	s.log(ctx).Info("maintenance mode set", zap.Bool("readOnly", mode.ReadOnly), zap.String("reason", mode.Reason))
+
+	return *mode, nil
+}
+
+func (s *service) Reload(ctx context.Context) (bool, error) {
+	mode, err := s.repo.Find(ctx)
+	if errors.Is(err, mongo.ErrEntityNotFound) {
+		mode = &Mode{}
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get maintenance mode: %w", err)
+	}
+
+	previous := s.current.Swap(mode)
+	return previous.ReadOnly != mode.ReadOnly, nil
+}
+
+func (s *service) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "maintenance-service"))
+}
@@ -0,0 +1,84 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type stubRepository struct {
+	mode *Mode
+}
+
+func (r *stubRepository) Find(context.Context) (*Mode, error) {
+	if r.mode == nil {
+		return nil, mongo.ErrEntityNotFound
+	}
+	cloned := *r.mode
+	return &cloned, nil
+}
+
+func (r *stubRepository) Save(_ context.Context, mode *Mode) error {
+	cloned := *mode
+	r.mode = &cloned
+	return nil
+}
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+func TestService_SetAppliesAndStores(t *testing.T) {
+	repo := &stubRepository{}
+	s := NewService(repo)
+	assert.False(t, s.Current().ReadOnly)
+
+	mode, err := s.Set(testCtx(), SetModeCommand{ReadOnly: true, Reason: "database upgrade"})
+	require.NoError(t, err)
+
+	assert.True(t, mode.ReadOnly)
+	assert.Equal(t, DefaultRetryAfter, mode.RetryAfter)
+	assert.Equal(t, mode, s.Current())
+	require.NotNil(t, repo.mode)
+	assert.True(t, repo.mode.ReadOnly)
+
+	mode, err = s.Set(testCtx(), SetModeCommand{ReadOnly: false, Reason: "ignored", RetryAfter: time.Minute})
+	require.NoError(t, err)
+	assert.Equal(t, Mode{ModifiedAt: mode.ModifiedAt}, mode, "leaving read-only mode clears the details")
+}
+
+func TestService_SetRejectsInvalidMode(t *testing.T) {
+	s := NewService(&stubRepository{})
+
+	_, err := s.Set(testCtx(), SetModeCommand{ReadOnly: true, RetryAfter: 48 * time.Hour})
+
+	require.ErrorIs(t, err, ErrInvalidMode)
+	assert.False(t, s.Current().ReadOnly)
+}
+
+func TestService_ReloadPicksUpStoredMode(t *testing.T) {
+	repo := &stubRepository{}
+	s := NewService(repo)
+
+	changed, err := s.Reload(testCtx())
+	require.NoError(t, err)
+	assert.False(t, changed, "a mode that was never set is read-write")
+
+	// Set by another replica
+	repo.mode = &Mode{ReadOnly: true, RetryAfter: time.Minute}
+	changed, err = s.Reload(testCtx())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, s.Current().ReadOnly)
+
+	changed, err = s.Reload(testCtx())
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
@@ -1,9 +1,30 @@
 package application
 
 import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/privacy"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
 	"go.uber.org/fx"
 )
 
@@ -15,19 +36,191 @@ func Module() fx.Option {
 			product.NewCreateProductHandler,
 			product.NewUpdateProductHandler,
 			product.NewDeleteProductHandler,
+			product.NewImportProductsHandler,
+			product.NewMergeDuplicateAttributesHandler,
+			product.NewStartMergeDuplicateAttributesHandler,
+			product.NewRemapOptionHandler,
+			product.NewStartRemapOptionHandler,
+			product.NewResyncAttributeSlugsHandler,
+			product.NewStartResyncAttributeSlugsHandler,
+			product.NewStartFindDuplicateProductsHandler,
+			product.NewStartInventoryValuationHandler,
+			product.NewMergeProductsHandler,
+			product.NewArchiveProductsHandler,
+			product.NewRestoreProductHandler,
+			product.NewDiscontinueProductHandler,
+			product.NewRecordSalesHandler,
+			product.NewRecordProductViewHandler,
+			product.NewDecayProductViewsHandler,
+			product.NewSetExperimentsHandler,
+			product.NewWarmCacheHandler,
 			category.NewCreateCategoryHandler,
 			category.NewUpdateCategoryHandler,
+			category.NewSetCategoryDisplayHandler,
+			category.NewResyncAttributeSlugsHandler,
+			categorytemplate.NewApplyTemplateHandler,
+			taxonomy.NewImportAttributesHandler,
+			taxonomy.NewImportTaxonomyHandler,
+			envsync.NewSyncHandler,
 			attribute.NewCreateAttributeHandler,
 			attribute.NewUpdateAttributeHandler,
+			attribute.NewSetAttributeDisplayHandler,
+			attribute.NewDeprecateOptionHandler,
+			reservation.NewReserveStockHandler,
+			reservation.NewReleaseStockHandler,
+			reservation.NewExpireReservationsHandler,
+			availability.NewSetScheduleHandler,
+			palette.NewCreatePaletteHandler,
+			palette.NewUpdatePaletteHandler,
+			supplier.NewCreateSupplierHandler,
+			supplier.NewUpdateSupplierHandler,
+			supplier.NewDeleteSupplierHandler,
+			comment.NewAddCommentHandler,
+			savedview.NewCreateSavedViewHandler,
+			savedview.NewUpdateSavedViewHandler,
+			savedview.NewDeleteSavedViewHandler,
+			apikey.NewCreateAPIKeyHandler,
+			apikey.NewRotateAPIKeyHandler,
+			apikey.NewRevokeAPIKeyHandler,
+		),
+		// Services shared by handlers
+		fx.Provide(
+			product.NewAttributeEnricher,
+			product.NewCategoryPathResolver,
+			product.NewAttributeDefinitionResolver,
+			palette.NewPaletteColors,
+			supplier.NewSuppliers,
+		),
+		// Business rules of the deployment, provided to the "product_validation_hook" group
+		fx.Provide(
+			fx.Annotate(product.NewValidationHooks, fx.ParamTags(`group:"product_validation_hook"`)),
 		),
 		// Query handlers
 		fx.Provide(
 			product.NewGetProductByIDHandler,
+			product.NewGetProductBySlugHandler,
 			product.NewGetListProductsHandler,
+			product.NewGetSitemapHandler,
+			product.NewStreamProductsHandler,
+			product.NewSampleProductsHandler,
+			product.NewGetAttributeProductsHandler,
+			product.NewGetUncategorizedReportHandler,
+			product.NewCheckCategoryProductHandler,
+			product.NewGetListConfigHandler,
+			product.NewGetCategoryPriceStatsHandler,
+			product.NewFacetCache,
+			product.NewGetCategoryFacetsHandler,
+			product.NewNavigationCache,
+			product.NewGetNavigationHandler,
+			product.NewVerifyProductsHandler,
+			product.NewFindDuplicateProductsHandler,
+			product.NewInventoryValuationHandler,
+			product.NewGetInventoryValuationReportHandler,
 			category.NewGetCategoryByIDHandler,
 			category.NewGetListCategoriesHandler,
+			category.NewGetProductFormSchemaHandler,
+			categorytemplate.NewListTemplatesHandler,
+			taxonomy.NewExportAttributesHandler,
+			taxonomy.NewExportTaxonomyHandler,
 			attribute.NewGetAttributeByIDHandler,
 			attribute.NewGetAttributeListHandler,
+			attribute.NewGenerateSlugHandler,
+			availability.NewGetAvailabilityHandler,
+			palette.NewGetPaletteByIDHandler,
+			palette.NewListPalettesHandler,
+			supplier.NewGetSupplierByIDHandler,
+			supplier.NewGetSupplierListHandler,
+			comment.NewGetCommentListHandler,
+			savedview.NewGetSavedViewByIDHandler,
+			savedview.NewGetSavedViewListHandler,
+			savedview.NewExecuteSavedViewHandler,
+			job.NewGetJobByIDHandler,
+			job.NewWatchJobHandler,
+			apikey.NewGetAPIKeyListHandler,
+		),
+		// Admin operations
+		fx.Provide(
+			replay.NewService,
+			maintenance.NewService,
+		),
+		// Background jobs
+		fx.Provide(
+			job.NewScheduler,
+			job.NewFailStaleJobsHandler,
+			leader.NewElector,
+		),
+		// API key authentication
+		fx.Provide(
+			apikey.NewAuthenticator,
+		),
+		// Feature flags
+		fx.Provide(
+			feature.NewRegistry,
+			feature.NewFlags,
+		),
+		// Tenant quotas
+		fx.Provide(
+			provideQuotaCounters,
+			quota.NewEnforcer,
+			quota.NewGetUsageHandler,
+		),
+		// Data retention
+		fx.Provide(
+			provideScrubSources,
+			privacy.NewScrubUserHandler,
+		),
+		// Incremental sync
+		fx.Provide(
+			change.NewGetChangesHandler,
+		),
+		// CDN purges, replaced when a CDN is configured
+		fx.Provide(
+			edgecache.NewPurger,
 		),
 	)
 }
+
+// provideScrubSources lists the collections holding user identifiers
+func provideScrubSources(comments comment.Repository, views savedview.Repository) privacy.Sources {
+	return privacy.Sources{
+		{Collection: "product_comment", Replace: comments.ReplaceAuthor, Count: comments.CountByAuthor},
+		{
+			Collection: "saved_view",
+			Replace:    views.ReplaceOwner,
+			Count: func(ctx context.Context, userID string) (int64, error) {
+				page, err := views.FindList(ctx, savedview.ListQuery{Page: 1, Size: 1, Owner: &userID})
+				if err != nil {
+					return 0, err
+				}
+				return page.Total, nil
+			},
+		},
+	}
+}
+
+// provideQuotaCounters counts the items of each resource with the list totals of its repository
+func provideQuotaCounters(products product.Repository, categories category.Repository, attributes attribute.Repository) quota.Counters {
+	return quota.Counters{
+		quota.Products: func(ctx context.Context) (int64, error) {
+			page, err := products.FindList(ctx, product.ListQuery{Page: 1, Size: 1})
+			if err != nil {
+				return 0, err
+			}
+			return page.Total, nil
+		},
+		quota.Categories: func(ctx context.Context) (int64, error) {
+			page, err := categories.FindList(ctx, category.ListQuery{Page: 1, Size: 1})
+			if err != nil {
+				return 0, err
+			}
+			return page.Total, nil
+		},
+		quota.Attributes: func(ctx context.Context) (int64, error) {
+			page, err := attributes.FindList(ctx, attribute.ListQuery{Page: 1, Size: 1})
+			if err != nil {
+				return 0, err
+			}
+			return page.Total, nil
+		},
+	}
+}
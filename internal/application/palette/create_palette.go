@@ -0,0 +1,44 @@
+package palette
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type CreatePaletteCommand struct {
+	Name   string
+	Colors []Color
+}
+
+type CreatePaletteCommandHandler interface {
+	Handle(ctx context.Context, cmd CreatePaletteCommand) (*Palette, error)
+}
+
+type createPaletteHandler struct {
+	repo Repository
+}
+
+func NewCreatePaletteHandler(repo Repository) CreatePaletteCommandHandler {
+	return &createPaletteHandler{repo: repo}
+}
+
+func (h *createPaletteHandler) Handle(ctx context.Context, cmd CreatePaletteCommand) (*Palette, error) {
+	p, err := NewPalette(cmd.Name, cmd.Colors)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Insert(ctx, p); err != nil {
+		return nil, fmt.Errorf("failed to insert palette: %w", err)
+	}
+
+	h.log(ctx).Debug("palette created", zap.String("id", p.ID))
+	return p, nil
+}
+
+func (h *createPaletteHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "create-palette-handler"))
+}
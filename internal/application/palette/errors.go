@@ -0,0 +1,29 @@
+package palette
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+var (
+	ErrInvalidPaletteData = errors.New("invalid palette data")
+	// ErrPaletteNotFound is the error attributes report for a missing palette
+	ErrPaletteNotFound = attribute.ErrPaletteNotFound
+)
+
+// ColorsInUseError lists the colors an update would remove while options of attributes bound to
+// the palette still use them. It matches ErrInvalidPaletteData with errors.Is.
+type ColorsInUseError struct {
+	Codes []string
+}
+
+func (e *ColorsInUseError) Error() string {
+	return fmt.Sprintf("%s: colors used by attribute options: %s", ErrInvalidPaletteData, strings.Join(e.Codes, ", "))
+}
+
+func (e *ColorsInUseError) Unwrap() error {
+	return ErrInvalidPaletteData
+}
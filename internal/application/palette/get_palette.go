@@ -0,0 +1,36 @@
+package palette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetPaletteByIDQuery struct {
+	ID string
+}
+
+type GetPaletteByIDQueryHandler interface {
+	Handle(ctx context.Context, query GetPaletteByIDQuery) (*Palette, error)
+}
+
+type getPaletteByIDHandler struct {
+	repo Repository
+}
+
+func NewGetPaletteByIDHandler(repo Repository) GetPaletteByIDQueryHandler {
+	return &getPaletteByIDHandler{repo: repo}
+}
+
+func (h *getPaletteByIDHandler) Handle(ctx context.Context, query GetPaletteByIDQuery) (*Palette, error) {
+	p, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrPaletteNotFound
+		}
+		return nil, fmt.Errorf("failed to get palette: %w", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,26 @@
+package palette
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListPalettesQueryHandler interface {
+	Handle(ctx context.Context) ([]*Palette, error)
+}
+
+type listPalettesHandler struct {
+	repo Repository
+}
+
+func NewListPalettesHandler(repo Repository) ListPalettesQueryHandler {
+	return &listPalettesHandler{repo: repo}
+}
+
+func (h *listPalettesHandler) Handle(ctx context.Context) ([]*Palette, error) {
+	palettes, err := h.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get palettes: %w", err)
+	}
+	return palettes, nil
+}
@@ -0,0 +1,117 @@
+package palette
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const maxColors = 200
+
+// Color is a named entry of a palette; codes are stored upper-case, e.g. #FF0000
+type Color struct {
+	Name string
+	Code string
+}
+
+// Palette is a named set of colors swatch attributes pick their option colors from,
+// so storefront swatches of different attributes show the same shades
+type Palette struct {
+	ID         string
+	Version    int
+	Name       string
+	Colors     []Color
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+// NewPalette creates a new palette with validation
+func NewPalette(name string, colors []Color) (*Palette, error) {
+	colors, err := validatePalette(name, colors)
+	if err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
+	return &Palette{
+		ID:         uuid.New().String(),
+		Version:    1,
+		Name:       name,
+		Colors:     colors,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a palette from persistence (no validation)
+func Reconstruct(id string, version int, name string, colors []Color, createdAt, modifiedAt time.Time) *Palette {
+	return &Palette{
+		ID:         id,
+		Version:    version,
+		Name:       name,
+		Colors:     colors,
+		CreatedAt:  createdAt,
+		ModifiedAt: modifiedAt,
+	}
+}
+
+// Update replaces the name and colors with validation
+func (p *Palette) Update(name string, colors []Color) error {
+	colors, err := validatePalette(name, colors)
+	if err != nil {
+		return err
+	}
+
+	p.Name = name
+	p.Colors = colors
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	return nil
+}
+
+// ColorCodes returns the codes of the palette colors
+func (p *Palette) ColorCodes() []string {
+	codes := make([]string, len(p.Colors))
+	for i, c := range p.Colors {
+		codes[i] = c.Code
+	}
+	return codes
+}
+
+// validatePalette returns the colors with normalized codes
+func validatePalette(name string, colors []Color) ([]Color, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidPaletteData)
+	}
+	if len(name) > 100 {
+		return nil, fmt.Errorf("%w: name is too long (max 100 characters)", ErrInvalidPaletteData)
+	}
+	if len(colors) > maxColors {
+		return nil, fmt.Errorf("%w: too many colors (max %d)", ErrInvalidPaletteData, maxColors)
+	}
+
+	normalized := make([]Color, len(colors))
+	seen := make(map[string]bool, len(colors))
+	for i, c := range colors {
+		if c.Name == "" {
+			return nil, fmt.Errorf("%w: color name is required", ErrInvalidPaletteData)
+		}
+		if len(c.Name) > 100 {
+			return nil, fmt.Errorf("%w: color name is too long (max 100 characters)", ErrInvalidPaletteData)
+		}
+		if !attribute.IsColorCode(c.Code) {
+			return nil, fmt.Errorf("%w: color %s code must be a hex color like #FF0000", ErrInvalidPaletteData, c.Name)
+		}
+		c.Code = strings.ToUpper(c.Code)
+		if seen[c.Code] {
+			return nil, fmt.Errorf("%w: duplicate color code: %s", ErrInvalidPaletteData, c.Code)
+		}
+		seen[c.Code] = true
+		normalized[i] = c
+	}
+	return normalized, nil
+}
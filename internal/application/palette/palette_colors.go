@@ -0,0 +1,30 @@
+package palette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type paletteColors struct {
+	repo Repository
+}
+
+// NewPaletteColors lets attribute handlers check swatch colors against palettes
+func NewPaletteColors(repo Repository) attribute.PaletteColors {
+	return &paletteColors{repo: repo}
+}
+
+func (c *paletteColors) ColorCodes(ctx context.Context, paletteID string) ([]string, error) {
+	p, err := c.repo.FindByID(ctx, paletteID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaletteNotFound, paletteID)
+		}
+		return nil, fmt.Errorf("failed to get palette: %w", err)
+	}
+	return p.ColorCodes(), nil
+}
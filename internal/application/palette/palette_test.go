@@ -0,0 +1,50 @@
+package palette
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPalette(t *testing.T) {
+	tests := []struct {
+		name        string
+		palName     string
+		colors      []Color
+		errContains string
+	}{
+		{name: "valid palette", palName: "Brand", colors: []Color{{Name: "Red", Code: "#F00"}, {Name: "Blue", Code: "#0000FF"}}},
+		{name: "empty palette", palName: "Brand"},
+		{name: "missing name", palName: "", errContains: "name is required"},
+		{name: "long name", palName: strings.Repeat("a", 101), errContains: "name is too long"},
+		{name: "missing color name", palName: "Brand", colors: []Color{{Code: "#F00"}}, errContains: "color name is required"},
+		{name: "invalid code", palName: "Brand", colors: []Color{{Name: "Red", Code: "red"}}, errContains: "must be a hex color"},
+		{name: "duplicate code", palName: "Brand", colors: []Color{{Name: "Red", Code: "#ff0000"}, {Name: "Crimson", Code: "#FF0000"}}, errContains: "duplicate color code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPalette(tt.palName, tt.colors)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidPaletteData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, p.ID)
+			assert.Equal(t, 1, p.Version)
+		})
+	}
+}
+
+func TestPalette_ColorCodesAreUpperCase(t *testing.T) {
+	p, err := NewPalette("Brand", []Color{{Name: "Red", Code: "#ff0000"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"#FF0000"}, p.ColorCodes())
+
+	require.NoError(t, p.Update("Brand", []Color{{Name: "Blue", Code: "#00f"}}))
+	assert.Equal(t, []string{"#00F"}, p.ColorCodes())
+}
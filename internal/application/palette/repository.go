@@ -0,0 +1,17 @@
+package palette
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, palette *Palette) error
+
+	// FindByID returns a palette or mongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*Palette, error)
+
+	// FindAll returns all palettes sorted by name; tenants keep a handful of them
+	FindAll(ctx context.Context) ([]*Palette, error)
+
+	Update(ctx context.Context, palette *Palette) (*Palette, error)
+}
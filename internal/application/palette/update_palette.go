@@ -0,0 +1,103 @@
+package palette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type UpdatePaletteCommand struct {
+	ID      string
+	Version int
+	Name    string
+	Colors  []Color
+}
+
+type UpdatePaletteCommandHandler interface {
+	// Handle replaces the palette colors; colors still used by options of attributes bound to the
+	// palette can't be removed
+	Handle(ctx context.Context, cmd UpdatePaletteCommand) (*Palette, error)
+}
+
+type updatePaletteHandler struct {
+	repo     Repository
+	attrRepo attribute.Repository
+}
+
+func NewUpdatePaletteHandler(repo Repository, attrRepo attribute.Repository) UpdatePaletteCommandHandler {
+	return &updatePaletteHandler{
+		repo:     repo,
+		attrRepo: attrRepo,
+	}
+}
+
+func (h *updatePaletteHandler) Handle(ctx context.Context, cmd UpdatePaletteCommand) (*Palette, error) {
+	p, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrPaletteNotFound
+		}
+		return nil, fmt.Errorf("failed to get palette: %w", err)
+	}
+
+	if p.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := p.Update(cmd.Name, cmd.Colors); err != nil {
+		return nil, err
+	}
+
+	if err := h.checkColorsInUse(ctx, p); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.Update(ctx, p)
+	if err != nil {
+		if errors.Is(err, mongo.ErrOptimisticLocking) {
+			return nil, mongo.ErrOptimisticLocking
+		}
+		return nil, fmt.Errorf("failed to update palette: %w", err)
+	}
+
+	h.log(ctx).Debug("palette updated", zap.String("id", updated.ID))
+	return updated, nil
+}
+
+func (h *updatePaletteHandler) checkColorsInUse(ctx context.Context, p *Palette) error {
+	attrs, err := h.attrRepo.FindByPaletteID(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get attributes of palette: %w", err)
+	}
+
+	codes := p.ColorCodes()
+	var missing []string
+	for _, a := range attrs {
+		for _, opt := range a.Options {
+			if opt.ColorCode == nil {
+				continue
+			}
+			code := strings.ToUpper(*opt.ColorCode)
+			if !slices.Contains(codes, code) && !slices.Contains(missing, code) {
+				missing = append(missing, code)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return &ColorsInUseError{Codes: missing}
+	}
+	return nil
+}
+
+func (h *updatePaletteHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "update-palette-handler"))
+}
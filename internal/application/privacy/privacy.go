@@ -0,0 +1,29 @@
+// Package privacy erases the identifiers of users from the catalog on deletion requests.
+//
+// The catalog stores users only where a client names them: the authors of product comments and the
+// owners of saved views. Product and category revisions and events carry no user identifiers.
+// Erasing replaces the identifier with ErasedUser and keeps the records, which other users still need.
+package privacy
+
+import (
+	"context"
+	"errors"
+)
+
+// ErasedUser replaces the identifier of an erased user
+const ErasedUser = "erased-user"
+
+var ErrInvalidScrubRequest = errors.New("invalid scrub request")
+
+// Source is a collection holding user identifiers
+type Source struct {
+	// Collection names the collection in the report
+	Collection string
+	// Replace replaces the identifier of the user with the replacement and returns the number of records changed
+	Replace func(ctx context.Context, userID, replacement string) (int64, error)
+	// Count returns the number of records still holding the identifier of the user
+	Count func(ctx context.Context, userID string) (int64, error)
+}
+
+// Sources are all collections holding user identifiers
+type Sources []Source
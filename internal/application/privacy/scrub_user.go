@@ -0,0 +1,76 @@
+package privacy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type ScrubUserCommand struct {
+	UserID string
+}
+
+// ScrubReport tells what was erased in each collection and whether the user is gone from all of them
+type ScrubReport struct {
+	Collections []CollectionReport
+	// Verified is set when no collection holds the identifier any more
+	Verified bool
+}
+
+type CollectionReport struct {
+	Collection string
+	// Scrubbed is the number of records changed by this request
+	Scrubbed int64
+	// Remaining is the number of records holding the identifier after the request, written meanwhile
+	Remaining int64
+}
+
+type ScrubUserCommandHandler interface {
+	// Handle erases the user from every source of the tenant and counts what is left. It is idempotent,
+	// so an unverified report is resolved by running it again.
+	Handle(ctx context.Context, cmd ScrubUserCommand) (*ScrubReport, error)
+}
+
+type scrubUserHandler struct {
+	sources Sources
+}
+
+func NewScrubUserHandler(sources Sources) ScrubUserCommandHandler {
+	return &scrubUserHandler{sources: sources}
+}
+
+func (h *scrubUserHandler) Handle(ctx context.Context, cmd ScrubUserCommand) (*ScrubReport, error) {
+	userID := strings.TrimSpace(cmd.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", ErrInvalidScrubRequest)
+	}
+	if userID == ErasedUser {
+		return nil, fmt.Errorf("%w: %s is the placeholder of erased users", ErrInvalidScrubRequest, ErasedUser)
+	}
+
+	report := &ScrubReport{Verified: true}
+	for _, s := range h.sources {
+		scrubbed, err := s.Replace(ctx, userID, ErasedUser)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scrub %s: %w", s.Collection, err)
+		}
+		remaining, err := s.Count(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify %s: %w", s.Collection, err)
+		}
+
+		report.Collections = append(report.Collections, CollectionReport{Collection: s.Collection, Scrubbed: scrubbed, Remaining: remaining})
+		report.Verified = report.Verified && remaining == 0
+	}
+
+	// The user ID itself stays out of the logs
+	h.log(ctx).Info("user scrubbed", zap.Any("collections", report.Collections), zap.Bool("verified", report.Verified))
+	return report, nil
+}
+
+func (h *scrubUserHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "scrub-user-handler"))
+}
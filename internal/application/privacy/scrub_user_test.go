@@ -0,0 +1,95 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+// fakeSource holds the user IDs of the records of a collection
+type fakeSource struct {
+	users []string
+	// added is written after each replace, as by a concurrent request
+	added []string
+}
+
+func (f *fakeSource) source(name string) Source {
+	return Source{
+		Collection: name,
+		Replace: func(_ context.Context, userID, replacement string) (int64, error) {
+			var n int64
+			for i, u := range f.users {
+				if u == userID {
+					f.users[i] = replacement
+					n++
+				}
+			}
+			f.users = append(f.users, f.added...)
+			return n, nil
+		},
+		Count: func(_ context.Context, userID string) (int64, error) {
+			var n int64
+			for _, u := range f.users {
+				if u == userID {
+					n++
+				}
+			}
+			return n, nil
+		},
+	}
+}
+
+func TestScrubUser(t *testing.T) {
+	comments := &fakeSource{users: []string{"maria", "olena", "maria"}}
+	views := &fakeSource{users: []string{"olena"}}
+	h := NewScrubUserHandler(Sources{comments.source("comments"), views.source("views")})
+
+	report, err := h.Handle(testCtx(), ScrubUserCommand{UserID: " maria "})
+
+	require.NoError(t, err)
+	assert.True(t, report.Verified)
+	assert.Equal(t, []CollectionReport{{Collection: "comments", Scrubbed: 2}, {Collection: "views"}}, report.Collections)
+	assert.Equal(t, []string{ErasedUser, "olena", ErasedUser}, comments.users)
+}
+
+func TestScrubUser_RecordsWrittenMeanwhileAreReported(t *testing.T) {
+	comments := &fakeSource{users: []string{"maria"}, added: []string{"maria"}}
+	h := NewScrubUserHandler(Sources{comments.source("comments")})
+
+	report, err := h.Handle(testCtx(), ScrubUserCommand{UserID: "maria"})
+
+	require.NoError(t, err)
+	assert.False(t, report.Verified)
+	assert.Equal(t, []CollectionReport{{Collection: "comments", Scrubbed: 1, Remaining: 1}}, report.Collections)
+}
+
+func TestScrubUser_InvalidUser(t *testing.T) {
+	h := NewScrubUserHandler(nil)
+
+	for _, userID := range []string{"", "  ", ErasedUser} {
+		_, err := h.Handle(testCtx(), ScrubUserCommand{UserID: userID})
+		assert.ErrorIs(t, err, ErrInvalidScrubRequest, userID)
+	}
+}
+
+func TestScrubUser_SourceFails(t *testing.T) {
+	failure := errors.New("boom")
+	h := NewScrubUserHandler(Sources{{
+		Collection: "comments",
+		Replace:    func(context.Context, string, string) (int64, error) { return 0, failure },
+	}})
+
+	_, err := h.Handle(testCtx(), ScrubUserCommand{UserID: "maria"})
+
+	assert.ErrorIs(t, err, failure)
+}
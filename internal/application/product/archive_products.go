@@ -0,0 +1,71 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ArchiveProductsCommand struct {
+	// DisabledFor is how long a product has to stay disabled and unchanged before it is archived
+	DisabledFor time.Duration
+	// BatchSize is the number of products moved per transaction
+	BatchSize int
+}
+
+type ArchiveProductsCommandHandler interface {
+	// Handle moves the products of the current tenant that have been disabled and unchanged for
+	// cmd.DisabledFor to the archive, keeping the catalog collection small. It returns the number of products
+	// archived; a product changed while it is being archived stops the run, the next one picks it up again.
+	Handle(ctx context.Context, cmd ArchiveProductsCommand) (int, error)
+}
+
+type archiveProductsHandler struct {
+	repo      Repository
+	txManager mongo.TxManager
+}
+
+func NewArchiveProductsHandler(repo Repository, txManager mongo.TxManager) ArchiveProductsCommandHandler {
+	return &archiveProductsHandler{repo: repo, txManager: txManager}
+}
+
+func (h *archiveProductsHandler) Handle(ctx context.Context, cmd ArchiveProductsCommand) (int, error) {
+	before := utc.Now().Add(-cmd.DisabledFor)
+	count := 0
+	for {
+		products, err := h.repo.FindArchivable(ctx, before, cmd.BatchSize)
+		if err != nil {
+			return count, fmt.Errorf("failed to find archivable products: %w", err)
+		}
+		if len(products) == 0 {
+			return count, nil
+		}
+
+		_, err = mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (struct{}, error) {
+			return struct{}{}, h.repo.Archive(txCtx, products)
+		})
+		if errors.Is(err, mongo.ErrOptimisticLocking) {
+			h.log(ctx).Debug("product changed while archiving, run stopped", zap.Int("archived", count))
+			return count, nil
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to archive products: %w", err)
+		}
+		count += len(products)
+
+		if len(products) < cmd.BatchSize {
+			return count, nil
+		}
+	}
+}
+
+func (h *archiveProductsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "archive-products-handler"))
+}
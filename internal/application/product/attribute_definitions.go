@@ -0,0 +1,64 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+// attributeDefinitionTTL bounds how long an attribute change takes to show in embedded definitions
+const attributeDefinitionTTL = time.Minute
+
+// AttributeDefinitionResolver resolves the attributes of product values with their options, so a product
+// page can render its specification table without loading the attributes itself
+type AttributeDefinitionResolver interface {
+	// Definitions returns the attributes with the given IDs once each in the order of the IDs, leaving out deleted ones.
+	// Attributes are cached per tenant and shared between callers, so they must not be changed.
+	Definitions(ctx context.Context, tenant string, ids []string) ([]*attribute.Attribute, error)
+}
+
+type attributeDefinitionResolver struct {
+	attrRepo attribute.Repository
+	attrs    *ttlCache[*attribute.Attribute]
+}
+
+func NewAttributeDefinitionResolver(attrRepo attribute.Repository) AttributeDefinitionResolver {
+	return &attributeDefinitionResolver{attrRepo: attrRepo, attrs: newTTLCache[*attribute.Attribute](attributeDefinitionTTL)}
+}
+
+func (r *attributeDefinitionResolver) Definitions(ctx context.Context, tenant string, ids []string) ([]*attribute.Attribute, error) {
+	found := make(map[string]*attribute.Attribute, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if _, ok := found[id]; ok {
+			continue
+		}
+		a, ok := r.attrs.get(cacheKey(tenant, id))
+		if !ok {
+			missing = append(missing, id)
+		}
+		found[id] = a
+	}
+
+	if len(missing) > 0 {
+		loaded, err := r.attrRepo.FindByIDs(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load attribute definitions: %w", err)
+		}
+		for _, a := range loaded {
+			found[a.ID] = a
+			r.attrs.put(cacheKey(tenant, a.ID), a)
+		}
+	}
+
+	defs := make([]*attribute.Attribute, 0, len(found))
+	for _, id := range ids {
+		if a := found[id]; a != nil {
+			defs = append(defs, a)
+			found[id] = nil
+		}
+	}
+	return defs, nil
+}
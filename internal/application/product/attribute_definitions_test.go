@@ -0,0 +1,53 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+func TestAttributeDefinitionResolver_LoadsMissingOnly(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	resolver := NewAttributeDefinitionResolver(attrRepo).(*attributeDefinitionResolver)
+	now := time.Now()
+	resolver.attrs.now = func() time.Time { return now }
+
+	color := &attribute.Attribute{ID: "color", Slug: "color"}
+	size := &attribute.Attribute{ID: "size", Slug: "size"}
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"color"}).Return([]*attribute.Attribute{color}, nil).Once()
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"size", "gone"}).Return([]*attribute.Attribute{size}, nil).Once()
+
+	defs, err := resolver.Definitions(context.Background(), "acme", []string{"color"})
+	require.NoError(t, err)
+	assert.Equal(t, []*attribute.Attribute{color}, defs)
+
+	// Cached attributes are reused, deleted ones are left out, repeated IDs are returned once
+	defs, err = resolver.Definitions(context.Background(), "acme", []string{"size", "color", "gone", "size"})
+	require.NoError(t, err)
+	assert.Equal(t, []*attribute.Attribute{size, color}, defs)
+}
+
+func TestAttributeDefinitionResolver_ExpiresPerTenant(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	resolver := NewAttributeDefinitionResolver(attrRepo).(*attributeDefinitionResolver)
+	now := time.Now()
+	resolver.attrs.now = func() time.Time { return now }
+
+	color := &attribute.Attribute{ID: "color"}
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"color"}).Return([]*attribute.Attribute{color}, nil).Times(3)
+
+	_, err := resolver.Definitions(context.Background(), "acme", []string{"color"})
+	require.NoError(t, err)
+	_, err = resolver.Definitions(context.Background(), "globex", []string{"color"})
+	require.NoError(t, err)
+
+	now = now.Add(attributeDefinitionTTL)
+	_, err = resolver.Definitions(context.Background(), "acme", []string{"color"})
+	require.NoError(t, err)
+}
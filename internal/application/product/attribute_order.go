@@ -0,0 +1,94 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+// AttributeEnricher resolves the attributes referenced by products so their values
+// carry the attribute slug and list options in the attribute's option order
+type AttributeEnricher interface {
+	Enrich(ctx context.Context, products []*Product) error
+}
+
+type attributeEnricher struct {
+	attrRepo attribute.Repository
+}
+
+func NewAttributeEnricher(attrRepo attribute.Repository) AttributeEnricher {
+	return &attributeEnricher{attrRepo: attrRepo}
+}
+
+func (e *attributeEnricher) Enrich(ctx context.Context, products []*Product) error {
+	attrIDs := lo.Uniq(lo.FlatMap(products, func(p *Product, _ int) []string {
+		return lo.Map(p.Attributes, func(v AttributeValue, _ int) string { return v.AttributeID })
+	}))
+	if len(attrIDs) == 0 {
+		return nil
+	}
+
+	// Attributes deleted after the product was saved are tolerated, their values keep the stored order
+	attrs, err := e.attrRepo.FindByIDs(ctx, attrIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load product attributes: %w", err)
+	}
+
+	for _, p := range products {
+		p.Attributes = OrderAttributeValues(p.Attributes, attrs)
+	}
+	return nil
+}
+
+// OrderAttributeValues fills the attribute slug of each value and sorts its option slugs
+// by the option SortOrder of the attribute, ties keep the attribute's option list order.
+// Slugs that are not options of the attribute go last in their original order.
+func OrderAttributeValues(values []AttributeValue, attrs []*attribute.Attribute) []AttributeValue {
+	if len(values) == 0 {
+		return values
+	}
+
+	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	return lo.Map(values, func(v AttributeValue, _ int) AttributeValue {
+		a, ok := attrMap[v.AttributeID]
+		if !ok {
+			return v
+		}
+		v.AttributeSlug = a.Slug
+		if len(v.OptionSlugValues) > 1 {
+			v.OptionSlugValues = sortOptionSlugs(v.OptionSlugValues, a.Options)
+		}
+		return v
+	})
+}
+
+func sortOptionSlugs(slugs []string, options []attribute.Option) []string {
+	ordered := slices.Clone(options)
+	slices.SortStableFunc(ordered, func(a, b attribute.Option) int {
+		return a.SortOrder - b.SortOrder
+	})
+	rank := make(map[string]int, len(ordered))
+	for i, o := range ordered {
+		rank[o.Slug] = i
+	}
+
+	sorted := slices.Clone(slugs)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		return optionRank(rank, a) - optionRank(rank, b)
+	})
+	return sorted
+}
+
+func optionRank(rank map[string]int, slug string) int {
+	if r, ok := rank[slug]; ok {
+		return r
+	}
+	return len(rank)
+}
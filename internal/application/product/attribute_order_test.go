@@ -0,0 +1,146 @@
+package product
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+func newOrderTestAttribute(id, slug string, options ...attribute.Option) *attribute.Attribute {
+	now := time.Now().UTC()
+	return attribute.Reconstruct(id, 1, slug, slug, attribute.AttributeTypeMultiple, nil, true, options, "", nil, nil, now, now)
+}
+
+func TestOrderAttributeValues(t *testing.T) {
+	sizes := newOrderTestAttribute("attr-size", "size",
+		attribute.Option{Name: "L", Slug: "l", SortOrder: 3},
+		attribute.Option{Name: "S", Slug: "s", SortOrder: 1},
+		attribute.Option{Name: "M", Slug: "m", SortOrder: 2},
+	)
+	tied := newOrderTestAttribute("attr-material", "material",
+		attribute.Option{Name: "Wool", Slug: "wool"},
+		attribute.Option{Name: "Cotton", Slug: "cotton"},
+		attribute.Option{Name: "Silk", Slug: "silk"},
+	)
+
+	tests := []struct {
+		name   string
+		values []AttributeValue
+		attrs  []*attribute.Attribute
+		want   []AttributeValue
+	}{
+		{
+			name:   "options follow the attribute sort order",
+			values: []AttributeValue{{AttributeID: "attr-size", OptionSlugValues: []string{"l", "s", "m"}}},
+			attrs:  []*attribute.Attribute{sizes},
+			want:   []AttributeValue{{AttributeID: "attr-size", AttributeSlug: "size", OptionSlugValues: []string{"s", "m", "l"}}},
+		},
+		{
+			name:   "equal sort orders keep the attribute option list order",
+			values: []AttributeValue{{AttributeID: "attr-material", OptionSlugValues: []string{"silk", "cotton", "wool"}}},
+			attrs:  []*attribute.Attribute{tied},
+			want:   []AttributeValue{{AttributeID: "attr-material", AttributeSlug: "material", OptionSlugValues: []string{"wool", "cotton", "silk"}}},
+		},
+		{
+			name:   "unknown slugs go last in their original order",
+			values: []AttributeValue{{AttributeID: "attr-size", OptionSlugValues: []string{"xxl", "l", "xs", "s"}}},
+			attrs:  []*attribute.Attribute{sizes},
+			want:   []AttributeValue{{AttributeID: "attr-size", AttributeSlug: "size", OptionSlugValues: []string{"s", "l", "xxl", "xs"}}},
+		},
+		{
+			name: "values of missing attributes are left untouched",
+			values: []AttributeValue{
+				{AttributeID: "attr-gone", AttributeSlug: "gone", OptionSlugValues: []string{"b", "a"}},
+				{AttributeID: "attr-size", OptionSlugValue: ptr("m")},
+			},
+			attrs: []*attribute.Attribute{sizes},
+			want: []AttributeValue{
+				{AttributeID: "attr-gone", AttributeSlug: "gone", OptionSlugValues: []string{"b", "a"}},
+				{AttributeID: "attr-size", AttributeSlug: "size", OptionSlugValue: ptr("m")},
+			},
+		},
+		{
+			name:   "no values",
+			values: nil,
+			attrs:  []*attribute.Attribute{sizes},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, OrderAttributeValues(tt.values, tt.attrs))
+		})
+	}
+}
+
+func TestOrderAttributeValues_DoesNotModifyInput(t *testing.T) {
+	sizes := newOrderTestAttribute("attr-size", "size",
+		attribute.Option{Name: "S", Slug: "s", SortOrder: 1},
+		attribute.Option{Name: "M", Slug: "m", SortOrder: 2},
+	)
+	values := []AttributeValue{{AttributeID: "attr-size", OptionSlugValues: []string{"m", "s"}}}
+
+	OrderAttributeValues(values, []*attribute.Attribute{sizes})
+
+	assert.Equal(t, []string{"m", "s"}, values[0].OptionSlugValues)
+	assert.Empty(t, values[0].AttributeSlug)
+}
+
+func TestAttributeEnricher_Enrich(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	enricher := NewAttributeEnricher(attrRepo)
+
+	sizes := newOrderTestAttribute("attr-size", "size",
+		attribute.Option{Name: "S", Slug: "s", SortOrder: 1},
+		attribute.Option{Name: "M", Slug: "m", SortOrder: 2},
+	)
+	first := createTestProductForQuery("product-1")
+	first.Attributes = []AttributeValue{{AttributeID: "attr-size", OptionSlugValues: []string{"m", "s"}}}
+	second := createTestProductForQuery("product-2")
+	second.Attributes = []AttributeValue{
+		{AttributeID: "attr-size", OptionSlugValues: []string{"s", "m"}},
+		{AttributeID: "attr-gone", OptionSlugValues: []string{"b", "a"}},
+	}
+
+	attrRepo.EXPECT().
+		FindByIDs(mock.Anything, []string{"attr-size", "attr-gone"}).
+		Return([]*attribute.Attribute{sizes}, nil)
+
+	require.NoError(t, enricher.Enrich(testCtx(), []*Product{first, second}))
+
+	assert.Equal(t, []string{"s", "m"}, first.Attributes[0].OptionSlugValues)
+	assert.Equal(t, "size", first.Attributes[0].AttributeSlug)
+	assert.Equal(t, []string{"s", "m"}, second.Attributes[0].OptionSlugValues)
+	assert.Equal(t, []string{"b", "a"}, second.Attributes[1].OptionSlugValues)
+}
+
+func TestAttributeEnricher_Enrich_NoAttributes(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	enricher := NewAttributeEnricher(attrRepo)
+
+	require.NoError(t, enricher.Enrich(testCtx(), []*Product{createTestProductForQuery("product-1")}))
+}
+
+func TestAttributeEnricher_Enrich_RepositoryError(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	enricher := NewAttributeEnricher(attrRepo)
+
+	p := createTestProductForQuery("product-1")
+	p.Attributes = []AttributeValue{{AttributeID: "attr-size", OptionSlugValues: []string{"m", "s"}}}
+
+	attrRepo.EXPECT().
+		FindByIDs(mock.Anything, []string{"attr-size"}).
+		Return(nil, errors.New("database error"))
+
+	err := enricher.Enrich(testCtx(), []*Product{p})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load product attributes")
+}
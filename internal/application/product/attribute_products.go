@@ -0,0 +1,80 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetAttributeProductsQuery struct {
+	AttributeID string
+	// OptionSlug keeps the products picking the option; it may name an option removed from the attribute
+	// since, to find the products still carrying it
+	OptionSlug *string
+	Page       int
+	Size       int
+}
+
+type GetAttributeProductsQueryHandler interface {
+	// Handle lists the products with a value for the attribute, by ID, before renaming or merging its options.
+	// It returns mongo.ErrEntityNotFound for an unknown attribute.
+	Handle(ctx context.Context, query GetAttributeProductsQuery) (*ListProductsResult, error)
+}
+
+type getAttributeProductsHandler struct {
+	list     *getListProductsHandler
+	attrRepo attribute.Repository
+}
+
+func NewGetAttributeProductsHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	reservedStock ReservedStock,
+	enricher AttributeEnricher,
+) GetAttributeProductsQueryHandler {
+	return &getAttributeProductsHandler{
+		list:     &getListProductsHandler{repo: repo, reservedStock: reservedStock, enricher: enricher},
+		attrRepo: attrRepo,
+	}
+}
+
+func (h *getAttributeProductsHandler) Handle(ctx context.Context, query GetAttributeProductsQuery) (*ListProductsResult, error) {
+	a, err := h.attrRepo.FindByID(ctx, query.AttributeID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+	if query.OptionSlug != nil && !a.HasOptions() {
+		return nil, fmt.Errorf("%w: attribute %s has no options", ErrInvalidProductData, a.Slug)
+	}
+
+	result, err := h.list.repo.FindList(ctx, ListQuery{
+		Page:        query.Page,
+		Size:        query.Size,
+		AttributeID: &a.ID,
+		OptionSlug:  query.OptionSlug,
+		Sort:        "_id",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products of attribute: %w", err)
+	}
+
+	if err := h.list.applyReservedStock(ctx, result.Items); err != nil {
+		return nil, err
+	}
+	if err := h.list.enricher.Enrich(ctx, result.Items); err != nil {
+		return nil, err
+	}
+
+	return &ListProductsResult{
+		Items: result.Items,
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestGetAttributeProductsHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	attrRepo := attribute.NewMockRepository(t)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetAttributeProductsHandler(repo, attrRepo, reservedStock, enricher)
+
+	now := time.Now().UTC()
+	attrRepo.EXPECT().FindByID(mock.Anything, "attr-1").
+		Return(attribute.Reconstruct("attr-1", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, now, now), nil)
+
+	p := createTestProductForQuery("product-1")
+	repo.EXPECT().
+		FindList(mock.Anything, mock.MatchedBy(func(q ListQuery) bool {
+			return q.Page == 2 && q.Size == 5 && q.Sort == "_id" &&
+				q.AttributeID != nil && *q.AttributeID == "attr-1" &&
+				q.OptionSlug != nil && *q.OptionSlug == "red"
+		})).
+		Return(&mongo.PageResult[Product]{Items: []*Product{p}, Page: 2, Size: 5, Total: 6}, nil)
+	reservedStock.EXPECT().ReservedQuantities(mock.Anything, []string{"product-1"}).Return(map[string]int{}, nil)
+	enricher.EXPECT().Enrich(mock.Anything, []*Product{p}).Return(nil)
+
+	result, err := handler.Handle(context.Background(), GetAttributeProductsQuery{
+		AttributeID: "attr-1",
+		OptionSlug:  ptr("red"),
+		Page:        2,
+		Size:        5,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*Product{p}, result.Items)
+	assert.Equal(t, int64(6), result.Total)
+}
+
+func TestGetAttributeProductsHandler_Handle_OptionOfAttributeWithoutOptions(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	handler := NewGetAttributeProductsHandler(NewMockRepository(t), attrRepo, NewMockReservedStock(t), NewMockAttributeEnricher(t))
+
+	now := time.Now().UTC()
+	attrRepo.EXPECT().FindByID(mock.Anything, "attr-1").
+		Return(attribute.Reconstruct("attr-1", 1, "Weight", "weight", attribute.AttributeTypeRange, nil, true, nil, "", nil, nil, now, now), nil)
+
+	_, err := handler.Handle(context.Background(), GetAttributeProductsQuery{AttributeID: "attr-1", OptionSlug: ptr("heavy")})
+
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
+
+func TestGetAttributeProductsHandler_Handle_AttributeNotFound(t *testing.T) {
+	attrRepo := attribute.NewMockRepository(t)
+	handler := NewGetAttributeProductsHandler(NewMockRepository(t), attrRepo, NewMockReservedStock(t), NewMockAttributeEnricher(t))
+
+	attrRepo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), GetAttributeProductsQuery{AttributeID: "missing"})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
@@ -0,0 +1,46 @@
+package product
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+// ConvertAttributeUnits stores numeric values in the canonical unit of their attribute, so filters
+// never compare values in different units. Values submitted in an input unit are converted and keep
+// the submitted value; values submitted in the canonical unit drop it.
+func ConvertAttributeUnits(values []AttributeValue, attrs []*attribute.Attribute) ([]AttributeValue, error) {
+	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	result := make([]AttributeValue, len(values))
+	for i, v := range values {
+		a, ok := attrMap[v.AttributeID]
+		if !ok || a.Type != attribute.AttributeTypeRange {
+			if v.Submitted != nil {
+				return nil, fmt.Errorf("%w: attribute %s doesn't take values with a unit", ErrInvalidProductData, v.AttributeID)
+			}
+			result[i] = v
+			continue
+		}
+
+		if v.Submitted != nil {
+			canonical, ok := a.ToCanonical(v.Submitted.Value, v.Submitted.Unit)
+			if !ok {
+				return nil, fmt.Errorf("%w: unit %s is not accepted for attribute %s", ErrInvalidProductData, v.Submitted.Unit, a.Slug)
+			}
+			v.NumericValue = &canonical
+			if v.Submitted.Unit == *a.Unit {
+				v.Submitted = nil
+			}
+		}
+		if v.NumericValue != nil {
+			v.Unit = a.Unit
+		}
+		result[i] = v
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package product
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+func TestConvertAttributeUnits(t *testing.T) {
+	now := time.Now().UTC()
+	cm := "cm"
+	length := attribute.Reconstruct("attr-length", 1, "Length", "length", attribute.AttributeTypeRange, &cm, true, nil, "", nil,
+		[]attribute.UnitConversion{{Unit: "mm", Factor: 0.1}, {Unit: "m", Factor: 100}}, now, now)
+	count := attribute.Reconstruct("attr-count", 1, "Count", "count", attribute.AttributeTypeRange, nil, true, nil, "", nil, nil, now, now)
+	color := newOrderTestAttribute("attr-color", "color")
+	attrs := []*attribute.Attribute{length, count, color}
+
+	t.Run("converts input units and keeps the submitted value", func(t *testing.T) {
+		values := []AttributeValue{
+			{AttributeID: "attr-length", NumericValue: ptr(250.0), Submitted: &SubmittedValue{Value: 250, Unit: "mm"}},
+			{AttributeID: "attr-count", NumericValue: ptr(3.0)},
+			{AttributeID: "attr-color", OptionSlugValues: []string{"red"}},
+		}
+
+		got, err := ConvertAttributeUnits(values, attrs)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 25.0, *got[0].NumericValue, 1e-9)
+		assert.Equal(t, &cm, got[0].Unit)
+		assert.Equal(t, &SubmittedValue{Value: 250, Unit: "mm"}, got[0].Submitted)
+		assert.Equal(t, 3.0, *got[1].NumericValue)
+		assert.Nil(t, got[1].Unit)
+		assert.Nil(t, got[2].Unit)
+		assert.Equal(t, 250.0, *values[0].NumericValue, "input values are not modified")
+	})
+
+	t.Run("canonical unit drops the submitted value", func(t *testing.T) {
+		got, err := ConvertAttributeUnits([]AttributeValue{
+			{AttributeID: "attr-length", NumericValue: ptr(12.0), Submitted: &SubmittedValue{Value: 12, Unit: "cm"}},
+		}, attrs)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12.0, *got[0].NumericValue)
+		assert.Equal(t, &cm, got[0].Unit)
+		assert.Nil(t, got[0].Submitted)
+	})
+
+	t.Run("rejects units the attribute doesn't accept", func(t *testing.T) {
+		for _, v := range []AttributeValue{
+			{AttributeID: "attr-length", NumericValue: ptr(1.0), Submitted: &SubmittedValue{Value: 1, Unit: "in"}},
+			{AttributeID: "attr-count", NumericValue: ptr(1.0), Submitted: &SubmittedValue{Value: 1, Unit: "pcs"}},
+			{AttributeID: "attr-color", NumericValue: ptr(1.0), Submitted: &SubmittedValue{Value: 1, Unit: "mm"}},
+		} {
+			_, err := ConvertAttributeUnits([]AttributeValue{v}, attrs)
+			require.ErrorIs(t, err, ErrInvalidProductData, v.AttributeID)
+		}
+	})
+}
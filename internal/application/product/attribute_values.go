@@ -0,0 +1,65 @@
+package product
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// MergeAttributeValues collapses value entries sharing an attribute into a single entry kept at the
+// position of the first one. Later entries win for the values they set, and option slugs of the
+// multiple type are combined. Imports use it to fold rows that repeat an attribute into one product.
+func MergeAttributeValues(values []AttributeValue) []AttributeValue {
+	if _, ok := duplicateAttributeID(values); !ok {
+		return values
+	}
+
+	merged := make([]AttributeValue, 0, len(values))
+	index := make(map[string]int, len(values))
+	for _, v := range values {
+		i, ok := index[v.AttributeID]
+		if !ok {
+			index[v.AttributeID] = len(merged)
+			v.OptionSlugValues = slices.Clone(v.OptionSlugValues)
+			merged = append(merged, v)
+			continue
+		}
+		merged[i] = mergeAttributeValue(merged[i], v)
+	}
+	return merged
+}
+
+func mergeAttributeValue(dst, src AttributeValue) AttributeValue {
+	if src.AttributeSlug != "" {
+		dst.AttributeSlug = src.AttributeSlug
+	}
+	if src.OptionSlugValue != nil {
+		dst.OptionSlugValue = src.OptionSlugValue
+	}
+	if len(src.OptionSlugValues) > 0 {
+		dst.OptionSlugValues = lo.Uniq(append(dst.OptionSlugValues, src.OptionSlugValues...))
+	}
+	if src.NumericValue != nil {
+		dst.NumericValue = src.NumericValue
+		dst.Submitted = src.Submitted
+	}
+	if src.TextValue != nil {
+		dst.TextValue = src.TextValue
+	}
+	if src.BooleanValue != nil {
+		dst.BooleanValue = src.BooleanValue
+	}
+	return dst
+}
+
+// duplicateAttributeID returns the first attribute that has more than one value entry
+func duplicateAttributeID(values []AttributeValue) (string, bool) {
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if _, ok := seen[v.AttributeID]; ok {
+			return v.AttributeID, true
+		}
+		seen[v.AttributeID] = struct{}{}
+	}
+	return "", false
+}
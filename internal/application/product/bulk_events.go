@@ -0,0 +1,48 @@
+package product
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// BulkOperation names a bulk write whose events are configured by a BulkEventPolicy
+type BulkOperation string
+
+const (
+	BulkImportProducts           BulkOperation = "import-products"
+	BulkMergeDuplicateAttributes BulkOperation = "merge-duplicate-attributes"
+	BulkRemapOption              BulkOperation = "remap-option"
+	BulkResyncAttributeSlugs     BulkOperation = "resync-attribute-slugs"
+)
+
+// BulkOperations lists the bulk writes whose events can be configured
+func BulkOperations() []BulkOperation {
+	return []BulkOperation{BulkImportProducts, BulkMergeDuplicateAttributes, BulkRemapOption, BulkResyncAttributeSlugs}
+}
+
+// BulkEvents tells which events a bulk operation stores with each committed batch
+type BulkEvents struct {
+	// PerProduct stores the update event of every written product, and its price change,
+	// as single writes do
+	PerProduct bool
+	// Summary stores one BulkProductsImportedEvent listing the written products
+	Summary bool
+	// Batched stores the events with one outbox write. They are relayed by the outbox poller
+	// a few seconds later instead of being sent right after the commit.
+	Batched bool
+}
+
+// DefaultBulkEvents stores the event of every product one by one, like single writes
+var DefaultBulkEvents = BulkEvents{PerProduct: true}
+
+// BulkEventPolicy picks the events of each bulk operation
+type BulkEventPolicy interface {
+	Events(op BulkOperation) BulkEvents
+}
+
+// BatchOutbox stores outbox messages with one write, in the transaction of the context if any.
+// There is nothing to send after the commit: the outbox poller relays the messages.
+type BatchOutbox interface {
+	CreateBatch(ctx context.Context, msgs []outbox.Message) error
+}
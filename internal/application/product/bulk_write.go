@@ -0,0 +1,170 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// errItemsFailed rolls back a bulk write transaction in which some products failed
+var errItemsFailed = errors.New("some products failed")
+
+// bulkWrite is a bulk write of the repository, BulkInsert or BulkUpdate
+type bulkWrite func(ctx context.Context, products []*Product) ([]error, error)
+
+// bulkWriter stores products with bulk writes together with their events, as configured for the operation
+type bulkWriter struct {
+	outbox       outbox.Outbox
+	batchOutbox  BatchOutbox
+	txManager    mongo.TxManager
+	eventFactory ProductEventFactory
+	flags        feature.Flags
+	operation    BulkOperation
+	events       BulkEvents
+	// rollsBack is false when the tx manager leaves the writes made before an error stored
+	rollsBack bool
+}
+
+// rollbackReporter is implemented by tx managers that may not roll back, such as the non-transactional one
+// used with a standalone Mongo. Tx managers that don't implement it roll back.
+type rollbackReporter interface {
+	RollsBack() bool
+}
+
+func newBulkWriter(
+	op BulkOperation,
+	policy BulkEventPolicy,
+	outbox outbox.Outbox,
+	batchOutbox BatchOutbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+	flags feature.Flags,
+) *bulkWriter {
+	w := &bulkWriter{
+		outbox:       outbox,
+		batchOutbox:  batchOutbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+		flags:        flags,
+		operation:    op,
+		events:       policy.Events(op),
+		rollsBack:    true,
+	}
+	if r, ok := txManager.(rollbackReporter); ok {
+		w.rollsBack = r.RollsBack()
+	}
+	return w
+}
+
+// write stores the products and their events in one transaction and returns the error of each product,
+// nil for the written ones, and the sends of the events, none when they are batched. oldPrices holds the prices
+// of updated products before the update, to announce changed ones as the update handler does; it is nil when
+// prices can't change.
+//
+// A failed write aborts a Mongo transaction, so when some products fail, the transaction is rolled back
+// and run again without them. Each attempt writes copies, so the products only change once committed.
+// Without rollback, the products written by the first attempt are kept and the failed ones reported.
+func (w *bulkWriter) write(ctx context.Context, products []*Product, oldPrices []float64, write bulkWrite) ([]error, []outbox.SendFunc, error) {
+	errs := make([]error, len(products))
+	pending := make([]int, len(products))
+	for i := range pending {
+		pending[i] = i
+	}
+
+	for len(pending) > 0 {
+		batch := make([]*Product, len(pending))
+		for j, i := range pending {
+			p := *products[i]
+			batch[j] = &p
+		}
+
+		var itemErrs []error
+		sends, err := mongo.WithTransaction(ctx, w.txManager, func(txCtx context.Context) ([]outbox.SendFunc, error) {
+			var err error
+			if itemErrs, err = write(txCtx, batch); err != nil {
+				return nil, err
+			}
+			written, indexes := batch, pending
+			if slices.ContainsFunc(itemErrs, func(err error) bool { return err != nil }) {
+				if w.rollsBack {
+					return nil, errItemsFailed
+				}
+				written, indexes = nil, nil
+				for j, p := range batch {
+					if itemErrs[j] == nil {
+						written = append(written, p)
+						indexes = append(indexes, pending[j])
+					}
+				}
+				if len(written) == 0 {
+					return nil, nil
+				}
+			}
+
+			msgs := w.messages(txCtx, written, oldPrices, indexes)
+			if w.events.Batched {
+				if err := w.batchOutbox.CreateBatch(txCtx, msgs); err != nil {
+					return nil, fmt.Errorf("failed to create outbox: %w", err)
+				}
+				return nil, nil
+			}
+
+			sends := make([]outbox.SendFunc, 0, len(msgs))
+			for _, msg := range msgs {
+				send, err := w.outbox.Create(txCtx, msg)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create outbox: %w", err)
+				}
+				sends = append(sends, send)
+			}
+			return sends, nil
+		})
+		if errors.Is(err, errItemsFailed) {
+			retry := pending[:0]
+			for j, i := range pending {
+				if itemErrs[j] != nil {
+					errs[i] = itemErrs[j]
+				} else {
+					retry = append(retry, i)
+				}
+			}
+			pending = retry
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for j, i := range pending {
+			if itemErrs[j] != nil {
+				errs[i] = itemErrs[j]
+				continue
+			}
+			*products[i] = *batch[j]
+		}
+		return errs, sends, nil
+	}
+	return errs, nil, nil
+}
+
+// messages returns the events of the written products, oldPrices and indexes as passed to write
+func (w *bulkWriter) messages(ctx context.Context, batch []*Product, oldPrices []float64, indexes []int) []outbox.Message {
+	var msgs []outbox.Message
+	if w.events.PerProduct {
+		for j, p := range batch {
+			msgs = append(msgs, w.eventFactory.NewProductUpdatedOutboxMessage(ctx, p))
+			if oldPrices != nil && p.Price != oldPrices[indexes[j]] && w.flags.Enabled(feature.PriceChangedEvents) {
+				msgs = append(msgs, w.eventFactory.NewProductPriceChangedOutboxMessage(ctx, p, oldPrices[indexes[j]]))
+			}
+		}
+	}
+	if w.events.Summary {
+		msgs = append(msgs, w.eventFactory.NewBulkProductsImportedOutboxMessage(ctx, w.operation, batch))
+	}
+	return msgs
+}
@@ -0,0 +1,63 @@
+package product
+
+import (
+	"maps"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds each metadata cache; a full cache starts over, as the tenants' metadata is small
+const maxCacheEntries = 10000
+
+type cacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// ttlCache keeps the metadata read for product queries for a fixed time. Keys start with the tenant,
+// as the cache is shared by all of them.
+type ttlCache[V any] struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry[V]
+}
+
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	return &ttlCache[V]{ttl: ttl, now: time.Now, entries: make(map[string]cacheEntry[V])}
+}
+
+func cacheKey(tenant, id string) string {
+	return tenant + "/" + id
+}
+
+func (c *ttlCache[V]) get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expires) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+func (c *ttlCache[V]) put(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxCacheEntries {
+		c.entries = make(map[string]cacheEntry[V])
+	}
+	c.entries[key] = cacheEntry[V]{value: value, expires: c.now().Add(c.ttl)}
+}
+
+func (c *ttlCache[V]) deletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.DeleteFunc(c.entries, func(key string, _ cacheEntry[V]) bool { return strings.HasPrefix(key, prefix) })
+}
@@ -0,0 +1,31 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// checkCategoryAttributes makes sure the product only has values for attributes its category uses.
+// It applies to drafts too, and a product without a category can't have attribute values.
+// Enforced while feature.StrictAttributeValidation is enabled.
+func checkCategoryAttributes(p *Product, c *category.Category) error {
+	used := make(map[string]bool)
+	if c != nil {
+		for _, attr := range c.Attributes {
+			used[attr.AttributeID] = true
+		}
+	}
+
+	var unused []string
+	for _, v := range p.Attributes {
+		if !used[v.AttributeID] {
+			unused = append(unused, v.AttributeSlug)
+		}
+	}
+	if len(unused) > 0 {
+		return fmt.Errorf("%w: attributes not used by the category: %s", ErrInvalidProductData, strings.Join(unused, ", "))
+	}
+	return nil
+}
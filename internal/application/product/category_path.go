@@ -0,0 +1,56 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// categoryPathTTL bounds how long a renamed category keeps its old name in breadcrumbs
+const categoryPathTTL = time.Minute
+
+// Crumb is a step of the category path of a product
+type Crumb struct {
+	CategoryID string
+	Name       string
+}
+
+// CategoryPathResolver resolves the breadcrumbs of product categories for product pages
+type CategoryPathResolver interface {
+	// Path returns the crumbs from the top category down to the given one, empty when the category
+	// no longer exists. Paths are cached per tenant, so renames show up within a minute.
+	Path(ctx context.Context, tenant, categoryID string) ([]Crumb, error)
+}
+
+type categoryPathResolver struct {
+	categoryRepo category.Repository
+	paths        *ttlCache[[]Crumb]
+}
+
+func NewCategoryPathResolver(categoryRepo category.Repository) CategoryPathResolver {
+	return &categoryPathResolver{categoryRepo: categoryRepo, paths: newTTLCache[[]Crumb](categoryPathTTL)}
+}
+
+func (r *categoryPathResolver) Path(ctx context.Context, tenant, categoryID string) ([]Crumb, error) {
+	key := cacheKey(tenant, categoryID)
+	if crumbs, ok := r.paths.get(key); ok {
+		return crumbs, nil
+	}
+
+	// Categories have no parent yet, so the path is the category itself
+	var crumbs []Crumb
+	c, err := r.categoryRepo.FindByID(ctx, categoryID)
+	switch {
+	case err == nil:
+		crumbs = []Crumb{{CategoryID: c.ID, Name: c.Name}}
+	case !errors.Is(err, mongo.ErrEntityNotFound):
+		return nil, fmt.Errorf("failed to resolve category path: %w", err)
+	}
+
+	r.paths.put(key, crumbs)
+	return crumbs, nil
+}
@@ -0,0 +1,61 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestCategoryPathResolver_CachesPerTenant(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	resolver := NewCategoryPathResolver(categoryRepo).(*categoryPathResolver)
+	now := time.Now()
+	resolver.paths.now = func() time.Time { return now }
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "cat-1").Return(&category.Category{ID: "cat-1", Name: "Shoes"}, nil).Times(2)
+
+	for range 3 {
+		crumbs, err := resolver.Path(context.Background(), "acme", "cat-1")
+		require.NoError(t, err)
+		assert.Equal(t, []Crumb{{CategoryID: "cat-1", Name: "Shoes"}}, crumbs)
+	}
+	// Another tenant doesn't share the cached path
+	_, err := resolver.Path(context.Background(), "globex", "cat-1")
+	require.NoError(t, err)
+}
+
+func TestCategoryPathResolver_Expires(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	resolver := NewCategoryPathResolver(categoryRepo).(*categoryPathResolver)
+	now := time.Now()
+	resolver.paths.now = func() time.Time { return now }
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "cat-1").Return(&category.Category{ID: "cat-1", Name: "Shoes"}, nil).Once()
+	_, err := resolver.Path(context.Background(), "acme", "cat-1")
+	require.NoError(t, err)
+
+	now = now.Add(categoryPathTTL)
+	categoryRepo.EXPECT().FindByID(mock.Anything, "cat-1").Return(&category.Category{ID: "cat-1", Name: "Sneakers"}, nil).Once()
+	crumbs, err := resolver.Path(context.Background(), "acme", "cat-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Sneakers", crumbs[0].Name)
+}
+
+func TestCategoryPathResolver_DeletedCategory(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	resolver := NewCategoryPathResolver(categoryRepo)
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "gone").Return(nil, mongo.ErrEntityNotFound).Once()
+
+	crumbs, err := resolver.Path(context.Background(), "acme", "gone")
+
+	require.NoError(t, err)
+	assert.Empty(t, crumbs)
+}
@@ -0,0 +1,177 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// Reasons an attribute value doesn't fit a category
+const (
+	ProblemUnknownAttribute = "unknown-attribute"
+	ProblemNotInCategory    = "not-in-category"
+	ProblemDuplicate        = "duplicate"
+	ProblemWrongType        = "wrong-type"
+	ProblemUnknownOption    = "unknown-option"
+	ProblemUnknownUnit      = "unknown-unit"
+)
+
+// MissingAttribute is a required or variant attribute of the category without a value
+type MissingAttribute struct {
+	AttributeID string
+	Slug        string
+	Role        category.AttributeRole
+	// Required tells the product can't be enabled without it; variant attributes that aren't required
+	// are only needed to tell the variants apart
+	Required bool
+}
+
+// AttributeProblem tells why a value doesn't fit the category
+type AttributeProblem struct {
+	AttributeID string
+	// Slug is empty for unknown attributes
+	Slug    string
+	Reason  string
+	Message string
+}
+
+// CategoryProductCheck lists what keeps attribute values from fitting a category
+type CategoryProductCheck struct {
+	// Missing follows the order of the category attributes
+	Missing []MissingAttribute
+	// Invalid follows the order of the values
+	Invalid []AttributeProblem
+}
+
+// OK reports whether the values fit the category
+func (c *CategoryProductCheck) OK() bool {
+	return len(c.Missing) == 0 && len(c.Invalid) == 0
+}
+
+type CheckCategoryProductQuery struct {
+	CategoryID string
+	Attributes []AttributeValue
+}
+
+type CheckCategoryProductQueryHandler interface {
+	// Handle checks the attribute values of a product being edited against the category, so a form can point
+	// at the missing and invalid ones before saving. Nothing is stored. It returns mongo.ErrEntityNotFound
+	// for an unknown category.
+	Handle(ctx context.Context, query CheckCategoryProductQuery) (*CategoryProductCheck, error)
+}
+
+type checkCategoryProductHandler struct {
+	attrRepo     attribute.Repository
+	categoryRepo category.Repository
+	limits       limits.Product
+}
+
+func NewCheckCategoryProductHandler(attrRepo attribute.Repository, categoryRepo category.Repository, l limits.Limits) CheckCategoryProductQueryHandler {
+	return &checkCategoryProductHandler{attrRepo: attrRepo, categoryRepo: categoryRepo, limits: l.Product}
+}
+
+func (h *checkCategoryProductHandler) Handle(ctx context.Context, query CheckCategoryProductQuery) (*CategoryProductCheck, error) {
+	if maxValues := h.limits.AttributeValues; len(query.Attributes) > maxValues {
+		return nil, fmt.Errorf("%w: too many attribute values (max %d)", ErrInvalidProductData, maxValues)
+	}
+
+	c, err := h.categoryRepo.FindByID(ctx, query.CategoryID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	attrs, err := h.attrRepo.FindByIDs(ctx, lo.Uniq(lo.Map(query.Attributes, func(v AttributeValue, _ int) string { return v.AttributeID })))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	return checkCategoryProduct(c, lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID }), query.Attributes), nil
+}
+
+func checkCategoryProduct(c *category.Category, attrs map[string]*attribute.Attribute, values []AttributeValue) *CategoryProductCheck {
+	check := &CategoryProductCheck{}
+	used := lo.KeyBy(c.Attributes, func(ca category.CategoryAttribute) string { return ca.AttributeID })
+
+	seen := make(map[string]bool, len(values))
+	filled := make(map[string]bool, len(values))
+	for _, v := range values {
+		a, ok := attrs[v.AttributeID]
+		if !ok {
+			check.Invalid = append(check.Invalid, AttributeProblem{
+				AttributeID: v.AttributeID, Reason: ProblemUnknownAttribute, Message: "attribute not found",
+			})
+			continue
+		}
+		problem := func(reason, msg string, args ...any) {
+			check.Invalid = append(check.Invalid, AttributeProblem{
+				AttributeID: a.ID, Slug: a.Slug, Reason: reason, Message: fmt.Sprintf(msg, args...),
+			})
+		}
+
+		switch {
+		case seen[a.ID]:
+			problem(ProblemDuplicate, "more than one value for the attribute")
+		case !lo.HasKey(used, a.ID):
+			problem(ProblemNotInCategory, "the category doesn't use the attribute")
+		case v.HasValue() && !valueFitsType(v, a.Type):
+			problem(ProblemWrongType, "the attribute takes %s values", a.Type)
+		default:
+			for _, slug := range valueOptionSlugs(v) {
+				if _, ok := a.FindOption(slug); !ok {
+					problem(ProblemUnknownOption, "unknown option %s", slug)
+				}
+			}
+			if v.Submitted != nil {
+				if _, ok := a.ToCanonical(v.Submitted.Value, v.Submitted.Unit); !ok {
+					problem(ProblemUnknownUnit, "unit %s is not accepted", v.Submitted.Unit)
+				}
+			}
+		}
+		seen[a.ID] = true
+		filled[a.ID] = filled[a.ID] || v.HasValue()
+	}
+
+	for _, ca := range c.Attributes {
+		if filled[ca.AttributeID] || (!ca.Required && !ca.Role.CreatesVariants()) {
+			continue
+		}
+		check.Missing = append(check.Missing, MissingAttribute{
+			AttributeID: ca.AttributeID, Slug: ca.Slug, Role: ca.Role, Required: ca.Required,
+		})
+	}
+	return check
+}
+
+// valueFitsType reports whether the value is set the way attributes of the type take it
+func valueFitsType(v AttributeValue, attrType attribute.AttributeType) bool {
+	switch attrType {
+	case attribute.AttributeTypeSingle:
+		return v.OptionSlugValue != nil
+	case attribute.AttributeTypeMultiple:
+		return len(v.OptionSlugValues) > 0
+	case attribute.AttributeTypeRange:
+		return v.NumericValue != nil
+	case attribute.AttributeTypeBoolean:
+		return v.BooleanValue != nil
+	case attribute.AttributeTypeText:
+		return v.TextValue != nil
+	}
+	return false
+}
+
+func valueOptionSlugs(v AttributeValue) []string {
+	if v.OptionSlugValue != nil {
+		return []string{*v.OptionSlugValue}
+	}
+	return v.OptionSlugValues
+}
@@ -0,0 +1,112 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestCheckCategoryProduct(t *testing.T) {
+	now := time.Now().UTC()
+	color := attribute.Reconstruct("color", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true,
+		[]attribute.Option{{Name: "Red", Slug: "red"}, {Name: "Blue", Slug: "blue"}}, "", nil, nil, now, now)
+	size := attribute.Reconstruct("size", 1, "Size", "size", attribute.AttributeTypeMultiple, nil, true,
+		[]attribute.Option{{Name: "S", Slug: "s"}, {Name: "M", Slug: "m"}}, "", nil, nil, now, now)
+	weight := attribute.Reconstruct("weight", 1, "Weight", "weight", attribute.AttributeTypeRange, ptr("kg"), true,
+		nil, "", nil, []attribute.UnitConversion{{Unit: "g", Factor: 0.001}}, now, now)
+	material := attribute.Reconstruct("material", 1, "Material", "material", attribute.AttributeTypeText, nil, true, nil, "", nil, nil, now, now)
+	season := attribute.Reconstruct("season", 1, "Season", "season", attribute.AttributeTypeText, nil, true, nil, "", nil, nil, now, now)
+	attrs := map[string]*attribute.Attribute{"color": color, "size": size, "weight": weight, "material": material, "season": season}
+
+	c := category.Reconstruct("shirts", 1, "Shirts", true, []category.CategoryAttribute{
+		{AttributeID: "color", Slug: "color", Role: category.AttributeRoleVariant, Required: true},
+		{AttributeID: "size", Slug: "size", Role: category.AttributeRoleVariant},
+		{AttributeID: "weight", Slug: "weight", Role: category.AttributeRoleSpecification, Required: true},
+		{AttributeID: "material", Slug: "material", Role: category.AttributeRoleDescription},
+	}, category.Display{}, now, now)
+
+	tests := []struct {
+		name        string
+		values      []AttributeValue
+		wantMissing []string
+		wantInvalid []string
+	}{
+		{
+			name: "complete",
+			values: []AttributeValue{
+				{AttributeID: "color", OptionSlugValue: ptr("red")},
+				{AttributeID: "size", OptionSlugValues: []string{"s", "m"}},
+				{AttributeID: "weight", NumericValue: ptr(500.0), Submitted: &SubmittedValue{Value: 500, Unit: "g"}},
+			},
+		},
+		{
+			name:        "nothing set",
+			wantMissing: []string{"color", "size", "weight"},
+		},
+		{
+			name: "empty entries are missing",
+			values: []AttributeValue{
+				{AttributeID: "color"},
+				{AttributeID: "size", OptionSlugValues: []string{"s"}},
+				{AttributeID: "weight", NumericValue: ptr(1.0)},
+			},
+			wantMissing: []string{"color"},
+		},
+		{
+			name: "invalid values",
+			values: []AttributeValue{
+				{AttributeID: "color", OptionSlugValue: ptr("green")},
+				{AttributeID: "size", OptionSlugValue: ptr("s")},
+				{AttributeID: "weight", NumericValue: ptr(1.0), Submitted: &SubmittedValue{Value: 1, Unit: "lb"}},
+				{AttributeID: "weight", NumericValue: ptr(2.0)},
+				{AttributeID: "season", TextValue: ptr("summer")},
+				{AttributeID: "gone", TextValue: ptr("x")},
+			},
+			wantInvalid: []string{
+				"color:" + ProblemUnknownOption,
+				"size:" + ProblemWrongType,
+				"weight:" + ProblemUnknownUnit,
+				"weight:" + ProblemDuplicate,
+				"season:" + ProblemNotInCategory,
+				"gone:" + ProblemUnknownAttribute,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := checkCategoryProduct(c, attrs, tt.values)
+
+			var missing, invalid []string
+			for _, m := range check.Missing {
+				missing = append(missing, m.Slug)
+			}
+			for _, p := range check.Invalid {
+				invalid = append(invalid, p.AttributeID+":"+p.Reason)
+			}
+			assert.Equal(t, tt.wantMissing, missing)
+			assert.Equal(t, tt.wantInvalid, invalid)
+			assert.Equal(t, tt.wantMissing == nil && tt.wantInvalid == nil, check.OK())
+		})
+	}
+}
+
+func TestCheckCategoryProductHandler_Handle_CategoryNotFound(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	handler := NewCheckCategoryProductHandler(attribute.NewMockRepository(t), categoryRepo, limits.Default())
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), CheckCategoryProductQuery{CategoryID: "missing"})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
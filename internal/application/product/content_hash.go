@@ -0,0 +1,81 @@
+package product
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// contentFields are the client-provided fields covered by the content hash.
+// Versions, timestamps and slugs derived by the service are left out, so an import
+// can compare what it sent with what is stored.
+type contentFields struct {
+	Name        string           `json:"name"`
+	Type        ProductType      `json:"type"`
+	Description *string          `json:"description"`
+	Price       float64          `json:"price"`
+	Quantity    int              `json:"quantity"`
+	ImageID     *string          `json:"imageId"`
+	CategoryID  *string          `json:"categoryId"`
+	Enabled     bool             `json:"enabled"`
+	Attributes  []attributeField `json:"attributes"`
+	// Left out unless set, so hashes of products without metadata, supplier or external ID don't change
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	SupplierID  *string           `json:"supplierId,omitempty"`
+	SupplierSKU *string           `json:"supplierSku,omitempty"`
+	ExternalID  *string           `json:"externalId,omitempty"`
+}
+
+type attributeField struct {
+	AttributeID      string   `json:"attributeId"`
+	OptionSlugValue  *string  `json:"optionSlugValue"`
+	OptionSlugValues []string `json:"optionSlugValues"`
+	NumericValue     *float64 `json:"numericValue"`
+	TextValue        *string  `json:"textValue"`
+	BooleanValue     *bool    `json:"booleanValue"`
+	// Left out unless set, so hashes of values without units don't change
+	Submitted *submittedField `json:"submitted,omitempty"`
+}
+
+type submittedField struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// ContentHash returns the hex SHA-256 of the product content
+func (p *Product) ContentHash() string {
+	fields := contentFields{
+		Name:        p.Name,
+		Type:        p.Type,
+		Description: p.Description,
+		Price:       p.Price,
+		Quantity:    p.Quantity,
+		ImageID:     p.ImageID,
+		CategoryID:  p.CategoryID,
+		Enabled:     p.Enabled,
+		Attributes:  make([]attributeField, len(p.Attributes)),
+		Metadata:    p.Metadata,
+		ExternalID:  p.ExternalID,
+	}
+	if p.Supplier != nil {
+		fields.SupplierID = &p.Supplier.SupplierID
+		fields.SupplierSKU = p.Supplier.SKU
+	}
+	for i, a := range p.Attributes {
+		fields.Attributes[i] = attributeField{
+			AttributeID:      a.AttributeID,
+			OptionSlugValue:  a.OptionSlugValue,
+			OptionSlugValues: a.OptionSlugValues,
+			NumericValue:     a.NumericValue,
+			TextValue:        a.TextValue,
+			BooleanValue:     a.BooleanValue,
+		}
+		if a.Submitted != nil {
+			fields.Attributes[i].Submitted = &submittedField{Value: a.Submitted.Value, Unit: a.Submitted.Unit}
+		}
+	}
+
+	data, _ := json.Marshal(fields) //nolint:errcheck // plain structs always marshal
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -2,10 +2,14 @@ package product
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -17,6 +21,8 @@ import (
 type CreateProductCommand struct {
 	ID          *uuid.UUID
 	Name        string
+	Slug        string // derived from the name when empty
+	Type        string // "physical" (default) or "service"
 	Description *string
 	Price       float64
 	Quantity    int
@@ -24,6 +30,12 @@ type CreateProductCommand struct {
 	CategoryID  *string
 	Enabled     bool
 	Attributes  []AttributeValue
+	// Metadata is integration data such as ERP codes, see Product.Metadata
+	Metadata map[string]string
+	// Supplier links the product to a supplier; nil leaves it unlinked
+	Supplier *SupplierRef
+	// ExternalID is the key of the product in the feed it is imported from, see Product.ExternalID
+	ExternalID *string
 }
 
 type CreateProductCommandHandler interface {
@@ -34,68 +46,140 @@ type createProductHandler struct {
 	repo         Repository
 	attrRepo     attribute.Repository
 	categoryRepo category.Repository
+	suppliers    Suppliers
 	outbox       outbox.Outbox
 	txManager    mongo.TxManager
 	eventFactory ProductEventFactory
+	flags        feature.Flags
+	quotas       quota.Enforcer
+	hooks        ValidationHooks
+	limits       limits.Product
 }
 
 func NewCreateProductHandler(
 	repo Repository,
 	attrRepo attribute.Repository,
 	categoryRepo category.Repository,
+	suppliers Suppliers,
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory ProductEventFactory,
+	flags feature.Flags,
+	quotas quota.Enforcer,
+	hooks ValidationHooks,
+	l limits.Limits,
 ) CreateProductCommandHandler {
 	return &createProductHandler{
 		repo:         repo,
 		attrRepo:     attrRepo,
 		categoryRepo: categoryRepo,
+		suppliers:    suppliers,
 		outbox:       outbox,
 		txManager:    txManager,
 		eventFactory: eventFactory,
+		flags:        flags,
+		quotas:       quotas,
+		hooks:        hooks,
+		limits:       l.Product,
 	}
 }
 
 func (h *createProductHandler) Handle(ctx context.Context, cmd CreateProductCommand) (*Product, error) {
-	if err := h.validateCategory(ctx, cmd.CategoryID); err != nil {
+	p, err := h.prepare(ctx, cmd)
+	if err != nil {
 		return nil, err
 	}
 
-	attrs, err := h.buildAttributes(ctx, cmd.Attributes)
+	return h.persistAndPublish(ctx, p)
+}
+
+// prepare validates the command against the category, attributes and supplier it refers to
+// and returns the product to insert, with a free slug
+func (h *createProductHandler) prepare(ctx context.Context, cmd CreateProductCommand) (*Product, error) {
+	var c *category.Category
+	if cmd.CategoryID != nil {
+		var err error
+		if c, err = h.findCategory(ctx, *cmd.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	values, attrs, err := h.buildAttributes(ctx, cmd.Attributes)
 	if err != nil {
 		return nil, err
 	}
-	cmd.Attributes = attrs
+	cmd.Attributes = values
 
 	p, err := h.createProduct(cmd)
 	if err != nil {
 		return nil, err
 	}
+	p.flagDeprecatedOptions(attrs)
 
-	msg := h.eventFactory.NewProductUpdatedOutboxMessage(ctx, p)
+	if len(cmd.Metadata) > 0 {
+		if err := p.ChangeMetadata(h.limits, cmd.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to create product: %w", err)
+		}
+	}
 
-	return h.persistAndPublish(ctx, p, msg)
-}
+	if cmd.Supplier != nil {
+		if err := p.ChangeSupplier(cmd.Supplier); err != nil {
+			return nil, fmt.Errorf("failed to create product: %w", err)
+		}
+		if err := checkSupplier(ctx, h.suppliers, p); err != nil {
+			return nil, err
+		}
+	}
 
-func (h *createProductHandler) validateCategory(ctx context.Context, categoryID *string) error {
-	if categoryID == nil {
-		return nil
+	if cmd.ExternalID != nil {
+		if err := p.ChangeExternalID(cmd.ExternalID); err != nil {
+			return nil, fmt.Errorf("failed to create product: %w", err)
+		}
 	}
 
-	exists, err := h.categoryRepo.Exists(ctx, *categoryID)
-	if err != nil {
-		return fmt.Errorf("failed to check category: %w", err)
+	if err := checkRequiredAttributes(p, c); err != nil {
+		return nil, err
 	}
-	if !exists {
-		return ErrCategoryNotFound
+	p.scoreQuality(c)
+
+	if h.flags.Enabled(feature.StrictAttributeValidation) {
+		if err := checkCategoryAttributes(p, c); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+
+	if err := h.hooks.check(ctx, p, c); err != nil {
+		return nil, err
+	}
+
+	if err := claimSlug(ctx, h.repo, p, cmd.Slug == ""); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// findCategory loads the category the product is assigned to
+func (h *createProductHandler) findCategory(ctx context.Context, categoryID string) (*category.Category, error) {
+	c, err := h.categoryRepo.FindByID(ctx, categoryID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return c, nil
 }
 
-func (h *createProductHandler) buildAttributes(ctx context.Context, productAttrs []AttributeValue) ([]AttributeValue, error) {
+// buildAttributes checks the values against their attributes, converts and orders them, and returns
+// the attributes loaded for them
+func (h *createProductHandler) buildAttributes(ctx context.Context, productAttrs []AttributeValue) ([]AttributeValue, []*attribute.Attribute, error) {
 	if len(productAttrs) == 0 {
-		return productAttrs, nil
+		return productAttrs, nil, nil
+	}
+
+	// Checked before the lookup, which would report a repeated attribute as missing
+	if err := validateAttributeValues(h.limits, productAttrs); err != nil {
+		return nil, nil, err
 	}
 
 	attrIDs := lo.Map(productAttrs, func(attr AttributeValue, _ int) string {
@@ -104,19 +188,15 @@ func (h *createProductHandler) buildAttributes(ctx context.Context, productAttrs
 
 	attrs, err := h.attrRepo.FindByIDsOrFail(ctx, attrIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
-		return a.ID
-	})
+	productAttrs, err = ConvertAttributeUnits(productAttrs, attrs)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return lo.Map(productAttrs, func(attr AttributeValue, _ int) AttributeValue {
-		if a, ok := attrMap[attr.AttributeID]; ok {
-			attr.AttributeSlug = a.Slug
-		}
-		return attr
-	}), nil
+	return OrderAttributeValues(productAttrs, attrs), attrs, nil
 }
 
 func (h *createProductHandler) createProduct(cmd CreateProductCommand) (*Product, error) {
@@ -124,9 +204,9 @@ func (h *createProductHandler) createProduct(cmd CreateProductCommand) (*Product
 	var err error
 
 	if cmd.ID != nil {
-		p, err = NewProductWithID(cmd.ID.String(), cmd.Name, cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, cmd.Attributes)
+		p, err = NewProductWithID(h.limits, cmd.ID.String(), cmd.Name, cmd.Slug, ProductType(cmd.Type), cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, cmd.Attributes)
 	} else {
-		p, err = NewProduct(cmd.Name, cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, cmd.Attributes)
+		p, err = NewProduct(h.limits, cmd.Name, cmd.Slug, ProductType(cmd.Type), cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, cmd.Attributes)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create product: %w", err)
@@ -137,7 +217,6 @@ func (h *createProductHandler) createProduct(cmd CreateProductCommand) (*Product
 func (h *createProductHandler) persistAndPublish(
 	ctx context.Context,
 	p *Product,
-	msg outbox.Message,
 ) (*Product, error) {
 	type createResult struct {
 		Product *Product
@@ -145,11 +224,14 @@ func (h *createProductHandler) persistAndPublish(
 	}
 
 	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*createResult, error) {
+		if err := h.quotas.Check(txCtx, quota.Products, 1); err != nil {
+			return nil, err
+		}
 		if err := h.repo.Insert(txCtx, p); err != nil {
 			return nil, fmt.Errorf("failed to insert product: %w", err)
 		}
 
-		send, err := h.outbox.Create(txCtx, msg)
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductUpdatedOutboxMessage(txCtx, p))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox: %w", err)
 		}
@@ -0,0 +1,44 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+// staticAttributeRepo serves a fixed attribute set without mock bookkeeping, so the benchmark
+// measures enrichment rather than testify call matching
+type staticAttributeRepo struct {
+	attribute.Repository
+	attrs []*attribute.Attribute
+}
+
+func (r *staticAttributeRepo) FindByIDsOrFail(_ context.Context, _ []string) ([]*attribute.Attribute, error) {
+	return r.attrs, nil
+}
+
+func BenchmarkCreateProductHandler_BuildAttributes(b *testing.B) {
+	for _, n := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("attributes=%d", n), func(b *testing.B) {
+			attrs := make([]*attribute.Attribute, n)
+			values := make([]AttributeValue, n)
+			for i := range n {
+				id := fmt.Sprintf("attr-%d", i)
+				attrs[i] = &attribute.Attribute{ID: id, Slug: fmt.Sprintf("slug-%d", i)}
+				values[i] = AttributeValue{AttributeID: id, OptionSlugValue: ptr("value")}
+			}
+
+			h := &createProductHandler{attrRepo: &staticAttributeRepo{attrs: attrs}}
+			ctx := testCtx()
+
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, _, err := h.buildAttributes(ctx, values); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -14,9 +14,13 @@ import (
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
 
 // mockSendFunc is a no-op send function for tests
@@ -29,6 +33,11 @@ func testCtx() context.Context {
 	return logger.With(context.Background(), zap.NewNop())
 }
 
+// testCategory creates a category without required attributes
+func testCategory(id string) *category.Category {
+	return category.Reconstruct(id, 1, "Test Category", true, nil, category.Display{}, time.Now().UTC(), time.Now().UTC())
+}
+
 // setupCreateProductHandler creates handler with mocked dependencies
 func setupCreateProductHandler(t *testing.T) (
 	*MockRepository,
@@ -46,7 +55,7 @@ func setupCreateProductHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockProductEventFactory(t)
 
-	handler := NewCreateProductHandler(repo, attrRepo, categoryRepo, outboxMock, txManager, eventFactory)
+	handler := NewCreateProductHandler(repo, attrRepo, categoryRepo, NewMockSuppliers(t), outboxMock, txManager, eventFactory, feature.Static(), quota.Unlimited(), nil, limits.Default())
 
 	return repo, attrRepo, categoryRepo, outboxMock, txManager, eventFactory, handler
 }
@@ -67,10 +76,10 @@ func TestCreateProductHandler_Handle_Success(t *testing.T) {
 		Attributes:  nil,
 	}
 
-	// Mock category exists check
+	// Mock category lookup
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	// Mock event factory
 	eventFactory.EXPECT().
@@ -85,6 +94,7 @@ func TestCreateProductHandler_Handle_Success(t *testing.T) {
 		})
 
 	// Mock repository insert
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().
 		Insert(mock.Anything, mock.AnythingOfType("*product.Product")).
 		Return(nil)
@@ -124,13 +134,14 @@ func TestCreateProductHandler_Handle_WithCustomID(t *testing.T) {
 		Enabled:    true,
 	}
 
-	categoryRepo.EXPECT().Exists(mock.Anything, categoryID).Return(true, nil)
+	categoryRepo.EXPECT().FindByID(mock.Anything, categoryID).Return(testCategory(categoryID), nil)
 	eventFactory.EXPECT().NewProductUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
 			return fn(ctx)
 		})
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil)
 	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil)
 
@@ -141,6 +152,37 @@ func TestCreateProductHandler_Handle_WithCustomID(t *testing.T) {
 	assert.Equal(t, customID.String(), result.ID)
 }
 
+func TestCreateProductHandler_Handle_MissingRequiredAttributes(t *testing.T) {
+	_, attrRepo, categoryRepo, _, _, _, handler := setupCreateProductHandler(t)
+
+	categoryID := "category-123"
+	now := time.Now().UTC()
+	shirts := category.Reconstruct(categoryID, 1, "Shirts", true, []category.CategoryAttribute{
+		{AttributeID: "attr-color", Slug: "color", Role: category.AttributeRoleVariant, Required: true},
+		{AttributeID: "attr-size", Slug: "size", Role: category.AttributeRoleVariant, Required: true},
+		{AttributeID: "attr-fabric", Slug: "fabric", Role: category.AttributeRoleSpecification},
+	}, category.Display{}, now, now)
+	color := attribute.Reconstruct("attr-color", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, now, now)
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, categoryID).Return(shirts, nil)
+	attrRepo.EXPECT().FindByIDsOrFail(mock.Anything, []string{"attr-color"}).Return([]*attribute.Attribute{color}, nil)
+
+	_, err := handler.Handle(testCtx(), CreateProductCommand{
+		Name:       "Shirt",
+		Price:      20,
+		Quantity:   1,
+		ImageID:    ptr("image-1"),
+		CategoryID: &categoryID,
+		Enabled:    true,
+		Attributes: []AttributeValue{{AttributeID: "attr-color", OptionSlugValue: ptr("red")}},
+	})
+
+	var missing *MissingAttributesError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"size"}, missing.Slugs)
+	assert.ErrorIs(t, err, ErrInvalidProductData)
+}
+
 func TestCreateProductHandler_Handle_CategoryNotFound(t *testing.T) {
 	_, _, categoryRepo, _, _, _, handler := setupCreateProductHandler(t)
 
@@ -155,8 +197,8 @@ func TestCreateProductHandler_Handle_CategoryNotFound(t *testing.T) {
 	}
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(false, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(nil, mongo.ErrEntityNotFound)
 
 	result, err := handler.Handle(ctx, cmd)
 
@@ -179,13 +221,13 @@ func TestCreateProductHandler_Handle_CategoryCheckError(t *testing.T) {
 	}
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(false, errors.New("database error"))
+		FindByID(mock.Anything, categoryID).
+		Return(nil, errors.New("database error"))
 
 	result, err := handler.Handle(ctx, cmd)
 
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to check category")
+	assert.Contains(t, err.Error(), "failed to get category")
 	assert.Nil(t, result)
 }
 
@@ -232,7 +274,7 @@ func TestCreateProductHandler_Handle_AttributeValidationFailure(t *testing.T) {
 }
 
 func TestCreateProductHandler_Handle_InsertError(t *testing.T) {
-	repo, _, categoryRepo, outboxMock, txManager, eventFactory, handler := setupCreateProductHandler(t)
+	repo, _, categoryRepo, outboxMock, txManager, _, handler := setupCreateProductHandler(t)
 
 	ctx := testCtx()
 	categoryID := "category-123"
@@ -245,13 +287,13 @@ func TestCreateProductHandler_Handle_InsertError(t *testing.T) {
 		Enabled:    true,
 	}
 
-	categoryRepo.EXPECT().Exists(mock.Anything, categoryID).Return(true, nil)
-	eventFactory.EXPECT().NewProductUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	categoryRepo.EXPECT().FindByID(mock.Anything, categoryID).Return(testCategory(categoryID), nil)
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
 			return fn(ctx)
 		})
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(errors.New("database error"))
 
 	// Outbox mock should not be called since Insert fails
@@ -278,13 +320,14 @@ func TestCreateProductHandler_Handle_OutboxError(t *testing.T) {
 		Enabled:    true,
 	}
 
-	categoryRepo.EXPECT().Exists(mock.Anything, categoryID).Return(true, nil)
+	categoryRepo.EXPECT().FindByID(mock.Anything, categoryID).Return(testCategory(categoryID), nil)
 	eventFactory.EXPECT().NewProductUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
 			return fn(ctx)
 		})
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil)
 	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, errors.New("outbox error"))
 
@@ -295,6 +338,37 @@ func TestCreateProductHandler_Handle_OutboxError(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestCreateProductHandler_Handle_SendErrorIsNotReturned(t *testing.T) {
+	repo, _, _, outboxMock, txManager, eventFactory, handler := setupCreateProductHandler(t)
+
+	ctx := testCtx()
+	cmd := CreateProductCommand{
+		Name:     "Test Product",
+		Price:    99.99,
+		Quantity: 10,
+	}
+
+	failingSend := func(_ context.Context) error {
+		return errors.New("broker unavailable")
+	}
+
+	eventFactory.EXPECT().NewProductUpdatedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
+	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil)
+	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(failingSend, nil)
+
+	// The product is committed together with its outbox message, so a failed immediate send must not fail the request
+	result, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+}
+
 func TestCreateProductHandler_Handle_NoCategoryValidation(t *testing.T) {
 	repo, _, _, outboxMock, txManager, eventFactory, handler := setupCreateProductHandler(t)
 
@@ -314,6 +388,7 @@ func TestCreateProductHandler_Handle_NoCategoryValidation(t *testing.T) {
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
 			return fn(ctx)
 		})
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil)
 	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil)
 
@@ -330,6 +405,9 @@ func createTestProduct() *Product {
 		"product-123",
 		1,
 		"Original Product",
+		"",
+		nil,
+		ProductTypePhysical,
 		ptr("Original description"),
 		99.99,
 		10,
@@ -337,6 +415,8 @@ func createTestProduct() *Product {
 		ptr("category-123"),
 		true,
 		nil,
+		nil,
+		nil, nil,
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -40,14 +40,17 @@ func NewDeleteProductHandler(
 }
 
 func (h *deleteProductHandler) Handle(ctx context.Context, cmd DeleteProductCommand) error {
-	msg := h.eventFactory.NewProductDeletedOutboxMessage(ctx, cmd.ID)
-
 	send, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (outbox.SendFunc, error) {
+		p, err := h.repo.FindByID(txCtx, cmd.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product: %w", err)
+		}
+
 		if err := h.repo.Delete(txCtx, cmd.ID); err != nil {
 			return nil, fmt.Errorf("failed to delete product: %w", err)
 		}
 
-		send, err := h.outbox.Create(txCtx, msg)
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductDeletedOutboxMessage(txCtx, p))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox: %w", err)
 		}
@@ -0,0 +1,24 @@
+package product
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/richtext"
+)
+
+// ExcerptLength is the maximum length of the plain-text excerpt of a description, in characters
+const ExcerptLength = 200
+
+// describe sanitizes the HTML of a description and derives its excerpt. A description left without
+// markup or text is dropped; one with images but no text has no excerpt.
+func describe(description *string) (sanitized, excerpt *string) {
+	if description == nil {
+		return nil, nil
+	}
+	html := richtext.Sanitize(*description)
+	if html == "" {
+		return nil, nil
+	}
+	if text := richtext.Excerpt(html, ExcerptLength); text != "" {
+		excerpt = &text
+	}
+	return &html, excerpt
+}
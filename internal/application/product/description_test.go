@@ -0,0 +1,39 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProduct_SanitizesDescription(t *testing.T) {
+	description := `<p>Great <b>phone</b><img src="x" onerror="alert(1)"></p><script>alert(document.cookie)</script>`
+
+	p, err := NewProduct(defaultLimits, "Phone", "", "", &description, 100, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `<p>Great <b>phone</b><img src="x"></p>`, *p.Description)
+	assert.Equal(t, "Great phone", *p.Excerpt)
+}
+
+func TestProduct_Update_SanitizesDescription(t *testing.T) {
+	p, err := NewProduct(defaultLimits, "Phone", "", "", nil, 100, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Nil(t, p.Excerpt)
+
+	long := "<p>" + strings.Repeat("word ", 100) + "</p>"
+	require.NoError(t, p.Update(defaultLimits, "Phone", "", &long, 100, 1, nil, nil, false, nil))
+	assert.LessOrEqual(t, len([]rune(*p.Excerpt)), ExcerptLength+1)
+	assert.True(t, strings.HasSuffix(*p.Excerpt, "…"))
+
+	onlyScript := "<script>alert(1)</script>"
+	require.NoError(t, p.Update(defaultLimits, "Phone", "", &onlyScript, 100, 1, nil, nil, false, nil))
+	assert.Nil(t, p.Description, "nothing is left of the description")
+	assert.Nil(t, p.Excerpt)
+
+	image := `<img src="https://cdn.example.com/a.png">`
+	require.NoError(t, p.Update(defaultLimits, "Phone", "", &image, 100, 1, nil, nil, false, nil))
+	assert.Equal(t, image, *p.Description)
+	assert.Nil(t, p.Excerpt, "a description without text has no excerpt")
+}
@@ -0,0 +1,121 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type DiscontinueProductCommand struct {
+	ID      string
+	Version int
+	// ReplacementProductID is the product storefronts send the visitors of the discontinued one to; nil for none
+	ReplacementProductID *string
+}
+
+type DiscontinueProductCommandHandler interface {
+	// Handle disables the product for good and records when it was discontinued and the product replacing it,
+	// which must exist and not be discontinued itself, or ErrReplacementNotFound and ErrInvalidProductData are returned.
+	// Discontinuing a discontinued product again changes its replacement and keeps the date.
+	Handle(ctx context.Context, cmd DiscontinueProductCommand) (*Product, error)
+}
+
+type discontinueProductHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ProductEventFactory
+}
+
+func NewDiscontinueProductHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+) DiscontinueProductCommandHandler {
+	return &discontinueProductHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *discontinueProductHandler) Handle(ctx context.Context, cmd DiscontinueProductCommand) (*Product, error) {
+	p, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if p.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if cmd.ReplacementProductID != nil {
+		if err := h.checkReplacement(ctx, p, *cmd.ReplacementProductID); err != nil {
+			return nil, err
+		}
+	}
+	p.Discontinue(cmd.ReplacementProductID)
+
+	type discontinueResult struct {
+		Product *Product
+		Send    outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*discontinueResult, error) {
+		updated, err := h.repo.Update(txCtx, p)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to update product: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductUpdatedOutboxMessage(txCtx, updated))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+		return &discontinueResult{Product: updated, Send: send}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("product discontinued", zap.String("id", res.Product.ID))
+
+	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return res.Product, nil
+}
+
+// checkReplacement makes sure the replacement can still be sold in place of the product
+func (h *discontinueProductHandler) checkReplacement(ctx context.Context, p *Product, replacementID string) error {
+	if replacementID == p.ID {
+		return fmt.Errorf("%w: a product can't replace itself", ErrInvalidProductData)
+	}
+
+	replacement, err := h.repo.FindByID(ctx, replacementID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return ErrReplacementNotFound
+		}
+		return fmt.Errorf("failed to get replacement product: %w", err)
+	}
+	if replacement.DiscontinuedAt != nil {
+		return fmt.Errorf("%w: replacement product %s is discontinued", ErrInvalidProductData, replacementID)
+	}
+	return nil
+}
+
+func (h *discontinueProductHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "discontinue-product-handler"))
+}
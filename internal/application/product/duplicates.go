@@ -0,0 +1,132 @@
+package product
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// maxDuplicateGroups bounds the review list of a duplicate search
+const maxDuplicateGroups = 1000
+
+// minFingerprintAttributes is the number of attribute values two products need before equal values
+// make them likely duplicates; fewer are shared by too many distinct products
+const minFingerprintAttributes = 3
+
+// DuplicateReason tells why products were grouped as likely duplicates
+type DuplicateReason string
+
+const (
+	// DuplicateSameName groups products of a category whose names only differ in case, spaces or punctuation
+	DuplicateSameName DuplicateReason = "same-name"
+	// DuplicateSameSupplierSKU groups products with the same supplier code, the barcode-like key of the catalog
+	DuplicateSameSupplierSKU DuplicateReason = "same-supplier-sku"
+	// DuplicateSameAttributes groups products of a category and type with the same attribute values
+	DuplicateSameAttributes DuplicateReason = "same-attributes"
+)
+
+// DuplicateGroup is a set of products that are likely the same. Products are ordered by creation,
+// so merging the others into the first keeps the oldest, as MergeProducts does with its products in this order.
+type DuplicateGroup struct {
+	Reason   DuplicateReason    `json:"reason"`
+	Products []DuplicateProduct `json:"products"`
+}
+
+// DuplicateProduct is what a reviewer needs to recognize a product of a group
+type DuplicateProduct struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Slug       string    `json:"slug"`
+	CategoryID *string   `json:"categoryId,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// duplicateFinder collects the products of a scan by the keys that make them likely duplicates
+type duplicateFinder struct {
+	groups map[DuplicateReason]map[string][]DuplicateProduct
+}
+
+func newDuplicateFinder() *duplicateFinder {
+	return &duplicateFinder{groups: map[DuplicateReason]map[string][]DuplicateProduct{
+		DuplicateSameName:        {},
+		DuplicateSameSupplierSKU: {},
+		DuplicateSameAttributes:  {},
+	}}
+}
+
+func (f *duplicateFinder) add(p *Product) {
+	d := DuplicateProduct{ID: p.ID, Name: p.Name, Slug: p.Slug, CategoryID: p.CategoryID, CreatedAt: p.CreatedAt}
+	category := ""
+	if p.CategoryID != nil {
+		category = *p.CategoryID
+	}
+
+	if name := duplicateNameKey(p.Name); name != "" {
+		f.put(DuplicateSameName, category+"|"+name, d)
+	}
+	if p.Supplier != nil && p.Supplier.SKU != nil {
+		f.put(DuplicateSameSupplierSKU, p.Supplier.SupplierID+"|"+*p.Supplier.SKU, d)
+	}
+	if len(p.Attributes) >= minFingerprintAttributes {
+		f.put(DuplicateSameAttributes, category+"|"+string(p.Type)+"|"+attributesFingerprint(p), d)
+	}
+}
+
+func (f *duplicateFinder) put(reason DuplicateReason, key string, d DuplicateProduct) {
+	f.groups[reason][key] = append(f.groups[reason][key], d)
+}
+
+// result returns the groups of more than one product, by reason and then by their oldest product,
+// and whether some were left out to stay within maxDuplicateGroups
+func (f *duplicateFinder) result() ([]DuplicateGroup, bool) {
+	var groups []DuplicateGroup
+	for _, reason := range []DuplicateReason{DuplicateSameName, DuplicateSameSupplierSKU, DuplicateSameAttributes} {
+		start := len(groups)
+		for _, products := range f.groups[reason] {
+			if len(products) < 2 {
+				continue
+			}
+			slices.SortFunc(products, compareDuplicates)
+			groups = append(groups, DuplicateGroup{Reason: reason, Products: products})
+		}
+		slices.SortFunc(groups[start:], func(a, b DuplicateGroup) int {
+			return compareDuplicates(a.Products[0], b.Products[0])
+		})
+	}
+
+	if len(groups) > maxDuplicateGroups {
+		return groups[:maxDuplicateGroups], true
+	}
+	return groups, false
+}
+
+func compareDuplicates(a, b DuplicateProduct) int {
+	return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+}
+
+// duplicateNameKey keeps the letters and digits of the name in lower case, any other character separates words
+func duplicateNameKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// attributesFingerprint is the attribute values as covered by the content hash, which are stored in attribute order
+func attributesFingerprint(p *Product) string {
+	fields := make([]attributeField, len(p.Attributes))
+	for i, a := range p.Attributes {
+		fields[i] = attributeField{
+			AttributeID:      a.AttributeID,
+			OptionSlugValue:  a.OptionSlugValue,
+			OptionSlugValues: a.OptionSlugValues,
+			NumericValue:     a.NumericValue,
+			TextValue:        a.TextValue,
+			BooleanValue:     a.BooleanValue,
+		}
+	}
+	data, _ := json.Marshal(fields) //nolint:errcheck // plain structs always marshal
+	return string(data)
+}
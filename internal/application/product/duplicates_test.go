@@ -0,0 +1,51 @@
+package product
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateNameKey(t *testing.T) {
+	assert.Equal(t, "phone x 128gb", duplicateNameKey("  Phone X, 128GB "))
+	assert.Equal(t, "телефон x", duplicateNameKey("Телефон—X"))
+	assert.Empty(t, duplicateNameKey("--"))
+}
+
+func TestDuplicateFinder_SameAttributes(t *testing.T) {
+	attrs := func(color string) []AttributeValue {
+		return []AttributeValue{
+			{AttributeID: "color", OptionSlugValue: ptr(color)},
+			{AttributeID: "size", OptionSlugValue: ptr("m")},
+			{AttributeID: "cotton", BooleanValue: ptr(true)},
+		}
+	}
+	now := time.Now().UTC()
+	finder := newDuplicateFinder()
+	for i, color := range []string{"red", "red", "blue"} {
+		p := createTestProduct()
+		p.ID = fmt.Sprintf("product-%d", i)
+		p.Name = "Shirt " + p.ID
+		p.Attributes = attrs(color)
+		p.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		finder.add(p)
+	}
+	// Too few values to tell products apart
+	for _, name := range []string{"Hat", "Cap"} {
+		few := createTestProduct()
+		few.Name = name
+		few.Attributes = attrs("red")[:2]
+		finder.add(few)
+	}
+
+	groups, truncated := finder.result()
+	assert.False(t, truncated)
+	require.Len(t, groups, 1)
+	assert.Equal(t, DuplicateSameAttributes, groups[0].Reason)
+	require.Len(t, groups[0].Products, 2)
+	assert.Equal(t, "product-1", groups[0].Products[0].ID, "oldest first")
+	assert.Equal(t, "product-0", groups[0].Products[1].ID)
+}
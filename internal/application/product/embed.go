@@ -0,0 +1,44 @@
+package product
+
+import (
+	"context"
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// Embed names related data a product query resolves along with the product, so a product page
+// doesn't need more calls to render
+type Embed string
+
+const (
+	// EmbedCategoryPath fills Product.CategoryPath
+	EmbedCategoryPath Embed = "categoryPath"
+	// EmbedAttributeDefinitions fills Product.AttributeDefinitions
+	EmbedAttributeDefinitions Embed = "attributeDefinitions"
+)
+
+// embedder resolves the embeds of the product queries from cached metadata
+type embedder struct {
+	categoryPaths CategoryPathResolver
+	attributeDefs AttributeDefinitionResolver
+}
+
+func (e *embedder) embed(ctx context.Context, tenant string, embeds []Embed, p *Product) error {
+	if slices.Contains(embeds, EmbedCategoryPath) && p.CategoryID != nil {
+		crumbs, err := e.categoryPaths.Path(ctx, tenant, *p.CategoryID)
+		if err != nil {
+			return err
+		}
+		p.CategoryPath = crumbs
+	}
+
+	if slices.Contains(embeds, EmbedAttributeDefinitions) && len(p.Attributes) > 0 {
+		defs, err := e.attributeDefs.Definitions(ctx, tenant, lo.Map(p.Attributes, func(v AttributeValue, _ int) string { return v.AttributeID }))
+		if err != nil {
+			return err
+		}
+		p.AttributeDefinitions = defs
+	}
+	return nil
+}
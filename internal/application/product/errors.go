@@ -1,8 +1,31 @@
 package product
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
-	ErrInvalidProductData = errors.New("invalid product data")
-	ErrCategoryNotFound   = errors.New("category not found")
+	ErrInvalidProductData      = errors.New("invalid product data")
+	ErrCategoryNotFound        = errors.New("category not found")
+	ErrNameAlreadyExists       = errors.New("product name already exists in category")
+	ErrSlugAlreadyExists       = errors.New("product slug already exists")
+	ErrSupplierNotFound        = errors.New("supplier not found")
+	ErrExternalIDAlreadyExists = errors.New("product external ID already exists")
+	ErrReplacementNotFound     = errors.New("replacement product not found")
 )
+
+// MissingAttributesError lists the required category attributes an enabled product has no value for.
+// It matches ErrInvalidProductData with errors.Is.
+type MissingAttributesError struct {
+	Slugs []string
+}
+
+func (e *MissingAttributesError) Error() string {
+	return fmt.Sprintf("%s: missing required attributes: %s", ErrInvalidProductData, strings.Join(e.Slugs, ", "))
+}
+
+func (e *MissingAttributesError) Unwrap() error {
+	return ErrInvalidProductData
+}
@@ -9,5 +9,11 @@ import (
 // ProductEventFactory creates product events
 type ProductEventFactory interface {
 	NewProductUpdatedOutboxMessage(ctx context.Context, p *Product) outbox.Message
-	NewProductDeletedOutboxMessage(ctx context.Context, productID string) outbox.Message
+	// NewProductDeletedOutboxMessage continues the product version sequence, see package events
+	NewProductDeletedOutboxMessage(ctx context.Context, p *Product) outbox.Message
+	// NewProductPriceChangedOutboxMessage announces the price of the updated product, see feature.PriceChangedEvents
+	NewProductPriceChangedOutboxMessage(ctx context.Context, p *Product, oldPrice float64) outbox.Message
+	// NewBulkProductsImportedOutboxMessage summarizes the products written by a bulk operation, see BulkEvents;
+	// the created ones have version 1
+	NewBulkProductsImportedOutboxMessage(ctx context.Context, op BulkOperation, products []*Product) outbox.Message
 }
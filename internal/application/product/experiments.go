@@ -0,0 +1,54 @@
+package product
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	maxExperiments         = 20
+	maxExperimentKeyLength = 64
+	maxVariantLength       = 64
+)
+
+// experimentKeyRegex allows keys such as pdp-title or home.hero_image
+var experimentKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// SetExperiments replaces the experiments running on the product
+func (p *Product) SetExperiments(experiments map[string]string) error {
+	if err := validateExperiments(experiments); err != nil {
+		return err
+	}
+
+	if len(experiments) == 0 {
+		experiments = nil
+	}
+	p.Experiments = maps.Clone(experiments)
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	return nil
+}
+
+func validateExperiments(experiments map[string]string) error {
+	if len(experiments) > maxExperiments {
+		return fmt.Errorf("%w: too many experiments (max %d)", ErrInvalidProductData, maxExperiments)
+	}
+
+	for key, variant := range experiments {
+		if len(key) > maxExperimentKeyLength {
+			return fmt.Errorf("%w: experiment key %s is too long (max %d characters)", ErrInvalidProductData, key, maxExperimentKeyLength)
+		}
+		if !experimentKeyRegex.MatchString(key) {
+			return fmt.Errorf("%w: experiment key %q may only contain letters, digits and _ . -", ErrInvalidProductData, key)
+		}
+		if variant == "" {
+			return fmt.Errorf("%w: experiment %s has no variant", ErrInvalidProductData, key)
+		}
+		if len(variant) > maxVariantLength {
+			return fmt.Errorf("%w: variant of experiment %s is too long (max %d characters)", ErrInvalidProductData, key, maxVariantLength)
+		}
+	}
+	return nil
+}
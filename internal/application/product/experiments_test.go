@@ -0,0 +1,50 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_SetExperiments(t *testing.T) {
+	tooMany := make(map[string]string, maxExperiments+1)
+	for i := range maxExperiments + 1 {
+		tooMany[fmt.Sprintf("exp-%d", i)] = "b"
+	}
+
+	tests := []struct {
+		name        string
+		experiments map[string]string
+		errContains string
+	}{
+		{name: "variants", experiments: map[string]string{"pdp-title": "short-title", "home.hero_image": "b"}},
+		{name: "no experiments", experiments: nil},
+		{name: "too many experiments", experiments: tooMany, errContains: "too many experiments"},
+		{name: "long key", experiments: map[string]string{strings.Repeat("k", 65): "b"}, errContains: "is too long"},
+		{name: "key with colon", experiments: map[string]string{"pdp:title": "b"}, errContains: "may only contain"},
+		{name: "empty variant", experiments: map[string]string{"pdp-title": ""}, errContains: "has no variant"},
+		{name: "long variant", experiments: map[string]string{"pdp-title": strings.Repeat("v", 65)}, errContains: "variant of experiment pdp-title is too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := createTestProduct()
+			hash := p.ContentHash()
+
+			err := p.SetExperiments(tt.experiments)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidProductData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, p.Experiments)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.experiments), len(p.Experiments))
+			assert.Equal(t, hash, p.ContentHash())
+		})
+	}
+}
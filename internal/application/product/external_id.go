@@ -0,0 +1,35 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const maxExternalIDLength = 128
+
+// ChangeExternalID sets the key of the product in the feed it is imported from; nil removes it
+func (p *Product) ChangeExternalID(externalID *string) error {
+	if err := validateExternalID(externalID); err != nil {
+		return err
+	}
+
+	p.ExternalID = externalID
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	return nil
+}
+
+func validateExternalID(externalID *string) error {
+	if externalID == nil {
+		return nil
+	}
+
+	if strings.TrimSpace(*externalID) != *externalID || *externalID == "" {
+		return fmt.Errorf("%w: external ID can't be empty or start or end with spaces", ErrInvalidProductData)
+	}
+	if len(*externalID) > maxExternalIDLength {
+		return fmt.Errorf("%w: external ID is too long (max %d characters)", ErrInvalidProductData, maxExternalIDLength)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ChangeExternalID(t *testing.T) {
+	tests := []struct {
+		name        string
+		externalID  *string
+		errContains string
+	}{
+		{name: "external ID", externalID: ptr("ERP-1")},
+		{name: "no external ID", externalID: nil},
+		{name: "empty", externalID: ptr(""), errContains: "can't be empty"},
+		{name: "surrounding spaces", externalID: ptr(" ERP-1"), errContains: "spaces"},
+		{name: "too long", externalID: ptr(strings.Repeat("e", 129)), errContains: "too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := createTestProduct()
+
+			err := p.ChangeExternalID(tt.externalID)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidProductData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, p.ExternalID)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.externalID, p.ExternalID)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// facetsTTL bounds how long a count computed while a product write commits can stay stale
+const facetsTTL = 5 * time.Minute
+
+// OptionCount is the number of enabled products of a category carrying an option
+type OptionCount struct {
+	Slug  string
+	Name  string
+	Count int64
+}
+
+// AttributeFacet lists every option of a filterable attribute of a category, unused ones with a zero count
+type AttributeFacet struct {
+	AttributeID string
+	Slug        string
+	Name        string
+	Options     []OptionCount
+}
+
+// FacetCache holds the facets of the categories of all tenants
+type FacetCache struct {
+	facets *ttlCache[[]AttributeFacet]
+
+	mu            sync.Mutex
+	invalidations map[string]int64
+}
+
+func NewFacetCache() *FacetCache {
+	return &FacetCache{facets: newTTLCache[[]AttributeFacet](facetsTTL), invalidations: make(map[string]int64)}
+}
+
+// Invalidate drops the cached facets of the tenant; product events call it, as a product can move
+// between categories
+func (c *FacetCache) Invalidate(tenant string) {
+	c.facets.deletePrefix(tenant + "/")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidations[tenant]++
+}
+
+// Invalidations returns how many times the facets of the tenant were dropped since start, so bursts of
+// product writes can be told apart from the usual churn
+func (c *FacetCache) Invalidations(tenant string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.invalidations[tenant]
+}
+
+type GetCategoryFacetsQuery struct {
+	CategoryID string
+	// Tenant scopes the cached facets
+	Tenant string
+}
+
+type GetCategoryFacetsQueryHandler interface {
+	// Handle counts the enabled products of the category carrying each option of its filterable single and
+	// multiple choice attributes, in category attribute order with options in attribute order.
+	// It returns mongo.ErrEntityNotFound for an unknown category.
+	Handle(ctx context.Context, query GetCategoryFacetsQuery) ([]AttributeFacet, error)
+}
+
+type getCategoryFacetsHandler struct {
+	repo         Repository
+	categoryRepo category.Repository
+	attrRepo     attribute.Repository
+	cache        *FacetCache
+}
+
+func NewGetCategoryFacetsHandler(repo Repository, categoryRepo category.Repository, attrRepo attribute.Repository, cache *FacetCache) GetCategoryFacetsQueryHandler {
+	return &getCategoryFacetsHandler{repo: repo, categoryRepo: categoryRepo, attrRepo: attrRepo, cache: cache}
+}
+
+func (h *getCategoryFacetsHandler) Handle(ctx context.Context, query GetCategoryFacetsQuery) ([]AttributeFacet, error) {
+	key := cacheKey(query.Tenant, query.CategoryID)
+	if facets, ok := h.cache.facets.get(key); ok {
+		return facets, nil
+	}
+
+	c, err := h.categoryRepo.FindByID(ctx, query.CategoryID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	filterable := lo.Filter(c.Attributes, func(a category.CategoryAttribute, _ int) bool { return a.Filterable })
+	slices.SortStableFunc(filterable, func(a, b category.CategoryAttribute) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+
+	attrs, err := h.attrRepo.FindByIDs(ctx, lo.Map(filterable, func(a category.CategoryAttribute, _ int) string { return a.AttributeID }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	byID := lo.KeyBy(lo.Filter(attrs, func(a *attribute.Attribute, _ int) bool {
+		return a.Type == attribute.AttributeTypeSingle || a.Type == attribute.AttributeTypeMultiple
+	}), func(a *attribute.Attribute) string { return a.ID })
+
+	filterable = lo.Filter(filterable, func(a category.CategoryAttribute, _ int) bool { return byID[a.AttributeID] != nil })
+
+	var counts map[string]map[string]int64
+	if len(filterable) > 0 {
+		counts, err = h.repo.OptionCounts(ctx, query.CategoryID, lo.Map(filterable, func(a category.CategoryAttribute, _ int) string { return a.AttributeID }))
+		if err != nil {
+			return nil, fmt.Errorf("failed to count attribute options: %w", err)
+		}
+	}
+
+	facets := []AttributeFacet{}
+	for _, ca := range filterable {
+		a := byID[ca.AttributeID]
+		options := slices.Clone(a.Options)
+		slices.SortStableFunc(options, func(x, y attribute.Option) int { return cmp.Compare(x.SortOrder, y.SortOrder) })
+		facet := AttributeFacet{AttributeID: a.ID, Slug: a.Slug, Name: a.Name, Options: make([]OptionCount, len(options))}
+		for i, o := range options {
+			facet.Options[i] = OptionCount{Slug: o.Slug, Name: o.Name, Count: counts[a.ID][o.Slug]}
+		}
+		facets = append(facets, facet)
+	}
+
+	h.cache.facets.put(key, facets)
+	return facets, nil
+}
@@ -0,0 +1,56 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+func TestGetCategoryFacetsHandler_InvalidatePerTenant(t *testing.T) {
+	repo := NewMockRepository(t)
+	categoryRepo := category.NewMockRepository(t)
+	attrRepo := attribute.NewMockRepository(t)
+	cache := NewFacetCache()
+	handler := NewGetCategoryFacetsHandler(repo, categoryRepo, attrRepo, cache)
+
+	shirts := &category.Category{ID: "shirts", Attributes: []category.CategoryAttribute{
+		{AttributeID: "size", SortOrder: 1, Filterable: true},
+		{AttributeID: "weight", SortOrder: 2, Filterable: true},
+		{AttributeID: "color", SortOrder: 0, Filterable: true},
+		{AttributeID: "fit", SortOrder: 3},
+	}}
+	attrs := []*attribute.Attribute{
+		{ID: "color", Slug: "color", Name: "Color", Type: attribute.AttributeTypeSingle, Options: []attribute.Option{
+			{Slug: "red", Name: "Red", SortOrder: 1}, {Slug: "blue", Name: "Blue", SortOrder: 0},
+		}},
+		{ID: "size", Slug: "size", Name: "Size", Type: attribute.AttributeTypeMultiple, Options: []attribute.Option{{Slug: "m", Name: "M"}}},
+		{ID: "weight", Slug: "weight", Name: "Weight", Type: attribute.AttributeTypeRange},
+	}
+	categoryRepo.EXPECT().FindByID(mock.Anything, "shirts").Return(shirts, nil).Times(3)
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"color", "size", "weight"}).Return(attrs, nil).Times(3)
+	repo.EXPECT().OptionCounts(mock.Anything, "shirts", []string{"color", "size"}).
+		Return(map[string]map[string]int64{"color": {"red": 4}, "size": {"m": 2}}, nil).Times(3)
+
+	want := []AttributeFacet{
+		{AttributeID: "color", Slug: "color", Name: "Color", Options: []OptionCount{{Slug: "blue", Name: "Blue"}, {Slug: "red", Name: "Red", Count: 4}}},
+		{AttributeID: "size", Slug: "size", Name: "Size", Options: []OptionCount{{Slug: "m", Name: "M", Count: 2}}},
+	}
+	for _, tenant := range []string{"acme", "globex", "acme", "globex"} {
+		facets, err := handler.Handle(context.Background(), GetCategoryFacetsQuery{CategoryID: "shirts", Tenant: tenant})
+		require.NoError(t, err)
+		assert.Equal(t, want, facets)
+	}
+
+	// Only the facets of the tenant are loaded again
+	cache.Invalidate("acme")
+	for _, tenant := range []string{"acme", "globex"} {
+		_, err := handler.Handle(context.Background(), GetCategoryFacetsQuery{CategoryID: "shirts", Tenant: tenant})
+		require.NoError(t, err)
+	}
+}
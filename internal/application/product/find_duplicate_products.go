@@ -0,0 +1,75 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+type FindDuplicateProductsQuery struct {
+	// Progress, if set, is called with the number of products scanned and the catalog size
+	Progress func(scanned, total int64)
+}
+
+// FindDuplicateProductsResult is the review list of a duplicate search and the result document of its job
+type FindDuplicateProductsResult struct {
+	Groups []DuplicateGroup `json:"groups"`
+	// Truncated is set when more than maxDuplicateGroups groups were found; merge some and search again
+	Truncated bool `json:"truncated"`
+}
+
+type FindDuplicateProductsQueryHandler interface {
+	// Handle scans the products of the current tenant and groups those that are likely duplicates:
+	// the same name within a category, the same supplier SKU, or the same attribute values within a category.
+	// A product can be in a group for each reason.
+	Handle(ctx context.Context, query FindDuplicateProductsQuery) (*FindDuplicateProductsResult, error)
+}
+
+type findDuplicateProductsHandler struct {
+	repo Repository
+}
+
+func NewFindDuplicateProductsHandler(repo Repository) FindDuplicateProductsQueryHandler {
+	return &findDuplicateProductsHandler{repo: repo}
+}
+
+func (h *findDuplicateProductsHandler) Handle(ctx context.Context, query FindDuplicateProductsQuery) (*FindDuplicateProductsResult, error) {
+	finder := newDuplicateFinder()
+	afterID := ""
+	var scanned, total int64
+	for {
+		page, err := h.repo.FindList(ctx, ListQuery{AfterID: afterID, Size: cleanupPageSize, Sort: "_id"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+		if afterID == "" {
+			total = page.Total
+		}
+
+		for _, p := range page.Items {
+			finder.add(p)
+		}
+
+		scanned += int64(len(page.Items))
+		if query.Progress != nil {
+			query.Progress(scanned, max(total, scanned))
+		}
+
+		if len(page.Items) < cleanupPageSize {
+			break
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+
+	groups, truncated := finder.result()
+	h.log(ctx).Debug("duplicate products found", zap.Int64("scanned", scanned), zap.Int("groups", len(groups)))
+
+	return &FindDuplicateProductsResult{Groups: groups, Truncated: truncated}, nil
+}
+
+func (h *findDuplicateProductsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "find-duplicate-products-handler"))
+}
@@ -3,6 +3,9 @@ package product
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/samber/lo"
 )
 
 type GetListProductsQuery struct {
@@ -10,8 +13,27 @@ type GetListProductsQuery struct {
 	Size       int
 	Enabled    *bool
 	CategoryID *string
-	Sort       string
-	Order      string
+	SupplierID *string
+	HasImage   *bool
+	// HasCategory keeps products with (true) or without (false) a category; imports often leave them uncategorized
+	HasCategory *bool
+	// AttributeID keeps the products with a value for the attribute; with OptionSlug, only those picking that option
+	AttributeID *string
+	OptionSlug  *string
+	Sort        string
+	Order       string
+	// IncludeArchived lists the archived products along with the others
+	IncludeArchived bool
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
+	// MinQualityScore and MaxQualityScore keep the products whose quality score is within the bounds;
+	// sort by qualityScore to work through the least complete products first
+	MinQualityScore *int
+	MaxQualityScore *int
+	// Recent lists the products created or modified in the last RecentDays instead of sorting by Sort,
+	// at most maxRecentResults of them
+	Recent     RecentPreset
+	RecentDays int
 }
 
 type ListProductsResult struct {
@@ -26,25 +48,87 @@ type GetListProductsQueryHandler interface {
 }
 
 type getListProductsHandler struct {
-	repo Repository
+	repo          Repository
+	reservedStock ReservedStock
+	enricher      AttributeEnricher
 }
 
-func NewGetListProductsHandler(repo Repository) GetListProductsQueryHandler {
-	return &getListProductsHandler{repo: repo}
+func NewGetListProductsHandler(repo Repository, reservedStock ReservedStock, enricher AttributeEnricher) GetListProductsQueryHandler {
+	return &getListProductsHandler{repo: repo, reservedStock: reservedStock, enricher: enricher}
 }
 
 func (h *getListProductsHandler) Handle(ctx context.Context, query GetListProductsQuery) (*ListProductsResult, error) {
-	listQuery := ListQuery(query)
+	if query.OptionSlug != nil && query.AttributeID == nil {
+		return nil, fmt.Errorf("%w: optionSlug needs an attributeId", ErrInvalidProductData)
+	}
+
+	listQuery := ListQuery{
+		Page:            query.Page,
+		Size:            query.Size,
+		Enabled:         query.Enabled,
+		CategoryID:      query.CategoryID,
+		SupplierID:      query.SupplierID,
+		HasImage:        query.HasImage,
+		HasCategory:     query.HasCategory,
+		AttributeID:     query.AttributeID,
+		OptionSlug:      query.OptionSlug,
+		IncludeArchived: query.IncludeArchived,
+		ModifiedAfter:   query.ModifiedAfter,
+		MinQualityScore: query.MinQualityScore,
+		MaxQualityScore: query.MaxQualityScore,
+		Sort:            query.Sort,
+		Order:           query.Order,
+	}
+	switch query.Sort {
+	case SortBestsellers:
+		listQuery.Sort, listQuery.Order = "unitsSold", "desc"
+	case SortTrending:
+		listQuery.Sort, listQuery.Order = "views", "desc"
+	}
+	if query.Recent != "" {
+		if err := applyRecentPreset(&listQuery, query.Recent, query.RecentDays, time.Now()); err != nil {
+			return nil, err
+		}
+	}
 
 	result, err := h.repo.FindList(ctx, listQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get products list: %w", err)
 	}
 
-	return &ListProductsResult{
+	if err := h.applyReservedStock(ctx, result.Items); err != nil {
+		return nil, err
+	}
+
+	if err := h.enricher.Enrich(ctx, result.Items); err != nil {
+		return nil, err
+	}
+
+	list := &ListProductsResult{
 		Items: result.Items,
 		Page:  result.Page,
 		Size:  result.Size,
 		Total: result.Total,
-	}, nil
+	}
+	if query.Recent != "" {
+		capRecentResults(list)
+	}
+	return list, nil
+}
+
+func (h *getListProductsHandler) applyReservedStock(ctx context.Context, products []*Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := lo.Map(products, func(p *Product, _ int) string { return p.ID })
+	reserved, err := h.reservedStock.ReservedQuantities(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get reserved stock: %w", err)
+	}
+
+	for _, p := range products {
+		p.Reserved = reserved[p.ID]
+	}
+	return nil
 }
@@ -4,12 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
 
 type GetProductByIDQuery struct {
 	ID string
+	// AsOf reads the product as it was at the given time instead of its current state.
+	// Reserved stock isn't historized and stays zero for such reads.
+	AsOf *time.Time
+	// IncludeArchived reads the product from the archive when it isn't in the catalog
+	IncludeArchived bool
+	Embed           []Embed
+	// Tenant scopes the cached data of the embeds
+	Tenant string
 }
 
 type GetProductByIDQueryHandler interface {
@@ -17,20 +26,75 @@ type GetProductByIDQueryHandler interface {
 }
 
 type getProductByIDHandler struct {
-	repo Repository
+	repo          Repository
+	reservedStock ReservedStock
+	enricher      AttributeEnricher
+	embedder      *embedder
 }
 
-func NewGetProductByIDHandler(repo Repository) GetProductByIDQueryHandler {
-	return &getProductByIDHandler{repo: repo}
+func NewGetProductByIDHandler(
+	repo Repository,
+	reservedStock ReservedStock,
+	enricher AttributeEnricher,
+	categoryPaths CategoryPathResolver,
+	attributeDefs AttributeDefinitionResolver,
+) GetProductByIDQueryHandler {
+	return &getProductByIDHandler{
+		repo:          repo,
+		reservedStock: reservedStock,
+		enricher:      enricher,
+		embedder:      &embedder{categoryPaths: categoryPaths, attributeDefs: attributeDefs},
+	}
 }
 
 func (h *getProductByIDHandler) Handle(ctx context.Context, query GetProductByIDQuery) (*Product, error) {
+	if query.AsOf != nil {
+		return h.handleAsOf(ctx, query)
+	}
+
 	p, err := h.repo.FindByID(ctx, query.ID)
+	if errors.Is(err, mongo.ErrEntityNotFound) && query.IncludeArchived {
+		p, err = h.repo.FindArchivedByID(ctx, query.ID)
+	}
 	if err != nil {
 		if errors.Is(err, mongo.ErrEntityNotFound) {
 			return nil, err
 		}
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
+
+	reserved, err := h.reservedStock.ReservedQuantities(ctx, []string{p.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reserved stock: %w", err)
+	}
+	p.Reserved = reserved[p.ID]
+
+	if err := h.enricher.Enrich(ctx, []*Product{p}); err != nil {
+		return nil, err
+	}
+	if err := h.embedder.embed(ctx, query.Tenant, query.Embed, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// handleAsOf embeds the current category path, as the path isn't historized
+func (h *getProductByIDHandler) handleAsOf(ctx context.Context, query GetProductByIDQuery) (*Product, error) {
+	p, err := h.repo.FindAsOf(ctx, query.ID, *query.AsOf)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get product revision: %w", err)
+	}
+
+	if err := h.enricher.Enrich(ctx, []*Product{p}); err != nil {
+		return nil, err
+	}
+	if err := h.embedder.embed(ctx, query.Tenant, query.Embed, p); err != nil {
+		return nil, err
+	}
+
 	return p, nil
 }
@@ -0,0 +1,78 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetProductBySlugQuery struct {
+	Slug  string
+	Embed []Embed
+	// Tenant scopes the cached data of the embeds
+	Tenant string
+}
+
+// ProductBySlug is a product resolved by slug. MovedTo is the current slug when the
+// requested one is a previous slug, so clients can redirect to the canonical URL.
+type ProductBySlug struct {
+	Product *Product
+	MovedTo *string
+}
+
+type GetProductBySlugQueryHandler interface {
+	Handle(ctx context.Context, query GetProductBySlugQuery) (*ProductBySlug, error)
+}
+
+type getProductBySlugHandler struct {
+	repo          Repository
+	reservedStock ReservedStock
+	enricher      AttributeEnricher
+	embedder      *embedder
+}
+
+func NewGetProductBySlugHandler(
+	repo Repository,
+	reservedStock ReservedStock,
+	enricher AttributeEnricher,
+	categoryPaths CategoryPathResolver,
+	attributeDefs AttributeDefinitionResolver,
+) GetProductBySlugQueryHandler {
+	return &getProductBySlugHandler{
+		repo:          repo,
+		reservedStock: reservedStock,
+		enricher:      enricher,
+		embedder:      &embedder{categoryPaths: categoryPaths, attributeDefs: attributeDefs},
+	}
+}
+
+func (h *getProductBySlugHandler) Handle(ctx context.Context, query GetProductBySlugQuery) (*ProductBySlug, error) {
+	p, err := h.repo.FindBySlug(ctx, query.Slug)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	reserved, err := h.reservedStock.ReservedQuantities(ctx, []string{p.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reserved stock: %w", err)
+	}
+	p.Reserved = reserved[p.ID]
+
+	if err := h.enricher.Enrich(ctx, []*Product{p}); err != nil {
+		return nil, err
+	}
+	if err := h.embedder.embed(ctx, query.Tenant, query.Embed, p); err != nil {
+		return nil, err
+	}
+
+	result := &ProductBySlug{Product: p}
+	if p.Slug != query.Slug {
+		result.MovedTo = &p.Slug
+	}
+	return result, nil
+}
@@ -0,0 +1,283 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ImportProductsCommand struct {
+	Products []CreateProductCommand
+}
+
+// ImportAction tells what an import did with a product
+type ImportAction string
+
+const (
+	ImportCreated ImportAction = "created"
+	// ImportUpdated means a stored product with the external ID of the imported one was overwritten
+	ImportUpdated ImportAction = "updated"
+	// ImportUnchanged means the stored product already had the imported content and wasn't written
+	ImportUnchanged ImportAction = "unchanged"
+)
+
+// ImportResult is the outcome of one imported product: the stored product, what was done with it
+// and its quality warnings, or the reason it wasn't stored
+type ImportResult struct {
+	Product  *Product
+	Action   ImportAction
+	Warnings []Warning
+	Err      error
+}
+
+type ImportProductsCommandHandler interface {
+	// Handle stores the products with bulk writes and returns their outcomes in command order.
+	//
+	// A product whose external ID belongs to a stored product updates it, so a feed can be imported again:
+	// the import overwrites the stored content whatever its version, keeps its slug unless one is given,
+	// and skips the write when nothing changed. It conflicts with ErrExternalIDAlreadyExists when it names
+	// another product ID, and fails when it changes the product type or repeats an external ID of the same import.
+	// A stored product changed between the lookup and the write fails with mongo.ErrOptimisticLocking and
+	// can be imported again. Other products are created as by CreateProduct.
+	//
+	// Each product is checked as by CreateProduct or UpdateProduct; one that fails the checks or conflicts
+	// with a stored product, including an earlier one of the same import, is reported and the others are stored.
+	// When the created products would exceed the product quota of the tenant, all of them are reported
+	// with a quota.ExceededError and only the updates are stored.
+	Handle(ctx context.Context, cmd ImportProductsCommand) ([]ImportResult, error)
+}
+
+type importProductsHandler struct {
+	create *createProductHandler
+	update *updateProductHandler
+	writer *bulkWriter
+	quotas quota.Enforcer
+	limits limits.Product
+}
+
+func NewImportProductsHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	categoryRepo category.Repository,
+	suppliers Suppliers,
+	outbox outbox.Outbox,
+	batchOutbox BatchOutbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+	flags feature.Flags,
+	quotas quota.Enforcer,
+	policy BulkEventPolicy,
+	hooks ValidationHooks,
+	l limits.Limits,
+) ImportProductsCommandHandler {
+	return &importProductsHandler{
+		create: &createProductHandler{
+			repo:         repo,
+			attrRepo:     attrRepo,
+			categoryRepo: categoryRepo,
+			suppliers:    suppliers,
+			flags:        flags,
+			hooks:        hooks,
+			limits:       l.Product,
+		},
+		update: &updateProductHandler{
+			repo:         repo,
+			attrRepo:     attrRepo,
+			categoryRepo: categoryRepo,
+			suppliers:    suppliers,
+			flags:        flags,
+			hooks:        hooks,
+			limits:       l.Product,
+		},
+		writer: newBulkWriter(BulkImportProducts, policy, outbox, batchOutbox, txManager, eventFactory, flags),
+		quotas: quotas,
+		limits: l.Product,
+	}
+}
+
+func (h *importProductsHandler) Handle(ctx context.Context, cmd ImportProductsCommand) ([]ImportResult, error) {
+	if maxItems := h.limits.ImportItems; len(cmd.Products) > maxItems {
+		return nil, fmt.Errorf("%w: at most %d products can be imported at once", ErrInvalidProductData, maxItems)
+	}
+
+	stored, err := h.findStored(ctx, cmd.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ImportResult, len(cmd.Products))
+	var inserts, updates []*Product
+	var insertIndexes, updateIndexes []int
+	var oldPrices []float64
+	seen := make(map[string]int)
+	for i, c := range cmd.Products {
+		if c.ExternalID != nil {
+			if first, ok := seen[*c.ExternalID]; ok {
+				results[i].Err = fmt.Errorf("%w: external ID %q repeats product %d of the import", ErrInvalidProductData, *c.ExternalID, first)
+				continue
+			}
+			seen[*c.ExternalID] = i
+
+			if p, ok := stored[*c.ExternalID]; ok {
+				oldPrice, oldHash, oldSlug := p.Price, p.ContentHash(), p.Slug
+				if err := h.prepareUpdate(ctx, p, c); err != nil {
+					results[i].Err = err
+					continue
+				}
+				if p.ContentHash() == oldHash && p.Slug == oldSlug {
+					results[i] = ImportResult{Product: p, Action: ImportUnchanged, Warnings: p.Warnings()}
+					continue
+				}
+				updates = append(updates, p)
+				updateIndexes = append(updateIndexes, i)
+				oldPrices = append(oldPrices, oldPrice)
+				continue
+			}
+		}
+
+		p, err := h.create.prepare(ctx, c)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		inserts = append(inserts, p)
+		insertIndexes = append(insertIndexes, i)
+	}
+
+	sends, err := h.store(ctx, results, updates, updateIndexes, oldPrices, h.update.repo.BulkUpdate, ImportUpdated)
+	if err == nil {
+		var insertSends []outbox.SendFunc
+		insertSends, err = h.store(ctx, results, inserts, insertIndexes, nil, h.insert, ImportCreated)
+		sends = append(sends, insertSends...)
+
+		// Past the quota none of the creates is stored, the updates are
+		var exceeded *quota.ExceededError
+		if errors.As(err, &exceeded) {
+			for _, i := range insertIndexes {
+				results[i].Err = exceeded
+			}
+			inserts, err = nil, nil
+		}
+	}
+
+	// The updates are committed even if the inserts fail
+	for _, send := range sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("products imported", zap.Int("requested", len(cmd.Products)),
+		zap.Int("updated", len(updates)), zap.Int("created", len(inserts)))
+
+	return results, nil
+}
+
+// insert checks the quota in the transaction of the batch before inserting it
+func (h *importProductsHandler) insert(ctx context.Context, products []*Product) ([]error, error) {
+	if err := h.quotas.Check(ctx, quota.Products, len(products)); err != nil {
+		return nil, err
+	}
+	return h.create.repo.BulkInsert(ctx, products)
+}
+
+// findStored loads the stored products with the external IDs of the imported ones, by external ID
+func (h *importProductsHandler) findStored(ctx context.Context, cmds []CreateProductCommand) (map[string]*Product, error) {
+	var externalIDs []string
+	for _, c := range cmds {
+		if c.ExternalID != nil {
+			externalIDs = append(externalIDs, *c.ExternalID)
+		}
+	}
+	if len(externalIDs) == 0 {
+		return nil, nil
+	}
+
+	products, err := h.update.repo.FindByExternalIDs(ctx, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products by external ID: %w", err)
+	}
+	return lo.KeyBy(products, func(p *Product) string { return *p.ExternalID }), nil
+}
+
+// prepareUpdate applies the imported product to the stored one with the same external ID
+func (h *importProductsHandler) prepareUpdate(ctx context.Context, p *Product, cmd CreateProductCommand) error {
+	if cmd.ID != nil && cmd.ID.String() != p.ID {
+		return fmt.Errorf("%w: %q is the external ID of product %s", ErrExternalIDAlreadyExists, *cmd.ExternalID, p.ID)
+	}
+
+	productType, err := resolveProductType(ProductType(cmd.Type))
+	if err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+	if productType != p.Type {
+		return fmt.Errorf("%w: the type of product %s can't change", ErrInvalidProductData, p.ID)
+	}
+
+	return h.update.apply(ctx, p, UpdateProductCommand{
+		ID:          p.ID,
+		Version:     p.Version,
+		Name:        cmd.Name,
+		Slug:        cmd.Slug,
+		Description: cmd.Description,
+		Price:       cmd.Price,
+		Quantity:    cmd.Quantity,
+		ImageID:     cmd.ImageID,
+		CategoryID:  cmd.CategoryID,
+		Enabled:     cmd.Enabled,
+		Attributes:  cmd.Attributes,
+		Metadata:    cmd.Metadata,
+		Supplier:    cmd.Supplier,
+		ExternalID:  cmd.ExternalID,
+	})
+}
+
+// store writes the products and records their outcomes at their command indexes
+func (h *importProductsHandler) store(
+	ctx context.Context,
+	results []ImportResult,
+	products []*Product,
+	indexes []int,
+	oldPrices []float64,
+	write bulkWrite,
+	action ImportAction,
+) ([]outbox.SendFunc, error) {
+	if len(products) == 0 {
+		return nil, nil
+	}
+
+	errs, sends, err := h.writer.write(ctx, products, oldPrices, write)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range indexes {
+		switch {
+		case errs[j] == nil:
+			results[i] = ImportResult{Product: products[j], Action: action, Warnings: products[j].Warnings()}
+		case errors.Is(errs[j], mongo.ErrOptimisticLocking):
+			results[i].Err = errs[j]
+		case action == ImportUpdated:
+			results[i].Err = fmt.Errorf("failed to update product: %w", errs[j])
+		default:
+			results[i].Err = fmt.Errorf("failed to insert product: %w", errs[j])
+		}
+	}
+	return sends, nil
+}
+
+func (h *importProductsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "import-products-handler"))
+}
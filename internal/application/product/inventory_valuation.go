@@ -0,0 +1,156 @@
+package product
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// CategoryValuation is the stock on hand of the physical products of a category, archived products left out
+type CategoryValuation struct {
+	// CategoryID is empty for the products without a category
+	CategoryID   string `json:"categoryId"`
+	CategoryName string `json:"categoryName"`
+	Products     int64  `json:"products"`
+	Units        int64  `json:"units"`
+	// StockValue is the sum of price * quantity
+	StockValue float64 `json:"stockValue"`
+	// CostValue is the sum of cost * quantity of the products with a cost; nil unless a cost key was given
+	CostValue *float64 `json:"costValue,omitempty"`
+	// MissingCost counts the products without a numeric cost under the cost key
+	MissingCost int64 `json:"missingCost,omitempty"`
+}
+
+// InventoryValuationResult is the inventory valuation report and the result document of its job
+type InventoryValuationResult struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	// CostKey is the metadata key the unit costs were read from, if any
+	CostKey    string              `json:"costKey,omitempty"`
+	Categories []CategoryValuation `json:"categories"`
+	Total      CategoryValuation   `json:"total"`
+}
+
+type InventoryValuationQuery struct {
+	// CostKey, if set, is the metadata key holding the unit cost of a product, such as erp.cost
+	CostKey string
+}
+
+type InventoryValuationQueryHandler interface {
+	// Handle values the stock of the current tenant per category, by category ID with uncategorized products first
+	Handle(ctx context.Context, query InventoryValuationQuery) (*InventoryValuationResult, error)
+}
+
+type inventoryValuationHandler struct {
+	repo         Repository
+	categoryRepo category.Repository
+}
+
+func NewInventoryValuationHandler(repo Repository, categoryRepo category.Repository) InventoryValuationQueryHandler {
+	return &inventoryValuationHandler{repo: repo, categoryRepo: categoryRepo}
+}
+
+func (h *inventoryValuationHandler) Handle(ctx context.Context, query InventoryValuationQuery) (*InventoryValuationResult, error) {
+	if err := validateCostKey(query.CostKey); err != nil {
+		return nil, err
+	}
+
+	categories, err := h.repo.Valuation(ctx, query.CostKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to value inventory: %w", err)
+	}
+
+	result := &InventoryValuationResult{GeneratedAt: utc.Now(), CostKey: query.CostKey, Categories: categories}
+	if query.CostKey != "" {
+		result.Total.CostValue = new(float64)
+	}
+	for i := range result.Categories {
+		c := &result.Categories[i]
+		if c.CategoryID != "" {
+			if c.CategoryName, err = h.categoryName(ctx, c.CategoryID); err != nil {
+				return nil, err
+			}
+		}
+		result.Total.Products += c.Products
+		result.Total.Units += c.Units
+		result.Total.StockValue += c.StockValue
+		result.Total.MissingCost += c.MissingCost
+		if c.CostValue != nil {
+			*result.Total.CostValue += *c.CostValue
+		}
+	}
+
+	h.log(ctx).Debug("inventory valued", zap.Int("categories", len(categories)), zap.Int64("products", result.Total.Products))
+	return result, nil
+}
+
+// categoryName returns the name of the category, empty once it's deleted
+func (h *inventoryValuationHandler) categoryName(ctx context.Context, id string) (string, error) {
+	c, err := h.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get category: %w", err)
+	}
+	return c.Name, nil
+}
+
+func (h *inventoryValuationHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "inventory-valuation-handler"))
+}
+
+func validateCostKey(key string) error {
+	if key != "" && (len(key) > maxMetadataKeyLength || !metadataKeyRegex.MatchString(key)) {
+		return fmt.Errorf("%w: cost key %q is not a valid metadata key", ErrInvalidProductData, key)
+	}
+	return nil
+}
+
+// WriteCSV writes the report as CSV with a header row, a row per category and a total row.
+// The cost columns are left out unless the report has a cost key.
+func (r *InventoryValuationResult) WriteCSV(w io.Writer) error {
+	header := []string{"category_id", "category_name", "products", "units", "stock_value"}
+	if r.CostKey != "" {
+		header = append(header, "cost_value", "missing_cost")
+	}
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(header) //nolint:errcheck // errors are reported by Error after Flush
+	for _, c := range r.Categories {
+		_ = cw.Write(r.csvRow(c)) //nolint:errcheck // errors are reported by Error after Flush
+	}
+	total := r.Total
+	total.CategoryName = "Total"
+	_ = cw.Write(r.csvRow(total)) //nolint:errcheck // errors are reported by Error after Flush
+	cw.Flush()
+	return cw.Error()
+}
+
+func (r *InventoryValuationResult) csvRow(c CategoryValuation) []string {
+	row := []string{
+		c.CategoryID,
+		c.CategoryName,
+		strconv.FormatInt(c.Products, 10),
+		strconv.FormatInt(c.Units, 10),
+		strconv.FormatFloat(c.StockValue, 'f', 2, 64),
+	}
+	if r.CostKey != "" {
+		cost := ""
+		if c.CostValue != nil {
+			cost = strconv.FormatFloat(*c.CostValue, 'f', 2, 64)
+		}
+		row = append(row, cost, strconv.FormatInt(c.MissingCost, 10))
+	}
+	return row
+}
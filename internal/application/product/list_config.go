@@ -0,0 +1,167 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// Kinds of list filters, telling the admin grid which input to render
+const (
+	FilterKindBoolean  = "boolean"
+	FilterKindCategory = "category"
+	FilterKindSupplier = "supplier"
+	FilterKindTime     = "time"
+	FilterKindRange    = "range"
+	FilterKindPreset   = "preset"
+	FilterKindOption   = "option"
+)
+
+// ListColumn is a column of the admin product grid. Attribute columns show the value of the attribute,
+// Key being the attribute slug.
+type ListColumn struct {
+	Key         string
+	Label       string
+	AttributeID string
+	// Sort is the sort key of the column, empty if the list can't be sorted by it
+	Sort string
+}
+
+// ListFilter is a filter of the product list; Key names the field of the list query it sets.
+// Option filters set AttributeID and pick one of Options as OptionSlug.
+type ListFilter struct {
+	Key         string
+	Label       string
+	Kind        string
+	AttributeID string
+	Options     []attribute.Option
+}
+
+// ListSort is a sort key of the product list
+type ListSort struct {
+	Key   string
+	Label string
+}
+
+// ListConfig tells the admin product grid which columns, filters and sorts to offer
+type ListConfig struct {
+	Columns []ListColumn
+	Filters []ListFilter
+	Sorts   []ListSort
+}
+
+var (
+	baseListColumns = []ListColumn{
+		{Key: "name", Label: "Name", Sort: "name"},
+		{Key: "price", Label: "Price", Sort: "price"},
+		{Key: "quantity", Label: "Quantity", Sort: "quantity"},
+		{Key: "enabled", Label: "Enabled"},
+		{Key: "categoryId", Label: "Category"},
+		{Key: "supplierId", Label: "Supplier"},
+		{Key: "qualityScore", Label: "Quality", Sort: "qualityScore"},
+		{Key: "createdAt", Label: "Created", Sort: "createdAt"},
+		{Key: "modifiedAt", Label: "Modified", Sort: "modifiedAt"},
+	}
+	baseListSorts = []ListSort{
+		{Key: "name", Label: "Name"},
+		{Key: "price", Label: "Price"},
+		{Key: "quantity", Label: "Quantity"},
+		{Key: "qualityScore", Label: "Quality"},
+		{Key: "createdAt", Label: "Created"},
+		{Key: "modifiedAt", Label: "Modified"},
+		{Key: SortBestsellers, Label: "Bestsellers"},
+		{Key: SortTrending, Label: "Trending"},
+	}
+)
+
+type GetListConfigQuery struct {
+	// CategoryID adds the columns and filters of the category attributes; without it the grid
+	// lists the whole catalog and can filter by category
+	CategoryID *string
+}
+
+type GetListConfigQueryHandler interface {
+	// Handle returns the grid configuration, derived from the filterable and searchable attributes of the
+	// category so the grid follows taxonomy changes. It returns mongo.ErrEntityNotFound for an unknown category.
+	Handle(ctx context.Context, query GetListConfigQuery) (*ListConfig, error)
+}
+
+type getListConfigHandler struct {
+	categoryRepo category.Repository
+	attrRepo     attribute.Repository
+}
+
+func NewGetListConfigHandler(categoryRepo category.Repository, attrRepo attribute.Repository) GetListConfigQueryHandler {
+	return &getListConfigHandler{categoryRepo: categoryRepo, attrRepo: attrRepo}
+}
+
+func (h *getListConfigHandler) Handle(ctx context.Context, query GetListConfigQuery) (*ListConfig, error) {
+	if query.CategoryID == nil {
+		return buildListConfig(nil, nil), nil
+	}
+
+	c, err := h.categoryRepo.FindByID(ctx, *query.CategoryID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	listed := lo.Filter(c.Attributes, func(a category.CategoryAttribute, _ int) bool { return a.Filterable || a.Searchable })
+	attrs, err := h.attrRepo.FindByIDs(ctx, lo.Map(listed, func(a category.CategoryAttribute, _ int) string { return a.AttributeID }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	return buildListConfig(c, lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID })), nil
+}
+
+func buildListConfig(c *category.Category, attrs map[string]*attribute.Attribute) *ListConfig {
+	config := &ListConfig{
+		Columns: slices.Clone(baseListColumns),
+		Filters: []ListFilter{{Key: "enabled", Label: "Enabled", Kind: FilterKindBoolean}},
+		Sorts:   slices.Clone(baseListSorts),
+	}
+	if c == nil {
+		config.Filters = append(config.Filters,
+			ListFilter{Key: "categoryId", Label: "Category", Kind: FilterKindCategory},
+			ListFilter{Key: "hasCategory", Label: "Has category", Kind: FilterKindBoolean},
+		)
+	}
+	config.Filters = append(config.Filters,
+		ListFilter{Key: "supplierId", Label: "Supplier", Kind: FilterKindSupplier},
+		ListFilter{Key: "hasImage", Label: "Has image", Kind: FilterKindBoolean},
+		ListFilter{Key: "modifiedAfter", Label: "Modified after", Kind: FilterKindTime},
+		ListFilter{Key: "qualityScore", Label: "Quality", Kind: FilterKindRange},
+		ListFilter{Key: "preset", Label: "Recent", Kind: FilterKindPreset},
+	)
+	if c == nil {
+		return config
+	}
+
+	categoryAttrs := slices.Clone(c.Attributes)
+	slices.SortStableFunc(categoryAttrs, func(a, b category.CategoryAttribute) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+	for _, ca := range categoryAttrs {
+		a, ok := attrs[ca.AttributeID]
+		if !ok || (!ca.Filterable && !ca.Searchable) {
+			continue
+		}
+		config.Columns = append(config.Columns, ListColumn{Key: a.Slug, Label: a.Name, AttributeID: a.ID})
+		if ca.Filterable && a.HasOptions() {
+			options := slices.Clone(a.Options)
+			slices.SortStableFunc(options, func(x, y attribute.Option) int { return cmp.Compare(x.SortOrder, y.SortOrder) })
+			config.Filters = append(config.Filters, ListFilter{
+				Key: "attributeId", Label: a.Name, Kind: FilterKindOption, AttributeID: a.ID, Options: options,
+			})
+		}
+	}
+	return config
+}
@@ -0,0 +1,87 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestGetListConfigHandler_Handle_WithoutCategory(t *testing.T) {
+	handler := NewGetListConfigHandler(category.NewMockRepository(t), attribute.NewMockRepository(t))
+
+	config, err := handler.Handle(context.Background(), GetListConfigQuery{})
+
+	require.NoError(t, err)
+	assert.Equal(t, baseListColumns, config.Columns)
+	assert.Equal(t, baseListSorts, config.Sorts)
+	keys := lo.Map(config.Filters, func(f ListFilter, _ int) string { return f.Key })
+	assert.Contains(t, keys, "categoryId")
+	assert.Contains(t, keys, "hasCategory")
+	assert.NotContains(t, keys, "attributeId")
+}
+
+func TestGetListConfigHandler_Handle_WithCategory(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	attrRepo := attribute.NewMockRepository(t)
+	handler := NewGetListConfigHandler(categoryRepo, attrRepo)
+
+	now := time.Now()
+	color := attribute.Reconstruct("color", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, []attribute.Option{
+		{Name: "Red", Slug: "red", SortOrder: 2},
+		{Name: "Blue", Slug: "blue", SortOrder: 1},
+	}, "", nil, nil, now, now)
+	material := attribute.Reconstruct("material", 1, "Material", "material", attribute.AttributeTypeText, nil, true, nil, "", nil, nil, now, now)
+	c := category.Reconstruct("shirts", 1, "Shirts", true, []category.CategoryAttribute{
+		{AttributeID: "material", Slug: "material", Role: category.AttributeRoleSpecification, SortOrder: 2, Searchable: true},
+		{AttributeID: "care", Slug: "care", Role: category.AttributeRoleDescription, SortOrder: 3},
+		{AttributeID: "color", Slug: "color", Role: category.AttributeRoleVariant, SortOrder: 1, Filterable: true},
+	}, category.Display{}, now, now)
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "shirts").Return(c, nil)
+	attrRepo.EXPECT().FindByIDs(mock.Anything, []string{"material", "color"}).Return([]*attribute.Attribute{material, color}, nil)
+
+	categoryID := "shirts"
+	config, err := handler.Handle(context.Background(), GetListConfigQuery{CategoryID: &categoryID})
+
+	require.NoError(t, err)
+	assert.Equal(t, []ListColumn{
+		{Key: "color", Label: "Color", AttributeID: "color"},
+		{Key: "material", Label: "Material", AttributeID: "material"},
+	}, config.Columns[len(baseListColumns):], "attribute columns follow the category sort order")
+
+	keys := lo.Map(config.Filters, func(f ListFilter, _ int) string { return f.Key })
+	assert.NotContains(t, keys, "categoryId")
+	assert.NotContains(t, keys, "hasCategory")
+	option := config.Filters[len(config.Filters)-1]
+	assert.Equal(t, ListFilter{
+		Key:         "attributeId",
+		Label:       "Color",
+		Kind:        FilterKindOption,
+		AttributeID: "color",
+		Options: []attribute.Option{
+			{Name: "Blue", Slug: "blue", SortOrder: 1},
+			{Name: "Red", Slug: "red", SortOrder: 2},
+		},
+	}, option, "only filterable option attributes get a filter")
+}
+
+func TestGetListConfigHandler_Handle_CategoryNotFound(t *testing.T) {
+	categoryRepo := category.NewMockRepository(t)
+	handler := NewGetListConfigHandler(categoryRepo, attribute.NewMockRepository(t))
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	categoryID := "missing"
+	_, err := handler.Handle(context.Background(), GetListConfigQuery{CategoryID: &categoryID})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
@@ -0,0 +1,129 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// cleanupPageSize is the number of products loaded per page while scanning the catalog
+const cleanupPageSize = 100
+
+type MergeDuplicateAttributesCommand struct {
+	// Progress, if set, is called with the number of products scanned and the catalog size
+	Progress func(scanned, total int64)
+	// ItemFailed, if set, is called for a product that couldn't be merged and the scan goes on;
+	// otherwise the first failure stops it
+	ItemFailed func(productID string, err error)
+}
+
+type MergeDuplicateAttributesCommandHandler interface {
+	// Handle scans the products of the current tenant and merges value entries that repeat an attribute,
+	// as stored before duplicates were rejected. It returns the number of products rewritten by this call.
+	Handle(ctx context.Context, cmd MergeDuplicateAttributesCommand) (int, error)
+}
+
+type mergeDuplicateAttributesHandler struct {
+	repo   Repository
+	writer *bulkWriter
+}
+
+func NewMergeDuplicateAttributesHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	batchOutbox BatchOutbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+	policy BulkEventPolicy,
+) MergeDuplicateAttributesCommandHandler {
+	return &mergeDuplicateAttributesHandler{
+		repo: repo,
+		// Prices don't change, so the price change flag isn't needed
+		writer: newBulkWriter(BulkMergeDuplicateAttributes, policy, outbox, batchOutbox, txManager, eventFactory, nil),
+	}
+}
+
+func (h *mergeDuplicateAttributesHandler) Handle(ctx context.Context, cmd MergeDuplicateAttributesCommand) (int, error) {
+	count := 0
+	afterID := ""
+	var scanned, total int64
+	for {
+		page, err := h.repo.FindList(ctx, ListQuery{AfterID: afterID, Size: cleanupPageSize, Sort: "_id"})
+		if err != nil {
+			return count, fmt.Errorf("failed to list products: %w", err)
+		}
+		if afterID == "" {
+			total = page.Total
+		}
+
+		merged, err := h.merge(ctx, page.Items, cmd.ItemFailed)
+		count += merged
+		if err != nil {
+			return count, err
+		}
+
+		scanned += int64(len(page.Items))
+		if cmd.Progress != nil {
+			cmd.Progress(scanned, max(total, scanned))
+		}
+
+		if len(page.Items) < cleanupPageSize {
+			return count, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+// merge rewrites the products of a page that repeat an attribute with one bulk update and stores
+// their update events in the same transaction. It returns the number of products rewritten.
+// A product changed concurrently is skipped: the change already had to pass the duplicate check.
+func (h *mergeDuplicateAttributesHandler) merge(ctx context.Context, page []*Product, itemFailed func(string, error)) (int, error) {
+	var products []*Product
+	for _, p := range page {
+		if _, ok := duplicateAttributeID(p.Attributes); !ok {
+			continue
+		}
+		p.Attributes = MergeAttributeValues(p.Attributes)
+		p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+		products = append(products, p)
+	}
+	if len(products) == 0 {
+		return 0, nil
+	}
+
+	errs, sends, err := h.writer.write(ctx, products, nil, h.repo.BulkUpdate)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, send := range sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	merged := lo.CountBy(errs, func(err error) bool { return err == nil })
+	for i, p := range products {
+		switch {
+		case errs[i] == nil:
+			h.log(ctx).Debug("duplicate attributes merged", zap.String("id", p.ID))
+		case errors.Is(errs[i], mongo.ErrOptimisticLocking):
+			h.log(ctx).Debug("product changed concurrently, skipped", zap.String("id", p.ID))
+		case itemFailed != nil:
+			itemFailed(p.ID, fmt.Errorf("failed to update product: %w", errs[i]))
+		default:
+			return merged, fmt.Errorf("failed to update product: %w", errs[i])
+		}
+	}
+
+	return merged, nil
+}
+
+func (h *mergeDuplicateAttributesHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "merge-duplicate-attributes-handler"))
+}
@@ -0,0 +1,138 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// maxMergeDuplicates bounds the products merged into one in a single call
+const maxMergeDuplicates = 100
+
+type MergeProductsCommand struct {
+	// KeepID is the product that stays
+	KeepID string
+	// DuplicateIDs are the products merged into it and deleted
+	DuplicateIDs []string
+}
+
+type MergeProductsCommandHandler interface {
+	// Handle deletes the duplicates and keeps their current and previous slugs as previous slugs of the kept
+	// product, so their storefront URLs redirect to it. The kept product takes the external ID of the first
+	// duplicate that has one unless it has its own, so the next import updates it. Other content of the duplicates
+	// is dropped. It is all written in one transaction and returns the kept product.
+	Handle(ctx context.Context, cmd MergeProductsCommand) (*Product, error)
+}
+
+type mergeProductsHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ProductEventFactory
+}
+
+func NewMergeProductsHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+) MergeProductsCommandHandler {
+	return &mergeProductsHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *mergeProductsHandler) Handle(ctx context.Context, cmd MergeProductsCommand) (*Product, error) {
+	if err := validateMergeProducts(cmd); err != nil {
+		return nil, err
+	}
+
+	type mergeResult struct {
+		Product *Product
+		Sends   []outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*mergeResult, error) {
+		kept, err := h.repo.FindByID(txCtx, cmd.KeepID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product: %w", err)
+		}
+
+		sends := make([]outbox.SendFunc, 0, len(cmd.DuplicateIDs)+1)
+		for _, id := range cmd.DuplicateIDs {
+			duplicate, err := h.repo.FindByID(txCtx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get product: %w", err)
+			}
+
+			// Deleted first, so its slugs and external ID are free for the kept product
+			if err := h.repo.Delete(txCtx, id); err != nil {
+				return nil, fmt.Errorf("failed to delete product: %w", err)
+			}
+
+			send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductDeletedOutboxMessage(txCtx, duplicate))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox: %w", err)
+			}
+			sends = append(sends, send)
+
+			kept.inheritSlugs(duplicate)
+			if kept.ExternalID == nil {
+				kept.ExternalID = duplicate.ExternalID
+			}
+		}
+		kept.ModifiedAt = utc.NowAfter(kept.ModifiedAt)
+
+		updated, err := h.repo.Update(txCtx, kept)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update product: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductUpdatedOutboxMessage(txCtx, updated))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return &mergeResult{Product: updated, Sends: append(sends, send)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("products merged", zap.String("id", cmd.KeepID), zap.Strings("duplicates", cmd.DuplicateIDs))
+
+	for _, send := range res.Sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	return res.Product, nil
+}
+
+func validateMergeProducts(cmd MergeProductsCommand) error {
+	if cmd.KeepID == "" {
+		return fmt.Errorf("%w: the product to keep is required", ErrInvalidProductData)
+	}
+	if len(cmd.DuplicateIDs) == 0 || len(cmd.DuplicateIDs) > maxMergeDuplicates {
+		return fmt.Errorf("%w: 1 to %d duplicates can be merged at once", ErrInvalidProductData, maxMergeDuplicates)
+	}
+	for i, id := range cmd.DuplicateIDs {
+		if id == cmd.KeepID || slices.Contains(cmd.DuplicateIDs[:i], id) {
+			return fmt.Errorf("%w: product %s is repeated", ErrInvalidProductData, id)
+		}
+	}
+	return nil
+}
+
+func (h *mergeProductsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "merge-products-handler"))
+}
@@ -0,0 +1,48 @@
+package product
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const maxMetadataKeyLength = 64
+
+// metadataKeyRegex allows namespaced keys such as erp.code or vendor:ref
+var metadataKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.:-]*$`)
+
+// ChangeMetadata replaces the integration metadata of the product
+func (p *Product) ChangeMetadata(l limits.Product, metadata map[string]string) error {
+	if err := validateMetadata(l, metadata); err != nil {
+		return err
+	}
+
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	p.Metadata = maps.Clone(metadata)
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	return nil
+}
+
+func validateMetadata(l limits.Product, metadata map[string]string) error {
+	if len(metadata) > l.MetadataKeys {
+		return fmt.Errorf("%w: too many metadata keys (max %d)", ErrInvalidProductData, l.MetadataKeys)
+	}
+
+	for key, value := range metadata {
+		if len(key) > maxMetadataKeyLength {
+			return fmt.Errorf("%w: metadata key %s is too long (max %d characters)", ErrInvalidProductData, key, maxMetadataKeyLength)
+		}
+		if !metadataKeyRegex.MatchString(key) {
+			return fmt.Errorf("%w: metadata key %q may only contain letters, digits and _ . : -", ErrInvalidProductData, key)
+		}
+		if len(value) > l.MetadataValueLength {
+			return fmt.Errorf("%w: metadata value of %s is too long (max %d characters)", ErrInvalidProductData, key, l.MetadataValueLength)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ChangeMetadata(t *testing.T) {
+	maxKeys := defaultLimits.MetadataKeys
+	tooMany := make(map[string]string, maxKeys+1)
+	for i := range maxKeys + 1 {
+		tooMany[fmt.Sprintf("key-%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name        string
+		metadata    map[string]string
+		errContains string
+	}{
+		{name: "namespaced keys", metadata: map[string]string{"erp.code": "A-100", "vendor:ref": "77", "sap_id": ""}},
+		{name: "no metadata", metadata: nil},
+		{name: "too many keys", metadata: tooMany, errContains: "too many metadata keys"},
+		{name: "long key", metadata: map[string]string{strings.Repeat("k", 65): "v"}, errContains: "is too long"},
+		{name: "key with spaces", metadata: map[string]string{"erp code": "v"}, errContains: "may only contain"},
+		{name: "empty key", metadata: map[string]string{"": "v"}, errContains: "may only contain"},
+		{name: "long value", metadata: map[string]string{"notes": strings.Repeat("v", 1025)}, errContains: "value of notes is too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := createTestProduct()
+
+			err := p.ChangeMetadata(defaultLimits, tt.metadata)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidProductData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, p.Metadata)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.metadata), len(p.Metadata))
+		})
+	}
+}
+
+func TestProduct_ChangeMetadata_CopiesMap(t *testing.T) {
+	p := createTestProduct()
+	metadata := map[string]string{"erp.code": "A-100"}
+
+	require.NoError(t, p.ChangeMetadata(defaultLimits, metadata))
+	metadata["erp.code"] = "changed"
+
+	assert.Equal(t, "A-100", p.Metadata["erp.code"])
+}
+
+func TestProduct_ContentHash_Metadata(t *testing.T) {
+	p := createTestProduct()
+	withoutMetadata := p.ContentHash()
+
+	require.NoError(t, p.ChangeMetadata(defaultLimits, map[string]string{"erp.code": "A-100"}))
+
+	assert.NotEqual(t, withoutMetadata, p.ContentHash())
+}
@@ -38,17 +38,80 @@ func (_m *MockProductEventFactory) EXPECT() *MockProductEventFactory_Expecter {
 	return &MockProductEventFactory_Expecter{mock: &_m.Mock}
 }
 
+// NewBulkProductsImportedOutboxMessage provides a mock function for the type MockProductEventFactory
+func (_mock *MockProductEventFactory) NewBulkProductsImportedOutboxMessage(ctx context.Context, op BulkOperation, products []*Product) outbox.Message {
+	ret := _mock.Called(ctx, op, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewBulkProductsImportedOutboxMessage")
+	}
+
+	var r0 outbox.Message
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BulkOperation, []*Product) outbox.Message); ok {
+		r0 = returnFunc(ctx, op, products)
+	} else {
+		r0 = ret.Get(0).(outbox.Message)
+	}
+	return r0
+}
+
+// MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewBulkProductsImportedOutboxMessage'
+type MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call struct {
+	*mock.Call
+}
+
+// NewBulkProductsImportedOutboxMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - op BulkOperation
+//   - products []*Product
+func (_e *MockProductEventFactory_Expecter) NewBulkProductsImportedOutboxMessage(ctx interface{}, op interface{}, products interface{}) *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call {
+	return &MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call{Call: _e.mock.On("NewBulkProductsImportedOutboxMessage", ctx, op, products)}
+}
+
+func (_c *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call) Run(run func(ctx context.Context, op BulkOperation, products []*Product)) *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 BulkOperation
+		if args[1] != nil {
+			arg1 = args[1].(BulkOperation)
+		}
+		var arg2 []*Product
+		if args[2] != nil {
+			arg2 = args[2].([]*Product)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call) Return(message outbox.Message) *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call {
+	_c.Call.Return(message)
+	return _c
+}
+
+func (_c *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, op BulkOperation, products []*Product) outbox.Message) *MockProductEventFactory_NewBulkProductsImportedOutboxMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewProductDeletedOutboxMessage provides a mock function for the type MockProductEventFactory
-func (_mock *MockProductEventFactory) NewProductDeletedOutboxMessage(ctx context.Context, productID string) outbox.Message {
-	ret := _mock.Called(ctx, productID)
+func (_mock *MockProductEventFactory) NewProductDeletedOutboxMessage(ctx context.Context, p *Product) outbox.Message {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewProductDeletedOutboxMessage")
 	}
 
 	var r0 outbox.Message
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) outbox.Message); ok {
-		r0 = returnFunc(ctx, productID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Product) outbox.Message); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
 		r0 = ret.Get(0).(outbox.Message)
 	}
@@ -62,20 +125,20 @@ type MockProductEventFactory_NewProductDeletedOutboxMessage_Call struct {
 
 // NewProductDeletedOutboxMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - productID string
-func (_e *MockProductEventFactory_Expecter) NewProductDeletedOutboxMessage(ctx interface{}, productID interface{}) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
-	return &MockProductEventFactory_NewProductDeletedOutboxMessage_Call{Call: _e.mock.On("NewProductDeletedOutboxMessage", ctx, productID)}
+//   - p *Product
+func (_e *MockProductEventFactory_Expecter) NewProductDeletedOutboxMessage(ctx interface{}, p interface{}) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
+	return &MockProductEventFactory_NewProductDeletedOutboxMessage_Call{Call: _e.mock.On("NewProductDeletedOutboxMessage", ctx, p)}
 }
 
-func (_c *MockProductEventFactory_NewProductDeletedOutboxMessage_Call) Run(run func(ctx context.Context, productID string)) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
+func (_c *MockProductEventFactory_NewProductDeletedOutboxMessage_Call) Run(run func(ctx context.Context, p *Product)) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *Product
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*Product)
 		}
 		run(
 			arg0,
@@ -90,7 +153,70 @@ func (_c *MockProductEventFactory_NewProductDeletedOutboxMessage_Call) Return(me
 	return _c
 }
 
-func (_c *MockProductEventFactory_NewProductDeletedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, productID string) outbox.Message) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
+func (_c *MockProductEventFactory_NewProductDeletedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, p *Product) outbox.Message) *MockProductEventFactory_NewProductDeletedOutboxMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProductPriceChangedOutboxMessage provides a mock function for the type MockProductEventFactory
+func (_mock *MockProductEventFactory) NewProductPriceChangedOutboxMessage(ctx context.Context, p *Product, oldPrice float64) outbox.Message {
+	ret := _mock.Called(ctx, p, oldPrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewProductPriceChangedOutboxMessage")
+	}
+
+	var r0 outbox.Message
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Product, float64) outbox.Message); ok {
+		r0 = returnFunc(ctx, p, oldPrice)
+	} else {
+		r0 = ret.Get(0).(outbox.Message)
+	}
+	return r0
+}
+
+// MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewProductPriceChangedOutboxMessage'
+type MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call struct {
+	*mock.Call
+}
+
+// NewProductPriceChangedOutboxMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *Product
+//   - oldPrice float64
+func (_e *MockProductEventFactory_Expecter) NewProductPriceChangedOutboxMessage(ctx interface{}, p interface{}, oldPrice interface{}) *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call {
+	return &MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call{Call: _e.mock.On("NewProductPriceChangedOutboxMessage", ctx, p, oldPrice)}
+}
+
+func (_c *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call) Run(run func(ctx context.Context, p *Product, oldPrice float64)) *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Product
+		if args[1] != nil {
+			arg1 = args[1].(*Product)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call) Return(message outbox.Message) *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call {
+	_c.Call.Return(message)
+	return _c
+}
+
+func (_c *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call) RunAndReturn(run func(ctx context.Context, p *Product, oldPrice float64) outbox.Message) *MockProductEventFactory_NewProductPriceChangedOutboxMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
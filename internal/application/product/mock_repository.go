@@ -6,6 +6,7 @@ package product
 
 import (
 	"context"
+	"time"
 
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	mock "github.com/stretchr/testify/mock"
@@ -38,12 +39,1285 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function for the type MockRepository
+func (_mock *MockRepository) Archive(ctx context.Context, products []*Product) error {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Product) error); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockRepository_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*Product
+func (_e *MockRepository_Expecter) Archive(ctx interface{}, products interface{}) *MockRepository_Archive_Call {
+	return &MockRepository_Archive_Call{Call: _e.mock.On("Archive", ctx, products)}
+}
+
+func (_c *MockRepository_Archive_Call) Run(run func(ctx context.Context, products []*Product)) *MockRepository_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*Product
+		if args[1] != nil {
+			arg1 = args[1].([]*Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Archive_Call) Return(err error) *MockRepository_Archive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Archive_Call) RunAndReturn(run func(ctx context.Context, products []*Product) error) *MockRepository_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkInsert provides a mock function for the type MockRepository
+func (_mock *MockRepository) BulkInsert(ctx context.Context, products []*Product) ([]error, error) {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkInsert")
+	}
+
+	var r0 []error
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Product) ([]error, error)); ok {
+		return returnFunc(ctx, products)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Product) []error); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*Product) error); ok {
+		r1 = returnFunc(ctx, products)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_BulkInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkInsert'
+type MockRepository_BulkInsert_Call struct {
+	*mock.Call
+}
+
+// BulkInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*Product
+func (_e *MockRepository_Expecter) BulkInsert(ctx interface{}, products interface{}) *MockRepository_BulkInsert_Call {
+	return &MockRepository_BulkInsert_Call{Call: _e.mock.On("BulkInsert", ctx, products)}
+}
+
+func (_c *MockRepository_BulkInsert_Call) Run(run func(ctx context.Context, products []*Product)) *MockRepository_BulkInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*Product
+		if args[1] != nil {
+			arg1 = args[1].([]*Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_BulkInsert_Call) Return(errs []error, err error) *MockRepository_BulkInsert_Call {
+	_c.Call.Return(errs, err)
+	return _c
+}
+
+func (_c *MockRepository_BulkInsert_Call) RunAndReturn(run func(ctx context.Context, products []*Product) ([]error, error)) *MockRepository_BulkInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdate provides a mock function for the type MockRepository
+func (_mock *MockRepository) BulkUpdate(ctx context.Context, products []*Product) ([]error, error) {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdate")
+	}
+
+	var r0 []error
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Product) ([]error, error)); ok {
+		return returnFunc(ctx, products)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Product) []error); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*Product) error); ok {
+		r1 = returnFunc(ctx, products)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_BulkUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdate'
+type MockRepository_BulkUpdate_Call struct {
+	*mock.Call
+}
+
+// BulkUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*Product
+func (_e *MockRepository_Expecter) BulkUpdate(ctx interface{}, products interface{}) *MockRepository_BulkUpdate_Call {
+	return &MockRepository_BulkUpdate_Call{Call: _e.mock.On("BulkUpdate", ctx, products)}
+}
+
+func (_c *MockRepository_BulkUpdate_Call) Run(run func(ctx context.Context, products []*Product)) *MockRepository_BulkUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*Product
+		if args[1] != nil {
+			arg1 = args[1].([]*Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_BulkUpdate_Call) Return(errs []error, err error) *MockRepository_BulkUpdate_Call {
+	_c.Call.Return(errs, err)
+	return _c
+}
+
+func (_c *MockRepository_BulkUpdate_Call) RunAndReturn(run func(ctx context.Context, products []*Product) ([]error, error)) *MockRepository_BulkUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByCategory provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountByCategory(ctx context.Context) (map[string]int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCategory")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCategory'
+type MockRepository_CountByCategory_Call struct {
+	*mock.Call
+}
+
+// CountByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) CountByCategory(ctx interface{}) *MockRepository_CountByCategory_Call {
+	return &MockRepository_CountByCategory_Call{Call: _e.mock.On("CountByCategory", ctx)}
+}
+
+func (_c *MockRepository_CountByCategory_Call) Run(run func(ctx context.Context)) *MockRepository_CountByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByCategory_Call) Return(stringToInt64 map[string]int64, err error) *MockRepository_CountByCategory_Call {
+	_c.Call.Return(stringToInt64, err)
+	return _c
+}
+
+func (_c *MockRepository_CountByCategory_Call) RunAndReturn(run func(ctx context.Context) (map[string]int64, error)) *MockRepository_CountByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUncategorized provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountUncategorized(ctx context.Context) ([]UncategorizedCount, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUncategorized")
+	}
+
+	var r0 []UncategorizedCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]UncategorizedCount, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []UncategorizedCount); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]UncategorizedCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountUncategorized_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUncategorized'
+type MockRepository_CountUncategorized_Call struct {
+	*mock.Call
+}
+
+// CountUncategorized is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) CountUncategorized(ctx interface{}) *MockRepository_CountUncategorized_Call {
+	return &MockRepository_CountUncategorized_Call{Call: _e.mock.On("CountUncategorized", ctx)}
+}
+
+func (_c *MockRepository_CountUncategorized_Call) Run(run func(ctx context.Context)) *MockRepository_CountUncategorized_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountUncategorized_Call) Return(uncategorizedCounts []UncategorizedCount, err error) *MockRepository_CountUncategorized_Call {
+	_c.Call.Return(uncategorizedCounts, err)
+	return _c
+}
+
+func (_c *MockRepository_CountUncategorized_Call) RunAndReturn(run func(ctx context.Context) ([]UncategorizedCount, error)) *MockRepository_CountUncategorized_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecayViews provides a mock function for the type MockRepository
+func (_mock *MockRepository) DecayViews(ctx context.Context, factor float64, minViews float64) (int64, error) {
+	ret := _mock.Called(ctx, factor, minViews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecayViews")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64) (int64, error)); ok {
+		return returnFunc(ctx, factor, minViews)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64) int64); ok {
+		r0 = returnFunc(ctx, factor, minViews)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, float64, float64) error); ok {
+		r1 = returnFunc(ctx, factor, minViews)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_DecayViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecayViews'
+type MockRepository_DecayViews_Call struct {
+	*mock.Call
+}
+
+// DecayViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - factor float64
+//   - minViews float64
+func (_e *MockRepository_Expecter) DecayViews(ctx interface{}, factor interface{}, minViews interface{}) *MockRepository_DecayViews_Call {
+	return &MockRepository_DecayViews_Call{Call: _e.mock.On("DecayViews", ctx, factor, minViews)}
+}
+
+func (_c *MockRepository_DecayViews_Call) Run(run func(ctx context.Context, factor float64, minViews float64)) *MockRepository_DecayViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DecayViews_Call) Return(n int64, err error) *MockRepository_DecayViews_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_DecayViews_Call) RunAndReturn(run func(ctx context.Context, factor float64, minViews float64) (int64, error)) *MockRepository_DecayViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockRepository
 func (_mock *MockRepository) Delete(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArchivable provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindArchivable(ctx context.Context, before time.Time, limit int) ([]*Product, error) {
+	ret := _mock.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArchivable")
+	}
+
+	var r0 []*Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*Product, error)); ok {
+		return returnFunc(ctx, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []*Product); ok {
+		r0 = returnFunc(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindArchivable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArchivable'
+type MockRepository_FindArchivable_Call struct {
+	*mock.Call
+}
+
+// FindArchivable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) FindArchivable(ctx interface{}, before interface{}, limit interface{}) *MockRepository_FindArchivable_Call {
+	return &MockRepository_FindArchivable_Call{Call: _e.mock.On("FindArchivable", ctx, before, limit)}
+}
+
+func (_c *MockRepository_FindArchivable_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockRepository_FindArchivable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindArchivable_Call) Return(products []*Product, err error) *MockRepository_FindArchivable_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockRepository_FindArchivable_Call) RunAndReturn(run func(ctx context.Context, before time.Time, limit int) ([]*Product, error)) *MockRepository_FindArchivable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArchivedByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindArchivedByID(ctx context.Context, id string) (*Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArchivedByID")
+	}
+
+	var r0 *Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindArchivedByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArchivedByID'
+type MockRepository_FindArchivedByID_Call struct {
+	*mock.Call
+}
+
+// FindArchivedByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindArchivedByID(ctx interface{}, id interface{}) *MockRepository_FindArchivedByID_Call {
+	return &MockRepository_FindArchivedByID_Call{Call: _e.mock.On("FindArchivedByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindArchivedByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindArchivedByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindArchivedByID_Call) Return(product1 *Product, err error) *MockRepository_FindArchivedByID_Call {
+	_c.Call.Return(product1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindArchivedByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*Product, error)) *MockRepository_FindArchivedByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAsOf provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindAsOf(ctx context.Context, id string, asOf time.Time) (*Product, error) {
+	ret := _mock.Called(ctx, id, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAsOf")
+	}
+
+	var r0 *Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (*Product, error)); ok {
+		return returnFunc(ctx, id, asOf)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) *Product); ok {
+		r0 = returnFunc(ctx, id, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAsOf'
+type MockRepository_FindAsOf_Call struct {
+	*mock.Call
+}
+
+// FindAsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - asOf time.Time
+func (_e *MockRepository_Expecter) FindAsOf(ctx interface{}, id interface{}, asOf interface{}) *MockRepository_FindAsOf_Call {
+	return &MockRepository_FindAsOf_Call{Call: _e.mock.On("FindAsOf", ctx, id, asOf)}
+}
+
+func (_c *MockRepository_FindAsOf_Call) Run(run func(ctx context.Context, id string, asOf time.Time)) *MockRepository_FindAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAsOf_Call) Return(product1 *Product, err error) *MockRepository_FindAsOf_Call {
+	_c.Call.Return(product1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAsOf_Call) RunAndReturn(run func(ctx context.Context, id string, asOf time.Time) (*Product, error)) *MockRepository_FindAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByExternalIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByExternalIDs(ctx context.Context, externalIDs []string) ([]*Product, error) {
+	ret := _mock.Called(ctx, externalIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByExternalIDs")
+	}
+
+	var r0 []*Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*Product, error)); ok {
+		return returnFunc(ctx, externalIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*Product); ok {
+		r0 = returnFunc(ctx, externalIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, externalIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByExternalIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByExternalIDs'
+type MockRepository_FindByExternalIDs_Call struct {
+	*mock.Call
+}
+
+// FindByExternalIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalIDs []string
+func (_e *MockRepository_Expecter) FindByExternalIDs(ctx interface{}, externalIDs interface{}) *MockRepository_FindByExternalIDs_Call {
+	return &MockRepository_FindByExternalIDs_Call{Call: _e.mock.On("FindByExternalIDs", ctx, externalIDs)}
+}
+
+func (_c *MockRepository_FindByExternalIDs_Call) Run(run func(ctx context.Context, externalIDs []string)) *MockRepository_FindByExternalIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByExternalIDs_Call) Return(products []*Product, err error) *MockRepository_FindByExternalIDs_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByExternalIDs_Call) RunAndReturn(run func(ctx context.Context, externalIDs []string) ([]*Product, error)) *MockRepository_FindByExternalIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByID(ctx context.Context, id string) (*Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(product1 *Product, err error) *MockRepository_FindByID_Call {
+	_c.Call.Return(product1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*Product, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByIDs(ctx context.Context, ids []string) ([]*Product, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []*Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*Product, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*Product); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockRepository_Expecter) FindByIDs(ctx interface{}, ids interface{}) *MockRepository_FindByIDs_Call {
+	return &MockRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, ids)}
+}
+
+func (_c *MockRepository_FindByIDs_Call) Run(run func(ctx context.Context, ids []string)) *MockRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByIDs_Call) Return(products []*Product, err error) *MockRepository_FindByIDs_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []string) ([]*Product, error)) *MockRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlug provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBySlug(ctx context.Context, slug string) (*Product, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlug")
+	}
+
+	var r0 *Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Product, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Product); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlug'
+type MockRepository_FindBySlug_Call struct {
+	*mock.Call
+}
+
+// FindBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockRepository_Expecter) FindBySlug(ctx interface{}, slug interface{}) *MockRepository_FindBySlug_Call {
+	return &MockRepository_FindBySlug_Call{Call: _e.mock.On("FindBySlug", ctx, slug)}
+}
+
+func (_c *MockRepository_FindBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockRepository_FindBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBySlug_Call) Return(product1 *Product, err error) *MockRepository_FindBySlug_Call {
+	_c.Call.Return(product1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*Product, error)) *MockRepository_FindBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindList provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindList(ctx context.Context, query ListQuery) (*mongo.PageResult[Product], error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindList")
+	}
+
+	var r0 *mongo.PageResult[Product]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery) (*mongo.PageResult[Product], error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery) *mongo.PageResult[Product]); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mongo.PageResult[Product])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindList'
+type MockRepository_FindList_Call struct {
+	*mock.Call
+}
+
+// FindList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query ListQuery
+func (_e *MockRepository_Expecter) FindList(ctx interface{}, query interface{}) *MockRepository_FindList_Call {
+	return &MockRepository_FindList_Call{Call: _e.mock.On("FindList", ctx, query)}
+}
+
+func (_c *MockRepository_FindList_Call) Run(run func(ctx context.Context, query ListQuery)) *MockRepository_FindList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(ListQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindList_Call) Return(pageResult *mongo.PageResult[Product], err error) *MockRepository_FindList_Call {
+	_c.Call.Return(pageResult, err)
+	return _c
+}
+
+func (_c *MockRepository_FindList_Call) RunAndReturn(run func(ctx context.Context, query ListQuery) (*mongo.PageResult[Product], error)) *MockRepository_FindList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockRepository
+func (_mock *MockRepository) Insert(ctx context.Context, product1 *Product) error {
+	ret := _mock.Called(ctx, product1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Product) error); ok {
+		r0 = returnFunc(ctx, product1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product1 *Product
+func (_e *MockRepository_Expecter) Insert(ctx interface{}, product1 interface{}) *MockRepository_Insert_Call {
+	return &MockRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, product1)}
+}
+
+func (_c *MockRepository_Insert_Call) Run(run func(ctx context.Context, product1 *Product)) *MockRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Product
+		if args[1] != nil {
+			arg1 = args[1].(*Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Insert_Call) Return(err error) *MockRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, product1 *Product) error) *MockRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OptionCounts provides a mock function for the type MockRepository
+func (_mock *MockRepository) OptionCounts(ctx context.Context, categoryID string, attributeIDs []string) (map[string]map[string]int64, error) {
+	ret := _mock.Called(ctx, categoryID, attributeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OptionCounts")
+	}
+
+	var r0 map[string]map[string]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]map[string]int64, error)); ok {
+		return returnFunc(ctx, categoryID, attributeIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) map[string]map[string]int64); ok {
+		r0 = returnFunc(ctx, categoryID, attributeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, categoryID, attributeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_OptionCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OptionCounts'
+type MockRepository_OptionCounts_Call struct {
+	*mock.Call
+}
+
+// OptionCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+//   - attributeIDs []string
+func (_e *MockRepository_Expecter) OptionCounts(ctx interface{}, categoryID interface{}, attributeIDs interface{}) *MockRepository_OptionCounts_Call {
+	return &MockRepository_OptionCounts_Call{Call: _e.mock.On("OptionCounts", ctx, categoryID, attributeIDs)}
+}
+
+func (_c *MockRepository_OptionCounts_Call) Run(run func(ctx context.Context, categoryID string, attributeIDs []string)) *MockRepository_OptionCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_OptionCounts_Call) Return(counts map[string]map[string]int64, err error) *MockRepository_OptionCounts_Call {
+	_c.Call.Return(counts, err)
+	return _c
+}
+
+func (_c *MockRepository_OptionCounts_Call) RunAndReturn(run func(ctx context.Context, categoryID string, attributeIDs []string) (map[string]map[string]int64, error)) *MockRepository_OptionCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PriceStats provides a mock function for the type MockRepository
+func (_mock *MockRepository) PriceStats(ctx context.Context, categoryID string) (*PriceStats, error) {
+	ret := _mock.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceStats")
+	}
+
+	var r0 *PriceStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*PriceStats, error)); ok {
+		return returnFunc(ctx, categoryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *PriceStats); ok {
+		r0 = returnFunc(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PriceStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_PriceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceStats'
+type MockRepository_PriceStats_Call struct {
+	*mock.Call
+}
+
+// PriceStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+func (_e *MockRepository_Expecter) PriceStats(ctx interface{}, categoryID interface{}) *MockRepository_PriceStats_Call {
+	return &MockRepository_PriceStats_Call{Call: _e.mock.On("PriceStats", ctx, categoryID)}
+}
+
+func (_c *MockRepository_PriceStats_Call) Run(run func(ctx context.Context, categoryID string)) *MockRepository_PriceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PriceStats_Call) Return(stats *PriceStats, err error) *MockRepository_PriceStats_Call {
+	_c.Call.Return(stats, err)
+	return _c
+}
+
+func (_c *MockRepository_PriceStats_Call) RunAndReturn(run func(ctx context.Context, categoryID string) (*PriceStats, error)) *MockRepository_PriceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSales provides a mock function for the type MockRepository
+func (_mock *MockRepository) RecordSales(ctx context.Context, orderID string, units map[string]int64) (bool, error) {
+	ret := _mock.Called(ctx, orderID, units)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSales")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]int64) (bool, error)); ok {
+		return returnFunc(ctx, orderID, units)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]int64) bool); ok {
+		r0 = returnFunc(ctx, orderID, units)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, map[string]int64) error); ok {
+		r1 = returnFunc(ctx, orderID, units)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_RecordSales_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSales'
+type MockRepository_RecordSales_Call struct {
+	*mock.Call
+}
+
+// RecordSales is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+//   - units map[string]int64
+func (_e *MockRepository_Expecter) RecordSales(ctx interface{}, orderID interface{}, units interface{}) *MockRepository_RecordSales_Call {
+	return &MockRepository_RecordSales_Call{Call: _e.mock.On("RecordSales", ctx, orderID, units)}
+}
+
+func (_c *MockRepository_RecordSales_Call) Run(run func(ctx context.Context, orderID string, units map[string]int64)) *MockRepository_RecordSales_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]int64
+		if args[2] != nil {
+			arg2 = args[2].(map[string]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecordSales_Call) Return(b bool, err error) *MockRepository_RecordSales_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRepository_RecordSales_Call) RunAndReturn(run func(ctx context.Context, orderID string, units map[string]int64) (bool, error)) *MockRepository_RecordSales_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function for the type MockRepository
+func (_mock *MockRepository) RecordView(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
 	}
 
 	var r0 error
@@ -55,19 +1329,19 @@ func (_mock *MockRepository) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
-// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockRepository_Delete_Call struct {
+// MockRepository_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type MockRepository_RecordView_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// RecordView is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *MockRepository_Expecter) RecordView(ctx interface{}, id interface{}) *MockRepository_RecordView_Call {
+	return &MockRepository_RecordView_Call{Call: _e.mock.On("RecordView", ctx, id)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Delete_Call {
+func (_c *MockRepository_RecordView_Call) Run(run func(ctx context.Context, id string)) *MockRepository_RecordView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -85,22 +1359,22 @@ func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id strin
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_Call {
+func (_c *MockRepository_RecordView_Call) Return(err error) *MockRepository_RecordView_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_RecordView_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockRepository_RecordView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindByID(ctx context.Context, id string) (*Product, error) {
+// Restore provides a mock function for the type MockRepository
+func (_mock *MockRepository) Restore(ctx context.Context, id string) (*Product, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByID")
+		panic("no return value specified for Restore")
 	}
 
 	var r0 *Product
@@ -123,19 +1397,19 @@ func (_mock *MockRepository) FindByID(ctx context.Context, id string) (*Product,
 	return r0, r1
 }
 
-// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
-type MockRepository_FindByID_Call struct {
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
 	*mock.Call
 }
 
-// FindByID is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockRepository_FindByID_Call {
-	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
 }
 
-func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindByID_Call {
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -153,57 +1427,58 @@ func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id str
 	return _c
 }
 
-func (_c *MockRepository_FindByID_Call) Return(product1 *Product, err error) *MockRepository_FindByID_Call {
+func (_c *MockRepository_Restore_Call) Return(product1 *Product, err error) *MockRepository_Restore_Call {
 	_c.Call.Return(product1, err)
 	return _c
 }
 
-func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*Product, error)) *MockRepository_FindByID_Call {
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) (*Product, error)) *MockRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindList provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindList(ctx context.Context, query ListQuery) (*mongo.PageResult[Product], error) {
-	ret := _mock.Called(ctx, query)
+// Sample provides a mock function for the type MockRepository
+func (_mock *MockRepository) Sample(ctx context.Context, query ListQuery, size int) ([]*Product, error) {
+	ret := _mock.Called(ctx, query, size)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindList")
+		panic("no return value specified for Sample")
 	}
 
-	var r0 *mongo.PageResult[Product]
+	var r0 []*Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery) (*mongo.PageResult[Product], error)); ok {
-		return returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery, int) ([]*Product, error)); ok {
+		return returnFunc(ctx, query, size)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery) *mongo.PageResult[Product]); ok {
-		r0 = returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery, int) []*Product); ok {
+		r0 = returnFunc(ctx, query, size)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*mongo.PageResult[Product])
+			r0 = ret.Get(0).([]*Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ListQuery) error); ok {
-		r1 = returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListQuery, int) error); ok {
+		r1 = returnFunc(ctx, query, size)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_FindList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindList'
-type MockRepository_FindList_Call struct {
+// MockRepository_Sample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sample'
+type MockRepository_Sample_Call struct {
 	*mock.Call
 }
 
-// FindList is a helper method to define mock.On call
+// Sample is a helper method to define mock.On call
 //   - ctx context.Context
-//   - query product.ListQuery
-func (_e *MockRepository_Expecter) FindList(ctx interface{}, query interface{}) *MockRepository_FindList_Call {
-	return &MockRepository_FindList_Call{Call: _e.mock.On("FindList", ctx, query)}
+//   - query ListQuery
+//   - size int
+func (_e *MockRepository_Expecter) Sample(ctx interface{}, query interface{}, size interface{}) *MockRepository_Sample_Call {
+	return &MockRepository_Sample_Call{Call: _e.mock.On("Sample", ctx, query, size)}
 }
 
-func (_c *MockRepository_FindList_Call) Run(run func(ctx context.Context, query ListQuery)) *MockRepository_FindList_Call {
+func (_c *MockRepository_Sample_Call) Run(run func(ctx context.Context, query ListQuery, size int)) *MockRepository_Sample_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -213,77 +1488,88 @@ func (_c *MockRepository_FindList_Call) Run(run func(ctx context.Context, query
 		if args[1] != nil {
 			arg1 = args[1].(ListQuery)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_FindList_Call) Return(pageResult *mongo.PageResult[Product], err error) *MockRepository_FindList_Call {
-	_c.Call.Return(pageResult, err)
+func (_c *MockRepository_Sample_Call) Return(products []*Product, err error) *MockRepository_Sample_Call {
+	_c.Call.Return(products, err)
 	return _c
 }
 
-func (_c *MockRepository_FindList_Call) RunAndReturn(run func(ctx context.Context, query ListQuery) (*mongo.PageResult[Product], error)) *MockRepository_FindList_Call {
+func (_c *MockRepository_Sample_Call) RunAndReturn(run func(ctx context.Context, query ListQuery, size int) ([]*Product, error)) *MockRepository_Sample_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Insert provides a mock function for the type MockRepository
-func (_mock *MockRepository) Insert(ctx context.Context, product1 *Product) error {
-	ret := _mock.Called(ctx, product1)
+// Stream provides a mock function for the type MockRepository
+func (_mock *MockRepository) Stream(ctx context.Context, query ListQuery, yield func(*Product) error) error {
+	ret := _mock.Called(ctx, query, yield)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Insert")
+		panic("no return value specified for Stream")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *Product) error); ok {
-		r0 = returnFunc(ctx, product1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQuery, func(*Product) error) error); ok {
+		r0 = returnFunc(ctx, query, yield)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
-type MockRepository_Insert_Call struct {
+// MockRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockRepository_Stream_Call struct {
 	*mock.Call
 }
 
-// Insert is a helper method to define mock.On call
+// Stream is a helper method to define mock.On call
 //   - ctx context.Context
-//   - product1 *Product
-func (_e *MockRepository_Expecter) Insert(ctx interface{}, product1 interface{}) *MockRepository_Insert_Call {
-	return &MockRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, product1)}
+//   - query ListQuery
+//   - yield func(*Product) error
+func (_e *MockRepository_Expecter) Stream(ctx interface{}, query interface{}, yield interface{}) *MockRepository_Stream_Call {
+	return &MockRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, query, yield)}
 }
 
-func (_c *MockRepository_Insert_Call) Run(run func(ctx context.Context, product1 *Product)) *MockRepository_Insert_Call {
+func (_c *MockRepository_Stream_Call) Run(run func(ctx context.Context, query ListQuery, yield func(*Product) error)) *MockRepository_Stream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *Product
+		var arg1 ListQuery
 		if args[1] != nil {
-			arg1 = args[1].(*Product)
+			arg1 = args[1].(ListQuery)
+		}
+		var arg2 func(*Product) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*Product) error)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_Insert_Call) Return(err error) *MockRepository_Insert_Call {
+func (_c *MockRepository_Stream_Call) Return(err error) *MockRepository_Stream_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, product1 *Product) error) *MockRepository_Insert_Call {
+func (_c *MockRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, query ListQuery, yield func(*Product) error) error) *MockRepository_Stream_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -355,3 +1641,71 @@ func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// Valuation provides a mock function for the type MockRepository
+func (_mock *MockRepository) Valuation(ctx context.Context, costKey string) ([]CategoryValuation, error) {
+	ret := _mock.Called(ctx, costKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Valuation")
+	}
+
+	var r0 []CategoryValuation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]CategoryValuation, error)); ok {
+		return returnFunc(ctx, costKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []CategoryValuation); ok {
+		r0 = returnFunc(ctx, costKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]CategoryValuation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, costKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Valuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Valuation'
+type MockRepository_Valuation_Call struct {
+	*mock.Call
+}
+
+// Valuation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - costKey string
+func (_e *MockRepository_Expecter) Valuation(ctx interface{}, costKey interface{}) *MockRepository_Valuation_Call {
+	return &MockRepository_Valuation_Call{Call: _e.mock.On("Valuation", ctx, costKey)}
+}
+
+func (_c *MockRepository_Valuation_Call) Run(run func(ctx context.Context, costKey string)) *MockRepository_Valuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Valuation_Call) Return(categoryValuations []CategoryValuation, err error) *MockRepository_Valuation_Call {
+	_c.Call.Return(categoryValuations, err)
+	return _c
+}
+
+func (_c *MockRepository_Valuation_Call) RunAndReturn(run func(ctx context.Context, costKey string) ([]CategoryValuation, error)) *MockRepository_Valuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
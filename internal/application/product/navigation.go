@@ -0,0 +1,140 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+const (
+	// navigationTTL bounds how long the product counts of a menu stay stale, as product writes don't drop it
+	navigationTTL = 5 * time.Minute
+
+	// MaxNavigationDepth is the deepest menu a query can ask for
+	MaxNavigationDepth = 5
+
+	navigationPageSize = 500
+)
+
+// NavigationNode is a category of the navigation menu
+type NavigationNode struct {
+	CategoryID string
+	Name       string
+	// Slug is derived from the name, as categories have none; categories whose names give the same slug
+	// get the start of the ID appended
+	Slug string
+	// ProductCount is the number of enabled products of the category
+	ProductCount int64
+	Children     []NavigationNode
+}
+
+// NavigationCache holds the navigation menus of all tenants
+type NavigationCache struct {
+	menus *ttlCache[[]NavigationNode]
+}
+
+func NewNavigationCache() *NavigationCache {
+	return &NavigationCache{menus: newTTLCache[[]NavigationNode](navigationTTL)}
+}
+
+// Invalidate drops the cached menus of the tenant; category events call it
+func (c *NavigationCache) Invalidate(tenant string) {
+	c.menus.deletePrefix(tenant + "/")
+}
+
+type GetNavigationQuery struct {
+	// Depth is the number of levels the menu is trimmed to, from 1 to MaxNavigationDepth
+	Depth int
+	// Tenant scopes the cached menu
+	Tenant string
+}
+
+type GetNavigationQueryHandler interface {
+	// Handle returns the enabled categories as a tree by name. Categories have no parent yet, so every
+	// category is at the top level and the depth only bounds the tree.
+	Handle(ctx context.Context, query GetNavigationQuery) ([]NavigationNode, error)
+}
+
+type getNavigationHandler struct {
+	repo         Repository
+	categoryRepo category.Repository
+	cache        *NavigationCache
+	limits       limits.Product
+}
+
+func NewGetNavigationHandler(repo Repository, categoryRepo category.Repository, cache *NavigationCache, l limits.Limits) GetNavigationQueryHandler {
+	return &getNavigationHandler{repo: repo, categoryRepo: categoryRepo, cache: cache, limits: l.Product}
+}
+
+func (h *getNavigationHandler) Handle(ctx context.Context, query GetNavigationQuery) ([]NavigationNode, error) {
+	if query.Depth < 1 || query.Depth > MaxNavigationDepth {
+		return nil, fmt.Errorf("%w: depth must be between 1 and %d", ErrInvalidProductData, MaxNavigationDepth)
+	}
+
+	key := cacheKey(query.Tenant, "menu")
+	menu, ok := h.cache.menus.get(key)
+	if !ok {
+		var err error
+		if menu, err = h.load(ctx); err != nil {
+			return nil, err
+		}
+		h.cache.menus.put(key, menu)
+	}
+	return trim(menu, query.Depth), nil
+}
+
+// load builds the whole menu, trimmed per query, so menus of every depth share the cache entry
+func (h *getNavigationHandler) load(ctx context.Context) ([]NavigationNode, error) {
+	enabled := true
+	var categories []*category.Category
+	for afterID := ""; ; {
+		page, err := h.categoryRepo.FindList(ctx, category.ListQuery{Size: navigationPageSize, Enabled: &enabled, AfterID: afterID, Sort: "_id"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		categories = append(categories, page.Items...)
+		if len(page.Items) < navigationPageSize {
+			break
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+
+	counts, err := h.repo.CountByCategory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	slices.SortStableFunc(categories, func(a, b *category.Category) int { return cmp.Compare(a.Name, b.Name) })
+	menu := make([]NavigationNode, len(categories))
+	taken := make(map[string]bool, len(categories))
+	for i, c := range categories {
+		slug := slugify(h.limits, c.Name)
+		switch {
+		case slug == "":
+			slug = shortID(c.ID)
+		case taken[slug]:
+			slug = fmt.Sprintf("%s-%s", slug, shortID(c.ID))
+		}
+		taken[slug] = true
+		menu[i] = NavigationNode{CategoryID: c.ID, Name: c.Name, Slug: slug, ProductCount: counts[c.ID]}
+	}
+	return menu, nil
+}
+
+// trim copies the nodes down to depth levels, leaving the cached menu untouched
+func trim(nodes []NavigationNode, depth int) []NavigationNode {
+	trimmed := make([]NavigationNode, len(nodes))
+	for i, n := range nodes {
+		trimmed[i] = n
+		trimmed[i].Children = nil
+		if depth > 1 && len(n.Children) > 0 {
+			trimmed[i].Children = trim(n.Children, depth-1)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,50 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestGetNavigationHandler(t *testing.T) {
+	repo := NewMockRepository(t)
+	categoryRepo := category.NewMockRepository(t)
+	cache := NewNavigationCache()
+	handler := NewGetNavigationHandler(repo, categoryRepo, cache, limits.Default())
+
+	categories := []*category.Category{
+		{ID: "c0ffee00-0000-0000-0000-000000000001", Name: "Power Tools", Enabled: true},
+		{ID: "c0ffee00-0000-0000-0000-000000000002", Name: "Garden", Enabled: true},
+		{ID: "d00d0000-0000-0000-0000-000000000003", Name: "Power tools!", Enabled: true},
+	}
+	categoryRepo.EXPECT().FindList(mock.Anything, mock.MatchedBy(func(q category.ListQuery) bool { return *q.Enabled && q.AfterID == "" })).
+		Return(&commonsmongo.PageResult[category.Category]{Items: categories}, nil).Twice()
+	repo.EXPECT().CountByCategory(mock.Anything).
+		Return(map[string]int64{"c0ffee00-0000-0000-0000-000000000001": 4}, nil).Twice()
+
+	want := []NavigationNode{
+		{CategoryID: "c0ffee00-0000-0000-0000-000000000002", Name: "Garden", Slug: "garden"},
+		{CategoryID: "c0ffee00-0000-0000-0000-000000000001", Name: "Power Tools", Slug: "power-tools", ProductCount: 4},
+		{CategoryID: "d00d0000-0000-0000-0000-000000000003", Name: "Power tools!", Slug: "power-tools-d00d0000"},
+	}
+	for _, depth := range []int{1, 3} {
+		menu, err := handler.Handle(context.Background(), GetNavigationQuery{Depth: depth, Tenant: "acme"})
+		require.NoError(t, err)
+		assert.Equal(t, want, menu)
+	}
+
+	// The menu is loaded again once a category event drops it
+	cache.Invalidate("acme")
+	_, err := handler.Handle(context.Background(), GetNavigationQuery{Depth: 1, Tenant: "acme"})
+	require.NoError(t, err)
+
+	_, err = handler.Handle(context.Background(), GetNavigationQuery{Depth: MaxNavigationDepth + 1, Tenant: "acme"})
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
@@ -0,0 +1,69 @@
+package product
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// flagDeprecatedOptions records a warning for every value of the product picked from a deprecated option
+// of the given attributes, replacing the warnings of an earlier call
+func (p *Product) flagDeprecatedOptions(attrs []*attribute.Attribute) {
+	p.optionWarnings = nil
+	byID := lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID })
+
+	for _, v := range p.Attributes {
+		a, ok := byID[v.AttributeID]
+		if !ok || !a.HasOptions() {
+			continue
+		}
+		slugs := v.OptionSlugValues
+		if v.OptionSlugValue != nil {
+			slugs = []string{*v.OptionSlugValue}
+		}
+		for _, slug := range slugs {
+			opt, ok := a.FindOption(slug)
+			if !ok || !opt.Deprecated {
+				continue
+			}
+			msg := fmt.Sprintf("option %s of attribute %s is deprecated", slug, a.Slug)
+			if opt.ReplacementSlug != nil {
+				msg += ", use " + *opt.ReplacementSlug
+			}
+			p.optionWarnings = append(p.optionWarnings, Warning{Code: WarningOptionDeprecated, Field: "attributes", Message: msg})
+		}
+	}
+}
+
+// RemapOption moves the values of the attribute picked from option from to option to.
+// A multiple value holding both options keeps one. It reports whether the product changed.
+func (p *Product) RemapOption(attributeID, from, to string) bool {
+	changed := false
+	for i, v := range p.Attributes {
+		if v.AttributeID != attributeID {
+			continue
+		}
+		if v.OptionSlugValue != nil && *v.OptionSlugValue == from {
+			p.Attributes[i].OptionSlugValue = &to
+			changed = true
+		}
+		if slices.Contains(v.OptionSlugValues, from) {
+			slugs := slices.Clone(v.OptionSlugValues)
+			for j, slug := range slugs {
+				if slug == from {
+					slugs[j] = to
+				}
+			}
+			p.Attributes[i].OptionSlugValues = lo.Uniq(slugs)
+			changed = true
+		}
+	}
+	if changed {
+		p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	}
+	return changed
+}
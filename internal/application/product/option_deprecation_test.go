@@ -0,0 +1,62 @@
+package product
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+func TestProduct_FlagDeprecatedOptions(t *testing.T) {
+	size, err := attribute.NewAttribute(limits.Default().Attribute, "size-id", "Size", "size", attribute.AttributeTypeMultiple, nil, true, []attribute.Option{
+		{Name: "XL", Slug: "xl"},
+		{Name: "Extra large", Slug: "extra-large"},
+		{Name: "Large", Slug: "large"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, size.DeprecateOption("extra-large", true, ptr("xl")))
+	require.NoError(t, size.DeprecateOption("large", true, nil))
+
+	p, err := NewProduct(defaultLimits, "Shirt", "", ProductTypePhysical, nil, 10, 1, nil, nil, false, []AttributeValue{
+		{AttributeID: "size-id", AttributeSlug: "size", OptionSlugValues: []string{"xl", "extra-large", "large"}},
+	})
+	require.NoError(t, err)
+
+	p.flagDeprecatedOptions([]*attribute.Attribute{size})
+	var messages []string
+	for _, w := range p.Warnings() {
+		if w.Code == WarningOptionDeprecated {
+			messages = append(messages, w.Message)
+		}
+	}
+	assert.Equal(t, []string{
+		"option extra-large of attribute size is deprecated, use xl",
+		"option large of attribute size is deprecated",
+	}, messages)
+
+	p.Attributes[0].OptionSlugValues = []string{"xl"}
+	p.flagDeprecatedOptions([]*attribute.Attribute{size})
+	for _, w := range p.Warnings() {
+		assert.NotEqual(t, WarningOptionDeprecated, w.Code)
+	}
+}
+
+func TestProduct_RemapOption(t *testing.T) {
+	p, err := NewProduct(defaultLimits, "Shirt", "", ProductTypePhysical, nil, 10, 1, nil, nil, false, []AttributeValue{
+		{AttributeID: "size-id", AttributeSlug: "size", OptionSlugValue: ptr("extra-large")},
+		{AttributeID: "fit-id", AttributeSlug: "fit", OptionSlugValues: []string{"slim", "extra-large", "regular", "slim-cut"}},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, p.RemapOption("size-id", "extra-large", "xl"))
+	assert.Equal(t, ptr("xl"), p.Attributes[0].OptionSlugValue)
+	assert.Equal(t, []string{"slim", "extra-large", "regular", "slim-cut"}, p.Attributes[1].OptionSlugValues, "other attributes keep their values")
+
+	assert.True(t, p.RemapOption("fit-id", "slim-cut", "slim"))
+	assert.Equal(t, []string{"slim", "extra-large", "regular"}, p.Attributes[1].OptionSlugValues)
+
+	assert.False(t, p.RemapOption("size-id", "extra-large", "xl"))
+}
@@ -0,0 +1,66 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// priceStatsTTL bounds how long price changes take to move the bounds of price filters
+const priceStatsTTL = time.Minute
+
+// PriceStats summarizes the prices of the enabled products of a category; all zero without products
+type PriceStats struct {
+	Count int64
+	Min   float64
+	Max   float64
+	Avg   float64
+}
+
+type GetCategoryPriceStatsQuery struct {
+	CategoryID string
+	// Tenant scopes the cached stats
+	Tenant string
+}
+
+type GetCategoryPriceStatsQueryHandler interface {
+	// Handle returns the price stats of the category, which storefronts use as the bounds of price sliders.
+	// Stats are cached for a minute. It returns mongo.ErrEntityNotFound for an unknown category.
+	Handle(ctx context.Context, query GetCategoryPriceStatsQuery) (*PriceStats, error)
+}
+
+type getCategoryPriceStatsHandler struct {
+	repo         Repository
+	categoryRepo category.Repository
+	stats        *ttlCache[*PriceStats]
+}
+
+func NewGetCategoryPriceStatsHandler(repo Repository, categoryRepo category.Repository) GetCategoryPriceStatsQueryHandler {
+	return &getCategoryPriceStatsHandler{repo: repo, categoryRepo: categoryRepo, stats: newTTLCache[*PriceStats](priceStatsTTL)}
+}
+
+func (h *getCategoryPriceStatsHandler) Handle(ctx context.Context, query GetCategoryPriceStatsQuery) (*PriceStats, error) {
+	key := cacheKey(query.Tenant, query.CategoryID)
+	if stats, ok := h.stats.get(key); ok {
+		return stats, nil
+	}
+
+	if _, err := h.categoryRepo.FindByID(ctx, query.CategoryID); err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	stats, err := h.repo.PriceStats(ctx, query.CategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price stats: %w", err)
+	}
+
+	h.stats.put(key, stats)
+	return stats, nil
+}
@@ -0,0 +1,47 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestGetCategoryPriceStatsHandler_CachesPerTenant(t *testing.T) {
+	repo := NewMockRepository(t)
+	categoryRepo := category.NewMockRepository(t)
+	handler := NewGetCategoryPriceStatsHandler(repo, categoryRepo).(*getCategoryPriceStatsHandler)
+	now := time.Now()
+	handler.stats.now = func() time.Time { return now }
+
+	stats := &PriceStats{Count: 2, Min: 10, Max: 30, Avg: 20}
+	categoryRepo.EXPECT().FindByID(mock.Anything, "cat-1").Return(&category.Category{ID: "cat-1"}, nil).Times(3)
+	repo.EXPECT().PriceStats(mock.Anything, "cat-1").Return(stats, nil).Times(3)
+
+	for _, tenant := range []string{"acme", "acme", "globex"} {
+		got, err := handler.Handle(context.Background(), GetCategoryPriceStatsQuery{CategoryID: "cat-1", Tenant: tenant})
+		require.NoError(t, err)
+		assert.Equal(t, stats, got)
+	}
+
+	now = now.Add(priceStatsTTL)
+	_, err := handler.Handle(context.Background(), GetCategoryPriceStatsQuery{CategoryID: "cat-1", Tenant: "acme"})
+	require.NoError(t, err)
+}
+
+func TestGetCategoryPriceStatsHandler_UnknownCategory(t *testing.T) {
+	repo := NewMockRepository(t)
+	categoryRepo := category.NewMockRepository(t)
+	handler := NewGetCategoryPriceStatsHandler(repo, categoryRepo)
+
+	categoryRepo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), GetCategoryPriceStatsQuery{CategoryID: "missing", Tenant: "acme"})
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
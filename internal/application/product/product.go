@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
 )
 
 // AttributeValue represents an attribute value assigned to a product
@@ -13,30 +17,125 @@ type AttributeValue struct {
 	AttributeSlug    string   // Attribute slug (immutable, stored for events)
 	OptionSlugValue  *string  // Slug of selected option (for single type)
 	OptionSlugValues []string // Slugs of selected options (for multiple type)
-	NumericValue     *float64 // Numeric value (for range type)
+	NumericValue     *float64 // Numeric value (for range type), in the canonical unit of the attribute
 	TextValue        *string  // Free text value (for text type)
 	BooleanValue     *bool    // Boolean value (for boolean type)
+	Unit             *string  // Canonical unit of NumericValue (from the attribute, stored for events)
+	// Submitted keeps a numeric value submitted in an input unit of the attribute, before conversion
+	Submitted *SubmittedValue
+}
+
+// SubmittedValue is a numeric value in the unit it was submitted in
+type SubmittedValue struct {
+	Value float64
+	Unit  string
+}
+
+// HasValue reports whether a value of any type is set
+func (v AttributeValue) HasValue() bool {
+	return v.OptionSlugValue != nil || len(v.OptionSlugValues) > 0 || v.NumericValue != nil || v.TextValue != nil || v.BooleanValue != nil
 }
 
+// ProductType tells physical goods from bookable services; it is fixed when the product is created
+type ProductType string
+
+const (
+	ProductTypePhysical ProductType = "physical"
+	ProductTypeService  ProductType = "service"
+)
+
 // Product - domain aggregate root
 type Product struct {
-	ID          string
-	Version     int
-	Name        string
+	ID      string
+	Version int
+	Name    string
+	// Slug identifies the product in storefront URLs
+	Slug string
+	// SlugHistory lists the previous slugs, oldest first, so old URLs keep resolving after a rename
+	SlugHistory []string
+	Type        ProductType
+	// Description is HTML, sanitized on write so storefronts can render it as is
 	Description *string
-	Price       float64
-	Quantity    int
-	ImageID     *string
-	CategoryID  *string
-	Enabled     bool
-	Attributes  []AttributeValue
-	CreatedAt   time.Time
-	ModifiedAt  time.Time
+	// Excerpt is the plain text of the description cut to ExcerptLength, for listings and meta tags
+	Excerpt    *string
+	Price      float64
+	Quantity   int
+	ImageID    *string
+	CategoryID *string
+	Enabled    bool
+	Attributes []AttributeValue
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+
+	// FirstPublishedAt is when the product was first enabled and LastEnabledAt when it was last enabled,
+	// nil while it never was. Products enabled before they were tracked get them when enabled again.
+	FirstPublishedAt *time.Time
+	LastEnabledAt    *time.Time
+	// DiscontinuedAt is when the product was withdrawn from sale for good, nil while it is sold.
+	// Discontinued products stay disabled; see Discontinue.
+	DiscontinuedAt *time.Time
+	// ReplacementProductID is the product storefronts redirect a discontinued product to, if any
+	ReplacementProductID *string
+
+	// Metadata holds integration data such as ERP codes or vendor references.
+	// It is carried in events but has no meaning for the catalog.
+	Metadata map[string]string
+
+	// Experiments maps the keys of the merchandising experiments running on the product to the variant
+	// storefronts show, such as "pdp-title" to "short-title". Storefronts resolve the variants themselves.
+	Experiments map[string]string
+
+	// Supplier is the supplier the product is purchased from, if any
+	Supplier *SupplierRef
+
+	// ExternalID is the key of the product in the supplier feed or ERP it is imported from.
+	// It is unique per tenant, so repeated imports update the product instead of duplicating it.
+	ExternalID *string
+
+	// ArchivedAt is set on products read from the archive, see Repository.Archive. Archived products
+	// are read-only until restored.
+	ArchivedAt *time.Time
+
+	// QualityScore rates the completeness of the product from 0 to MaxQualityScore; it is recalculated
+	// on every create and update. Products stored before scoring have 0 until they are written again.
+	QualityScore int
+
+	// UnitsSold counts the units of the product in the orders reported by order analytics, see RecordSales.
+	// It is a ranking signal rather than a ledger: an order counted while the product is being updated may be lost.
+	UnitsSold int64
+
+	// Views counts the recent views of the product page, see RecordProductView. The daily decay scales it down,
+	// so older views weigh less; like UnitsSold it is a ranking signal and a view may be lost.
+	Views float64
+
+	// optionWarnings flags the values picked from deprecated options; writes fill it from the attributes
+	// they load, see flagDeprecatedOptions
+	optionWarnings []Warning
+
+	// previousCategoryID is the category the product was moved out of by Update, nil while it wasn't moved
+	// or had none. It is never persisted, so it only lasts for the write that moved it.
+	previousCategoryID *string
+
+	// Reserved is the stock held by active reservations. It is filled by the queries
+	// and never persisted: Quantity always stays the stock on hand.
+	Reserved int
+
+	// CategoryPath is the breadcrumb of the category, filled by queries asked for EmbedCategoryPath
+	// and never persisted
+	CategoryPath []Crumb
+	// AttributeDefinitions are the attributes of the values in value order, filled by queries asked
+	// for EmbedAttributeDefinitions and never persisted. They are shared with a cache and must not be changed.
+	AttributeDefinitions []*attribute.Attribute
 }
 
 // NewProduct creates a new product with validation
-func NewProduct(name string, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) (*Product, error) {
-	if err := validateProductData(name, price, quantity); err != nil {
+func NewProduct(l limits.Product, name, slug string, productType ProductType, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) (*Product, error) {
+	if err := validateProductData(l, name, price, quantity); err != nil {
+		return nil, err
+	}
+
+	productType, err := resolveProductType(productType)
+	if err != nil {
 		return nil, err
 	}
 
@@ -44,12 +143,26 @@ func NewProduct(name string, description *string, price float64, quantity int, i
 		return nil, err
 	}
 
-	now := time.Now().UTC()
-	return &Product{
-		ID:          uuid.New().String(),
+	if err := validateAttributeValues(l, attributes); err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	slug, err = resolveSlug(l, slug, name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	description, excerpt := describe(description)
+	now := utc.Now()
+	p := &Product{
+		ID:          id,
 		Version:     1,
 		Name:        name,
+		Slug:        slug,
+		Type:        productType,
 		Description: description,
+		Excerpt:     excerpt,
 		Price:       price,
 		Quantity:    quantity,
 		ImageID:     imageID,
@@ -58,12 +171,21 @@ func NewProduct(name string, description *string, price float64, quantity int, i
 		Attributes:  attributes,
 		CreatedAt:   now,
 		ModifiedAt:  now,
-	}, nil
+	}
+	if enabled {
+		p.markEnabled(now)
+	}
+	return p, nil
 }
 
 // NewProductWithID creates a product with a specific ID (for idempotency)
-func NewProductWithID(id, name string, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) (*Product, error) {
-	if err := validateProductData(name, price, quantity); err != nil {
+func NewProductWithID(l limits.Product, id, name, slug string, productType ProductType, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) (*Product, error) {
+	if err := validateProductData(l, name, price, quantity); err != nil {
+		return nil, err
+	}
+
+	productType, err := resolveProductType(productType)
+	if err != nil {
 		return nil, err
 	}
 
@@ -71,12 +193,25 @@ func NewProductWithID(id, name string, description *string, price float64, quant
 		return nil, err
 	}
 
-	now := time.Now().UTC()
-	return &Product{
+	if err := validateAttributeValues(l, attributes); err != nil {
+		return nil, err
+	}
+
+	slug, err = resolveSlug(l, slug, name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	description, excerpt := describe(description)
+	now := utc.Now()
+	p := &Product{
 		ID:          id,
 		Version:     1,
 		Name:        name,
+		Slug:        slug,
+		Type:        productType,
 		Description: description,
+		Excerpt:     excerpt,
 		Price:       price,
 		Quantity:    quantity,
 		ImageID:     imageID,
@@ -85,15 +220,22 @@ func NewProductWithID(id, name string, description *string, price float64, quant
 		Attributes:  attributes,
 		CreatedAt:   now,
 		ModifiedAt:  now,
-	}, nil
+	}
+	if enabled {
+		p.markEnabled(now)
+	}
+	return p, nil
 }
 
 // Reconstruct rebuilds a product from persistence (no validation)
-func Reconstruct(id string, version int, name string, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue, createdAt, modifiedAt time.Time) *Product {
+func Reconstruct(id string, version int, name, slug string, slugHistory []string, productType ProductType, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue, metadata map[string]string, supplier *SupplierRef, externalID *string, createdAt, modifiedAt time.Time) *Product {
 	return &Product{
 		ID:          id,
 		Version:     version,
 		Name:        name,
+		Slug:        slug,
+		SlugHistory: slugHistory,
+		Type:        productType,
 		Description: description,
 		Price:       price,
 		Quantity:    quantity,
@@ -101,14 +243,19 @@ func Reconstruct(id string, version int, name string, description *string, price
 		CategoryID:  categoryID,
 		Enabled:     enabled,
 		Attributes:  attributes,
+		Metadata:    metadata,
+		Supplier:    supplier,
+		ExternalID:  externalID,
 		CreatedAt:   createdAt,
 		ModifiedAt:  modifiedAt,
 	}
 }
 
 // Update modifies product data with validation
-func (p *Product) Update(name string, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) error {
-	if err := validateProductData(name, price, quantity); err != nil {
+// An empty slug keeps the current one, or derives a new one from the name when the product is renamed.
+// The replaced slug is kept in the history.
+func (p *Product) Update(l limits.Product, name, slug string, description *string, price float64, quantity int, imageID *string, categoryID *string, enabled bool, attributes []AttributeValue) error {
+	if err := validateProductData(l, name, price, quantity); err != nil {
 		return err
 	}
 
@@ -116,27 +263,93 @@ func (p *Product) Update(name string, description *string, price float64, quanti
 		return err
 	}
 
+	if enabled && p.DiscontinuedAt != nil {
+		return fmt.Errorf("%w: cannot enable a discontinued product", ErrInvalidProductData)
+	}
+
+	if err := validateAttributeValues(l, attributes); err != nil {
+		return err
+	}
+
+	if slug == "" && name == p.Name && p.Slug != "" {
+		slug = p.Slug
+	}
+	slug, err := resolveSlug(l, slug, name, p.ID)
+	if err != nil {
+		return err
+	}
+
+	p.changeSlug(slug)
 	p.Name = name
-	p.Description = description
+	p.Description, p.Excerpt = describe(description)
 	p.Price = price
 	p.Quantity = quantity
 	p.ImageID = imageID
+	if p.previousCategoryID == nil && p.CategoryID != nil && (categoryID == nil || *categoryID != *p.CategoryID) {
+		p.previousCategoryID = p.CategoryID
+	}
 	p.CategoryID = categoryID
+	wasEnabled := p.Enabled
 	p.Enabled = enabled
 	p.Attributes = attributes
-	p.ModifiedAt = time.Now().UTC()
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	if enabled && !wasEnabled {
+		p.markEnabled(p.ModifiedAt)
+	}
 
 	return nil
 }
 
+// PreviousCategoryID returns the category the product was moved out of by this write, nil if it wasn't moved
+// or had no category
+func (p *Product) PreviousCategoryID() *string {
+	return p.previousCategoryID
+}
+
+// markEnabled records that the product went on sale at the given time
+func (p *Product) markEnabled(at time.Time) {
+	p.LastEnabledAt = &at
+	if p.FirstPublishedAt == nil {
+		p.FirstPublishedAt = &at
+	}
+}
+
+// Discontinue disables the product for good and links it to the product replacing it, if any
+func (p *Product) Discontinue(replacementID *string) {
+	now := utc.NowAfter(p.ModifiedAt)
+	if p.DiscontinuedAt == nil {
+		p.DiscontinuedAt = &now
+	}
+	p.Enabled = false
+	p.ReplacementProductID = replacementID
+	p.ModifiedAt = now
+}
+
+// IsService reports whether the product is a bookable service
+func (p *Product) IsService() bool {
+	return p.Type == ProductTypeService
+}
+
+// resolveProductType validates the type of a new product; an empty type creates a physical product
+func resolveProductType(t ProductType) (ProductType, error) {
+	switch t {
+	case "":
+		return ProductTypePhysical, nil
+	case ProductTypePhysical, ProductTypeService:
+		return t, nil
+	default:
+		return "", fmt.Errorf("%w: unknown product type %q", ErrInvalidProductData, t)
+	}
+}
+
 // validateProductData validates business rules
-func validateProductData(name string, price float64, quantity int) error {
+func validateProductData(l limits.Product, name string, price float64, quantity int) error {
 	if name == "" {
 		return fmt.Errorf("%w: name is required", ErrInvalidProductData)
 	}
 
-	if len(name) > 255 {
-		return fmt.Errorf("%w: name is too long (max 255 characters)", ErrInvalidProductData)
+	if maxLength := l.NameLength; len(name) > maxLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidProductData, maxLength)
 	}
 
 	if price < 0 {
@@ -174,3 +387,20 @@ func validateEnabledState(enabled bool, price float64, quantity int, imageID *st
 
 	return nil
 }
+
+// validateAttributeValues rejects products carrying more than one value entry for the same attribute,
+// or values for more attributes than the limit
+func validateAttributeValues(l limits.Product, attributes []AttributeValue) error {
+	if maxValues := l.AttributeValues; len(attributes) > maxValues {
+		return fmt.Errorf("%w: too many attribute values (max %d)", ErrInvalidProductData, maxValues)
+	}
+	if id, ok := duplicateAttributeID(attributes); ok {
+		return fmt.Errorf("%w: duplicate value for attribute %s", ErrInvalidProductData, id)
+	}
+	return nil
+}
+
+// Available returns the stock that is not held by reservations
+func (p *Product) Available() int {
+	return max(p.Quantity-p.Reserved, 0)
+}
@@ -7,8 +7,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 )
 
+// defaultLimits are the product limits the tests validate against
+var defaultLimits = limits.Default().Product
+
 func TestNewProduct(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -134,7 +139,10 @@ func TestNewProduct(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			product, err := NewProduct(
+				defaultLimits,
 				tt.productName,
+				"",
+				ProductTypePhysical,
 				tt.description,
 				tt.price,
 				tt.quantity,
@@ -202,8 +210,11 @@ func TestNewProductWithID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			product, err := NewProductWithID(
+				defaultLimits,
 				tt.id,
 				tt.productName,
+				"",
+				ProductTypePhysical,
 				nil,
 				tt.price,
 				tt.quantity,
@@ -243,7 +254,7 @@ func TestProduct_Update(t *testing.T) {
 		{
 			name: "successful update",
 			setup: func() *Product {
-				p, _ := NewProduct("Original", nil, 0, 0, nil, nil, false, nil)
+				p, _ := NewProduct(defaultLimits, "Original", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
 				return p
 			},
 			newName:     "Updated Name",
@@ -259,7 +270,7 @@ func TestProduct_Update(t *testing.T) {
 		{
 			name: "error when updating with empty name",
 			setup: func() *Product {
-				p, _ := NewProduct("Original", nil, 0, 0, nil, nil, false, nil)
+				p, _ := NewProduct(defaultLimits, "Original", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
 				return p
 			},
 			newName:  "",
@@ -271,7 +282,7 @@ func TestProduct_Update(t *testing.T) {
 		{
 			name: "error when enabling without required fields",
 			setup: func() *Product {
-				p, _ := NewProduct("Original", nil, 0, 0, nil, nil, false, nil)
+				p, _ := NewProduct(defaultLimits, "Original", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
 				return p
 			},
 			newName:  "Updated",
@@ -289,7 +300,9 @@ func TestProduct_Update(t *testing.T) {
 			originalModifiedAt := product.ModifiedAt
 
 			err := product.Update(
+				defaultLimits,
 				tt.newName,
+				"",
 				tt.description,
 				tt.price,
 				tt.quantity,
@@ -318,13 +331,174 @@ func TestProduct_Update(t *testing.T) {
 	}
 }
 
+func TestProduct_LifecycleTimestamps(t *testing.T) {
+	p, err := NewProduct(defaultLimits, "Lamp", "", ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Nil(t, p.FirstPublishedAt)
+	assert.Nil(t, p.LastEnabledAt)
+
+	enable := func(enabled bool) {
+		t.Helper()
+		require.NoError(t, p.Update(defaultLimits, p.Name, "", nil, 10, 1, ptr("image-1"), ptr("lamps"), enabled, nil))
+	}
+
+	enable(true)
+	require.NotNil(t, p.FirstPublishedAt)
+	firstPublished := *p.FirstPublishedAt
+	assert.Equal(t, p.ModifiedAt, firstPublished)
+	assert.Equal(t, firstPublished, *p.LastEnabledAt)
+
+	// Staying enabled keeps both
+	time.Sleep(time.Millisecond)
+	enable(true)
+	assert.Equal(t, firstPublished, *p.LastEnabledAt)
+
+	enable(false)
+	enable(true)
+	assert.Equal(t, firstPublished, *p.FirstPublishedAt)
+	assert.True(t, p.LastEnabledAt.After(firstPublished))
+
+	created, err := NewProduct(defaultLimits, "Lamp", "", ProductTypePhysical, nil, 10, 1, ptr("image-1"), ptr("lamps"), true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, created.CreatedAt, *created.FirstPublishedAt)
+	assert.Equal(t, created.CreatedAt, *created.LastEnabledAt)
+
+	created.Discontinue(nil)
+	require.NotNil(t, created.DiscontinuedAt)
+	discontinuedAt := *created.DiscontinuedAt
+	assert.False(t, created.Enabled)
+	created.Discontinue(ptr("lamp-2"))
+	assert.Equal(t, discontinuedAt, *created.DiscontinuedAt, "discontinuing again keeps the date")
+	assert.Equal(t, ptr("lamp-2"), created.ReplacementProductID)
+}
+
+func TestProduct_ModifiedAtNeverGoesBack(t *testing.T) {
+	// Last written by a replica whose clock runs an hour ahead
+	ahead := time.Now().UTC().Add(time.Hour).Truncate(time.Millisecond)
+	p := Reconstruct("id-1", 3, "Lamp", "lamp", nil, ProductTypePhysical, nil, 10, 1, nil, nil, false, nil, nil, nil, nil, ahead.Add(-time.Hour), ahead)
+
+	require.NoError(t, p.Update(defaultLimits, p.Name, "", nil, 12, 1, nil, nil, false, nil))
+	assert.Equal(t, ahead.Add(time.Millisecond), p.ModifiedAt)
+
+	p.Discontinue(nil)
+	assert.Equal(t, ahead.Add(2*time.Millisecond), p.ModifiedAt)
+}
+
+func TestNewProduct_Type(t *testing.T) {
+	tests := []struct {
+		name        string
+		productType ProductType
+		want        ProductType
+		wantErr     bool
+	}{
+		{name: "defaults to physical", productType: "", want: ProductTypePhysical},
+		{name: "physical", productType: ProductTypePhysical, want: ProductTypePhysical},
+		{name: "service", productType: ProductTypeService, want: ProductTypeService},
+		{name: "unknown type", productType: "digital", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct(defaultLimits, "Test Product", "", tt.productType, nil, 0, 0, nil, nil, false, nil)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidProductData)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, product.Type)
+			assert.Equal(t, tt.want == ProductTypeService, product.IsService())
+		})
+	}
+}
+
+func TestProduct_DuplicateAttributes(t *testing.T) {
+	attrs := []AttributeValue{
+		{AttributeID: "attr-color", OptionSlugValue: ptr("red")},
+		{AttributeID: "attr-color", OptionSlugValue: ptr("blue")},
+	}
+
+	_, err := NewProduct(defaultLimits, "Test Product", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, attrs)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+	assert.Contains(t, err.Error(), "attr-color")
+
+	_, err = NewProductWithID(defaultLimits, "product-1", "Test Product", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, attrs)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+
+	p, err := NewProduct(defaultLimits, "Test Product", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.NoError(t, err)
+	err = p.Update(defaultLimits, "Test Product", "", nil, 0, 0, nil, nil, false, attrs)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+	assert.Empty(t, p.Attributes)
+}
+
+func TestProduct_AttributeValueLimit(t *testing.T) {
+	l := limits.Default().Product
+	l.AttributeValues = 2
+
+	attrs := []AttributeValue{
+		{AttributeID: "attr-color", OptionSlugValue: ptr("red")},
+		{AttributeID: "attr-size", OptionSlugValue: ptr("m")},
+	}
+	p, err := NewProduct(l, "Test Product", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, attrs)
+	require.NoError(t, err)
+
+	err = p.Update(l, "Test Product", "", nil, 0, 0, nil, nil, false, append(attrs, AttributeValue{AttributeID: "attr-fit", OptionSlugValue: ptr("slim")}))
+	require.ErrorIs(t, err, ErrInvalidProductData)
+	assert.Contains(t, err.Error(), "too many attribute values (max 2)")
+	assert.Len(t, p.Attributes, 2)
+}
+
+func TestMergeAttributeValues(t *testing.T) {
+	values := []AttributeValue{
+		{AttributeID: "attr-color", OptionSlugValue: ptr("red")},
+		{AttributeID: "attr-size", OptionSlugValues: []string{"s", "m"}},
+		{AttributeID: "attr-weight", NumericValue: ptr(1.5)},
+		{AttributeID: "attr-color", OptionSlugValue: ptr("blue")},
+		{AttributeID: "attr-size", OptionSlugValues: []string{"m", "l"}},
+		{AttributeID: "attr-weight", TextValue: ptr("light")},
+	}
+
+	merged := MergeAttributeValues(values)
+
+	assert.Equal(t, []AttributeValue{
+		{AttributeID: "attr-color", OptionSlugValue: ptr("blue")},
+		{AttributeID: "attr-size", OptionSlugValues: []string{"s", "m", "l"}},
+		{AttributeID: "attr-weight", NumericValue: ptr(1.5), TextValue: ptr("light")},
+	}, merged)
+	assert.Equal(t, []string{"s", "m"}, values[1].OptionSlugValues, "input is left untouched")
+
+	unique := values[:3]
+	assert.Equal(t, unique, MergeAttributeValues(unique))
+}
+
+func TestProduct_ContentHash(t *testing.T) {
+	p, err := NewProduct(defaultLimits, "Test Product", "", ProductTypePhysical, nil, 10, 1, nil, nil, false, []AttributeValue{
+		{AttributeID: "attr-color", AttributeSlug: "color", OptionSlugValue: ptr("red")},
+	})
+	require.NoError(t, err)
+	hash := p.ContentHash()
+	assert.Len(t, hash, 64)
+
+	// Bookkeeping fields are not covered
+	p.Version = 7
+	p.Slug = "renamed"
+	p.ModifiedAt = p.ModifiedAt.Add(time.Hour)
+	p.Attributes[0].AttributeSlug = "colour"
+	assert.Equal(t, hash, p.ContentHash())
+
+	p.Attributes[0].OptionSlugValue = ptr("blue")
+	assert.NotEqual(t, hash, p.ContentHash())
+}
+
 func TestReconstruct(t *testing.T) {
 	t.Run("reconstructs product without validation", func(t *testing.T) {
 		// Reconstruct should not validate - it's for rebuilding from persistence
 		product := Reconstruct(
 			"id-123",
 			5,
-			"", // Empty name would fail validation in NewProduct
+			"", "", nil, // Empty name would fail validation in NewProduct
+			ProductTypePhysical,
 			nil,
 			-100, // Negative price would fail validation
 			-50,  // Negative quantity would fail validation
@@ -332,6 +506,8 @@ func TestReconstruct(t *testing.T) {
 			nil,
 			true, // Enabled without required fields
 			nil,
+			nil,
+			nil, nil,
 			fixedTime(),
 			fixedTime(),
 		)
@@ -387,7 +563,7 @@ func TestValidateProductData(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateProductData(tt.productName, tt.price, tt.quantity)
+			err := validateProductData(defaultLimits, tt.productName, tt.price, tt.quantity)
 
 			if tt.wantErr {
 				require.Error(t, err)
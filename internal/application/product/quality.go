@@ -0,0 +1,53 @@
+package product
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// Weights of the parts of the quality score, out of 100. The catalog has no translations,
+// so they don't count until it does.
+const (
+	qualityImageWeight       = 30
+	qualityDescriptionWeight = 30
+	qualityAttributesWeight  = 40
+)
+
+// MaxQualityScore is the score of a product with an image, a description of ExcerptLength characters
+// of text or more and a value for every required attribute of its category
+const MaxQualityScore = qualityImageWeight + qualityDescriptionWeight + qualityAttributesWeight
+
+// scoreQuality rates the completeness of the product against its category, nil without one.
+// Writes call it, so the stored score ranks the products content teams should complete first.
+func (p *Product) scoreQuality(c *category.Category) {
+	score := 0.0
+	if p.ImageID != nil {
+		score += qualityImageWeight
+	}
+	if p.Excerpt != nil {
+		length := min(utf8.RuneCountInString(*p.Excerpt), ExcerptLength)
+		score += qualityDescriptionWeight * float64(length) / ExcerptLength
+	}
+	// Attributes only count once the product is in a category that tells which are required
+	if c != nil {
+		required := c.RequiredAttributes()
+		if len(required) == 0 {
+			score += qualityAttributesWeight
+		} else {
+			values := make(map[string]bool, len(p.Attributes))
+			for _, v := range p.Attributes {
+				values[v.AttributeID] = values[v.AttributeID] || v.HasValue()
+			}
+			filled := 0
+			for _, attr := range required {
+				if values[attr.AttributeID] {
+					filled++
+				}
+			}
+			score += qualityAttributesWeight * float64(filled) / float64(len(required))
+		}
+	}
+	p.QualityScore = int(math.Round(score))
+}
@@ -0,0 +1,50 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+func TestProduct_ScoreQuality(t *testing.T) {
+	full := "<p>" + strings.Repeat("a", ExcerptLength+10) + "</p>"
+	half := "<p>" + strings.Repeat("a", ExcerptLength/2) + "</p>"
+	image := "image-1"
+	color := "red"
+	shirts := &category.Category{ID: "shirts", Attributes: []category.CategoryAttribute{
+		{AttributeID: "color", Required: true},
+		{AttributeID: "size", Required: true},
+		{AttributeID: "material"},
+	}}
+	socks := &category.Category{ID: "socks"}
+
+	tests := []struct {
+		name        string
+		description *string
+		imageID     *string
+		attrs       []AttributeValue
+		category    *category.Category
+		want        int
+	}{
+		{name: "complete", description: &full, imageID: &image, category: socks, want: MaxQualityScore},
+		{name: "bare"},
+		{name: "without category", description: &full, imageID: &image, want: qualityImageWeight + qualityDescriptionWeight},
+		{name: "half the description", description: &half, category: socks, want: qualityDescriptionWeight/2 + qualityAttributesWeight},
+		{name: "some required attributes", imageID: &image, category: shirts,
+			attrs: []AttributeValue{{AttributeID: "color", OptionSlugValue: &color}},
+			want:  qualityImageWeight + qualityAttributesWeight/2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewProduct(defaultLimits, "Shirt", "", ProductTypePhysical, tt.description, 10, 1, tt.imageID, nil, false, tt.attrs)
+			require.NoError(t, err)
+
+			p.scoreQuality(tt.category)
+			assert.Equal(t, tt.want, p.QualityScore)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package product
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -22,6 +23,9 @@ func createTestProductForQuery(id string) *Product {
 		id,
 		1,
 		"Test Product",
+		"test-product",
+		[]string{"old-product"},
+		ProductTypePhysical,
 		ptr("Test description"),
 		99.99,
 		10,
@@ -29,6 +33,8 @@ func createTestProductForQuery(id string) *Product {
 		ptr("category-123"),
 		true,
 		nil,
+		nil,
+		nil, nil,
 		time.Now().UTC(),
 		time.Now().UTC(),
 	)
@@ -36,7 +42,9 @@ func createTestProductForQuery(id string) *Product {
 
 func TestGetProductByIDHandler_Handle_Success(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetProductByIDHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetProductByIDHandler(repo, reservedStock, enricher, NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
 
 	ctx := context.Background()
 	productID := "product-123"
@@ -46,17 +54,72 @@ func TestGetProductByIDHandler_Handle_Success(t *testing.T) {
 		FindByID(mock.Anything, productID).
 		Return(expectedProduct, nil)
 
+	reservedStock.EXPECT().
+		ReservedQuantities(mock.Anything, []string{productID}).
+		Return(map[string]int{productID: 4}, nil)
+
+	enricher.EXPECT().
+		Enrich(mock.Anything, []*Product{expectedProduct}).
+		Return(nil)
+
 	result, err := handler.Handle(ctx, GetProductByIDQuery{ID: productID})
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, expectedProduct.ID, result.ID)
 	assert.Equal(t, expectedProduct.Name, result.Name)
+	assert.Equal(t, 10, result.Quantity, "stock on hand is not changed by reservations")
+	assert.Equal(t, 4, result.Reserved)
+	assert.Equal(t, 6, result.Available())
+}
+
+func TestGetProductBySlugHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name        string
+		slug        string
+		wantMovedTo *string
+	}{
+		{name: "current slug", slug: "test-product"},
+		{name: "previous slug", slug: "old-product", wantMovedTo: ptr("test-product")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockRepository(t)
+			reservedStock := NewMockReservedStock(t)
+			enricher := NewMockAttributeEnricher(t)
+			handler := NewGetProductBySlugHandler(repo, reservedStock, enricher, NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
+
+			expectedProduct := createTestProductForQuery("product-123")
+			repo.EXPECT().FindBySlug(mock.Anything, tt.slug).Return(expectedProduct, nil)
+			reservedStock.EXPECT().ReservedQuantities(mock.Anything, []string{"product-123"}).Return(map[string]int{}, nil)
+			enricher.EXPECT().Enrich(mock.Anything, []*Product{expectedProduct}).Return(nil)
+
+			result, err := handler.Handle(context.Background(), GetProductBySlugQuery{Slug: tt.slug})
+
+			require.NoError(t, err)
+			assert.Equal(t, "product-123", result.Product.ID)
+			assert.Equal(t, tt.wantMovedTo, result.MovedTo)
+		})
+	}
+}
+
+func TestGetProductBySlugHandler_Handle_NotFound(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetProductBySlugHandler(repo, NewMockReservedStock(t), NewMockAttributeEnricher(t), NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
+
+	repo.EXPECT().FindBySlug(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), GetProductBySlugQuery{Slug: "missing"})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
 }
 
 func TestGetProductByIDHandler_Handle_NotFound(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetProductByIDHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetProductByIDHandler(repo, reservedStock, enricher, NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
 
 	ctx := context.Background()
 	productID := "non-existent-id"
@@ -74,7 +137,9 @@ func TestGetProductByIDHandler_Handle_NotFound(t *testing.T) {
 
 func TestGetProductByIDHandler_Handle_RepositoryError(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetProductByIDHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetProductByIDHandler(repo, reservedStock, enricher, NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
 
 	ctx := context.Background()
 	productID := "product-123"
@@ -90,9 +155,67 @@ func TestGetProductByIDHandler_Handle_RepositoryError(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGetProductByIDHandler_Handle_AsOf(t *testing.T) {
+	repo := NewMockRepository(t)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetProductByIDHandler(repo, reservedStock, enricher, NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
+
+	asOf := time.Now().UTC().Add(-24 * time.Hour)
+	past := createTestProductForQuery("product-123")
+
+	repo.EXPECT().
+		FindAsOf(mock.Anything, "product-123", asOf).
+		Return(past, nil)
+	enricher.EXPECT().
+		Enrich(mock.Anything, []*Product{past}).
+		Return(nil)
+
+	result, err := handler.Handle(context.Background(), GetProductByIDQuery{ID: "product-123", AsOf: &asOf})
+
+	require.NoError(t, err)
+	assert.Same(t, past, result)
+	assert.Zero(t, result.Reserved, "reserved stock isn't historized")
+}
+
+func TestGetProductByIDHandler_Handle_EmbedCategoryPath(t *testing.T) {
+	repo := NewMockRepository(t)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	paths := NewMockCategoryPathResolver(t)
+	handler := NewGetProductByIDHandler(repo, reservedStock, enricher, paths, NewMockAttributeDefinitionResolver(t))
+
+	p := createTestProductForQuery("product-123")
+	repo.EXPECT().FindByID(mock.Anything, "product-123").Return(p, nil)
+	reservedStock.EXPECT().ReservedQuantities(mock.Anything, []string{"product-123"}).Return(nil, nil)
+	enricher.EXPECT().Enrich(mock.Anything, []*Product{p}).Return(nil)
+	paths.EXPECT().Path(mock.Anything, "acme", "category-123").Return([]Crumb{{CategoryID: "category-123", Name: "Phones"}}, nil)
+
+	result, err := handler.Handle(context.Background(), GetProductByIDQuery{ID: "product-123", Embed: []Embed{EmbedCategoryPath}, Tenant: "acme"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []Crumb{{CategoryID: "category-123", Name: "Phones"}}, result.CategoryPath)
+}
+
+func TestGetProductByIDHandler_Handle_AsOfNotFound(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetProductByIDHandler(repo, NewMockReservedStock(t), NewMockAttributeEnricher(t), NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t))
+
+	asOf := time.Now().UTC()
+	repo.EXPECT().
+		FindAsOf(mock.Anything, "product-123", asOf).
+		Return(nil, mongo.ErrEntityNotFound)
+
+	_, err := handler.Handle(context.Background(), GetProductByIDQuery{ID: "product-123", AsOf: &asOf})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
 func TestGetListProductsHandler_Handle_Success(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetListProductsHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetListProductsHandler(repo, reservedStock, enricher)
 
 	ctx := context.Background()
 	products := []*Product{
@@ -117,11 +240,23 @@ func TestGetListProductsHandler_Handle_Success(t *testing.T) {
 			Total: 3,
 		}, nil)
 
+	reservedStock.EXPECT().
+		ReservedQuantities(mock.Anything, []string{"product-1", "product-2", "product-3"}).
+		Return(map[string]int{"product-2": 15}, nil)
+
+	enricher.EXPECT().
+		Enrich(mock.Anything, products).
+		Return(nil)
+
 	result, err := handler.Handle(ctx, query)
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Len(t, result.Items, 3)
+	assert.Equal(t, 10, result.Items[0].Available())
+	assert.Equal(t, 10, result.Items[1].Quantity)
+	assert.Equal(t, 15, result.Items[1].Reserved)
+	assert.Equal(t, 0, result.Items[1].Available())
 	assert.Equal(t, 1, result.Page)
 	assert.Equal(t, 10, result.Size)
 	assert.Equal(t, int64(3), result.Total)
@@ -129,7 +264,9 @@ func TestGetListProductsHandler_Handle_Success(t *testing.T) {
 
 func TestGetListProductsHandler_Handle_WithFilters(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetListProductsHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetListProductsHandler(repo, reservedStock, enricher)
 
 	ctx := context.Background()
 	enabled := true
@@ -160,6 +297,10 @@ func TestGetListProductsHandler_Handle_WithFilters(t *testing.T) {
 			Total: 0,
 		}, nil)
 
+	enricher.EXPECT().
+		Enrich(mock.Anything, mock.Anything).
+		Return(nil)
+
 	result, err := handler.Handle(ctx, query)
 
 	require.NoError(t, err)
@@ -169,7 +310,9 @@ func TestGetListProductsHandler_Handle_WithFilters(t *testing.T) {
 
 func TestGetListProductsHandler_Handle_RepositoryError(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetListProductsHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetListProductsHandler(repo, reservedStock, enricher)
 
 	ctx := context.Background()
 	query := GetListProductsQuery{
@@ -188,9 +331,20 @@ func TestGetListProductsHandler_Handle_RepositoryError(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGetListProductsHandler_Handle_OptionSlugWithoutAttribute(t *testing.T) {
+	handler := NewGetListProductsHandler(NewMockRepository(t), NewMockReservedStock(t), NewMockAttributeEnricher(t))
+	slug := "red"
+
+	_, err := handler.Handle(context.Background(), GetListProductsQuery{Page: 1, Size: 10, OptionSlug: &slug})
+
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
+
 func TestGetListProductsHandler_Handle_EmptyResult(t *testing.T) {
 	repo := NewMockRepository(t)
-	handler := NewGetListProductsHandler(repo)
+	reservedStock := NewMockReservedStock(t)
+	enricher := NewMockAttributeEnricher(t)
+	handler := NewGetListProductsHandler(repo, reservedStock, enricher)
 
 	ctx := context.Background()
 	query := GetListProductsQuery{
@@ -207,6 +361,10 @@ func TestGetListProductsHandler_Handle_EmptyResult(t *testing.T) {
 			Total: 0,
 		}, nil)
 
+	enricher.EXPECT().
+		Enrich(mock.Anything, mock.Anything).
+		Return(nil)
+
 	result, err := handler.Handle(ctx, query)
 
 	require.NoError(t, err)
@@ -214,3 +372,60 @@ func TestGetListProductsHandler_Handle_EmptyResult(t *testing.T) {
 	assert.Empty(t, result.Items)
 	assert.Equal(t, int64(0), result.Total)
 }
+
+func TestVerifyProductsHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewVerifyProductsHandler(repo)
+
+	matching := createTestProductForQuery("product-1")
+	stale := createTestProductForQuery("product-2")
+	stale.Version = 3
+	changed := createTestProductForQuery("product-3")
+
+	repo.EXPECT().
+		FindByIDs(mock.Anything, []string{"product-1", "product-2", "product-3", "missing"}).
+		Return([]*Product{changed, matching, stale}, nil)
+
+	result, err := handler.Handle(context.Background(), VerifyProductsQuery{Items: []ExpectedProduct{
+		{ID: "product-1", Version: ptr(1), ContentHash: ptr(matching.ContentHash())},
+		{ID: "product-2", Version: ptr(1)},
+		{ID: "product-3", ContentHash: ptr("stale-hash")},
+		{ID: "missing"},
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []ProductMismatch{
+		{ID: "product-2", Reason: MismatchReasonVersion, ActualVersion: 3, ActualHash: stale.ContentHash()},
+		{ID: "product-3", Reason: MismatchReasonContentHash, ActualVersion: 1, ActualHash: changed.ContentHash()},
+		{ID: "missing", Reason: MismatchReasonMissing},
+	}, result)
+}
+
+func TestVerifyProductsHandler_Handle_Batches(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewVerifyProductsHandler(repo)
+
+	items := make([]ExpectedProduct, verifyBatchSize+1)
+	for i := range items {
+		items[i] = ExpectedProduct{ID: fmt.Sprintf("product-%d", i)}
+	}
+	repo.EXPECT().
+		FindByIDs(mock.Anything, mock.MatchedBy(func(ids []string) bool { return len(ids) == verifyBatchSize })).
+		Return([]*Product{}, nil).Once()
+	repo.EXPECT().
+		FindByIDs(mock.Anything, []string{fmt.Sprintf("product-%d", verifyBatchSize)}).
+		Return([]*Product{}, nil).Once()
+
+	result, err := handler.Handle(context.Background(), VerifyProductsQuery{Items: items})
+
+	require.NoError(t, err)
+	assert.Len(t, result, verifyBatchSize+1)
+}
+
+func TestVerifyProductsHandler_Handle_TooManyItems(t *testing.T) {
+	handler := NewVerifyProductsHandler(NewMockRepository(t))
+
+	_, err := handler.Handle(context.Background(), VerifyProductsQuery{Items: make([]ExpectedProduct, maxVerifyItems+1)})
+
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
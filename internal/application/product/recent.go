@@ -0,0 +1,48 @@
+package product
+
+import (
+	"fmt"
+	"time"
+)
+
+// RecentPreset lists the products created or modified in the last days, newest first,
+// so storefront rails such as "New in" don't compute dates themselves
+type RecentPreset string
+
+const (
+	RecentCreated  RecentPreset = "created"
+	RecentModified RecentPreset = "modified"
+)
+
+// maxRecentDays bounds the window of the recent presets
+const maxRecentDays = 90
+
+// maxRecentResults caps the products a recent preset lists, so a rail never pages through the catalog
+const maxRecentResults = 200
+
+// applyRecentPreset restricts the list query to the window of the preset and sorts it newest first
+func applyRecentPreset(query *ListQuery, preset RecentPreset, days int, now time.Time) error {
+	if days < 1 || days > maxRecentDays {
+		return fmt.Errorf("%w: recent preset days must be between 1 and %d", ErrInvalidProductData, maxRecentDays)
+	}
+	since := now.UTC().AddDate(0, 0, -days)
+
+	switch preset {
+	case RecentCreated:
+		query.CreatedAfter, query.Sort = &since, "createdAt"
+	case RecentModified:
+		query.ModifiedAfter, query.Sort = &since, "modifiedAt"
+	default:
+		return fmt.Errorf("%w: unknown recent preset %q", ErrInvalidProductData, preset)
+	}
+	query.Order = "desc"
+	return nil
+}
+
+// capRecentResults drops the items past maxRecentResults from a page of a recent preset
+func capRecentResults(result *ListProductsResult) {
+	offset := (result.Page - 1) * result.Size
+	keep := max(0, min(len(result.Items), maxRecentResults-offset))
+	result.Items = result.Items[:keep]
+	result.Total = min(result.Total, maxRecentResults)
+}
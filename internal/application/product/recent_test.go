@@ -0,0 +1,60 @@
+package product
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRecentPreset(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	var created ListQuery
+	require.NoError(t, applyRecentPreset(&created, RecentCreated, 7, now))
+	assert.Equal(t, now.AddDate(0, 0, -7), *created.CreatedAfter)
+	assert.Nil(t, created.ModifiedAfter)
+	assert.Equal(t, "createdAt", created.Sort)
+	assert.Equal(t, "desc", created.Order)
+
+	modified := ListQuery{Sort: "name", Order: "asc"}
+	require.NoError(t, applyRecentPreset(&modified, RecentModified, 1, now))
+	assert.Equal(t, now.AddDate(0, 0, -1), *modified.ModifiedAfter)
+	assert.Equal(t, "modifiedAt", modified.Sort)
+	assert.Equal(t, "desc", modified.Order)
+}
+
+func TestApplyRecentPreset_Invalid(t *testing.T) {
+	now := time.Now()
+	for name, tc := range map[string]struct {
+		preset RecentPreset
+		days   int
+	}{
+		"no days":        {RecentCreated, 0},
+		"too many days":  {RecentCreated, maxRecentDays + 1},
+		"unknown preset": {"popular", 7},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, applyRecentPreset(&ListQuery{}, tc.preset, tc.days, now), ErrInvalidProductData)
+		})
+	}
+}
+
+func TestCapRecentResults(t *testing.T) {
+	page := func(n int) []*Product { return make([]*Product, n) }
+
+	last := &ListProductsResult{Items: page(30), Page: 4, Size: 60, Total: 500}
+	capRecentResults(last)
+	assert.Len(t, last.Items, 20)
+	assert.EqualValues(t, maxRecentResults, last.Total)
+
+	past := &ListProductsResult{Items: page(60), Page: 5, Size: 60, Total: 500}
+	capRecentResults(past)
+	assert.Empty(t, past.Items)
+
+	small := &ListProductsResult{Items: page(3), Page: 1, Size: 10, Total: 3}
+	capRecentResults(small)
+	assert.Len(t, small.Items, 3)
+	assert.EqualValues(t, 3, small.Total)
+}
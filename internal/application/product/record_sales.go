@@ -0,0 +1,68 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// SortBestsellers lists the most sold products first, by UnitsSold; the order of the query is ignored
+const SortBestsellers = "bestsellers"
+
+// SoldItem is a line of an order reported by order analytics
+type SoldItem struct {
+	ProductID string
+	Quantity  int
+}
+
+type RecordSalesCommand struct {
+	OrderID string
+	Items   []SoldItem
+}
+
+type RecordSalesCommandHandler interface {
+	// Handle adds the quantities of the order to the units sold of its products. An order is counted once,
+	// however often it is reported; lines without a product or a positive quantity are ignored.
+	Handle(ctx context.Context, cmd RecordSalesCommand) error
+}
+
+type recordSalesHandler struct {
+	repo      Repository
+	txManager mongo.TxManager
+}
+
+func NewRecordSalesHandler(repo Repository, txManager mongo.TxManager) RecordSalesCommandHandler {
+	return &recordSalesHandler{repo: repo, txManager: txManager}
+}
+
+func (h *recordSalesHandler) Handle(ctx context.Context, cmd RecordSalesCommand) error {
+	if cmd.OrderID == "" {
+		return fmt.Errorf("%w: order ID is required", ErrInvalidProductData)
+	}
+
+	units := make(map[string]int64)
+	for _, item := range cmd.Items {
+		if item.ProductID != "" && item.Quantity > 0 {
+			units[item.ProductID] += int64(item.Quantity)
+		}
+	}
+
+	recorded, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (bool, error) {
+		return h.repo.RecordSales(txCtx, cmd.OrderID, units)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record sales: %w", err)
+	}
+	if !recorded {
+		h.log(ctx).Debug("order sales already recorded", zap.String("orderId", cmd.OrderID))
+	}
+	return nil
+}
+
+func (h *recordSalesHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "record-sales-handler"))
+}
@@ -0,0 +1,60 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+)
+
+func TestRecordSalesHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	txManager := mocks.NewMockTxManager(t)
+	handler := NewRecordSalesHandler(repo, txManager)
+
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+	repo.EXPECT().
+		RecordSales(mock.Anything, "order-1", map[string]int64{"product-1": 3, "product-2": 1}).
+		Return(true, nil)
+
+	err := handler.Handle(testCtxUpdate(), RecordSalesCommand{OrderID: "order-1", Items: []SoldItem{
+		{ProductID: "product-1", Quantity: 2},
+		{ProductID: "product-2", Quantity: 1},
+		{ProductID: "product-1", Quantity: 1},
+		{ProductID: "product-3", Quantity: 0},
+		{Quantity: 5},
+	}})
+
+	require.NoError(t, err)
+}
+
+func TestRecordSalesHandler_Handle_RecordedBefore(t *testing.T) {
+	repo := NewMockRepository(t)
+	txManager := mocks.NewMockTxManager(t)
+	handler := NewRecordSalesHandler(repo, txManager)
+
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+	repo.EXPECT().RecordSales(mock.Anything, "order-1", mock.Anything).Return(false, nil)
+
+	assert.NoError(t, handler.Handle(testCtxUpdate(), RecordSalesCommand{OrderID: "order-1"}))
+}
+
+func TestRecordSalesHandler_Handle_MissingOrderID(t *testing.T) {
+	handler := NewRecordSalesHandler(NewMockRepository(t), mocks.NewMockTxManager(t))
+
+	err := handler.Handle(testCtxUpdate(), RecordSalesCommand{Items: []SoldItem{{ProductID: "product-1", Quantity: 1}}})
+
+	assert.ErrorIs(t, err, ErrInvalidProductData)
+}
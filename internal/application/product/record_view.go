@@ -0,0 +1,75 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// SortTrending lists the most viewed products of the last days first, by Views; the order of the query is ignored
+const SortTrending = "trending"
+
+// minViews is the decayed view count below which a product stops trending and its views are reset
+const minViews = 0.01
+
+type RecordProductViewCommandHandler interface {
+	// Handle adds a view to the product. A product that doesn't exist fails with mongo.ErrEntityNotFound.
+	Handle(ctx context.Context, id string) error
+}
+
+type recordProductViewHandler struct {
+	repo Repository
+}
+
+func NewRecordProductViewHandler(repo Repository) RecordProductViewCommandHandler {
+	return &recordProductViewHandler{repo: repo}
+}
+
+func (h *recordProductViewHandler) Handle(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: product ID is required", ErrInvalidProductData)
+	}
+	if err := h.repo.RecordView(ctx, id); err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return mongo.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to record view: %w", err)
+	}
+	return nil
+}
+
+type DecayProductViewsCommandHandler interface {
+	// Handle multiplies the views of every product by factor, which is between 0 and 1, so the trending sort
+	// follows the recent views. It returns the number of products still trending.
+	Handle(ctx context.Context, factor float64) (int64, error)
+}
+
+type decayProductViewsHandler struct {
+	repo Repository
+}
+
+func NewDecayProductViewsHandler(repo Repository) DecayProductViewsCommandHandler {
+	return &decayProductViewsHandler{repo: repo}
+}
+
+func (h *decayProductViewsHandler) Handle(ctx context.Context, factor float64) (int64, error) {
+	if factor < 0 || factor >= 1 {
+		return 0, fmt.Errorf("%w: decay factor must be at least 0 and below 1", ErrInvalidProductData)
+	}
+
+	trending, err := h.repo.DecayViews(ctx, factor, minViews)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decay views: %w", err)
+	}
+	h.log(ctx).Debug("product views decayed", zap.Float64("factor", factor), zap.Int64("trending", trending))
+	return trending, nil
+}
+
+func (h *decayProductViewsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "decay-product-views-handler"))
+}
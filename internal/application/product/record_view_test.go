@@ -0,0 +1,38 @@
+package product
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestRecordProductViewHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewRecordProductViewHandler(repo)
+
+	repo.EXPECT().RecordView(mock.Anything, "product-1").Return(nil)
+	repo.EXPECT().RecordView(mock.Anything, "missing").Return(mongo.ErrEntityNotFound)
+
+	assert.NoError(t, handler.Handle(testCtxUpdate(), "product-1"))
+	assert.ErrorIs(t, handler.Handle(testCtxUpdate(), "missing"), mongo.ErrEntityNotFound)
+	assert.ErrorIs(t, handler.Handle(testCtxUpdate(), ""), ErrInvalidProductData)
+}
+
+func TestDecayProductViewsHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewDecayProductViewsHandler(repo)
+
+	repo.EXPECT().DecayViews(mock.Anything, 0.5, minViews).Return(3, nil)
+
+	trending, err := handler.Handle(testCtxUpdate(), 0.5)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, trending)
+
+	for _, factor := range []float64{-0.1, 1, 2} {
+		_, err := handler.Handle(testCtxUpdate(), factor)
+		assert.ErrorIs(t, err, ErrInvalidProductData, "factor %v", factor)
+	}
+}
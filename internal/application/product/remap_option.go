@@ -0,0 +1,153 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type RemapOptionCommand struct {
+	AttributeID string
+	// OptionSlug is a deprecated option with a replacement
+	OptionSlug string
+	// Progress, if set, is called with the number of products scanned and the catalog size
+	Progress func(scanned, total int64)
+	// ItemFailed, if set, is called for a product that couldn't be remapped and the scan goes on;
+	// otherwise the first failure stops it
+	ItemFailed func(productID string, err error)
+}
+
+type RemapOptionCommandHandler interface {
+	// Handle scans the products of the current tenant and moves their values picked from the deprecated
+	// option to its replacement. It returns the number of products rewritten by this call.
+	Handle(ctx context.Context, cmd RemapOptionCommand) (int, error)
+}
+
+type remapOptionHandler struct {
+	repo     Repository
+	attrRepo attribute.Repository
+	writer   *bulkWriter
+}
+
+func NewRemapOptionHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	outbox outbox.Outbox,
+	batchOutbox BatchOutbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+	policy BulkEventPolicy,
+) RemapOptionCommandHandler {
+	return &remapOptionHandler{
+		repo:     repo,
+		attrRepo: attrRepo,
+		// Prices don't change, so the price change flag isn't needed
+		writer: newBulkWriter(BulkRemapOption, policy, outbox, batchOutbox, txManager, eventFactory, nil),
+	}
+}
+
+func (h *remapOptionHandler) Handle(ctx context.Context, cmd RemapOptionCommand) (int, error) {
+	replacement, err := findOptionReplacement(ctx, h.attrRepo, cmd.AttributeID, cmd.OptionSlug)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	afterID := ""
+	var scanned, total int64
+	for {
+		page, err := h.repo.FindList(ctx, ListQuery{AfterID: afterID, Size: cleanupPageSize, Sort: "_id"})
+		if err != nil {
+			return count, fmt.Errorf("failed to list products: %w", err)
+		}
+		if afterID == "" {
+			total = page.Total
+		}
+
+		remapped, err := h.remap(ctx, page.Items, cmd, replacement)
+		count += remapped
+		if err != nil {
+			return count, err
+		}
+
+		scanned += int64(len(page.Items))
+		if cmd.Progress != nil {
+			cmd.Progress(scanned, max(total, scanned))
+		}
+
+		if len(page.Items) < cleanupPageSize {
+			return count, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+// remap rewrites the products of a page that use the option with one bulk update and stores their
+// update events in the same transaction. It returns the number of products rewritten.
+// A product changed concurrently is skipped; the write flagged it if it still uses the option, and the
+// remap can run again.
+func (h *remapOptionHandler) remap(ctx context.Context, page []*Product, cmd RemapOptionCommand, replacement string) (int, error) {
+	products := lo.Filter(page, func(p *Product, _ int) bool {
+		return p.RemapOption(cmd.AttributeID, cmd.OptionSlug, replacement)
+	})
+	if len(products) == 0 {
+		return 0, nil
+	}
+
+	errs, sends, err := h.writer.write(ctx, products, nil, h.repo.BulkUpdate)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, send := range sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	remapped := lo.CountBy(errs, func(err error) bool { return err == nil })
+	for i, p := range products {
+		switch {
+		case errs[i] == nil:
+			h.log(ctx).Debug("deprecated option remapped", zap.String("id", p.ID))
+		case errors.Is(errs[i], mongo.ErrOptimisticLocking):
+			h.log(ctx).Debug("product changed concurrently, skipped", zap.String("id", p.ID))
+		case cmd.ItemFailed != nil:
+			cmd.ItemFailed(p.ID, fmt.Errorf("failed to update product: %w", errs[i]))
+		default:
+			return remapped, fmt.Errorf("failed to update product: %w", errs[i])
+		}
+	}
+
+	return remapped, nil
+}
+
+func (h *remapOptionHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "remap-option-handler"))
+}
+
+// findOptionReplacement returns the replacement of a deprecated option of the attribute
+func findOptionReplacement(ctx context.Context, attrRepo attribute.Repository, attributeID, slug string) (string, error) {
+	a, err := attrRepo.FindByID(ctx, attributeID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return "", fmt.Errorf("%w: attribute %s not found", ErrInvalidProductData, attributeID)
+		}
+		return "", fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	opt, ok := a.FindOption(slug)
+	switch {
+	case !ok:
+		return "", fmt.Errorf("%w: attribute %s has no option %s", ErrInvalidProductData, a.Slug, slug)
+	case !opt.Deprecated || opt.ReplacementSlug == nil:
+		return "", fmt.Errorf("%w: option %s of attribute %s is not deprecated with a replacement", ErrInvalidProductData, slug, a.Slug)
+	}
+	return *opt.ReplacementSlug, nil
+}
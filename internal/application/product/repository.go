@@ -2,6 +2,7 @@ package product
 
 import (
 	"context"
+	"time"
 
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 )
@@ -11,8 +12,27 @@ type ListQuery struct {
 	Size       int
 	Enabled    *bool
 	CategoryID *string
-	Sort       string
-	Order      string
+	SupplierID *string
+	// HasImage keeps products with (true) or without (false) a main image
+	HasImage *bool
+	// HasCategory keeps products with (true) or without (false) a category
+	HasCategory *bool
+	// AttributeID keeps the products with a value for the attribute; with OptionSlug, only those picking that option
+	AttributeID *string
+	OptionSlug  *string
+	// AfterID restricts the list to IDs greater than the given one, for keyset pagination sorted by "_id"
+	AfterID string
+	Sort    string
+	Order   string
+	// IncludeArchived lists the archived products along with the others
+	IncludeArchived bool
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
+	// CreatedAfter keeps the products created after the given time
+	CreatedAfter *time.Time
+	// MinQualityScore and MaxQualityScore keep the products whose quality score is within the bounds
+	MinQualityScore *int
+	MaxQualityScore *int
 }
 
 type Repository interface {
@@ -20,9 +40,87 @@ type Repository interface {
 
 	FindByID(ctx context.Context, id string) (*Product, error)
 
+	// FindByIDs returns the products with the given IDs that exist, in no particular order
+	FindByIDs(ctx context.Context, ids []string) ([]*Product, error)
+
+	// FindAsOf returns the state the product had at the given time, from its revision history.
+	// It returns commonsmongo.ErrEntityNotFound if it didn't exist at that time.
+	FindAsOf(ctx context.Context, id string, asOf time.Time) (*Product, error)
+
+	// FindByExternalIDs returns the products with the given external IDs that exist, in no particular order
+	FindByExternalIDs(ctx context.Context, externalIDs []string) ([]*Product, error)
+
+	// FindBySlug returns the product whose current or previous slug matches
+	FindBySlug(ctx context.Context, slug string) (*Product, error)
+
 	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[Product], error)
 
+	// Stream calls yield for each product matching the filters of the query in ID order, reading them from a
+	// cursor instead of loading pages; paging, sorting and IncludeArchived are ignored. It stops at the first
+	// error of yield and returns it.
+	Stream(ctx context.Context, query ListQuery, yield func(*Product) error) error
+
+	// PriceStats summarizes the prices of the enabled products of the category
+	PriceStats(ctx context.Context, categoryID string) (*PriceStats, error)
+
+	// OptionCounts counts the enabled products of the category carrying each option of the attributes,
+	// by attribute ID and option slug; options no product carries are left out
+	OptionCounts(ctx context.Context, categoryID string, attributeIDs []string) (map[string]map[string]int64, error)
+
+	// CountByCategory counts the enabled products of each category, by category ID; uncategorized products
+	// are left out and so are categories without enabled products
+	CountByCategory(ctx context.Context) (map[string]int64, error)
+
+	// CountUncategorized counts the products without a category per supplier, in no particular order; products
+	// without a supplier are counted under an empty supplier ID
+	CountUncategorized(ctx context.Context) ([]UncategorizedCount, error)
+
+	// Valuation sums the stock on hand of the physical products per category, by category ID with uncategorized
+	// products first. With a cost key, the unit cost of a product is the number stored under that metadata key.
+	Valuation(ctx context.Context, costKey string) ([]CategoryValuation, error)
+
+	// Sample returns up to size products picked at random among those matching the filters of the query;
+	// paging, sorting and IncludeArchived are ignored. A product is returned once at most.
+	Sample(ctx context.Context, query ListQuery, size int) ([]*Product, error)
+
 	Update(ctx context.Context, product *Product) (*Product, error)
 
 	Delete(ctx context.Context, id string) error
+
+	// BulkInsert inserts the products with unordered bulk writes, so a failing product doesn't stop the others.
+	// It returns the error of each product, nil for the inserted ones, such as ErrSlugAlreadyExists.
+	BulkInsert(ctx context.Context, products []*Product) ([]error, error)
+
+	// BulkUpdate updates the products with unordered bulk writes and the optimistic locking of Update.
+	// It returns the error of each product, nil for the updated ones, whose version is incremented in place.
+	BulkUpdate(ctx context.Context, products []*Product) ([]error, error)
+
+	// FindArchivable returns up to limit disabled products not modified since before, least recently modified first
+	FindArchivable(ctx context.Context, before time.Time, limit int) ([]*Product, error)
+
+	// Archive moves the products to the archive as they were read, with ArchivedAt set. Archived products are
+	// left out of every other read except FindArchivedByID and FindList with IncludeArchived, and free their name,
+	// slugs and external ID. It fails with commonsmongo.ErrOptimisticLocking if one of them changed since it was read.
+	Archive(ctx context.Context, products []*Product) error
+
+	// FindArchivedByID returns a product from the archive
+	FindArchivedByID(ctx context.Context, id string) (*Product, error)
+
+	// Restore moves a product from the archive back to the catalog and returns it, with ModifiedAt set to now so
+	// the next archive run leaves it alone. Like Insert, it fails with ErrNameAlreadyExists, ErrSlugAlreadyExists
+	// or ErrExternalIDAlreadyExists if another product took them meanwhile.
+	Restore(ctx context.Context, id string) (*Product, error)
+
+	// RecordSales adds the units sold by the order to the products, by product ID, without changing their version
+	// or ModifiedAt; products that don't exist are skipped. It returns false without counting anything when the order
+	// was recorded before.
+	RecordSales(ctx context.Context, orderID string, units map[string]int64) (bool, error)
+
+	// RecordView adds one to the views of the product without changing its version or ModifiedAt.
+	// Returns mongo.ErrEntityNotFound if the product doesn't exist.
+	RecordView(ctx context.Context, id string) error
+
+	// DecayViews multiplies the views of every product by factor and resets the views that fall below minViews,
+	// without changing versions or ModifiedAt. It returns the number of products with views left.
+	DecayViews(ctx context.Context, factor, minViews float64) (int64, error)
 }
@@ -0,0 +1,29 @@
+package product
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// checkRequiredAttributes makes sure an enabled product has a value for every required attribute of
+// its category. Disabled products are drafts and may be incomplete.
+func checkRequiredAttributes(p *Product, c *category.Category) error {
+	if !p.Enabled || c == nil {
+		return nil
+	}
+
+	values := make(map[string]bool, len(p.Attributes))
+	for _, v := range p.Attributes {
+		values[v.AttributeID] = v.HasValue()
+	}
+
+	var missing []string
+	for _, attr := range c.RequiredAttributes() {
+		if !values[attr.AttributeID] {
+			missing = append(missing, attr.Slug)
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingAttributesError{Slugs: missing}
+	}
+	return nil
+}
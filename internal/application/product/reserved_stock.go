@@ -0,0 +1,9 @@
+package product
+
+import "context"
+
+// ReservedStock reports the stock held by active reservations, keyed by product ID.
+// Products without reservations are absent from the result.
+type ReservedStock interface {
+	ReservedQuantities(ctx context.Context, productIDs []string) (map[string]int, error)
+}
@@ -0,0 +1,57 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type RestoreProductCommand struct {
+	ID string
+}
+
+type RestoreProductCommandHandler interface {
+	// Handle moves an archived product back to the catalog, still disabled, so it can be changed again.
+	// It fails with ErrNameAlreadyExists, ErrSlugAlreadyExists or ErrExternalIDAlreadyExists if another
+	// product took them while it was archived.
+	Handle(ctx context.Context, cmd RestoreProductCommand) (*Product, error)
+}
+
+type restoreProductHandler struct {
+	repo      Repository
+	txManager mongo.TxManager
+	quotas    quota.Enforcer
+}
+
+func NewRestoreProductHandler(repo Repository, txManager mongo.TxManager, quotas quota.Enforcer) RestoreProductCommandHandler {
+	return &restoreProductHandler{repo: repo, txManager: txManager, quotas: quotas}
+}
+
+func (h *restoreProductHandler) Handle(ctx context.Context, cmd RestoreProductCommand) (*Product, error) {
+	p, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*Product, error) {
+		// Archived products don't count against the quota, so restoring one adds a product
+		if err := h.quotas.Check(txCtx, quota.Products, 1); err != nil {
+			return nil, err
+		}
+		return h.repo.Restore(txCtx, cmd.ID)
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) || errors.Is(err, quota.ErrExceeded) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to restore product: %w", err)
+	}
+
+	h.log(ctx).Debug("product restored", zap.String("id", p.ID))
+	return p, nil
+}
+
+func (h *restoreProductHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "restore-product-handler"))
+}
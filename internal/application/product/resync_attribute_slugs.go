@@ -0,0 +1,151 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// ResyncAttributeSlugs copies the slugs of the attributes into the values of the product, as they are copied
+// when the product is written, and reports whether a copy was stale. Attributes missing from attrs are left alone.
+func (p *Product) ResyncAttributeSlugs(attrs map[string]*attribute.Attribute) bool {
+	changed := false
+	for i, v := range p.Attributes {
+		if a, ok := attrs[v.AttributeID]; ok && a.Slug != v.AttributeSlug {
+			p.Attributes[i].AttributeSlug = a.Slug
+			changed = true
+		}
+	}
+	if changed {
+		p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	}
+	return changed
+}
+
+type ResyncAttributeSlugsCommand struct {
+	// Progress, if set, is called with the number of products scanned and the catalog size
+	Progress func(scanned, total int64)
+	// ItemFailed, if set, is called for a product that couldn't be rewritten and the scan goes on;
+	// otherwise the first failure stops it
+	ItemFailed func(productID string, err error)
+}
+
+type ResyncAttributeSlugsCommandHandler interface {
+	// Handle scans the products of the current tenant and rewrites those holding a stale copy of an attribute
+	// slug, so their events and read models carry the current slug. It returns the number of products rewritten.
+	Handle(ctx context.Context, cmd ResyncAttributeSlugsCommand) (int, error)
+}
+
+type resyncAttributeSlugsHandler struct {
+	repo     Repository
+	attrRepo attribute.Repository
+	writer   *bulkWriter
+}
+
+func NewResyncAttributeSlugsHandler(
+	repo Repository,
+	attrRepo attribute.Repository,
+	outbox outbox.Outbox,
+	batchOutbox BatchOutbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+	policy BulkEventPolicy,
+) ResyncAttributeSlugsCommandHandler {
+	return &resyncAttributeSlugsHandler{
+		repo:     repo,
+		attrRepo: attrRepo,
+		// Prices don't change, so the price change flag isn't needed
+		writer: newBulkWriter(BulkResyncAttributeSlugs, policy, outbox, batchOutbox, txManager, eventFactory, nil),
+	}
+}
+
+func (h *resyncAttributeSlugsHandler) Handle(ctx context.Context, cmd ResyncAttributeSlugsCommand) (int, error) {
+	count := 0
+	afterID := ""
+	var scanned, total int64
+	for {
+		page, err := h.repo.FindList(ctx, ListQuery{AfterID: afterID, Size: cleanupPageSize, Sort: "_id"})
+		if err != nil {
+			return count, fmt.Errorf("failed to list products: %w", err)
+		}
+		if afterID == "" {
+			total = page.Total
+		}
+
+		resynced, err := h.resync(ctx, page.Items, cmd.ItemFailed)
+		count += resynced
+		if err != nil {
+			return count, err
+		}
+
+		scanned += int64(len(page.Items))
+		if cmd.Progress != nil {
+			cmd.Progress(scanned, max(total, scanned))
+		}
+
+		if len(page.Items) < cleanupPageSize {
+			return count, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+// resync rewrites the products of a page with stale slugs with one bulk update and stores their update
+// events in the same transaction. It returns the number of products rewritten.
+// A product changed concurrently is skipped: the change copied the slugs again.
+func (h *resyncAttributeSlugsHandler) resync(ctx context.Context, page []*Product, itemFailed func(string, error)) (int, error) {
+	ids := lo.Uniq(lo.FlatMap(page, func(p *Product, _ int) []string {
+		return lo.Map(p.Attributes, func(v AttributeValue, _ int) string { return v.AttributeID })
+	}))
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	attrs, err := h.attrRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID })
+
+	products := lo.Filter(page, func(p *Product, _ int) bool { return p.ResyncAttributeSlugs(attrMap) })
+	if len(products) == 0 {
+		return 0, nil
+	}
+
+	errs, sends, err := h.writer.write(ctx, products, nil, h.repo.BulkUpdate)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, send := range sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	resynced := lo.CountBy(errs, func(err error) bool { return err == nil })
+	for i, p := range products {
+		switch {
+		case errs[i] == nil:
+			h.log(ctx).Debug("attribute slugs resynced", zap.String("id", p.ID))
+		case errors.Is(errs[i], mongo.ErrOptimisticLocking):
+			h.log(ctx).Debug("product changed concurrently, skipped", zap.String("id", p.ID))
+		case itemFailed != nil:
+			itemFailed(p.ID, fmt.Errorf("failed to update product: %w", errs[i]))
+		default:
+			return resynced, fmt.Errorf("failed to update product: %w", errs[i])
+		}
+	}
+
+	return resynced, nil
+}
+
+func (h *resyncAttributeSlugsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "resync-attribute-slugs-handler"))
+}
@@ -0,0 +1,33 @@
+package product
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+func TestProduct_ResyncAttributeSlugs(t *testing.T) {
+	modified := time.Now().UTC().Add(-time.Hour)
+	p := &Product{ModifiedAt: modified, Attributes: []AttributeValue{
+		{AttributeID: "color", AttributeSlug: "colour", OptionSlugValue: ptr("red")},
+		{AttributeID: "size", AttributeSlug: "size", OptionSlugValues: []string{"m"}},
+		{AttributeID: "deleted", AttributeSlug: "deleted", TextValue: ptr("x")},
+	}}
+	attrs := map[string]*attribute.Attribute{
+		"color": {ID: "color", Slug: "color"},
+		"size":  {ID: "size", Slug: "size"},
+	}
+
+	assert.True(t, p.ResyncAttributeSlugs(attrs))
+	assert.Equal(t, []AttributeValue{
+		{AttributeID: "color", AttributeSlug: "color", OptionSlugValue: ptr("red")},
+		{AttributeID: "size", AttributeSlug: "size", OptionSlugValues: []string{"m"}},
+		{AttributeID: "deleted", AttributeSlug: "deleted", TextValue: ptr("x")},
+	}, p.Attributes, "values of deleted attributes are left alone")
+	assert.True(t, p.ModifiedAt.After(modified))
+
+	assert.False(t, p.ResyncAttributeSlugs(attrs), "slugs are current")
+}
@@ -0,0 +1,53 @@
+package product
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	defaultSampleSize = 10
+	maxSampleSize     = 100
+)
+
+type SampleProductsQuery struct {
+	// Size defaults to defaultSampleSize and is capped at maxSampleSize
+	Size       int
+	Enabled    *bool
+	CategoryID *string
+}
+
+type SampleProductsQueryHandler interface {
+	// Handle returns products picked at random among those matching the query, for placeholders such as
+	// "you may like" rails and for smoke tests that need real products. Each call returns another pick.
+	Handle(ctx context.Context, query SampleProductsQuery) ([]*Product, error)
+}
+
+type sampleProductsHandler struct {
+	list *getListProductsHandler
+}
+
+func NewSampleProductsHandler(repo Repository, reservedStock ReservedStock, enricher AttributeEnricher) SampleProductsQueryHandler {
+	return &sampleProductsHandler{list: &getListProductsHandler{repo: repo, reservedStock: reservedStock, enricher: enricher}}
+}
+
+func (h *sampleProductsHandler) Handle(ctx context.Context, query SampleProductsQuery) ([]*Product, error) {
+	size := query.Size
+	if size <= 0 {
+		size = defaultSampleSize
+	}
+	size = min(size, maxSampleSize)
+
+	products, err := h.list.repo.Sample(ctx, ListQuery{Enabled: query.Enabled, CategoryID: query.CategoryID}, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample products: %w", err)
+	}
+
+	if err := h.list.applyReservedStock(ctx, products); err != nil {
+		return nil, err
+	}
+	if err := h.list.enricher.Enrich(ctx, products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
@@ -0,0 +1,97 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type SetExperimentsCommand struct {
+	ID          string
+	Version     int
+	Experiments map[string]string
+}
+
+type SetExperimentsCommandHandler interface {
+	// Handle replaces the experiments of the product; an empty map ends them all.
+	// Experiments are left out of the content hash, so imports neither see nor overwrite them.
+	Handle(ctx context.Context, cmd SetExperimentsCommand) (*Product, error)
+}
+
+type setExperimentsHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ProductEventFactory
+}
+
+func NewSetExperimentsHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ProductEventFactory,
+) SetExperimentsCommandHandler {
+	return &setExperimentsHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *setExperimentsHandler) Handle(ctx context.Context, cmd SetExperimentsCommand) (*Product, error) {
+	p, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	if p.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := p.SetExperiments(cmd.Experiments); err != nil {
+		return nil, err
+	}
+
+	type setResult struct {
+		Product *Product
+		Send    outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*setResult, error) {
+		updated, err := h.repo.Update(txCtx, p)
+		if err != nil {
+			if errors.Is(err, mongo.ErrOptimisticLocking) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to update product: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewProductUpdatedOutboxMessage(txCtx, updated))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+		return &setResult{Product: updated, Send: send}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("product experiments set", zap.String("id", res.Product.ID), zap.Int("experiments", len(res.Product.Experiments)))
+
+	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return res.Product, nil
+}
+
+func (h *setExperimentsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "set-experiments-handler"))
+}
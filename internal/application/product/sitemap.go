@@ -0,0 +1,78 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// sitemapBatchSize is the number of products loaded per query while a sitemap file is written
+const sitemapBatchSize = 500
+
+// SitemapEntry is a product URL of the sitemap
+type SitemapEntry struct {
+	Slug       string
+	ModifiedAt time.Time
+}
+
+type GetSitemapQuery struct {
+	// Page is the 1-based sitemap file
+	Page int
+	// Size is the number of products per sitemap file, a multiple of the batch size
+	Size int
+}
+
+type GetSitemapQueryHandler interface {
+	// Pages returns the number of sitemap files of the given size the enabled products fill, at least one
+	Pages(ctx context.Context, size int) (int, error)
+
+	// Handle calls yield for each enabled product of the page in ID order, loading them in batches
+	// so the sitemap can be streamed. Products stored before slugs were introduced are skipped.
+	Handle(ctx context.Context, query GetSitemapQuery, yield func(SitemapEntry) error) error
+}
+
+type getSitemapHandler struct {
+	repo Repository
+}
+
+func NewGetSitemapHandler(repo Repository) GetSitemapQueryHandler {
+	return &getSitemapHandler{repo: repo}
+}
+
+func (h *getSitemapHandler) Pages(ctx context.Context, size int) (int, error) {
+	enabled := true
+	result, err := h.repo.FindList(ctx, ListQuery{Page: 1, Size: 1, Enabled: &enabled})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+	return max(int((result.Total+int64(size)-1)/int64(size)), 1), nil
+}
+
+func (h *getSitemapHandler) Handle(ctx context.Context, query GetSitemapQuery, yield func(SitemapEntry) error) error {
+	if query.Page < 1 || query.Size < sitemapBatchSize || query.Size%sitemapBatchSize != 0 {
+		return fmt.Errorf("%w: invalid sitemap page %d of size %d", ErrInvalidProductData, query.Page, query.Size)
+	}
+
+	enabled := true
+	batchesPerPage := query.Size / sitemapBatchSize
+	first := (query.Page-1)*batchesPerPage + 1
+	for batch := first; batch < first+batchesPerPage; batch++ {
+		result, err := h.repo.FindList(ctx, ListQuery{Page: batch, Size: sitemapBatchSize, Enabled: &enabled, Sort: "_id"})
+		if err != nil {
+			return fmt.Errorf("failed to list products: %w", err)
+		}
+
+		for _, p := range result.Items {
+			if p.Slug == "" {
+				continue
+			}
+			if err := yield(SitemapEntry{Slug: p.Slug, ModifiedAt: p.ModifiedAt}); err != nil {
+				return err
+			}
+		}
+		if len(result.Items) < sitemapBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func sitemapProducts(n int, modifiedAt time.Time) []*Product {
+	products := make([]*Product, n)
+	for i := range products {
+		id := fmt.Sprintf("product-%04d", i)
+		products[i] = Reconstruct(id, 1, id, id, nil, ProductTypePhysical, nil, 1, 1, nil, nil, true, nil, nil, nil, nil, modifiedAt, modifiedAt)
+	}
+	return products
+}
+
+func TestGetSitemapHandler_Pages(t *testing.T) {
+	tests := []struct {
+		total int64
+		want  int
+	}{
+		{total: 0, want: 1},
+		{total: 1000, want: 1},
+		{total: 1001, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.total), func(t *testing.T) {
+			repo := NewMockRepository(t)
+			repo.EXPECT().
+				FindList(mock.Anything, mock.MatchedBy(func(q ListQuery) bool { return q.Enabled != nil && *q.Enabled })).
+				Return(&commonsmongo.PageResult[Product]{Total: tt.total}, nil)
+
+			pages, err := NewGetSitemapHandler(repo).Pages(context.Background(), 1000)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pages)
+		})
+	}
+}
+
+func TestGetSitemapHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	now := time.Now().UTC()
+	full := sitemapProducts(sitemapBatchSize, now)
+	last := sitemapProducts(3, now)
+	last[1].Slug = "" // stored before slugs were introduced
+
+	// Page 3 of 1000 products per file starts with the fifth batch of 500
+	repo.EXPECT().
+		FindList(mock.Anything, mock.MatchedBy(func(q ListQuery) bool { return q.Page == 5 && q.Size == sitemapBatchSize && *q.Enabled })).
+		Return(&commonsmongo.PageResult[Product]{Items: full}, nil)
+	repo.EXPECT().
+		FindList(mock.Anything, mock.MatchedBy(func(q ListQuery) bool { return q.Page == 6 })).
+		Return(&commonsmongo.PageResult[Product]{Items: last}, nil)
+
+	var entries []SitemapEntry
+	err := NewGetSitemapHandler(repo).Handle(context.Background(), GetSitemapQuery{Page: 3, Size: 1000}, func(e SitemapEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, entries, sitemapBatchSize+2)
+	assert.Equal(t, SitemapEntry{Slug: "product-0000", ModifiedAt: now}, entries[0])
+	assert.Equal(t, "product-0002", entries[len(entries)-1].Slug)
+}
+
+func TestGetSitemapHandler_Handle_Errors(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetSitemapHandler(repo)
+	yield := func(SitemapEntry) error { return nil }
+
+	err := handler.Handle(context.Background(), GetSitemapQuery{Page: 1, Size: 700}, yield)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+
+	repo.EXPECT().FindList(mock.Anything, mock.Anything).Return(&commonsmongo.PageResult[Product]{Items: sitemapProducts(1, time.Now())}, nil)
+	stop := errors.New("client gone")
+	err = handler.Handle(context.Background(), GetSitemapQuery{Page: 1, Size: 500}, func(SitemapEntry) error { return stop })
+	require.ErrorIs(t, err, stop)
+}
@@ -0,0 +1,109 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/slug"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// disambiguationLength is the length of the ID suffix of a disambiguated slug
+const disambiguationLength = 9
+
+// maxDerivedSlugLength leaves room for the ID suffix of a disambiguated slug
+func maxDerivedSlugLength(l limits.Product) int {
+	return l.SlugLength - disambiguationLength
+}
+
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Slugs returns the current slug followed by the previous ones, all of them resolve to the product
+func (p *Product) Slugs() []string {
+	if p.Slug == "" {
+		return p.SlugHistory
+	}
+	return append([]string{p.Slug}, p.SlugHistory...)
+}
+
+// changeSlug moves the current slug to the history. Taking back a previous slug removes it from there.
+func (p *Product) changeSlug(slug string) {
+	if slug == p.Slug {
+		return
+	}
+	history := slices.DeleteFunc(slices.Clone(p.SlugHistory), func(s string) bool { return s == slug })
+	if p.Slug != "" && !slices.Contains(history, p.Slug) {
+		history = append(history, p.Slug)
+	}
+	p.Slug = slug
+	p.SlugHistory = history
+}
+
+// inheritSlugs keeps the slugs of a product merged into p resolving, as previous slugs of p
+func (p *Product) inheritSlugs(merged *Product) {
+	history := slices.Clone(p.SlugHistory)
+	for _, slug := range merged.Slugs() {
+		if slug != p.Slug && !slices.Contains(history, slug) {
+			history = append(history, slug)
+		}
+	}
+	p.SlugHistory = history
+}
+
+// disambiguateSlug appends the start of the ID to a slug derived from the name that is already taken
+func (p *Product) disambiguateSlug() {
+	p.Slug = fmt.Sprintf("%s-%s", p.Slug, shortID(p.ID))
+}
+
+// claimSlug makes sure the slug of p doesn't resolve to another product. A slug derived from
+// the name is disambiguated instead of rejected. The unique index still guards concurrent writes.
+func claimSlug(ctx context.Context, repo Repository, p *Product, derived bool) error {
+	owner, err := repo.FindBySlug(ctx, p.Slug)
+	if errors.Is(err, mongo.ErrEntityNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check slug: %w", err)
+	}
+	if owner.ID == p.ID {
+		return nil
+	}
+	if !derived {
+		return ErrSlugAlreadyExists
+	}
+	p.disambiguateSlug()
+	return nil
+}
+
+// resolveSlug validates a given slug, an empty one is derived from the name
+func resolveSlug(l limits.Product, slug, name, id string) (string, error) {
+	if slug == "" {
+		if slug = slugify(l, name); slug == "" {
+			slug = shortID(id)
+		}
+		return slug, nil
+	}
+
+	if maxLength := l.SlugLength; len(slug) > maxLength {
+		return "", fmt.Errorf("%w: slug is too long (max %d characters)", ErrInvalidProductData, maxLength)
+	}
+	if !slugRegex.MatchString(slug) {
+		return "", fmt.Errorf("%w: slug must contain only lowercase letters, digits and hyphens", ErrInvalidProductData)
+	}
+	return slug, nil
+}
+
+// slugify derives a slug from the name, see slug.FromName
+func slugify(l limits.Product, name string) string {
+	return slug.FromName(name, maxDerivedSlugLength(l))
+}
+
+func shortID(id string) string {
+	id = strings.ReplaceAll(id, "-", "")
+	return strings.ToLower(id[:min(len(id), 8)])
+}
@@ -0,0 +1,82 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "iPhone 15 Pro", want: "iphone-15-pro"},
+		{name: "  Crème brûlée -- 2 pcs! ", want: "creme-brulee-2-pcs"},
+		{name: "T-Shirt (XL)", want: "t-shirt-xl"},
+		{name: "Футболка", want: "futbolka"},
+		{name: "東京", want: ""},
+		{name: strings.Repeat("a", 300), want: strings.Repeat("a", maxDerivedSlugLength(defaultLimits))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, slugify(defaultLimits, tt.name))
+		})
+	}
+}
+
+func TestNewProduct_Slug(t *testing.T) {
+	p, err := NewProductWithID(defaultLimits, "0f8fad5b-d9cb-469f-a165-70867728950e", "Blue Shirt", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "blue-shirt", p.Slug)
+	assert.Empty(t, p.SlugHistory)
+
+	p, err = NewProductWithID(defaultLimits, "0f8fad5b-d9cb-469f-a165-70867728950e", "東京", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0f8fad5b", p.Slug, "falls back to the ID when the name has no character a slug keeps")
+
+	p, err = NewProduct(defaultLimits, "Blue Shirt", "summer-shirt", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "summer-shirt", p.Slug)
+
+	_, err = NewProduct(defaultLimits, "Blue Shirt", "Summer Shirt", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
+
+func TestProduct_Update_SlugHistory(t *testing.T) {
+	p, err := NewProduct(defaultLimits, "Blue Shirt", "", ProductTypePhysical, nil, 0, 0, nil, nil, false, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Update(defaultLimits, "Blue Shirt", "", nil, 0, 0, nil, nil, false, nil))
+	assert.Equal(t, "blue-shirt", p.Slug, "unchanged name keeps the slug")
+	assert.Empty(t, p.SlugHistory)
+
+	require.NoError(t, p.Update(defaultLimits, "Navy Shirt", "", nil, 0, 0, nil, nil, false, nil))
+	assert.Equal(t, "navy-shirt", p.Slug, "a rename derives a new slug")
+	assert.Equal(t, []string{"blue-shirt"}, p.SlugHistory)
+
+	require.NoError(t, p.Update(defaultLimits, "Navy Shirt", "navy", nil, 0, 0, nil, nil, false, nil))
+	assert.Equal(t, []string{"blue-shirt", "navy-shirt"}, p.SlugHistory)
+
+	require.NoError(t, p.Update(defaultLimits, "Navy Shirt", "blue-shirt", nil, 0, 0, nil, nil, false, nil))
+	assert.Equal(t, "blue-shirt", p.Slug)
+	assert.Equal(t, []string{"navy-shirt", "navy"}, p.SlugHistory, "a previous slug taken back leaves the history")
+	assert.Equal(t, []string{"blue-shirt", "navy-shirt", "navy"}, p.Slugs())
+
+	err = p.Update(defaultLimits, "Navy Shirt", "-bad-", nil, 0, 0, nil, nil, false, nil)
+	require.ErrorIs(t, err, ErrInvalidProductData)
+	assert.Equal(t, "blue-shirt", p.Slug)
+}
+
+func TestProduct_Update_DerivesMissingSlug(t *testing.T) {
+	// Stored before slugs were introduced
+	p := Reconstruct("product-1", 1, "Blue Shirt", "", nil, ProductTypePhysical, nil, 0, 0, nil, nil, false, nil, nil, nil, nil, fixedTime(), fixedTime())
+
+	require.NoError(t, p.Update(defaultLimits, "Blue Shirt", "", nil, 0, 0, nil, nil, false, nil))
+
+	assert.Equal(t, "blue-shirt", p.Slug)
+	assert.Empty(t, p.SlugHistory)
+}
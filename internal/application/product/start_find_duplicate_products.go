@@ -0,0 +1,31 @@
+package product
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+type StartFindDuplicateProductsCommandHandler interface {
+	// Handle runs the duplicate search as a background job and returns the queued job.
+	// Progress counts the products scanned; the result is a FindDuplicateProductsResult.
+	Handle(ctx context.Context) (*job.Job, error)
+}
+
+type startFindDuplicateProductsHandler struct {
+	findHandler FindDuplicateProductsQueryHandler
+	scheduler   job.Scheduler
+}
+
+func NewStartFindDuplicateProductsHandler(findHandler FindDuplicateProductsQueryHandler, scheduler job.Scheduler) StartFindDuplicateProductsCommandHandler {
+	return &startFindDuplicateProductsHandler{
+		findHandler: findHandler,
+		scheduler:   scheduler,
+	}
+}
+
+func (h *startFindDuplicateProductsHandler) Handle(ctx context.Context) (*job.Job, error) {
+	return h.scheduler.Submit(ctx, job.TypeFindDuplicateProducts, func(ctx context.Context, progress job.Progress) (any, error) {
+		return h.findHandler.Handle(ctx, FindDuplicateProductsQuery{Progress: progress.Advance})
+	})
+}
@@ -0,0 +1,85 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+type StartInventoryValuationCommand struct {
+	// CostKey, if set, is the metadata key holding the unit cost of a product
+	CostKey string
+}
+
+type StartInventoryValuationCommandHandler interface {
+	// Handle runs the inventory valuation as a background job and returns the queued job.
+	// The result is an InventoryValuationResult, also downloadable as CSV once the job has succeeded.
+	Handle(ctx context.Context, cmd StartInventoryValuationCommand) (*job.Job, error)
+}
+
+type startInventoryValuationHandler struct {
+	valuationHandler InventoryValuationQueryHandler
+	scheduler        job.Scheduler
+}
+
+func NewStartInventoryValuationHandler(valuationHandler InventoryValuationQueryHandler, scheduler job.Scheduler) StartInventoryValuationCommandHandler {
+	return &startInventoryValuationHandler{
+		valuationHandler: valuationHandler,
+		scheduler:        scheduler,
+	}
+}
+
+func (h *startInventoryValuationHandler) Handle(ctx context.Context, cmd StartInventoryValuationCommand) (*job.Job, error) {
+	// Fail the request rather than the job
+	if err := validateCostKey(cmd.CostKey); err != nil {
+		return nil, err
+	}
+
+	return h.scheduler.Submit(ctx, job.TypeInventoryValuation, func(ctx context.Context, progress job.Progress) (any, error) {
+		result, err := h.valuationHandler.Handle(ctx, InventoryValuationQuery{CostKey: cmd.CostKey})
+		if err != nil {
+			return nil, err
+		}
+		progress.Advance(int64(len(result.Categories)), int64(len(result.Categories)))
+		return result, nil
+	})
+}
+
+type GetInventoryValuationReportQuery struct {
+	JobID string
+}
+
+type GetInventoryValuationReportQueryHandler interface {
+	// Handle returns the report of a valuation job. It returns job.ErrJobNotFound for an unknown job
+	// or a job of another type, and job.ErrJobNotSucceeded while the job runs or once it has failed.
+	Handle(ctx context.Context, query GetInventoryValuationReportQuery) (*InventoryValuationResult, error)
+}
+
+type getInventoryValuationReportHandler struct {
+	getJob job.GetJobByIDQueryHandler
+}
+
+func NewGetInventoryValuationReportHandler(getJob job.GetJobByIDQueryHandler) GetInventoryValuationReportQueryHandler {
+	return &getInventoryValuationReportHandler{getJob: getJob}
+}
+
+func (h *getInventoryValuationReportHandler) Handle(ctx context.Context, query GetInventoryValuationReportQuery) (*InventoryValuationResult, error) {
+	j, err := h.getJob.Handle(ctx, job.GetJobByIDQuery{ID: query.JobID})
+	if err != nil {
+		return nil, err
+	}
+	if j.Type != job.TypeInventoryValuation {
+		return nil, job.ErrJobNotFound
+	}
+	if j.Status != job.StatusSucceeded {
+		return nil, job.ErrJobNotSucceeded
+	}
+
+	var result InventoryValuationResult
+	if err := json.Unmarshal(j.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode valuation report: %w", err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,43 @@
+package product
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+// MergeDuplicateAttributesResult is the result document of the merge job
+type MergeDuplicateAttributesResult struct {
+	// Merged is the number of products rewritten
+	Merged int `json:"merged"`
+}
+
+type StartMergeDuplicateAttributesCommandHandler interface {
+	// Handle runs the merge of duplicate attribute values as a background job and returns the queued job.
+	// Progress counts the products scanned; products that fail are reported as item errors.
+	Handle(ctx context.Context, cmd MergeDuplicateAttributesCommand) (*job.Job, error)
+}
+
+type startMergeDuplicateAttributesHandler struct {
+	mergeHandler MergeDuplicateAttributesCommandHandler
+	scheduler    job.Scheduler
+}
+
+func NewStartMergeDuplicateAttributesHandler(mergeHandler MergeDuplicateAttributesCommandHandler, scheduler job.Scheduler) StartMergeDuplicateAttributesCommandHandler {
+	return &startMergeDuplicateAttributesHandler{
+		mergeHandler: mergeHandler,
+		scheduler:    scheduler,
+	}
+}
+
+func (h *startMergeDuplicateAttributesHandler) Handle(ctx context.Context, cmd MergeDuplicateAttributesCommand) (*job.Job, error) {
+	return h.scheduler.Submit(ctx, job.TypeMergeDuplicateAttributes, func(ctx context.Context, progress job.Progress) (any, error) {
+		cmd.Progress = progress.Advance
+		cmd.ItemFailed = progress.ItemFailed
+		merged, err := h.mergeHandler.Handle(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return MergeDuplicateAttributesResult{Merged: merged}, nil
+	})
+}
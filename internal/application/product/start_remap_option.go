@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+// RemapOptionResult is the result document of the remap job
+type RemapOptionResult struct {
+	// Remapped is the number of products rewritten
+	Remapped int `json:"remapped"`
+}
+
+type StartRemapOptionCommandHandler interface {
+	// Handle checks that the option is deprecated with a replacement, failing with ErrInvalidProductData otherwise,
+	// and runs the remap as a background job. Progress counts the products scanned; products that fail are
+	// reported as item errors.
+	Handle(ctx context.Context, cmd RemapOptionCommand) (*job.Job, error)
+}
+
+type startRemapOptionHandler struct {
+	remapHandler RemapOptionCommandHandler
+	attrRepo     attribute.Repository
+	scheduler    job.Scheduler
+}
+
+func NewStartRemapOptionHandler(remapHandler RemapOptionCommandHandler, attrRepo attribute.Repository, scheduler job.Scheduler) StartRemapOptionCommandHandler {
+	return &startRemapOptionHandler{
+		remapHandler: remapHandler,
+		attrRepo:     attrRepo,
+		scheduler:    scheduler,
+	}
+}
+
+func (h *startRemapOptionHandler) Handle(ctx context.Context, cmd RemapOptionCommand) (*job.Job, error) {
+	if _, err := findOptionReplacement(ctx, h.attrRepo, cmd.AttributeID, cmd.OptionSlug); err != nil {
+		return nil, err
+	}
+
+	return h.scheduler.Submit(ctx, job.TypeRemapOption, func(ctx context.Context, progress job.Progress) (any, error) {
+		cmd.Progress = progress.Advance
+		cmd.ItemFailed = progress.ItemFailed
+		remapped, err := h.remapHandler.Handle(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return RemapOptionResult{Remapped: remapped}, nil
+	})
+}
@@ -0,0 +1,60 @@
+package product
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+// ResyncAttributeSlugsResult is the result document of the resync job
+type ResyncAttributeSlugsResult struct {
+	// Categories is the number of categories rewritten
+	Categories int `json:"categories"`
+	// Products is the number of products rewritten
+	Products int `json:"products"`
+}
+
+type StartResyncAttributeSlugsCommandHandler interface {
+	// Handle runs the resync of the attribute slugs copied into categories, then into products, as a background
+	// job and returns the queued job. Progress counts the products scanned; categories and products that fail
+	// are reported as item errors, categories as "category/<id>".
+	Handle(ctx context.Context) (*job.Job, error)
+}
+
+type startResyncAttributeSlugsHandler struct {
+	categoryHandler category.ResyncAttributeSlugsCommandHandler
+	productHandler  ResyncAttributeSlugsCommandHandler
+	scheduler       job.Scheduler
+}
+
+func NewStartResyncAttributeSlugsHandler(
+	categoryHandler category.ResyncAttributeSlugsCommandHandler,
+	productHandler ResyncAttributeSlugsCommandHandler,
+	scheduler job.Scheduler,
+) StartResyncAttributeSlugsCommandHandler {
+	return &startResyncAttributeSlugsHandler{
+		categoryHandler: categoryHandler,
+		productHandler:  productHandler,
+		scheduler:       scheduler,
+	}
+}
+
+func (h *startResyncAttributeSlugsHandler) Handle(ctx context.Context) (*job.Job, error) {
+	return h.scheduler.Submit(ctx, job.TypeResyncAttributeSlugs, func(ctx context.Context, progress job.Progress) (any, error) {
+		categories, err := h.categoryHandler.Handle(ctx, category.ResyncAttributeSlugsCommand{
+			ItemFailed: func(categoryID string, err error) { progress.ItemFailed("category/"+categoryID, err) },
+		})
+		if err != nil {
+			return nil, err
+		}
+		products, err := h.productHandler.Handle(ctx, ResyncAttributeSlugsCommand{
+			Progress:   progress.Advance,
+			ItemFailed: progress.ItemFailed,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return ResyncAttributeSlugsResult{Categories: categories, Products: products}, nil
+	})
+}
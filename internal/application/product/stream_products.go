@@ -0,0 +1,73 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// streamBatchSize is the number of streamed products enriched together, trading memory for round trips
+const streamBatchSize = 100
+
+type StreamProductsQuery struct {
+	Enabled     *bool
+	CategoryID  *string
+	SupplierID  *string
+	HasImage    *bool
+	HasCategory *bool
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
+}
+
+type StreamProductsQueryHandler interface {
+	// Handle calls yield for every product matching the query in ID order, as GetListProducts returns them.
+	// Products are read from a cursor and enriched in batches, so the whole catalog can be exported
+	// without paging or holding it in memory. It stops at the first error of yield and returns it.
+	Handle(ctx context.Context, query StreamProductsQuery, yield func(*Product) error) error
+}
+
+type streamProductsHandler struct {
+	list *getListProductsHandler
+}
+
+func NewStreamProductsHandler(repo Repository, reservedStock ReservedStock, enricher AttributeEnricher) StreamProductsQueryHandler {
+	return &streamProductsHandler{list: &getListProductsHandler{repo: repo, reservedStock: reservedStock, enricher: enricher}}
+}
+
+func (h *streamProductsHandler) Handle(ctx context.Context, query StreamProductsQuery, yield func(*Product) error) error {
+	batch := make([]*Product, 0, streamBatchSize)
+	flush := func() error {
+		if err := h.list.applyReservedStock(ctx, batch); err != nil {
+			return err
+		}
+		if err := h.list.enricher.Enrich(ctx, batch); err != nil {
+			return err
+		}
+		for _, p := range batch {
+			if err := yield(p); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := h.list.repo.Stream(ctx, ListQuery{
+		Enabled:       query.Enabled,
+		CategoryID:    query.CategoryID,
+		SupplierID:    query.SupplierID,
+		HasImage:      query.HasImage,
+		HasCategory:   query.HasCategory,
+		ModifiedAfter: query.ModifiedAfter,
+	}, func(p *Product) error {
+		batch = append(batch, p)
+		if len(batch) < streamBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream products: %w", err)
+	}
+	return flush()
+}
@@ -0,0 +1,66 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const maxSupplierSKULength = 64
+
+// SupplierRef links a product to the supplier it is purchased from
+type SupplierRef struct {
+	SupplierID string
+	// SKU is the code the supplier uses for the product
+	SKU *string
+}
+
+// Suppliers resolves the suppliers products are linked to
+type Suppliers interface {
+	Exists(ctx context.Context, supplierID string) (bool, error)
+}
+
+// ChangeSupplier links the product to a supplier; nil removes the link
+func (p *Product) ChangeSupplier(ref *SupplierRef) error {
+	if err := validateSupplierRef(ref); err != nil {
+		return err
+	}
+
+	p.Supplier = ref
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	return nil
+}
+
+func validateSupplierRef(ref *SupplierRef) error {
+	if ref == nil {
+		return nil
+	}
+
+	if ref.SupplierID == "" {
+		return fmt.Errorf("%w: supplier SKU needs a supplier", ErrInvalidProductData)
+	}
+	if ref.SKU != nil && *ref.SKU == "" {
+		return fmt.Errorf("%w: supplier SKU can't be empty", ErrInvalidProductData)
+	}
+	if ref.SKU != nil && len(*ref.SKU) > maxSupplierSKULength {
+		return fmt.Errorf("%w: supplier SKU is too long (max %d characters)", ErrInvalidProductData, maxSupplierSKULength)
+	}
+	return nil
+}
+
+// checkSupplier verifies that the supplier the product is linked to exists
+func checkSupplier(ctx context.Context, suppliers Suppliers, p *Product) error {
+	if p.Supplier == nil {
+		return nil
+	}
+
+	exists, err := suppliers.Exists(ctx, p.Supplier.SupplierID)
+	if err != nil {
+		return fmt.Errorf("failed to check supplier: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrSupplierNotFound, p.Supplier.SupplierID)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ChangeSupplier(t *testing.T) {
+	tests := []struct {
+		name        string
+		ref         *SupplierRef
+		errContains string
+	}{
+		{name: "supplier with SKU", ref: &SupplierRef{SupplierID: "supplier-1", SKU: ptr("AN-1")}},
+		{name: "supplier without SKU", ref: &SupplierRef{SupplierID: "supplier-1"}},
+		{name: "no supplier", ref: nil},
+		{name: "SKU without supplier", ref: &SupplierRef{SKU: ptr("AN-1")}, errContains: "needs a supplier"},
+		{name: "empty SKU", ref: &SupplierRef{SupplierID: "supplier-1", SKU: ptr("")}, errContains: "can't be empty"},
+		{name: "long SKU", ref: &SupplierRef{SupplierID: "supplier-1", SKU: ptr(strings.Repeat("s", 65))}, errContains: "too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := createTestProduct()
+
+			err := p.ChangeSupplier(tt.ref)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidProductData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, p.Supplier)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.ref, p.Supplier)
+		})
+	}
+}
+
+func TestCheckSupplier(t *testing.T) {
+	suppliers := NewMockSuppliers(t)
+	suppliers.EXPECT().Exists(testCtx(), "supplier-1").Return(true, nil).Once()
+	suppliers.EXPECT().Exists(testCtx(), "missing").Return(false, nil).Once()
+
+	p := createTestProduct()
+	require.NoError(t, checkSupplier(testCtx(), suppliers, p), "unlinked products are not checked")
+
+	p.Supplier = &SupplierRef{SupplierID: "supplier-1"}
+	require.NoError(t, checkSupplier(testCtx(), suppliers, p))
+
+	p.Supplier = &SupplierRef{SupplierID: "missing"}
+	require.ErrorIs(t, checkSupplier(testCtx(), suppliers, p), ErrSupplierNotFound)
+}
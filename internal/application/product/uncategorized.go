@@ -0,0 +1,58 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// UncategorizedCount counts the products of a supplier that have no category
+type UncategorizedCount struct {
+	// SupplierID is empty for the products without a supplier
+	SupplierID string
+	Products   int64
+	Enabled    int64
+}
+
+// UncategorizedReport summarizes the products without a category, which category pages, navigation and
+// facets leave out; imports often create them
+type UncategorizedReport struct {
+	Products int64
+	// Enabled products are sold without showing in any category
+	Enabled int64
+	// Suppliers counts them per supplier, the most first, so cleanup can start with the feed creating them
+	Suppliers []UncategorizedCount
+}
+
+type GetUncategorizedReportQuery struct{}
+
+type GetUncategorizedReportQueryHandler interface {
+	// Handle counts the products without a category; list them with GetListProducts and HasCategory false
+	Handle(ctx context.Context, query GetUncategorizedReportQuery) (*UncategorizedReport, error)
+}
+
+type getUncategorizedReportHandler struct {
+	repo Repository
+}
+
+func NewGetUncategorizedReportHandler(repo Repository) GetUncategorizedReportQueryHandler {
+	return &getUncategorizedReportHandler{repo: repo}
+}
+
+func (h *getUncategorizedReportHandler) Handle(ctx context.Context, _ GetUncategorizedReportQuery) (*UncategorizedReport, error) {
+	counts, err := h.repo.CountUncategorized(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count uncategorized products: %w", err)
+	}
+
+	slices.SortFunc(counts, func(a, b UncategorizedCount) int {
+		return cmp.Or(cmp.Compare(b.Products, a.Products), cmp.Compare(a.SupplierID, b.SupplierID))
+	})
+	report := &UncategorizedReport{Suppliers: counts}
+	for _, c := range counts {
+		report.Products += c.Products
+		report.Enabled += c.Enabled
+	}
+	return report, nil
+}
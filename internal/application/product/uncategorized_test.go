@@ -0,0 +1,34 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUncategorizedReportHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	handler := NewGetUncategorizedReportHandler(repo)
+
+	repo.EXPECT().CountUncategorized(mock.Anything).Return([]UncategorizedCount{
+		{SupplierID: "", Products: 2, Enabled: 2},
+		{SupplierID: "globex", Products: 5, Enabled: 0},
+		{SupplierID: "acme", Products: 5, Enabled: 3},
+	}, nil)
+
+	report, err := handler.Handle(context.Background(), GetUncategorizedReportQuery{})
+
+	require.NoError(t, err)
+	assert.Equal(t, &UncategorizedReport{
+		Products: 12,
+		Enabled:  5,
+		Suppliers: []UncategorizedCount{
+			{SupplierID: "acme", Products: 5, Enabled: 3},
+			{SupplierID: "globex", Products: 5, Enabled: 0},
+			{SupplierID: "", Products: 2, Enabled: 2},
+		},
+	}, report)
+}
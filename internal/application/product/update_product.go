@@ -9,6 +9,8 @@ import (
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -19,6 +21,7 @@ type UpdateProductCommand struct {
 	ID          string
 	Version     int
 	Name        string
+	Slug        string // keeps the current slug when empty, unless the product is renamed
 	Description *string
 	Price       float64
 	Quantity    int
@@ -26,6 +29,12 @@ type UpdateProductCommand struct {
 	CategoryID  *string
 	Enabled     bool
 	Attributes  []AttributeValue
+	// Metadata replaces the integration data of the product, see Product.Metadata
+	Metadata map[string]string
+	// Supplier links the product to a supplier; nil leaves it unlinked
+	Supplier *SupplierRef
+	// ExternalID changes the key of the product in the feed it is imported from; nil keeps the current one
+	ExternalID *string
 }
 
 type UpdateProductCommandHandler interface {
@@ -36,26 +45,38 @@ type updateProductHandler struct {
 	repo         Repository
 	attrRepo     attribute.Repository
 	categoryRepo category.Repository
+	suppliers    Suppliers
 	outbox       outbox.Outbox
 	txManager    mongo.TxManager
 	eventFactory ProductEventFactory
+	flags        feature.Flags
+	hooks        ValidationHooks
+	limits       limits.Product
 }
 
 func NewUpdateProductHandler(
 	repo Repository,
 	attrRepo attribute.Repository,
 	categoryRepo category.Repository,
+	suppliers Suppliers,
 	outbox outbox.Outbox,
 	txManager mongo.TxManager,
 	eventFactory ProductEventFactory,
+	flags feature.Flags,
+	hooks ValidationHooks,
+	l limits.Limits,
 ) UpdateProductCommandHandler {
 	return &updateProductHandler{
 		repo:         repo,
 		attrRepo:     attrRepo,
 		categoryRepo: categoryRepo,
+		suppliers:    suppliers,
 		outbox:       outbox,
 		txManager:    txManager,
 		eventFactory: eventFactory,
+		flags:        flags,
+		hooks:        hooks,
+		limits:       l.Product,
 	}
 }
 
@@ -65,20 +86,69 @@ func (h *updateProductHandler) Handle(ctx context.Context, cmd UpdateProductComm
 		return nil, err
 	}
 
-	if err = h.validateCategory(ctx, cmd.CategoryID); err != nil {
+	oldPrice := p.Price
+	if err = h.apply(ctx, p, cmd); err != nil {
 		return nil, err
 	}
 
-	attrs, err := h.buildAttributes(ctx, cmd.Attributes)
+	return h.persistAndPublish(ctx, p, oldPrice)
+}
+
+// apply changes the loaded product as the command asks, checked against the category, attributes and
+// supplier it refers to, and claims its slug
+func (h *updateProductHandler) apply(ctx context.Context, p *Product, cmd UpdateProductCommand) error {
+	var c *category.Category
+	if cmd.CategoryID != nil {
+		var err error
+		if c, err = h.findCategory(ctx, *cmd.CategoryID); err != nil {
+			return err
+		}
+	}
+
+	values, attrs, err := h.buildAttributes(ctx, cmd.Attributes)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if err = p.Update(h.limits, cmd.Name, cmd.Slug, cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, values); err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+	p.flagDeprecatedOptions(attrs)
+
+	if err = p.ChangeMetadata(h.limits, cmd.Metadata); err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	if err = p.ChangeSupplier(cmd.Supplier); err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	if cmd.ExternalID != nil {
+		if err = p.ChangeExternalID(cmd.ExternalID); err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+	}
+
+	if err = checkSupplier(ctx, h.suppliers, p); err != nil {
+		return err
+	}
+
+	if err = checkRequiredAttributes(p, c); err != nil {
+		return err
 	}
+	p.scoreQuality(c)
 
-	if err = p.Update(cmd.Name, cmd.Description, cmd.Price, cmd.Quantity, cmd.ImageID, cmd.CategoryID, cmd.Enabled, attrs); err != nil {
-		return nil, fmt.Errorf("failed to update product: %w", err)
+	if h.flags.Enabled(feature.StrictAttributeValidation) {
+		if err = checkCategoryAttributes(p, c); err != nil {
+			return err
+		}
+	}
+
+	if err = h.hooks.check(ctx, p, c); err != nil {
+		return err
 	}
 
-	return h.persistAndPublish(ctx, p)
+	return claimSlug(ctx, h.repo, p, cmd.Slug == "")
 }
 
 func (h *updateProductHandler) findAndValidateProduct(ctx context.Context, id string, version int) (*Product, error) {
@@ -97,24 +167,28 @@ func (h *updateProductHandler) findAndValidateProduct(ctx context.Context, id st
 	return p, nil
 }
 
-func (h *updateProductHandler) validateCategory(ctx context.Context, categoryID *string) error {
-	if categoryID == nil {
-		return nil
-	}
-
-	exists, err := h.categoryRepo.Exists(ctx, *categoryID)
+// findCategory loads the category the product is assigned to
+func (h *updateProductHandler) findCategory(ctx context.Context, categoryID string) (*category.Category, error) {
+	c, err := h.categoryRepo.FindByID(ctx, categoryID)
 	if err != nil {
-		return fmt.Errorf("failed to check category: %w", err)
-	}
-	if !exists {
-		return ErrCategoryNotFound
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
 	}
-	return nil
+	return c, nil
 }
 
-func (h *updateProductHandler) buildAttributes(ctx context.Context, productAttrs []AttributeValue) ([]AttributeValue, error) {
+// buildAttributes checks the values against their attributes, converts and orders them, and returns
+// the attributes loaded for them
+func (h *updateProductHandler) buildAttributes(ctx context.Context, productAttrs []AttributeValue) ([]AttributeValue, []*attribute.Attribute, error) {
 	if len(productAttrs) == 0 {
-		return productAttrs, nil
+		return productAttrs, nil, nil
+	}
+
+	// Checked before the lookup, which would report a repeated attribute as missing
+	if err := validateAttributeValues(h.limits, productAttrs); err != nil {
+		return nil, nil, err
 	}
 
 	attrIDs := lo.Map(productAttrs, func(attr AttributeValue, _ int) string {
@@ -123,28 +197,25 @@ func (h *updateProductHandler) buildAttributes(ctx context.Context, productAttrs
 
 	attrs, err := h.attrRepo.FindByIDsOrFail(ctx, attrIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
-		return a.ID
-	})
+	productAttrs, err = ConvertAttributeUnits(productAttrs, attrs)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return lo.Map(productAttrs, func(attr AttributeValue, _ int) AttributeValue {
-		if a, ok := attrMap[attr.AttributeID]; ok {
-			attr.AttributeSlug = a.Slug
-		}
-		return attr
-	}), nil
+	return OrderAttributeValues(productAttrs, attrs), attrs, nil
 }
 
 func (h *updateProductHandler) persistAndPublish(
 	ctx context.Context,
 	p *Product,
+	oldPrice float64,
 ) (*Product, error) {
 	type updateResult struct {
 		Product *Product
-		Send    outbox.SendFunc
+		Sends   []outbox.SendFunc
 	}
 
 	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*updateResult, error) {
@@ -162,10 +233,19 @@ func (h *updateProductHandler) persistAndPublish(
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox: %w", err)
 		}
+		sends := []outbox.SendFunc{send}
+
+		if updated.Price != oldPrice && h.flags.Enabled(feature.PriceChangedEvents) {
+			send, err = h.outbox.Create(txCtx, h.eventFactory.NewProductPriceChangedOutboxMessage(txCtx, updated, oldPrice))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox: %w", err)
+			}
+			sends = append(sends, send)
+		}
 
 		return &updateResult{
 			Product: updated,
-			Send:    send,
+			Sends:   sends,
 		}, nil
 	})
 	if err != nil {
@@ -174,7 +254,9 @@ func (h *updateProductHandler) persistAndPublish(
 
 	h.log(ctx).Debug("product updated", zap.String("id", res.Product.ID))
 
-	_ = res.Send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	for _, send := range res.Sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
 
 	return res.Product, nil
 }
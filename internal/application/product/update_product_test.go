@@ -12,6 +12,8 @@ import (
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
 	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
@@ -40,7 +42,7 @@ func setupUpdateProductHandler(t *testing.T) (
 	txManager := mocks.NewMockTxManager(t)
 	eventFactory := NewMockProductEventFactory(t)
 
-	handler := NewUpdateProductHandler(repo, attrRepo, categoryRepo, outboxMock, txManager, eventFactory)
+	handler := NewUpdateProductHandler(repo, attrRepo, categoryRepo, NewMockSuppliers(t), outboxMock, txManager, eventFactory, feature.Static(), nil, limits.Default())
 
 	return repo, attrRepo, categoryRepo, outboxMock, txManager, eventFactory, handler
 }
@@ -72,8 +74,8 @@ func TestUpdateProductHandler_Handle_Success(t *testing.T) {
 
 	// Mock category validation
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	// Mock transaction
 	txManager.EXPECT().
@@ -83,6 +85,7 @@ func TestUpdateProductHandler_Handle_Success(t *testing.T) {
 		})
 
 	// Mock update - return a copy of the updated product
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().
 		Update(mock.Anything, mock.AnythingOfType("*product.Product")).
 		RunAndReturn(func(_ context.Context, p *Product) (*Product, error) {
@@ -180,8 +183,8 @@ func TestUpdateProductHandler_Handle_CategoryNotFound(t *testing.T) {
 		Return(existingProduct, nil)
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(false, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(nil, mongo.ErrEntityNotFound)
 
 	result, err := handler.Handle(ctx, cmd)
 
@@ -213,8 +216,8 @@ func TestUpdateProductHandler_Handle_InvalidUpdateData(t *testing.T) {
 		Return(existingProduct, nil)
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	result, err := handler.Handle(ctx, cmd)
 
@@ -246,8 +249,8 @@ func TestUpdateProductHandler_Handle_UpdateRepositoryError(t *testing.T) {
 		Return(existingProduct, nil)
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
@@ -255,6 +258,7 @@ func TestUpdateProductHandler_Handle_UpdateRepositoryError(t *testing.T) {
 			return fn(ctx)
 		})
 
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().
 		Update(mock.Anything, mock.Anything).
 		Return(nil, errors.New("database error"))
@@ -289,8 +293,8 @@ func TestUpdateProductHandler_Handle_OptimisticLockingOnUpdate(t *testing.T) {
 		Return(existingProduct, nil)
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	txManager.EXPECT().
 		WithTransaction(mock.Anything, mock.Anything).
@@ -298,6 +302,7 @@ func TestUpdateProductHandler_Handle_OptimisticLockingOnUpdate(t *testing.T) {
 			return fn(ctx)
 		})
 
+	repo.EXPECT().FindBySlug(mock.Anything, mock.Anything).Return(nil, mongo.ErrEntityNotFound)
 	repo.EXPECT().
 		Update(mock.Anything, mock.Anything).
 		Return(nil, mongo.ErrOptimisticLocking)
@@ -335,8 +340,8 @@ func TestUpdateProductHandler_Handle_AttributeValidationFailure(t *testing.T) {
 		Return(existingProduct, nil)
 
 	categoryRepo.EXPECT().
-		Exists(mock.Anything, categoryID).
-		Return(true, nil)
+		FindByID(mock.Anything, categoryID).
+		Return(testCategory(categoryID), nil)
 
 	attrRepo.EXPECT().
 		FindByIDsOrFail(mock.Anything, []string{"non-existent-attr"}).
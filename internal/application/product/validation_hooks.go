@@ -0,0 +1,77 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// ValidationHook is a business rule a deployment adds to the product checks, such as a price ending a retail
+// tenant requires or an attribute an alcohol category can't do without. Hooks are provided in fx to the
+// "product_validation_hook" group and run by the create, update and import handlers after the built-in checks.
+type ValidationHook interface {
+	// Validate returns the violations of the rule by the product about to be stored, nil when it passes.
+	// c is the category of the product, nil when it has none. An error fails the write.
+	Validate(ctx context.Context, p *Product, c *category.Category) ([]RuleViolation, error)
+}
+
+// ValidationHookFunc adapts a function to a ValidationHook
+type ValidationHookFunc func(ctx context.Context, p *Product, c *category.Category) ([]RuleViolation, error)
+
+func (f ValidationHookFunc) Validate(ctx context.Context, p *Product, c *category.Category) ([]RuleViolation, error) {
+	return f(ctx, p, c)
+}
+
+// ValidationHooks are the hooks registered by the deployment. fx doesn't keep the order of a group,
+// so hooks shouldn't depend on each other.
+type ValidationHooks []ValidationHook
+
+// NewValidationHooks collects the hooks of the "product_validation_hook" group
+func NewValidationHooks(hooks []ValidationHook) ValidationHooks {
+	return hooks
+}
+
+// RuleViolation is a product breaking a rule of a validation hook
+type RuleViolation struct {
+	// Rule names the rule, for clients and logs
+	Rule string
+	// Field is the product field at fault, such as "price" or "attributes.age-restriction"
+	Field   string
+	Message string
+}
+
+// RuleViolationsError lists the violations of every hook by a product. It matches ErrInvalidProductData
+// with errors.Is.
+type RuleViolationsError struct {
+	Violations []RuleViolation
+}
+
+func (e *RuleViolationsError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%s: %s", v.Rule, v.Message)
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidProductData, strings.Join(messages, "; "))
+}
+
+func (e *RuleViolationsError) Unwrap() error {
+	return ErrInvalidProductData
+}
+
+// check runs every hook, so the violations of all of them are reported at once
+func (hooks ValidationHooks) check(ctx context.Context, p *Product, c *category.Category) error {
+	var violations []RuleViolation
+	for _, hook := range hooks {
+		v, err := hook.Validate(ctx, p, c)
+		if err != nil {
+			return fmt.Errorf("failed to run validation hook: %w", err)
+		}
+		violations = append(violations, v...)
+	}
+	if len(violations) > 0 {
+		return &RuleViolationsError{Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+func TestValidationHooks_Check(t *testing.T) {
+	violation := func(rule string) ValidationHook {
+		return ValidationHookFunc(func(context.Context, *Product, *category.Category) ([]RuleViolation, error) {
+			return []RuleViolation{{Rule: rule, Field: "price", Message: rule + " broken"}}, nil
+		})
+	}
+	pass := ValidationHookFunc(func(context.Context, *Product, *category.Category) ([]RuleViolation, error) {
+		return nil, nil
+	})
+
+	t.Run("no hooks", func(t *testing.T) {
+		require.NoError(t, ValidationHooks(nil).check(context.Background(), &Product{}, nil))
+	})
+
+	t.Run("passing hooks", func(t *testing.T) {
+		require.NoError(t, ValidationHooks{pass, pass}.check(context.Background(), &Product{}, nil))
+	})
+
+	t.Run("violations of every hook", func(t *testing.T) {
+		err := ValidationHooks{violation("a"), pass, violation("b")}.check(context.Background(), &Product{}, nil)
+
+		var violations *RuleViolationsError
+		require.ErrorAs(t, err, &violations)
+		require.ErrorIs(t, err, ErrInvalidProductData)
+		assert.Equal(t, []RuleViolation{
+			{Rule: "a", Field: "price", Message: "a broken"},
+			{Rule: "b", Field: "price", Message: "b broken"},
+		}, violations.Violations)
+		assert.Equal(t, "invalid product data: a: a broken; b: b broken", err.Error())
+	})
+
+	t.Run("hook error", func(t *testing.T) {
+		failing := ValidationHookFunc(func(context.Context, *Product, *category.Category) ([]RuleViolation, error) {
+			return nil, errors.New("tenant settings unavailable")
+		})
+
+		err := ValidationHooks{violation("a"), failing}.check(context.Background(), &Product{}, nil)
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrInvalidProductData)
+	})
+}
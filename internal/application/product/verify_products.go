@@ -0,0 +1,95 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// verifyBatchSize is the number of products loaded per query while verifying
+	verifyBatchSize = 500
+	// maxVerifyItems bounds a single verification request
+	maxVerifyItems = 10000
+)
+
+// ExpectedProduct is the state a client expects for a product; unset fields are not compared
+type ExpectedProduct struct {
+	ID          string
+	Version     *int
+	ContentHash *string
+}
+
+type VerifyProductsQuery struct {
+	Items []ExpectedProduct
+}
+
+type MismatchReason string
+
+const (
+	MismatchReasonMissing     MismatchReason = "missing"
+	MismatchReasonVersion     MismatchReason = "version"
+	MismatchReasonContentHash MismatchReason = "content_hash"
+)
+
+// ProductMismatch describes a product whose stored state differs from the expected one
+type ProductMismatch struct {
+	ID            string
+	Reason        MismatchReason
+	ActualVersion int
+	ActualHash    string
+}
+
+type VerifyProductsQueryHandler interface {
+	// Handle compares the expected products with the stored ones and returns the mismatches in request order
+	Handle(ctx context.Context, query VerifyProductsQuery) ([]ProductMismatch, error)
+}
+
+type verifyProductsHandler struct {
+	repo Repository
+}
+
+func NewVerifyProductsHandler(repo Repository) VerifyProductsQueryHandler {
+	return &verifyProductsHandler{repo: repo}
+}
+
+func (h *verifyProductsHandler) Handle(ctx context.Context, query VerifyProductsQuery) ([]ProductMismatch, error) {
+	if len(query.Items) > maxVerifyItems {
+		return nil, fmt.Errorf("%w: at most %d products can be verified at once", ErrInvalidProductData, maxVerifyItems)
+	}
+
+	mismatches := []ProductMismatch{}
+	for _, batch := range lo.Chunk(query.Items, verifyBatchSize) {
+		ids := lo.Uniq(lo.Map(batch, func(e ExpectedProduct, _ int) string { return e.ID }))
+		found, err := h.repo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get products: %w", err)
+		}
+		stored := lo.KeyBy(found, func(p *Product) string { return p.ID })
+
+		for _, expected := range batch {
+			if mismatch, ok := compareProduct(expected, stored[expected.ID]); ok {
+				mismatches = append(mismatches, mismatch)
+			}
+		}
+	}
+	return mismatches, nil
+}
+
+func compareProduct(expected ExpectedProduct, p *Product) (ProductMismatch, bool) {
+	if p == nil {
+		return ProductMismatch{ID: expected.ID, Reason: MismatchReasonMissing}, true
+	}
+
+	mismatch := ProductMismatch{ID: p.ID, ActualVersion: p.Version, ActualHash: p.ContentHash()}
+	switch {
+	case expected.Version != nil && *expected.Version != p.Version:
+		mismatch.Reason = MismatchReasonVersion
+	case expected.ContentHash != nil && *expected.ContentHash != mismatch.ActualHash:
+		mismatch.Reason = MismatchReasonContentHash
+	default:
+		return ProductMismatch{}, false
+	}
+	return mismatch, true
+}
@@ -0,0 +1,111 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type WarmCacheCommand struct {
+	// Tenant scopes the cached metadata, as for the product queries
+	Tenant string
+	// Products is the number of most viewed enabled products whose page metadata is loaded
+	Products int
+	// Categories is the number of categories of those products, by their summed views, whose facets are loaded
+	Categories int
+}
+
+type WarmCacheResult struct {
+	Products   int
+	Categories int
+}
+
+type WarmCacheCommandHandler interface {
+	// Handle loads into the caches of this replica what the pages of the most viewed products read: their
+	// category paths and attribute definitions, and the facets of their top categories. Products nobody
+	// viewed recently are left out, so a tenant without views warms nothing.
+	Handle(ctx context.Context, cmd WarmCacheCommand) (*WarmCacheResult, error)
+}
+
+type warmCacheHandler struct {
+	repo     Repository
+	embedder *embedder
+	facets   GetCategoryFacetsQueryHandler
+}
+
+func NewWarmCacheHandler(
+	repo Repository,
+	categoryPaths CategoryPathResolver,
+	attributeDefs AttributeDefinitionResolver,
+	facets GetCategoryFacetsQueryHandler,
+) WarmCacheCommandHandler {
+	return &warmCacheHandler{
+		repo:     repo,
+		embedder: &embedder{categoryPaths: categoryPaths, attributeDefs: attributeDefs},
+		facets:   facets,
+	}
+}
+
+func (h *warmCacheHandler) Handle(ctx context.Context, cmd WarmCacheCommand) (*WarmCacheResult, error) {
+	if cmd.Products <= 0 || cmd.Categories < 0 {
+		return nil, fmt.Errorf("%w: products must be positive and categories not negative", ErrInvalidProductData)
+	}
+
+	enabled := true
+	page, err := h.repo.FindList(ctx, ListQuery{Page: 1, Size: cmd.Products, Enabled: &enabled, Sort: "views", Order: "desc"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most viewed products: %w", err)
+	}
+
+	result := &WarmCacheResult{}
+	categoryViews := make(map[string]float64)
+	for _, p := range page.Items {
+		if p.Views <= 0 {
+			break
+		}
+		if err := h.embedder.embed(ctx, cmd.Tenant, []Embed{EmbedCategoryPath, EmbedAttributeDefinitions}, p); err != nil {
+			return nil, err
+		}
+		if p.CategoryID != nil {
+			categoryViews[*p.CategoryID] += p.Views
+		}
+		result.Products++
+	}
+
+	for _, categoryID := range topCategories(categoryViews, cmd.Categories) {
+		_, err := h.facets.Handle(ctx, GetCategoryFacetsQuery{CategoryID: categoryID, Tenant: cmd.Tenant})
+		switch {
+		case err == nil:
+			result.Categories++
+		case !errors.Is(err, mongo.ErrEntityNotFound):
+			return nil, err
+		}
+	}
+
+	h.log(ctx).Debug("cache warmed", zap.String("tenant", cmd.Tenant),
+		zap.Int("products", result.Products), zap.Int("categories", result.Categories))
+	return result, nil
+}
+
+// topCategories returns at most n categories by views, the most viewed first
+func topCategories(views map[string]float64, n int) []string {
+	ids := make([]string, 0, len(views))
+	for id := range views {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		return cmp.Or(cmp.Compare(views[b], views[a]), cmp.Compare(a, b))
+	})
+	return ids[:min(n, len(ids))]
+}
+
+func (h *warmCacheHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "warm-cache-handler"))
+}
@@ -0,0 +1,61 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type facetsFunc func(ctx context.Context, query GetCategoryFacetsQuery) ([]AttributeFacet, error)
+
+func (f facetsFunc) Handle(ctx context.Context, query GetCategoryFacetsQuery) ([]AttributeFacet, error) {
+	return f(ctx, query)
+}
+
+func TestWarmCacheHandler_Handle(t *testing.T) {
+	repo := NewMockRepository(t)
+	paths := NewMockCategoryPathResolver(t)
+	defs := NewMockAttributeDefinitionResolver(t)
+	var warmed []string
+	facets := facetsFunc(func(_ context.Context, query GetCategoryFacetsQuery) ([]AttributeFacet, error) {
+		assert.Equal(t, "acme", query.Tenant)
+		warmed = append(warmed, query.CategoryID)
+		if query.CategoryID == "deleted" {
+			return nil, mongo.ErrEntityNotFound
+		}
+		return nil, nil
+	})
+	handler := NewWarmCacheHandler(repo, paths, defs, facets)
+
+	enabled := true
+	repo.EXPECT().FindList(mock.Anything, ListQuery{Page: 1, Size: 5, Enabled: &enabled, Sort: "views", Order: "desc"}).
+		Return(&mongo.PageResult[Product]{Items: []*Product{
+			{ID: "p1", Views: 10, CategoryID: ptr("phones"), Attributes: []AttributeValue{{AttributeID: "color"}}},
+			{ID: "p2", Views: 8, CategoryID: ptr("deleted")},
+			{ID: "p3", Views: 7, CategoryID: ptr("cases")},
+			{ID: "p4", Views: 4, CategoryID: ptr("cases")},
+			{ID: "p5", Views: 0, CategoryID: ptr("cables")},
+		}}, nil)
+	paths.EXPECT().Path(mock.Anything, "acme", mock.Anything).Return(nil, nil).Times(4)
+	defs.EXPECT().Definitions(mock.Anything, "acme", []string{"color"}).Return([]*attribute.Attribute{}, nil)
+
+	res, err := handler.Handle(testCtx(), WarmCacheCommand{Tenant: "acme", Products: 5, Categories: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, &WarmCacheResult{Products: 4, Categories: 2}, res, "products without views and deleted categories are left out")
+	assert.Equal(t, []string{"cases", "phones", "deleted"}, warmed, "categories by summed views")
+}
+
+func TestWarmCacheHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := NewWarmCacheHandler(NewMockRepository(t), NewMockCategoryPathResolver(t), NewMockAttributeDefinitionResolver(t), nil)
+
+	_, err := handler.Handle(context.Background(), WarmCacheCommand{Tenant: "acme"})
+
+	require.ErrorIs(t, err, ErrInvalidProductData)
+}
@@ -0,0 +1,54 @@
+package product
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// minDescriptionLength is the length of description text, in characters, below which a description is flagged as short
+const minDescriptionLength = 50
+
+// WarningCode names a quality issue of a product
+type WarningCode string
+
+const (
+	WarningDescriptionMissing WarningCode = "description-missing"
+	// WarningDescriptionShort flags a description with less than minDescriptionLength characters of text
+	WarningDescriptionShort WarningCode = "description-short"
+	WarningImageMissing     WarningCode = "image-missing"
+	WarningCategoryMissing  WarningCode = "category-missing"
+	WarningPriceZero        WarningCode = "price-zero"
+	// WarningOptionDeprecated flags a value picked from a deprecated attribute option
+	WarningOptionDeprecated WarningCode = "option-deprecated"
+)
+
+// Warning is a quality issue of a product. Unlike validation errors, warnings don't stop a write: they are
+// returned with the stored product, so an import can accept imperfect data and still flag it for review.
+type Warning struct {
+	Code WarningCode
+	// Field is the product field the warning is about
+	Field   string
+	Message string
+}
+
+// Warnings lists the quality issues of the product, nil without issues
+func (p *Product) Warnings() []Warning {
+	var warnings []Warning
+	switch {
+	case p.Description == nil:
+		warnings = append(warnings, Warning{Code: WarningDescriptionMissing, Field: "description", Message: "description is missing"})
+	case p.Excerpt == nil || utf8.RuneCountInString(*p.Excerpt) < minDescriptionLength:
+		warnings = append(warnings, Warning{Code: WarningDescriptionShort, Field: "description",
+			Message: fmt.Sprintf("description has less than %d characters of text", minDescriptionLength)})
+	}
+	if p.ImageID == nil {
+		warnings = append(warnings, Warning{Code: WarningImageMissing, Field: "imageId", Message: "image is missing"})
+	}
+	if p.CategoryID == nil {
+		warnings = append(warnings, Warning{Code: WarningCategoryMissing, Field: "categoryId", Message: "category is missing"})
+	}
+	if p.Price == 0 {
+		warnings = append(warnings, Warning{Code: WarningPriceZero, Field: "price", Message: "price is zero"})
+	}
+	return append(warnings, p.optionWarnings...)
+}
@@ -0,0 +1,44 @@
+package product
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct_Warnings(t *testing.T) {
+	longText := "<p>" + strings.Repeat("Soft cotton shirt. ", 4) + "</p>"
+	shortText := "<p>Soft shirt</p>"
+	imageOnly := `<p><img src="https://cdn.example.com/a.png"></p>`
+	image, category := "image-1", "shirts"
+
+	tests := []struct {
+		name        string
+		description *string
+		imageID     *string
+		categoryID  *string
+		price       float64
+		want        []WarningCode
+	}{
+		{name: "complete", description: &longText, imageID: &image, categoryID: &category, price: 10},
+		{name: "short description", description: &shortText, imageID: &image, categoryID: &category, price: 10,
+			want: []WarningCode{WarningDescriptionShort}},
+		{name: "description without text", description: &imageOnly, imageID: &image, categoryID: &category, price: 10,
+			want: []WarningCode{WarningDescriptionShort}},
+		{name: "bare", price: 0,
+			want: []WarningCode{WarningDescriptionMissing, WarningImageMissing, WarningCategoryMissing, WarningPriceZero}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewProduct(defaultLimits, "Shirt", "", ProductTypePhysical, tt.description, tt.price, 1, tt.imageID, tt.categoryID, false, nil)
+			assert.NoError(t, err)
+
+			var codes []WarningCode
+			for _, w := range p.Warnings() {
+				codes = append(codes, w.Code)
+			}
+			assert.Equal(t, tt.want, codes)
+		})
+	}
+}
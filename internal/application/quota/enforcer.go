@@ -0,0 +1,53 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+)
+
+// Enforcer checks creates against the limits of the tenant
+type Enforcer interface {
+	// Check returns an ExceededError if adding items of the resource would take the tenant past its limit.
+	// Call it in the transaction that stores the items.
+	Check(ctx context.Context, r Resource, adding int) error
+}
+
+type enforcer struct {
+	plans    Plans
+	counters Counters
+	guard    Guard
+}
+
+func NewEnforcer(plans Plans, counters Counters, guard Guard) Enforcer {
+	return &enforcer{plans: plans, counters: counters, guard: guard}
+}
+
+func (e *enforcer) Check(ctx context.Context, r Resource, adding int) error {
+	limit := e.plans.Limits(ctx).Of(r)
+	if limit == 0 || adding <= 0 {
+		return nil
+	}
+
+	if err := e.guard.Lock(ctx, r); err != nil {
+		return fmt.Errorf("failed to lock %s: %w", r, err)
+	}
+	used, err := e.counters[r](ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count %s: %w", r, err)
+	}
+	if used+int64(adding) > int64(limit) {
+		return &ExceededError{Resource: r, Limit: limit}
+	}
+	return nil
+}
+
+type unlimited struct{}
+
+// Unlimited returns an enforcer that allows every create, e.g. for tests
+func Unlimited() Enforcer {
+	return unlimited{}
+}
+
+func (unlimited) Check(context.Context, Resource, int) error {
+	return nil
+}
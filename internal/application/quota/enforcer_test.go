@@ -0,0 +1,71 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedCounters(products, categories, attributes int64) Counters {
+	count := func(n int64) Counter {
+		return func(context.Context) (int64, error) { return n, nil }
+	}
+	return Counters{Products: count(products), Categories: count(categories), Attributes: count(attributes)}
+}
+
+// recordingGuard records the resources locked
+type recordingGuard struct {
+	locked []Resource
+}
+
+func (g *recordingGuard) Lock(_ context.Context, r Resource) error {
+	g.locked = append(g.locked, r)
+	return nil
+}
+
+func TestEnforcer_Check(t *testing.T) {
+	guard := &recordingGuard{}
+	e := NewEnforcer(Static(Limits{MaxProducts: 10, MaxCategories: 2}), fixedCounters(8, 2, 500), guard)
+	ctx := context.Background()
+
+	require.NoError(t, e.Check(ctx, Products, 2), "up to the limit")
+
+	err := e.Check(ctx, Products, 3)
+	require.ErrorIs(t, err, ErrExceeded)
+	var exceeded *ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, Products, exceeded.Resource)
+	assert.Equal(t, 10, exceeded.Limit)
+
+	require.ErrorIs(t, e.Check(ctx, Categories, 1), ErrExceeded)
+	require.NoError(t, e.Check(ctx, Categories, 0), "nothing added")
+	require.NoError(t, e.Check(ctx, Attributes, 1), "zero means unlimited")
+
+	assert.Equal(t, []Resource{Products, Products, Categories}, guard.locked, "only limited checks that add items lock")
+}
+
+func TestEnforcer_CountFails(t *testing.T) {
+	errStore := errors.New("store down")
+	counters := fixedCounters(0, 0, 0)
+	counters[Products] = func(context.Context) (int64, error) { return 0, errStore }
+	e := NewEnforcer(Static(Limits{MaxProducts: 10}), counters, &recordingGuard{})
+
+	err := e.Check(context.Background(), Products, 1)
+	require.ErrorIs(t, err, errStore)
+	assert.NotErrorIs(t, err, ErrExceeded)
+}
+
+func TestGetUsage(t *testing.T) {
+	h := NewGetUsageHandler(Static(Limits{MaxProducts: 10}), fixedCounters(8, 2, 5))
+
+	usage, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Usage{
+		{Resource: Products, Used: 8, Limit: 10},
+		{Resource: Categories, Used: 2},
+		{Resource: Attributes, Used: 5},
+	}, usage)
+}
@@ -0,0 +1,40 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+)
+
+// Usage is the number of items of a resource the tenant stores and its limit, zero when unlimited
+type Usage struct {
+	Resource Resource
+	Used     int64
+	Limit    int
+}
+
+type GetUsageQueryHandler interface {
+	// Handle returns the usage of every resource by the tenant of the request
+	Handle(ctx context.Context) ([]Usage, error)
+}
+
+type getUsageHandler struct {
+	plans    Plans
+	counters Counters
+}
+
+func NewGetUsageHandler(plans Plans, counters Counters) GetUsageQueryHandler {
+	return &getUsageHandler{plans: plans, counters: counters}
+}
+
+func (h *getUsageHandler) Handle(ctx context.Context) ([]Usage, error) {
+	limits := h.plans.Limits(ctx)
+	usage := make([]Usage, len(resources))
+	for i, r := range resources {
+		used, err := h.counters[r](ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", r, err)
+		}
+		usage[i] = Usage{Resource: r, Used: used, Limit: limits.Of(r)}
+	}
+	return usage, nil
+}
@@ -0,0 +1,91 @@
+// Package quota caps the size of the catalog of each tenant, so tenants on a smaller plan can't grow
+// past what their plan pays for.
+//
+// The limits of a tenant come from its plan. Creates are checked in the transaction that stores the
+// items, after locking the resource for the tenant, so concurrent creates can't overshoot a limit together.
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Resource is a kind of catalog item counted against a limit
+type Resource string
+
+const (
+	Products   Resource = "products"
+	Categories Resource = "categories"
+	Attributes Resource = "attributes"
+)
+
+var resources = []Resource{Products, Categories, Attributes}
+
+// Limits is the maximum number of items of each resource a tenant can store; zero means unlimited
+type Limits struct {
+	MaxProducts   int
+	MaxCategories int
+	MaxAttributes int
+}
+
+// Of returns the limit of the resource
+func (l Limits) Of(r Resource) int {
+	switch r {
+	case Products:
+		return l.MaxProducts
+	case Categories:
+		return l.MaxCategories
+	case Attributes:
+		return l.MaxAttributes
+	default:
+		return 0
+	}
+}
+
+// Plans tells the limits of the tenant of the request
+type Plans interface {
+	Limits(ctx context.Context) Limits
+}
+
+type staticPlans Limits
+
+// Static returns plans giving every tenant the same limits, e.g. for tests
+func Static(limits Limits) Plans {
+	return staticPlans(limits)
+}
+
+func (p staticPlans) Limits(context.Context) Limits {
+	return Limits(p)
+}
+
+// Counter returns the number of items of a resource stored by the tenant of the request
+type Counter func(ctx context.Context) (int64, error)
+
+// Counters holds the counter of each resource
+type Counters map[Resource]Counter
+
+// Guard serializes the checks of a resource by a tenant
+type Guard interface {
+	// Lock writes a document of the resource for the tenant of the request in the transaction of ctx.
+	// Concurrent transactions locking the same resource conflict, and the one retried after the conflict
+	// counts the items the other stored.
+	Lock(ctx context.Context, r Resource) error
+}
+
+// ErrExceeded matches every ExceededError
+var ErrExceeded = errors.New("quota exceeded")
+
+// ExceededError is returned instead of storing items past the limit of the tenant
+type ExceededError struct {
+	Resource Resource
+	Limit    int
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s: the plan allows at most %d %s", ErrExceeded, e.Limit, e.Resource)
+}
+
+func (e *ExceededError) Is(target error) bool {
+	return target == ErrExceeded
+}
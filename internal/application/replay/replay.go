@@ -0,0 +1,97 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Target is an aggregate type whose events can be replayed
+type Target string
+
+const (
+	TargetAttributes Target = "attributes"
+	TargetCategories Target = "categories"
+	TargetProducts   Target = "products"
+)
+
+// allTargets lists the targets in replay order: attributes and categories go first
+// so that projections see them before the products referencing them
+var allTargets = []Target{TargetAttributes, TargetCategories, TargetProducts}
+
+const defaultRatePerSecond = 100
+
+var (
+	ErrReplayInProgress = errors.New("replay is already running")
+	ErrUnknownTarget    = errors.New("unknown replay target")
+	ErrInvalidRate      = errors.New("replay rate must be positive")
+	ErrReplaySuperseded = errors.New("replay was superseded by another run")
+)
+
+// StartReplayCommand republishes the current state of the selected aggregates.
+// An empty Targets list replays everything; RatePerSecond 0 uses the default rate.
+type StartReplayCommand struct {
+	Targets       []Target
+	RatePerSecond int
+}
+
+// Progress reports how many events of a target have been published
+type Progress struct {
+	Target    Target
+	Total     int64
+	Published int64
+}
+
+// Status describes the latest replay of a tenant.
+// A running replay refreshes UpdatedAt while it makes progress; one that stopped refreshing it
+// died with its replica and is reported as interrupted.
+type Status struct {
+	ID         string
+	Running    bool
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+	Progress   []Progress
+	Error      string
+}
+
+// Service rebuilds downstream read models (product views, search indexes) by publishing
+// an updated event for every stored aggregate. Events go through the outbox like regular
+// writes and carry the replay header, so consumers apply them even if they have seen
+// the stored version already (see events.IsStale).
+type Service interface {
+	// Start launches a replay in the background; it fails with ErrReplayInProgress
+	// while another replay of the same tenant is running
+	Start(ctx context.Context, cmd StartReplayCommand) (Status, error)
+
+	// Status returns the state of the latest replay, false if none was started
+	Status(ctx context.Context) (Status, bool, error)
+}
+
+func (cmd StartReplayCommand) targets() ([]Target, error) {
+	if len(cmd.Targets) == 0 {
+		return allTargets, nil
+	}
+
+	for _, t := range cmd.Targets {
+		if !slices.Contains(allTargets, t) {
+			return nil, ErrUnknownTarget
+		}
+	}
+	// Keep the dependency order regardless of the order requested
+	return slices.DeleteFunc(slices.Clone(allTargets), func(t Target) bool {
+		return !slices.Contains(cmd.Targets, t)
+	}), nil
+}
+
+func (cmd StartReplayCommand) rate() (int, error) {
+	switch {
+	case cmd.RatePerSecond == 0:
+		return defaultRatePerSecond, nil
+	case cmd.RatePerSecond < 0:
+		return 0, ErrInvalidRate
+	default:
+		return cmd.RatePerSecond, nil
+	}
+}
@@ -0,0 +1,30 @@
+package replay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartReplayCommand_Targets(t *testing.T) {
+	targets, err := StartReplayCommand{}.targets()
+	require.NoError(t, err)
+	assert.Equal(t, []Target{TargetAttributes, TargetCategories, TargetProducts}, targets)
+
+	targets, err = StartReplayCommand{Targets: []Target{TargetProducts, TargetAttributes}}.targets()
+	require.NoError(t, err)
+	assert.Equal(t, []Target{TargetAttributes, TargetProducts}, targets)
+
+	_, err = StartReplayCommand{Targets: []Target{"orders"}}.targets()
+	require.ErrorIs(t, err, ErrUnknownTarget)
+}
+
+func TestStartReplayCommand_Rate(t *testing.T) {
+	perSecond, err := StartReplayCommand{}.rate()
+	require.NoError(t, err)
+	assert.Equal(t, defaultRatePerSecond, perSecond)
+
+	_, err = StartReplayCommand{RatePerSecond: -1}.rate()
+	require.ErrorIs(t, err, ErrInvalidRate)
+}
@@ -0,0 +1,20 @@
+package replay
+
+import (
+	"context"
+	"time"
+)
+
+// Repository persists the latest replay of each tenant, so its status is visible on every replica
+// and a replay can't be started twice by different replicas
+type Repository interface {
+	// Begin stores job as the latest replay. It fails with ErrReplayInProgress when another replay
+	// is running and has updated its status after staleBefore.
+	Begin(ctx context.Context, job *Status, staleBefore time.Time) error
+
+	// Save stores the progress of job; it fails with ErrReplaySuperseded when another replay has begun since
+	Save(ctx context.Context, job *Status) error
+
+	// FindLatest returns the latest replay or mongo.ErrEntityNotFound if none was started
+	FindLatest(ctx context.Context) (*Status, error)
+}
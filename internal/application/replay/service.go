@@ -0,0 +1,319 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const pageSize = 100
+
+// pageOrder keeps pagination stable while the collections are being written to:
+// pages continue after the last ID seen, so deletes and inserts don't shift them
+const pageOrder = "_id"
+
+const (
+	// saveInterval bounds how often the progress of a running replay is persisted
+	saveInterval = 5 * time.Second
+	// staleAfter is how long a running replay may go without saving before it is considered dead
+	staleAfter = time.Minute
+)
+
+type replayService struct {
+	repo            Repository
+	productRepo     product.Repository
+	productAttrs    product.AttributeEnricher
+	categoryRepo    category.Repository
+	attributeRepo   attribute.Repository
+	productEvents   product.ProductEventFactory
+	categoryEvents  category.CategoryEventFactory
+	attributeEvents attribute.AttributeEventFactory
+	outbox          outbox.Outbox
+
+	// stopCtx is cancelled on shutdown to abort running replays
+	stopCtx context.Context
+	wg      sync.WaitGroup
+}
+
+func NewService(
+	lc fx.Lifecycle,
+	repo Repository,
+	productRepo product.Repository,
+	productAttrs product.AttributeEnricher,
+	categoryRepo category.Repository,
+	attributeRepo attribute.Repository,
+	productEvents product.ProductEventFactory,
+	categoryEvents category.CategoryEventFactory,
+	attributeEvents attribute.AttributeEventFactory,
+	outbox outbox.Outbox,
+) Service {
+	stopCtx, stop := context.WithCancel(context.Background())
+	s := &replayService{
+		repo:            repo,
+		productRepo:     productRepo,
+		productAttrs:    productAttrs,
+		categoryRepo:    categoryRepo,
+		attributeRepo:   attributeRepo,
+		productEvents:   productEvents,
+		categoryEvents:  categoryEvents,
+		attributeEvents: attributeEvents,
+		outbox:          outbox,
+		stopCtx:         stopCtx,
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			stop()
+			s.wg.Wait()
+			return nil
+		},
+	})
+
+	return s
+}
+
+func (s *replayService) Start(ctx context.Context, cmd StartReplayCommand) (Status, error) {
+	targets, err := cmd.targets()
+	if err != nil {
+		return Status{}, err
+	}
+	perSecond, err := cmd.rate()
+	if err != nil {
+		return Status{}, err
+	}
+
+	now := utc.Now()
+	job := &Status{
+		ID:        uuid.New().String(),
+		Running:   true,
+		StartedAt: now,
+		UpdatedAt: now,
+		Progress: lo.Map(targets, func(t Target, _ int) Progress {
+			return Progress{Target: t}
+		}),
+	}
+	if err := s.repo.Begin(ctx, job, now.Add(-staleAfter)); err != nil {
+		return Status{}, err
+	}
+
+	// The replay outlives the request: keep its values (tenant, logger) but not its cancellation
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(s.stopCtx, cancel)
+
+	started := snapshot(job)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer stop()
+		defer cancel()
+		s.run(runCtx, job, targets, rate.NewLimiter(rate.Limit(perSecond), 1))
+	}()
+
+	return started, nil
+}
+
+func (s *replayService) Status(ctx context.Context) (Status, bool, error) {
+	job, err := s.repo.FindLatest(ctx)
+	if errors.Is(err, commonsmongo.ErrEntityNotFound) {
+		return Status{}, false, nil
+	}
+	if err != nil {
+		return Status{}, false, fmt.Errorf("failed to load replay status: %w", err)
+	}
+
+	if job.Running && time.Since(job.UpdatedAt) > staleAfter {
+		job.Running = false
+		job.Error = "replay was interrupted"
+	}
+	return *job, true, nil
+}
+
+func (s *replayService) run(ctx context.Context, job *Status, targets []Target, limiter *rate.Limiter) {
+	s.log(ctx).Info("replay started", zap.String("id", job.ID), zap.Any("targets", targets))
+
+	var err error
+	for i, target := range targets {
+		report := func(total, published int64) error {
+			job.Progress[i] = Progress{Target: target, Total: total, Published: published}
+			if time.Since(job.UpdatedAt) < saveInterval {
+				return nil
+			}
+			return s.save(ctx, job)
+		}
+
+		if err = s.replayTarget(ctx, target, limiter, report); err != nil {
+			err = fmt.Errorf("replay %s: %w", target, err)
+			break
+		}
+	}
+	if errors.Is(err, ErrReplaySuperseded) {
+		s.log(ctx).Warn("replay stopped", zap.String("id", job.ID), zap.Error(err))
+		return
+	}
+
+	finishedAt := utc.Now()
+	job.Running = false
+	job.FinishedAt = &finishedAt
+	if err != nil {
+		job.Error = err.Error()
+	}
+
+	// Record the outcome even if the replay was aborted by shutdown
+	if saveErr := s.save(context.WithoutCancel(ctx), job); saveErr != nil {
+		s.log(ctx).Error("failed to save replay status", zap.String("id", job.ID), zap.Error(saveErr))
+	}
+
+	if err != nil {
+		s.log(ctx).Error("replay failed", zap.String("id", job.ID), zap.Error(err))
+		return
+	}
+	s.log(ctx).Info("replay finished", zap.String("id", job.ID), zap.Duration("duration", finishedAt.Sub(job.StartedAt)))
+}
+
+func (s *replayService) save(ctx context.Context, job *Status) error {
+	job.UpdatedAt = utc.Now()
+	return s.repo.Save(ctx, job)
+}
+
+func (s *replayService) replayTarget(ctx context.Context, target Target, limiter *rate.Limiter, report func(total, published int64) error) error {
+	switch target {
+	case TargetAttributes:
+		return replayPages(ctx, limiter, report,
+			func(ctx context.Context, afterID string) (*commonsmongo.PageResult[attribute.Attribute], error) {
+				return s.attributeRepo.FindList(ctx, attribute.ListQuery{AfterID: afterID, Size: pageSize, Sort: pageOrder})
+			},
+			func(a *attribute.Attribute) string { return a.ID },
+			func(ctx context.Context, a *attribute.Attribute) error {
+				return s.publish(ctx, s.attributeEvents.NewAttributeUpdatedOutboxMessage(ctx, a, attribute.OptionsDelta{}))
+			},
+		)
+	case TargetCategories:
+		return replayPages(ctx, limiter, report,
+			func(ctx context.Context, afterID string) (*commonsmongo.PageResult[category.Category], error) {
+				return s.categoryRepo.FindList(ctx, category.ListQuery{AfterID: afterID, Size: pageSize, Sort: pageOrder})
+			},
+			func(c *category.Category) string { return c.ID },
+			s.publishCategory,
+		)
+	case TargetProducts:
+		return replayPages(ctx, limiter, report,
+			func(ctx context.Context, afterID string) (*commonsmongo.PageResult[product.Product], error) {
+				return s.productRepo.FindList(ctx, product.ListQuery{AfterID: afterID, Size: pageSize, Sort: pageOrder})
+			},
+			func(p *product.Product) string { return p.ID },
+			s.publishProduct,
+		)
+	default:
+		return ErrUnknownTarget
+	}
+}
+
+func (s *replayService) publishProduct(ctx context.Context, p *product.Product) error {
+	// Stored values may predate a reordering of the attribute options
+	if err := s.productAttrs.Enrich(ctx, []*product.Product{p}); err != nil {
+		return fmt.Errorf("failed to enrich attributes of product %s: %w", p.ID, err)
+	}
+
+	return s.publish(ctx, s.productEvents.NewProductUpdatedOutboxMessage(ctx, p))
+}
+
+func (s *replayService) publishCategory(ctx context.Context, c *category.Category) error {
+	attrIDs := lo.Map(c.Attributes, func(a category.CategoryAttribute, _ int) string {
+		return a.AttributeID
+	})
+
+	attrs, err := s.attributeRepo.FindByIDsOrFail(ctx, attrIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load attributes of category %s: %w", c.ID, err)
+	}
+
+	return s.publish(ctx, s.categoryEvents.NewCategoryUpdatedOutboxMessage(ctx, c, attrs))
+}
+
+// publish marks msg as a replay, so consumers don't skip the version they have already applied
+func (s *replayService) publish(ctx context.Context, msg outbox.Message) error {
+	msg.Headers[catalogevents.HeaderReplay] = "true"
+
+	send, err := s.outbox.Create(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	return nil
+}
+
+func (s *replayService) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "replay-service"))
+}
+
+// replayPages walks all items in ID order and publishes each of them, waiting on the limiter before each one.
+// Total is the count when the replay of the target started; items created later are published as well.
+func replayPages[T any](
+	ctx context.Context,
+	limiter *rate.Limiter,
+	report func(total, published int64) error,
+	fetch func(ctx context.Context, afterID string) (*commonsmongo.PageResult[T], error),
+	idOf func(item *T) string,
+	publish func(ctx context.Context, item *T) error,
+) error {
+	var (
+		total, published int64
+		afterID          string
+	)
+	for {
+		result, err := fetch(ctx, afterID)
+		if err != nil {
+			return fmt.Errorf("failed to load items after %q: %w", afterID, err)
+		}
+		if afterID == "" {
+			total = result.Total
+		}
+		if err := report(total, published); err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			if err := publish(ctx, item); err != nil {
+				return err
+			}
+			published++
+			afterID = idOf(item)
+			if err := report(max(total, published), published); err != nil {
+				return err
+			}
+		}
+
+		if len(result.Items) < pageSize {
+			return nil
+		}
+	}
+}
+
+func snapshot(job *Status) Status {
+	s := *job
+	s.Progress = slices.Clone(job.Progress)
+	return s
+}
@@ -0,0 +1,12 @@
+package reservation
+
+import "errors"
+
+var (
+	ErrInvalidReservationData = errors.New("invalid reservation data")
+	ErrReservationNotFound    = errors.New("reservation not found")
+	ErrNotReservationOwner    = errors.New("reservation belongs to another owner")
+	ErrProductNotFound        = errors.New("product not found")
+	ErrProductNotAvailable    = errors.New("product is not available for sale")
+	ErrInsufficientStock      = errors.New("insufficient stock")
+)
@@ -0,0 +1,14 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// ReservationEventFactory creates reservation events
+type ReservationEventFactory interface {
+	NewStockReservedOutboxMessage(ctx context.Context, r *Reservation) outbox.Message
+	NewStockReleasedOutboxMessage(ctx context.Context, r *Reservation) outbox.Message
+	NewStockReservationExpiredOutboxMessage(ctx context.Context, r *Reservation) outbox.Message
+}
@@ -0,0 +1,105 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type ExpireReservationsCommand struct {
+	// Limit bounds the number of reservations expired by a single run
+	Limit int
+}
+
+type ExpireReservationsCommandHandler interface {
+	// Handle removes the reservations of the current tenant that expired and announces each of them.
+	// It returns the number of reservations expired by this call.
+	Handle(ctx context.Context, cmd ExpireReservationsCommand) (int, error)
+}
+
+type expireReservationsHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ReservationEventFactory
+}
+
+func NewExpireReservationsHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ReservationEventFactory,
+) ExpireReservationsCommandHandler {
+	return &expireReservationsHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *expireReservationsHandler) Handle(ctx context.Context, cmd ExpireReservationsCommand) (int, error) {
+	now := utc.Now()
+
+	expired, err := h.repo.FindExpired(ctx, now, cmd.Limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired reservations: %w", err)
+	}
+
+	count := 0
+	for _, r := range expired {
+		ok, err := h.expire(ctx, r, now)
+		if err != nil {
+			return count, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// expire deletes a single reservation and stores its expiry event in the same transaction.
+// A reservation already removed by a concurrent run is skipped without an event.
+func (h *expireReservationsHandler) expire(ctx context.Context, r *Reservation, now time.Time) (bool, error) {
+	msg := h.eventFactory.NewStockReservationExpiredOutboxMessage(ctx, r)
+
+	send, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (outbox.SendFunc, error) {
+		deleted, err := h.repo.DeleteExpired(txCtx, r.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete reservation: %w", err)
+		}
+		if !deleted {
+			return nil, nil
+		}
+
+		send, err := h.outbox.Create(txCtx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return send, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if send == nil {
+		return false, nil
+	}
+
+	h.log(ctx).Debug("reservation expired", zap.String("id", r.ID), zap.String("productId", r.ProductID), zap.Int("quantity", r.Quantity))
+
+	_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return true, nil
+}
+
+func (h *expireReservationsHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "expire-reservations-handler"))
+}
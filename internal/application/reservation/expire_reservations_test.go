@@ -0,0 +1,77 @@
+package reservation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+func setupExpireReservationsHandler(t *testing.T) (
+	*MockRepository,
+	*mocks.MockOutbox,
+	*mocks.MockTxManager,
+	*MockReservationEventFactory,
+	ExpireReservationsCommandHandler,
+) {
+	repo := NewMockRepository(t)
+	outboxMock := mocks.NewMockOutbox(t)
+	txManager := mocks.NewMockTxManager(t)
+	eventFactory := NewMockReservationEventFactory(t)
+
+	handler := NewExpireReservationsHandler(repo, outboxMock, txManager, eventFactory)
+
+	return repo, outboxMock, txManager, eventFactory, handler
+}
+
+func TestExpireReservationsHandler_Handle_Success(t *testing.T) {
+	repo, outboxMock, txManager, eventFactory, handler := setupExpireReservationsHandler(t)
+	now := time.Now().UTC()
+	first := Reconstruct("reservation-1", "product-123", "order-1", 2, now.Add(-time.Minute), now)
+	second := Reconstruct("reservation-2", "product-123", "order-2", 1, now.Add(-time.Second), now)
+
+	repo.EXPECT().FindExpired(mock.Anything, mock.Anything, 10).Return([]*Reservation{first, second}, nil)
+	passThroughTx(txManager)
+	repo.EXPECT().DeleteExpired(mock.Anything, first.ID, mock.Anything).Return(true, nil)
+	repo.EXPECT().DeleteExpired(mock.Anything, second.ID, mock.Anything).Return(true, nil)
+	eventFactory.EXPECT().NewStockReservationExpiredOutboxMessage(mock.Anything, first).Return(outbox.Message{})
+	eventFactory.EXPECT().NewStockReservationExpiredOutboxMessage(mock.Anything, second).Return(outbox.Message{})
+	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil).Times(2)
+
+	count, err := handler.Handle(testCtx(), ExpireReservationsCommand{Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestExpireReservationsHandler_Handle_AlreadyRemoved(t *testing.T) {
+	repo, _, txManager, eventFactory, handler := setupExpireReservationsHandler(t)
+	now := time.Now().UTC()
+	r := Reconstruct("reservation-1", "product-123", "order-1", 2, now.Add(-time.Minute), now)
+
+	repo.EXPECT().FindExpired(mock.Anything, mock.Anything, 10).Return([]*Reservation{r}, nil)
+	passThroughTx(txManager)
+	repo.EXPECT().DeleteExpired(mock.Anything, r.ID, mock.Anything).Return(false, nil)
+	eventFactory.EXPECT().NewStockReservationExpiredOutboxMessage(mock.Anything, r).Return(outbox.Message{})
+
+	count, err := handler.Handle(testCtx(), ExpireReservationsCommand{Limit: 10})
+
+	require.NoError(t, err)
+	assert.Zero(t, count, "a reservation removed by a concurrent run is not announced twice")
+}
+
+func TestExpireReservationsHandler_Handle_FindError(t *testing.T) {
+	repo, _, _, _, handler := setupExpireReservationsHandler(t)
+
+	repo.EXPECT().FindExpired(mock.Anything, mock.Anything, 10).Return(nil, errors.New("db down"))
+
+	_, err := handler.Handle(testCtx(), ExpireReservationsCommand{Limit: 10})
+
+	require.Error(t, err)
+}
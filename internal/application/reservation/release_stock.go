@@ -0,0 +1,83 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type ReleaseStockCommand struct {
+	ID    string
+	Owner string
+}
+
+type ReleaseStockCommandHandler interface {
+	Handle(ctx context.Context, cmd ReleaseStockCommand) error
+}
+
+type releaseStockHandler struct {
+	repo         Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ReservationEventFactory
+}
+
+func NewReleaseStockHandler(
+	repo Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ReservationEventFactory,
+) ReleaseStockCommandHandler {
+	return &releaseStockHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+// Handle releases an active reservation on behalf of its owner. Expired reservations are already
+// released and are reported as ErrReservationNotFound.
+func (h *releaseStockHandler) Handle(ctx context.Context, cmd ReleaseStockCommand) error {
+	send, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (outbox.SendFunc, error) {
+		r, err := h.repo.FindByID(txCtx, cmd.ID)
+		if err != nil {
+			if errors.Is(err, mongo.ErrEntityNotFound) {
+				return nil, ErrReservationNotFound
+			}
+			return nil, fmt.Errorf("failed to get reservation: %w", err)
+		}
+		if r.Owner != cmd.Owner {
+			return nil, ErrNotReservationOwner
+		}
+
+		if err := h.repo.Delete(txCtx, r.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete reservation: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, h.eventFactory.NewStockReleasedOutboxMessage(txCtx, r))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return send, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.log(ctx).Debug("stock released", zap.String("id", cmd.ID))
+
+	_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return nil
+}
+
+func (h *releaseStockHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "release-stock-handler"))
+}
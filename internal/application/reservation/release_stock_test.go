@@ -0,0 +1,70 @@
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func setupReleaseStockHandler(t *testing.T) (
+	*MockRepository,
+	*mocks.MockOutbox,
+	*mocks.MockTxManager,
+	*MockReservationEventFactory,
+	ReleaseStockCommandHandler,
+) {
+	repo := NewMockRepository(t)
+	outboxMock := mocks.NewMockOutbox(t)
+	txManager := mocks.NewMockTxManager(t)
+	eventFactory := NewMockReservationEventFactory(t)
+
+	handler := NewReleaseStockHandler(repo, outboxMock, txManager, eventFactory)
+
+	return repo, outboxMock, txManager, eventFactory, handler
+}
+
+func TestReleaseStockHandler_Handle_Success(t *testing.T) {
+	repo, outboxMock, txManager, eventFactory, handler := setupReleaseStockHandler(t)
+	now := time.Now().UTC()
+	r := Reconstruct("reservation-1", "product-123", "order-1", 2, now.Add(time.Minute), now)
+
+	passThroughTx(txManager)
+	repo.EXPECT().FindByID(mock.Anything, r.ID).Return(r, nil)
+	repo.EXPECT().Delete(mock.Anything, r.ID).Return(nil)
+	eventFactory.EXPECT().NewStockReleasedOutboxMessage(mock.Anything, r).Return(outbox.Message{})
+	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil)
+
+	err := handler.Handle(testCtx(), ReleaseStockCommand{ID: r.ID, Owner: "order-1"})
+
+	require.NoError(t, err)
+}
+
+func TestReleaseStockHandler_Handle_NotFound(t *testing.T) {
+	repo, _, txManager, _, handler := setupReleaseStockHandler(t)
+
+	passThroughTx(txManager)
+	repo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, mongo.ErrEntityNotFound)
+
+	err := handler.Handle(testCtx(), ReleaseStockCommand{ID: "missing", Owner: "order-1"})
+
+	require.ErrorIs(t, err, ErrReservationNotFound)
+}
+
+func TestReleaseStockHandler_Handle_OtherOwner(t *testing.T) {
+	repo, _, txManager, _, handler := setupReleaseStockHandler(t)
+	now := time.Now().UTC()
+	r := Reconstruct("reservation-1", "product-123", "order-1", 2, now.Add(time.Minute), now)
+
+	passThroughTx(txManager)
+	repo.EXPECT().FindByID(mock.Anything, r.ID).Return(r, nil)
+
+	err := handler.Handle(testCtx(), ReleaseStockCommand{ID: r.ID, Owner: "order-2"})
+
+	require.ErrorIs(t, err, ErrNotReservationOwner)
+}
@@ -0,0 +1,29 @@
+package reservation
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, reservation *Reservation) error
+
+	// FindByID returns an unexpired reservation or mongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*Reservation, error)
+
+	Delete(ctx context.Context, id string) error
+
+	// FindExpired returns up to limit reservations that expired at or before now, oldest first
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*Reservation, error)
+
+	// DeleteExpired removes the reservation if it expired at or before now and reports whether it did,
+	// so that concurrent expiry runs announce every reservation only once
+	DeleteExpired(ctx context.Context, id string, now time.Time) (bool, error)
+
+	// LockProduct serializes reservations of a product: a concurrent transaction
+	// locking the same product fails with a write conflict and is retried
+	LockProduct(ctx context.Context, productID string) error
+
+	// ReservedQuantities sums the unexpired reservations per product
+	ReservedQuantities(ctx context.Context, productIDs []string) (map[string]int, error)
+}
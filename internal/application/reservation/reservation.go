@@ -0,0 +1,75 @@
+package reservation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	// DefaultTTL is used when a reservation is requested without a TTL
+	DefaultTTL = 15 * time.Minute
+	// MaxTTL bounds how long stock can be held for a single checkout
+	MaxTTL = 24 * time.Hour
+)
+
+// Reservation holds a quantity of a product for a limited time, e.g. while a payment is in progress.
+// Reservations are never updated: they are either released by their owner or expire.
+type Reservation struct {
+	ID        string
+	ProductID string
+	// Owner identifies who holds the stock, e.g. an order ID; only the owner may release it
+	Owner     string
+	Quantity  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// NewReservation creates a reservation that expires after ttl; zero ttl uses DefaultTTL
+func NewReservation(productID, owner string, quantity int, ttl time.Duration) (*Reservation, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidReservationData)
+	}
+	if owner == "" {
+		return nil, fmt.Errorf("%w: owner is required", ErrInvalidReservationData)
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidReservationData)
+	}
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	if ttl < 0 || ttl > MaxTTL {
+		return nil, fmt.Errorf("%w: ttl must be between 0 and %s", ErrInvalidReservationData, MaxTTL)
+	}
+
+	now := utc.Now()
+	return &Reservation{
+		ID:        uuid.New().String(),
+		ProductID: productID,
+		Owner:     owner,
+		Quantity:  quantity,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a reservation from persistence without validation
+func Reconstruct(id, productID, owner string, quantity int, expiresAt, createdAt time.Time) *Reservation {
+	return &Reservation{
+		ID:        id,
+		ProductID: productID,
+		Owner:     owner,
+		Quantity:  quantity,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+}
+
+// IsExpired reports whether the reservation no longer holds stock
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,114 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type ReserveStockCommand struct {
+	ProductID string
+	Owner     string
+	Quantity  int
+	TTL       time.Duration
+}
+
+type ReserveStockCommandHandler interface {
+	Handle(ctx context.Context, cmd ReserveStockCommand) (*Reservation, error)
+}
+
+type reserveStockHandler struct {
+	repo         Repository
+	productRepo  product.Repository
+	outbox       outbox.Outbox
+	txManager    mongo.TxManager
+	eventFactory ReservationEventFactory
+}
+
+func NewReserveStockHandler(
+	repo Repository,
+	productRepo product.Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	eventFactory ReservationEventFactory,
+) ReserveStockCommandHandler {
+	return &reserveStockHandler{
+		repo:         repo,
+		productRepo:  productRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *reserveStockHandler) Handle(ctx context.Context, cmd ReserveStockCommand) (*Reservation, error) {
+	r, err := NewReservation(cmd.ProductID, cmd.Owner, cmd.Quantity, cmd.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := h.eventFactory.NewStockReservedOutboxMessage(ctx, r)
+
+	send, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (outbox.SendFunc, error) {
+		if err := h.repo.LockProduct(txCtx, r.ProductID); err != nil {
+			return nil, fmt.Errorf("failed to lock product stock: %w", err)
+		}
+
+		if err := h.checkAvailability(txCtx, r); err != nil {
+			return nil, err
+		}
+
+		if err := h.repo.Insert(txCtx, r); err != nil {
+			return nil, fmt.Errorf("failed to insert reservation: %w", err)
+		}
+
+		send, err := h.outbox.Create(txCtx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+
+		return send, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("stock reserved", zap.String("id", r.ID), zap.String("productId", r.ProductID), zap.Int("quantity", r.Quantity))
+
+	_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+
+	return r, nil
+}
+
+func (h *reserveStockHandler) checkAvailability(ctx context.Context, r *Reservation) error {
+	p, err := h.productRepo.FindByID(ctx, r.ProductID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return ErrProductNotFound
+		}
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	if !p.Enabled {
+		return ErrProductNotAvailable
+	}
+
+	reserved, err := h.repo.ReservedQuantities(ctx, []string{r.ProductID})
+	if err != nil {
+		return fmt.Errorf("failed to get reserved stock: %w", err)
+	}
+	if p.Quantity-reserved[r.ProductID] < r.Quantity {
+		return ErrInsufficientStock
+	}
+	return nil
+}
+
+func (h *reserveStockHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "reserve-stock-handler"))
+}
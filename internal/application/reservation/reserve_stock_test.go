@@ -0,0 +1,136 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/testutil/mocks"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+// mockSendFunc is a no-op send function for tests
+func mockSendFunc(_ context.Context) error {
+	return nil
+}
+
+func passThroughTx(txManager *mocks.MockTxManager) {
+	txManager.EXPECT().
+		WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) (any, error)) (any, error) {
+			return fn(ctx)
+		})
+}
+
+func createTestProduct(quantity int, enabled bool) *product.Product {
+	now := time.Now().UTC()
+	return product.Reconstruct("product-123", 1, "Phone", "", nil, product.ProductTypePhysical, nil, 100, quantity, nil, nil, enabled, nil, nil, nil, nil, now, now)
+}
+
+func setupReserveStockHandler(t *testing.T) (
+	*MockRepository,
+	*product.MockRepository,
+	*mocks.MockOutbox,
+	*mocks.MockTxManager,
+	*MockReservationEventFactory,
+	ReserveStockCommandHandler,
+) {
+	repo := NewMockRepository(t)
+	productRepo := product.NewMockRepository(t)
+	outboxMock := mocks.NewMockOutbox(t)
+	txManager := mocks.NewMockTxManager(t)
+	eventFactory := NewMockReservationEventFactory(t)
+
+	handler := NewReserveStockHandler(repo, productRepo, outboxMock, txManager, eventFactory)
+
+	return repo, productRepo, outboxMock, txManager, eventFactory, handler
+}
+
+func TestReserveStockHandler_Handle_Success(t *testing.T) {
+	repo, productRepo, outboxMock, txManager, eventFactory, handler := setupReserveStockHandler(t)
+
+	eventFactory.EXPECT().NewStockReservedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	passThroughTx(txManager)
+	repo.EXPECT().LockProduct(mock.Anything, "product-123").Return(nil)
+	productRepo.EXPECT().FindByID(mock.Anything, "product-123").Return(createTestProduct(10, true), nil)
+	repo.EXPECT().ReservedQuantities(mock.Anything, []string{"product-123"}).Return(map[string]int{"product-123": 7}, nil)
+	repo.EXPECT().Insert(mock.Anything, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+	outboxMock.EXPECT().Create(mock.Anything, mock.Anything).Return(mockSendFunc, nil)
+
+	result, err := handler.Handle(testCtx(), ReserveStockCommand{ProductID: "product-123", Owner: "order-1", Quantity: 3, TTL: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, "product-123", result.ProductID)
+	assert.Equal(t, "order-1", result.Owner)
+	assert.Equal(t, 3, result.Quantity)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), result.ExpiresAt, 5*time.Second)
+}
+
+func TestReserveStockHandler_Handle_InsufficientStock(t *testing.T) {
+	repo, productRepo, _, txManager, eventFactory, handler := setupReserveStockHandler(t)
+
+	eventFactory.EXPECT().NewStockReservedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+	passThroughTx(txManager)
+	repo.EXPECT().LockProduct(mock.Anything, "product-123").Return(nil)
+	productRepo.EXPECT().FindByID(mock.Anything, "product-123").Return(createTestProduct(10, true), nil)
+	repo.EXPECT().ReservedQuantities(mock.Anything, []string{"product-123"}).Return(map[string]int{"product-123": 8}, nil)
+
+	result, err := handler.Handle(testCtx(), ReserveStockCommand{ProductID: "product-123", Owner: "order-1", Quantity: 3})
+
+	require.ErrorIs(t, err, ErrInsufficientStock)
+	assert.Nil(t, result)
+}
+
+func TestReserveStockHandler_Handle_ProductUnavailable(t *testing.T) {
+	tests := []struct {
+		name    string
+		product *product.Product
+		findErr error
+		wantErr error
+	}{
+		{name: "not found", findErr: mongo.ErrEntityNotFound, wantErr: ErrProductNotFound},
+		{name: "disabled", product: createTestProduct(10, false), wantErr: ErrProductNotAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, productRepo, _, txManager, eventFactory, handler := setupReserveStockHandler(t)
+
+			eventFactory.EXPECT().NewStockReservedOutboxMessage(mock.Anything, mock.Anything).Return(outbox.Message{})
+			passThroughTx(txManager)
+			repo.EXPECT().LockProduct(mock.Anything, "product-123").Return(nil)
+			productRepo.EXPECT().FindByID(mock.Anything, "product-123").Return(tt.product, tt.findErr)
+
+			_, err := handler.Handle(testCtx(), ReserveStockCommand{ProductID: "product-123", Owner: "order-1", Quantity: 1})
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReserveStockHandler_Handle_InvalidData(t *testing.T) {
+	_, _, _, _, _, handler := setupReserveStockHandler(t)
+
+	tests := []ReserveStockCommand{
+		{ProductID: "", Quantity: 1},
+		{ProductID: "product-123", Quantity: 0},
+		{ProductID: "product-123", Quantity: 1, TTL: MaxTTL + time.Second},
+	}
+
+	for _, cmd := range tests {
+		_, err := handler.Handle(testCtx(), cmd)
+		require.ErrorIs(t, err, ErrInvalidReservationData)
+	}
+}
@@ -0,0 +1,224 @@
+// Package richtext cleans the HTML of rich-text fields before it is stored and sent to storefronts,
+// which render it as is.
+//
+// Sanitize keeps an allow-list of formatting tags and drops everything else: tags that can run code,
+// such as script, are dropped with their content, other tags are dropped and their text is kept.
+// Attributes are dropped except link and image URLs with a safe scheme.
+package richtext
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowedTags are the tags kept by Sanitize, with the attributes kept on them
+var allowedTags = map[atom.Atom][]string{
+	atom.P: nil, atom.Br: nil, atom.Hr: nil, atom.Div: nil, atom.Span: nil,
+	atom.Strong: nil, atom.B: nil, atom.Em: nil, atom.I: nil, atom.U: nil, atom.S: nil,
+	atom.Sub: nil, atom.Sup: nil, atom.Small: nil, atom.Mark: nil,
+	atom.H2: nil, atom.H3: nil, atom.H4: nil, atom.H5: nil, atom.H6: nil,
+	atom.Ul: nil, atom.Ol: nil, atom.Li: nil, atom.Dl: nil, atom.Dt: nil, atom.Dd: nil,
+	atom.Blockquote: nil, atom.Pre: nil, atom.Code: nil,
+	atom.Table: nil, atom.Thead: nil, atom.Tbody: nil, atom.Tr: nil, atom.Th: nil, atom.Td: nil,
+	atom.A:   {"href", "title"},
+	atom.Img: {"src", "alt", "width", "height"},
+}
+
+// droppedWithContent are the tags whose content is no text for the reader, or markup that can run code
+var droppedWithContent = []atom.Atom{
+	atom.Script, atom.Style, atom.Iframe, atom.Object, atom.Embed, atom.Noscript, atom.Template,
+	atom.Textarea, atom.Select, atom.Title, atom.Head, atom.Frameset, atom.Noembed,
+}
+
+// blockTags separate words in an excerpt
+var blockTags = []atom.Atom{
+	atom.P, atom.Br, atom.Hr, atom.Div, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Li, atom.Dt, atom.Dd,
+	atom.Blockquote, atom.Pre, atom.Tr, atom.Th, atom.Td, atom.H1,
+}
+
+var voidTags = []atom.Atom{atom.Br, atom.Hr, atom.Img}
+
+// urlAttributes hold URLs, which are kept only with these schemes or relative
+var (
+	urlAttributes = []string{"href", "src"}
+	linkSchemes   = []string{"http", "https", "mailto"}
+	imageSchemes  = []string{"http", "https"}
+)
+
+// linkRel keeps links in descriptions from passing ranking or the storefront window to other sites
+const linkRel = "nofollow noopener noreferrer"
+
+// Sanitize returns the HTML with only the allowed tags and attributes; open tags are closed
+func Sanitize(s string) string {
+	var b strings.Builder
+	var open []atom.Atom
+	skip := 0
+
+	walk(s, func(t html.Token) {
+		if skip > 0 {
+			switch {
+			case t.Type == html.StartTagToken && slices.Contains(droppedWithContent, t.DataAtom):
+				skip++
+			case t.Type == html.EndTagToken && slices.Contains(droppedWithContent, t.DataAtom):
+				skip--
+			}
+			return
+		}
+
+		switch t.Type {
+		case html.TextToken:
+			b.WriteString(html.EscapeString(t.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if slices.Contains(droppedWithContent, t.DataAtom) {
+				if t.Type == html.StartTagToken {
+					skip++
+				}
+				return
+			}
+			attrs, ok := allowedTags[t.DataAtom]
+			if !ok {
+				return
+			}
+			if t.DataAtom == atom.Img && safeURL(attr(t, "src"), imageSchemes) == "" {
+				return
+			}
+			writeStartTag(&b, t, attrs)
+			if !slices.Contains(voidTags, t.DataAtom) {
+				open = append(open, t.DataAtom)
+			}
+		case html.EndTagToken:
+			// Close the innermost open tag of the name along with the ones left open inside it
+			i := len(open) - 1
+			for i >= 0 && open[i] != t.DataAtom {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			for len(open) > i {
+				b.WriteString("</" + open[len(open)-1].String() + ">")
+				open = open[:len(open)-1]
+			}
+		}
+	})
+
+	for len(open) > 0 {
+		b.WriteString("</" + open[len(open)-1].String() + ">")
+		open = open[:len(open)-1]
+	}
+	return b.String()
+}
+
+// Excerpt returns the text of the HTML with whitespace collapsed, cut at a word boundary
+// to at most maxLength characters with an ellipsis
+func Excerpt(s string, maxLength int) string {
+	var b strings.Builder
+	skip := 0
+
+	walk(s, func(t html.Token) {
+		switch {
+		case t.Type == html.StartTagToken && slices.Contains(droppedWithContent, t.DataAtom):
+			skip++
+		case t.Type == html.EndTagToken && slices.Contains(droppedWithContent, t.DataAtom):
+			skip = max(skip-1, 0)
+		case skip > 0:
+		case t.Type == html.TextToken:
+			b.WriteString(t.Data)
+		case slices.Contains(blockTags, t.DataAtom):
+			b.WriteByte(' ')
+		}
+	})
+
+	text := strings.Join(strings.Fields(b.String()), " ")
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+
+	runes := []rune(text)[:maxLength]
+	cut := string(runes)
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:-") + "…"
+}
+
+// walk calls fn with each token of the HTML
+func walk(s string, fn func(html.Token)) {
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		// The tokenizer stops with io.EOF at the end; malformed input is tokenized as text, not failed
+		if z.Next() == html.ErrorToken {
+			return
+		}
+		fn(z.Token())
+	}
+}
+
+// writeStartTag writes the tag with the allowed attributes, escaped
+func writeStartTag(b *strings.Builder, t html.Token, allowed []string) {
+	b.WriteString("<" + t.DataAtom.String())
+	for _, name := range allowed {
+		value := attr(t, name)
+		if value == "" {
+			continue
+		}
+		if slices.Contains(urlAttributes, name) {
+			schemes := linkSchemes
+			if t.DataAtom == atom.Img {
+				schemes = imageSchemes
+			}
+			if value = safeURL(value, schemes); value == "" {
+				continue
+			}
+		}
+		b.WriteString(" " + name + `="` + html.EscapeString(value) + `"`)
+	}
+	if t.DataAtom == atom.A {
+		b.WriteString(` rel="` + linkRel + `"`)
+	}
+	b.WriteString(">")
+}
+
+func attr(t html.Token, name string) string {
+	for _, a := range t.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// safeURL returns the URL if it is relative or has one of the schemes, empty otherwise
+func safeURL(raw string, schemes []string) string {
+	// Browsers ignore whitespace and control characters inside a scheme, so "java\tscript:" runs code
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, raw)
+	if cleaned == "" {
+		return ""
+	}
+
+	u, err := url.Parse(cleaned)
+	if err != nil {
+		return ""
+	}
+	if u.Scheme == "" {
+		// A colon before any slash would be read as a scheme by browsers
+		if i := strings.IndexByte(cleaned, ':'); i >= 0 && !strings.ContainsAny(cleaned[:i], "/?#") {
+			return ""
+		}
+		return cleaned
+	}
+	if !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+		return ""
+	}
+	return cleaned
+}
@@ -0,0 +1,52 @@
+package richtext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain text", in: "Fast & light", want: "Fast &amp; light"},
+		{name: "allowed formatting", in: "<p>Fast <strong>and</strong> <em>light</em></p><ul><li>USB-C</li></ul>", want: "<p>Fast <strong>and</strong> <em>light</em></p><ul><li>USB-C</li></ul>"},
+		{name: "script with content", in: `<p>Hi</p><script>alert(1)</script>`, want: "<p>Hi</p>"},
+		{name: "script inside svg", in: `<svg onload="alert(1)"><script>alert(1)</script><text>x</text></svg>ok`, want: "xok"},
+		{name: "nested dropped tags", in: `<noscript><style>p{}</style>x</noscript>ok`, want: "ok"},
+		{name: "event handler", in: `<p onclick="alert(1)" class="x">Hi</p>`, want: "<p>Hi</p>"},
+		{name: "unknown tag keeps text", in: `<font color="red">Sale</font>`, want: "Sale"},
+		{name: "javascript link", in: `<a href="javascript:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "obfuscated scheme", in: `<a href="jav&#x09;ascript:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "safe link", in: `<a href="https://example.com/?a=1&b=2" title="Spec">x</a>`, want: `<a href="https://example.com/?a=1&amp;b=2" title="Spec" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "relative link", in: `<a href="/products/phone">x</a>`, want: `<a href="/products/phone" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "data image", in: `<img src="data:text/html;base64,PHNjcmlwdD4=" onerror="alert(1)">`, want: ""},
+		{name: "image", in: `<img src="https://cdn.example.com/a.png" alt="A" onerror="alert(1)">`, want: `<img src="https://cdn.example.com/a.png" alt="A">`},
+		{name: "attribute breakout", in: `<a href='https://x.com/"><script>alert(1)</script>'>x</a>`, want: `<a href="https://x.com/&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "unclosed tags", in: "<p><strong>Bold", want: "<p><strong>Bold</strong></p>"},
+		{name: "stray end tag", in: "Text</div></p>", want: "Text"},
+		{name: "misnested tags", in: "<p><em>a</p>b</em>", want: "<p><em>a</em></p>b"},
+		{name: "comment", in: "a<!-- <script>alert(1)</script> -->b", want: "ab"},
+		{name: "unclosed script", in: "a<script>alert(1)", want: "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sanitize(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, Sanitize(got), "sanitizing is idempotent")
+		})
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	assert.Equal(t, "Fast and light. USB-C Wi-Fi", Excerpt("<p>Fast <b>and</b> light.</p><ul><li>USB-C</li><li>Wi-Fi</li></ul><script>x()</script>", 100))
+	assert.Equal(t, "a < b & c", Excerpt("a &lt; b &amp; c", 100), "entities are decoded, the excerpt is plain text")
+	assert.Equal(t, "The quick brown…", Excerpt("The quick brown fox jumps", 18))
+	assert.Equal(t, "Невеликий…", Excerpt("Невеликий легкий", 12), "lengths count characters")
+	assert.Equal(t, strings.Repeat("x", 5)+"…", Excerpt(strings.Repeat("x", 10), 5))
+	assert.Empty(t, Excerpt("<img src=\"https://cdn.example.com/a.png\">", 10))
+}
@@ -0,0 +1,48 @@
+package savedview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type CreateSavedViewCommand struct {
+	Name       string
+	Owner      string
+	EntityType EntityType
+	Filter     map[string]string
+	Sort       string
+	Order      string
+}
+
+type CreateSavedViewCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateSavedViewCommand) (*SavedView, error)
+}
+
+type createSavedViewHandler struct {
+	repo Repository
+}
+
+func NewCreateSavedViewHandler(repo Repository) CreateSavedViewCommandHandler {
+	return &createSavedViewHandler{repo: repo}
+}
+
+func (h *createSavedViewHandler) Handle(ctx context.Context, cmd CreateSavedViewCommand) (*SavedView, error) {
+	v, err := NewSavedView(cmd.Name, cmd.Owner, cmd.EntityType, cmd.Filter, cmd.Sort, cmd.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Insert(ctx, v); err != nil {
+		return nil, fmt.Errorf("failed to insert saved view: %w", err)
+	}
+
+	h.log(ctx).Debug("saved view created", zap.String("id", v.ID), zap.String("entityType", string(v.EntityType)))
+	return v, nil
+}
+
+func (h *createSavedViewHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "create-saved-view-handler"))
+}
@@ -0,0 +1,47 @@
+package savedview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type DeleteSavedViewCommand struct {
+	ID string
+}
+
+type DeleteSavedViewCommandHandler interface {
+	Handle(ctx context.Context, cmd DeleteSavedViewCommand) error
+}
+
+type deleteSavedViewHandler struct {
+	repo Repository
+}
+
+func NewDeleteSavedViewHandler(repo Repository) DeleteSavedViewCommandHandler {
+	return &deleteSavedViewHandler{repo: repo}
+}
+
+func (h *deleteSavedViewHandler) Handle(ctx context.Context, cmd DeleteSavedViewCommand) error {
+	if _, err := h.repo.FindByID(ctx, cmd.ID); err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return ErrSavedViewNotFound
+		}
+		return fmt.Errorf("failed to get saved view: %w", err)
+	}
+
+	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+
+	h.log(ctx).Debug("saved view deleted", zap.String("id", cmd.ID))
+	return nil
+}
+
+func (h *deleteSavedViewHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "delete-saved-view-handler"))
+}
@@ -0,0 +1,8 @@
+package savedview
+
+import "errors"
+
+var (
+	ErrInvalidSavedViewData = errors.New("invalid saved view data")
+	ErrSavedViewNotFound    = errors.New("saved view not found")
+)
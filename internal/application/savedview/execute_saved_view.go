@@ -0,0 +1,89 @@
+package savedview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ExecuteSavedViewQuery struct {
+	ID   string
+	Page int
+	Size int
+}
+
+// ExecuteSavedViewResult holds the view and the page of the entities it lists;
+// only the list of the view's entity type is set
+type ExecuteSavedViewResult struct {
+	View       *SavedView
+	Products   *product.ListProductsResult
+	Categories *category.ListCategoriesResult
+}
+
+type ExecuteSavedViewQueryHandler interface {
+	// Handle runs the list query of the view through the regular list handlers
+	Handle(ctx context.Context, query ExecuteSavedViewQuery) (*ExecuteSavedViewResult, error)
+}
+
+type executeSavedViewHandler struct {
+	repo       Repository
+	products   product.GetListProductsQueryHandler
+	categories category.GetListCategoriesQueryHandler
+}
+
+func NewExecuteSavedViewHandler(
+	repo Repository,
+	products product.GetListProductsQueryHandler,
+	categories category.GetListCategoriesQueryHandler,
+) ExecuteSavedViewQueryHandler {
+	return &executeSavedViewHandler{
+		repo:       repo,
+		products:   products,
+		categories: categories,
+	}
+}
+
+func (h *executeSavedViewHandler) Handle(ctx context.Context, query ExecuteSavedViewQuery) (*ExecuteSavedViewResult, error) {
+	v, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrSavedViewNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+
+	result := &ExecuteSavedViewResult{View: v}
+	switch v.EntityType {
+	case EntityTypeProduct:
+		result.Products, err = h.products.Handle(ctx, product.GetListProductsQuery{
+			Page:        query.Page,
+			Size:        query.Size,
+			Enabled:     v.BoolFilter(FilterEnabled),
+			CategoryID:  v.StringFilter(FilterCategoryID),
+			SupplierID:  v.StringFilter(FilterSupplierID),
+			HasImage:    v.BoolFilter(FilterHasImage),
+			HasCategory: v.BoolFilter(FilterHasCategory),
+			Sort:        v.Sort,
+			Order:       v.Order,
+		})
+	case EntityTypeCategory:
+		result.Categories, err = h.categories.Handle(ctx, category.GetListCategoriesQuery{
+			Page:    query.Page,
+			Size:    query.Size,
+			Enabled: v.BoolFilter(FilterEnabled),
+			Sort:    v.Sort,
+			Order:   v.Order,
+		})
+	default:
+		return nil, fmt.Errorf("saved view %s has unknown entity type %q", v.ID, v.EntityType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package savedview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetSavedViewByIDQuery struct {
+	ID string
+}
+
+type GetSavedViewByIDQueryHandler interface {
+	Handle(ctx context.Context, query GetSavedViewByIDQuery) (*SavedView, error)
+}
+
+type getSavedViewByIDHandler struct {
+	repo Repository
+}
+
+func NewGetSavedViewByIDHandler(repo Repository) GetSavedViewByIDQueryHandler {
+	return &getSavedViewByIDHandler{repo: repo}
+}
+
+func (h *getSavedViewByIDHandler) Handle(ctx context.Context, query GetSavedViewByIDQuery) (*SavedView, error) {
+	v, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrSavedViewNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,46 @@
+package savedview
+
+import (
+	"context"
+	"fmt"
+)
+
+type GetSavedViewListQuery struct {
+	Page       int
+	Size       int
+	Owner      *string
+	EntityType *EntityType
+}
+
+type ListSavedViewsResult struct {
+	Items []*SavedView
+	Page  int
+	Size  int
+	Total int64
+}
+
+type GetSavedViewListQueryHandler interface {
+	Handle(ctx context.Context, query GetSavedViewListQuery) (*ListSavedViewsResult, error)
+}
+
+type getSavedViewListHandler struct {
+	repo Repository
+}
+
+func NewGetSavedViewListHandler(repo Repository) GetSavedViewListQueryHandler {
+	return &getSavedViewListHandler{repo: repo}
+}
+
+func (h *getSavedViewListHandler) Handle(ctx context.Context, query GetSavedViewListQuery) (*ListSavedViewsResult, error) {
+	result, err := h.repo.FindList(ctx, ListQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved views list: %w", err)
+	}
+
+	return &ListSavedViewsResult{
+		Items: result.Items,
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	}, nil
+}
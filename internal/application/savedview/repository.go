@@ -0,0 +1,31 @@
+package savedview
+
+import (
+	"context"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ListQuery struct {
+	Page       int
+	Size       int
+	Owner      *string
+	EntityType *EntityType
+}
+
+type Repository interface {
+	Insert(ctx context.Context, view *SavedView) error
+
+	// FindByID returns a saved view or commonsmongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*SavedView, error)
+
+	// FindList returns saved views sorted by name
+	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[SavedView], error)
+
+	Update(ctx context.Context, view *SavedView) (*SavedView, error)
+
+	// ReplaceOwner sets the owner of the views of owner, bumping their versions, and returns their number
+	ReplaceOwner(ctx context.Context, owner, replacement string) (int64, error)
+
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,174 @@
+package savedview
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	maxNameLength  = 100
+	maxOwnerLength = 100
+)
+
+// EntityType is the kind of entity a saved view lists
+type EntityType string
+
+const (
+	EntityTypeProduct  EntityType = "product"
+	EntityTypeCategory EntityType = "category"
+)
+
+// Filter keys of saved views; the values are serialized as strings
+const (
+	FilterEnabled     = "enabled"
+	FilterCategoryID  = "categoryId"
+	FilterSupplierID  = "supplierId"
+	FilterHasImage    = "hasImage"
+	FilterHasCategory = "hasCategory"
+)
+
+// filterKeys lists the filters each entity type supports
+var filterKeys = map[EntityType][]string{
+	EntityTypeProduct:  {FilterEnabled, FilterCategoryID, FilterSupplierID, FilterHasImage, FilterHasCategory},
+	EntityTypeCategory: {FilterEnabled},
+}
+
+// boolFilters hold "true" or "false"
+var boolFilters = []string{FilterEnabled, FilterHasImage, FilterHasCategory}
+
+// SavedView is a named list query admin users share, such as
+// "Products missing images in Electronics". Views are visible to everyone; Owner records who created it.
+type SavedView struct {
+	ID         string
+	Version    int
+	Name       string
+	Owner      string
+	EntityType EntityType
+	// Filter maps filter keys of the entity type to their serialized values
+	Filter     map[string]string
+	Sort       string
+	Order      string
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+// NewSavedView creates a new saved view with validation
+func NewSavedView(name, owner string, entityType EntityType, filter map[string]string, sort, order string) (*SavedView, error) {
+	if owner == "" {
+		return nil, fmt.Errorf("%w: owner is required", ErrInvalidSavedViewData)
+	}
+	if utf8.RuneCountInString(owner) > maxOwnerLength {
+		return nil, fmt.Errorf("%w: owner is too long (max %d characters)", ErrInvalidSavedViewData, maxOwnerLength)
+	}
+	if _, ok := filterKeys[entityType]; !ok {
+		return nil, fmt.Errorf("%w: unknown entity type %q", ErrInvalidSavedViewData, entityType)
+	}
+	if err := validateSavedView(name, entityType, filter, order); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
+	return &SavedView{
+		ID:         uuid.New().String(),
+		Version:    1,
+		Name:       name,
+		Owner:      owner,
+		EntityType: entityType,
+		Filter:     cloneFilter(filter),
+		Sort:       sort,
+		Order:      order,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a saved view from persistence (no validation)
+func Reconstruct(id string, version int, name, owner string, entityType EntityType, filter map[string]string, sort, order string, createdAt, modifiedAt time.Time) *SavedView {
+	return &SavedView{
+		ID:         id,
+		Version:    version,
+		Name:       name,
+		Owner:      owner,
+		EntityType: entityType,
+		Filter:     filter,
+		Sort:       sort,
+		Order:      order,
+		CreatedAt:  createdAt,
+		ModifiedAt: modifiedAt,
+	}
+}
+
+// Update replaces the name and query of the view; owner and entity type don't change
+func (v *SavedView) Update(name string, filter map[string]string, sort, order string) error {
+	if err := validateSavedView(name, v.EntityType, filter, order); err != nil {
+		return err
+	}
+
+	v.Name = name
+	v.Filter = cloneFilter(filter)
+	v.Sort = sort
+	v.Order = order
+	v.ModifiedAt = utc.NowAfter(v.ModifiedAt)
+	return nil
+}
+
+// BoolFilter returns the parsed value of a boolean filter or nil if it isn't set
+func (v *SavedView) BoolFilter(key string) *bool {
+	value, ok := v.Filter[key]
+	if !ok {
+		return nil
+	}
+	b, _ := strconv.ParseBool(value) //nolint:errcheck // validated on write
+	return &b
+}
+
+// StringFilter returns the value of a filter or nil if it isn't set
+func (v *SavedView) StringFilter(key string) *string {
+	value, ok := v.Filter[key]
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func validateSavedView(name string, entityType EntityType, filter map[string]string, order string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSavedViewData)
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("%w: name is too long (max %d characters)", ErrInvalidSavedViewData, maxNameLength)
+	}
+	if order != "" && order != "asc" && order != "desc" {
+		return fmt.Errorf("%w: order must be asc or desc", ErrInvalidSavedViewData)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(filter)) {
+		if !slices.Contains(filterKeys[entityType], key) {
+			return fmt.Errorf("%w: %s views can't filter by %s", ErrInvalidSavedViewData, entityType, key)
+		}
+		value := filter[key]
+		if slices.Contains(boolFilters, key) {
+			if value != "true" && value != "false" {
+				return fmt.Errorf("%w: filter %s must be true or false", ErrInvalidSavedViewData, key)
+			}
+		} else if value == "" {
+			return fmt.Errorf("%w: filter %s needs a value", ErrInvalidSavedViewData, key)
+		}
+	}
+	return nil
+}
+
+func cloneFilter(filter map[string]string) map[string]string {
+	if len(filter) == 0 {
+		return nil
+	}
+	return maps.Clone(filter)
+}
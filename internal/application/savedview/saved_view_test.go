@@ -0,0 +1,59 @@
+package savedview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSavedView(t *testing.T) {
+	tests := []struct {
+		name        string
+		viewName    string
+		owner       string
+		entityType  EntityType
+		filter      map[string]string
+		order       string
+		errContains string
+	}{
+		{name: "product view", viewName: "Missing images", owner: "maria", entityType: EntityTypeProduct, filter: map[string]string{"hasImage": "false", "categoryId": "electronics"}, order: "desc"},
+		{name: "uncategorized products view", viewName: "Uncategorized", owner: "maria", entityType: EntityTypeProduct, filter: map[string]string{"hasCategory": "false", "supplierId": "s1"}},
+		{name: "category view without filter", viewName: "All", owner: "maria", entityType: EntityTypeCategory},
+		{name: "missing name", viewName: "", owner: "maria", entityType: EntityTypeProduct, errContains: "name is required"},
+		{name: "long name", viewName: strings.Repeat("n", 101), owner: "maria", entityType: EntityTypeProduct, errContains: "name is too long"},
+		{name: "missing owner", viewName: "View", owner: "", entityType: EntityTypeProduct, errContains: "owner is required"},
+		{name: "unknown entity type", viewName: "View", owner: "maria", entityType: "order", errContains: "unknown entity type"},
+		{name: "unsupported filter", viewName: "View", owner: "maria", entityType: EntityTypeCategory, filter: map[string]string{"supplierId": "s1"}, errContains: "category views can't filter by supplierId"},
+		{name: "invalid bool", viewName: "View", owner: "maria", entityType: EntityTypeProduct, filter: map[string]string{"enabled": "yes"}, errContains: "must be true or false"},
+		{name: "empty value", viewName: "View", owner: "maria", entityType: EntityTypeProduct, filter: map[string]string{"categoryId": ""}, errContains: "needs a value"},
+		{name: "invalid order", viewName: "View", owner: "maria", entityType: EntityTypeProduct, order: "up", errContains: "order must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewSavedView(tt.viewName, tt.owner, tt.entityType, tt.filter, "name", tt.order)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidSavedViewData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, v.ID)
+			assert.Equal(t, 1, v.Version)
+		})
+	}
+}
+
+func TestSavedView_Filters(t *testing.T) {
+	v, err := NewSavedView("Missing images", "maria", EntityTypeProduct, map[string]string{"hasImage": "false", "categoryId": "electronics"}, "", "")
+	require.NoError(t, err)
+
+	require.NotNil(t, v.BoolFilter(FilterHasImage))
+	assert.False(t, *v.BoolFilter(FilterHasImage))
+	assert.Nil(t, v.BoolFilter(FilterEnabled))
+	assert.Equal(t, "electronics", *v.StringFilter(FilterCategoryID))
+	assert.Nil(t, v.StringFilter(FilterSupplierID))
+}
@@ -0,0 +1,65 @@
+package savedview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type UpdateSavedViewCommand struct {
+	ID      string
+	Version int
+	Name    string
+	Filter  map[string]string
+	Sort    string
+	Order   string
+}
+
+type UpdateSavedViewCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateSavedViewCommand) (*SavedView, error)
+}
+
+type updateSavedViewHandler struct {
+	repo Repository
+}
+
+func NewUpdateSavedViewHandler(repo Repository) UpdateSavedViewCommandHandler {
+	return &updateSavedViewHandler{repo: repo}
+}
+
+func (h *updateSavedViewHandler) Handle(ctx context.Context, cmd UpdateSavedViewCommand) (*SavedView, error) {
+	v, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrSavedViewNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+
+	if v.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := v.Update(cmd.Name, cmd.Filter, cmd.Sort, cmd.Order); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.Update(ctx, v)
+	if err != nil {
+		if errors.Is(err, mongo.ErrOptimisticLocking) {
+			return nil, mongo.ErrOptimisticLocking
+		}
+		return nil, fmt.Errorf("failed to update saved view: %w", err)
+	}
+
+	h.log(ctx).Debug("saved view updated", zap.String("id", updated.ID))
+	return updated, nil
+}
+
+func (h *updateSavedViewHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "update-saved-view-handler"))
+}
@@ -0,0 +1,136 @@
+// Package slug derives URL slugs from names. Cyrillic is transliterated, other letters lose their accents,
+// and a slug that is taken gets a -2, -3, ... suffix.
+package slug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// batchSize is the number of candidates checked at once
+const batchSize = 10
+
+// maxCandidates bounds the suffixes tried before giving up on a name
+const maxCandidates = 100
+
+// maxAttempts bounds the writes retried after losing a slug to a concurrent write
+const maxAttempts = 3
+
+var (
+	// ErrEmpty is returned for a name without a single character a slug keeps
+	ErrEmpty = errors.New("name has no characters to derive a slug from")
+	// ErrExhausted is returned when every candidate of the name is taken
+	ErrExhausted = errors.New("no free slug left for the name")
+)
+
+// transliterations follow the Ukrainian national system; the letters only Russian has are added
+var transliterations = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "e", 'є': "ie", 'ж': "zh",
+	'з': "z", 'и': "y", 'і': "i", 'ї': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+	'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ь': "", 'ю': "iu", 'я': "ia",
+	'ё': "e", 'ъ': "", 'ы': "y", 'э': "e",
+	// Latin letters without a decomposition
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i",
+	// Apostrophes join the parts of a word
+	'\'': "", '’': "", 'ʼ': "",
+}
+
+// FromName keeps the ASCII letters and digits of the transliterated name; any other character
+// separates words. The slug is cut to maxLength.
+func FromName(name string, maxLength int) string {
+	var b strings.Builder
+	separate := false
+	write := func(s string) {
+		if separate && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(s)
+		separate = false
+	}
+
+	for _, r := range strings.ToLower(name) {
+		// Transliterated before the decomposition, which would split й or ї into another letter and a mark
+		if t, ok := transliterations[r]; ok {
+			if t != "" {
+				write(t)
+			}
+			continue
+		}
+		for _, d := range norm.NFKD.String(string(r)) {
+			switch {
+			case d >= 'a' && d <= 'z', d >= '0' && d <= '9':
+				write(string(d))
+			case unicode.Is(unicode.Mn, d):
+			default:
+				separate = true
+			}
+		}
+	}
+	return trim(b.String(), maxLength)
+}
+
+// Candidate returns the n-th slug tried for base: base itself, then base-2, base-3 and so on,
+// with base cut so the suffix fits in maxLength
+func Candidate(base string, n, maxLength int) string {
+	if n <= 1 {
+		return trim(base, maxLength)
+	}
+	suffix := "-" + strconv.Itoa(n)
+	return trim(base, maxLength-len(suffix)) + suffix
+}
+
+// TakenFunc returns the candidates that are already in use
+type TakenFunc func(ctx context.Context, candidates []string) ([]string, error)
+
+// Unique returns the first candidate of the name that isn't taken. Two writes may still pick the same
+// slug at once; the unique index rejects one of them, which then runs again with WithRetry.
+func Unique(ctx context.Context, name string, maxLength int, taken TakenFunc) (string, error) {
+	base := FromName(name, maxLength)
+	if base == "" {
+		return "", ErrEmpty
+	}
+
+	for first := 1; first <= maxCandidates; first += batchSize {
+		candidates := make([]string, 0, batchSize)
+		for n := first; n < first+batchSize; n++ {
+			candidates = append(candidates, Candidate(base, n, maxLength))
+		}
+		used, err := taken(ctx, candidates)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slugs: %w", err)
+		}
+		for _, c := range candidates {
+			if !slices.Contains(used, c) {
+				return c, nil
+			}
+		}
+	}
+	return "", ErrExhausted
+}
+
+// WithRetry runs the write again when it fails with conflict, the error of a slug taken by a
+// concurrent write, so it can pick the next free slug
+func WithRetry(ctx context.Context, conflict error, write func(ctx context.Context) error) error {
+	var err error
+	for range maxAttempts {
+		if err = write(ctx); !errors.Is(err, conflict) {
+			return err
+		}
+	}
+	return err
+}
+
+func trim(slug string, maxLength int) string {
+	if len(slug) <= maxLength {
+		return slug
+	}
+	return strings.TrimRight(slug[:maxLength], "-")
+}
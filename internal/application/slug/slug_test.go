@@ -0,0 +1,100 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "iPhone 15 Pro", want: "iphone-15-pro"},
+		{name: "  Crème brûlée -- 2 pcs! ", want: "creme-brulee-2-pcs"},
+		{name: "Women's T-Shirt", want: "womens-t-shirt"},
+		{name: "Футболка", want: "futbolka"},
+		{name: "Їжачок й Ґанок", want: "izhachok-i-ganok"},
+		{name: "М'ясо, 500 г", want: "miaso-500-h"},
+		{name: "Straße", want: "strasse"},
+		{name: "東京", want: ""},
+		{name: strings.Repeat("abc ", 30), want: strings.Repeat("abc-", 12) + "ab"},
+		{name: strings.Repeat("a ", 30), want: strings.Repeat("a-", 24) + "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FromName(tt.name, 50))
+		})
+	}
+}
+
+func TestCandidate(t *testing.T) {
+	assert.Equal(t, "color", Candidate("color", 1, 10))
+	assert.Equal(t, "color-2", Candidate("color", 2, 10))
+	assert.Equal(t, "screen-12", Candidate("screen-size", 12, 10), "the base makes room for the suffix")
+}
+
+func TestUnique(t *testing.T) {
+	ctx := context.Background()
+	takenOf := func(used ...string) TakenFunc {
+		return func(_ context.Context, candidates []string) ([]string, error) {
+			return slices.DeleteFunc(slices.Clone(candidates), func(c string) bool { return !slices.Contains(used, c) }), nil
+		}
+	}
+
+	s, err := Unique(ctx, "Color", 50, takenOf())
+	require.NoError(t, err)
+	assert.Equal(t, "color", s)
+
+	s, err = Unique(ctx, "Color", 50, takenOf("color", "color-2"))
+	require.NoError(t, err)
+	assert.Equal(t, "color-3", s)
+
+	all := make([]string, 0, maxCandidates)
+	for n := 1; n <= maxCandidates; n++ {
+		all = append(all, Candidate("color", n, 50))
+	}
+	s, err = Unique(ctx, "Color", 50, takenOf(all[:15]...))
+	require.NoError(t, err)
+	assert.Equal(t, "color-16", s, "checks the next batch")
+
+	_, err = Unique(ctx, "Color", 50, takenOf(all...))
+	require.ErrorIs(t, err, ErrExhausted)
+
+	_, err = Unique(ctx, "?!", 50, takenOf())
+	require.ErrorIs(t, err, ErrEmpty)
+
+	_, err = Unique(ctx, "Color", 50, func(context.Context, []string) ([]string, error) { return nil, errors.New("down") })
+	require.Error(t, err)
+}
+
+func TestWithRetry(t *testing.T) {
+	conflict := errors.New("taken")
+	ctx := context.Background()
+
+	calls := 0
+	err := WithRetry(ctx, conflict, func(context.Context) error {
+		calls++
+		if calls < 2 {
+			return conflict
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	err = WithRetry(ctx, conflict, func(context.Context) error {
+		calls++
+		return conflict
+	})
+	require.ErrorIs(t, err, conflict)
+	assert.Equal(t, maxAttempts, calls)
+}
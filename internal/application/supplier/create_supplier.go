@@ -0,0 +1,50 @@
+package supplier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"go.uber.org/zap"
+)
+
+type CreateSupplierCommand struct {
+	Name         string
+	Code         string
+	Contact      Contact
+	LeadTimeDays int
+}
+
+type CreateSupplierCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateSupplierCommand) (*Supplier, error)
+}
+
+type createSupplierHandler struct {
+	repo Repository
+}
+
+func NewCreateSupplierHandler(repo Repository) CreateSupplierCommandHandler {
+	return &createSupplierHandler{repo: repo}
+}
+
+func (h *createSupplierHandler) Handle(ctx context.Context, cmd CreateSupplierCommand) (*Supplier, error) {
+	s, err := NewSupplier(cmd.Name, cmd.Code, cmd.Contact, cmd.LeadTimeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Insert(ctx, s); err != nil {
+		if errors.Is(err, ErrCodeAlreadyExists) {
+			return nil, ErrCodeAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to insert supplier: %w", err)
+	}
+
+	h.log(ctx).Debug("supplier created", zap.String("id", s.ID))
+	return s, nil
+}
+
+func (h *createSupplierHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "create-supplier-handler"))
+}
@@ -0,0 +1,61 @@
+package supplier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type DeleteSupplierCommand struct {
+	ID string
+}
+
+type DeleteSupplierCommandHandler interface {
+	// Handle deletes a supplier no product is linked to
+	Handle(ctx context.Context, cmd DeleteSupplierCommand) error
+}
+
+type deleteSupplierHandler struct {
+	repo        Repository
+	productRepo product.Repository
+}
+
+func NewDeleteSupplierHandler(repo Repository, productRepo product.Repository) DeleteSupplierCommandHandler {
+	return &deleteSupplierHandler{
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+func (h *deleteSupplierHandler) Handle(ctx context.Context, cmd DeleteSupplierCommand) error {
+	if _, err := h.repo.FindByID(ctx, cmd.ID); err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return ErrSupplierNotFound
+		}
+		return fmt.Errorf("failed to get supplier: %w", err)
+	}
+
+	linked, err := h.productRepo.FindList(ctx, product.ListQuery{Page: 1, Size: 1, SupplierID: &cmd.ID})
+	if err != nil {
+		return fmt.Errorf("failed to get products of supplier: %w", err)
+	}
+	if linked.Total > 0 {
+		return fmt.Errorf("%w: %d products are linked to it", ErrSupplierInUse, linked.Total)
+	}
+
+	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("failed to delete supplier: %w", err)
+	}
+
+	h.log(ctx).Debug("supplier deleted", zap.String("id", cmd.ID))
+	return nil
+}
+
+func (h *deleteSupplierHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "delete-supplier-handler"))
+}
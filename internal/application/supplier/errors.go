@@ -0,0 +1,15 @@
+package supplier
+
+import (
+	"errors"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+var (
+	ErrInvalidSupplierData = errors.New("invalid supplier data")
+	ErrCodeAlreadyExists   = errors.New("supplier code already exists")
+	ErrSupplierInUse       = errors.New("supplier has products")
+	// ErrSupplierNotFound is the error products report for a missing supplier
+	ErrSupplierNotFound = product.ErrSupplierNotFound
+)
@@ -0,0 +1,36 @@
+package supplier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type GetSupplierByIDQuery struct {
+	ID string
+}
+
+type GetSupplierByIDQueryHandler interface {
+	Handle(ctx context.Context, query GetSupplierByIDQuery) (*Supplier, error)
+}
+
+type getSupplierByIDHandler struct {
+	repo Repository
+}
+
+func NewGetSupplierByIDHandler(repo Repository) GetSupplierByIDQueryHandler {
+	return &getSupplierByIDHandler{repo: repo}
+}
+
+func (h *getSupplierByIDHandler) Handle(ctx context.Context, query GetSupplierByIDQuery) (*Supplier, error) {
+	s, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrSupplierNotFound
+		}
+		return nil, fmt.Errorf("failed to get supplier: %w", err)
+	}
+	return s, nil
+}
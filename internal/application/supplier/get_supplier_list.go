@@ -0,0 +1,49 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type GetSupplierListQuery struct {
+	Page  int
+	Size  int
+	Sort  string
+	Order string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
+}
+
+type ListSuppliersResult struct {
+	Items []*Supplier
+	Page  int
+	Size  int
+	Total int64
+}
+
+type GetSupplierListQueryHandler interface {
+	Handle(ctx context.Context, query GetSupplierListQuery) (*ListSuppliersResult, error)
+}
+
+type getSupplierListHandler struct {
+	repo Repository
+}
+
+func NewGetSupplierListHandler(repo Repository) GetSupplierListQueryHandler {
+	return &getSupplierListHandler{repo: repo}
+}
+
+func (h *getSupplierListHandler) Handle(ctx context.Context, query GetSupplierListQuery) (*ListSuppliersResult, error) {
+	result, err := h.repo.FindList(ctx, ListQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suppliers list: %w", err)
+	}
+
+	return &ListSuppliersResult{
+		Items: result.Items,
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	}, nil
+}
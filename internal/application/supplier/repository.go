@@ -0,0 +1,34 @@
+package supplier
+
+import (
+	"context"
+	"time"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ListQuery struct {
+	Page  int
+	Size  int
+	Sort  string
+	Order string
+	// ModifiedAfter keeps the items modified after the given time, for incremental sync
+	ModifiedAfter *time.Time
+}
+
+type Repository interface {
+	// Insert returns ErrCodeAlreadyExists if another supplier has the code
+	Insert(ctx context.Context, supplier *Supplier) error
+
+	// FindByID returns a supplier or commonsmongo.ErrEntityNotFound
+	FindByID(ctx context.Context, id string) (*Supplier, error)
+
+	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[Supplier], error)
+
+	// Update returns ErrCodeAlreadyExists if another supplier has the code
+	Update(ctx context.Context, supplier *Supplier) (*Supplier, error)
+
+	Delete(ctx context.Context, id string) error
+
+	Exists(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,131 @@
+package supplier
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+const (
+	maxCodeLength = 32
+	maxLeadTime   = 365
+)
+
+// codeRegex allows codes such as ACME or ACME-EU_2
+var codeRegex = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]*$`)
+
+// Contact is the person purchasing talks to at the supplier; all fields are optional
+type Contact struct {
+	Name  *string
+	Email *string
+	Phone *string
+}
+
+// Supplier is a vendor products are purchased from
+type Supplier struct {
+	ID      string
+	Version int
+	Name    string
+	// Code is the short unique identifier purchasing tools use, stored upper-case
+	Code    string
+	Contact Contact
+	// LeadTimeDays is the usual number of days between ordering and receiving goods
+	LeadTimeDays int
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
+
+// NewSupplier creates a new supplier with validation
+func NewSupplier(name, code string, contact Contact, leadTimeDays int) (*Supplier, error) {
+	code = strings.ToUpper(code)
+	if err := validateSupplier(name, code, contact, leadTimeDays); err != nil {
+		return nil, err
+	}
+
+	now := utc.Now()
+	return &Supplier{
+		ID:           uuid.New().String(),
+		Version:      1,
+		Name:         name,
+		Code:         code,
+		Contact:      contact,
+		LeadTimeDays: leadTimeDays,
+		CreatedAt:    now,
+		ModifiedAt:   now,
+	}, nil
+}
+
+// Reconstruct rebuilds a supplier from persistence (no validation)
+func Reconstruct(id string, version int, name, code string, contact Contact, leadTimeDays int, createdAt, modifiedAt time.Time) *Supplier {
+	return &Supplier{
+		ID:           id,
+		Version:      version,
+		Name:         name,
+		Code:         code,
+		Contact:      contact,
+		LeadTimeDays: leadTimeDays,
+		CreatedAt:    createdAt,
+		ModifiedAt:   modifiedAt,
+	}
+}
+
+// Update modifies supplier data with validation
+func (s *Supplier) Update(name, code string, contact Contact, leadTimeDays int) error {
+	code = strings.ToUpper(code)
+	if err := validateSupplier(name, code, contact, leadTimeDays); err != nil {
+		return err
+	}
+
+	s.Name = name
+	s.Code = code
+	s.Contact = contact
+	s.LeadTimeDays = leadTimeDays
+	s.ModifiedAt = utc.NowAfter(s.ModifiedAt)
+	return nil
+}
+
+func validateSupplier(name, code string, contact Contact, leadTimeDays int) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSupplierData)
+	}
+	if len(name) > 255 {
+		return fmt.Errorf("%w: name is too long (max 255 characters)", ErrInvalidSupplierData)
+	}
+
+	if code == "" {
+		return fmt.Errorf("%w: code is required", ErrInvalidSupplierData)
+	}
+	if len(code) > maxCodeLength {
+		return fmt.Errorf("%w: code is too long (max %d characters)", ErrInvalidSupplierData, maxCodeLength)
+	}
+	if !codeRegex.MatchString(code) {
+		return fmt.Errorf("%w: code may only contain letters, digits, _ and -", ErrInvalidSupplierData)
+	}
+
+	if leadTimeDays < 0 || leadTimeDays > maxLeadTime {
+		return fmt.Errorf("%w: lead time must be between 0 and %d days", ErrInvalidSupplierData, maxLeadTime)
+	}
+
+	return validateContact(contact)
+}
+
+func validateContact(contact Contact) error {
+	if contact.Name != nil && len(*contact.Name) > 255 {
+		return fmt.Errorf("%w: contact name is too long (max 255 characters)", ErrInvalidSupplierData)
+	}
+	if contact.Email != nil {
+		if _, err := mail.ParseAddress(*contact.Email); err != nil {
+			return fmt.Errorf("%w: contact email is invalid", ErrInvalidSupplierData)
+		}
+	}
+	if contact.Phone != nil && len(*contact.Phone) > 32 {
+		return fmt.Errorf("%w: contact phone is too long (max 32 characters)", ErrInvalidSupplierData)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package supplier
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestNewSupplier(t *testing.T) {
+	tests := []struct {
+		name         string
+		supName      string
+		code         string
+		contact      Contact
+		leadTimeDays int
+		errContains  string
+	}{
+		{name: "valid supplier", supName: "Acme", code: "acme-eu_2", contact: Contact{Name: ptr("Jo"), Email: ptr("orders@acme.test"), Phone: ptr("+1 555 0100")}, leadTimeDays: 14},
+		{name: "no contact", supName: "Acme", code: "ACME"},
+		{name: "missing name", supName: "", code: "ACME", errContains: "name is required"},
+		{name: "missing code", supName: "Acme", code: "", errContains: "code is required"},
+		{name: "long code", supName: "Acme", code: strings.Repeat("A", 33), errContains: "code is too long"},
+		{name: "code with spaces", supName: "Acme", code: "AC ME", errContains: "code may only contain"},
+		{name: "negative lead time", supName: "Acme", code: "ACME", leadTimeDays: -1, errContains: "lead time"},
+		{name: "long lead time", supName: "Acme", code: "ACME", leadTimeDays: 366, errContains: "lead time"},
+		{name: "invalid email", supName: "Acme", code: "ACME", contact: Contact{Email: ptr("acme")}, errContains: "email is invalid"},
+		{name: "long phone", supName: "Acme", code: "ACME", contact: Contact{Phone: ptr(strings.Repeat("1", 33))}, errContains: "phone is too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSupplier(tt.supName, tt.code, tt.contact, tt.leadTimeDays)
+
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidSupplierData)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, s.ID)
+			assert.Equal(t, strings.ToUpper(tt.code), s.Code)
+		})
+	}
+}
+
+func TestSupplier_Update(t *testing.T) {
+	s, err := NewSupplier("Acme", "ACME", Contact{}, 7)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Update("Acme Corp", "acme-corp", Contact{Email: ptr("buy@acme.test")}, 10))
+	assert.Equal(t, "ACME-CORP", s.Code)
+	assert.Equal(t, 10, s.LeadTimeDays)
+
+	require.ErrorIs(t, s.Update("", "ACME", Contact{}, 7), ErrInvalidSupplierData)
+}
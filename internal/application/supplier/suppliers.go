@@ -0,0 +1,20 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+type suppliers struct {
+	repo Repository
+}
+
+// NewSuppliers lets product handlers check the suppliers products are linked to
+func NewSuppliers(repo Repository) product.Suppliers {
+	return &suppliers{repo: repo}
+}
+
+func (s *suppliers) Exists(ctx context.Context, supplierID string) (bool, error) {
+	return s.repo.Exists(ctx, supplierID)
+}
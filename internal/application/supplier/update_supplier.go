@@ -0,0 +1,68 @@
+package supplier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.uber.org/zap"
+)
+
+type UpdateSupplierCommand struct {
+	ID           string
+	Version      int
+	Name         string
+	Code         string
+	Contact      Contact
+	LeadTimeDays int
+}
+
+type UpdateSupplierCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateSupplierCommand) (*Supplier, error)
+}
+
+type updateSupplierHandler struct {
+	repo Repository
+}
+
+func NewUpdateSupplierHandler(repo Repository) UpdateSupplierCommandHandler {
+	return &updateSupplierHandler{repo: repo}
+}
+
+func (h *updateSupplierHandler) Handle(ctx context.Context, cmd UpdateSupplierCommand) (*Supplier, error) {
+	s, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			return nil, ErrSupplierNotFound
+		}
+		return nil, fmt.Errorf("failed to get supplier: %w", err)
+	}
+
+	if s.Version != cmd.Version {
+		return nil, mongo.ErrOptimisticLocking
+	}
+
+	if err := s.Update(cmd.Name, cmd.Code, cmd.Contact, cmd.LeadTimeDays); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.Update(ctx, s)
+	if err != nil {
+		switch {
+		case errors.Is(err, mongo.ErrOptimisticLocking):
+			return nil, mongo.ErrOptimisticLocking
+		case errors.Is(err, ErrCodeAlreadyExists):
+			return nil, ErrCodeAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to update supplier: %w", err)
+	}
+
+	h.log(ctx).Debug("supplier updated", zap.String("id", updated.ID))
+	return updated, nil
+}
+
+func (h *updateSupplierHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "update-supplier-handler"))
+}
@@ -0,0 +1,243 @@
+package taxonomy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+// BundleVersion is the version of the bundle format written by exports; imports accept only this version
+const BundleVersion = 1
+
+// Bundle is a portable copy of attribute and category definitions, exported as JSON from one environment
+// and imported into another. Images and palettes live in environment specific services and are left out.
+type Bundle struct {
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exportedAt"`
+	Attributes []AttributeEntry `json:"attributes"`
+	// Categories is set by taxonomy exports, together with the attributes they use
+	Categories []CategoryEntry `json:"categories,omitempty"`
+}
+
+// AttributeEntry describes an attribute of a bundle. Attributes are matched by slug on import,
+// the ID is kept when the attribute is created and not taken.
+type AttributeEntry struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Slug        string        `json:"slug"`
+	Type        string        `json:"type"`
+	Unit        *string       `json:"unit,omitempty"`
+	Enabled     bool          `json:"enabled"`
+	DisplayType string        `json:"displayType"`
+	InputUnits  []UnitEntry   `json:"inputUnits,omitempty"`
+	Options     []OptionEntry `json:"options,omitempty"`
+	// Bindings lists the categories using the attribute, when the export included them
+	Bindings []BindingEntry `json:"bindings,omitempty"`
+}
+
+type UnitEntry struct {
+	Unit   string  `json:"unit"`
+	Factor float64 `json:"factor"`
+}
+
+type OptionEntry struct {
+	Name            string  `json:"name"`
+	Slug            string  `json:"slug"`
+	ColorCode       *string `json:"colorCode,omitempty"`
+	Deprecated      bool    `json:"deprecated,omitempty"`
+	ReplacementSlug *string `json:"replacementSlug,omitempty"`
+}
+
+// BindingEntry is a category using the attribute of the entry. Categories are matched by ID on import,
+// CategoryName only helps reading the bundle.
+type BindingEntry struct {
+	CategoryID   string `json:"categoryId"`
+	CategoryName string `json:"categoryName"`
+	Usage
+}
+
+// Usage describes how a category uses an attribute
+type Usage struct {
+	Role       string `json:"role"`
+	SortOrder  int    `json:"sortOrder"`
+	Filterable bool   `json:"filterable,omitempty"`
+	Searchable bool   `json:"searchable,omitempty"`
+	Required   bool   `json:"required,omitempty"`
+}
+
+// CategoryEntry describes a category of a bundle. Categories are matched by ID on import and
+// refer to their attributes by slug.
+type CategoryEntry struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Enabled     bool                     `json:"enabled"`
+	Description *string                  `json:"description,omitempty"`
+	Template    string                   `json:"template,omitempty"`
+	Attributes  []CategoryAttributeEntry `json:"attributes,omitempty"`
+}
+
+type CategoryAttributeEntry struct {
+	AttributeSlug string `json:"attributeSlug"`
+	Usage
+}
+
+func toAttributeEntry(a *attribute.Attribute) AttributeEntry {
+	e := AttributeEntry{
+		ID:          a.ID,
+		Name:        a.Name,
+		Slug:        a.Slug,
+		Type:        string(a.Type),
+		Unit:        a.Unit,
+		Enabled:     a.Enabled,
+		DisplayType: string(a.DisplayType),
+	}
+	for _, u := range a.InputUnits {
+		e.InputUnits = append(e.InputUnits, UnitEntry{Unit: u.Unit, Factor: u.Factor})
+	}
+	for _, opt := range a.Options {
+		e.Options = append(e.Options, OptionEntry{
+			Name:            opt.Name,
+			Slug:            opt.Slug,
+			ColorCode:       opt.ColorCode,
+			Deprecated:      opt.Deprecated,
+			ReplacementSlug: opt.ReplacementSlug,
+		})
+	}
+	return e
+}
+
+func toCategoryEntry(c *category.Category) CategoryEntry {
+	e := CategoryEntry{
+		ID:          c.ID,
+		Name:        c.Name,
+		Enabled:     c.Enabled,
+		Description: c.Display.Description,
+		Template:    string(c.Display.Template),
+	}
+	for _, ca := range c.Attributes {
+		e.Attributes = append(e.Attributes, CategoryAttributeEntry{AttributeSlug: ca.Slug, Usage: toUsage(ca)})
+	}
+	return e
+}
+
+func toBindingEntry(c *category.Category, ca category.CategoryAttribute) BindingEntry {
+	return BindingEntry{CategoryID: c.ID, CategoryName: c.Name, Usage: toUsage(ca)}
+}
+
+func toUsage(ca category.CategoryAttribute) Usage {
+	return Usage{
+		Role:       string(ca.Role),
+		SortOrder:  ca.SortOrder,
+		Filterable: ca.Filterable,
+		Searchable: ca.Searchable,
+		Required:   ca.Required,
+	}
+}
+
+// toCategoryAttribute returns the use of attribute a by a category. The role is validated with the category.
+func (u Usage) toCategoryAttribute(a *attribute.Attribute) (category.CategoryAttribute, error) {
+	role := category.AttributeRole(u.Role)
+	if role.CreatesVariants() && !a.HasOptions() {
+		return category.CategoryAttribute{}, fmt.Errorf("%w: attribute %s has type %s and can't be a variant, only single and multiple attributes can",
+			category.ErrInvalidCategoryData, a.Slug, a.Type)
+	}
+	return category.CategoryAttribute{
+		AttributeID: a.ID,
+		Slug:        a.Slug,
+		Role:        role,
+		SortOrder:   u.SortOrder,
+		Filterable:  u.Filterable,
+		Searchable:  u.Searchable,
+		Required:    u.Required,
+	}, nil
+}
+
+// newAttribute creates the attribute of the entry with the given ID and slug
+func newAttribute(l limits.Attribute, id, slug string, e AttributeEntry) (*attribute.Attribute, error) {
+	options := make([]attribute.Option, len(e.Options))
+	for i, opt := range e.Options {
+		options[i] = attribute.Option{Name: opt.Name, Slug: opt.Slug, ColorCode: opt.ColorCode, SortOrder: i}
+	}
+	a, err := attribute.NewAttribute(l, id, e.Name, slug, attribute.AttributeType(e.Type), e.Unit, e.Enabled, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := changeDisplayType(a, e); err != nil {
+		return nil, err
+	}
+	if err := applyDefinition(l, a, e); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// overwriteAttribute replaces the definition of a stored attribute with the entry. The type can't change;
+// the option images and the palette of the stored attribute are kept when its display type stays.
+func overwriteAttribute(l limits.Attribute, a *attribute.Attribute, e AttributeEntry) error {
+	if attribute.AttributeType(e.Type) != a.Type {
+		return fmt.Errorf("%w: attribute %s has type %s, the bundle has %s", attribute.ErrInvalidAttributeData, a.Slug, a.Type, e.Type)
+	}
+
+	options := make([]attribute.Option, len(e.Options))
+	for i, opt := range e.Options {
+		options[i] = attribute.Option{Name: opt.Name, Slug: opt.Slug, ColorCode: opt.ColorCode, SortOrder: i}
+	}
+	// Deprecations are replaced by the ones of the bundle below
+	for _, opt := range a.Options {
+		if opt.Deprecated {
+			if err := a.DeprecateOption(opt.Slug, false, nil); err != nil {
+				return err
+			}
+		}
+	}
+	// Swatch options need a color code or an image, so a swatch is only left before its options change
+	// and only entered after
+	leavesSwatch := a.DisplayType == attribute.DisplayTypeSwatch
+	if leavesSwatch {
+		if err := changeDisplayType(a, e); err != nil {
+			return err
+		}
+	}
+	if err := a.Update(l, e.Name, e.Unit, e.Enabled, options); err != nil {
+		return err
+	}
+	if !leavesSwatch {
+		if err := changeDisplayType(a, e); err != nil {
+			return err
+		}
+	}
+	return applyDefinition(l, a, e)
+}
+
+// changeDisplayType sets the display type of the entry, if any and different. A new display type
+// drops the option images and the palette.
+func changeDisplayType(a *attribute.Attribute, e AttributeEntry) error {
+	displayType := attribute.DisplayType(e.DisplayType)
+	if displayType == "" || displayType == a.DisplayType {
+		return nil
+	}
+	return a.ChangeDisplay(displayType, nil, nil)
+}
+
+// applyDefinition sets the input units and option deprecations of the entry
+func applyDefinition(l limits.Attribute, a *attribute.Attribute, e AttributeEntry) error {
+	inputUnits := make([]attribute.UnitConversion, len(e.InputUnits))
+	for i, u := range e.InputUnits {
+		inputUnits[i] = attribute.UnitConversion{Unit: u.Unit, Factor: u.Factor}
+	}
+	if err := a.ChangeInputUnits(l, inputUnits); err != nil {
+		return err
+	}
+
+	for _, opt := range e.Options {
+		if opt.Deprecated {
+			if err := a.DeprecateOption(opt.Slug, true, opt.ReplacementSlug); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
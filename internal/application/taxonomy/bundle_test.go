@@ -0,0 +1,81 @@
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+// defaultLimits are the attribute limits the tests validate against
+var defaultLimits = limits.Default().Attribute
+
+func newColorSwatch(t *testing.T) *attribute.Attribute {
+	t.Helper()
+	red := "#FF0000"
+	a, err := attribute.NewAttribute(defaultLimits, "", "Color", "color", attribute.AttributeTypeSingle, nil, true, []attribute.Option{
+		{Name: "Red", Slug: "red", ColorCode: &red},
+	})
+	require.NoError(t, err)
+	require.NoError(t, a.ChangeDisplay(attribute.DisplayTypeSwatch, nil, map[string]string{"red": "image-red"}))
+	return a
+}
+
+func TestEntry_RoundTrip(t *testing.T) {
+	a := newColorSwatch(t)
+	require.NoError(t, a.Update(defaultLimits, "Color", nil, true, []attribute.Option{
+		{Name: "Red", Slug: "red", ColorCode: a.Options[0].ColorCode},
+		{Name: "Crimson", Slug: "crimson", ColorCode: a.Options[0].ColorCode},
+	}))
+	require.NoError(t, a.DeprecateOption("crimson", true, ptr("red")))
+
+	restored, err := newAttribute(defaultLimits, a.ID, a.Slug, toAttributeEntry(a))
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID, restored.ID)
+	assert.Equal(t, attribute.DisplayTypeSwatch, restored.DisplayType)
+	crimson, _ := restored.FindOption("crimson")
+	assert.True(t, crimson.Deprecated)
+	assert.Equal(t, ptr("red"), crimson.ReplacementSlug)
+	assert.Nil(t, restored.Options[0].ImageID, "images stay in the source environment")
+}
+
+func TestOverwriteAttribute(t *testing.T) {
+	t.Run("leaves a swatch before its options change", func(t *testing.T) {
+		a := newColorSwatch(t)
+
+		err := overwriteAttribute(defaultLimits, a, AttributeEntry{
+			Name: "Colour", Type: "single", Enabled: true, DisplayType: string(attribute.DisplayTypeDropdown),
+			Options: []OptionEntry{{Name: "Blue", Slug: "blue"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Colour", a.Name)
+		assert.Equal(t, attribute.DisplayTypeDropdown, a.DisplayType)
+	})
+
+	t.Run("keeps the images of a swatch", func(t *testing.T) {
+		a := newColorSwatch(t)
+
+		err := overwriteAttribute(defaultLimits, a, AttributeEntry{
+			Name: "Color", Type: "single", Enabled: true, DisplayType: string(attribute.DisplayTypeSwatch),
+			Options: []OptionEntry{{Name: "Red", Slug: "red"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ptr("image-red"), a.Options[0].ImageID)
+	})
+
+	t.Run("rejects another type", func(t *testing.T) {
+		a := newColorSwatch(t)
+
+		err := overwriteAttribute(defaultLimits, a, AttributeEntry{Name: "Color", Type: "text", Enabled: true})
+		require.ErrorIs(t, err, attribute.ErrInvalidAttributeData)
+		assert.Equal(t, "Color", a.Name)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,5 @@
+package taxonomy
+
+import "errors"
+
+var ErrInvalidBundle = errors.New("invalid taxonomy bundle")
@@ -0,0 +1,139 @@
+package taxonomy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// exportPageSize is the page size of the scans of an export
+const exportPageSize = 200
+
+type ExportAttributesQuery struct {
+	// IDs selects the exported attributes; all attributes are exported when empty
+	IDs []string
+	// IncludeBindings adds the categories using each attribute
+	IncludeBindings bool
+}
+
+type ExportAttributesQueryHandler interface {
+	// Handle returns a bundle of the attributes sorted by slug. An unknown ID fails with mongo.ErrEntityNotFound.
+	Handle(ctx context.Context, query ExportAttributesQuery) (*Bundle, error)
+}
+
+type exportAttributesHandler struct {
+	attributeRepo attribute.Repository
+	categoryRepo  category.Repository
+}
+
+func NewExportAttributesHandler(attributeRepo attribute.Repository, categoryRepo category.Repository) ExportAttributesQueryHandler {
+	return &exportAttributesHandler{attributeRepo: attributeRepo, categoryRepo: categoryRepo}
+}
+
+func (h *exportAttributesHandler) Handle(ctx context.Context, query ExportAttributesQuery) (*Bundle, error) {
+	attrs, err := h.findAttributes(ctx, query.IDs)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(attrs, func(a, b *attribute.Attribute) int { return strings.Compare(a.Slug, b.Slug) })
+
+	entries := make([]AttributeEntry, len(attrs))
+	for i, a := range attrs {
+		entries[i] = toAttributeEntry(a)
+	}
+
+	if query.IncludeBindings {
+		bindings, err := h.findBindings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range entries {
+			entries[i].Bindings = bindings[entries[i].ID]
+		}
+	}
+
+	h.log(ctx).Debug("attributes exported", zap.Int("attributes", len(entries)), zap.Bool("bindings", query.IncludeBindings))
+
+	return &Bundle{Version: BundleVersion, ExportedAt: utc.Now(), Attributes: entries}, nil
+}
+
+func (h *exportAttributesHandler) findAttributes(ctx context.Context, ids []string) ([]*attribute.Attribute, error) {
+	if len(ids) > 0 {
+		ids = lo.Uniq(ids)
+		attrs, err := h.attributeRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attributes: %w", err)
+		}
+		if len(attrs) != len(ids) {
+			found := lo.KeyBy(attrs, func(a *attribute.Attribute) string { return a.ID })
+			missing, _ := lo.Find(ids, func(id string) bool { return found[id] == nil })
+			return nil, fmt.Errorf("%w: attribute %s", mongo.ErrEntityNotFound, missing)
+		}
+		return attrs, nil
+	}
+	return listAttributes(ctx, h.attributeRepo)
+}
+
+// findBindings scans the categories for the bindings of every attribute, by attribute ID
+func (h *exportAttributesHandler) findBindings(ctx context.Context) (map[string][]BindingEntry, error) {
+	categories, err := listCategories(ctx, h.categoryRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make(map[string][]BindingEntry)
+	for _, c := range categories {
+		for _, ca := range c.Attributes {
+			bindings[ca.AttributeID] = append(bindings[ca.AttributeID], toBindingEntry(c, ca))
+		}
+	}
+	return bindings, nil
+}
+
+// listAttributes scans all attributes
+func listAttributes(ctx context.Context, repo attribute.Repository) ([]*attribute.Attribute, error) {
+	var attrs []*attribute.Attribute
+	afterID := ""
+	for {
+		page, err := repo.FindList(ctx, attribute.ListQuery{AfterID: afterID, Size: exportPageSize, Sort: "_id"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attributes: %w", err)
+		}
+		attrs = append(attrs, page.Items...)
+		if len(page.Items) < exportPageSize {
+			return attrs, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+// listCategories scans all categories
+func listCategories(ctx context.Context, repo category.Repository) ([]*category.Category, error) {
+	var categories []*category.Category
+	afterID := ""
+	for {
+		page, err := repo.FindList(ctx, category.ListQuery{AfterID: afterID, Size: exportPageSize, Sort: "_id"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		categories = append(categories, page.Items...)
+		if len(page.Items) < exportPageSize {
+			return categories, nil
+		}
+		afterID = page.Items[len(page.Items)-1].ID
+	}
+}
+
+func (h *exportAttributesHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "export-attributes-handler"))
+}
@@ -0,0 +1,93 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ExportTaxonomyQuery struct {
+	// CategoryIDs selects the exported categories; all categories are exported when empty
+	CategoryIDs []string
+}
+
+type ExportTaxonomyQueryHandler interface {
+	// Handle returns a bundle of the categories sorted by name and of the attributes they use sorted by slug.
+	// An unknown ID fails with mongo.ErrEntityNotFound.
+	Handle(ctx context.Context, query ExportTaxonomyQuery) (*Bundle, error)
+}
+
+type exportTaxonomyHandler struct {
+	attributeRepo attribute.Repository
+	categoryRepo  category.Repository
+}
+
+func NewExportTaxonomyHandler(attributeRepo attribute.Repository, categoryRepo category.Repository) ExportTaxonomyQueryHandler {
+	return &exportTaxonomyHandler{attributeRepo: attributeRepo, categoryRepo: categoryRepo}
+}
+
+func (h *exportTaxonomyHandler) Handle(ctx context.Context, query ExportTaxonomyQuery) (*Bundle, error) {
+	categories, err := h.findCategories(ctx, query.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(categories, func(a, b *category.Category) int { return strings.Compare(a.Name, b.Name) })
+
+	var attrIDs []string
+	for _, c := range categories {
+		for _, ca := range c.Attributes {
+			attrIDs = append(attrIDs, ca.AttributeID)
+		}
+	}
+	attrs, err := h.attributeRepo.FindByIDs(ctx, lo.Uniq(attrIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	slices.SortFunc(attrs, func(a, b *attribute.Attribute) int { return strings.Compare(a.Slug, b.Slug) })
+
+	bundle := &Bundle{
+		Version:    BundleVersion,
+		ExportedAt: utc.Now(),
+		Attributes: lo.Map(attrs, func(a *attribute.Attribute, _ int) AttributeEntry { return toAttributeEntry(a) }),
+		Categories: lo.Map(categories, func(c *category.Category, _ int) CategoryEntry { return toCategoryEntry(c) }),
+	}
+
+	h.log(ctx).Debug("taxonomy exported", zap.Int("categories", len(bundle.Categories)), zap.Int("attributes", len(bundle.Attributes)))
+
+	return bundle, nil
+}
+
+func (h *exportTaxonomyHandler) findCategories(ctx context.Context, ids []string) ([]*category.Category, error) {
+	if len(ids) == 0 {
+		return listCategories(ctx, h.categoryRepo)
+	}
+
+	ids = lo.Uniq(ids)
+	categories := make([]*category.Category, len(ids))
+	for i, id := range ids {
+		c, err := h.categoryRepo.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, mongo.ErrEntityNotFound) {
+				return nil, fmt.Errorf("%w: category %s", mongo.ErrEntityNotFound, id)
+			}
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+		categories[i] = c
+	}
+	return categories, nil
+}
+
+func (h *exportTaxonomyHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "export-taxonomy-handler"))
+}
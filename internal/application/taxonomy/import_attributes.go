@@ -0,0 +1,352 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// maxBundleAttributes bounds the attributes of an imported bundle
+const maxBundleAttributes = 1000
+
+// maxRenameAttempts bounds the numbered slugs tried for a renamed attribute
+const maxRenameAttempts = 100
+
+// ConflictStrategy tells what an import does with an attribute whose slug already exists
+type ConflictStrategy string
+
+const (
+	// ConflictSkip keeps the stored attribute and its category bindings unchanged
+	ConflictSkip ConflictStrategy = "skip"
+	// ConflictOverwrite replaces the definition of the stored attribute with the one of the bundle
+	ConflictOverwrite ConflictStrategy = "overwrite"
+	// ConflictRename creates the attribute of the bundle with a numbered slug, such as size-2
+	ConflictRename ConflictStrategy = "rename"
+)
+
+// IsValid reports whether the strategy is known
+func (s ConflictStrategy) IsValid() bool {
+	switch s {
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+		return true
+	}
+	return false
+}
+
+type ImportAttributesCommand struct {
+	Bundle   Bundle
+	Conflict ConflictStrategy
+}
+
+// ImportAction tells what an import did with an entity of the bundle
+type ImportAction string
+
+const (
+	ImportCreated ImportAction = "created"
+	ImportUpdated ImportAction = "updated"
+	ImportSkipped ImportAction = "skipped"
+	ImportRenamed ImportAction = "renamed"
+	// ImportUnchanged means the stored entity already matched the bundle and wasn't written
+	ImportUnchanged ImportAction = "unchanged"
+)
+
+// AttributeImportResult is the outcome of an attribute of the bundle
+type AttributeImportResult struct {
+	// Slug is the slug of the attribute in the bundle; a renamed attribute has another slug
+	Slug      string
+	Attribute *attribute.Attribute
+	Action    ImportAction
+	// BoundCategories is the number of categories the attribute was bound to
+	BoundCategories int
+	// MissingCategories lists the IDs of the categories of bindings that don't exist in this environment
+	MissingCategories []string
+}
+
+type ImportAttributesCommandHandler interface {
+	// Handle stores the attributes of the bundle and their category bindings in one transaction and
+	// returns their outcomes in bundle order. Attributes are matched by slug and conflicts are resolved
+	// with the strategy of the command; any invalid attribute or binding fails the whole import.
+	// A binding replaces the one of the category for the same attribute; bindings to categories that
+	// don't exist are reported and skipped.
+	Handle(ctx context.Context, cmd ImportAttributesCommand) ([]AttributeImportResult, error)
+}
+
+type importAttributesHandler struct {
+	store
+	txManager mongo.TxManager
+	quotas    quota.Enforcer
+	limits    limits.Limits
+}
+
+func NewImportAttributesHandler(
+	attributeRepo attribute.Repository,
+	categoryRepo category.Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	attributeEvents attribute.AttributeEventFactory,
+	categoryEvents category.CategoryEventFactory,
+	quotas quota.Enforcer,
+	l limits.Limits,
+) ImportAttributesCommandHandler {
+	return &importAttributesHandler{
+		store: store{
+			attributeRepo:   attributeRepo,
+			categoryRepo:    categoryRepo,
+			outbox:          outbox,
+			attributeEvents: attributeEvents,
+			categoryEvents:  categoryEvents,
+		},
+		txManager: txManager,
+		quotas:    quotas,
+		limits:    l,
+	}
+}
+
+func (h *importAttributesHandler) Handle(ctx context.Context, cmd ImportAttributesCommand) ([]AttributeImportResult, error) {
+	if err := validateImport(cmd); err != nil {
+		return nil, err
+	}
+
+	type importResult struct {
+		Results []AttributeImportResult
+		Sends   []outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*importResult, error) {
+		results, sends, err := h.importAttributes(txCtx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		bindingSends, err := h.importBindings(txCtx, cmd.Bundle.Attributes, results)
+		if err != nil {
+			return nil, err
+		}
+		return &importResult{Results: results, Sends: append(sends, bindingSends...)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("attributes imported", zap.Int("attributes", len(res.Results)), zap.String("conflict", string(cmd.Conflict)))
+
+	for _, send := range res.Sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	return res.Results, nil
+}
+
+func validateImport(cmd ImportAttributesCommand) error {
+	if cmd.Bundle.Version != BundleVersion {
+		return fmt.Errorf("%w: unsupported version %d, expected %d", ErrInvalidBundle, cmd.Bundle.Version, BundleVersion)
+	}
+	if !cmd.Conflict.IsValid() {
+		return fmt.Errorf("%w: unknown conflict strategy %q", ErrInvalidBundle, cmd.Conflict)
+	}
+	if len(cmd.Bundle.Attributes) > maxBundleAttributes {
+		return fmt.Errorf("%w: at most %d attributes can be imported at once", ErrInvalidBundle, maxBundleAttributes)
+	}
+
+	seen := make(map[string]bool, len(cmd.Bundle.Attributes))
+	for _, e := range cmd.Bundle.Attributes {
+		if seen[e.Slug] {
+			return fmt.Errorf("%w: attribute %s is repeated", ErrInvalidBundle, e.Slug)
+		}
+		seen[e.Slug] = true
+	}
+	return nil
+}
+
+// importAttributes stores the attributes of the bundle together with their events
+func (h *importAttributesHandler) importAttributes(ctx context.Context, cmd ImportAttributesCommand) ([]AttributeImportResult, []outbox.SendFunc, error) {
+	entries := cmd.Bundle.Attributes
+	slugs := lo.Map(entries, func(e AttributeEntry, _ int) string { return e.Slug })
+	existing, err := h.attributeRepo.FindBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	bySlug := lo.KeyBy(existing, func(a *attribute.Attribute) string { return a.Slug })
+
+	created := len(entries) - len(existing)
+	if cmd.Conflict == ConflictRename {
+		created = len(entries)
+	}
+	if err := h.quotas.Check(ctx, quota.Attributes, created); err != nil {
+		return nil, nil, err
+	}
+
+	// Renamed attributes avoid the slugs of the bundle as well as the stored ones
+	taken := lo.SliceToMap(slugs, func(slug string) (string, bool) { return slug, true })
+
+	results := make([]AttributeImportResult, len(entries))
+	var sends []outbox.SendFunc
+	for i, e := range entries {
+		results[i].Slug = e.Slug
+
+		stored, exists := bySlug[e.Slug]
+		var send outbox.SendFunc
+		switch {
+		case !exists:
+			results[i].Attribute, send, err = h.create(ctx, e, e.Slug, true)
+			results[i].Action = ImportCreated
+		case cmd.Conflict == ConflictSkip:
+			results[i].Attribute, results[i].Action = stored, ImportSkipped
+		case cmd.Conflict == ConflictOverwrite:
+			results[i].Attribute, send, err = h.overwrite(ctx, stored, e)
+			results[i].Action = ImportUpdated
+		default:
+			var slug string
+			if slug, err = h.freeSlug(ctx, e.Slug, taken); err == nil {
+				taken[slug] = true
+				results[i].Attribute, send, err = h.create(ctx, e, slug, false)
+				results[i].Action = ImportRenamed
+			}
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to import attribute %s: %w", e.Slug, err)
+		}
+		if send != nil {
+			sends = append(sends, send)
+		}
+	}
+	return results, sends, nil
+}
+
+// create inserts the attribute of the entry; it keeps the ID of the entry when asked to and the ID is free
+func (h *importAttributesHandler) create(ctx context.Context, e AttributeEntry, slug string, keepID bool) (*attribute.Attribute, outbox.SendFunc, error) {
+	id := ""
+	if keepID && e.ID != "" {
+		taken, err := h.attributeRepo.Exists(ctx, e.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check attribute ID: %w", err)
+		}
+		if !taken {
+			id = e.ID
+		}
+	}
+
+	a, err := newAttribute(h.limits.Attribute, id, slug, e)
+	if err != nil {
+		return nil, nil, err
+	}
+	send, err := h.insertAttribute(ctx, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, send, nil
+}
+
+func (h *importAttributesHandler) overwrite(ctx context.Context, a *attribute.Attribute, e AttributeEntry) (*attribute.Attribute, outbox.SendFunc, error) {
+	before := a.Options
+	if err := overwriteAttribute(h.limits.Attribute, a, e); err != nil {
+		return nil, nil, err
+	}
+	return h.updateAttribute(ctx, a, before)
+}
+
+// freeSlug returns the first numbered variant of the slug that is neither stored nor taken
+func (h *importAttributesHandler) freeSlug(ctx context.Context, slug string, taken map[string]bool) (string, error) {
+	candidates := make([]string, 0, maxRenameAttempts)
+	for n := 2; len(candidates) < maxRenameAttempts; n++ {
+		candidates = append(candidates, slug+"-"+strconv.Itoa(n))
+	}
+
+	stored, err := h.attributeRepo.FindBySlugs(ctx, candidates)
+	if err != nil {
+		return "", fmt.Errorf("failed to get attributes: %w", err)
+	}
+	storedSlugs := lo.SliceToMap(stored, func(a *attribute.Attribute) (string, bool) { return a.Slug, true })
+
+	for _, candidate := range candidates {
+		if !taken[candidate] && !storedSlugs[candidate] {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no free slug left for %s", attribute.ErrSlugAlreadyExists, slug)
+}
+
+// binding is a binding of the bundle resolved to the imported attribute
+type binding struct {
+	result int
+	entry  BindingEntry
+}
+
+// importBindings binds the imported attributes to the existing categories of their bindings, together
+// with the category events. Skipped attributes keep the bindings they have.
+func (h *importAttributesHandler) importBindings(ctx context.Context, entries []AttributeEntry, results []AttributeImportResult) ([]outbox.SendFunc, error) {
+	byCategory := make(map[string][]binding)
+	for i, e := range entries {
+		if results[i].Action == ImportSkipped {
+			continue
+		}
+		for _, b := range e.Bindings {
+			byCategory[b.CategoryID] = append(byCategory[b.CategoryID], binding{result: i, entry: b})
+		}
+	}
+
+	categoryIDs := lo.Keys(byCategory)
+	slices.Sort(categoryIDs)
+
+	var sends []outbox.SendFunc
+	for _, categoryID := range categoryIDs {
+		bindings := byCategory[categoryID]
+		c, err := h.categoryRepo.FindByID(ctx, categoryID)
+		if errors.Is(err, mongo.ErrEntityNotFound) {
+			for _, b := range bindings {
+				results[b.result].MissingCategories = append(results[b.result].MissingCategories, categoryID)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+
+		send, err := h.bind(ctx, c, bindings, results)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind attributes to category %s: %w", c.Name, err)
+		}
+		for _, b := range bindings {
+			results[b.result].BoundCategories++
+		}
+		sends = append(sends, send)
+	}
+	return sends, nil
+}
+
+func (h *importAttributesHandler) bind(ctx context.Context, c *category.Category, bindings []binding, results []AttributeImportResult) (outbox.SendFunc, error) {
+	categoryAttrs := slices.Clone(c.Attributes)
+	for _, b := range bindings {
+		a := results[b.result].Attribute
+		ca, err := b.entry.toCategoryAttribute(a)
+		if err != nil {
+			return nil, err
+		}
+		if i := slices.IndexFunc(categoryAttrs, func(existing category.CategoryAttribute) bool { return existing.AttributeID == a.ID }); i >= 0 {
+			categoryAttrs[i] = ca
+		} else {
+			categoryAttrs = append(categoryAttrs, ca)
+		}
+	}
+
+	if err := c.Update(h.limits.Category, c.Name, c.Enabled, categoryAttrs); err != nil {
+		return nil, err
+	}
+	return h.updateCategory(ctx, c)
+}
+
+func (h *importAttributesHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "import-attributes-handler"))
+}
@@ -0,0 +1,413 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// maxBundleCategories bounds the categories of an imported bundle
+const maxBundleCategories = 1000
+
+// EntityKind names the kind of entity of a change
+type EntityKind string
+
+const (
+	EntityAttribute EntityKind = "attribute"
+	EntityCategory  EntityKind = "category"
+)
+
+// Change is what a taxonomy import does, or would do in a dry run, with an entity of the bundle
+type Change struct {
+	Entity EntityKind
+	// ID is the ID of the stored entity, or the one it is created with
+	ID string
+	// Key is the slug of an attribute or the name of a category
+	Key    string
+	Action ImportAction
+	// Fields names the fields an update changes, in the JSON names of the bundle
+	Fields []string
+}
+
+type ImportTaxonomyCommand struct {
+	Bundle Bundle
+	// DryRun returns the changes without storing them
+	DryRun bool
+}
+
+type ImportTaxonomyCommandHandler interface {
+	// Handle makes the stored attributes and categories match the bundle and returns the changes,
+	// attributes first, each in bundle order. Attributes are matched by slug and categories by ID;
+	// created ones keep the ID of the bundle when it is free. Entities left out of the bundle are kept.
+	// Categories have no parent, so an import never moves one.
+	//
+	// Everything is stored in one transaction, and an entity failing the checks fails the whole import,
+	// a dry run included, so a successful dry run tells the import would succeed on the same data.
+	Handle(ctx context.Context, cmd ImportTaxonomyCommand) ([]Change, error)
+}
+
+type importTaxonomyHandler struct {
+	store
+	txManager mongo.TxManager
+	quotas    quota.Enforcer
+	limits    limits.Limits
+}
+
+func NewImportTaxonomyHandler(
+	attributeRepo attribute.Repository,
+	categoryRepo category.Repository,
+	outbox outbox.Outbox,
+	txManager mongo.TxManager,
+	attributeEvents attribute.AttributeEventFactory,
+	categoryEvents category.CategoryEventFactory,
+	quotas quota.Enforcer,
+	l limits.Limits,
+) ImportTaxonomyCommandHandler {
+	return &importTaxonomyHandler{
+		store: store{
+			attributeRepo:   attributeRepo,
+			categoryRepo:    categoryRepo,
+			outbox:          outbox,
+			attributeEvents: attributeEvents,
+			categoryEvents:  categoryEvents,
+		},
+		txManager: txManager,
+		quotas:    quotas,
+		limits:    l,
+	}
+}
+
+// plannedAttribute is an attribute of the bundle as it is to be stored
+type plannedAttribute struct {
+	change Change
+	// attribute is the created attribute, or a copy of the stored one with the changes of the bundle
+	attribute *attribute.Attribute
+	// before holds the options of the stored attribute
+	before []attribute.Option
+}
+
+type plannedCategory struct {
+	change   Change
+	category *category.Category
+}
+
+type taxonomyPlan struct {
+	attributes []plannedAttribute
+	categories []plannedCategory
+}
+
+func (p *taxonomyPlan) changes() []Change {
+	changes := make([]Change, 0, len(p.attributes)+len(p.categories))
+	for _, a := range p.attributes {
+		changes = append(changes, a.change)
+	}
+	for _, c := range p.categories {
+		changes = append(changes, c.change)
+	}
+	return changes
+}
+
+func (p *taxonomyPlan) count(entity EntityKind, action ImportAction) int {
+	return lo.CountBy(p.changes(), func(c Change) bool { return c.Entity == entity && c.Action == action })
+}
+
+func (h *importTaxonomyHandler) Handle(ctx context.Context, cmd ImportTaxonomyCommand) ([]Change, error) {
+	if err := validateTaxonomy(cmd.Bundle); err != nil {
+		return nil, err
+	}
+
+	if cmd.DryRun {
+		plan, err := h.plan(ctx, cmd.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		return plan.changes(), nil
+	}
+
+	type importResult struct {
+		Changes []Change
+		Sends   []outbox.SendFunc
+	}
+
+	res, err := mongo.WithTransaction(ctx, h.txManager, func(txCtx context.Context) (*importResult, error) {
+		plan, err := h.plan(txCtx, cmd.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		sends, err := h.apply(txCtx, plan)
+		if err != nil {
+			return nil, err
+		}
+		return &importResult{Changes: plan.changes(), Sends: sends}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.log(ctx).Debug("taxonomy imported", zap.Int("changes", len(res.Changes)), zap.Int("written", len(res.Sends)))
+
+	for _, send := range res.Sends {
+		_ = send(ctx) //nolint:errcheck // best-effort send, errors already logged in outbox
+	}
+
+	return res.Changes, nil
+}
+
+func validateTaxonomy(b Bundle) error {
+	if b.Version != BundleVersion {
+		return fmt.Errorf("%w: unsupported version %d, expected %d", ErrInvalidBundle, b.Version, BundleVersion)
+	}
+	if len(b.Attributes) > maxBundleAttributes {
+		return fmt.Errorf("%w: at most %d attributes can be imported at once", ErrInvalidBundle, maxBundleAttributes)
+	}
+	if len(b.Categories) > maxBundleCategories {
+		return fmt.Errorf("%w: at most %d categories can be imported at once", ErrInvalidBundle, maxBundleCategories)
+	}
+
+	if slug, ok := firstRepeated(lo.Map(b.Attributes, func(e AttributeEntry, _ int) string { return e.Slug })); ok {
+		return fmt.Errorf("%w: attribute %s is repeated", ErrInvalidBundle, slug)
+	}
+	if id, ok := firstRepeated(lo.Map(b.Categories, func(e CategoryEntry, _ int) string { return e.ID })); ok {
+		return fmt.Errorf("%w: category %s is repeated", ErrInvalidBundle, id)
+	}
+	for _, e := range b.Categories {
+		if e.ID == "" {
+			return fmt.Errorf("%w: category %s has no ID", ErrInvalidBundle, e.Name)
+		}
+	}
+	return nil
+}
+
+func firstRepeated(keys []string) (string, bool) {
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			return key, true
+		}
+		seen[key] = true
+	}
+	return "", false
+}
+
+// plan checks the bundle against the stored entities and builds the entities to store, without writing
+func (h *importTaxonomyHandler) plan(ctx context.Context, b Bundle) (*taxonomyPlan, error) {
+	slugs := lo.Map(b.Attributes, func(e AttributeEntry, _ int) string { return e.Slug })
+	for _, c := range b.Categories {
+		for _, ca := range c.Attributes {
+			slugs = append(slugs, ca.AttributeSlug)
+		}
+	}
+	stored, err := h.attributeRepo.FindBySlugs(ctx, lo.Uniq(slugs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	bySlug := lo.KeyBy(stored, func(a *attribute.Attribute) string { return a.Slug })
+
+	plan := &taxonomyPlan{}
+	for _, e := range b.Attributes {
+		planned, err := h.planAttribute(ctx, e, bySlug[e.Slug])
+		if err != nil {
+			return nil, fmt.Errorf("failed to import attribute %s: %w", e.Slug, err)
+		}
+		plan.attributes = append(plan.attributes, planned)
+		bySlug[e.Slug] = planned.attribute
+	}
+
+	for _, e := range b.Categories {
+		planned, err := h.planCategory(ctx, e, bySlug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import category %s: %w", e.Name, err)
+		}
+		plan.categories = append(plan.categories, planned)
+	}
+
+	if err := h.quotas.Check(ctx, quota.Attributes, plan.count(EntityAttribute, ImportCreated)); err != nil {
+		return nil, err
+	}
+	if err := h.quotas.Check(ctx, quota.Categories, plan.count(EntityCategory, ImportCreated)); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (h *importTaxonomyHandler) planAttribute(ctx context.Context, e AttributeEntry, stored *attribute.Attribute) (plannedAttribute, error) {
+	change := Change{Entity: EntityAttribute, Key: e.Slug}
+
+	if stored == nil {
+		id := ""
+		if e.ID != "" {
+			taken, err := h.attributeRepo.Exists(ctx, e.ID)
+			if err != nil {
+				return plannedAttribute{}, fmt.Errorf("failed to check attribute ID: %w", err)
+			}
+			if !taken {
+				id = e.ID
+			}
+		}
+		a, err := newAttribute(h.limits.Attribute, id, e.Slug, e)
+		if err != nil {
+			return plannedAttribute{}, err
+		}
+		change.ID, change.Action = a.ID, ImportCreated
+		return plannedAttribute{change: change, attribute: a}, nil
+	}
+
+	change.ID = stored.ID
+	change.Fields = attributeChanges(toAttributeEntry(stored), e)
+	if len(change.Fields) == 0 {
+		change.Action = ImportUnchanged
+		return plannedAttribute{change: change, attribute: stored}, nil
+	}
+
+	// The domain methods replace the slices of the attribute, so the copy leaves the stored one as it is
+	a := *stored
+	if err := overwriteAttribute(h.limits.Attribute, &a, e); err != nil {
+		return plannedAttribute{}, err
+	}
+	change.Action = ImportUpdated
+	return plannedAttribute{change: change, attribute: &a, before: stored.Options}, nil
+}
+
+func (h *importTaxonomyHandler) planCategory(ctx context.Context, e CategoryEntry, bySlug map[string]*attribute.Attribute) (plannedCategory, error) {
+	change := Change{Entity: EntityCategory, ID: e.ID, Key: e.Name}
+
+	categoryAttrs := make([]category.CategoryAttribute, len(e.Attributes))
+	for i, ca := range e.Attributes {
+		a, ok := bySlug[ca.AttributeSlug]
+		if !ok {
+			return plannedCategory{}, fmt.Errorf("%w: attribute %s is neither in the bundle nor stored", ErrInvalidBundle, ca.AttributeSlug)
+		}
+		var err error
+		if categoryAttrs[i], err = ca.toCategoryAttribute(a); err != nil {
+			return plannedCategory{}, err
+		}
+	}
+
+	stored, err := h.categoryRepo.FindByID(ctx, e.ID)
+	if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+		return plannedCategory{}, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	if stored == nil {
+		c, err := category.NewCategoryWithID(h.limits.Category, e.ID, e.Name, e.Enabled, categoryAttrs)
+		if err != nil {
+			return plannedCategory{}, err
+		}
+		if err := c.ChangeDisplay(category.Display{Description: e.Description, Template: category.DisplayTemplate(e.Template)}); err != nil {
+			return plannedCategory{}, err
+		}
+		change.Action = ImportCreated
+		return plannedCategory{change: change, category: c}, nil
+	}
+
+	change.Fields = categoryChanges(toCategoryEntry(stored), e)
+	if len(change.Fields) == 0 {
+		change.Action = ImportUnchanged
+		return plannedCategory{change: change, category: stored}, nil
+	}
+
+	c := *stored
+	if err := c.Update(h.limits.Category, e.Name, e.Enabled, categoryAttrs); err != nil {
+		return plannedCategory{}, err
+	}
+	display := c.Display
+	display.Description, display.Template = e.Description, category.DisplayTemplate(e.Template)
+	if err := c.ChangeDisplay(display); err != nil {
+		return plannedCategory{}, err
+	}
+	change.Action = ImportUpdated
+	return plannedCategory{change: change, category: &c}, nil
+}
+
+// apply stores the created and updated entities of the plan together with their events
+func (h *importTaxonomyHandler) apply(ctx context.Context, plan *taxonomyPlan) ([]outbox.SendFunc, error) {
+	var sends []outbox.SendFunc
+	for _, p := range plan.attributes {
+		var send outbox.SendFunc
+		var err error
+		switch p.change.Action {
+		case ImportCreated:
+			send, err = h.insertAttribute(ctx, p.attribute)
+		case ImportUpdated:
+			_, send, err = h.updateAttribute(ctx, p.attribute, p.before)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import attribute %s: %w", p.change.Key, err)
+		}
+		sends = append(sends, send)
+	}
+
+	for _, p := range plan.categories {
+		var send outbox.SendFunc
+		var err error
+		switch p.change.Action {
+		case ImportCreated:
+			send, err = h.insertCategory(ctx, p.category)
+		case ImportUpdated:
+			send, err = h.updateCategory(ctx, p.category)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import category %s: %w", p.change.Key, err)
+		}
+		sends = append(sends, send)
+	}
+	return sends, nil
+}
+
+// attributeChanges names the fields of the stored attribute the entry changes. Bindings and IDs aren't compared.
+func attributeChanges(stored, e AttributeEntry) []string {
+	return changedFields([]fieldPair{
+		{"name", stored.Name, e.Name},
+		{"unit", stored.Unit, e.Unit},
+		{"enabled", stored.Enabled, e.Enabled},
+		{"displayType", stored.DisplayType, lo.CoalesceOrEmpty(e.DisplayType, stored.DisplayType)},
+		{"inputUnits", stored.InputUnits, e.InputUnits},
+		{"options", stored.Options, e.Options},
+	})
+}
+
+// categoryChanges names the fields of the stored category the entry changes
+func categoryChanges(stored, e CategoryEntry) []string {
+	return changedFields([]fieldPair{
+		{"name", stored.Name, e.Name},
+		{"enabled", stored.Enabled, e.Enabled},
+		{"description", stored.Description, e.Description},
+		{"template", stored.Template, e.Template},
+		{"attributes", stored.Attributes, e.Attributes},
+	})
+}
+
+type fieldPair struct {
+	name          string
+	stored, entry any
+}
+
+func changedFields(pairs []fieldPair) []string {
+	var fields []string
+	for _, p := range pairs {
+		if !reflect.DeepEqual(p.stored, p.entry) {
+			fields = append(fields, p.name)
+		}
+	}
+	return fields
+}
+
+func (h *importTaxonomyHandler) log(ctx context.Context) *zap.Logger {
+	return logger.Get(ctx).With(zap.String("component", "import-taxonomy-handler"))
+}
@@ -0,0 +1,87 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// store writes imported attributes and categories together with their events, in the transaction of the context
+type store struct {
+	attributeRepo   attribute.Repository
+	categoryRepo    category.Repository
+	outbox          outbox.Outbox
+	attributeEvents attribute.AttributeEventFactory
+	categoryEvents  category.CategoryEventFactory
+}
+
+func (s *store) insertAttribute(ctx context.Context, a *attribute.Attribute) (outbox.SendFunc, error) {
+	if err := s.attributeRepo.Insert(ctx, a); err != nil {
+		return nil, fmt.Errorf("failed to insert attribute: %w", err)
+	}
+	return s.attributeEvent(ctx, a, attribute.DiffOptions(nil, a.Options))
+}
+
+// updateAttribute stores the attribute, whose options were before before the import
+func (s *store) updateAttribute(ctx context.Context, a *attribute.Attribute, before []attribute.Option) (*attribute.Attribute, outbox.SendFunc, error) {
+	updated, err := s.attributeRepo.Update(ctx, a)
+	if err != nil {
+		if errors.Is(err, mongo.ErrOptimisticLocking) {
+			return nil, nil, mongo.ErrOptimisticLocking
+		}
+		return nil, nil, fmt.Errorf("failed to update attribute: %w", err)
+	}
+	send, err := s.attributeEvent(ctx, updated, attribute.DiffOptions(before, updated.Options))
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, send, nil
+}
+
+func (s *store) attributeEvent(ctx context.Context, a *attribute.Attribute, delta attribute.OptionsDelta) (outbox.SendFunc, error) {
+	send, err := s.outbox.Create(ctx, s.attributeEvents.NewAttributeUpdatedOutboxMessage(ctx, a, delta))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox: %w", err)
+	}
+	return send, nil
+}
+
+func (s *store) insertCategory(ctx context.Context, c *category.Category) (outbox.SendFunc, error) {
+	if err := s.categoryRepo.Insert(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to insert category: %w", err)
+	}
+	return s.categoryEvent(ctx, c)
+}
+
+func (s *store) updateCategory(ctx context.Context, c *category.Category) (outbox.SendFunc, error) {
+	updated, err := s.categoryRepo.Update(ctx, c)
+	if err != nil {
+		if errors.Is(err, mongo.ErrOptimisticLocking) {
+			return nil, mongo.ErrOptimisticLocking
+		}
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	return s.categoryEvent(ctx, updated)
+}
+
+// categoryEvent stores the update event of the category, which lists its attributes
+func (s *store) categoryEvent(ctx context.Context, c *category.Category) (outbox.SendFunc, error) {
+	attrIDs := lo.Map(c.Attributes, func(ca category.CategoryAttribute, _ int) string { return ca.AttributeID })
+	attrs, err := s.attributeRepo.FindByIDs(ctx, attrIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	send, err := s.outbox.Create(ctx, s.categoryEvents.NewCategoryUpdatedOutboxMessage(ctx, c, attrs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox: %w", err)
+	}
+	return send, nil
+}
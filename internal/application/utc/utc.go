@@ -0,0 +1,39 @@
+// Package utc is the single source of the timestamps the service stores and emits. They are in UTC and
+// cut to milliseconds, the precision of BSON dates, so a value returned by a write or carried by an event
+// is equal to the one read back from the database.
+package utc
+
+import "time"
+
+// Now returns the current time in UTC with millisecond precision
+func Now() time.Time {
+	return Normalize(time.Now())
+}
+
+// Normalize converts the time to UTC with millisecond precision; the zero time stays zero
+func Normalize(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.UTC().Truncate(time.Millisecond)
+}
+
+// NormalizePtr normalizes an optional time, returning nil when it isn't set
+func NormalizePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	n := Normalize(*t)
+	return &n
+}
+
+// NowAfter returns the current time, or a millisecond past previous when the clock of this replica is
+// behind it. Consumers order the changes of an aggregate by their modification time, so it must grow
+// with the version even when replicas disagree on the time.
+func NowAfter(previous time.Time) time.Time {
+	now := Now()
+	if next := Normalize(previous).Add(time.Millisecond); now.Before(next) {
+		return next
+	}
+	return now
+}
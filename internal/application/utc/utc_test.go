@@ -0,0 +1,98 @@
+package utc
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	kyiv := time.FixedZone("EEST", 3*60*60)
+	local := time.Date(2026, 5, 1, 15, 4, 5, 123456789, kyiv)
+
+	n := Normalize(local)
+
+	assert.Equal(t, time.UTC, n.Location())
+	assert.Equal(t, time.Date(2026, 5, 1, 12, 4, 5, 123000000, time.UTC), n)
+	assert.True(t, Normalize(time.Time{}).IsZero())
+	assert.Nil(t, NormalizePtr(nil))
+	assert.Equal(t, n, *NormalizePtr(&local))
+}
+
+func TestNow(t *testing.T) {
+	now := Now()
+
+	assert.Equal(t, time.UTC, now.Location())
+	assert.Zero(t, now.Nanosecond()%int(time.Millisecond))
+}
+
+func TestNowAfter(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	assert.WithinDuration(t, time.Now(), NowAfter(past), time.Second)
+	assert.WithinDuration(t, time.Now(), NowAfter(time.Time{}), time.Second)
+	assert.Equal(t, Normalize(future).Add(time.Millisecond), NowAfter(future))
+}
+
+// TestNoDirectUTCNow keeps the service on Now: a time.Now().UTC() or timestamppb.Now() elsewhere would
+// store and emit nanoseconds the database drops
+func TestNoDirectUTCNow(t *testing.T) {
+	root := filepath.Join("..", "..")
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if isUTCNow(n) {
+				t.Errorf("%s: use utc.Now instead of time.Now().UTC()", fset.Position(n.Pos()))
+			}
+			if isCall(n, "timestamppb", "Now") {
+				t.Errorf("%s: use timestamppb.New(utc.Now()) instead of timestamppb.Now()", fset.Position(n.Pos()))
+			}
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func isUTCNow(n ast.Node) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "UTC" {
+		return false
+	}
+	return isCall(sel.X, "time", "Now")
+}
+
+// isCall reports whether the node calls the function of the package
+func isCall(n ast.Node, pkg, fn string) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != fn {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg
+}
@@ -0,0 +1,12 @@
+package event
+
+import "errors"
+
+var (
+	ErrInvalidSchemaVersion = errors.New("invalid schema version")
+	ErrMissingUpcaster      = errors.New("no upcaster registered for schema version")
+	ErrUnknownEventType     = errors.New("event type has no registered schema version")
+	// ErrFutureSchemaVersion is returned for payloads published by a newer service version,
+	// which this build can't interpret without losing data
+	ErrFutureSchemaVersion = errors.New("schema version is newer than the current one")
+)
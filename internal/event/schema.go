@@ -0,0 +1,78 @@
+// Package event tracks the schema versions of the published catalog events and upcasts
+// payloads written with an older schema to the current one.
+//
+// Every published message carries its payload schema version in the schema_version header.
+// When a payload changes incompatibly (e.g. a float price becomes a money message), the version
+// of that event type is bumped in currentVersions and an Upcaster from the previous version is
+// registered, so that stored or in-flight events of the older shape can still be decoded.
+//
+// Payloads are published in the protobuf binary encoding. An incompatible change never reuses a
+// field number: the old field is reserved and the data moves to a new field. Decoding an old payload
+// with the current message type therefore keeps the old fields as unknown fields, from which the
+// upcasters move the data into the new ones.
+package event
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+)
+
+// InitialSchemaVersion is the schema version of an event type that has never changed
+const InitialSchemaVersion = 1
+
+// currentVersions holds the schema version the service publishes per event type
+var currentVersions = map[protoreflect.FullName]int{
+	typeOf(&eventsv1.ProductUpdatedEvent{}):          InitialSchemaVersion,
+	typeOf(&eventsv1.ProductDeletedEvent{}):          InitialSchemaVersion,
+	typeOf(&eventsv1.ProductPriceChangedEvent{}):     InitialSchemaVersion,
+	typeOf(&eventsv1.BulkProductsImportedEvent{}):    InitialSchemaVersion,
+	typeOf(&eventsv1.CategoryUpdatedEvent{}):         InitialSchemaVersion,
+	typeOf(&eventsv1.AttributeUpdatedEvent{}):        InitialSchemaVersion,
+	typeOf(&eventsv1.StockReservedEvent{}):           InitialSchemaVersion,
+	typeOf(&eventsv1.StockReleasedEvent{}):           InitialSchemaVersion,
+	typeOf(&eventsv1.StockReservationExpiredEvent{}): InitialSchemaVersion,
+}
+
+// SchemaVersion returns the current schema version of the event's type.
+// Panics if the event type is not registered in currentVersions.
+func SchemaVersion(msg proto.Message) int {
+	version, ok := currentVersions[typeOf(msg)]
+	if !ok {
+		panic("event: no schema version registered for " + string(typeOf(msg)))
+	}
+	return version
+}
+
+// SchemaVersions returns the current schema version of every published event type, by full message name
+func SchemaVersions() map[string]int {
+	versions := make(map[string]int, len(currentVersions))
+	for name, version := range currentVersions {
+		versions[string(name)] = version
+	}
+	return versions
+}
+
+// SchemaVersionFromHeaders returns the schema version a message was published with.
+// Messages published before versioning was introduced have no header and use the initial version.
+func SchemaVersionFromHeaders(headers map[string]string) (int, error) {
+	raw, ok := headers[catalogevents.HeaderSchemaVersion]
+	if !ok {
+		return InitialSchemaVersion, nil
+	}
+
+	version, err := strconv.Atoi(raw)
+	if err != nil || version < InitialSchemaVersion {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSchemaVersion, raw)
+	}
+	return version, nil
+}
+
+func typeOf(msg proto.Message) protoreflect.FullName {
+	return msg.ProtoReflect().Descriptor().FullName()
+}
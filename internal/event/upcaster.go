@@ -0,0 +1,140 @@
+package event
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Upcaster rewrites a payload decoded into the current message type from the shape of one schema
+// version into the shape of the next. Fields that the current schema no longer has are available
+// as unknown fields of msg, see TakeUnknownFields.
+type Upcaster func(msg protoreflect.Message) error
+
+type upcasterKey struct {
+	eventType   protoreflect.FullName
+	fromVersion int
+}
+
+// Registry chains upcasters to bring payloads of any supported version up to the current one
+type Registry struct {
+	current   map[protoreflect.FullName]int
+	upcasters map[upcasterKey]Upcaster
+}
+
+// NewRegistry creates a registry with the upcasters of all published event types
+func NewRegistry() *Registry {
+	r := &Registry{
+		current:   make(map[protoreflect.FullName]int, len(currentVersions)),
+		upcasters: make(map[upcasterKey]Upcaster),
+	}
+	for eventType, version := range currentVersions {
+		r.current[eventType] = version
+	}
+	return r
+}
+
+// Register adds the upcaster that converts fromVersion payloads of the event type to fromVersion+1
+// and raises the current version of the event type if needed
+func (r *Registry) Register(eventType protoreflect.FullName, fromVersion int, up Upcaster) {
+	r.upcasters[upcasterKey{eventType: eventType, fromVersion: fromVersion}] = up
+	if r.current[eventType] <= fromVersion {
+		r.current[eventType] = fromVersion + 1
+	}
+}
+
+// CurrentVersion returns the latest schema version known for the event type
+func (r *Registry) CurrentVersion(eventType protoreflect.FullName) (int, error) {
+	version, ok := r.current[eventType]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+	return version, nil
+}
+
+// Upcast converts a message decoded from a payload of the given schema version to the current version
+func (r *Registry) Upcast(msg protoreflect.Message, version int) error {
+	eventType := msg.Descriptor().FullName()
+	current, err := r.CurrentVersion(eventType)
+	if err != nil {
+		return err
+	}
+	if version > current {
+		return fmt.Errorf("%w: %s version %d, current %d", ErrFutureSchemaVersion, eventType, version, current)
+	}
+
+	for v := version; v < current; v++ {
+		up, ok := r.upcasters[upcasterKey{eventType: eventType, fromVersion: v}]
+		if !ok {
+			return fmt.Errorf("%w: %s from %d", ErrMissingUpcaster, eventType, v)
+		}
+		if err := up(msg); err != nil {
+			return fmt.Errorf("upcast %s from schema version %d: %w", eventType, v, err)
+		}
+	}
+	return nil
+}
+
+// Decode unmarshals a binary payload of the given schema version into msg and upcasts it
+func (r *Registry) Decode(data []byte, version int, msg proto.Message) error {
+	eventType := typeOf(msg)
+	current, err := r.CurrentVersion(eventType)
+	if err != nil {
+		return err
+	}
+	if version > current {
+		// Checked before unmarshalling: fields added by the newer version would silently be lost
+		return fmt.Errorf("%w: %s version %d, current %d", ErrFutureSchemaVersion, eventType, version, current)
+	}
+
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("decode %s payload: %w", eventType, err)
+	}
+	return r.Upcast(msg.ProtoReflect(), version)
+}
+
+// UnknownField is a field of an older schema that the current message type doesn't declare
+type UnknownField struct {
+	Type protowire.Type
+	// Value is the encoded value without the tag; length-delimited values exclude the length prefix
+	Value []byte
+}
+
+// TakeUnknownFields removes all occurrences of the field number from the unknown fields of msg
+// and returns them in wire order
+func TakeUnknownFields(msg protoreflect.Message, num protowire.Number) ([]UnknownField, error) {
+	var (
+		taken []UnknownField
+		kept  protoreflect.RawFields
+	)
+
+	b := msg.GetUnknown()
+	for len(b) > 0 {
+		n, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return nil, protowire.ParseError(tagLen)
+		}
+		valueLen := protowire.ConsumeFieldValue(n, typ, b[tagLen:])
+		if valueLen < 0 {
+			return nil, protowire.ParseError(valueLen)
+		}
+
+		if n != num {
+			kept = append(kept, b[:tagLen+valueLen]...)
+		} else {
+			value := b[tagLen : tagLen+valueLen]
+			if typ == protowire.BytesType {
+				value, _ = protowire.ConsumeBytes(value)
+			}
+			taken = append(taken, UnknownField{Type: typ, Value: value})
+		}
+		b = b[tagLen+valueLen:]
+	}
+
+	if len(taken) > 0 {
+		msg.SetUnknown(kept)
+	}
+	return taken, nil
+}
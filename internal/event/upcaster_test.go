@@ -0,0 +1,265 @@
+package event
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/kafkaproto"
+)
+
+// serialize encodes an event the way the outbox publishes it
+func serialize(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+
+	data, err := kafkaproto.NewSerializer().Serialize(msg)
+	require.NoError(t, err)
+	return data
+}
+
+func TestRegistry_RoundTripCurrentVersions(t *testing.T) {
+	now := timestamppb.Now()
+	tests := []struct {
+		name  string
+		event proto.Message
+	}{
+		{"product updated", &eventsv1.ProductUpdatedEvent{ProductId: "product-1", Version: 3, Name: "Phone", Price: 10.5, Quantity: 2, Enabled: true, ModifiedAt: now}},
+		{"product deleted", &eventsv1.ProductDeletedEvent{ProductId: "product-1"}},
+		{"category updated", &eventsv1.CategoryUpdatedEvent{CategoryId: "category-1", Version: 2, Name: "Phones", Enabled: true, ModifiedAt: now, Display: &eventsv1.CategoryPresentation{ImageId: proto.String("image-1"), Template: eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_GRID}}},
+		{"attribute updated", &eventsv1.AttributeUpdatedEvent{AttributeId: "attr-1", Version: 1, Name: "Color", Slug: "color", ModifiedAt: now, DisplayType: eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_SWATCH, Options: []*eventsv1.AttributeOption{{Slug: "red", Name: "Red", ImageId: proto.String("image-red")}}}},
+		{"stock reserved", &eventsv1.StockReservedEvent{ReservationId: "reservation-1", ProductId: "product-1", Quantity: 2, Owner: "order-1", ExpiresAt: now}},
+		{"stock released", &eventsv1.StockReleasedEvent{ReservationId: "reservation-1", ProductId: "product-1", Quantity: 2, Owner: "order-1", ReleasedAt: now}},
+		{"reservation expired", &eventsv1.StockReservationExpiredEvent{ReservationId: "reservation-1", ProductId: "product-1", Quantity: 2, Owner: "order-1", ExpiresAt: now}},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := tt.event.ProtoReflect().New().Interface()
+			require.NoError(t, r.Decode(serialize(t, tt.event), SchemaVersion(tt.event), decoded))
+
+			assert.True(t, proto.Equal(tt.event, decoded))
+		})
+	}
+}
+
+// legacyProductSchema builds a ProductUpdatedEvent descriptor as it looked in an older API version
+func legacyProductSchema(t *testing.T, version int, fields ...*descriptorpb.FieldDescriptorProto) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String(fmt.Sprintf("legacy/v%d/product_events.proto", version)),
+		Package: proto.String(fmt.Sprintf("legacy.v%d", version)),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("ProductUpdatedEvent"),
+			Field: fields,
+		}},
+	}, nil)
+	require.NoError(t, err)
+	return file.Messages().Get(0)
+}
+
+func legacyField(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     typ.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+}
+
+// legacyPayload serializes a message of a legacy schema with the given field values
+func legacyPayload(t *testing.T, md protoreflect.MessageDescriptor, values map[protoreflect.Name]protoreflect.Value) []byte {
+	t.Helper()
+
+	msg := dynamicpb.NewMessage(md)
+	for name, value := range values {
+		msg.Set(md.Fields().ByName(name), value)
+	}
+	return serialize(t, msg)
+}
+
+func TestRegistry_UpcastsOlderVersions(t *testing.T) {
+	eventType := typeOf(&eventsv1.ProductUpdatedEvent{})
+	r := NewRegistry()
+
+	// v1 -> v2: price_cents (field 100, now reserved) became the decimal price
+	r.Register(eventType, 1, func(msg protoreflect.Message) error {
+		fields, err := TakeUnknownFields(msg, 100)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			cents, n := protowire.ConsumeVarint(f.Value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			msg.Set(msg.Descriptor().Fields().ByName("price"), protoreflect.ValueOfFloat64(float64(int64(cents))/100)) //nolint:gosec // varint holds an int64
+		}
+		return nil
+	})
+	// v2 -> v3: the display name moved from title (field 101, now reserved) to name
+	r.Register(eventType, 2, func(msg protoreflect.Message) error {
+		fields, err := TakeUnknownFields(msg, 101)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			msg.Set(msg.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString(string(f.Value)))
+		}
+		return nil
+	})
+	current, err := r.CurrentVersion(eventType)
+	require.NoError(t, err)
+	require.Equal(t, 3, current)
+
+	v1 := legacyProductSchema(t, 1,
+		legacyField("product_id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+		legacyField("price_cents", 100, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+		legacyField("title", 101, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+	)
+	v2 := legacyProductSchema(t, 2,
+		legacyField("product_id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+		legacyField("price", 4, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+		legacyField("title", 101, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+	)
+
+	tests := []struct {
+		name    string
+		version int
+		data    []byte
+	}{
+		{"version 1", 1, legacyPayload(t, v1, map[protoreflect.Name]protoreflect.Value{
+			"product_id":  protoreflect.ValueOfString("product-1"),
+			"price_cents": protoreflect.ValueOfInt64(1050),
+			"title":       protoreflect.ValueOfString("Phone"),
+		})},
+		{"version 2", 2, legacyPayload(t, v2, map[protoreflect.Name]protoreflect.Value{
+			"product_id": protoreflect.ValueOfString("product-1"),
+			"price":      protoreflect.ValueOfFloat64(10.5),
+			"title":      protoreflect.ValueOfString("Phone"),
+		})},
+		{"version 3", 3, serialize(t, &eventsv1.ProductUpdatedEvent{ProductId: "product-1", Name: "Phone", Price: 10.5})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded eventsv1.ProductUpdatedEvent
+			require.NoError(t, r.Decode(tt.data, tt.version, &decoded))
+
+			assert.Equal(t, "product-1", decoded.GetProductId())
+			assert.Equal(t, "Phone", decoded.GetName())
+			assert.InDelta(t, 10.5, decoded.GetPrice(), 0.001)
+			assert.Empty(t, decoded.ProtoReflect().GetUnknown(), "upcasters consume the legacy fields")
+		})
+	}
+}
+
+func TestRegistry_RejectsFutureVersions(t *testing.T) {
+	event := &eventsv1.CategoryUpdatedEvent{CategoryId: "category-1"}
+
+	var decoded eventsv1.CategoryUpdatedEvent
+	err := NewRegistry().Decode(serialize(t, event), SchemaVersion(event)+1, &decoded)
+
+	require.ErrorIs(t, err, ErrFutureSchemaVersion)
+	assert.Empty(t, decoded.GetCategoryId())
+}
+
+func TestRegistry_MissingUpcaster(t *testing.T) {
+	eventType := typeOf(&eventsv1.CategoryUpdatedEvent{})
+	r := NewRegistry()
+	r.Register(eventType, 2, func(protoreflect.Message) error { return nil })
+
+	err := r.Upcast((&eventsv1.CategoryUpdatedEvent{}).ProtoReflect(), 1)
+
+	require.ErrorIs(t, err, ErrMissingUpcaster)
+}
+
+func TestRegistry_UnknownEventType(t *testing.T) {
+	err := NewRegistry().Decode(nil, InitialSchemaVersion, &eventsv1.StringList{})
+
+	require.ErrorIs(t, err, ErrUnknownEventType)
+	assert.Panics(t, func() { SchemaVersion(&eventsv1.StringList{}) })
+}
+
+func TestTakeUnknownFields(t *testing.T) {
+	var raw []byte
+	raw = protowire.AppendTag(raw, 20, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, 7)
+	raw = protowire.AppendTag(raw, 21, protowire.BytesType)
+	raw = protowire.AppendString(raw, "kept")
+	raw = protowire.AppendTag(raw, 20, protowire.Fixed64Type)
+	raw = protowire.AppendFixed64(raw, math.Float64bits(1.5))
+
+	msg := (&eventsv1.ProductUpdatedEvent{}).ProtoReflect()
+	msg.SetUnknown(raw)
+
+	fields, err := TakeUnknownFields(msg, 20)
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, protowire.VarintType, fields[0].Type)
+	assert.Equal(t, protowire.Fixed64Type, fields[1].Type)
+
+	kept, err := TakeUnknownFields(msg, 21)
+	require.NoError(t, err)
+	require.Len(t, kept, 1)
+	assert.Equal(t, "kept", string(kept[0].Value))
+	assert.Empty(t, msg.GetUnknown())
+}
+
+// consumedEvents are published by other services and only consumed by the catalog, so they have no catalog schema version
+var consumedEvents = map[protoreflect.FullName]bool{
+	"catalog.v1.ProductsSoldEvent": true,
+}
+
+// TestCurrentVersions_CoverAllEvents fails when an event is added to the API contract
+// without registering its schema version
+func TestCurrentVersions_CoverAllEvents(t *testing.T) {
+	var events []protoreflect.FullName
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		options, ok := file.Options().(*descriptorpb.FileOptions)
+		if !ok || !strings.HasPrefix(options.GetGoPackage(), "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/") {
+			return true
+		}
+		for i := range file.Messages().Len() {
+			if md := file.Messages().Get(i); strings.HasSuffix(string(md.Name()), "Event") && !consumedEvents[md.FullName()] {
+				events = append(events, md.FullName())
+			}
+		}
+		return true
+	})
+
+	require.NotEmpty(t, events)
+	for _, eventType := range events {
+		assert.Contains(t, currentVersions, eventType)
+	}
+}
+
+func TestSchemaVersionFromHeaders(t *testing.T) {
+	version, err := SchemaVersionFromHeaders(map[string]string{catalogevents.HeaderSchemaVersion: "2"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	version, err = SchemaVersionFromHeaders(map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, InitialSchemaVersion, version)
+
+	_, err = SchemaVersionFromHeaders(map[string]string{catalogevents.HeaderSchemaVersion: "0"})
+	require.ErrorIs(t, err, ErrInvalidSchemaVersion)
+}
@@ -0,0 +1,76 @@
+// Package aftercommit defers work to the commit of the transaction it is scheduled in, such as dropping
+// the caches of the written entities, so a rolled back write leaves them alone.
+//
+// The tx managers are decorated with Decorate, which gives every attempt of a transaction its own list:
+// an attempt the driver retries or a transaction that rolls back drops the work scheduled in it.
+package aftercommit
+
+import (
+	"context"
+	"sync"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type hooksKey struct{}
+
+// hooks is the work scheduled by one attempt of a transaction
+type hooks struct {
+	mu  sync.Mutex
+	fns []func(ctx context.Context)
+}
+
+// Run schedules fn to run once the transaction of ctx commits, with the context the transaction was started with.
+// Outside of a transaction fn runs right away.
+func Run(ctx context.Context, fn func(ctx context.Context)) {
+	h, ok := ctx.Value(hooksKey{}).(*hooks)
+	if !ok {
+		fn(ctx)
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fns = append(h.fns, fn)
+}
+
+// rollbackReporter is implemented by tx managers that may not roll back, see product.bulkWriter
+type rollbackReporter interface {
+	RollsBack() bool
+}
+
+type txManager struct {
+	next commonsmongo.TxManager
+}
+
+// Decorate runs the work scheduled in a transaction of the tx manager once it commits
+func Decorate(next commonsmongo.TxManager) commonsmongo.TxManager {
+	return &txManager{next: next}
+}
+
+func (m *txManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	// A transaction started within another one commits with it
+	if _, ok := ctx.Value(hooksKey{}).(*hooks); ok {
+		return m.next.WithTransaction(ctx, fn)
+	}
+
+	var h *hooks
+	result, err := m.next.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		h = &hooks{}
+		return fn(context.WithValue(txCtx, hooksKey{}, h))
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, fn := range h.fns {
+		fn(ctx)
+	}
+	return result, nil
+}
+
+// RollsBack reports whether the decorated tx manager rolls back, which it does unless it tells otherwise
+func (m *txManager) RollsBack() bool {
+	if r, ok := m.next.(rollbackReporter); ok {
+		return r.RollsBack()
+	}
+	return true
+}
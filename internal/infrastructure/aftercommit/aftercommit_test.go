@@ -0,0 +1,83 @@
+package aftercommit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// retryingTxManager runs the function a given number of times, as the driver does on transient errors
+type retryingTxManager struct {
+	attempts int
+}
+
+func (m retryingTxManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	var (
+		result any
+		err    error
+	)
+	for range m.attempts {
+		result, err = fn(ctx)
+	}
+	return result, err
+}
+
+func TestRun(t *testing.T) {
+	var ran []string
+	schedule := func(ctx context.Context, name string) {
+		Run(ctx, func(context.Context) { ran = append(ran, name) })
+	}
+
+	schedule(context.Background(), "outside")
+	assert.Equal(t, []string{"outside"}, ran, "work outside of a transaction runs right away")
+
+	ran = nil
+	tm := Decorate(retryingTxManager{attempts: 2})
+	_, err := tm.WithTransaction(context.Background(), func(txCtx context.Context) (any, error) {
+		schedule(txCtx, "committed")
+		assert.Empty(t, ran, "work waits for the commit")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"committed"}, ran, "a retried attempt drops the work of the previous one")
+
+	ran = nil
+	_, err = tm.WithTransaction(context.Background(), func(txCtx context.Context) (any, error) {
+		schedule(txCtx, "rolled back")
+		return nil, errors.New("write failed")
+	})
+	require.Error(t, err)
+	assert.Empty(t, ran)
+}
+
+func TestDecorate_Nested(t *testing.T) {
+	var ran []string
+	tm := Decorate(retryingTxManager{attempts: 1})
+
+	_, err := tm.WithTransaction(context.Background(), func(txCtx context.Context) (any, error) {
+		_, err := tm.WithTransaction(txCtx, func(txCtx context.Context) (any, error) {
+			Run(txCtx, func(context.Context) { ran = append(ran, "inner") })
+			return nil, nil
+		})
+		assert.Empty(t, ran, "an inner transaction commits with the outer one")
+		return nil, err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"inner"}, ran)
+}
+
+type nonRollingTxManager struct {
+	retryingTxManager
+}
+
+func (nonRollingTxManager) RollsBack() bool {
+	return false
+}
+
+func TestDecorate_RollsBack(t *testing.T) {
+	assert.True(t, Decorate(retryingTxManager{}).(rollbackReporter).RollsBack())    //nolint:forcetypeassert // test
+	assert.False(t, Decorate(nonRollingTxManager{}).(rollbackReporter).RollsBack()) //nolint:forcetypeassert // test
+}
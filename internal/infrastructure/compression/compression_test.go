@@ -0,0 +1,104 @@
+package compression
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, body, acceptEncoding string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	cfg := Config{}
+	cfg.ApplyDefaults()
+	compress, err := cfg.Middleware()
+	require.NoError(t, err)
+
+	h := compress(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_Negotiation(t *testing.T) {
+	large := strings.Repeat(`{"name":"Blue shirt"},`, 100)
+
+	tests := []struct {
+		name           string
+		body           string
+		acceptEncoding string
+		want           string
+	}{
+		{name: "zstd preferred", body: large, acceptEncoding: "gzip, zstd", want: "zstd"},
+		{name: "gzip", body: large, acceptEncoding: "gzip", want: "gzip"},
+		{name: "zstd refused", body: large, acceptEncoding: "gzip, zstd;q=0", want: "gzip"},
+		{name: "identity", body: large, acceptEncoding: "", want: ""},
+		{name: "below threshold", body: `{"name":"Blue shirt"}`, acceptEncoding: "gzip, zstd", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, tt.body, tt.acceptEncoding)
+
+			assert.Equal(t, tt.want, rec.Header().Get("Content-Encoding"))
+			assert.Contains(t, rec.Header().Values("Vary"), "Accept-Encoding")
+			if tt.want == "" {
+				assert.Equal(t, tt.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestMiddleware_Zstd(t *testing.T) {
+	body := strings.Repeat(`{"name":"Blue shirt"},`, 100)
+
+	rec := serve(t, body, "zstd")
+
+	d, err := zstd.NewReader(rec.Body)
+	require.NoError(t, err)
+	defer d.Close()
+	decoded, err := io.ReadAll(d)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(decoded))
+	assert.Less(t, rec.Body.Len(), len(body)/5)
+}
+
+func TestConnectZstd_RoundTrip(t *testing.T) {
+	message := bytes.Repeat([]byte("product attributes "), 100)
+
+	// Connect pools compressors, so each one is reset and used again
+	c, d := newZstdCompressor(), newZstdDecompressor()
+	for range 2 {
+		var buf bytes.Buffer
+		c.Reset(&buf)
+		_, err := c.Write(message)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+
+		require.NoError(t, d.Reset(&buf))
+		decoded, err := io.ReadAll(d)
+		require.NoError(t, err)
+		require.NoError(t, d.Close())
+		assert.Equal(t, message, decoded)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := Config{}
+	cfg.ApplyDefaults()
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, 1024, cfg.MinBytes)
+
+	assert.Error(t, (&Config{MinBytes: 2 << 20}).Validate())
+}
@@ -0,0 +1,27 @@
+package compression
+
+import "fmt"
+
+const maxMinBytes = 1 << 20
+
+// Config holds the response compression settings
+type Config struct {
+	// MinBytes is the size from which responses are compressed; smaller ones cost more to compress
+	// than they save on the wire. Default: 1024
+	MinBytes int `koanf:"min-bytes"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.MinBytes <= 0 {
+		c.MinBytes = 1024
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.MinBytes > maxMinBytes {
+		return fmt.Errorf("min-bytes cannot exceed %d", maxMinBytes)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package compression
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module provides the response compression settings shared by the Connect and plain HTTP endpoints
+func Module() fx.Option {
+	return fx.Provide(provideConfig)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "compression", nil)
+}
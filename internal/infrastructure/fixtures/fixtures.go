@@ -0,0 +1,136 @@
+// Package fixtures seeds the catalog with static data, for running the service as a mock server
+// for frontends and for the contract tests of consumer services.
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+)
+
+//go:embed catalog.json
+var cannedCatalog []byte
+
+// Fixtures is the data a mock server starts with. The taxonomy is a bundle as exported by the service;
+// products refer to its categories by ID and to its attributes by slug.
+type Fixtures struct {
+	Taxonomy taxonomy.Bundle `json:"taxonomy"`
+	Products []ProductEntry  `json:"products"`
+}
+
+type ProductEntry struct {
+	ID          uuid.UUID    `json:"id"`
+	Name        string       `json:"name"`
+	Slug        string       `json:"slug,omitempty"`
+	Type        string       `json:"type,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Price       float64      `json:"price"`
+	Quantity    int          `json:"quantity"`
+	ImageID     *string      `json:"imageId,omitempty"`
+	CategoryID  *string      `json:"categoryId,omitempty"`
+	Enabled     bool         `json:"enabled"`
+	Attributes  []ValueEntry `json:"attributes,omitempty"`
+}
+
+// ValueEntry is a value of a product attribute; only the field matching the attribute type is set
+type ValueEntry struct {
+	Attribute string   `json:"attribute"`
+	Option    *string  `json:"option,omitempty"`
+	Options   []string `json:"options,omitempty"`
+	Number    *float64 `json:"number,omitempty"`
+	Text      *string  `json:"text,omitempty"`
+	Boolean   *bool    `json:"boolean,omitempty"`
+}
+
+// Load reads the fixtures of the file, or the canned catalog shipped with the service when path is empty
+func Load(path string) (*Fixtures, error) {
+	data := cannedCatalog
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read fixtures: %w", err)
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var f Fixtures
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	return &f, nil
+}
+
+// Seeder stores fixtures through the commands of the service, so they pass the checks of any other write
+type Seeder struct {
+	importTaxonomy taxonomy.ImportTaxonomyCommandHandler
+	createProduct  product.CreateProductCommandHandler
+}
+
+func NewSeeder(importTaxonomy taxonomy.ImportTaxonomyCommandHandler, createProduct product.CreateProductCommandHandler) *Seeder {
+	return &Seeder{importTaxonomy: importTaxonomy, createProduct: createProduct}
+}
+
+// Seed imports the taxonomy, then creates the products with their attribute values moved to the IDs
+// the attributes are stored under
+func (s *Seeder) Seed(ctx context.Context, f *Fixtures) error {
+	changes, err := s.importTaxonomy.Handle(ctx, taxonomy.ImportTaxonomyCommand{Bundle: f.Taxonomy})
+	if err != nil {
+		return fmt.Errorf("failed to import taxonomy: %w", err)
+	}
+	attributeIDs := make(map[string]string)
+	for _, c := range changes {
+		if c.Entity == taxonomy.EntityAttribute {
+			attributeIDs[c.Key] = c.ID
+		}
+	}
+
+	for _, p := range f.Products {
+		cmd, err := toCreateCommand(p, attributeIDs)
+		if err != nil {
+			return err
+		}
+		if _, err := s.createProduct.Handle(ctx, cmd); err != nil {
+			return fmt.Errorf("failed to create product %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+func toCreateCommand(p ProductEntry, attributeIDs map[string]string) (product.CreateProductCommand, error) {
+	cmd := product.CreateProductCommand{
+		ID:          &p.ID,
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Type:        p.Type,
+		Description: p.Description,
+		Price:       p.Price,
+		Quantity:    p.Quantity,
+		ImageID:     p.ImageID,
+		CategoryID:  p.CategoryID,
+		Enabled:     p.Enabled,
+	}
+	for _, v := range p.Attributes {
+		id, ok := attributeIDs[v.Attribute]
+		if !ok {
+			return product.CreateProductCommand{}, fmt.Errorf("product %s: attribute %s is not in the taxonomy", p.Name, v.Attribute)
+		}
+		cmd.Attributes = append(cmd.Attributes, product.AttributeValue{
+			AttributeID:      id,
+			OptionSlugValue:  v.Option,
+			OptionSlugValues: v.Options,
+			NumericValue:     v.Number,
+			TextValue:        v.Text,
+			BooleanValue:     v.Boolean,
+		})
+	}
+	return cmd, nil
+}
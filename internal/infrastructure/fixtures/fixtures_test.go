@@ -0,0 +1,34 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_CannedCatalog(t *testing.T) {
+	f, err := Load("")
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, f.Taxonomy.Attributes)
+	assert.NotEmpty(t, f.Taxonomy.Categories)
+	assert.NotEmpty(t, f.Products)
+}
+
+func TestLoad_RejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"taxonomy":{"version":1},"products":[{"nmae":"Typo"}]}`), 0o600))
+
+	_, err := Load(path)
+
+	require.ErrorContains(t, err, "unknown field")
+}
+
+func TestToCreateCommand_UnknownAttribute(t *testing.T) {
+	_, err := toCreateCommand(ProductEntry{Name: "Drill", Attributes: []ValueEntry{{Attribute: "colour"}}}, map[string]string{"color": "attribute-1"})
+
+	require.ErrorContains(t, err, "attribute colour is not in the taxonomy")
+}
@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Module seeds the fixtures of the file, or the canned catalog when path is empty, on start as the given
+// tenant. It is meant for in-memory storage: seeding a database that holds the fixtures already fails.
+func Module(tenantSlug, path string) fx.Option {
+	return fx.Options(
+		fx.Provide(NewSeeder),
+		fx.Invoke(func(lc fx.Lifecycle, s *Seeder, log *zap.Logger) {
+			lc.Append(fx.Hook{
+				OnStart: func(ctx context.Context) error {
+					f, err := Load(path)
+					if err != nil {
+						return err
+					}
+					if err := s.Seed(tenant.ContextWithSlug(ctx, tenantSlug), f); err != nil {
+						return err
+					}
+					log.Info("fixtures seeded",
+						zap.Int("attributes", len(f.Taxonomy.Attributes)),
+						zap.Int("categories", len(f.Taxonomy.Categories)),
+						zap.Int("products", len(f.Products)),
+					)
+					return nil
+				},
+			})
+		}),
+	)
+}
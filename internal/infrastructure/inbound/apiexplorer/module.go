@@ -0,0 +1,53 @@
+package apiexplorer
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Module serves Swagger UI for the Connect API with examples recorded from the calls made to this
+// instance. It exposes the payloads of the recorded calls, so it is only meant for development.
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			newRecorder,
+			fx.Annotate(provideRecorderInterceptor, fx.ResultTags(`group:"connect_interceptor"`)),
+		),
+		fx.Invoke(registerRoutes),
+	)
+}
+
+func registerRoutes(mux *http.ServeMux, rec *recorder, log *zap.Logger) {
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if err := json.NewEncoder(w).Encode(document(rec)); err != nil {
+			log.Debug("failed to write the API document", zap.Error(err))
+		}
+	})
+	mux.HandleFunc("GET /swagger", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(swaggerPage)) //nolint:errcheck // the client went away
+	})
+}
+
+const swaggerPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Catalog API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    SwaggerUIBundle({
+      url: '/openapi.json',
+      dom_id: '#swagger-ui'
+    });
+  </script>
+</body>
+</html>`
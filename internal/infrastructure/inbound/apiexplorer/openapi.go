@@ -0,0 +1,198 @@
+package apiexplorer
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// files are the proto files of the Connect API
+var files = []protoreflect.FileDescriptor{
+	catalogv1.File_catalog_v1_api_key_proto,
+	catalogv1.File_catalog_v1_attribute_proto,
+	catalogv1.File_catalog_v1_availability_proto,
+	catalogv1.File_catalog_v1_category_proto,
+	catalogv1.File_catalog_v1_category_template_proto,
+	catalogv1.File_catalog_v1_change_proto,
+	catalogv1.File_catalog_v1_environment_sync_proto,
+	catalogv1.File_catalog_v1_job_proto,
+	catalogv1.File_catalog_v1_palette_proto,
+	catalogv1.File_catalog_v1_privacy_proto,
+	catalogv1.File_catalog_v1_product_proto,
+	catalogv1.File_catalog_v1_product_comment_proto,
+	catalogv1.File_catalog_v1_quota_proto,
+	catalogv1.File_catalog_v1_replay_proto,
+	catalogv1.File_catalog_v1_reservation_proto,
+	catalogv1.File_catalog_v1_saved_view_proto,
+	catalogv1.File_catalog_v1_supplier_proto,
+	catalogv1.File_catalog_v1_taxonomy_proto,
+}
+
+// document describes the unary procedures of the Connect API as OpenAPI operations: Connect serves them
+// as JSON POST requests on the procedure path. Each operation carries the last successful call of
+// the procedure as its example. Streaming procedures are left out, Swagger UI can't call them.
+func document(rec *recorder) map[string]any {
+	schemas := make(map[string]any)
+	paths := make(map[string]any)
+
+	for _, file := range files {
+		services := file.Services()
+		for i := range services.Len() {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+				if method.IsStreamingClient() || method.IsStreamingServer() {
+					continue
+				}
+				procedure := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+				addSchema(schemas, method.Input())
+				addSchema(schemas, method.Output())
+				paths[procedure] = map[string]any{"post": operation(rec, procedure, service, method)}
+			}
+		}
+	}
+	schemas["connect.Error"] = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "string"},
+			"message": map[string]any{"type": "string"},
+		},
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "Catalog Connect API",
+			"version":     "v1",
+			"description": "Examples are the last successful call of each procedure on this instance; procedures not called yet have none.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearer": map[string]any{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []any{map[string]any{"bearer": []any{}}},
+	}
+}
+
+func operation(rec *recorder, procedure string, service protoreflect.ServiceDescriptor, method protoreflect.MethodDescriptor) map[string]any {
+	request := map[string]any{"schema": ref(method.Input())}
+	response := map[string]any{"schema": ref(method.Output())}
+	if e, ok := rec.example(procedure); ok {
+		request["example"] = e.Request
+		response["example"] = e.Response
+	}
+
+	return map[string]any{
+		"tags":        []any{string(service.Name())},
+		"operationId": fmt.Sprintf("%s_%s", service.Name(), method.Name()),
+		"parameters": []any{map[string]any{
+			"name":     tenant.TenantSlugHeader,
+			"in":       "header",
+			"required": true,
+			"schema":   map[string]any{"type": "string"},
+		}},
+		"requestBody": map[string]any{
+			"required": true,
+			"content":  map[string]any{"application/json": request},
+		},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "OK",
+				"content":     map[string]any{"application/json": response},
+			},
+			"default": map[string]any{
+				"description": "Connect error",
+				"content":     map[string]any{"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/connect.Error"}}},
+			},
+		},
+	}
+}
+
+func ref(msg protoreflect.MessageDescriptor) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + string(msg.FullName())}
+}
+
+// addSchema adds the schema of the message and of the messages it refers to, with the JSON names protojson uses
+func addSchema(schemas map[string]any, msg protoreflect.MessageDescriptor) {
+	name := string(msg.FullName())
+	if _, ok := schemas[name]; ok {
+		return
+	}
+	properties := make(map[string]any)
+	schemas[name] = map[string]any{"type": "object", "properties": properties}
+
+	fields := msg.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		properties[field.JSONName()] = fieldSchema(schemas, field)
+	}
+}
+
+func fieldSchema(schemas map[string]any, field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": singularSchema(schemas, field.MapValue())}
+	}
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": singularSchema(schemas, field)}
+	}
+	return singularSchema(schemas, field)
+}
+
+// singularSchema maps a value to its protojson encoding, where 64-bit integers are strings
+func singularSchema(schemas map[string]any, field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]any{"type": "number"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]any, values.Len())
+		for i := range values.Len() {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageSchema(schemas, field.Message())
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+func messageSchema(schemas map[string]any, msg protoreflect.MessageDescriptor) map[string]any {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return map[string]any{"type": "string"}
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Value", "google.protobuf.Any":
+		return map[string]any{}
+	case "google.protobuf.StringValue":
+		return map[string]any{"type": "string"}
+	case "google.protobuf.BoolValue":
+		return map[string]any{"type": "boolean"}
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return map[string]any{"type": "integer", "format": "int32"}
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue":
+		return map[string]any{"type": "number"}
+	}
+	addSchema(schemas, msg)
+	return ref(msg)
+}
@@ -0,0 +1,82 @@
+package apiexplorer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+)
+
+// roundTrip encodes the document as it is served
+func roundTrip(t *testing.T, rec *recorder) map[string]any {
+	t.Helper()
+
+	data, err := json.Marshal(document(rec))
+	require.NoError(t, err)
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	return doc
+}
+
+func TestDocument_Schemas(t *testing.T) {
+	doc := roundTrip(t, newRecorder())
+
+	paths := doc["paths"].(map[string]any)
+	op := paths[catalogv1connect.ProductServiceGetProductByIdProcedure].(map[string]any)["post"].(map[string]any)
+	assert.Equal(t, "ProductService_GetProductById", op["operationId"])
+	assert.NotContains(t, op["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"], "example",
+		"procedures not called yet have no example")
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	request := schemas["catalog.v1.GetProductByIdRequest"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "format": "date-time"}, request["asOf"])
+	assert.Equal(t, "array", request["embed"].(map[string]any)["type"])
+
+	productSchema := schemas["catalog.v1.Product"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "format": "int64"}, productSchema["version"], "64-bit integers are strings in JSON")
+	assert.Equal(t, map[string]any{"$ref": "#/components/schemas/catalog.v1.AttributeValue"}, productSchema["attributes"].(map[string]any)["items"])
+	assert.Contains(t, schemas, "catalog.v1.AttributeValue")
+}
+
+type fakeProducts struct {
+	catalogv1connect.UnimplementedProductServiceHandler
+}
+
+func (fakeProducts) GetProductById(_ context.Context, req *connect.Request[catalogv1.GetProductByIdRequest]) (*connect.Response[catalogv1.GetProductByIdResponse], error) {
+	return connect.NewResponse(&catalogv1.GetProductByIdResponse{Product: &catalogv1.Product{Id: req.Msg.GetId(), Name: "Drill"}}), nil
+}
+
+func TestDocument_RecordedExamples(t *testing.T) {
+	rec := newRecorder()
+	mux := http.NewServeMux()
+	mux.Handle(catalogv1connect.NewProductServiceHandler(fakeProducts{}, connect.WithInterceptors(provideRecorderInterceptor(rec).Handler)))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := catalogv1connect.NewProductServiceClient(srv.Client(), srv.URL)
+
+	_, err := client.GetProductById(context.Background(), connect.NewRequest(&catalogv1.GetProductByIdRequest{Id: "product-1"}))
+	require.NoError(t, err)
+	_, err = client.GetProductList(context.Background(), connect.NewRequest(&catalogv1.GetProductListRequest{}))
+	require.Error(t, err)
+
+	doc := roundTrip(t, rec)
+
+	paths := doc["paths"].(map[string]any)
+	op := paths[catalogv1connect.ProductServiceGetProductByIdProcedure].(map[string]any)["post"].(map[string]any)
+	request := op["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+	assert.Equal(t, map[string]any{"id": "product-1"}, request["example"])
+	response := op["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+	assert.Equal(t, map[string]any{"product": map[string]any{"id": "product-1", "name": "Drill"}}, response["example"])
+
+	failed := paths[catalogv1connect.ProductServiceGetProductListProcedure].(map[string]any)["post"].(map[string]any)
+	assert.NotContains(t, failed["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"], "example",
+		"failed calls aren't examples")
+}
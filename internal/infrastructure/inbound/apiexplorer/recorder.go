@@ -0,0 +1,86 @@
+package apiexplorer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// recorderInterceptorPriority runs the interceptor outside the audience interceptor (60),
+// so the examples hold the fields the caller was sent
+const recorderInterceptorPriority = 55
+
+// maxExampleBytes keeps large payloads, such as full product lists, out of the document
+const maxExampleBytes = 16 << 10
+
+// example is the last successful call of a procedure
+type example struct {
+	Request  json.RawMessage
+	Response json.RawMessage
+}
+
+// recorder keeps the last successful call of each unary procedure as the example of the procedure
+type recorder struct {
+	mu       sync.RWMutex
+	examples map[string]example
+}
+
+func newRecorder() *recorder {
+	return &recorder{examples: make(map[string]example)}
+}
+
+func (r *recorder) example(procedure string) (example, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.examples[procedure]
+	return e, ok
+}
+
+func (r *recorder) record(procedure string, req, res any) {
+	reqJSON, ok := marshalExample(req)
+	if !ok {
+		return
+	}
+	resJSON, ok := marshalExample(res)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.examples[procedure] = example{Request: reqJSON, Response: resJSON}
+}
+
+func marshalExample(msg any) (json.RawMessage, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, false
+	}
+	data, err := protojson.Marshal(m)
+	if err != nil || len(data) > maxExampleBytes {
+		return nil, false
+	}
+	return data, true
+}
+
+func provideRecorderInterceptor(r *recorder) interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: recorderInterceptorPriority,
+		Handler: connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				res, err := next(ctx, req)
+				if err == nil {
+					r.record(req.Spec().Procedure, req.Any(), res.Any())
+				}
+				return res, err
+			}
+		}),
+	}
+}
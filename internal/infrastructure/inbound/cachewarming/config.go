@@ -0,0 +1,52 @@
+package cachewarming
+
+import (
+	"errors"
+	"time"
+)
+
+// maxProducts bounds the products warmed per tenant, as they are read in a single page
+const maxProducts = 1000
+
+// Config holds the cache warming worker configuration.
+//
+// The caches are per replica: every replica warms them for every tenant when it starts, and again for a
+// tenant once a storm of product writes that dropped its facets is over.
+type Config struct {
+	// Products is the number of most viewed products warmed per tenant. Default: 100
+	Products int `koanf:"products"`
+	// Categories is the number of top categories of those products whose facets are warmed. Default: 20
+	Categories int `koanf:"categories"`
+	// Interval is the delay between two checks for invalidation storms. Default: 30s
+	Interval time.Duration `koanf:"interval"`
+	// StormThreshold is the number of facet invalidations of a tenant within an interval that makes
+	// a storm. Default: 100
+	StormThreshold int `koanf:"storm-threshold"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.Products <= 0 {
+		c.Products = 100
+	}
+	if c.Categories <= 0 {
+		c.Categories = 20
+	}
+	if c.Interval <= 0 {
+		c.Interval = 30 * time.Second
+	}
+	if c.StormThreshold <= 0 {
+		c.StormThreshold = 100
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.Products > maxProducts {
+		return errors.New("products must be at most 1000")
+	}
+	if c.Interval < time.Second {
+		return errors.New("interval must be at least 1s")
+	}
+	return nil
+}
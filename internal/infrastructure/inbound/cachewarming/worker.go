@@ -0,0 +1,113 @@
+package cachewarming
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Worker warms the caches of every enabled tenant on start, so the first requests after a deploy don't
+// all miss, and again for a tenant after a storm of facet invalidations. Unlike the cron tasks and the
+// job scheduler it is not gated on the leader.Elector: it runs on every replica, as each has its own caches.
+type Worker struct {
+	cfg     Config
+	slugs   tenant.SlugsProvider
+	handler product.WarmCacheCommandHandler
+	facets  *product.FacetCache
+	log     *zap.Logger
+
+	// seen is the number of facet invalidations of each tenant at the last check
+	seen map[string]int64
+	// storming holds the tenants whose last check saw a storm
+	storming map[string]bool
+}
+
+func newWorker(
+	cfg Config,
+	slugs tenant.SlugsProvider,
+	handler product.WarmCacheCommandHandler,
+	facets *product.FacetCache,
+	log *zap.Logger,
+) *Worker {
+	return &Worker{
+		cfg:      cfg,
+		slugs:    slugs,
+		handler:  handler,
+		facets:   facets,
+		log:      log.With(zap.String("component", "cache-warming")),
+		seen:     make(map[string]int64),
+		storming: make(map[string]bool),
+	}
+}
+
+// Run warms the caches of every tenant, then the caches of tenants after storms until ctx is cancelled
+func (w *Worker) Run(ctx context.Context) error {
+	w.warmAll(ctx, func(string) bool { return true })
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.warmAll(ctx, w.settled)
+		}
+	}
+}
+
+func (w *Worker) warmAll(ctx context.Context, due func(slug string) bool) {
+	slugs, err := w.slugs.GetSlugs(ctx)
+	if err != nil {
+		w.log.Error("failed to get tenants", zap.Error(err))
+		return
+	}
+
+	for _, slug := range slugs {
+		if ctx.Err() != nil {
+			return
+		}
+		if due(slug) {
+			w.warmTenant(ctx, slug)
+		}
+	}
+}
+
+// settled reports whether a storm of facet invalidations of the tenant is over: the previous check saw at
+// least StormThreshold of them and this one fewer. Warming during the storm would only fill caches that
+// are dropped again.
+func (w *Worker) settled(slug string) bool {
+	count := w.facets.Invalidations(slug)
+	invalidations := count - w.seen[slug]
+	w.seen[slug] = count
+
+	if invalidations >= int64(w.cfg.StormThreshold) {
+		w.storming[slug] = true
+		return false
+	}
+	if !w.storming[slug] {
+		return false
+	}
+	delete(w.storming, slug)
+	return true
+}
+
+// warmTenant warms the caches of a tenant; a failure is logged, as cold caches only slow requests down
+func (w *Worker) warmTenant(ctx context.Context, slug string) {
+	res, err := w.handler.Handle(tenant.ContextWithSlug(ctx, slug), product.WarmCacheCommand{
+		Tenant:     slug,
+		Products:   w.cfg.Products,
+		Categories: w.cfg.Categories,
+	})
+	if err != nil {
+		w.log.Error("failed to warm cache", zap.String("tenant", slug), zap.Error(err))
+		return
+	}
+	w.log.Info("cache warmed", zap.String("tenant", slug),
+		zap.Int("products", res.Products), zap.Int("categories", res.Categories))
+}
@@ -0,0 +1,33 @@
+package cachewarming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestWorker_Settled(t *testing.T) {
+	facets := product.NewFacetCache()
+	w := newWorker(Config{StormThreshold: 3}, nil, nil, facets, zap.NewNop())
+	invalidate := func(n int) {
+		for range n {
+			facets.Invalidate("acme")
+		}
+	}
+
+	invalidate(2)
+	assert.False(t, w.settled("acme"), "usual churn")
+
+	invalidate(3)
+	assert.False(t, w.settled("acme"), "storm starts")
+	invalidate(5)
+	assert.False(t, w.settled("acme"), "storm goes on")
+
+	invalidate(1)
+	assert.True(t, w.settled("acme"), "storm is over")
+	assert.False(t, w.settled("acme"), "warmed once per storm")
+	assert.False(t, w.settled("other"), "tenants are checked apart")
+}
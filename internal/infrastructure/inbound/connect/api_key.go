@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+// apiKeyInterceptorPriority runs the interceptor before the auth interceptor (22),
+// which then finds the key in the Authorization header
+const apiKeyInterceptorPriority = 20
+
+// apiKeyHeader carries the key for integrations that can't set a bearer token
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyRole is the role of the claims of a key
+const apiKeyRole = "api_key"
+
+// apiKeyLookupTimeout bounds the key lookup; the validator gets no request context
+const apiKeyLookupTimeout = 2 * time.Second
+
+// apiKeyModule accepts API keys next to bearer tokens: the token validator is decorated to authenticate
+// the tokens that look like keys, and the X-API-Key header is accepted in place of Authorization
+func apiKeyModule() fx.Option {
+	return fx.Options(
+		fx.Decorate(decorateAPIKeyValidator),
+		fx.Provide(
+			fx.Annotate(
+				provideAPIKeyInterceptor,
+				fx.ResultTags(`group:"connect_interceptor"`),
+			),
+		),
+	)
+}
+
+// apiKeyValidator authenticates API keys and passes other tokens to the token validator
+type apiKeyValidator struct {
+	next          validation.Validator
+	authenticator apikey.Authenticator
+}
+
+func decorateAPIKeyValidator(next validation.Validator, authenticator apikey.Authenticator) validation.Validator {
+	return &apiKeyValidator{next: next, authenticator: authenticator}
+}
+
+func (v *apiKeyValidator) ValidateToken(token string) (*validation.Claims, error) {
+	if !strings.HasPrefix(token, apikey.Prefix) {
+		return v.next.ValidateToken(token)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiKeyLookupTimeout)
+	defer cancel()
+
+	k, err := v.authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &validation.Claims{Tenant: k.Tenant, Role: apiKeyRole, Permissions: k.Permissions}, nil
+}
+
+func provideAPIKeyInterceptor() interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: apiKeyInterceptorPriority,
+		Handler:  newAPIKeyUnaryInterceptor(),
+	}
+}
+
+// newAPIKeyUnaryInterceptor moves a key sent in the X-API-Key header to the Authorization header,
+// unless the request has one already
+func newAPIKeyUnaryInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if key := req.Header().Get(apiKeyHeader); key != "" && req.Header().Get("Authorization") == "" {
+				req.Header().Set("Authorization", "Bearer "+key)
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type apiKeyHandler struct {
+	createHandler  apikey.CreateAPIKeyCommandHandler
+	rotateHandler  apikey.RotateAPIKeyCommandHandler
+	revokeHandler  apikey.RevokeAPIKeyCommandHandler
+	getListHandler apikey.GetAPIKeyListQueryHandler
+}
+
+func (h *apiKeyHandler) CreateApiKey(ctx context.Context, req *connect.Request[catalogv1.CreateApiKeyRequest]) (*connect.Response[catalogv1.CreateApiKeyResponse], error) { //nolint:revive
+	// A key can't grant more than its creator holds
+	if claims := validation.ClaimsFromContext(ctx); claims != nil {
+		for _, p := range req.Msg.GetPermissions() {
+			if !claims.HasAnyPermission([]string{p}) {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission %q can't be granted without holding it", p))
+			}
+		}
+	}
+
+	created, err := h.createHandler.Handle(ctx, apikey.CreateAPIKeyCommand{
+		Tenant:      tenant.MustSlugFromContext(ctx),
+		Name:        req.Msg.GetName(),
+		Permissions: req.Msg.GetPermissions(),
+	})
+	if err != nil {
+		return nil, mapAPIKeyConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.CreateApiKeyResponse{
+		ApiKey: toProtoAPIKey(created.APIKey),
+		Key:    created.Key,
+	}), nil
+}
+
+func (h *apiKeyHandler) ListApiKeys(ctx context.Context, _ *connect.Request[catalogv1.ListApiKeysRequest]) (*connect.Response[catalogv1.ListApiKeysResponse], error) { //nolint:revive
+	keys, err := h.getListHandler.Handle(ctx, apikey.GetAPIKeyListQuery{Tenant: tenant.MustSlugFromContext(ctx)})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&catalogv1.ListApiKeysResponse{
+		ApiKeys: lo.Map(keys, func(k *apikey.APIKey, _ int) *catalogv1.ApiKey { return toProtoAPIKey(k) }),
+	}), nil
+}
+
+func (h *apiKeyHandler) RotateApiKey(ctx context.Context, req *connect.Request[catalogv1.RotateApiKeyRequest]) (*connect.Response[catalogv1.RotateApiKeyResponse], error) { //nolint:revive
+	rotated, err := h.rotateHandler.Handle(ctx, apikey.RotateAPIKeyCommand{
+		Tenant:      tenant.MustSlugFromContext(ctx),
+		ID:          req.Msg.GetId(),
+		GracePeriod: time.Duration(req.Msg.GetGracePeriodSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, mapAPIKeyConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.RotateApiKeyResponse{
+		ApiKey: toProtoAPIKey(rotated.APIKey),
+		Key:    rotated.Key,
+	}), nil
+}
+
+func (h *apiKeyHandler) RevokeApiKey(ctx context.Context, req *connect.Request[catalogv1.RevokeApiKeyRequest]) (*connect.Response[catalogv1.RevokeApiKeyResponse], error) { //nolint:revive
+	err := h.revokeHandler.Handle(ctx, apikey.RevokeAPIKeyCommand{
+		Tenant: tenant.MustSlugFromContext(ctx),
+		ID:     req.Msg.GetId(),
+	})
+	if err != nil {
+		return nil, mapAPIKeyConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.RevokeApiKeyResponse{}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoAPIKey(k *apikey.APIKey) *catalogv1.ApiKey {
+	pk := &catalogv1.ApiKey{
+		Id:          k.ID,
+		Name:        k.Name,
+		Permissions: k.Permissions,
+		CreatedAt:   timestamppb.New(k.CreatedAt),
+	}
+	if k.ExpiresAt != nil {
+		pk.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	return pk
+}
+
+func mapAPIKeyConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, apikey.ErrInvalidAPIKeyData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, apikey.ErrAPIKeyNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
 	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -12,10 +14,13 @@ import (
 )
 
 type attributeHandler struct {
-	createHandler  attribute.CreateAttributeCommandHandler
-	updateHandler  attribute.UpdateAttributeCommandHandler
-	getByIDHandler attribute.GetAttributeByIDQueryHandler
-	getListHandler attribute.GetAttributeListQueryHandler
+	createHandler    attribute.CreateAttributeCommandHandler
+	updateHandler    attribute.UpdateAttributeCommandHandler
+	getByIDHandler   attribute.GetAttributeByIDQueryHandler
+	getListHandler   attribute.GetAttributeListQueryHandler
+	displayHandler   attribute.SetAttributeDisplayCommandHandler
+	deprecateHandler attribute.DeprecateOptionCommandHandler
+	slugHandler      attribute.GenerateSlugQueryHandler
 }
 
 func (h *attributeHandler) CreateAttribute(ctx context.Context, req *connect.Request[catalogv1.CreateAttributeRequest]) (*connect.Response[catalogv1.CreateAttributeResponse], error) {
@@ -30,12 +35,13 @@ func (h *attributeHandler) CreateAttribute(ctx context.Context, req *connect.Req
 	}
 
 	cmd := attribute.CreateAttributeCommand{
-		Name:    req.Msg.GetName(),
-		Slug:    req.Msg.GetSlug(),
-		Type:    protoAttributeTypeToString(req.Msg.GetType()),
-		Unit:    unit,
-		Enabled: req.Msg.GetEnabled(),
-		Options: protoToOptionInputs(req.Msg.GetOptions()),
+		Name:       req.Msg.GetName(),
+		Slug:       req.Msg.GetSlug(),
+		Type:       protoAttributeTypeToString(req.Msg.GetType()),
+		Unit:       unit,
+		Enabled:    req.Msg.GetEnabled(),
+		Options:    protoToOptionInputs(req.Msg.GetOptions()),
+		InputUnits: protoToUnitConversions(req.Msg.GetInputUnits()),
 	}
 	if id != nil {
 		cmd.ID = parseUUIDPtr(*id)
@@ -58,12 +64,13 @@ func (h *attributeHandler) UpdateAttribute(ctx context.Context, req *connect.Req
 	}
 
 	cmd := attribute.UpdateAttributeCommand{
-		ID:      req.Msg.GetId(),
-		Version: int(req.Msg.GetVersion()),
-		Name:    req.Msg.GetName(),
-		Unit:    unit,
-		Enabled: req.Msg.GetEnabled(),
-		Options: protoToOptionInputs(req.Msg.GetOptions()),
+		ID:         req.Msg.GetId(),
+		Version:    int(req.Msg.GetVersion()),
+		Name:       req.Msg.GetName(),
+		Unit:       unit,
+		Enabled:    req.Msg.GetEnabled(),
+		Options:    protoToOptionInputs(req.Msg.GetOptions()),
+		InputUnits: protoToUnitConversions(req.Msg.GetInputUnits()),
 	}
 
 	updated, err := h.updateHandler.Handle(ctx, cmd)
@@ -96,13 +103,19 @@ func (h *attributeHandler) GetAttributeList(ctx context.Context, req *connect.Re
 		attrType = &s
 	}
 
+	modifiedAfter, err := parseTimePtr(req.Msg.GetModifiedAfter())
+	if err != nil {
+		return nil, err
+	}
+
 	q := attribute.GetAttributeListQuery{
-		Page:    int(req.Msg.GetPage()),
-		Size:    int(req.Msg.GetSize()),
-		Enabled: req.Msg.Enabled,
-		Type:    attrType,
-		Sort:    req.Msg.GetSort(),
-		Order:   req.Msg.GetOrder(),
+		Page:          int(req.Msg.GetPage()),
+		Size:          int(req.Msg.GetSize()),
+		Enabled:       req.Msg.Enabled,
+		Type:          attrType,
+		Sort:          req.Msg.GetSort(),
+		Order:         req.Msg.GetOrder(),
+		ModifiedAfter: modifiedAfter,
 	}
 
 	result, err := h.getListHandler.Handle(ctx, q)
@@ -123,30 +136,98 @@ func (h *attributeHandler) GetAttributeList(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+func (h *attributeHandler) SetAttributeDisplay(ctx context.Context, req *connect.Request[catalogv1.SetAttributeDisplayRequest]) (*connect.Response[catalogv1.SetAttributeDisplayResponse], error) {
+	cmd := attribute.SetAttributeDisplayCommand{
+		ID:           req.Msg.GetId(),
+		Version:      int(req.Msg.GetVersion()),
+		DisplayType:  protoAttributeDisplayTypeToString(req.Msg.GetDisplayType()),
+		PaletteID:    req.Msg.PaletteId,
+		OptionImages: req.Msg.GetOptionImages(),
+	}
+
+	updated, err := h.displayHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, mapAttributeConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SetAttributeDisplayResponse{
+		Attribute: toProtoAttribute(updated),
+	}), nil
+}
+
+func (h *attributeHandler) DeprecateAttributeOption(ctx context.Context, req *connect.Request[catalogv1.DeprecateAttributeOptionRequest]) (*connect.Response[catalogv1.DeprecateAttributeOptionResponse], error) {
+	updated, err := h.deprecateHandler.Handle(ctx, attribute.DeprecateOptionCommand{
+		ID:              req.Msg.GetId(),
+		Version:         int(req.Msg.GetVersion()),
+		OptionSlug:      req.Msg.GetOptionSlug(),
+		Deprecated:      req.Msg.GetDeprecated(),
+		ReplacementSlug: req.Msg.ReplacementSlug,
+	})
+	if err != nil {
+		return nil, mapAttributeConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.DeprecateAttributeOptionResponse{
+		Attribute: toProtoAttribute(updated),
+	}), nil
+}
+
+func (h *attributeHandler) GenerateAttributeSlug(ctx context.Context, req *connect.Request[catalogv1.GenerateAttributeSlugRequest]) (*connect.Response[catalogv1.GenerateAttributeSlugResponse], error) {
+	slug, err := h.slugHandler.Handle(ctx, attribute.GenerateSlugQuery{Name: req.Msg.GetName()})
+	if err != nil {
+		return nil, mapAttributeConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GenerateAttributeSlugResponse{Slug: slug}), nil
+}
+
 // ==================== Helpers ====================
 
 func toProtoAttribute(a *attribute.Attribute) *catalogv1.Attribute {
-	opts := make([]*catalogv1.AttributeOption, len(a.Options))
-	for i, o := range a.Options {
+	return &catalogv1.Attribute{
+		Id:          a.ID,
+		Version:     int64(a.Version),
+		Name:        a.Name,
+		Slug:        a.Slug,
+		Type:        stringToProtoAttributeType(string(a.Type)),
+		Unit:        a.Unit,
+		Enabled:     a.Enabled,
+		Options:     toProtoAttributeOptions(a.Options),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		ModifiedAt:  timestamppb.New(a.ModifiedAt),
+		DisplayType: stringToProtoAttributeDisplayType(string(a.DisplayType)),
+		InputUnits:  toProtoUnitConversions(a.InputUnits),
+		PaletteId:   a.PaletteID,
+	}
+}
+
+func toProtoAttributeOptions(options []attribute.Option) []*catalogv1.AttributeOption {
+	opts := make([]*catalogv1.AttributeOption, len(options))
+	for i, o := range options {
 		opts[i] = &catalogv1.AttributeOption{
 			Name:      o.Name,
 			Slug:      o.Slug,
 			ColorCode: o.ColorCode,
 			SortOrder: int32(o.SortOrder), //nolint:gosec // SortOrder is a small integer, cannot overflow int32
+			ImageId:   o.ImageID,
+
+			Deprecated:      o.Deprecated,
+			ReplacementSlug: o.ReplacementSlug,
 		}
 	}
-	return &catalogv1.Attribute{
-		Id:         a.ID,
-		Version:    int64(a.Version),
-		Name:       a.Name,
-		Slug:       a.Slug,
-		Type:       stringToProtoAttributeType(string(a.Type)),
-		Unit:       a.Unit,
-		Enabled:    a.Enabled,
-		Options:    opts,
-		CreatedAt:  timestamppb.New(a.CreatedAt),
-		ModifiedAt: timestamppb.New(a.ModifiedAt),
-	}
+	return opts
+}
+
+func toProtoUnitConversions(units []attribute.UnitConversion) []*catalogv1.AttributeUnitConversion {
+	return lo.Map(units, func(c attribute.UnitConversion, _ int) *catalogv1.AttributeUnitConversion {
+		return &catalogv1.AttributeUnitConversion{Unit: c.Unit, Factor: c.Factor}
+	})
+}
+
+func protoToUnitConversions(units []*catalogv1.AttributeUnitConversion) []attribute.UnitConversion {
+	return lo.Map(units, func(c *catalogv1.AttributeUnitConversion, _ int) attribute.UnitConversion {
+		return attribute.UnitConversion{Unit: c.GetUnit(), Factor: c.GetFactor()}
+	})
 }
 
 func protoToOptionInputs(opts []*catalogv1.AttributeOptionInput) []attribute.OptionInput {
@@ -200,9 +281,44 @@ func stringToProtoAttributeType(s string) catalogv1.AttributeType {
 	}
 }
 
+func protoAttributeDisplayTypeToString(t catalogv1.AttributeDisplayType) string {
+	switch t {
+	case catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_SWATCH:
+		return "swatch"
+	case catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_DROPDOWN:
+		return "dropdown"
+	case catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_CHECKBOX:
+		return "checkbox"
+	case catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_SLIDER:
+		return "slider"
+	case catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_TEXT:
+		return "text"
+	default:
+		return ""
+	}
+}
+
+func stringToProtoAttributeDisplayType(s string) catalogv1.AttributeDisplayType {
+	switch s {
+	case "swatch":
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_SWATCH
+	case "dropdown":
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_DROPDOWN
+	case "checkbox":
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_CHECKBOX
+	case "slider":
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_SLIDER
+	case "text":
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_TEXT
+	default:
+		return catalogv1.AttributeDisplayType_ATTRIBUTE_DISPLAY_TYPE_UNSPECIFIED
+	}
+}
+
 func mapAttributeConnectError(err error) *connect.Error {
 	switch {
-	case errors.Is(err, attribute.ErrInvalidAttributeData):
+	case errors.Is(err, attribute.ErrInvalidAttributeData), errors.Is(err, attribute.ErrImageNotFound),
+		errors.Is(err, attribute.ErrPaletteNotFound):
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	case errors.Is(err, attribute.ErrSlugAlreadyExists):
 		return connect.NewError(connect.CodeAlreadyExists, err)
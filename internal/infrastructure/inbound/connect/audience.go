@@ -0,0 +1,168 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+// audienceInterceptorPriority runs the interceptor after auth (22) has stored the claims
+// and after the commons interceptors, right around the handlers
+const audienceInterceptorPriority = 60
+
+// audience decides which fields responses carry
+type audience int
+
+const (
+	// audienceStorefront sees the public fields only
+	audienceStorefront audience = iota
+	// audienceAdmin sees internal fields as well, such as supplier data
+	audienceAdmin
+)
+
+// AudienceConfig holds which callers see the internal fields of responses
+type AudienceConfig struct {
+	// AdminPermissions are held by back-office users; any of them selects the admin audience.
+	// Default: products:write, suppliers:read, catalog:admin
+	AdminPermissions []string `koanf:"admin-permissions"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *AudienceConfig) ApplyDefaults() {
+	if len(c.AdminPermissions) == 0 {
+		c.AdminPermissions = []string{"products:write", "suppliers:read", "catalog:admin"}
+	}
+}
+
+// Validate validates the configuration
+func (c *AudienceConfig) Validate() error {
+	if slices.Contains(c.AdminPermissions, "") {
+		return errors.New("admin-permissions must not contain empty permissions")
+	}
+	return nil
+}
+
+// internalFields lists the fields of each response message that only the admin audience sees.
+// Messages are found at any depth, e.g. the products of a list response or the options of an attribute.
+var internalFields = map[protoreflect.FullName][]protoreflect.Name{
+	(&catalogv1.Product{}).ProtoReflect().Descriptor().FullName(): {"supplier_id", "supplier_sku", "metadata", "external_id", "units_sold", "views"},
+	// Whether an attribute is required is a completeness rule of the back office
+	(&catalogv1.CategoryAttribute{}).ProtoReflect().Descriptor().FullName(): {"required"},
+	// Metadata is internal, so are its limits
+	(&catalogv1.ProductFormConstraints{}).ProtoReflect().Descriptor().FullName(): {"max_metadata_keys", "metadata_value_max_length"},
+	// Input units and the palette serve the product and attribute forms
+	(&catalogv1.Attribute{}).ProtoReflect().Descriptor().FullName():                  {"input_units", "palette_id"},
+	(&catalogv1.ProductFormField{}).ProtoReflect().Descriptor().FullName():           {"input_units"},
+	(&catalogv1.Supplier{}).ProtoReflect().Descriptor().FullName():                   {"contact", "lead_time_days"},
+	(&catalogv1.UncategorizedSupplierCount{}).ProtoReflect().Descriptor().FullName(): {"supplier_id"},
+}
+
+// audienceShaper shapes response messages by the audience of the caller,
+// for the Connect procedures and the plain HTTP routes alike
+type audienceShaper struct {
+	adminPermissions []string
+}
+
+func newAudienceShaper(cfg AudienceConfig) *audienceShaper {
+	return &audienceShaper{adminPermissions: cfg.AdminPermissions}
+}
+
+// audienceOf resolves the audience from the token claims; requests without claims
+// get the storefront audience
+func (s *audienceShaper) audienceOf(ctx context.Context) audience {
+	claims := validation.ClaimsFromContext(ctx)
+	if claims != nil && claims.HasAnyPermission(s.adminPermissions) {
+		return audienceAdmin
+	}
+	return audienceStorefront
+}
+
+// shape clears the internal fields of the message unless the caller is of the admin audience
+func (s *audienceShaper) shape(ctx context.Context, msg proto.Message) {
+	if s.audienceOf(ctx) == audienceAdmin {
+		return
+	}
+	stripInternalFields(msg.ProtoReflect())
+}
+
+func provideAudienceConfig(k *koanf.Koanf) (AudienceConfig, error) {
+	return coreconfig.Load[AudienceConfig](k, "audience", nil)
+}
+
+func provideAudienceInterceptor(shaper *audienceShaper) interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: audienceInterceptorPriority,
+		Handler:  newAudienceUnaryInterceptor(shaper),
+	}
+}
+
+// audienceModule provides the shaper and the interceptor that shapes responses by audience
+func audienceModule() fx.Option {
+	return fx.Provide(
+		provideAudienceConfig,
+		newAudienceShaper,
+		fx.Annotate(
+			provideAudienceInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+// newAudienceUnaryInterceptor clears the internal fields of responses to storefront callers,
+// so handlers and mappers build a single response for every audience
+func newAudienceUnaryInterceptor(shaper *audienceShaper) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+
+			if msg, ok := resp.Any().(proto.Message); ok {
+				shaper.shape(ctx, msg)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// stripInternalFields clears the internal fields of the message and of the messages it contains
+func stripInternalFields(m protoreflect.Message) {
+	internal := internalFields[m.Descriptor().FullName()]
+
+	var cleared []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case slices.Contains(internal, fd.Name()):
+			cleared = append(cleared, fd)
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := range list.Len() {
+				stripInternalFields(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				stripInternalFields(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			stripInternalFields(v.Message())
+		}
+		return true
+	})
+
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
@@ -0,0 +1,141 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type availabilityHandler struct {
+	setHandler availability.SetScheduleCommandHandler
+	getHandler availability.GetAvailabilityQueryHandler
+}
+
+func (h *availabilityHandler) SetAvailabilitySchedule(ctx context.Context, req *connect.Request[catalogv1.SetAvailabilityScheduleRequest]) (*connect.Response[catalogv1.SetAvailabilityScheduleResponse], error) {
+	slots := make([]availability.WeeklySlotInput, len(req.Msg.GetWeeklySlots()))
+	for i, slot := range req.Msg.GetWeeklySlots() {
+		slots[i] = availability.WeeklySlotInput{
+			Weekday: protoWeekdayToString(slot.GetWeekday()),
+			Start:   slot.GetStart(),
+			End:     slot.GetEnd(),
+		}
+	}
+	blackouts := make([]availability.BlackoutInput, len(req.Msg.GetBlackouts()))
+	for i, b := range req.Msg.GetBlackouts() {
+		blackouts[i] = availability.BlackoutInput{Date: b.GetDate(), Reason: b.GetReason()}
+	}
+
+	cmd := availability.SetScheduleCommand{
+		ProductID:   req.Msg.GetProductId(),
+		Version:     int(req.Msg.GetVersion()),
+		Timezone:    req.Msg.GetTimezone(),
+		WeeklySlots: slots,
+		Blackouts:   blackouts,
+	}
+
+	s, err := h.setHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, mapAvailabilityConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SetAvailabilityScheduleResponse{
+		Schedule: toProtoAvailabilitySchedule(s),
+	}), nil
+}
+
+func (h *availabilityHandler) GetAvailability(ctx context.Context, req *connect.Request[catalogv1.GetAvailabilityRequest]) (*connect.Response[catalogv1.GetAvailabilityResponse], error) {
+	q := availability.GetAvailabilityQuery{
+		ProductID: req.Msg.GetProductId(),
+		From:      req.Msg.GetFrom(),
+		To:        req.Msg.GetTo(),
+	}
+
+	result, err := h.getHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, mapAvailabilityConnectError(err)
+	}
+
+	days := make([]*catalogv1.DayAvailability, len(result.Days))
+	for i, d := range result.Days {
+		slots := make([]*catalogv1.TimeSlot, len(d.TimeSlots))
+		for j, tr := range d.TimeSlots {
+			slots[j] = &catalogv1.TimeSlot{Start: timestamppb.New(tr.Start), End: timestamppb.New(tr.End)}
+		}
+		days[i] = &catalogv1.DayAvailability{
+			Date:      d.Date.Format(availability.DateLayout),
+			Blackout:  d.Blackout,
+			Reason:    d.Reason,
+			TimeSlots: slots,
+		}
+	}
+
+	return connect.NewResponse(&catalogv1.GetAvailabilityResponse{
+		ProductId: result.Schedule.ProductID,
+		Timezone:  result.Schedule.Timezone,
+		Days:      days,
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoAvailabilitySchedule(s *availability.Schedule) *catalogv1.AvailabilitySchedule {
+	slots := make([]*catalogv1.WeeklySlot, len(s.WeeklySlots))
+	for i, slot := range s.WeeklySlots {
+		slots[i] = &catalogv1.WeeklySlot{
+			Weekday: weekdayToProtoWeekday(slot.Weekday),
+			Start:   slot.Start.String(),
+			End:     slot.End.String(),
+		}
+	}
+	blackouts := make([]*catalogv1.Blackout, len(s.Blackouts))
+	for i, b := range s.Blackouts {
+		blackouts[i] = &catalogv1.Blackout{Date: b.Date.Format(availability.DateLayout), Reason: b.Reason}
+	}
+
+	return &catalogv1.AvailabilitySchedule{
+		ProductId:   s.ProductID,
+		Version:     int64(s.Version),
+		Timezone:    s.Timezone,
+		WeeklySlots: slots,
+		Blackouts:   blackouts,
+		ModifiedAt:  timestamppb.New(s.ModifiedAt),
+	}
+}
+
+// protoWeekdayToString returns the english weekday name expected by the schedule, e.g. "monday"
+func protoWeekdayToString(w catalogv1.Weekday) string {
+	if w == catalogv1.Weekday_WEEKDAY_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(w.String(), "WEEKDAY_"))
+}
+
+// weekdayToProtoWeekday maps time.Weekday, which starts with Sunday = 0, to the proto enum starting with Monday = 1
+func weekdayToProtoWeekday(w time.Weekday) catalogv1.Weekday {
+	if w == time.Sunday {
+		return catalogv1.Weekday_WEEKDAY_SUNDAY
+	}
+	return catalogv1.Weekday(w)
+}
+
+func mapAvailabilityConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, availability.ErrInvalidScheduleData), errors.Is(err, availability.ErrInvalidDateRange):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, availability.ErrProductNotFound), errors.Is(err, availability.ErrScheduleNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, availability.ErrNotServiceProduct):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
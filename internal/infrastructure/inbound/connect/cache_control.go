@@ -0,0 +1,215 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// cacheControlInterceptorPriority runs the interceptor after the tenant checks (26) and rate limiting (40),
+// so rejected requests get no cache headers, and outside the audience interceptor (60)
+const cacheControlInterceptorPriority = 45
+
+const maxCacheAge = 24 * time.Hour
+
+// CacheControlConfig holds how long a CDN in front of the catalog may keep the responses of GET requests,
+// per entity type. Responses carry a Surrogate-Key header naming the entities they include,
+// which update commands purge, so long ages are safe for entities that rarely change.
+type CacheControlConfig struct {
+	// Enabled adds the headers. Default: false
+	Enabled bool `koanf:"enabled"`
+	// Attributes applies to the attribute reads. Default: 1h
+	Attributes time.Duration `koanf:"attributes"`
+	// Categories applies to the category reads. Default: 10m
+	Categories time.Duration `koanf:"categories"`
+	// Products applies to the product reads and the category facets and price stats. Default: 1m
+	Products time.Duration `koanf:"products"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *CacheControlConfig) ApplyDefaults() {
+	if c.Attributes <= 0 {
+		c.Attributes = time.Hour
+	}
+	if c.Categories <= 0 {
+		c.Categories = 10 * time.Minute
+	}
+	if c.Products <= 0 {
+		c.Products = time.Minute
+	}
+}
+
+// Validate validates the configuration
+func (c *CacheControlConfig) Validate() error {
+	for name, d := range map[string]time.Duration{"attributes": c.Attributes, "categories": c.Categories, "products": c.Products} {
+		if d < time.Second || d > maxCacheAge {
+			return fmt.Errorf("%s must be between 1s and %s", name, maxCacheAge)
+		}
+	}
+	return nil
+}
+
+func (c CacheControlConfig) maxAge(kind edgecache.Kind) time.Duration {
+	switch kind {
+	case edgecache.Attribute:
+		return c.Attributes
+	case edgecache.Category:
+		return c.Categories
+	default:
+		return c.Products
+	}
+}
+
+// cacheableProcedures maps the reads a CDN may cache to the entity type whose age applies.
+// Facets and price stats are computed from the products of the category.
+var cacheableProcedures = map[string]edgecache.Kind{
+	catalogv1connect.AttributeServiceGetAttributeByIdProcedure:     edgecache.Attribute,
+	catalogv1connect.AttributeServiceGetAttributeListProcedure:     edgecache.Attribute,
+	catalogv1connect.CategoryServiceGetCategoryByIdProcedure:       edgecache.Category,
+	catalogv1connect.CategoryServiceGetCategoryListProcedure:       edgecache.Category,
+	catalogv1connect.CategoryServiceGetCategoryFacetsProcedure:     edgecache.Product,
+	catalogv1connect.CategoryServiceGetCategoryPriceStatsProcedure: edgecache.Product,
+	catalogv1connect.ProductServiceGetProductByIdProcedure:         edgecache.Product,
+	catalogv1connect.ProductServiceGetProductBySlugProcedure:       edgecache.Product,
+	catalogv1connect.ProductServiceGetProductListProcedure:         edgecache.Product,
+	catalogv1connect.ProductServiceSampleProductsProcedure:         edgecache.Product,
+}
+
+// categoryProductsProcedures are computed from the products of the category named by the id of the request
+var categoryProductsProcedures = map[string]bool{
+	catalogv1connect.CategoryServiceGetCategoryFacetsProcedure:     true,
+	catalogv1connect.CategoryServiceGetCategoryPriceStatsProcedure: true,
+}
+
+// surrogateKinds tells the entity type of the response messages that get a surrogate key.
+// Messages are found at any depth, e.g. the products of a list response.
+var surrogateKinds = map[protoreflect.FullName]edgecache.Kind{
+	(&catalogv1.Product{}).ProtoReflect().Descriptor().FullName():   edgecache.Product,
+	(&catalogv1.Category{}).ProtoReflect().Descriptor().FullName():  edgecache.Category,
+	(&catalogv1.Attribute{}).ProtoReflect().Descriptor().FullName(): edgecache.Attribute,
+}
+
+// cacheControlModule provides the interceptor that adds CDN cache headers to the catalog reads
+func cacheControlModule() fx.Option {
+	return fx.Provide(
+		provideCacheControlConfig,
+		fx.Annotate(
+			provideCacheControlInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+func provideCacheControlConfig(k *koanf.Koanf) (CacheControlConfig, error) {
+	return coreconfig.Load[CacheControlConfig](k, "cache-control", nil)
+}
+
+func provideCacheControlInterceptor(cfg CacheControlConfig) interceptor.Interceptor {
+	if !cfg.Enabled {
+		return interceptor.Interceptor{Priority: cacheControlInterceptorPriority}
+	}
+	return interceptor.Interceptor{
+		Priority: cacheControlInterceptorPriority,
+		Handler:  newCacheControlUnaryInterceptor(cfg),
+	}
+}
+
+// newCacheControlUnaryInterceptor marks the successful responses of cacheable GET requests as public for
+// the age of their entity type. Responses vary by token, which selects the audience, and by tenant.
+// The Surrogate-Key header names the entities of the response and, for responses that don't show exactly
+// one entity, the list key of the entity type, which every write of that type purges. The facets and price
+// stats of a category are named by the key of its products instead.
+func newCacheControlUnaryInterceptor(cfg CacheControlConfig) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			kind, ok := cacheableProcedures[req.Spec().Procedure]
+			if err != nil || resp == nil || !ok || req.HTTPMethod() != http.MethodGet {
+				return resp, err
+			}
+
+			h := resp.Header()
+			h.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(cfg.maxAge(kind).Seconds())))
+			h.Add("Vary", "Authorization")
+			h.Add("Vary", "X-Tenant-Slug")
+			if categoryProductsProcedures[req.Spec().Procedure] {
+				if msg, ok := req.Any().(proto.Message); ok {
+					h.Set("Surrogate-Key", edgecache.CategoryProductsKey(ctx, stringField(msg.ProtoReflect(), "id")))
+				}
+			} else if msg, ok := resp.Any().(proto.Message); ok {
+				h.Set("Surrogate-Key", strings.Join(surrogateKeys(ctx, kind, msg.ProtoReflect()), " "))
+			}
+			return resp, nil
+		}
+	}
+}
+
+// surrogateKeys lists the keys of the entities in the response, with the category of each product
+// so that renaming a category purges the products showing it. Entities embedded in another one,
+// such as the attribute definitions of a product, get keys but don't count as shown.
+func surrogateKeys(ctx context.Context, kind edgecache.Kind, m protoreflect.Message) []string {
+	var keys []string
+	entities := 0
+	var collect func(m protoreflect.Message, embedded bool)
+	collect = func(m protoreflect.Message, embedded bool) {
+		if k, ok := surrogateKinds[m.Descriptor().FullName()]; ok {
+			if !embedded {
+				entities++
+			}
+			embedded = true
+			keys = append(keys, edgecache.EntityKey(ctx, k, stringField(m, "id")))
+			if categoryID := stringField(m, "category_id"); k == edgecache.Product && categoryID != "" {
+				keys = append(keys, edgecache.EntityKey(ctx, edgecache.Category, categoryID))
+			}
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsList() && fd.Message() != nil:
+				list := v.List()
+				for i := range list.Len() {
+					collect(list.Get(i).Message(), embedded)
+				}
+			case fd.IsMap() && fd.MapValue().Message() != nil:
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					collect(mv.Message(), embedded)
+					return true
+				})
+			case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+				collect(v.Message(), embedded)
+			}
+			return true
+		})
+	}
+	collect(m, false)
+
+	if entities != 1 {
+		keys = append(keys, edgecache.ListKey(ctx, kind))
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// stringField returns the value of the string field of the message, or "" if it has none
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return m.Get(fd).String()
+}
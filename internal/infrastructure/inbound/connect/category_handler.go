@@ -7,7 +7,10 @@ import (
 	"connectrpc.com/connect"
 	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,6 +19,12 @@ type categoryHandler struct {
 	updateHandler  category.UpdateCategoryCommandHandler
 	getByIDHandler category.GetCategoryByIDQueryHandler
 	getListHandler category.GetListCategoriesQueryHandler
+	displayHandler category.SetCategoryDisplayCommandHandler
+	// priceStatsHandler lives in the product package, as the stats aggregate products
+	priceStatsHandler product.GetCategoryPriceStatsQueryHandler
+	facetsHandler     product.GetCategoryFacetsQueryHandler
+	checkHandler      product.CheckCategoryProductQueryHandler
+	formHandler       category.GetProductFormSchemaQueryHandler
 }
 
 func (h *categoryHandler) CreateCategory(ctx context.Context, req *connect.Request[catalogv1.CreateCategoryRequest]) (*connect.Response[catalogv1.CreateCategoryResponse], error) {
@@ -58,7 +67,11 @@ func (h *categoryHandler) UpdateCategory(ctx context.Context, req *connect.Reque
 }
 
 func (h *categoryHandler) GetCategoryById(ctx context.Context, req *connect.Request[catalogv1.GetCategoryByIdRequest]) (*connect.Response[catalogv1.GetCategoryByIdResponse], error) { //nolint:revive
-	q := category.GetCategoryByIDQuery{ID: req.Msg.GetId()}
+	asOf, err := parseTimePtr(req.Msg.GetAsOf())
+	if err != nil {
+		return nil, err
+	}
+	q := category.GetCategoryByIDQuery{ID: req.Msg.GetId(), AsOf: asOf}
 
 	found, err := h.getByIDHandler.Handle(ctx, q)
 	if err != nil {
@@ -71,12 +84,18 @@ func (h *categoryHandler) GetCategoryById(ctx context.Context, req *connect.Requ
 }
 
 func (h *categoryHandler) GetCategoryList(ctx context.Context, req *connect.Request[catalogv1.GetCategoryListRequest]) (*connect.Response[catalogv1.GetCategoryListResponse], error) {
+	modifiedAfter, err := parseTimePtr(req.Msg.GetModifiedAfter())
+	if err != nil {
+		return nil, err
+	}
+
 	q := category.GetListCategoriesQuery{
-		Page:    int(req.Msg.GetPage()),
-		Size:    int(req.Msg.GetSize()),
-		Enabled: req.Msg.Enabled,
-		Sort:    req.Msg.GetSort(),
-		Order:   req.Msg.GetOrder(),
+		Page:          int(req.Msg.GetPage()),
+		Size:          int(req.Msg.GetSize()),
+		Enabled:       req.Msg.Enabled,
+		Sort:          req.Msg.GetSort(),
+		Order:         req.Msg.GetOrder(),
+		ModifiedAfter: modifiedAfter,
 	}
 
 	result, err := h.getListHandler.Handle(ctx, q)
@@ -97,6 +116,65 @@ func (h *categoryHandler) GetCategoryList(ctx context.Context, req *connect.Requ
 	}), nil
 }
 
+func (h *categoryHandler) SetCategoryDisplay(ctx context.Context, req *connect.Request[catalogv1.SetCategoryDisplayRequest]) (*connect.Response[catalogv1.SetCategoryDisplayResponse], error) {
+	display := req.Msg.GetDisplay()
+	cmd := category.SetCategoryDisplayCommand{
+		ID:            req.Msg.GetId(),
+		Version:       int(req.Msg.GetVersion()),
+		ImageID:       display.ImageId,
+		BannerImageID: display.BannerImageId,
+		Description:   display.Description,
+		Template:      protoCategoryTemplateToString(display.GetTemplate()),
+	}
+
+	updated, err := h.displayHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, mapCategoryConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SetCategoryDisplayResponse{
+		Category: toProtoCategory(updated),
+	}), nil
+}
+
+func (h *categoryHandler) GetCategoryPriceStats(ctx context.Context, req *connect.Request[catalogv1.GetCategoryPriceStatsRequest]) (*connect.Response[catalogv1.GetCategoryPriceStatsResponse], error) {
+	q := product.GetCategoryPriceStatsQuery{CategoryID: req.Msg.GetId(), Tenant: tenant.MustSlugFromContext(ctx)}
+
+	stats, err := h.priceStatsHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, mapCategoryConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetCategoryPriceStatsResponse{
+		Count: stats.Count,
+		Min:   stats.Min,
+		Max:   stats.Max,
+		Avg:   stats.Avg,
+	}), nil
+}
+
+func (h *categoryHandler) GetCategoryFacets(ctx context.Context, req *connect.Request[catalogv1.GetCategoryFacetsRequest]) (*connect.Response[catalogv1.GetCategoryFacetsResponse], error) {
+	q := product.GetCategoryFacetsQuery{CategoryID: req.Msg.GetId(), Tenant: tenant.MustSlugFromContext(ctx)}
+
+	facets, err := h.facetsHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, mapCategoryConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetCategoryFacetsResponse{
+		Facets: lo.Map(facets, func(f product.AttributeFacet, _ int) *catalogv1.AttributeFacet {
+			return &catalogv1.AttributeFacet{
+				AttributeId: f.AttributeID,
+				Slug:        f.Slug,
+				Name:        f.Name,
+				Options: lo.Map(f.Options, func(o product.OptionCount, _ int) *catalogv1.FacetOption {
+					return &catalogv1.FacetOption{Slug: o.Slug, Name: o.Name, Count: o.Count}
+				}),
+			}
+		}),
+	}), nil
+}
+
 // ==================== Helpers ====================
 
 func toProtoCategory(c *category.Category) *catalogv1.Category {
@@ -108,6 +186,7 @@ func toProtoCategory(c *category.Category) *catalogv1.Category {
 			SortOrder:   int32(a.SortOrder), //nolint:gosec // SortOrder is a small integer, cannot overflow int32
 			Filterable:  a.Filterable,
 			Searchable:  a.Searchable,
+			Required:    a.Required,
 		}
 	}
 	return &catalogv1.Category{
@@ -118,6 +197,19 @@ func toProtoCategory(c *category.Category) *catalogv1.Category {
 		Attributes: attrs,
 		CreatedAt:  timestamppb.New(c.CreatedAt),
 		ModifiedAt: timestamppb.New(c.ModifiedAt),
+		Display:    toProtoCategoryDisplay(c.Display),
+	}
+}
+
+func toProtoCategoryDisplay(d category.Display) *catalogv1.CategoryDisplay {
+	if d.IsEmpty() {
+		return nil
+	}
+	return &catalogv1.CategoryDisplay{
+		ImageId:       d.ImageID,
+		BannerImageId: d.BannerImageID,
+		Description:   d.Description,
+		Template:      stringToProtoCategoryTemplate(string(d.Template)),
 	}
 }
 
@@ -134,17 +226,80 @@ func protoToCategoryAttributeInputs(attrs []*catalogv1.CategoryAttributeInput) [
 			SortOrder:   sortOrder,
 			Filterable:  a.GetFilterable(),
 			Searchable:  a.GetSearchable(),
+			Required:    a.GetRequired(),
 		}
 	}
 	return result
 }
 
+func (h *categoryHandler) GetProductFormSchema(ctx context.Context, req *connect.Request[catalogv1.GetProductFormSchemaRequest]) (*connect.Response[catalogv1.GetProductFormSchemaResponse], error) {
+	schema, err := h.formHandler.Handle(ctx, category.GetProductFormSchemaQuery{CategoryID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapCategoryConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetProductFormSchemaResponse{
+		CategoryId:      schema.CategoryID,
+		CategoryVersion: int64(schema.CategoryVersion),
+		Fields: lo.Map(schema.Fields, func(f category.FormField, _ int) *catalogv1.ProductFormField {
+			return &catalogv1.ProductFormField{
+				AttributeId: f.AttributeID,
+				Slug:        f.Slug,
+				Name:        f.Name,
+				Type:        stringToProtoAttributeType(string(f.Type)),
+				DisplayType: stringToProtoAttributeDisplayType(string(f.DisplayType)),
+				Role:        stringToProtoCategoryAttributeRole(string(f.Role)),
+				Required:    f.Required,
+				Options:     toProtoAttributeOptions(f.Options),
+				Unit:        f.Unit,
+				InputUnits:  toProtoUnitConversions(f.InputUnits),
+			}
+		}),
+		Constraints: &catalogv1.ProductFormConstraints{
+			NameMaxLength:          int32(schema.Constraints.NameMaxLength),          //nolint:gosec // limits are small integers, cannot overflow int32
+			SlugMaxLength:          int32(schema.Constraints.SlugMaxLength),          //nolint:gosec // limits are small integers, cannot overflow int32
+			MaxAttributeValues:     int32(schema.Constraints.MaxAttributeValues),     //nolint:gosec // limits are small integers, cannot overflow int32
+			MaxMetadataKeys:        int32(schema.Constraints.MaxMetadataKeys),        //nolint:gosec // limits are small integers, cannot overflow int32
+			MetadataValueMaxLength: int32(schema.Constraints.MetadataValueMaxLength), //nolint:gosec // limits are small integers, cannot overflow int32
+		},
+	}), nil
+}
+
+func (h *categoryHandler) CheckCategoryProduct(ctx context.Context, req *connect.Request[catalogv1.CheckCategoryProductRequest]) (*connect.Response[catalogv1.CheckCategoryProductResponse], error) {
+	check, err := h.checkHandler.Handle(ctx, product.CheckCategoryProductQuery{
+		CategoryID: req.Msg.GetId(),
+		Attributes: protoToAttributeValues(req.Msg.GetAttributes()),
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.CheckCategoryProductResponse{
+		Ok: check.OK(),
+		Missing: lo.Map(check.Missing, func(m product.MissingAttribute, _ int) *catalogv1.MissingCategoryAttribute {
+			return &catalogv1.MissingCategoryAttribute{
+				AttributeId: m.AttributeID,
+				Slug:        m.Slug,
+				Role:        stringToProtoCategoryAttributeRole(string(m.Role)),
+				Required:    m.Required,
+			}
+		}),
+		Invalid: lo.Map(check.Invalid, func(p product.AttributeProblem, _ int) *catalogv1.InvalidAttributeValue {
+			return &catalogv1.InvalidAttributeValue{AttributeId: p.AttributeID, Slug: p.Slug, Reason: p.Reason, Message: p.Message}
+		}),
+	}), nil
+}
+
 func protoCategoryAttributeRoleToString(r catalogv1.CategoryAttributeRole) string {
 	switch r {
 	case catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_VARIANT:
 		return "variant"
 	case catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_SPECIFICATION:
 		return "specification"
+	case catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_DESCRIPTION:
+		return "description"
+	case catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_FILTER_ONLY:
+		return "filter-only"
 	default:
 		return ""
 	}
@@ -156,14 +311,48 @@ func stringToProtoCategoryAttributeRole(s string) catalogv1.CategoryAttributeRol
 		return catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_VARIANT
 	case "specification":
 		return catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_SPECIFICATION
+	case "description":
+		return catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_DESCRIPTION
+	case "filter-only":
+		return catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_FILTER_ONLY
 	default:
 		return catalogv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_UNSPECIFIED
 	}
 }
 
+func protoCategoryTemplateToString(t catalogv1.CategoryTemplate) string {
+	switch t {
+	case catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_DEFAULT:
+		return "default"
+	case catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_GRID:
+		return "grid"
+	case catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_LIST:
+		return "list"
+	case catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_LANDING:
+		return "landing"
+	default:
+		return ""
+	}
+}
+
+func stringToProtoCategoryTemplate(s string) catalogv1.CategoryTemplate {
+	switch s {
+	case "default":
+		return catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_DEFAULT
+	case "grid":
+		return catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_GRID
+	case "list":
+		return catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_LIST
+	case "landing":
+		return catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_LANDING
+	default:
+		return catalogv1.CategoryTemplate_CATEGORY_TEMPLATE_UNSPECIFIED
+	}
+}
+
 func mapCategoryConnectError(err error) *connect.Error {
 	switch {
-	case errors.Is(err, category.ErrInvalidCategoryData):
+	case errors.Is(err, category.ErrInvalidCategoryData), errors.Is(err, category.ErrImageNotFound):
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	case errors.Is(err, mongo.ErrEntityNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
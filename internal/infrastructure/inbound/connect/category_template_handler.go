@@ -0,0 +1,95 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+)
+
+type categoryTemplateHandler struct {
+	listHandler  categorytemplate.ListTemplatesQueryHandler
+	applyHandler categorytemplate.ApplyTemplateCommandHandler
+}
+
+func (h *categoryTemplateHandler) ListCategoryTemplates(ctx context.Context, _ *connect.Request[catalogv1.ListCategoryTemplatesRequest]) (*connect.Response[catalogv1.ListCategoryTemplatesResponse], error) {
+	templates, err := h.listHandler.Handle(ctx, categorytemplate.ListTemplatesQuery{})
+	if err != nil {
+		return nil, mapCategoryTemplateConnectError(err)
+	}
+
+	items := make([]*catalogv1.CategoryTemplateDefinition, len(templates))
+	for i, t := range templates {
+		items[i] = toProtoCategoryTemplate(t)
+	}
+
+	return connect.NewResponse(&catalogv1.ListCategoryTemplatesResponse{
+		Templates: items,
+	}), nil
+}
+
+func (h *categoryTemplateHandler) ApplyCategoryTemplate(ctx context.Context, req *connect.Request[catalogv1.ApplyCategoryTemplateRequest]) (*connect.Response[catalogv1.ApplyCategoryTemplateResponse], error) {
+	cmd := categorytemplate.ApplyTemplateCommand{
+		Template:     req.Msg.GetTemplateKey(),
+		CategoryName: req.Msg.GetCategoryName(),
+		Enabled:      req.Msg.GetEnabled(),
+	}
+
+	result, err := h.applyHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, mapCategoryTemplateConnectError(err)
+	}
+
+	attrs := make([]*catalogv1.Attribute, len(result.Attributes))
+	for i, a := range result.Attributes {
+		attrs[i] = toProtoAttribute(a)
+	}
+
+	return connect.NewResponse(&catalogv1.ApplyCategoryTemplateResponse{
+		Category:          toProtoCategory(result.Category),
+		Attributes:        attrs,
+		CreatedAttributes: int32(result.CreatedAttributes), //nolint:gosec // bounded by the template size
+	}), nil
+}
+
+func toProtoCategoryTemplate(t categorytemplate.Template) *catalogv1.CategoryTemplateDefinition {
+	attrs := make([]*catalogv1.CategoryTemplateAttribute, len(t.Attributes))
+	for i, a := range t.Attributes {
+		options := make([]*catalogv1.CategoryTemplateOption, len(a.Options))
+		for j, opt := range a.Options {
+			options[j] = &catalogv1.CategoryTemplateOption{Name: opt.Name, Slug: opt.Slug, ColorCode: opt.ColorCode}
+		}
+		attrs[i] = &catalogv1.CategoryTemplateAttribute{
+			Name:       a.Name,
+			Slug:       a.Slug,
+			Type:       stringToProtoAttributeType(a.Type),
+			Unit:       a.Unit,
+			Options:    options,
+			Role:       stringToProtoCategoryAttributeRole(a.Role),
+			Filterable: a.Filterable,
+			Searchable: a.Searchable,
+			Required:   a.Required,
+		}
+	}
+	return &catalogv1.CategoryTemplateDefinition{
+		Key:         t.Key,
+		Name:        t.Name,
+		Description: t.Description,
+		Attributes:  attrs,
+	}
+}
+
+func mapCategoryTemplateConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, categorytemplate.ErrTemplateNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, category.ErrInvalidCategoryData), errors.Is(err, attribute.ErrInvalidAttributeData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,44 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+)
+
+type changeHandler struct {
+	getChangesHandler change.GetChangesQueryHandler
+}
+
+func (h *changeHandler) GetChanges(ctx context.Context, req *connect.Request[catalogv1.GetChangesRequest]) (*connect.Response[catalogv1.GetChangesResponse], error) {
+	result, err := h.getChangesHandler.Handle(ctx, change.GetChangesQuery{
+		After: req.Msg.GetAfter(),
+		Limit: int(req.Msg.GetLimit()),
+	})
+	if err != nil {
+		if errors.Is(err, change.ErrInvalidCursor) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetChangesResponse{
+		Changes: lo.Map(result.Changes, func(c change.Change, _ int) *catalogv1.Change {
+			return &catalogv1.Change{
+				EntityType: string(c.Entity),
+				Id:         c.ID,
+				Version:    int32(c.Version), //nolint:gosec // Version counts writes of one entity, cannot overflow int32
+				Kind:       string(c.Kind),
+				At:         timestamppb.New(c.At),
+			}
+		}),
+		Cursor:  result.Cursor,
+		HasMore: result.HasMore,
+	}), nil
+}
@@ -0,0 +1,77 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type commentHandler struct {
+	addHandler     comment.AddCommentCommandHandler
+	getListHandler comment.GetCommentListQueryHandler
+}
+
+func (h *commentHandler) AddProductComment(ctx context.Context, req *connect.Request[catalogv1.AddProductCommentRequest]) (*connect.Response[catalogv1.AddProductCommentResponse], error) {
+	c, err := h.addHandler.Handle(ctx, comment.AddCommentCommand{
+		ProductID: req.Msg.GetProductId(),
+		Author:    req.Msg.GetAuthor(),
+		Text:      req.Msg.GetText(),
+	})
+	if err != nil {
+		return nil, mapCommentConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.AddProductCommentResponse{
+		Comment: toProtoComment(c),
+	}), nil
+}
+
+func (h *commentHandler) GetProductCommentList(ctx context.Context, req *connect.Request[catalogv1.GetProductCommentListRequest]) (*connect.Response[catalogv1.GetProductCommentListResponse], error) {
+	result, err := h.getListHandler.Handle(ctx, comment.GetCommentListQuery{
+		ProductID: req.Msg.GetProductId(),
+		Page:      int(req.Msg.GetPage()),
+		Size:      int(req.Msg.GetSize()),
+	})
+	if err != nil {
+		return nil, mapCommentConnectError(err)
+	}
+
+	items := make([]*catalogv1.ProductComment, len(result.Items))
+	for i, c := range result.Items {
+		items[i] = toProtoComment(c)
+	}
+
+	return connect.NewResponse(&catalogv1.GetProductCommentListResponse{
+		Items: items,
+		Page:  int32(result.Page), //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		Size:  int32(result.Size), //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		Total: result.Total,
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoComment(c *comment.Comment) *catalogv1.ProductComment {
+	return &catalogv1.ProductComment{
+		Id:        c.ID,
+		ProductId: c.ProductID,
+		Author:    c.Author,
+		Text:      c.Text,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
+
+func mapCommentConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, comment.ErrInvalidCommentData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, comment.ErrProductNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
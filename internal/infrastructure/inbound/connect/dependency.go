@@ -0,0 +1,51 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/dependency"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// dependencyInterceptorPriority runs the interceptor inside the time budget (31),
+// so a request that ran out of time is still reported as such
+const dependencyInterceptorPriority = 32
+
+// dependencyModule provides the interceptor that reports cut-off dependencies as Unavailable
+func dependencyModule() fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			provideDependencyInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+func provideDependencyInterceptor() interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: dependencyInterceptorPriority,
+		Handler:  newDependencyUnaryInterceptor(),
+	}
+}
+
+// newDependencyUnaryInterceptor answers requests that failed on a dependency cut off by its circuit breaker
+// with Unavailable (HTTP 503) and a Retry-After header, whatever code the handler mapped the error to
+func newDependencyUnaryInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			var unavailable *dependency.UnavailableError
+			if err != nil && errors.As(err, &unavailable) {
+				connectErr := connect.NewError(connect.CodeUnavailable, unavailable)
+				connectErr.Meta().Set("Retry-After", strconv.Itoa(int(unavailable.RetryAfter.Seconds())))
+				return nil, connectErr
+			}
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type environmentSyncHandler struct {
+	syncHandler envsync.SyncCommandHandler
+}
+
+func (h *environmentSyncHandler) SyncFromSource(ctx context.Context, req *connect.Request[catalogv1.SyncFromSourceRequest]) (*connect.Response[catalogv1.SyncFromSourceResponse], error) {
+	res, err := h.syncHandler.Handle(ctx, envsync.SyncCommand{
+		AttributeIDs:       req.Msg.GetAttributeIds(),
+		CategoryIDs:        req.Msg.GetCategoryIds(),
+		ProductIDs:         req.Msg.GetProductIds(),
+		ProductCategoryIDs: req.Msg.GetProductCategoryIds(),
+		ProductLimit:       int(req.Msg.GetProductLimit()),
+	})
+	if err != nil {
+		return nil, mapEnvironmentSyncConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SyncFromSourceResponse{
+		TaxonomyChanges: lo.Map(res.Taxonomy, func(c taxonomy.Change, _ int) *catalogv1.TaxonomyChange {
+			return &catalogv1.TaxonomyChange{
+				Entity: toProtoTaxonomyEntity(c.Entity),
+				Id:     c.ID,
+				Key:    c.Key,
+				Action: toProtoTaxonomyChangeAction(c.Action),
+				Fields: c.Fields,
+			}
+		}),
+		Products: lo.Map(res.Products, func(r envsync.ProductResult, _ int) *catalogv1.SyncedProduct {
+			if r.Err != nil {
+				connectErr := mapProductConnectError(r.Err)
+				return &catalogv1.SyncedProduct{
+					Id:    r.ID,
+					Error: &catalogv1.ImportProductError{Code: connectErr.Code().String(), Message: connectErr.Message()},
+				}
+			}
+			return &catalogv1.SyncedProduct{
+				Id:      r.ID,
+				Product: toProtoProduct(r.Product),
+				Action:  toProtoImportAction(r.Action),
+			}
+		}),
+	}), nil
+}
+
+func mapEnvironmentSyncConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, envsync.ErrInvalidSyncRequest):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, envsync.ErrSourceNotConfigured):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, envsync.ErrSourceUnavailable):
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, mongo.ErrEntityNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return mapTaxonomyConnectError(err)
+	}
+}
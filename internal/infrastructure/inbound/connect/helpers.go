@@ -1,7 +1,14 @@
 package connect
 
 import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
 )
 
 // parseUUIDPtr parses a string into a *uuid.UUID, returning nil on failure.
@@ -12,3 +19,32 @@ func parseUUIDPtr(s string) *uuid.UUID {
 	}
 	return &u
 }
+
+// parseTimePtr converts an optional timestamp to the precision the service stores, returning nil when it isn't set.
+func parseTimePtr(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil //nolint:nilnil // an unset timestamp is not an error
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid timestamp: %w", err))
+	}
+	t := utc.Normalize(ts.AsTime())
+	return &t, nil
+}
+
+// int32ToIntPtr converts an optional int32 field, returning nil when it isn't set.
+func int32ToIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+// timestampPtr converts an optional time, returning nil when it isn't set.
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
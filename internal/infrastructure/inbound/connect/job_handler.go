@@ -0,0 +1,87 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type jobHandler struct {
+	getByIDHandler job.GetJobByIDQueryHandler
+}
+
+func (h *jobHandler) GetJob(ctx context.Context, req *connect.Request[catalogv1.GetJobRequest]) (*connect.Response[catalogv1.GetJobResponse], error) {
+	j, err := h.getByIDHandler.Handle(ctx, job.GetJobByIDQuery{ID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetJobResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoJob(j *job.Job) *catalogv1.Job {
+	pj := &catalogv1.Job{
+		Id:     j.ID,
+		Type:   string(j.Type),
+		Status: jobStatusToProto(j.Status),
+		Done:   j.Done,
+		Total:  j.Total,
+		Failed: j.Failed,
+		ItemErrors: lo.Map(j.ItemErrors, func(ie job.ItemError, _ int) *catalogv1.JobItemError {
+			return &catalogv1.JobItemError{Index: ie.Index, Item: ie.Item, Error: ie.Error}
+		}),
+		CreatedAt: timestamppb.New(j.CreatedAt),
+		UpdatedAt: timestamppb.New(j.UpdatedAt),
+	}
+	if len(j.Result) > 0 {
+		result := string(j.Result)
+		pj.Result = &result
+	}
+	if j.Error != "" {
+		pj.Error = &j.Error
+	}
+	if j.StartedAt != nil {
+		pj.StartedAt = timestamppb.New(*j.StartedAt)
+	}
+	if j.FinishedAt != nil {
+		pj.FinishedAt = timestamppb.New(*j.FinishedAt)
+	}
+	return pj
+}
+
+func jobStatusToProto(s job.Status) catalogv1.JobStatus {
+	switch s {
+	case job.StatusQueued:
+		return catalogv1.JobStatus_JOB_STATUS_QUEUED
+	case job.StatusRunning:
+		return catalogv1.JobStatus_JOB_STATUS_RUNNING
+	case job.StatusSucceeded:
+		return catalogv1.JobStatus_JOB_STATUS_SUCCEEDED
+	case job.StatusFailed:
+		return catalogv1.JobStatus_JOB_STATUS_FAILED
+	default:
+		return catalogv1.JobStatus_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func mapJobConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, job.ErrJobNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, job.ErrQueueFull):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, job.ErrNotLeader):
+		return connect.NewError(connect.CodeUnavailable, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
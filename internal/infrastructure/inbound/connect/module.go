@@ -5,9 +5,25 @@ import (
 
 	"connectrpc.com/connect"
 	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/privacy"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
 	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
 	"go.uber.org/fx"
 )
@@ -18,10 +34,34 @@ func Module() fx.Option {
 		fx.Provide(
 			newAttributeHandler,
 			newCategoryHandler,
+			newCategoryTemplateHandler,
+			newTaxonomyHandler,
+			newEnvironmentSyncHandler,
+			newPaletteHandler,
 			newProductHandler,
+			newReservationHandler,
+			newAvailabilityHandler,
+			newReplayHandler,
+			newSupplierHandler,
+			newCommentHandler,
+			newSavedViewHandler,
+			newJobHandler,
+			newQuotaHandler,
+			newAPIKeyHandler,
+			newPrivacyHandler,
+			newChangeHandler,
+			newProductStreamHandler,
 			provideProcedurePermissions,
 		),
-		fx.Invoke(registerConnectRoutes),
+		audienceModule(),
+		readOnlyModule(),
+		timeoutModule(),
+		dependencyModule(),
+		quotaModule(),
+		apiKeyModule(),
+		cacheControlModule(),
+		viewTrackingModule(),
+		fx.Invoke(registerConnectRoutes, registerProductStreamRoute),
 	)
 }
 
@@ -30,12 +70,18 @@ func newAttributeHandler(
 	updateHandler attribute.UpdateAttributeCommandHandler,
 	getByIDHandler attribute.GetAttributeByIDQueryHandler,
 	getListHandler attribute.GetAttributeListQueryHandler,
+	displayHandler attribute.SetAttributeDisplayCommandHandler,
+	deprecateHandler attribute.DeprecateOptionCommandHandler,
+	slugHandler attribute.GenerateSlugQueryHandler,
 ) *attributeHandler {
 	return &attributeHandler{
-		createHandler:  createHandler,
-		updateHandler:  updateHandler,
-		getByIDHandler: getByIDHandler,
-		getListHandler: getListHandler,
+		createHandler:    createHandler,
+		updateHandler:    updateHandler,
+		getByIDHandler:   getByIDHandler,
+		getListHandler:   getListHandler,
+		displayHandler:   displayHandler,
+		deprecateHandler: deprecateHandler,
+		slugHandler:      slugHandler,
 	}
 }
 
@@ -44,12 +90,64 @@ func newCategoryHandler(
 	updateHandler category.UpdateCategoryCommandHandler,
 	getByIDHandler category.GetCategoryByIDQueryHandler,
 	getListHandler category.GetListCategoriesQueryHandler,
+	displayHandler category.SetCategoryDisplayCommandHandler,
+	priceStatsHandler product.GetCategoryPriceStatsQueryHandler,
+	facetsHandler product.GetCategoryFacetsQueryHandler,
+	checkHandler product.CheckCategoryProductQueryHandler,
+	formHandler category.GetProductFormSchemaQueryHandler,
 ) *categoryHandler {
 	return &categoryHandler{
+		createHandler:     createHandler,
+		updateHandler:     updateHandler,
+		getByIDHandler:    getByIDHandler,
+		getListHandler:    getListHandler,
+		displayHandler:    displayHandler,
+		priceStatsHandler: priceStatsHandler,
+		facetsHandler:     facetsHandler,
+		checkHandler:      checkHandler,
+		formHandler:       formHandler,
+	}
+}
+
+func newCategoryTemplateHandler(
+	listHandler categorytemplate.ListTemplatesQueryHandler,
+	applyHandler categorytemplate.ApplyTemplateCommandHandler,
+) *categoryTemplateHandler {
+	return &categoryTemplateHandler{
+		listHandler:  listHandler,
+		applyHandler: applyHandler,
+	}
+}
+
+func newTaxonomyHandler(
+	exportAttributesHandler taxonomy.ExportAttributesQueryHandler,
+	importAttributesHandler taxonomy.ImportAttributesCommandHandler,
+	exportTaxonomyHandler taxonomy.ExportTaxonomyQueryHandler,
+	importTaxonomyHandler taxonomy.ImportTaxonomyCommandHandler,
+) *taxonomyHandler {
+	return &taxonomyHandler{
+		exportAttributesHandler: exportAttributesHandler,
+		importAttributesHandler: importAttributesHandler,
+		exportTaxonomyHandler:   exportTaxonomyHandler,
+		importTaxonomyHandler:   importTaxonomyHandler,
+	}
+}
+
+func newEnvironmentSyncHandler(syncHandler envsync.SyncCommandHandler) *environmentSyncHandler {
+	return &environmentSyncHandler{syncHandler: syncHandler}
+}
+
+func newPaletteHandler(
+	createHandler palette.CreatePaletteCommandHandler,
+	updateHandler palette.UpdatePaletteCommandHandler,
+	getByIDHandler palette.GetPaletteByIDQueryHandler,
+	listHandler palette.ListPalettesQueryHandler,
+) *paletteHandler {
+	return &paletteHandler{
 		createHandler:  createHandler,
 		updateHandler:  updateHandler,
 		getByIDHandler: getByIDHandler,
-		getListHandler: getListHandler,
+		listHandler:    listHandler,
 	}
 }
 
@@ -57,10 +155,86 @@ func newProductHandler(
 	createHandler product.CreateProductCommandHandler,
 	updateHandler product.UpdateProductCommandHandler,
 	deleteHandler product.DeleteProductCommandHandler,
+	mergeHandler product.StartMergeDuplicateAttributesCommandHandler,
+	findDupsHandler product.StartFindDuplicateProductsCommandHandler,
+	remapHandler product.StartRemapOptionCommandHandler,
+	resyncHandler product.StartResyncAttributeSlugsCommandHandler,
+	valuationHandler product.StartInventoryValuationCommandHandler,
+	mergeDupsHandler product.MergeProductsCommandHandler,
+	restoreHandler product.RestoreProductCommandHandler,
+	discontinueHandler product.DiscontinueProductCommandHandler,
+	recordViewHandler product.RecordProductViewCommandHandler,
+	experimentsHandler product.SetExperimentsCommandHandler,
+	viewLimiter *viewLimiter,
+	verifyHandler product.VerifyProductsQueryHandler,
+	importHandler product.ImportProductsCommandHandler,
 	getByIDHandler product.GetProductByIDQueryHandler,
+	getBySlugHandler product.GetProductBySlugQueryHandler,
 	getListHandler product.GetListProductsQueryHandler,
+	sampleHandler product.SampleProductsQueryHandler,
+	attrProductsHandler product.GetAttributeProductsQueryHandler,
+	uncategorizedHandler product.GetUncategorizedReportQueryHandler,
+	listConfigHandler product.GetListConfigQueryHandler,
 ) *productHandler {
 	return &productHandler{
+		createHandler:        createHandler,
+		updateHandler:        updateHandler,
+		deleteHandler:        deleteHandler,
+		mergeHandler:         mergeHandler,
+		findDupsHandler:      findDupsHandler,
+		remapHandler:         remapHandler,
+		resyncHandler:        resyncHandler,
+		valuationHandler:     valuationHandler,
+		mergeDupsHandler:     mergeDupsHandler,
+		restoreHandler:       restoreHandler,
+		discontinueHandler:   discontinueHandler,
+		recordViewHandler:    recordViewHandler,
+		experimentsHandler:   experimentsHandler,
+		viewLimiter:          viewLimiter,
+		verifyHandler:        verifyHandler,
+		importHandler:        importHandler,
+		getByIDHandler:       getByIDHandler,
+		getBySlugHandler:     getBySlugHandler,
+		getListHandler:       getListHandler,
+		sampleHandler:        sampleHandler,
+		attrProductsHandler:  attrProductsHandler,
+		uncategorizedHandler: uncategorizedHandler,
+		listConfigHandler:    listConfigHandler,
+	}
+}
+
+func newReservationHandler(
+	reserveHandler reservation.ReserveStockCommandHandler,
+	releaseHandler reservation.ReleaseStockCommandHandler,
+) *reservationHandler {
+	return &reservationHandler{
+		reserveHandler: reserveHandler,
+		releaseHandler: releaseHandler,
+	}
+}
+
+func newAvailabilityHandler(
+	setHandler availability.SetScheduleCommandHandler,
+	getHandler availability.GetAvailabilityQueryHandler,
+) *availabilityHandler {
+	return &availabilityHandler{
+		setHandler: setHandler,
+		getHandler: getHandler,
+	}
+}
+
+func newReplayHandler(service replay.Service) *replayHandler {
+	return &replayHandler{service: service}
+}
+
+func newSupplierHandler(
+	createHandler supplier.CreateSupplierCommandHandler,
+	updateHandler supplier.UpdateSupplierCommandHandler,
+	deleteHandler supplier.DeleteSupplierCommandHandler,
+	getByIDHandler supplier.GetSupplierByIDQueryHandler,
+	getListHandler supplier.GetSupplierListQueryHandler,
+) *supplierHandler {
+	return &supplierHandler{
 		createHandler:  createHandler,
 		updateHandler:  updateHandler,
 		deleteHandler:  deleteHandler,
@@ -69,14 +243,88 @@ func newProductHandler(
 	}
 }
 
+func newCommentHandler(
+	addHandler comment.AddCommentCommandHandler,
+	getListHandler comment.GetCommentListQueryHandler,
+) *commentHandler {
+	return &commentHandler{
+		addHandler:     addHandler,
+		getListHandler: getListHandler,
+	}
+}
+
+func newSavedViewHandler(
+	createHandler savedview.CreateSavedViewCommandHandler,
+	updateHandler savedview.UpdateSavedViewCommandHandler,
+	deleteHandler savedview.DeleteSavedViewCommandHandler,
+	getByIDHandler savedview.GetSavedViewByIDQueryHandler,
+	getListHandler savedview.GetSavedViewListQueryHandler,
+	executeHandler savedview.ExecuteSavedViewQueryHandler,
+) *savedViewHandler {
+	return &savedViewHandler{
+		createHandler:  createHandler,
+		updateHandler:  updateHandler,
+		deleteHandler:  deleteHandler,
+		getByIDHandler: getByIDHandler,
+		getListHandler: getListHandler,
+		executeHandler: executeHandler,
+	}
+}
+
+func newJobHandler(getByIDHandler job.GetJobByIDQueryHandler) *jobHandler {
+	return &jobHandler{getByIDHandler: getByIDHandler}
+}
+
+func newQuotaHandler(usageHandler quota.GetUsageQueryHandler) *quotaHandler {
+	return &quotaHandler{usageHandler: usageHandler}
+}
+
+func newAPIKeyHandler(
+	createHandler apikey.CreateAPIKeyCommandHandler,
+	rotateHandler apikey.RotateAPIKeyCommandHandler,
+	revokeHandler apikey.RevokeAPIKeyCommandHandler,
+	getListHandler apikey.GetAPIKeyListQueryHandler,
+) *apiKeyHandler {
+	return &apiKeyHandler{
+		createHandler:  createHandler,
+		rotateHandler:  rotateHandler,
+		revokeHandler:  revokeHandler,
+		getListHandler: getListHandler,
+	}
+}
+
+func newPrivacyHandler(scrubHandler privacy.ScrubUserCommandHandler) *privacyHandler {
+	return &privacyHandler{scrubHandler: scrubHandler}
+}
+
+func newChangeHandler(getChangesHandler change.GetChangesQueryHandler) *changeHandler {
+	return &changeHandler{getChangesHandler: getChangesHandler}
+}
+
 func registerConnectRoutes(
 	mux *http.ServeMux,
 	attrHandler *attributeHandler,
 	catHandler *categoryHandler,
+	tmplHandler *categoryTemplateHandler,
+	taxHandler *taxonomyHandler,
+	syncHandler *environmentSyncHandler,
+	palHandler *paletteHandler,
 	prodHandler *productHandler,
+	resHandler *reservationHandler,
+	availHandler *availabilityHandler,
+	replHandler *replayHandler,
+	supHandler *supplierHandler,
+	comHandler *commentHandler,
+	viewHandler *savedViewHandler,
+	jobHandler *jobHandler,
+	quotaHandler *quotaHandler,
+	keyHandler *apiKeyHandler,
+	privHandler *privacyHandler,
+	chgHandler *changeHandler,
 	interceptors []connect.Interceptor,
+	compressionCfg compression.Config,
 ) {
-	opts := connect.WithInterceptors(interceptors...)
+	opts := connect.WithHandlerOptions(append(compressionCfg.ConnectOptions(), connect.WithInterceptors(interceptors...))...)
 
 	attrPath, attrH := catalogv1connect.NewAttributeServiceHandler(attrHandler, opts)
 	mux.Handle(attrPath, attrH)
@@ -84,24 +332,145 @@ func registerConnectRoutes(
 	catPath, catH := catalogv1connect.NewCategoryServiceHandler(catHandler, opts)
 	mux.Handle(catPath, catH)
 
+	tmplPath, tmplH := catalogv1connect.NewCategoryTemplateServiceHandler(tmplHandler, opts)
+	mux.Handle(tmplPath, tmplH)
+
+	taxPath, taxH := catalogv1connect.NewTaxonomyServiceHandler(taxHandler, opts)
+	mux.Handle(taxPath, taxH)
+
+	syncPath, syncH := catalogv1connect.NewEnvironmentSyncServiceHandler(syncHandler, opts)
+	mux.Handle(syncPath, syncH)
+
+	palPath, palH := catalogv1connect.NewPaletteServiceHandler(palHandler, opts)
+	mux.Handle(palPath, palH)
+
 	prodPath, prodH := catalogv1connect.NewProductServiceHandler(prodHandler, opts)
 	mux.Handle(prodPath, prodH)
+
+	resPath, resH := catalogv1connect.NewReservationServiceHandler(resHandler, opts)
+	mux.Handle(resPath, resH)
+
+	availPath, availH := catalogv1connect.NewAvailabilityServiceHandler(availHandler, opts)
+	mux.Handle(availPath, availH)
+
+	replPath, replH := catalogv1connect.NewReplayServiceHandler(replHandler, opts)
+	mux.Handle(replPath, replH)
+
+	supPath, supH := catalogv1connect.NewSupplierServiceHandler(supHandler, opts)
+	mux.Handle(supPath, supH)
+
+	comPath, comH := catalogv1connect.NewProductCommentServiceHandler(comHandler, opts)
+	mux.Handle(comPath, comH)
+
+	viewPath, viewH := catalogv1connect.NewSavedViewServiceHandler(viewHandler, opts)
+	mux.Handle(viewPath, viewH)
+
+	jobPath, jobH := catalogv1connect.NewJobServiceHandler(jobHandler, opts)
+	mux.Handle(jobPath, jobH)
+
+	quotaPath, quotaH := catalogv1connect.NewQuotaServiceHandler(quotaHandler, opts)
+	mux.Handle(quotaPath, quotaH)
+
+	keyPath, keyH := catalogv1connect.NewApiKeyServiceHandler(keyHandler, opts)
+	mux.Handle(keyPath, keyH)
+
+	privPath, privH := catalogv1connect.NewPrivacyServiceHandler(privHandler, opts)
+	mux.Handle(privPath, privH)
+
+	chgPath, chgH := catalogv1connect.NewChangeServiceHandler(chgHandler, opts)
+	mux.Handle(chgPath, chgH)
 }
 
 func provideProcedurePermissions() validation.ProcedurePermissions {
 	return validation.ProcedurePermissions{
-		catalogv1connect.AttributeServiceCreateAttributeProcedure:  {"attributes:write"},
-		catalogv1connect.AttributeServiceUpdateAttributeProcedure:  {"attributes:write"},
-		catalogv1connect.AttributeServiceGetAttributeByIdProcedure: {"attributes:read"},
-		catalogv1connect.AttributeServiceGetAttributeListProcedure: {"attributes:read"},
-		catalogv1connect.CategoryServiceCreateCategoryProcedure:    {"categories:write"},
-		catalogv1connect.CategoryServiceUpdateCategoryProcedure:    {"categories:write"},
-		catalogv1connect.CategoryServiceGetCategoryByIdProcedure:   {"categories:read"},
-		catalogv1connect.CategoryServiceGetCategoryListProcedure:   {"categories:read"},
-		catalogv1connect.ProductServiceCreateProductProcedure:      {"products:write"},
-		catalogv1connect.ProductServiceUpdateProductProcedure:      {"products:write"},
-		catalogv1connect.ProductServiceDeleteProductProcedure:      {"products:delete"},
-		catalogv1connect.ProductServiceGetProductByIdProcedure:     {"products:read"},
-		catalogv1connect.ProductServiceGetProductListProcedure:     {"products:read"},
+		catalogv1connect.AttributeServiceCreateAttributeProcedure:          {"attributes:write"},
+		catalogv1connect.AttributeServiceUpdateAttributeProcedure:          {"attributes:write"},
+		catalogv1connect.AttributeServiceGetAttributeByIdProcedure:         {"attributes:read"},
+		catalogv1connect.AttributeServiceGetAttributeListProcedure:         {"attributes:read"},
+		catalogv1connect.AttributeServiceSetAttributeDisplayProcedure:      {"attributes:write"},
+		catalogv1connect.AttributeServiceDeprecateAttributeOptionProcedure: {"attributes:write"},
+		catalogv1connect.AttributeServiceGenerateAttributeSlugProcedure:    {"attributes:write"},
+		catalogv1connect.CategoryServiceCreateCategoryProcedure:            {"categories:write"},
+		catalogv1connect.CategoryServiceUpdateCategoryProcedure:            {"categories:write"},
+		catalogv1connect.CategoryServiceGetCategoryByIdProcedure:           {"categories:read"},
+		catalogv1connect.CategoryServiceGetCategoryListProcedure:           {"categories:read"},
+		catalogv1connect.CategoryServiceSetCategoryDisplayProcedure:        {"categories:write"},
+		catalogv1connect.CategoryServiceGetCategoryPriceStatsProcedure:     {"products:read"},
+		catalogv1connect.CategoryServiceGetCategoryFacetsProcedure:         {"products:read"},
+		catalogv1connect.CategoryServiceCheckCategoryProductProcedure:      {"products:read"},
+		catalogv1connect.CategoryServiceGetProductFormSchemaProcedure:      {"categories:read"},
+		catalogv1connect.ProductServiceCreateProductProcedure:              {"products:write"},
+		catalogv1connect.ProductServiceUpdateProductProcedure:              {"products:write"},
+		catalogv1connect.ProductServiceDeleteProductProcedure:              {"products:delete"},
+		catalogv1connect.ProductServiceGetProductByIdProcedure:             {"products:read"},
+		catalogv1connect.ProductServiceGetProductBySlugProcedure:           {"products:read"},
+		catalogv1connect.ProductServiceGetProductListProcedure:             {"products:read"},
+		catalogv1connect.ProductServiceVerifyProductsProcedure:             {"products:read"},
+		catalogv1connect.ProductServiceSampleProductsProcedure:             {"products:read"},
+		catalogv1connect.ProductServiceGetAttributeProductsProcedure:       {"products:read"},
+		catalogv1connect.ProductServiceGetUncategorizedReportProcedure:     {"products:read"},
+		catalogv1connect.ProductServiceGetProductListConfigProcedure:       {"products:read"},
+		catalogv1connect.ProductServiceImportProductsProcedure:             {"products:write"},
+		catalogv1connect.ProductServiceMergeProductsProcedure:              {"products:delete"},
+		catalogv1connect.ProductServiceRestoreProductProcedure:             {"products:write"},
+		catalogv1connect.ProductServiceDiscontinueProductProcedure:         {"products:write"},
+		catalogv1connect.ProductServiceSetProductExperimentsProcedure:      {"products:write"},
+		// Storefronts report views with their read access; views only feed the trending sort
+		catalogv1connect.ProductServiceRecordProductViewProcedure: {"products:read"},
+		// Checkout services hold stock during payment with a dedicated permission
+		catalogv1connect.ReservationServiceReserveStockProcedure:             {"products:reserve"},
+		catalogv1connect.ReservationServiceReleaseStockProcedure:             {"products:reserve"},
+		catalogv1connect.AvailabilityServiceSetAvailabilityScheduleProcedure: {"products:write"},
+		catalogv1connect.AvailabilityServiceGetAvailabilityProcedure:         {"products:read"},
+		// Replays and cleanups act on the whole catalog of a tenant
+		catalogv1connect.ProductServiceMergeDuplicateProductAttributesProcedure: {"catalog:admin"},
+		catalogv1connect.ProductServiceFindDuplicateProductsProcedure:           {"catalog:admin"},
+		catalogv1connect.ProductServiceRemapDeprecatedOptionProcedure:           {"catalog:admin"},
+		catalogv1connect.ProductServiceResyncAttributeSlugsProcedure:            {"catalog:admin"},
+		catalogv1connect.ProductServiceStartInventoryValuationProcedure:         {"catalog:admin"},
+		catalogv1connect.ReplayServiceStartReplayProcedure:                      {"catalog:admin"},
+		catalogv1connect.ReplayServiceGetReplayStatusProcedure:                  {"catalog:admin"},
+		// Applying a template writes attributes as well as a category, which no single write permission covers
+		catalogv1connect.CategoryTemplateServiceListCategoryTemplatesProcedure: {"categories:read"},
+		catalogv1connect.CategoryTemplateServiceApplyCategoryTemplateProcedure: {"catalog:admin"},
+		// Bundles carry attributes together with their category bindings
+		catalogv1connect.TaxonomyServiceExportAttributesProcedure:      {"attributes:read"},
+		catalogv1connect.TaxonomyServiceImportAttributesProcedure:      {"catalog:admin"},
+		catalogv1connect.TaxonomyServiceExportTaxonomyProcedure:        {"categories:read"},
+		catalogv1connect.TaxonomyServiceImportTaxonomyProcedure:        {"catalog:admin"},
+		catalogv1connect.EnvironmentSyncServiceSyncFromSourceProcedure: {"catalog:admin"},
+		// Palettes are attribute master data
+		catalogv1connect.PaletteServiceCreatePaletteProcedure:  {"attributes:write"},
+		catalogv1connect.PaletteServiceUpdatePaletteProcedure:  {"attributes:write"},
+		catalogv1connect.PaletteServiceGetPaletteByIdProcedure: {"attributes:read"},
+		catalogv1connect.PaletteServiceGetPaletteListProcedure: {"attributes:read"},
+		// Suppliers are managed by purchasing
+		catalogv1connect.SupplierServiceCreateSupplierProcedure:  {"suppliers:write"},
+		catalogv1connect.SupplierServiceUpdateSupplierProcedure:  {"suppliers:write"},
+		catalogv1connect.SupplierServiceDeleteSupplierProcedure:  {"suppliers:delete"},
+		catalogv1connect.SupplierServiceGetSupplierByIdProcedure: {"suppliers:read"},
+		catalogv1connect.SupplierServiceGetSupplierListProcedure: {"suppliers:read"},
+		// Comments are internal to the merchandising team, so reading them needs write access as well
+		catalogv1connect.ProductCommentServiceAddProductCommentProcedure:     {"products:write"},
+		catalogv1connect.ProductCommentServiceGetProductCommentListProcedure: {"products:write"},
+		// Saved views serve the admin lists; executing one also checks read access to its entity type
+		catalogv1connect.SavedViewServiceCreateSavedViewProcedure:  {"products:write", "categories:write"},
+		catalogv1connect.SavedViewServiceUpdateSavedViewProcedure:  {"products:write", "categories:write"},
+		catalogv1connect.SavedViewServiceDeleteSavedViewProcedure:  {"products:write", "categories:write"},
+		catalogv1connect.SavedViewServiceGetSavedViewByIdProcedure: {"products:read", "categories:read"},
+		catalogv1connect.SavedViewServiceGetSavedViewListProcedure: {"products:read", "categories:read"},
+		catalogv1connect.SavedViewServiceExecuteSavedViewProcedure: {"products:read", "categories:read"},
+		// Jobs are started by bulk operations, whose callers poll them
+		catalogv1connect.JobServiceGetJobProcedure: {"catalog:admin", "products:write"},
+		// Usage tells back-office users how much of the plan is left
+		catalogv1connect.QuotaServiceGetQuotaUsageProcedure: {"catalog:admin", "products:write", "categories:write", "attributes:write"},
+		// API keys grant access to the tenant's catalog, so only admins manage them
+		catalogv1connect.ApiKeyServiceCreateApiKeyProcedure: {"catalog:admin"},
+		catalogv1connect.ApiKeyServiceListApiKeysProcedure:  {"catalog:admin"},
+		catalogv1connect.ApiKeyServiceRotateApiKeyProcedure: {"catalog:admin"},
+		catalogv1connect.ApiKeyServiceRevokeApiKeyProcedure: {"catalog:admin"},
+		catalogv1connect.PrivacyServiceScrubUserProcedure:   {"catalog:admin"},
+		// The feed carries IDs and versions only, readers fetch the entities with their own permissions
+		catalogv1connect.ChangeServiceGetChangesProcedure: {"products:read", "categories:read"},
 	}
 }
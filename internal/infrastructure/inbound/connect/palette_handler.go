@@ -0,0 +1,117 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type paletteHandler struct {
+	createHandler  palette.CreatePaletteCommandHandler
+	updateHandler  palette.UpdatePaletteCommandHandler
+	getByIDHandler palette.GetPaletteByIDQueryHandler
+	listHandler    palette.ListPalettesQueryHandler
+}
+
+func (h *paletteHandler) CreatePalette(ctx context.Context, req *connect.Request[catalogv1.CreatePaletteRequest]) (*connect.Response[catalogv1.CreatePaletteResponse], error) {
+	created, err := h.createHandler.Handle(ctx, palette.CreatePaletteCommand{
+		Name:   req.Msg.GetName(),
+		Colors: protoToPaletteColors(req.Msg.GetColors()),
+	})
+	if err != nil {
+		return nil, mapPaletteConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.CreatePaletteResponse{
+		Palette: toProtoPalette(created),
+	}), nil
+}
+
+func (h *paletteHandler) UpdatePalette(ctx context.Context, req *connect.Request[catalogv1.UpdatePaletteRequest]) (*connect.Response[catalogv1.UpdatePaletteResponse], error) {
+	updated, err := h.updateHandler.Handle(ctx, palette.UpdatePaletteCommand{
+		ID:      req.Msg.GetId(),
+		Version: int(req.Msg.GetVersion()),
+		Name:    req.Msg.GetName(),
+		Colors:  protoToPaletteColors(req.Msg.GetColors()),
+	})
+	if err != nil {
+		return nil, mapPaletteConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.UpdatePaletteResponse{
+		Palette: toProtoPalette(updated),
+	}), nil
+}
+
+func (h *paletteHandler) GetPaletteById(ctx context.Context, req *connect.Request[catalogv1.GetPaletteByIdRequest]) (*connect.Response[catalogv1.GetPaletteByIdResponse], error) { //nolint:revive
+	p, err := h.getByIDHandler.Handle(ctx, palette.GetPaletteByIDQuery{ID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapPaletteConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetPaletteByIdResponse{
+		Palette: toProtoPalette(p),
+	}), nil
+}
+
+func (h *paletteHandler) GetPaletteList(ctx context.Context, _ *connect.Request[catalogv1.GetPaletteListRequest]) (*connect.Response[catalogv1.GetPaletteListResponse], error) {
+	palettes, err := h.listHandler.Handle(ctx)
+	if err != nil {
+		return nil, mapPaletteConnectError(err)
+	}
+
+	items := make([]*catalogv1.Palette, len(palettes))
+	for i, p := range palettes {
+		items[i] = toProtoPalette(p)
+	}
+
+	return connect.NewResponse(&catalogv1.GetPaletteListResponse{
+		Items: items,
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoPalette(p *palette.Palette) *catalogv1.Palette {
+	colors := make([]*catalogv1.PaletteColor, len(p.Colors))
+	for i, c := range p.Colors {
+		colors[i] = &catalogv1.PaletteColor{Name: c.Name, Code: c.Code}
+	}
+	return &catalogv1.Palette{
+		Id:         p.ID,
+		Version:    int64(p.Version),
+		Name:       p.Name,
+		Colors:     colors,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+		ModifiedAt: timestamppb.New(p.ModifiedAt),
+	}
+}
+
+func protoToPaletteColors(colors []*catalogv1.PaletteColor) []palette.Color {
+	result := make([]palette.Color, len(colors))
+	for i, c := range colors {
+		result[i] = palette.Color{Name: c.GetName(), Code: c.GetCode()}
+	}
+	return result
+}
+
+func mapPaletteConnectError(err error) *connect.Error {
+	var inUse *palette.ColorsInUseError
+	switch {
+	case errors.As(err, &inUse):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, palette.ErrInvalidPaletteData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, palette.ErrPaletteNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
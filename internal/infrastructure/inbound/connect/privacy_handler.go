@@ -0,0 +1,32 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/privacy"
+	"github.com/samber/lo"
+)
+
+type privacyHandler struct {
+	scrubHandler privacy.ScrubUserCommandHandler
+}
+
+func (h *privacyHandler) ScrubUser(ctx context.Context, req *connect.Request[catalogv1.ScrubUserRequest]) (*connect.Response[catalogv1.ScrubUserResponse], error) {
+	report, err := h.scrubHandler.Handle(ctx, privacy.ScrubUserCommand{UserID: req.Msg.GetUserId()})
+	if err != nil {
+		if errors.Is(err, privacy.ErrInvalidScrubRequest) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&catalogv1.ScrubUserResponse{
+		Collections: lo.Map(report.Collections, func(c privacy.CollectionReport, _ int) *catalogv1.ScrubbedCollection {
+			return &catalogv1.ScrubbedCollection{Collection: c.Collection, Scrubbed: c.Scrubbed, Remaining: c.Remaining}
+		}),
+		Verified: report.Verified,
+	}), nil
+}
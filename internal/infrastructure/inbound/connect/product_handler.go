@@ -8,47 +8,78 @@ import (
 	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+	"github.com/samber/lo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type productHandler struct {
-	createHandler  product.CreateProductCommandHandler
-	updateHandler  product.UpdateProductCommandHandler
-	deleteHandler  product.DeleteProductCommandHandler
-	getByIDHandler product.GetProductByIDQueryHandler
-	getListHandler product.GetListProductsQueryHandler
+	createHandler        product.CreateProductCommandHandler
+	updateHandler        product.UpdateProductCommandHandler
+	deleteHandler        product.DeleteProductCommandHandler
+	mergeHandler         product.StartMergeDuplicateAttributesCommandHandler
+	findDupsHandler      product.StartFindDuplicateProductsCommandHandler
+	remapHandler         product.StartRemapOptionCommandHandler
+	resyncHandler        product.StartResyncAttributeSlugsCommandHandler
+	valuationHandler     product.StartInventoryValuationCommandHandler
+	mergeDupsHandler     product.MergeProductsCommandHandler
+	restoreHandler       product.RestoreProductCommandHandler
+	discontinueHandler   product.DiscontinueProductCommandHandler
+	recordViewHandler    product.RecordProductViewCommandHandler
+	experimentsHandler   product.SetExperimentsCommandHandler
+	viewLimiter          *viewLimiter
+	verifyHandler        product.VerifyProductsQueryHandler
+	importHandler        product.ImportProductsCommandHandler
+	getByIDHandler       product.GetProductByIDQueryHandler
+	getBySlugHandler     product.GetProductBySlugQueryHandler
+	getListHandler       product.GetListProductsQueryHandler
+	sampleHandler        product.SampleProductsQueryHandler
+	attrProductsHandler  product.GetAttributeProductsQueryHandler
+	uncategorizedHandler product.GetUncategorizedReportQueryHandler
+	listConfigHandler    product.GetListConfigQueryHandler
 }
 
 func (h *productHandler) CreateProduct(ctx context.Context, req *connect.Request[catalogv1.CreateProductRequest]) (*connect.Response[catalogv1.CreateProductResponse], error) {
-	cmd := product.CreateProductCommand{
-		Name:        req.Msg.GetName(),
-		Description: req.Msg.Description,
-		Price:       req.Msg.GetPrice(),
-		Quantity:    int(req.Msg.GetQuantity()),
-		ImageID:     req.Msg.ImageId,
-		CategoryID:  req.Msg.CategoryId,
-		Enabled:     req.Msg.GetEnabled(),
-		Attributes:  protoToAttributeValues(req.Msg.GetAttributes()),
-	}
-	if req.Msg.Id != nil {
-		cmd.ID = parseUUIDPtr(*req.Msg.Id)
-	}
-
-	created, err := h.createHandler.Handle(ctx, cmd)
+	created, err := h.createHandler.Handle(ctx, protoToCreateProductCommand(req.Msg))
 	if err != nil {
 		return nil, mapProductConnectError(err)
 	}
 
 	return connect.NewResponse(&catalogv1.CreateProductResponse{
-		Product: toProtoProduct(created),
+		Product:  toProtoProduct(created),
+		Warnings: toProtoWarnings(created.Warnings()),
 	}), nil
 }
 
+func protoToCreateProductCommand(msg *catalogv1.CreateProductRequest) product.CreateProductCommand {
+	cmd := product.CreateProductCommand{
+		Name:        msg.GetName(),
+		Slug:        msg.GetSlug(),
+		Type:        protoProductTypeToString(msg.GetType()),
+		Description: msg.Description,
+		Price:       msg.GetPrice(),
+		Quantity:    int(msg.GetQuantity()),
+		ImageID:     msg.ImageId,
+		CategoryID:  msg.CategoryId,
+		Enabled:     msg.GetEnabled(),
+		Attributes:  protoToAttributeValues(msg.GetAttributes()),
+		Metadata:    msg.GetMetadata(),
+		Supplier:    protoToSupplierRef(msg.SupplierId, msg.SupplierSku),
+		ExternalID:  msg.ExternalId,
+	}
+	if msg.Id != nil {
+		cmd.ID = parseUUIDPtr(*msg.Id)
+	}
+	return cmd
+}
+
 func (h *productHandler) UpdateProduct(ctx context.Context, req *connect.Request[catalogv1.UpdateProductRequest]) (*connect.Response[catalogv1.UpdateProductResponse], error) {
 	cmd := product.UpdateProductCommand{
 		ID:          req.Msg.GetId(),
 		Version:     int(req.Msg.GetVersion()),
 		Name:        req.Msg.GetName(),
+		Slug:        req.Msg.GetSlug(),
 		Description: req.Msg.Description,
 		Price:       req.Msg.GetPrice(),
 		Quantity:    int(req.Msg.GetQuantity()),
@@ -56,6 +87,9 @@ func (h *productHandler) UpdateProduct(ctx context.Context, req *connect.Request
 		CategoryID:  req.Msg.CategoryId,
 		Enabled:     req.Msg.GetEnabled(),
 		Attributes:  protoToAttributeValues(req.Msg.GetAttributes()),
+		Metadata:    req.Msg.GetMetadata(),
+		Supplier:    protoToSupplierRef(req.Msg.SupplierId, req.Msg.SupplierSku),
+		ExternalID:  req.Msg.ExternalId,
 	}
 
 	updated, err := h.updateHandler.Handle(ctx, cmd)
@@ -64,12 +98,23 @@ func (h *productHandler) UpdateProduct(ctx context.Context, req *connect.Request
 	}
 
 	return connect.NewResponse(&catalogv1.UpdateProductResponse{
-		Product: toProtoProduct(updated),
+		Product:  toProtoProduct(updated),
+		Warnings: toProtoWarnings(updated.Warnings()),
 	}), nil
 }
 
 func (h *productHandler) GetProductById(ctx context.Context, req *connect.Request[catalogv1.GetProductByIdRequest]) (*connect.Response[catalogv1.GetProductByIdResponse], error) { //nolint:revive
-	q := product.GetProductByIDQuery{ID: req.Msg.GetId()}
+	asOf, err := parseTimePtr(req.Msg.GetAsOf())
+	if err != nil {
+		return nil, err
+	}
+	q := product.GetProductByIDQuery{
+		ID:              req.Msg.GetId(),
+		AsOf:            asOf,
+		IncludeArchived: req.Msg.GetIncludeArchived(),
+		Embed:           protoToEmbeds(req.Msg.GetEmbed()),
+		Tenant:          tenant.MustSlugFromContext(ctx),
+	}
 
 	found, err := h.getByIDHandler.Handle(ctx, q)
 	if err != nil {
@@ -81,6 +126,22 @@ func (h *productHandler) GetProductById(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
+func (h *productHandler) GetProductBySlug(ctx context.Context, req *connect.Request[catalogv1.GetProductBySlugRequest]) (*connect.Response[catalogv1.GetProductBySlugResponse], error) {
+	found, err := h.getBySlugHandler.Handle(ctx, product.GetProductBySlugQuery{
+		Slug:   req.Msg.GetSlug(),
+		Embed:  protoToEmbeds(req.Msg.GetEmbed()),
+		Tenant: tenant.MustSlugFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetProductBySlugResponse{
+		Product: toProtoProduct(found.Product),
+		MovedTo: found.MovedTo,
+	}), nil
+}
+
 func (h *productHandler) DeleteProduct(ctx context.Context, req *connect.Request[catalogv1.DeleteProductRequest]) (*connect.Response[catalogv1.DeleteProductResponse], error) {
 	cmd := product.DeleteProductCommand{ID: req.Msg.GetId()}
 
@@ -91,19 +152,231 @@ func (h *productHandler) DeleteProduct(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&catalogv1.DeleteProductResponse{}), nil
 }
 
+func (h *productHandler) MergeDuplicateProductAttributes(ctx context.Context, _ *connect.Request[catalogv1.MergeDuplicateProductAttributesRequest]) (*connect.Response[catalogv1.MergeDuplicateProductAttributesResponse], error) {
+	j, err := h.mergeHandler.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	if err != nil {
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.MergeDuplicateProductAttributesResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+func (h *productHandler) FindDuplicateProducts(ctx context.Context, _ *connect.Request[catalogv1.FindDuplicateProductsRequest]) (*connect.Response[catalogv1.FindDuplicateProductsResponse], error) {
+	j, err := h.findDupsHandler.Handle(ctx)
+	if err != nil {
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.FindDuplicateProductsResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+func (h *productHandler) RemapDeprecatedOption(ctx context.Context, req *connect.Request[catalogv1.RemapDeprecatedOptionRequest]) (*connect.Response[catalogv1.RemapDeprecatedOptionResponse], error) {
+	j, err := h.remapHandler.Handle(ctx, product.RemapOptionCommand{
+		AttributeID: req.Msg.GetAttributeId(),
+		OptionSlug:  req.Msg.GetOptionSlug(),
+	})
+	if err != nil {
+		if errors.Is(err, product.ErrInvalidProductData) {
+			return nil, mapProductConnectError(err)
+		}
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.RemapDeprecatedOptionResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+func (h *productHandler) ResyncAttributeSlugs(ctx context.Context, _ *connect.Request[catalogv1.ResyncAttributeSlugsRequest]) (*connect.Response[catalogv1.ResyncAttributeSlugsResponse], error) {
+	j, err := h.resyncHandler.Handle(ctx)
+	if err != nil {
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ResyncAttributeSlugsResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+func (h *productHandler) StartInventoryValuation(ctx context.Context, req *connect.Request[catalogv1.StartInventoryValuationRequest]) (*connect.Response[catalogv1.StartInventoryValuationResponse], error) {
+	j, err := h.valuationHandler.Handle(ctx, product.StartInventoryValuationCommand{CostKey: req.Msg.GetCostKey()})
+	if err != nil {
+		if errors.Is(err, product.ErrInvalidProductData) {
+			return nil, mapProductConnectError(err)
+		}
+		return nil, mapJobConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.StartInventoryValuationResponse{
+		Job: toProtoJob(j),
+	}), nil
+}
+
+func (h *productHandler) MergeProducts(ctx context.Context, req *connect.Request[catalogv1.MergeProductsRequest]) (*connect.Response[catalogv1.MergeProductsResponse], error) {
+	kept, err := h.mergeDupsHandler.Handle(ctx, product.MergeProductsCommand{
+		KeepID:       req.Msg.GetKeepId(),
+		DuplicateIDs: req.Msg.GetDuplicateIds(),
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.MergeProductsResponse{
+		Product: toProtoProduct(kept),
+	}), nil
+}
+
+func (h *productHandler) RestoreProduct(ctx context.Context, req *connect.Request[catalogv1.RestoreProductRequest]) (*connect.Response[catalogv1.RestoreProductResponse], error) {
+	restored, err := h.restoreHandler.Handle(ctx, product.RestoreProductCommand{ID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.RestoreProductResponse{
+		Product: toProtoProduct(restored),
+	}), nil
+}
+
+func (h *productHandler) DiscontinueProduct(ctx context.Context, req *connect.Request[catalogv1.DiscontinueProductRequest]) (*connect.Response[catalogv1.DiscontinueProductResponse], error) {
+	discontinued, err := h.discontinueHandler.Handle(ctx, product.DiscontinueProductCommand{
+		ID:                   req.Msg.GetId(),
+		Version:              int(req.Msg.GetVersion()),
+		ReplacementProductID: req.Msg.ReplacementProductId,
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.DiscontinueProductResponse{
+		Product: toProtoProduct(discontinued),
+	}), nil
+}
+
+func (h *productHandler) SetProductExperiments(ctx context.Context, req *connect.Request[catalogv1.SetProductExperimentsRequest]) (*connect.Response[catalogv1.SetProductExperimentsResponse], error) {
+	updated, err := h.experimentsHandler.Handle(ctx, product.SetExperimentsCommand{
+		ID:          req.Msg.GetId(),
+		Version:     int(req.Msg.GetVersion()),
+		Experiments: req.Msg.GetExperiments(),
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SetProductExperimentsResponse{
+		Product: toProtoProduct(updated),
+	}), nil
+}
+
+func (h *productHandler) ImportProducts(ctx context.Context, req *connect.Request[catalogv1.ImportProductsRequest]) (*connect.Response[catalogv1.ImportProductsResponse], error) {
+	cmds := lo.Map(req.Msg.GetProducts(), func(p *catalogv1.CreateProductRequest, _ int) product.CreateProductCommand {
+		return protoToCreateProductCommand(p)
+	})
+
+	results, err := h.importHandler.Handle(ctx, product.ImportProductsCommand{Products: cmds})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ImportProductsResponse{
+		Results: lo.Map(results, func(r product.ImportResult, _ int) *catalogv1.ImportProductResult {
+			if r.Err != nil {
+				connectErr := mapProductConnectError(r.Err)
+				return &catalogv1.ImportProductResult{
+					Error: &catalogv1.ImportProductError{Code: connectErr.Code().String(), Message: connectErr.Message()},
+				}
+			}
+			return &catalogv1.ImportProductResult{
+				Product:  toProtoProduct(r.Product),
+				Action:   toProtoImportAction(r.Action),
+				Warnings: toProtoWarnings(r.Warnings),
+			}
+		}),
+	}), nil
+}
+
+func toProtoWarnings(warnings []product.Warning) []*catalogv1.ProductWarning {
+	return lo.Map(warnings, func(w product.Warning, _ int) *catalogv1.ProductWarning {
+		return &catalogv1.ProductWarning{Code: string(w.Code), Field: w.Field, Message: w.Message}
+	})
+}
+
+func toProtoImportAction(a product.ImportAction) catalogv1.ImportProductAction {
+	switch a {
+	case product.ImportCreated:
+		return catalogv1.ImportProductAction_IMPORT_PRODUCT_ACTION_CREATED
+	case product.ImportUpdated:
+		return catalogv1.ImportProductAction_IMPORT_PRODUCT_ACTION_UPDATED
+	case product.ImportUnchanged:
+		return catalogv1.ImportProductAction_IMPORT_PRODUCT_ACTION_UNCHANGED
+	default:
+		return catalogv1.ImportProductAction_IMPORT_PRODUCT_ACTION_UNSPECIFIED
+	}
+}
+
+func (h *productHandler) VerifyProducts(ctx context.Context, req *connect.Request[catalogv1.VerifyProductsRequest]) (*connect.Response[catalogv1.VerifyProductsResponse], error) {
+	items := make([]product.ExpectedProduct, len(req.Msg.GetItems()))
+	for i, item := range req.Msg.GetItems() {
+		items[i] = product.ExpectedProduct{ID: item.GetId(), ContentHash: item.ContentHash}
+		if item.Version != nil {
+			version := int(item.GetVersion())
+			items[i].Version = &version
+		}
+	}
+
+	mismatches, err := h.verifyHandler.Handle(ctx, product.VerifyProductsQuery{Items: items})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	result := make([]*catalogv1.ProductMismatch, len(mismatches))
+	for i, m := range mismatches {
+		result[i] = &catalogv1.ProductMismatch{
+			Id:                m.ID,
+			Reason:            mismatchReasonToProto(m.Reason),
+			ActualVersion:     int64(m.ActualVersion),
+			ActualContentHash: m.ActualHash,
+		}
+	}
+
+	return connect.NewResponse(&catalogv1.VerifyProductsResponse{
+		Mismatches: result,
+	}), nil
+}
+
 func (h *productHandler) GetProductList(ctx context.Context, req *connect.Request[catalogv1.GetProductListRequest]) (*connect.Response[catalogv1.GetProductListResponse], error) {
+	modifiedAfter, err := parseTimePtr(req.Msg.GetModifiedAfter())
+	if err != nil {
+		return nil, err
+	}
+
 	q := product.GetListProductsQuery{
-		Page:       int(req.Msg.GetPage()),
-		Size:       int(req.Msg.GetSize()),
-		Enabled:    req.Msg.Enabled,
-		CategoryID: req.Msg.CategoryId,
-		Sort:       req.Msg.GetSort(),
-		Order:      req.Msg.GetOrder(),
+		Page:        int(req.Msg.GetPage()),
+		Size:        int(req.Msg.GetSize()),
+		Enabled:     req.Msg.Enabled,
+		CategoryID:  req.Msg.CategoryId,
+		SupplierID:  req.Msg.SupplierId,
+		HasImage:    req.Msg.HasImage,
+		HasCategory: req.Msg.HasCategory,
+		AttributeID: req.Msg.AttributeId,
+		OptionSlug:  req.Msg.OptionSlug,
+		Sort:        req.Msg.GetSort(),
+		Order:       req.Msg.GetOrder(),
+
+		IncludeArchived: req.Msg.GetIncludeArchived(),
+		ModifiedAfter:   modifiedAfter,
+		Recent:          protoToRecentPreset(req.Msg.GetPreset()),
+		RecentDays:      int(req.Msg.GetPresetDays()),
+		MinQualityScore: int32ToIntPtr(req.Msg.MinQualityScore),
+		MaxQualityScore: int32ToIntPtr(req.Msg.MaxQualityScore),
 	}
 
 	result, err := h.getListHandler.Handle(ctx, q)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, mapProductConnectError(err)
 	}
 
 	items := make([]*catalogv1.Product, len(result.Items))
@@ -119,6 +392,89 @@ func (h *productHandler) GetProductList(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
+func (h *productHandler) SampleProducts(ctx context.Context, req *connect.Request[catalogv1.SampleProductsRequest]) (*connect.Response[catalogv1.SampleProductsResponse], error) {
+	products, err := h.sampleHandler.Handle(ctx, product.SampleProductsQuery{
+		Size:       int(req.Msg.GetSize()),
+		Enabled:    req.Msg.Enabled,
+		CategoryID: req.Msg.CategoryId,
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.SampleProductsResponse{
+		Products: lo.Map(products, func(p *product.Product, _ int) *catalogv1.Product { return toProtoProduct(p) }),
+	}), nil
+}
+
+func (h *productHandler) GetAttributeProducts(ctx context.Context, req *connect.Request[catalogv1.GetAttributeProductsRequest]) (*connect.Response[catalogv1.GetAttributeProductsResponse], error) {
+	result, err := h.attrProductsHandler.Handle(ctx, product.GetAttributeProductsQuery{
+		AttributeID: req.Msg.GetAttributeId(),
+		OptionSlug:  req.Msg.OptionSlug,
+		Page:        int(req.Msg.GetPage()),
+		Size:        int(req.Msg.GetSize()),
+	})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetAttributeProductsResponse{
+		Items: lo.Map(result.Items, func(p *product.Product, _ int) *catalogv1.Product { return toProtoProduct(p) }),
+		Page:  int32(result.Page), //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		Size:  int32(result.Size), //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		Total: result.Total,
+	}), nil
+}
+
+func (h *productHandler) GetUncategorizedReport(ctx context.Context, _ *connect.Request[catalogv1.GetUncategorizedReportRequest]) (*connect.Response[catalogv1.GetUncategorizedReportResponse], error) {
+	report, err := h.uncategorizedHandler.Handle(ctx, product.GetUncategorizedReportQuery{})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetUncategorizedReportResponse{
+		Products: report.Products,
+		Enabled:  report.Enabled,
+		Suppliers: lo.Map(report.Suppliers, func(c product.UncategorizedCount, _ int) *catalogv1.UncategorizedSupplierCount {
+			return &catalogv1.UncategorizedSupplierCount{
+				SupplierId: lo.EmptyableToPtr(c.SupplierID),
+				Products:   c.Products,
+				Enabled:    c.Enabled,
+			}
+		}),
+	}), nil
+}
+
+func (h *productHandler) GetProductListConfig(ctx context.Context, req *connect.Request[catalogv1.GetProductListConfigRequest]) (*connect.Response[catalogv1.GetProductListConfigResponse], error) {
+	config, err := h.listConfigHandler.Handle(ctx, product.GetListConfigQuery{CategoryID: req.Msg.CategoryId})
+	if err != nil {
+		return nil, mapProductConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetProductListConfigResponse{
+		Columns: lo.Map(config.Columns, func(c product.ListColumn, _ int) *catalogv1.ProductListColumn {
+			return &catalogv1.ProductListColumn{
+				Key:         c.Key,
+				Label:       c.Label,
+				AttributeId: lo.EmptyableToPtr(c.AttributeID),
+				Sort:        lo.EmptyableToPtr(c.Sort),
+			}
+		}),
+		Filters: lo.Map(config.Filters, func(f product.ListFilter, _ int) *catalogv1.ProductListFilter {
+			return &catalogv1.ProductListFilter{
+				Key:         f.Key,
+				Label:       f.Label,
+				Kind:        f.Kind,
+				AttributeId: lo.EmptyableToPtr(f.AttributeID),
+				Options:     toProtoAttributeOptions(f.Options),
+			}
+		}),
+		Sorts: lo.Map(config.Sorts, func(s product.ListSort, _ int) *catalogv1.ProductListSort {
+			return &catalogv1.ProductListSort{Key: s.Key, Label: s.Label}
+		}),
+	}), nil
+}
+
 // ==================== Helpers ====================
 
 func toProtoProduct(p *product.Product) *catalogv1.Product {
@@ -126,19 +482,121 @@ func toProtoProduct(p *product.Product) *catalogv1.Product {
 	for i, a := range p.Attributes {
 		attrs[i] = domainToProtoAttributeValue(a)
 	}
-	return &catalogv1.Product{
-		Id:          p.ID,
-		Version:     int64(p.Version),
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Quantity:    int32(p.Quantity), //nolint:gosec // Quantity is a product inventory count, practically bounded
-		ImageId:     p.ImageID,
-		CategoryId:  p.CategoryID,
-		Enabled:     p.Enabled,
-		Attributes:  attrs,
-		CreatedAt:   timestamppb.New(p.CreatedAt),
-		ModifiedAt:  timestamppb.New(p.ModifiedAt),
+	result := &catalogv1.Product{
+		Id:            p.ID,
+		Version:       int64(p.Version),
+		Name:          p.Name,
+		Slug:          p.Slug,
+		PreviousSlugs: p.SlugHistory,
+		Type:          productTypeToProto(p.Type),
+		Description:   p.Description,
+		Excerpt:       p.Excerpt,
+		Price:         p.Price,
+		Quantity:      int32(p.Quantity), //nolint:gosec // Quantity is a product inventory count, practically bounded
+		ImageId:       p.ImageID,
+		CategoryId:    p.CategoryID,
+		Enabled:       p.Enabled,
+		Attributes:    attrs,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		ModifiedAt:    timestamppb.New(p.ModifiedAt),
+		ContentHash:   p.ContentHash(),
+		Metadata:      p.Metadata,
+		Experiments:   p.Experiments,
+		ExternalId:    p.ExternalID,
+
+		ReservedQuantity:  int32(p.Reserved),     //nolint:gosec // bounded by Quantity
+		AvailableQuantity: int32(p.Available()),  //nolint:gosec // bounded by Quantity
+		QualityScore:      int32(p.QualityScore), //nolint:gosec // bounded by MaxQualityScore
+		UnitsSold:         p.UnitsSold,
+		Views:             p.Views,
+
+		FirstPublishedAt: timestampPtr(p.FirstPublishedAt),
+		LastEnabledAt:    timestampPtr(p.LastEnabledAt),
+		DiscontinuedAt:   timestampPtr(p.DiscontinuedAt),
+
+		ReplacementProductId: p.ReplacementProductID,
+	}
+	if p.Supplier != nil {
+		result.SupplierId = &p.Supplier.SupplierID
+		result.SupplierSku = p.Supplier.SKU
+	}
+	if p.ArchivedAt != nil {
+		result.ArchivedAt = timestamppb.New(*p.ArchivedAt)
+	}
+	for _, c := range p.CategoryPath {
+		result.CategoryPath = append(result.CategoryPath, &catalogv1.CategoryCrumb{Id: c.CategoryID, Name: c.Name})
+	}
+	for _, a := range p.AttributeDefinitions {
+		result.AttributeDefinitions = append(result.AttributeDefinitions, toProtoAttribute(a))
+	}
+	return result
+}
+
+func protoToRecentPreset(p catalogv1.ProductListPreset) product.RecentPreset {
+	switch p {
+	case catalogv1.ProductListPreset_PRODUCT_LIST_PRESET_NEW_ARRIVALS:
+		return product.RecentCreated
+	case catalogv1.ProductListPreset_PRODUCT_LIST_PRESET_RECENTLY_MODIFIED:
+		return product.RecentModified
+	default:
+		return ""
+	}
+}
+
+// protoToEmbeds skips unspecified values
+func protoToEmbeds(embeds []catalogv1.ProductEmbed) []product.Embed {
+	var result []product.Embed
+	for _, e := range embeds {
+		switch e {
+		case catalogv1.ProductEmbed_PRODUCT_EMBED_CATEGORY_PATH:
+			result = append(result, product.EmbedCategoryPath)
+		case catalogv1.ProductEmbed_PRODUCT_EMBED_ATTRIBUTE_DEFINITIONS:
+			result = append(result, product.EmbedAttributeDefinitions)
+		}
+	}
+	return result
+}
+
+// protoToSupplierRef links the product when either field is set, so a SKU without a supplier is rejected
+func protoToSupplierRef(supplierID, sku *string) *product.SupplierRef {
+	if supplierID == nil && sku == nil {
+		return nil
+	}
+	return &product.SupplierRef{SupplierID: lo.FromPtr(supplierID), SKU: sku}
+}
+
+func protoProductTypeToString(t catalogv1.ProductType) string {
+	switch t {
+	case catalogv1.ProductType_PRODUCT_TYPE_PHYSICAL:
+		return string(product.ProductTypePhysical)
+	case catalogv1.ProductType_PRODUCT_TYPE_SERVICE:
+		return string(product.ProductTypeService)
+	default:
+		return ""
+	}
+}
+
+func productTypeToProto(t product.ProductType) catalogv1.ProductType {
+	switch t {
+	case product.ProductTypePhysical:
+		return catalogv1.ProductType_PRODUCT_TYPE_PHYSICAL
+	case product.ProductTypeService:
+		return catalogv1.ProductType_PRODUCT_TYPE_SERVICE
+	default:
+		return catalogv1.ProductType_PRODUCT_TYPE_UNSPECIFIED
+	}
+}
+
+func mismatchReasonToProto(r product.MismatchReason) catalogv1.ProductMismatchReason {
+	switch r {
+	case product.MismatchReasonMissing:
+		return catalogv1.ProductMismatchReason_PRODUCT_MISMATCH_REASON_MISSING
+	case product.MismatchReasonVersion:
+		return catalogv1.ProductMismatchReason_PRODUCT_MISMATCH_REASON_VERSION
+	case product.MismatchReasonContentHash:
+		return catalogv1.ProductMismatchReason_PRODUCT_MISMATCH_REASON_CONTENT_HASH
+	default:
+		return catalogv1.ProductMismatchReason_PRODUCT_MISMATCH_REASON_UNSPECIFIED
 	}
 }
 
@@ -158,6 +616,11 @@ func domainToProtoAttributeValue(a product.AttributeValue) *catalogv1.AttributeV
 	case a.BooleanValue != nil:
 		av.Value = &catalogv1.AttributeValue_BooleanValue{BooleanValue: *a.BooleanValue}
 	}
+	av.Unit = a.Unit
+	if a.Submitted != nil {
+		av.SubmittedNumericValue = &a.Submitted.Value
+		av.SubmittedUnit = &a.Submitted.Unit
+	}
 	return av
 }
 
@@ -180,6 +643,9 @@ func protoToAttributeValue(a *catalogv1.AttributeValueInput) product.AttributeVa
 		}
 	case *catalogv1.AttributeValueInput_NumericValue:
 		av.NumericValue = &v.NumericValue
+		if a.Unit != nil {
+			av.Submitted = &product.SubmittedValue{Value: v.NumericValue, Unit: a.GetUnit()}
+		}
 	case *catalogv1.AttributeValueInput_TextValue:
 		av.TextValue = &v.TextValue
 	case *catalogv1.AttributeValueInput_BooleanValue:
@@ -189,11 +655,21 @@ func protoToAttributeValue(a *catalogv1.AttributeValueInput) product.AttributeVa
 }
 
 func mapProductConnectError(err error) *connect.Error {
+	var missing *product.MissingAttributesError
+	var violations *product.RuleViolationsError
 	switch {
+	case errors.As(err, &missing):
+		return newMissingAttributesError(err, missing.Slugs)
+	case errors.As(err, &violations):
+		return newRuleViolationsError(err, violations.Violations)
 	case errors.Is(err, product.ErrInvalidProductData):
 		return connect.NewError(connect.CodeInvalidArgument, err)
-	case errors.Is(err, product.ErrCategoryNotFound):
+	case errors.Is(err, product.ErrCategoryNotFound), errors.Is(err, product.ErrSupplierNotFound),
+		errors.Is(err, product.ErrReplacementNotFound):
 		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, product.ErrNameAlreadyExists), errors.Is(err, product.ErrSlugAlreadyExists),
+		errors.Is(err, product.ErrExternalIDAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
 	case errors.Is(err, mongo.ErrEntityNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
 	case errors.Is(err, mongo.ErrOptimisticLocking):
@@ -202,3 +678,35 @@ func mapProductConnectError(err error) *connect.Error {
 		return connect.NewError(connect.CodeInternal, err)
 	}
 }
+
+// newMissingAttributesError reports each missing attribute as a field violation, so forms can
+// highlight the attributes to fill in
+func newMissingAttributesError(err error, slugs []string) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(slugs))
+	for i, slug := range slugs {
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       "attributes." + slug,
+			Description: "value is required by the category",
+		}
+	}
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// newRuleViolationsError reports the violations of the validation hooks as field violations, the rule
+// being the reason
+func newRuleViolationsError(err error, violations []product.RuleViolation) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+
+	fieldViolations := lo.Map(violations, func(v product.RuleViolation, _ int) *errdetails.BadRequest_FieldViolation {
+		return &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Message, Reason: v.Rule}
+	})
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: fieldViolations}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
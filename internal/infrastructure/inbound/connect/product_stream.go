@@ -0,0 +1,232 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// ndjsonContentType is newline-delimited JSON, one product per line
+const ndjsonContentType = "application/x-ndjson"
+
+// productStreamFlushLines is the number of lines written between flushes, so consumers start early
+// without a flush per line
+const productStreamFlushLines = 100
+
+// productStreamHandler serves GET /products for data pipelines: the whole filtered product list as
+// NDJSON, each line the JSON of the Product message GetProductList returns, with the same permissions
+// and audience. It is plain HTTP because Connect has no streaming over HTTP/1.1 GET, and the interceptors
+// of the API only wrap unary calls.
+type productStreamHandler struct {
+	stream product.StreamProductsQueryHandler
+	// auth runs the tenant, API key and auth interceptors of the API as a GetProductList call
+	auth   http.Handler
+	shaper *audienceShaper
+	log    *zap.Logger
+}
+
+// authorizedKey holds where the auth handler stores the context of an authorized request
+type authorizedKey struct{}
+
+func newProductStreamHandler(
+	stream product.StreamProductsQueryHandler,
+	validator validation.Validator,
+	permissions validation.ProcedurePermissions,
+	shaper *audienceShaper,
+	log *zap.Logger,
+) *productStreamHandler {
+	auth := connect.NewUnaryHandler(
+		catalogv1connect.ProductServiceGetProductListProcedure,
+		func(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			if authorized, ok := ctx.Value(authorizedKey{}).(*context.Context); ok {
+				*authorized = ctx
+			}
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		// In the order of their priorities in the interceptor chain
+		connect.WithInterceptors(
+			tenant.NewResolverInterceptor(),
+			newAPIKeyUnaryInterceptor(),
+			validation.NewAuthInterceptor(validator, permissions, log),
+			tenant.NewValidatorInterceptor(),
+		),
+	)
+
+	return &productStreamHandler{
+		stream: stream,
+		auth:   auth,
+		shaper: shaper,
+		log:    log.With(zap.String("component", "product-stream-handler")),
+	}
+}
+
+func registerProductStreamRoute(mux *http.ServeMux, h *productStreamHandler, compressionCfg compression.Config) error {
+	compress, err := compressionCfg.Middleware()
+	if err != nil {
+		return fmt.Errorf("product stream: %w", err)
+	}
+	mux.Handle("GET /products", compress(http.HandlerFunc(h.serve)))
+	return nil
+}
+
+func (h *productStreamHandler) serve(w http.ResponseWriter, r *http.Request) {
+	if !acceptsNDJSON(r.Header.Get("Accept")) {
+		http.Error(w, "GET /products only streams "+ndjsonContentType+"; page with ProductService.GetProductList", http.StatusNotAcceptable)
+		return
+	}
+	query, err := parseStreamQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx, ok := h.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// An export of a large catalog lasts past the write timeout of the server
+	_ = rc.SetWriteDeadline(time.Time{}) //nolint:errcheck // not supported by every writer, the stream still works
+
+	lines := 0
+	err = h.stream.Handle(ctx, query, func(p *product.Product) error {
+		msg := toProtoProduct(p)
+		h.shaper.shape(ctx, msg)
+		line, err := protojson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode product %s: %w", p.ID, err)
+		}
+
+		if lines == 0 {
+			w.Header().Set("Content-Type", ndjsonContentType)
+			w.WriteHeader(http.StatusOK)
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		lines++
+		if lines%productStreamFlushLines == 0 {
+			return rc.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if lines == 0 {
+			h.log.Error("failed to stream products", zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		// The response is already streaming: abort it so the consumer sees a broken stream instead of a short one
+		h.log.Warn("product stream aborted", zap.Int("lines", lines), zap.Error(err))
+		panic(http.ErrAbortHandler)
+	}
+	if lines == 0 {
+		w.Header().Set("Content-Type", ndjsonContentType)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// authorize passes the headers of the request to the auth handler as a GetProductList call. An authorized request
+// gets the context the interceptors built, with the tenant and claims; a rejected one gets their Connect error.
+func (h *productStreamHandler) authorize(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	var authorized context.Context
+	call, err := http.NewRequestWithContext(context.WithValue(r.Context(), authorizedKey{}, &authorized),
+		http.MethodPost, catalogv1connect.ProductServiceGetProductListProcedure, strings.NewReader("{}"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return nil, false
+	}
+	call.Header = r.Header.Clone()
+	call.Header.Set("Content-Type", "application/json")
+	call.Header.Del("Content-Encoding")
+	call.Header.Del("Accept-Encoding")
+
+	res := newBufferedResponse()
+	h.auth.ServeHTTP(res, call)
+	if authorized == nil {
+		maps.Copy(w.Header(), res.header)
+		w.WriteHeader(res.status)
+		_, _ = w.Write(res.body.Bytes()) //nolint:errcheck // the client is gone
+		return nil, false
+	}
+	return authorized, true
+}
+
+// bufferedResponse keeps the response of the auth handler, written to the client only on rejection
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+}
+
+func (b *bufferedResponse) Header() http.Header         { return b.header }
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+func (b *bufferedResponse) WriteHeader(status int)      { b.status = status }
+
+// acceptsNDJSON tells whether the Accept header names NDJSON explicitly, with a non-zero quality
+func acceptsNDJSON(accept string) bool {
+	for part := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ndjsonContentType {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if v, err := strconv.ParseFloat(q, 64); err != nil || v <= 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// parseStreamQuery reads the filters of GetProductList from the query string
+func parseStreamQuery(r *http.Request) (product.StreamProductsQuery, error) {
+	values := r.URL.Query()
+	var query product.StreamProductsQuery
+	for name, target := range map[string]**bool{"enabled": &query.Enabled, "hasImage": &query.HasImage, "hasCategory": &query.HasCategory} {
+		if v := values.Get(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return query, fmt.Errorf("%s must be true or false", name)
+			}
+			*target = &b
+		}
+	}
+	for name, target := range map[string]**string{"categoryId": &query.CategoryID, "supplierId": &query.SupplierID} {
+		if v := values.Get(name); v != "" {
+			*target = &v
+		}
+	}
+	if v := values.Get("modifiedAfter"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return query, errors.New("modifiedAfter must be an RFC 3339 time")
+		}
+		query.ModifiedAfter = utc.NormalizePtr(&t)
+	}
+	return query, nil
+}
@@ -0,0 +1,63 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"go.uber.org/fx"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// quotaInterceptorPriority runs the interceptor next to the dependency interceptor (32),
+// inside the time budget
+const quotaInterceptorPriority = 33
+
+// quotaModule provides the interceptor that reports exceeded quotas as PermissionDenied
+func quotaModule() fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			provideQuotaInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+func provideQuotaInterceptor() interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: quotaInterceptorPriority,
+		Handler:  newQuotaUnaryInterceptor(),
+	}
+}
+
+// newQuotaUnaryInterceptor answers creates past the plan of the tenant with PermissionDenied (HTTP 403)
+// and a QuotaFailure detail naming the resource, whatever code the handler mapped the error to
+func newQuotaUnaryInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			var exceeded *quota.ExceededError
+			if err != nil && errors.As(err, &exceeded) {
+				return nil, newQuotaExceededError(exceeded)
+			}
+			return resp, err
+		}
+	}
+}
+
+func newQuotaExceededError(exceeded *quota.ExceededError) *connect.Error {
+	connectErr := connect.NewError(connect.CodePermissionDenied, exceeded)
+	detail, err := connect.NewErrorDetail(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     string(exceeded.Resource),
+			Description: exceeded.Error(),
+		}},
+	})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
@@ -0,0 +1,31 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/samber/lo"
+)
+
+type quotaHandler struct {
+	usageHandler quota.GetUsageQueryHandler
+}
+
+func (h *quotaHandler) GetQuotaUsage(ctx context.Context, _ *connect.Request[catalogv1.GetQuotaUsageRequest]) (*connect.Response[catalogv1.GetQuotaUsageResponse], error) {
+	usage, err := h.usageHandler.Handle(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetQuotaUsageResponse{
+		Usage: lo.Map(usage, func(u quota.Usage, _ int) *catalogv1.QuotaUsage {
+			return &catalogv1.QuotaUsage{
+				Resource: string(u.Resource),
+				Used:     u.Used,
+				Limit:    int32(u.Limit), //nolint:gosec // plan limits are configured, far below int32
+			}
+		}),
+	}), nil
+}
@@ -0,0 +1,61 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// readOnlyInterceptorPriority runs the interceptor after auth (22) and the tenant checks (26),
+// so callers without access get the same errors as always, and before rate limiting (40)
+const readOnlyInterceptorPriority = 35
+
+// readOnlyModule provides the interceptor that rejects mutating procedures in read-only mode
+func readOnlyModule() fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			provideReadOnlyInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+func provideReadOnlyInterceptor(mode maintenance.Service) interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: readOnlyInterceptorPriority,
+		Handler:  newReadOnlyUnaryInterceptor(mode),
+	}
+}
+
+// newReadOnlyUnaryInterceptor lets procedures marked with the NO_SIDE_EFFECTS idempotency level through
+// and answers the others with Unavailable (HTTP 503) and a Retry-After header while the service is read-only.
+// A procedure without the mark counts as mutating, so a new read procedure is blocked rather than a write let through.
+func newReadOnlyUnaryInterceptor(mode maintenance.Service) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects {
+				return next(ctx, req)
+			}
+			if current := mode.Current(); current.ReadOnly {
+				return nil, readOnlyError(current)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func readOnlyError(mode maintenance.Mode) *connect.Error {
+	msg := "catalog is read-only for maintenance"
+	if mode.Reason != "" {
+		msg += ": " + mode.Reason
+	}
+	err := connect.NewError(connect.CodeUnavailable, errors.New(msg))
+	err.Meta().Set("Retry-After", strconv.Itoa(int(mode.RetryAfter.Seconds())))
+	return err
+}
@@ -0,0 +1,111 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type replayHandler struct {
+	service replay.Service
+}
+
+func (h *replayHandler) StartReplay(ctx context.Context, req *connect.Request[catalogv1.StartReplayRequest]) (*connect.Response[catalogv1.StartReplayResponse], error) {
+	targets := make([]replay.Target, len(req.Msg.GetTargets()))
+	for i, t := range req.Msg.GetTargets() {
+		targets[i] = protoReplayTargetToTarget(t)
+	}
+
+	status, err := h.service.Start(ctx, replay.StartReplayCommand{
+		Targets:       targets,
+		RatePerSecond: int(req.Msg.GetRatePerSecond()),
+	})
+	if err != nil {
+		return nil, mapReplayConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.StartReplayResponse{
+		Status: toProtoReplayStatus(status),
+	}), nil
+}
+
+func (h *replayHandler) GetReplayStatus(ctx context.Context, _ *connect.Request[catalogv1.GetReplayStatusRequest]) (*connect.Response[catalogv1.GetReplayStatusResponse], error) {
+	status, ok, err := h.service.Status(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no replay has been started"))
+	}
+
+	return connect.NewResponse(&catalogv1.GetReplayStatusResponse{
+		Status: toProtoReplayStatus(status),
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoReplayStatus(s replay.Status) *catalogv1.ReplayStatus {
+	progress := make([]*catalogv1.ReplayProgress, len(s.Progress))
+	for i, p := range s.Progress {
+		progress[i] = &catalogv1.ReplayProgress{
+			Target:    targetToProtoReplayTarget(p.Target),
+			Total:     p.Total,
+			Published: p.Published,
+		}
+	}
+
+	status := &catalogv1.ReplayStatus{
+		Id:        s.ID,
+		Running:   s.Running,
+		StartedAt: timestamppb.New(s.StartedAt),
+		UpdatedAt: timestamppb.New(s.UpdatedAt),
+		Progress:  progress,
+		Error:     s.Error,
+	}
+	if s.FinishedAt != nil {
+		status.FinishedAt = timestamppb.New(*s.FinishedAt)
+	}
+	return status
+}
+
+func protoReplayTargetToTarget(t catalogv1.ReplayTarget) replay.Target {
+	switch t {
+	case catalogv1.ReplayTarget_REPLAY_TARGET_ATTRIBUTES:
+		return replay.TargetAttributes
+	case catalogv1.ReplayTarget_REPLAY_TARGET_CATEGORIES:
+		return replay.TargetCategories
+	case catalogv1.ReplayTarget_REPLAY_TARGET_PRODUCTS:
+		return replay.TargetProducts
+	default:
+		return ""
+	}
+}
+
+func targetToProtoReplayTarget(t replay.Target) catalogv1.ReplayTarget {
+	switch t {
+	case replay.TargetAttributes:
+		return catalogv1.ReplayTarget_REPLAY_TARGET_ATTRIBUTES
+	case replay.TargetCategories:
+		return catalogv1.ReplayTarget_REPLAY_TARGET_CATEGORIES
+	case replay.TargetProducts:
+		return catalogv1.ReplayTarget_REPLAY_TARGET_PRODUCTS
+	default:
+		return catalogv1.ReplayTarget_REPLAY_TARGET_UNSPECIFIED
+	}
+}
+
+func mapReplayConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, replay.ErrUnknownTarget), errors.Is(err, replay.ErrInvalidRate):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, replay.ErrReplayInProgress):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
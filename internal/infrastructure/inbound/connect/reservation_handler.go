@@ -0,0 +1,76 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type reservationHandler struct {
+	reserveHandler reservation.ReserveStockCommandHandler
+	releaseHandler reservation.ReleaseStockCommandHandler
+}
+
+func (h *reservationHandler) ReserveStock(ctx context.Context, req *connect.Request[catalogv1.ReserveStockRequest]) (*connect.Response[catalogv1.ReserveStockResponse], error) {
+	cmd := reservation.ReserveStockCommand{
+		ProductID: req.Msg.GetProductId(),
+		Owner:     req.Msg.GetOwner(),
+		Quantity:  int(req.Msg.GetQuantity()),
+		TTL:       time.Duration(req.Msg.GetTtlSeconds()) * time.Second,
+	}
+
+	created, err := h.reserveHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, mapReservationConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ReserveStockResponse{
+		Reservation: toProtoReservation(created),
+	}), nil
+}
+
+func (h *reservationHandler) ReleaseStock(ctx context.Context, req *connect.Request[catalogv1.ReleaseStockRequest]) (*connect.Response[catalogv1.ReleaseStockResponse], error) {
+	cmd := reservation.ReleaseStockCommand{
+		ID:    req.Msg.GetId(),
+		Owner: req.Msg.GetOwner(),
+	}
+
+	if err := h.releaseHandler.Handle(ctx, cmd); err != nil {
+		return nil, mapReservationConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ReleaseStockResponse{}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoReservation(r *reservation.Reservation) *catalogv1.Reservation {
+	return &catalogv1.Reservation{
+		Id:        r.ID,
+		ProductId: r.ProductID,
+		Owner:     r.Owner,
+		Quantity:  int32(r.Quantity), //nolint:gosec // Quantity originates from int32 proto field, cannot overflow
+		ExpiresAt: timestamppb.New(r.ExpiresAt),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
+
+func mapReservationConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, reservation.ErrInvalidReservationData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, reservation.ErrProductNotFound), errors.Is(err, reservation.ErrReservationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, reservation.ErrNotReservationOwner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, reservation.ErrProductNotAvailable), errors.Is(err, reservation.ErrInsufficientStock):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,208 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// savedViewReadPermissions are needed to execute a view of the entity type, on top of the
+// permission of the procedure
+var savedViewReadPermissions = map[savedview.EntityType][]string{
+	savedview.EntityTypeProduct:  {"products:read"},
+	savedview.EntityTypeCategory: {"categories:read"},
+}
+
+type savedViewHandler struct {
+	createHandler  savedview.CreateSavedViewCommandHandler
+	updateHandler  savedview.UpdateSavedViewCommandHandler
+	deleteHandler  savedview.DeleteSavedViewCommandHandler
+	getByIDHandler savedview.GetSavedViewByIDQueryHandler
+	getListHandler savedview.GetSavedViewListQueryHandler
+	executeHandler savedview.ExecuteSavedViewQueryHandler
+}
+
+func (h *savedViewHandler) CreateSavedView(ctx context.Context, req *connect.Request[catalogv1.CreateSavedViewRequest]) (*connect.Response[catalogv1.CreateSavedViewResponse], error) {
+	created, err := h.createHandler.Handle(ctx, savedview.CreateSavedViewCommand{
+		Name:       req.Msg.GetName(),
+		Owner:      req.Msg.GetOwner(),
+		EntityType: protoToSavedViewEntityType(req.Msg.GetEntityType()),
+		Filter:     req.Msg.GetFilter(),
+		Sort:       req.Msg.GetSort(),
+		Order:      req.Msg.GetOrder(),
+	})
+	if err != nil {
+		return nil, mapSavedViewConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.CreateSavedViewResponse{
+		View: toProtoSavedView(created),
+	}), nil
+}
+
+func (h *savedViewHandler) UpdateSavedView(ctx context.Context, req *connect.Request[catalogv1.UpdateSavedViewRequest]) (*connect.Response[catalogv1.UpdateSavedViewResponse], error) {
+	updated, err := h.updateHandler.Handle(ctx, savedview.UpdateSavedViewCommand{
+		ID:      req.Msg.GetId(),
+		Version: int(req.Msg.GetVersion()),
+		Name:    req.Msg.GetName(),
+		Filter:  req.Msg.GetFilter(),
+		Sort:    req.Msg.GetSort(),
+		Order:   req.Msg.GetOrder(),
+	})
+	if err != nil {
+		return nil, mapSavedViewConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.UpdateSavedViewResponse{
+		View: toProtoSavedView(updated),
+	}), nil
+}
+
+func (h *savedViewHandler) DeleteSavedView(ctx context.Context, req *connect.Request[catalogv1.DeleteSavedViewRequest]) (*connect.Response[catalogv1.DeleteSavedViewResponse], error) {
+	if err := h.deleteHandler.Handle(ctx, savedview.DeleteSavedViewCommand{ID: req.Msg.GetId()}); err != nil {
+		return nil, mapSavedViewConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.DeleteSavedViewResponse{}), nil
+}
+
+func (h *savedViewHandler) GetSavedViewById(ctx context.Context, req *connect.Request[catalogv1.GetSavedViewByIdRequest]) (*connect.Response[catalogv1.GetSavedViewByIdResponse], error) { //nolint:revive
+	v, err := h.getByIDHandler.Handle(ctx, savedview.GetSavedViewByIDQuery{ID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapSavedViewConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetSavedViewByIdResponse{
+		View: toProtoSavedView(v),
+	}), nil
+}
+
+func (h *savedViewHandler) GetSavedViewList(ctx context.Context, req *connect.Request[catalogv1.GetSavedViewListRequest]) (*connect.Response[catalogv1.GetSavedViewListResponse], error) {
+	q := savedview.GetSavedViewListQuery{
+		Page:  int(req.Msg.GetPage()),
+		Size:  int(req.Msg.GetSize()),
+		Owner: req.Msg.Owner,
+	}
+	if t := protoToSavedViewEntityType(req.Msg.GetEntityType()); t != "" {
+		q.EntityType = &t
+	}
+
+	result, err := h.getListHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	items := make([]*catalogv1.SavedView, len(result.Items))
+	for i, v := range result.Items {
+		items[i] = toProtoSavedView(v)
+	}
+
+	return connect.NewResponse(&catalogv1.GetSavedViewListResponse{
+		Items: items,
+		Page:  int32(result.Page), //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		Size:  int32(result.Size), //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		Total: result.Total,
+	}), nil
+}
+
+func (h *savedViewHandler) ExecuteSavedView(ctx context.Context, req *connect.Request[catalogv1.ExecuteSavedViewRequest]) (*connect.Response[catalogv1.ExecuteSavedViewResponse], error) {
+	result, err := h.executeHandler.Handle(ctx, savedview.ExecuteSavedViewQuery{
+		ID:   req.Msg.GetId(),
+		Page: int(req.Msg.GetPage()),
+		Size: int(req.Msg.GetSize()),
+	})
+	if err != nil {
+		return nil, mapSavedViewConnectError(err)
+	}
+
+	entityType := result.View.EntityType
+	if claims := validation.ClaimsFromContext(ctx); claims != nil && !claims.HasAnyPermission(savedViewReadPermissions[entityType]) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("executing %s views needs read access to them", entityType))
+	}
+
+	resp := &catalogv1.ExecuteSavedViewResponse{View: toProtoSavedView(result.View)}
+	switch {
+	case result.Products != nil:
+		resp.Products = make([]*catalogv1.Product, len(result.Products.Items))
+		for i, p := range result.Products.Items {
+			resp.Products[i] = toProtoProduct(p)
+		}
+		resp.Page = int32(result.Products.Page) //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		resp.Size = int32(result.Products.Size) //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		resp.Total = result.Products.Total
+	case result.Categories != nil:
+		resp.Categories = make([]*catalogv1.Category, len(result.Categories.Items))
+		for i, c := range result.Categories.Items {
+			resp.Categories[i] = toProtoCategory(c)
+		}
+		resp.Page = int32(result.Categories.Page) //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		resp.Size = int32(result.Categories.Size) //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		resp.Total = result.Categories.Total
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoSavedView(v *savedview.SavedView) *catalogv1.SavedView {
+	pv := &catalogv1.SavedView{
+		Id:         v.ID,
+		Version:    int64(v.Version),
+		Name:       v.Name,
+		Owner:      v.Owner,
+		EntityType: savedViewEntityTypeToProto(v.EntityType),
+		Filter:     v.Filter,
+		CreatedAt:  timestamppb.New(v.CreatedAt),
+		ModifiedAt: timestamppb.New(v.ModifiedAt),
+	}
+	if v.Sort != "" {
+		pv.Sort = &v.Sort
+	}
+	if v.Order != "" {
+		pv.Order = &v.Order
+	}
+	return pv
+}
+
+func protoToSavedViewEntityType(t catalogv1.SavedViewEntityType) savedview.EntityType {
+	switch t {
+	case catalogv1.SavedViewEntityType_SAVED_VIEW_ENTITY_TYPE_PRODUCT:
+		return savedview.EntityTypeProduct
+	case catalogv1.SavedViewEntityType_SAVED_VIEW_ENTITY_TYPE_CATEGORY:
+		return savedview.EntityTypeCategory
+	default:
+		return ""
+	}
+}
+
+func savedViewEntityTypeToProto(t savedview.EntityType) catalogv1.SavedViewEntityType {
+	switch t {
+	case savedview.EntityTypeProduct:
+		return catalogv1.SavedViewEntityType_SAVED_VIEW_ENTITY_TYPE_PRODUCT
+	case savedview.EntityTypeCategory:
+		return catalogv1.SavedViewEntityType_SAVED_VIEW_ENTITY_TYPE_CATEGORY
+	default:
+		return catalogv1.SavedViewEntityType_SAVED_VIEW_ENTITY_TYPE_UNSPECIFIED
+	}
+}
+
+func mapSavedViewConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, savedview.ErrInvalidSavedViewData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, savedview.ErrSavedViewNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
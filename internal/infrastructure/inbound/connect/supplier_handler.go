@@ -0,0 +1,146 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type supplierHandler struct {
+	createHandler  supplier.CreateSupplierCommandHandler
+	updateHandler  supplier.UpdateSupplierCommandHandler
+	deleteHandler  supplier.DeleteSupplierCommandHandler
+	getByIDHandler supplier.GetSupplierByIDQueryHandler
+	getListHandler supplier.GetSupplierListQueryHandler
+}
+
+func (h *supplierHandler) CreateSupplier(ctx context.Context, req *connect.Request[catalogv1.CreateSupplierRequest]) (*connect.Response[catalogv1.CreateSupplierResponse], error) {
+	created, err := h.createHandler.Handle(ctx, supplier.CreateSupplierCommand{
+		Name:         req.Msg.GetName(),
+		Code:         req.Msg.GetCode(),
+		Contact:      protoToSupplierContact(req.Msg.GetContact()),
+		LeadTimeDays: int(req.Msg.GetLeadTimeDays()),
+	})
+	if err != nil {
+		return nil, mapSupplierConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.CreateSupplierResponse{
+		Supplier: toProtoSupplier(created),
+	}), nil
+}
+
+func (h *supplierHandler) UpdateSupplier(ctx context.Context, req *connect.Request[catalogv1.UpdateSupplierRequest]) (*connect.Response[catalogv1.UpdateSupplierResponse], error) {
+	updated, err := h.updateHandler.Handle(ctx, supplier.UpdateSupplierCommand{
+		ID:           req.Msg.GetId(),
+		Version:      int(req.Msg.GetVersion()),
+		Name:         req.Msg.GetName(),
+		Code:         req.Msg.GetCode(),
+		Contact:      protoToSupplierContact(req.Msg.GetContact()),
+		LeadTimeDays: int(req.Msg.GetLeadTimeDays()),
+	})
+	if err != nil {
+		return nil, mapSupplierConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.UpdateSupplierResponse{
+		Supplier: toProtoSupplier(updated),
+	}), nil
+}
+
+func (h *supplierHandler) DeleteSupplier(ctx context.Context, req *connect.Request[catalogv1.DeleteSupplierRequest]) (*connect.Response[catalogv1.DeleteSupplierResponse], error) {
+	if err := h.deleteHandler.Handle(ctx, supplier.DeleteSupplierCommand{ID: req.Msg.GetId()}); err != nil {
+		return nil, mapSupplierConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.DeleteSupplierResponse{}), nil
+}
+
+func (h *supplierHandler) GetSupplierById(ctx context.Context, req *connect.Request[catalogv1.GetSupplierByIdRequest]) (*connect.Response[catalogv1.GetSupplierByIdResponse], error) { //nolint:revive
+	s, err := h.getByIDHandler.Handle(ctx, supplier.GetSupplierByIDQuery{ID: req.Msg.GetId()})
+	if err != nil {
+		return nil, mapSupplierConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.GetSupplierByIdResponse{
+		Supplier: toProtoSupplier(s),
+	}), nil
+}
+
+func (h *supplierHandler) GetSupplierList(ctx context.Context, req *connect.Request[catalogv1.GetSupplierListRequest]) (*connect.Response[catalogv1.GetSupplierListResponse], error) {
+	modifiedAfter, err := parseTimePtr(req.Msg.GetModifiedAfter())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getListHandler.Handle(ctx, supplier.GetSupplierListQuery{
+		Page:          int(req.Msg.GetPage()),
+		Size:          int(req.Msg.GetSize()),
+		Sort:          req.Msg.GetSort(),
+		Order:         req.Msg.GetOrder(),
+		ModifiedAfter: modifiedAfter,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	items := make([]*catalogv1.Supplier, len(result.Items))
+	for i, s := range result.Items {
+		items[i] = toProtoSupplier(s)
+	}
+
+	return connect.NewResponse(&catalogv1.GetSupplierListResponse{
+		Items: items,
+		Page:  int32(result.Page), //nolint:gosec // Page originates from int32 proto field, cannot overflow
+		Size:  int32(result.Size), //nolint:gosec // Size originates from int32 proto field, cannot overflow
+		Total: result.Total,
+	}), nil
+}
+
+// ==================== Helpers ====================
+
+func toProtoSupplier(s *supplier.Supplier) *catalogv1.Supplier {
+	return &catalogv1.Supplier{
+		Id:      s.ID,
+		Version: int64(s.Version),
+		Name:    s.Name,
+		Code:    s.Code,
+		Contact: &catalogv1.SupplierContact{
+			Name:  s.Contact.Name,
+			Email: s.Contact.Email,
+			Phone: s.Contact.Phone,
+		},
+		LeadTimeDays: int32(s.LeadTimeDays), //nolint:gosec // bounded by the lead time validation
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		ModifiedAt:   timestamppb.New(s.ModifiedAt),
+	}
+}
+
+func protoToSupplierContact(c *catalogv1.SupplierContact) supplier.Contact {
+	if c == nil {
+		return supplier.Contact{}
+	}
+	return supplier.Contact{Name: c.Name, Email: c.Email, Phone: c.Phone}
+}
+
+func mapSupplierConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, supplier.ErrInvalidSupplierData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, supplier.ErrSupplierNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, supplier.ErrCodeAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, supplier.ErrSupplierInUse):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
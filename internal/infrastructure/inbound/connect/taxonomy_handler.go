@@ -0,0 +1,193 @@
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type taxonomyHandler struct {
+	exportAttributesHandler taxonomy.ExportAttributesQueryHandler
+	importAttributesHandler taxonomy.ImportAttributesCommandHandler
+	exportTaxonomyHandler   taxonomy.ExportTaxonomyQueryHandler
+	importTaxonomyHandler   taxonomy.ImportTaxonomyCommandHandler
+}
+
+func (h *taxonomyHandler) ExportAttributes(ctx context.Context, req *connect.Request[catalogv1.ExportAttributesRequest]) (*connect.Response[catalogv1.ExportAttributesResponse], error) {
+	bundle, err := h.exportAttributesHandler.Handle(ctx, taxonomy.ExportAttributesQuery{
+		IDs:             req.Msg.GetIds(),
+		IncludeBindings: req.Msg.GetIncludeBindings(),
+	})
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode bundle: %w", err))
+	}
+
+	return connect.NewResponse(&catalogv1.ExportAttributesResponse{
+		Bundle:         string(data),
+		AttributeCount: int32(len(bundle.Attributes)), //nolint:gosec // bounded by the attribute quota
+	}), nil
+}
+
+func (h *taxonomyHandler) ImportAttributes(ctx context.Context, req *connect.Request[catalogv1.ImportAttributesRequest]) (*connect.Response[catalogv1.ImportAttributesResponse], error) {
+	bundle, err := decodeBundle(req.Msg.GetBundle())
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	results, err := h.importAttributesHandler.Handle(ctx, taxonomy.ImportAttributesCommand{
+		Bundle:   *bundle,
+		Conflict: protoToConflictStrategy(req.Msg.GetConflict()),
+	})
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ImportAttributesResponse{
+		Results: lo.Map(results, func(r taxonomy.AttributeImportResult, _ int) *catalogv1.AttributeImportResult {
+			return &catalogv1.AttributeImportResult{
+				Slug:               r.Slug,
+				Attribute:          toProtoAttribute(r.Attribute),
+				Action:             toProtoAttributeImportAction(r.Action),
+				BoundCategories:    int32(r.BoundCategories), //nolint:gosec // bounded by the category count
+				MissingCategoryIds: r.MissingCategories,
+			}
+		}),
+	}), nil
+}
+
+func (h *taxonomyHandler) ExportTaxonomy(ctx context.Context, req *connect.Request[catalogv1.ExportTaxonomyRequest]) (*connect.Response[catalogv1.ExportTaxonomyResponse], error) {
+	bundle, err := h.exportTaxonomyHandler.Handle(ctx, taxonomy.ExportTaxonomyQuery{CategoryIDs: req.Msg.GetCategoryIds()})
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode bundle: %w", err))
+	}
+
+	return connect.NewResponse(&catalogv1.ExportTaxonomyResponse{
+		Bundle:         string(data),
+		CategoryCount:  int32(len(bundle.Categories)), //nolint:gosec // bounded by the category quota
+		AttributeCount: int32(len(bundle.Attributes)), //nolint:gosec // bounded by the attribute quota
+	}), nil
+}
+
+func (h *taxonomyHandler) ImportTaxonomy(ctx context.Context, req *connect.Request[catalogv1.ImportTaxonomyRequest]) (*connect.Response[catalogv1.ImportTaxonomyResponse], error) {
+	bundle, err := decodeBundle(req.Msg.GetBundle())
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	changes, err := h.importTaxonomyHandler.Handle(ctx, taxonomy.ImportTaxonomyCommand{
+		Bundle: *bundle,
+		DryRun: req.Msg.GetDryRun(),
+	})
+	if err != nil {
+		return nil, mapTaxonomyConnectError(err)
+	}
+
+	return connect.NewResponse(&catalogv1.ImportTaxonomyResponse{
+		Changes: lo.Map(changes, func(c taxonomy.Change, _ int) *catalogv1.TaxonomyChange {
+			return &catalogv1.TaxonomyChange{
+				Entity: toProtoTaxonomyEntity(c.Entity),
+				Id:     c.ID,
+				Key:    c.Key,
+				Action: toProtoTaxonomyChangeAction(c.Action),
+				Fields: c.Fields,
+			}
+		}),
+		Applied: !req.Msg.GetDryRun(),
+	}), nil
+}
+
+func decodeBundle(data string) (*taxonomy.Bundle, error) {
+	var bundle taxonomy.Bundle
+	if err := json.Unmarshal([]byte(data), &bundle); err != nil {
+		return nil, fmt.Errorf("%w: %w", taxonomy.ErrInvalidBundle, err)
+	}
+	return &bundle, nil
+}
+
+func toProtoTaxonomyEntity(e taxonomy.EntityKind) catalogv1.TaxonomyEntity {
+	switch e {
+	case taxonomy.EntityAttribute:
+		return catalogv1.TaxonomyEntity_TAXONOMY_ENTITY_ATTRIBUTE
+	case taxonomy.EntityCategory:
+		return catalogv1.TaxonomyEntity_TAXONOMY_ENTITY_CATEGORY
+	default:
+		return catalogv1.TaxonomyEntity_TAXONOMY_ENTITY_UNSPECIFIED
+	}
+}
+
+func toProtoTaxonomyChangeAction(a taxonomy.ImportAction) catalogv1.TaxonomyChangeAction {
+	switch a {
+	case taxonomy.ImportCreated:
+		return catalogv1.TaxonomyChangeAction_TAXONOMY_CHANGE_ACTION_CREATED
+	case taxonomy.ImportUpdated:
+		return catalogv1.TaxonomyChangeAction_TAXONOMY_CHANGE_ACTION_UPDATED
+	case taxonomy.ImportUnchanged:
+		return catalogv1.TaxonomyChangeAction_TAXONOMY_CHANGE_ACTION_UNCHANGED
+	default:
+		return catalogv1.TaxonomyChangeAction_TAXONOMY_CHANGE_ACTION_UNSPECIFIED
+	}
+}
+
+func protoToConflictStrategy(s catalogv1.ImportConflictStrategy) taxonomy.ConflictStrategy {
+	switch s {
+	case catalogv1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_SKIP:
+		return taxonomy.ConflictSkip
+	case catalogv1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_OVERWRITE:
+		return taxonomy.ConflictOverwrite
+	case catalogv1.ImportConflictStrategy_IMPORT_CONFLICT_STRATEGY_RENAME:
+		return taxonomy.ConflictRename
+	default:
+		return ""
+	}
+}
+
+func toProtoAttributeImportAction(a taxonomy.ImportAction) catalogv1.AttributeImportAction {
+	switch a {
+	case taxonomy.ImportCreated:
+		return catalogv1.AttributeImportAction_ATTRIBUTE_IMPORT_ACTION_CREATED
+	case taxonomy.ImportUpdated:
+		return catalogv1.AttributeImportAction_ATTRIBUTE_IMPORT_ACTION_UPDATED
+	case taxonomy.ImportSkipped:
+		return catalogv1.AttributeImportAction_ATTRIBUTE_IMPORT_ACTION_SKIPPED
+	case taxonomy.ImportRenamed:
+		return catalogv1.AttributeImportAction_ATTRIBUTE_IMPORT_ACTION_RENAMED
+	default:
+		return catalogv1.AttributeImportAction_ATTRIBUTE_IMPORT_ACTION_UNSPECIFIED
+	}
+}
+
+func mapTaxonomyConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, taxonomy.ErrInvalidBundle), errors.Is(err, attribute.ErrInvalidAttributeData),
+		errors.Is(err, category.ErrInvalidCategoryData):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, attribute.ErrSlugAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, mongo.ErrEntityNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, mongo.ErrOptimisticLocking):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
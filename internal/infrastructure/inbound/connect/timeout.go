@@ -0,0 +1,130 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/connect/interceptor"
+)
+
+// timeoutInterceptorPriority runs the interceptor right after the server-wide request timeout (30),
+// which stays the upper bound when it is configured
+const timeoutInterceptorPriority = 31
+
+const maxTimeout = 5 * time.Minute
+
+// TimeoutConfig holds the time budget of a request per operation class.
+// The budget is the deadline of the request context, so the Mongo and Kafka calls of the request stop with it.
+type TimeoutConfig struct {
+	// Read applies to procedures without side effects. Default: 2s
+	Read time.Duration `koanf:"read"`
+	// Write applies to the other procedures. Default: 5s
+	Write time.Duration `koanf:"write"`
+	// Bulk applies to the procedures listed in bulkProcedures. Default: 60s
+	Bulk time.Duration `koanf:"bulk"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *TimeoutConfig) ApplyDefaults() {
+	if c.Read <= 0 {
+		c.Read = 2 * time.Second
+	}
+	if c.Write <= 0 {
+		c.Write = 5 * time.Second
+	}
+	if c.Bulk <= 0 {
+		c.Bulk = time.Minute
+	}
+}
+
+// Validate validates the configuration
+func (c *TimeoutConfig) Validate() error {
+	for name, d := range map[string]time.Duration{"read": c.Read, "write": c.Write, "bulk": c.Bulk} {
+		if d < 100*time.Millisecond || d > maxTimeout {
+			return fmt.Errorf("%s must be between 100ms and %s", name, maxTimeout)
+		}
+	}
+	return nil
+}
+
+// bulkProcedures act on many aggregates in one call
+var bulkProcedures = map[string]bool{
+	catalogv1connect.ProductServiceVerifyProductsProcedure:                  true,
+	catalogv1connect.ProductServiceImportProductsProcedure:                  true,
+	catalogv1connect.ProductServiceMergeDuplicateProductAttributesProcedure: true,
+	catalogv1connect.ProductServiceFindDuplicateProductsProcedure:           true,
+	catalogv1connect.ProductServiceRemapDeprecatedOptionProcedure:           true,
+	catalogv1connect.ProductServiceResyncAttributeSlugsProcedure:            true,
+	catalogv1connect.ProductServiceStartInventoryValuationProcedure:         true,
+	catalogv1connect.ReplayServiceStartReplayProcedure:                      true,
+	catalogv1connect.CategoryTemplateServiceApplyCategoryTemplateProcedure:  true,
+	catalogv1connect.TaxonomyServiceExportAttributesProcedure:               true,
+	catalogv1connect.TaxonomyServiceImportAttributesProcedure:               true,
+	catalogv1connect.TaxonomyServiceExportTaxonomyProcedure:                 true,
+	catalogv1connect.TaxonomyServiceImportTaxonomyProcedure:                 true,
+	catalogv1connect.EnvironmentSyncServiceSyncFromSourceProcedure:          true,
+}
+
+// timeoutModule provides the interceptor that bounds every procedure by the budget of its class
+func timeoutModule() fx.Option {
+	return fx.Provide(
+		provideTimeoutConfig,
+		fx.Annotate(
+			provideTimeoutInterceptor,
+			fx.ResultTags(`group:"connect_interceptor"`),
+		),
+	)
+}
+
+func provideTimeoutConfig(k *koanf.Koanf) (TimeoutConfig, error) {
+	return coreconfig.Load[TimeoutConfig](k, "request-timeouts", nil)
+}
+
+func provideTimeoutInterceptor(cfg TimeoutConfig) interceptor.Interceptor {
+	return interceptor.Interceptor{
+		Priority: timeoutInterceptorPriority,
+		Handler:  newTimeoutUnaryInterceptor(cfg),
+	}
+}
+
+// budget returns the operation class and time budget of a procedure
+func (c TimeoutConfig) budget(spec connect.Spec) (string, time.Duration) {
+	switch {
+	case bulkProcedures[spec.Procedure]:
+		return "bulk", c.Bulk
+	case spec.IdempotencyLevel == connect.IdempotencyNoSideEffects:
+		return "read", c.Read
+	default:
+		return "write", c.Write
+	}
+}
+
+// newTimeoutUnaryInterceptor answers a request that ran out of its budget with DeadlineExceeded (HTTP 504),
+// whatever error the handler made of the cancelled calls. A shorter deadline set by the caller still applies.
+func newTimeoutUnaryInterceptor(cfg TimeoutConfig) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			class, budget := cfg.budget(req.Spec())
+			ctx, cancel := context.WithTimeout(ctx, budget)
+			defer cancel()
+
+			resp, err := next(ctx, req)
+			if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				logger.Get(ctx).Warn("request exceeded its time budget",
+					zap.String("procedure", req.Spec().Procedure), zap.String("class", class), zap.Duration("budget", budget), zap.Error(err))
+				return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("%s request exceeded its time budget", class))
+			}
+			return resp, err
+		}
+	}
+}
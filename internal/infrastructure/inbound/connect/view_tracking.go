@@ -0,0 +1,101 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// ViewTrackingConfig bounds the product views counted per tenant, so a busy storefront or a bot
+// doesn't turn page views into a write load on the catalog
+type ViewTrackingConfig struct {
+	// ViewsPerSecond is the rate of views counted per tenant. Default: 50
+	ViewsPerSecond float64 `koanf:"views-per-second"`
+	// Burst is the number of views counted at once above the rate. Default: 100
+	Burst int `koanf:"burst"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *ViewTrackingConfig) ApplyDefaults() {
+	if c.ViewsPerSecond <= 0 {
+		c.ViewsPerSecond = 50
+	}
+	if c.Burst <= 0 {
+		c.Burst = 100
+	}
+}
+
+// Validate validates the configuration
+func (c *ViewTrackingConfig) Validate() error {
+	if c.ViewsPerSecond > 10000 {
+		return errors.New("views-per-second must be at most 10000")
+	}
+	return nil
+}
+
+// viewTrackingModule provides the limiter of the RecordProductView procedure
+func viewTrackingModule() fx.Option {
+	return fx.Provide(
+		provideViewTrackingConfig,
+		newViewLimiter,
+	)
+}
+
+func provideViewTrackingConfig(k *koanf.Koanf) (ViewTrackingConfig, error) {
+	return coreconfig.Load[ViewTrackingConfig](k, "view-tracking", nil)
+}
+
+// viewLimiter holds a token bucket per tenant
+type viewLimiter struct {
+	cfg      ViewTrackingConfig
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newViewLimiter(cfg ViewTrackingConfig) *viewLimiter {
+	return &viewLimiter{cfg: cfg, limiters: make(map[string]*rate.Limiter)}
+}
+
+// allow takes a token from the bucket of the tenant of the context
+func (l *viewLimiter) allow(ctx context.Context) bool {
+	slug, _ := tenant.SlugFromContext(ctx)
+
+	l.mu.Lock()
+	limiter, ok := l.limiters[slug]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(l.cfg.ViewsPerSecond), l.cfg.Burst)
+		l.limiters[slug] = limiter
+	}
+	l.mu.Unlock()
+
+	return limiter.Allow()
+}
+
+// RecordProductView answers every valid request with success: storefronts report views without waiting
+// for or retrying them, so views past the limit and failed writes are only logged
+func (h *productHandler) RecordProductView(ctx context.Context, req *connect.Request[catalogv1.RecordProductViewRequest]) (*connect.Response[catalogv1.RecordProductViewResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("product ID is required"))
+	}
+
+	log := logger.Get(ctx).With(zap.String("component", "view-tracking"), zap.String("productId", req.Msg.GetId()))
+	if !h.viewLimiter.allow(ctx) {
+		log.Debug("product view dropped by the rate limit")
+		return connect.NewResponse(&catalogv1.RecordProductViewResponse{}), nil
+	}
+	if err := h.recordViewHandler.Handle(ctx, req.Msg.GetId()); err != nil {
+		log.Debug("product view not recorded", zap.Error(err))
+	}
+	return connect.NewResponse(&catalogv1.RecordProductViewResponse{}), nil
+}
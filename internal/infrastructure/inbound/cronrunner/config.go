@@ -0,0 +1,81 @@
+package cronrunner
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+)
+
+// Config holds the cron module configuration
+type Config struct {
+	// Tasks enables or disables tasks by name and overrides their schedule
+	Tasks map[string]TaskConfig `koanf:"tasks"`
+	// Timeout bounds a single run. Default: 10m
+	Timeout time.Duration `koanf:"timeout"`
+	// Archive is the policy of the archive-disabled-products task
+	Archive ArchiveConfig `koanf:"archive"`
+	// Views is the policy of the decay-product-views task
+	Views ViewsConfig `koanf:"views"`
+}
+
+// ArchiveConfig tells which products the archive-disabled-products task moves to the archive
+type ArchiveConfig struct {
+	// DisabledFor is how long a product stays disabled and unchanged before it is archived. Default: 4320h (180 days)
+	DisabledFor time.Duration `koanf:"disabled-for"`
+	// BatchSize is the number of products moved per transaction. Default: 100
+	BatchSize int `koanf:"batch-size"`
+}
+
+// ViewsConfig tells how fast the decay-product-views task forgets views
+type ViewsConfig struct {
+	// DecayFactor multiplies the views of every product at each run. Default: 0.5, so views halve daily
+	DecayFactor float64 `koanf:"decay-factor"`
+}
+
+// TaskConfig overrides the defaults of a task
+type TaskConfig struct {
+	// Enabled turns the task on or off; the default of the task applies when unset
+	Enabled *bool `koanf:"enabled"`
+	// Schedule is a cron expression replacing the default schedule of the task
+	Schedule string `koanf:"schedule"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Minute
+	}
+	if c.Archive.DisabledFor <= 0 {
+		c.Archive.DisabledFor = 180 * 24 * time.Hour
+	}
+	if c.Archive.BatchSize <= 0 {
+		c.Archive.BatchSize = 100
+	}
+	if c.Views.DecayFactor <= 0 {
+		c.Views.DecayFactor = 0.5
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.Timeout < time.Second {
+		return errors.New("timeout must be at least 1s")
+	}
+	if c.Archive.DisabledFor < 24*time.Hour {
+		return errors.New("archive.disabled-for must be at least 24h")
+	}
+	if c.Views.DecayFactor >= 1 {
+		return errors.New("views.decay-factor must be below 1")
+	}
+	for name, t := range c.Tasks {
+		if t.Schedule == "" {
+			continue
+		}
+		if _, err := cron.ParseSchedule(t.Schedule); err != nil {
+			return fmt.Errorf("task %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package cronrunner
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module runs the recurring tasks of the fx group "cron_task" on their schedule, on the leader replica only
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newRunner,
+			fx.Annotate(newStaleJobsTask, fx.ResultTags(`group:"cron_task"`)),
+			fx.Annotate(newArchiveProductsTask, fx.ResultTags(`group:"cron_task"`)),
+			fx.Annotate(newDecayViewsTask, fx.ResultTags(`group:"cron_task"`)),
+			fx.Annotate(newResyncAttributeSlugsTask, fx.ResultTags(`group:"cron_task"`)),
+		),
+		fx.Invoke(worker.RunWorker[*Runner]("cron", worker.WithReady())),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "cron", nil)
+}
@@ -0,0 +1,164 @@
+package cronrunner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+)
+
+type scheduledTask struct {
+	cron.Task
+	schedule cron.Schedule
+}
+
+// Runner runs every enabled task on its schedule while this replica leads "cron", and records the runs.
+// Occurrences falling into a read-only maintenance window are skipped.
+type Runner struct {
+	cfg     Config
+	tasks   []scheduledTask
+	runs    cron.RunRepository
+	elector leader.Elector
+	mode    maintenance.Service
+	log     *zap.Logger
+}
+
+type runnerParams struct {
+	fx.In
+
+	Cfg     Config
+	Tasks   []cron.Task `group:"cron_task"`
+	Runs    cron.RunRepository
+	Elector leader.Elector
+	Mode    maintenance.Service
+	Log     *zap.Logger
+}
+
+func newRunner(p runnerParams) (*Runner, error) {
+	r := &Runner{
+		cfg:     p.Cfg,
+		runs:    p.Runs,
+		elector: p.Elector,
+		mode:    p.Mode,
+		log:     p.Log.With(zap.String("component", "cron")),
+	}
+
+	known := make(map[string]bool, len(p.Tasks))
+	for _, t := range p.Tasks {
+		known[t.Name] = true
+
+		enabled, expr := t.Enabled, t.Schedule
+		if override, ok := p.Cfg.Tasks[t.Name]; ok {
+			if override.Enabled != nil {
+				enabled = *override.Enabled
+			}
+			if override.Schedule != "" {
+				expr = override.Schedule
+			}
+		}
+		if !enabled {
+			r.log.Info("cron task disabled", zap.String("task", t.Name))
+			continue
+		}
+
+		schedule, err := cron.ParseSchedule(expr)
+		if err != nil {
+			return nil, fmt.Errorf("cron task %s: %w", t.Name, err)
+		}
+		r.tasks = append(r.tasks, scheduledTask{Task: t, schedule: schedule})
+	}
+
+	// A typo in the config would silently keep a task on its defaults
+	for name := range p.Cfg.Tasks {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown cron task in config: %s", name)
+		}
+	}
+
+	return r, nil
+}
+
+// Run runs the tasks whenever this replica leads until ctx is cancelled
+func (r *Runner) Run(ctx context.Context) error {
+	if len(r.tasks) == 0 {
+		return nil
+	}
+	r.elector.Run(ctx, "cron", r.runTasks)
+	return nil
+}
+
+func (r *Runner) runTasks(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, t := range r.tasks {
+		r.log.Info("cron task scheduled", zap.String("task", t.Name), zap.Time("next", t.schedule.Next(time.Now())))
+		wg.Go(func() { r.loop(ctx, t) })
+	}
+	wg.Wait()
+}
+
+func (r *Runner) loop(ctx context.Context, t scheduledTask) {
+	for {
+		next := t.schedule.Next(time.Now())
+		if next.IsZero() {
+			r.log.Warn("cron task schedule never matches", zap.String("task", t.Name))
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		r.runOnce(ctx, t, next)
+	}
+}
+
+// runOnce runs the occurrence of the task at scheduledAt. A run cut short by a leadership change is recorded
+// as failed; the new leader goes on with the next occurrence.
+func (r *Runner) runOnce(ctx context.Context, t scheduledTask, scheduledAt time.Time) {
+	if r.mode.Current().ReadOnly {
+		r.log.Info("cron task skipped, service is read-only", zap.String("task", t.Name))
+		return
+	}
+
+	run := cron.NewRun(t.Name, r.elector.Owner(), scheduledAt)
+	if err := r.runs.Insert(ctx, run); err != nil {
+		r.log.Warn("failed to record cron run", zap.String("task", t.Name), zap.Error(err))
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	err := safeRun(runCtx, t.Task)
+	cancel()
+	run.Finish(err)
+
+	// Record the outcome even if the run was aborted
+	if err := r.runs.Save(context.WithoutCancel(ctx), run); err != nil {
+		r.log.Warn("failed to record cron run", zap.String("task", t.Name), zap.Error(err))
+	}
+
+	if err != nil {
+		r.log.Error("cron task failed", zap.String("task", t.Name), zap.Duration("duration", run.Duration()), zap.Error(err))
+		return
+	}
+	r.log.Info("cron task finished", zap.String("task", t.Name), zap.Duration("duration", run.Duration()))
+}
+
+// safeRun turns a panic of the task into a failed run, so it doesn't stop the other tasks
+func safeRun(ctx context.Context, t cron.Task) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("cron task panicked: %v", p)
+		}
+	}()
+	return t.Run(ctx)
+}
@@ -0,0 +1,150 @@
+package cronrunner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+)
+
+// recordedRuns keeps the last saved state of every run
+type recordedRuns map[string]cron.Run
+
+func (r recordedRuns) Insert(_ context.Context, run *cron.Run) error {
+	r[run.ID] = *run
+	return nil
+}
+
+func (r recordedRuns) Save(_ context.Context, run *cron.Run) error {
+	r[run.ID] = *run
+	return nil
+}
+
+// soleElector makes this replica the leader until ctx is done
+type soleElector struct{}
+
+func (soleElector) Run(ctx context.Context, _ string, fn func(ctx context.Context)) {
+	fn(ctx)
+}
+
+func (soleElector) Owner() string {
+	return "replica-a"
+}
+
+// fixedMode is a maintenance mode that is never stored or reloaded
+type fixedMode struct {
+	mode maintenance.Mode
+}
+
+func (m *fixedMode) Current() maintenance.Mode {
+	return m.mode
+}
+
+func (m *fixedMode) Set(context.Context, maintenance.SetModeCommand) (maintenance.Mode, error) {
+	return maintenance.Mode{}, errors.New("not supported")
+}
+
+func (m *fixedMode) Reload(context.Context) (bool, error) {
+	return false, nil
+}
+
+func newTestRunner(t *testing.T, cfg Config, runs recordedRuns, tasks ...cron.Task) *Runner {
+	t.Helper()
+
+	cfg.ApplyDefaults()
+	r, err := newRunner(runnerParams{Cfg: cfg, Tasks: tasks, Runs: runs, Elector: soleElector{}, Mode: &fixedMode{}, Log: zap.NewNop()})
+	require.NoError(t, err)
+	return r
+}
+
+func TestRunner_RecordsRun(t *testing.T) {
+	runs := recordedRuns{}
+	calls := 0
+	r := newTestRunner(t, Config{}, runs, cron.Task{Name: "count", Schedule: "* * * * *", Enabled: true, Run: func(context.Context) error {
+		calls++
+		return nil
+	}})
+	scheduledAt := time.Now().Truncate(time.Minute)
+
+	r.runOnce(context.Background(), r.tasks[0], scheduledAt)
+
+	assert.Equal(t, 1, calls)
+	require.Len(t, runs, 1)
+	for _, run := range runs {
+		assert.Equal(t, "count", run.Task)
+		assert.Equal(t, "replica-a", run.Owner)
+		assert.Equal(t, cron.RunStatusSucceeded, run.Status)
+		assert.Equal(t, scheduledAt.UTC(), run.ScheduledAt)
+		assert.NotNil(t, run.FinishedAt)
+	}
+}
+
+func TestRunner_RecordsFailures(t *testing.T) {
+	runs := recordedRuns{}
+	r := newTestRunner(t, Config{}, runs,
+		cron.Task{Name: "failing", Schedule: "@daily", Enabled: true, Run: func(context.Context) error {
+			return errors.New("boom")
+		}},
+		cron.Task{Name: "panicking", Schedule: "@daily", Enabled: true, Run: func(context.Context) error {
+			panic("oops")
+		}},
+	)
+
+	for _, task := range r.tasks {
+		r.runOnce(context.Background(), task, time.Now())
+	}
+
+	require.Len(t, runs, 2)
+	for _, run := range runs {
+		assert.Equal(t, cron.RunStatusFailed, run.Status, run.Task)
+		assert.NotEmpty(t, run.Error, run.Task)
+	}
+}
+
+func TestRunner_SkipsWhileReadOnly(t *testing.T) {
+	runs := recordedRuns{}
+	calls := 0
+	r := newTestRunner(t, Config{}, runs, cron.Task{Name: "count", Schedule: "* * * * *", Enabled: true, Run: func(context.Context) error {
+		calls++
+		return nil
+	}})
+	r.mode = &fixedMode{mode: maintenance.Mode{ReadOnly: true}}
+
+	r.runOnce(context.Background(), r.tasks[0], time.Now())
+
+	assert.Zero(t, calls)
+	assert.Empty(t, runs, "skipped occurrences are not recorded, the database is under maintenance")
+}
+
+func TestNewRunner_AppliesConfig(t *testing.T) {
+	noop := func(context.Context) error { return nil }
+	tasks := []cron.Task{
+		{Name: "on", Schedule: "@daily", Enabled: true, Run: noop},
+		{Name: "off", Schedule: "@daily", Enabled: true, Run: noop},
+		{Name: "opt-in", Schedule: "@daily", Run: noop},
+	}
+	cfg := Config{Tasks: map[string]TaskConfig{
+		"on":     {Schedule: "0 3 * * *"},
+		"off":    {Enabled: new(false)},
+		"opt-in": {Enabled: new(true)},
+	}}
+
+	r := newTestRunner(t, cfg, recordedRuns{}, tasks...)
+
+	require.Len(t, r.tasks, 2)
+	assert.Equal(t, "on", r.tasks[0].Name)
+	assert.Equal(t, 3, r.tasks[0].schedule.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).Hour())
+	assert.Equal(t, "opt-in", r.tasks[1].Name)
+
+	cfg.Tasks["typo"] = TaskConfig{}
+	cfg.ApplyDefaults()
+	_, err := newRunner(runnerParams{Cfg: cfg, Tasks: tasks, Runs: recordedRuns{}, Log: zap.NewNop()})
+	assert.ErrorContains(t, err, "unknown cron task in config: typo")
+}
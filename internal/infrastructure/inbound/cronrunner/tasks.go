@@ -0,0 +1,131 @@
+package cronrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// newStaleJobsTask stores the background jobs whose replica died as failed, in every enabled tenant
+func newStaleJobsTask(slugs tenant.SlugsProvider, handler job.FailStaleJobsCommandHandler, log *zap.Logger) cron.Task {
+	log = log.With(zap.String("component", "cron"), zap.String("task", "fail-stale-jobs"))
+
+	return cron.Task{
+		Name:     "fail-stale-jobs",
+		Schedule: "*/5 * * * *",
+		Enabled:  true,
+		Run: func(ctx context.Context) error {
+			return forEachTenant(ctx, slugs, func(tenantCtx context.Context, slug string) error {
+				count, err := handler.Handle(tenantCtx)
+				if count > 0 {
+					log.Info("stale jobs failed", zap.String("tenant", slug), zap.Int("count", count))
+				}
+				return err
+			})
+		},
+	}
+}
+
+// newArchiveProductsTask moves the products disabled for long to the archive, in every enabled tenant.
+// It is off by default: archived products disappear from reads that don't ask for them.
+func newArchiveProductsTask(cfg Config, slugs tenant.SlugsProvider, handler product.ArchiveProductsCommandHandler, log *zap.Logger) cron.Task {
+	log = log.With(zap.String("component", "cron"), zap.String("task", "archive-disabled-products"))
+
+	return cron.Task{
+		Name:     "archive-disabled-products",
+		Schedule: "30 3 * * *",
+		Enabled:  false,
+		Run: func(ctx context.Context) error {
+			return forEachTenant(ctx, slugs, func(tenantCtx context.Context, slug string) error {
+				count, err := handler.Handle(tenantCtx, product.ArchiveProductsCommand{
+					DisabledFor: cfg.Archive.DisabledFor,
+					BatchSize:   cfg.Archive.BatchSize,
+				})
+				if count > 0 {
+					log.Info("disabled products archived", zap.String("tenant", slug), zap.Int("count", count))
+				}
+				return err
+			})
+		},
+	}
+}
+
+// newDecayViewsTask scales down the product views daily, in every enabled tenant, so the trending sort
+// follows the views of the last days
+func newDecayViewsTask(cfg Config, slugs tenant.SlugsProvider, handler product.DecayProductViewsCommandHandler, log *zap.Logger) cron.Task {
+	log = log.With(zap.String("component", "cron"), zap.String("task", "decay-product-views"))
+
+	return cron.Task{
+		Name:     "decay-product-views",
+		Schedule: "15 2 * * *",
+		Enabled:  true,
+		Run: func(ctx context.Context) error {
+			return forEachTenant(ctx, slugs, func(tenantCtx context.Context, slug string) error {
+				trending, err := handler.Handle(tenantCtx, cfg.Views.DecayFactor)
+				if err == nil {
+					log.Info("product views decayed", zap.String("tenant", slug), zap.Int64("trending", trending))
+				}
+				return err
+			})
+		},
+	}
+}
+
+// newResyncAttributeSlugsTask copies the current attribute slugs into the categories and products holding
+// stale copies, left by imports or restores, in every enabled tenant
+func newResyncAttributeSlugsTask(
+	slugs tenant.SlugsProvider,
+	categoryHandler category.ResyncAttributeSlugsCommandHandler,
+	productHandler product.ResyncAttributeSlugsCommandHandler,
+	log *zap.Logger,
+) cron.Task {
+	log = log.With(zap.String("component", "cron"), zap.String("task", "resync-attribute-slugs"))
+
+	return cron.Task{
+		Name:     "resync-attribute-slugs",
+		Schedule: "0 4 * * 0",
+		Enabled:  true,
+		Run: func(ctx context.Context) error {
+			return forEachTenant(ctx, slugs, func(tenantCtx context.Context, slug string) error {
+				categories, err := categoryHandler.Handle(tenantCtx, category.ResyncAttributeSlugsCommand{})
+				if err != nil {
+					return err
+				}
+				products, err := productHandler.Handle(tenantCtx, product.ResyncAttributeSlugsCommand{})
+				if categories > 0 || products > 0 {
+					log.Info("attribute slugs resynced", zap.String("tenant", slug),
+						zap.Int("categories", categories), zap.Int("products", products))
+				}
+				return err
+			})
+		},
+	}
+}
+
+// forEachTenant runs fn for every enabled tenant; a failing tenant doesn't stop the others
+func forEachTenant(ctx context.Context, slugs tenant.SlugsProvider, fn func(ctx context.Context, slug string) error) error {
+	all, err := slugs.GetSlugs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenants: %w", err)
+	}
+
+	var errs []error
+	for _, slug := range all {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		if err := fn(tenant.ContextWithSlug(ctx, slug), slug); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", slug, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,40 @@
+package featureflags
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+)
+
+// Config holds the feature flag configuration of the environment.
+//
+// The defaults apply until a setting of the flag is stored in the feature_flag collection of the service
+// database; stored settings are picked up by every replica within the reload interval.
+type Config struct {
+	// Defaults enables or disables flags by name. Flags left out are disabled.
+	Defaults map[string]bool `koanf:"defaults"`
+	// ReloadInterval is the delay between two reloads of the stored settings. Default: 30s
+	ReloadInterval time.Duration `koanf:"reload-interval"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.ReloadInterval <= 0 {
+		c.ReloadInterval = 30 * time.Second
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.ReloadInterval < time.Second {
+		return errors.New("reload-interval must be at least 1s")
+	}
+	for name := range c.Defaults {
+		if !feature.IsKnown(feature.Flag(name)) {
+			return fmt.Errorf("unknown feature flag: %s", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package featureflags
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module provides the configured flag defaults and runs the worker that reloads the stored flag settings
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			provideDefaults,
+			newReloader,
+		),
+		fx.Invoke(worker.RunWorker[*Reloader]("feature-flags")),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "feature-flags", nil)
+}
+
+func provideDefaults(cfg Config) feature.Defaults {
+	defaults := make(feature.Defaults, len(cfg.Defaults))
+	for name, enabled := range cfg.Defaults {
+		defaults[feature.Flag(name)] = enabled
+	}
+	return defaults
+}
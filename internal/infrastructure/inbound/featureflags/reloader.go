@@ -0,0 +1,55 @@
+package featureflags
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+)
+
+// Reloader reloads the flag settings on every replica, starting right away so stored settings
+// apply soon after startup
+type Reloader struct {
+	cfg      Config
+	registry *feature.Registry
+	log      *zap.Logger
+}
+
+func newReloader(cfg Config, registry *feature.Registry, log *zap.Logger) *Reloader {
+	return &Reloader{
+		cfg:      cfg,
+		registry: registry,
+		log:      log.With(zap.String("component", "feature-flags")),
+	}
+}
+
+// Run reloads the flags every interval until ctx is cancelled
+func (r *Reloader) Run(ctx context.Context) error {
+	r.reload(ctx)
+
+	ticker := time.NewTicker(r.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.reload(ctx)
+		}
+	}
+}
+
+// reload keeps the current states on failure, the next reload retries
+func (r *Reloader) reload(ctx context.Context) {
+	changed, err := r.registry.Reload(ctx)
+	if err != nil {
+		r.log.Warn("failed to reload feature flags", zap.Error(err))
+		return
+	}
+	for _, flag := range changed {
+		r.log.Info("feature flag changed", zap.String("flag", string(flag)), zap.Bool("enabled", r.registry.Enabled(flag)))
+	}
+}
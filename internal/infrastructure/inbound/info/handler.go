@@ -0,0 +1,73 @@
+package info
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Build details, set with -ldflags "-X" by the Makefile. Without them, Version is the version the service
+// is deployed as, Commit the revision the Go toolchain embeds and BuildTime the time of that commit.
+var (
+	Version   string
+	Commit    string
+	BuildTime string
+)
+
+type infoResponse struct {
+	Service      string         `json:"service"`
+	Environment  string         `json:"environment"`
+	Version      string         `json:"version"`
+	Commit       string         `json:"commit,omitempty"`
+	BuildTime    string         `json:"buildTime,omitempty"`
+	GoVersion    string         `json:"goVersion"`
+	FeatureFlags []string       `json:"featureFlags"`
+	EventSchemas map[string]int `json:"eventSchemas"`
+}
+
+type handler struct {
+	app   config.AppConfig
+	flags feature.Flags
+	build infoResponse
+}
+
+func newHandler(app config.AppConfig, flags feature.Flags) *handler {
+	build := infoResponse{Version: Version, Commit: Commit, BuildTime: BuildTime}
+	if build.Version == "" {
+		build.Version = app.ServiceVersion
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		build.GoVersion = bi.GoVersion
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && build.Commit == "":
+				build.Commit = s.Value
+			case s.Key == "vcs.time" && build.BuildTime == "":
+				build.BuildTime = s.Value
+			}
+		}
+	}
+	return &handler{app: app, flags: flags, build: build}
+}
+
+// getInfo describes the build and the flags this replica applies. It needs no token, like the health checks.
+func (h *handler) getInfo(w http.ResponseWriter, _ *http.Request) {
+	resp := h.build
+	resp.Service = h.app.ServiceName
+	resp.Environment = h.app.Environment
+	resp.FeatureFlags = []string{}
+	for _, flag := range feature.Known() {
+		if h.flags.Enabled(flag) {
+			resp.FeatureFlags = append(resp.FeatureFlags, string(flag))
+		}
+	}
+	resp.EventSchemas = event.SchemaVersions()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(resp) //nolint:errcheck // the client went away
+}
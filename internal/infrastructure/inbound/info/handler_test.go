@@ -0,0 +1,43 @@
+package info
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+func TestGetInfo(t *testing.T) {
+	Version, Commit, BuildTime = "1.2.3", "abc123", "2026-01-02T03:04:05Z"
+	t.Cleanup(func() { Version, Commit, BuildTime = "", "", "" })
+
+	mux := http.NewServeMux()
+	app := config.AppConfig{ServiceName: "ecommerce-catalog-service", Environment: "staging", ServiceVersion: "1.2.2"}
+	registerRoutes(mux, newHandler(app, feature.Static(feature.PriceChangedEvents)))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/info", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp infoResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "ecommerce-catalog-service", resp.Service)
+	assert.Equal(t, "staging", resp.Environment)
+	assert.Equal(t, "1.2.3", resp.Version)
+	assert.Equal(t, "abc123", resp.Commit)
+	assert.Equal(t, "2026-01-02T03:04:05Z", resp.BuildTime)
+	assert.Equal(t, []string{string(feature.PriceChangedEvents)}, resp.FeatureFlags)
+	assert.Equal(t, 1, resp.EventSchemas["catalog.v1.ProductUpdatedEvent"])
+}
+
+func TestGetInfo_DeployedVersion(t *testing.T) {
+	h := newHandler(config.AppConfig{ServiceVersion: "1.2.2"}, feature.Static())
+
+	assert.Equal(t, "1.2.2", h.build.Version)
+}
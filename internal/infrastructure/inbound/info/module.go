@@ -0,0 +1,19 @@
+package info
+
+import (
+	"net/http"
+
+	"go.uber.org/fx"
+)
+
+// Module serves the description of the running build for the service registry and support engineers
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(newHandler),
+		fx.Invoke(registerRoutes),
+	)
+}
+
+func registerRoutes(mux *http.ServeMux, h *handler) {
+	mux.HandleFunc("GET /info", h.getInfo)
+}
@@ -0,0 +1,189 @@
+package jobevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// keepAliveInterval is how often a comment is written while a job makes no progress, so proxies keep the stream open
+const keepAliveInterval = 15 * time.Second
+
+var (
+	// requiredPermissions are those of GetJob, any of them allows following a job
+	requiredPermissions = []string{"catalog:admin", "products:write"}
+	// reportPermissions are those of StartInventoryValuation
+	reportPermissions = []string{"catalog:admin"}
+)
+
+type handler struct {
+	watch     job.WatchJobQueryHandler
+	valuation product.GetInventoryValuationReportQueryHandler
+	validator validation.Validator
+	log       *zap.Logger
+}
+
+func newHandler(
+	watch job.WatchJobQueryHandler,
+	valuation product.GetInventoryValuationReportQueryHandler,
+	validator validation.Validator,
+	log *zap.Logger,
+) *handler {
+	return &handler{watch: watch, valuation: valuation, validator: validator, log: log.With(zap.String("component", "job-events-handler"))}
+}
+
+// serveEvents streams a progress event whenever the job advances, an item-error event for every item that failed
+// and a finished event once it has succeeded or failed, then ends the stream
+func (h *handler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := h.authorize(w, r, requiredPermissions)
+	if !ok {
+		return
+	}
+
+	events, err := h.watch.Handle(ctx, job.WatchJobQuery{ID: r.PathValue("id")})
+	if err != nil {
+		if errors.Is(err, job.ErrJobNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		h.log.Error("failed to watch job", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream lasts as long as the job, past the write timeout of the server
+	_ = rc.SetWriteDeadline(time.Time{}) //nolint:errcheck // not supported by every writer, the stream still works
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			err = writeEvent(w, e)
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			h.log.Debug("job event stream closed", zap.Error(err))
+			return
+		}
+	}
+}
+
+// serveValuationCSV downloads the report of a succeeded inventory valuation job as CSV
+func (h *handler) serveValuationCSV(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := h.authorize(w, r, reportPermissions)
+	if !ok {
+		return
+	}
+
+	report, err := h.valuation.Handle(ctx, product.GetInventoryValuationReportQuery{JobID: r.PathValue("id")})
+	switch {
+	case errors.Is(err, job.ErrJobNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, job.ErrJobNotSucceeded):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		h.log.Error("failed to get valuation report", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="inventory-valuation-%s.csv"`, report.GeneratedAt.Format("2006-01-02")))
+	if err := report.WriteCSV(w); err != nil {
+		h.log.Debug("valuation report download closed", zap.Error(err))
+	}
+}
+
+// authorize checks the bearer token the way the auth and tenant interceptors do for Connect procedures
+func (h *handler) authorize(w http.ResponseWriter, r *http.Request, permissions []string) (context.Context, bool) {
+	slug := r.Header.Get(tenant.TenantSlugHeader)
+	if slug == "" {
+		http.Error(w, "tenant not found in request header", http.StatusBadRequest)
+		return nil, false
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return nil, false
+	}
+	claims, err := h.validator.ValidateToken(token)
+	if err != nil {
+		h.log.Warn("auth failed", zap.Error(err))
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return nil, false
+	}
+	if !claims.HasAnyPermission(permissions) {
+		http.Error(w, fmt.Sprintf("missing required permissions: %v", permissions), http.StatusForbidden)
+		return nil, false
+	}
+	if claims.IsTenantScoped() && claims.Tenant != slug {
+		http.Error(w, fmt.Sprintf("token tenant %q does not match request tenant %q", claims.Tenant, slug), http.StatusForbidden)
+		return nil, false
+	}
+
+	ctx := tenant.ContextWithSlug(r.Context(), slug)
+	return validation.ContextWithClaims(ctx, claims), true
+}
+
+type progressData struct {
+	Status  job.Status      `json:"status"`
+	Done    int64           `json:"done"`
+	Total   int64           `json:"total"`
+	Percent int             `json:"percent"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+type itemErrorData struct {
+	Index int64  `json:"index"`
+	Item  string `json:"item"`
+	Error string `json:"error"`
+}
+
+func writeEvent(w io.Writer, e job.Event) error {
+	name := "progress"
+	var data any = progressData{Status: e.Status, Done: e.Done, Total: e.Total, Percent: e.Percent(), Result: e.Result, Error: e.Error}
+	switch {
+	case e.ItemError != nil:
+		name = "item-error"
+		data = itemErrorData{Index: e.ItemError.Index, Item: e.ItemError.Item, Error: e.ItemError.Error}
+	case e.Finished():
+		name = "finished"
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode job event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, payload)
+	return err
+}
@@ -0,0 +1,151 @@
+package jobevents
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+type stubWatch struct {
+	events []job.Event
+	err    error
+	query  job.WatchJobQuery
+}
+
+func (s *stubWatch) Handle(_ context.Context, query job.WatchJobQuery) (<-chan job.Event, error) {
+	s.query = query
+	if s.err != nil {
+		return nil, s.err
+	}
+	ch := make(chan job.Event, len(s.events))
+	for _, e := range s.events {
+		ch <- e
+	}
+	close(ch)
+	return ch, nil
+}
+
+type stubValuation struct {
+	report *product.InventoryValuationResult
+	err    error
+}
+
+func (s *stubValuation) Handle(context.Context, product.GetInventoryValuationReportQuery) (*product.InventoryValuationResult, error) {
+	return s.report, s.err
+}
+
+// stubValidator accepts the tokens it knows
+type stubValidator map[string]*validation.Claims
+
+func (v stubValidator) ValidateToken(token string) (*validation.Claims, error) {
+	claims, ok := v[token]
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+var validator = stubValidator{
+	"admin":    {Tenant: "shop", Permissions: []string{"catalog:admin"}},
+	"reader":   {Tenant: "shop", Permissions: []string{"products:read"}},
+	"intruder": {Tenant: "other", Permissions: []string{"products:write"}},
+}
+
+func serve(t *testing.T, watch *stubWatch, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	return get(t, newHandler(watch, &stubValuation{}, validator, zap.NewNop()), "/jobs/job-1/events", token)
+}
+
+func get(t *testing.T, h *handler, path, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(tenant.TenantSlugHeader, "shop")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_StreamsEvents(t *testing.T) {
+	watch := &stubWatch{events: []job.Event{
+		{Status: job.StatusRunning, Done: 1, Total: 4},
+		{Status: job.StatusRunning, Done: 1, Total: 4, ItemError: &job.ItemError{Index: 0, Item: "product-1", Error: "boom"}},
+		{Status: job.StatusSucceeded, Done: 4, Total: 4, Result: []byte(`{"merged":3}`)},
+	}}
+
+	rec := serve(t, watch, "admin")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "job-1", watch.query.ID)
+	assert.Equal(t, "event: progress\n"+
+		`data: {"status":"running","done":1,"total":4,"percent":25}`+"\n\n"+
+		"event: item-error\n"+
+		`data: {"index":0,"item":"product-1","error":"boom"}`+"\n\n"+
+		"event: finished\n"+
+		`data: {"status":"succeeded","done":4,"total":4,"percent":100,"result":{"merged":3}}`+"\n\n",
+		rec.Body.String())
+}
+
+func TestHandler_NotFound(t *testing.T) {
+	rec := serve(t, &stubWatch{err: job.ErrJobNotFound}, "admin")
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Unauthorized(t *testing.T) {
+	assert.Equal(t, http.StatusUnauthorized, serve(t, &stubWatch{}, "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(t, &stubWatch{}, "forged").Code)
+	assert.Equal(t, http.StatusForbidden, serve(t, &stubWatch{}, "reader").Code)
+	assert.Equal(t, http.StatusForbidden, serve(t, &stubWatch{}, "intruder").Code)
+}
+
+func TestHandler_ValuationCSV(t *testing.T) {
+	cost := 30.0
+	valuation := &stubValuation{report: &product.InventoryValuationResult{
+		GeneratedAt: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC),
+		CostKey:     "erp.cost",
+		Categories: []product.CategoryValuation{
+			{CategoryID: "lamps", CategoryName: "Lamps, desk", Products: 2, Units: 3, StockValue: 45.5, CostValue: &cost, MissingCost: 1},
+		},
+		Total: product.CategoryValuation{Products: 2, Units: 3, StockValue: 45.5, CostValue: &cost, MissingCost: 1},
+	}}
+	h := newHandler(&stubWatch{}, valuation, validator, zap.NewNop())
+
+	rec := get(t, h, "/jobs/job-1/inventory-valuation.csv", "admin")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="inventory-valuation-2026-03-01.csv"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "category_id,category_name,products,units,stock_value,cost_value,missing_cost\n"+
+		"lamps,\"Lamps, desk\",2,3,45.50,30.00,1\n"+
+		",Total,2,3,45.50,30.00,1\n", rec.Body.String())
+
+	assert.Equal(t, http.StatusForbidden, get(t, h, "/jobs/job-1/inventory-valuation.csv", "intruder").Code)
+}
+
+func TestHandler_ValuationCSVNotReady(t *testing.T) {
+	notReady := newHandler(&stubWatch{}, &stubValuation{err: job.ErrJobNotSucceeded}, validator, zap.NewNop())
+	assert.Equal(t, http.StatusConflict, get(t, notReady, "/jobs/job-1/inventory-valuation.csv", "admin").Code)
+
+	missing := newHandler(&stubWatch{}, &stubValuation{err: job.ErrJobNotFound}, validator, zap.NewNop())
+	assert.Equal(t, http.StatusNotFound, get(t, missing, "/jobs/job-1/inventory-valuation.csv", "admin").Code)
+}
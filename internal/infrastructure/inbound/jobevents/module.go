@@ -0,0 +1,21 @@
+package jobevents
+
+import (
+	"net/http"
+
+	"go.uber.org/fx"
+)
+
+// Module streams the progress of background jobs as server-sent events for the admin UI
+// and serves the reports of the jobs that produce one as downloads
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(newHandler),
+		fx.Invoke(registerRoutes),
+	)
+}
+
+func registerRoutes(mux *http.ServeMux, h *handler) {
+	mux.HandleFunc("GET /jobs/{id}/events", h.serveEvents)
+	mux.HandleFunc("GET /jobs/{id}/inventory-valuation.csv", h.serveValuationCSV)
+}
@@ -0,0 +1,27 @@
+package maintenancemode
+
+import (
+	"errors"
+	"time"
+)
+
+// Config holds the maintenance mode configuration
+type Config struct {
+	// ReloadInterval bounds how long other replicas take to apply a mode set on one of them. Default: 10s
+	ReloadInterval time.Duration `koanf:"reload-interval"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.ReloadInterval <= 0 {
+		c.ReloadInterval = 10 * time.Second
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.ReloadInterval < time.Second {
+		return errors.New("reload-interval must be at least 1s")
+	}
+	return nil
+}
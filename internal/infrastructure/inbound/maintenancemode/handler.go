@@ -0,0 +1,111 @@
+package maintenancemode
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+// requiredPermissions allow switching the mode; tenant-scoped tokens are refused because the mode applies to every tenant
+var requiredPermissions = []string{"catalog:admin"}
+
+// maxBodySize bounds the request body, a mode is a few fields
+const maxBodySize = 4 << 10
+
+type handler struct {
+	service   maintenance.Service
+	validator validation.Validator
+	log       *zap.Logger
+}
+
+func newHandler(service maintenance.Service, validator validation.Validator, log *zap.Logger) *handler {
+	return &handler{service: service, validator: validator, log: log.With(zap.String("component", "maintenance-handler"))}
+}
+
+type modeRequest struct {
+	ReadOnly          bool   `json:"readOnly"`
+	Reason            string `json:"reason"`
+	RetryAfterSeconds int    `json:"retryAfterSeconds"`
+}
+
+type modeResponse struct {
+	ReadOnly          bool      `json:"readOnly"`
+	Reason            string    `json:"reason,omitempty"`
+	RetryAfterSeconds int       `json:"retryAfterSeconds,omitempty"`
+	ModifiedAt        time.Time `json:"modifiedAt,omitzero"`
+}
+
+// getMode returns the mode this replica applies
+func (h *handler) getMode(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r) {
+		return
+	}
+	writeMode(w, h.service.Current())
+}
+
+// setMode stores the mode; other replicas apply it within the reload interval
+func (h *handler) setMode(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r) {
+		return
+	}
+
+	var req modeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	mode, err := h.service.Set(r.Context(), maintenance.SetModeCommand{
+		ReadOnly:   req.ReadOnly,
+		Reason:     req.Reason,
+		RetryAfter: time.Duration(req.RetryAfterSeconds) * time.Second,
+	})
+	if err != nil {
+		if errors.Is(err, maintenance.ErrInvalidMode) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.log.Error("failed to set maintenance mode", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writeMode(w, mode)
+}
+
+// authorize accepts platform tokens with an admin permission only
+func (h *handler) authorize(w http.ResponseWriter, r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return false
+	}
+	claims, err := h.validator.ValidateToken(token)
+	if err != nil {
+		h.log.Warn("auth failed", zap.Error(err))
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return false
+	}
+	if !claims.HasAnyPermission(requiredPermissions) || claims.IsTenantScoped() {
+		http.Error(w, "maintenance mode can only be changed by platform admins", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func writeMode(w http.ResponseWriter, mode maintenance.Mode) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(modeResponse{ //nolint:errcheck // the client went away
+		ReadOnly:          mode.ReadOnly,
+		Reason:            mode.Reason,
+		RetryAfterSeconds: int(mode.RetryAfter.Seconds()),
+		ModifiedAt:        mode.ModifiedAt,
+	})
+}
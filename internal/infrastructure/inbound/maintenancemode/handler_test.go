@@ -0,0 +1,116 @@
+package maintenancemode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+type stubRepository struct {
+	mode *maintenance.Mode
+}
+
+func (r *stubRepository) Find(context.Context) (*maintenance.Mode, error) {
+	if r.mode == nil {
+		return nil, mongo.ErrEntityNotFound
+	}
+	return r.mode, nil
+}
+
+func (r *stubRepository) Save(_ context.Context, mode *maintenance.Mode) error {
+	r.mode = mode
+	return nil
+}
+
+// stubValidator accepts the tokens it knows
+type stubValidator map[string]*validation.Claims
+
+func (v stubValidator) ValidateToken(token string) (*validation.Claims, error) {
+	claims, ok := v[token]
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+var validator = stubValidator{
+	"platform":     {Permissions: []string{"catalog:admin"}},
+	"tenant-admin": {Tenant: "shop", Permissions: []string{"catalog:admin"}},
+	"service":      {Permissions: []string{"products:read"}},
+}
+
+func serve(t *testing.T, service maintenance.Service, method, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	registerRoutes(mux, newHandler(service, validator, zap.NewNop()))
+
+	req := httptest.NewRequest(method, "/admin/maintenance", strings.NewReader(body))
+	req = req.WithContext(logger.With(req.Context(), zap.NewNop()))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_SetAndGetMode(t *testing.T) {
+	repo := &stubRepository{}
+	service := maintenance.NewService(repo)
+
+	rec := serve(t, service, http.MethodPut, "platform", `{"readOnly":true,"reason":"database upgrade","retryAfterSeconds":600}`)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp modeResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.ReadOnly)
+	assert.Equal(t, "database upgrade", resp.Reason)
+	assert.Equal(t, 600, resp.RetryAfterSeconds)
+	require.NotNil(t, repo.mode, "the mode is stored")
+	assert.True(t, service.Current().ReadOnly)
+
+	rec = serve(t, service, http.MethodGet, "platform", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"readOnly":true`)
+}
+
+func TestHandler_RejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		body  string
+		code  int
+	}{
+		{name: "missing token", body: `{"readOnly":true}`, code: http.StatusUnauthorized},
+		{name: "unknown token", token: "forged", body: `{"readOnly":true}`, code: http.StatusUnauthorized},
+		{name: "tenant admin", token: "tenant-admin", body: `{"readOnly":true}`, code: http.StatusForbidden},
+		{name: "missing permission", token: "service", body: `{"readOnly":true}`, code: http.StatusForbidden},
+		{name: "malformed body", token: "platform", body: `{"readOnly":`, code: http.StatusBadRequest},
+		{name: "invalid mode", token: "platform", body: `{"readOnly":true,"retryAfterSeconds":-1}`, code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := maintenance.NewService(&stubRepository{})
+
+			rec := serve(t, service, http.MethodPut, tt.token, tt.body)
+
+			assert.Equal(t, tt.code, rec.Code)
+			assert.False(t, service.Current().ReadOnly)
+		})
+	}
+}
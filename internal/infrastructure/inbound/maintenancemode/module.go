@@ -0,0 +1,58 @@
+package maintenancemode
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module serves the admin endpoint that switches the service into read-only mode
+// and keeps the mode of this replica in sync with the stored one
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newHandler,
+			newReloader,
+		),
+		fx.Invoke(
+			registerRoutes,
+			registerInitialLoad,
+			worker.RunWorker[*Reloader]("maintenance-mode"),
+		),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "maintenance", nil)
+}
+
+func registerRoutes(mux *http.ServeMux, h *handler) {
+	mux.HandleFunc("GET /admin/maintenance", h.getMode)
+	mux.HandleFunc("PUT /admin/maintenance", h.setMode)
+}
+
+// registerInitialLoad applies the stored mode before the service takes traffic, so a restart during
+// maintenance doesn't accept writes. Failing to load it is logged like unreachable brokers are.
+func registerInitialLoad(lc fx.Lifecycle, service maintenance.Service, log *zap.Logger) {
+	log = log.With(zap.String("component", "maintenance-mode"))
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if _, err := service.Reload(ctx); err != nil {
+				log.Warn("failed to load maintenance mode", zap.Error(err))
+				return nil
+			}
+			if mode := service.Current(); mode.ReadOnly {
+				log.Warn("service is read-only for maintenance", zap.String("reason", mode.Reason))
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package maintenancemode
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+)
+
+// Reloader applies the mode set on another replica
+type Reloader struct {
+	cfg     Config
+	service maintenance.Service
+	log     *zap.Logger
+}
+
+func newReloader(cfg Config, service maintenance.Service, log *zap.Logger) *Reloader {
+	return &Reloader{
+		cfg:     cfg,
+		service: service,
+		log:     log.With(zap.String("component", "maintenance-mode")),
+	}
+}
+
+// Run reloads the mode every interval until ctx is cancelled; a failed reload keeps the current mode
+func (r *Reloader) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			changed, err := r.service.Reload(ctx)
+			if err != nil {
+				r.log.Warn("failed to reload maintenance mode", zap.Error(err))
+				continue
+			}
+			if changed {
+				mode := r.service.Current()
+				r.log.Info("maintenance mode changed", zap.Bool("readOnly", mode.ReadOnly), zap.String("reason", mode.Reason))
+			}
+		}
+	}
+}
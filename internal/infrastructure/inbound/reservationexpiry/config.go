@@ -0,0 +1,35 @@
+package reservationexpiry
+
+import (
+	"errors"
+	"time"
+)
+
+// Config holds the reservation expiry worker configuration.
+//
+// A reservation stops holding stock as soon as it expires; the worker only removes it and
+// publishes StockReservationExpiredEvent, so the interval bounds how late that event can be.
+type Config struct {
+	// Interval is the delay between two expiry runs. Default: 10s
+	Interval time.Duration `koanf:"interval"`
+	// BatchSize is the maximum number of reservations expired per tenant and run. Default: 100
+	BatchSize int `koanf:"batch-size"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.Interval <= 0 {
+		c.Interval = 10 * time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.Interval < time.Second {
+		return errors.New("interval must be at least 1s")
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package reservationexpiry
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module runs the worker that removes expired reservations and announces their expiry
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newWorker,
+		),
+		fx.Invoke(worker.RunWorker[*Worker]("reservation-expiry", worker.WithReady())),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "reservation-expiry", nil)
+}
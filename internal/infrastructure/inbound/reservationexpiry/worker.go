@@ -0,0 +1,97 @@
+package reservationexpiry
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Worker periodically expires the reservations of every enabled tenant on the leader replica.
+// It pauses while the service is read-only for maintenance.
+type Worker struct {
+	cfg     Config
+	slugs   tenant.SlugsProvider
+	handler reservation.ExpireReservationsCommandHandler
+	elector leader.Elector
+	mode    maintenance.Service
+	log     *zap.Logger
+}
+
+func newWorker(
+	cfg Config,
+	slugs tenant.SlugsProvider,
+	handler reservation.ExpireReservationsCommandHandler,
+	elector leader.Elector,
+	mode maintenance.Service,
+	log *zap.Logger,
+) *Worker {
+	return &Worker{
+		cfg:     cfg,
+		slugs:   slugs,
+		handler: handler,
+		elector: elector,
+		mode:    mode,
+		log:     log.With(zap.String("component", "reservation-expiry")),
+	}
+}
+
+// Run expires reservations whenever this replica leads until ctx is cancelled
+func (w *Worker) Run(ctx context.Context) error {
+	w.elector.Run(ctx, "reservation-expiry", w.expireLoop)
+	return nil
+}
+
+func (w *Worker) expireLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if w.mode.Current().ReadOnly {
+				continue
+			}
+			w.expireAll(ctx)
+		}
+	}
+}
+
+func (w *Worker) expireAll(ctx context.Context) {
+	slugs, err := w.slugs.GetSlugs(ctx)
+	if err != nil {
+		w.log.Error("failed to get tenants", zap.Error(err))
+		return
+	}
+
+	for _, slug := range slugs {
+		w.expireTenant(ctx, slug)
+	}
+}
+
+// expireTenant drains the expired reservations of a tenant in batches; a failure is logged
+// and retried on the next run, which doesn't block the other tenants
+func (w *Worker) expireTenant(ctx context.Context, slug string) {
+	tenantCtx := tenant.ContextWithSlug(ctx, slug)
+
+	for ctx.Err() == nil {
+		count, err := w.handler.Handle(tenantCtx, reservation.ExpireReservationsCommand{Limit: w.cfg.BatchSize})
+		if err != nil {
+			w.log.Error("failed to expire reservations", zap.String("tenant", slug), zap.Error(err))
+			return
+		}
+		if count > 0 {
+			w.log.Debug("reservations expired", zap.String("tenant", slug), zap.Int("count", count))
+		}
+		if count < w.cfg.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package salesanalytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/consumer"
+)
+
+// readOnlyPollInterval is how often a sale held while the service is read-only checks the mode again
+const readOnlyPollInterval = time.Second
+
+// errReadOnly fails an attempt that ended while the service was read-only; it isn't permanent, so the sale is retried
+var errReadOnly = errors.New("service is read-only, the sale is counted once it is writable")
+
+type handler struct {
+	recordSales product.RecordSalesCommandHandler
+	mode        maintenance.Service
+}
+
+func newHandler(recordSales product.RecordSalesCommandHandler, mode maintenance.Service) *handler {
+	return &handler{recordSales: recordSales, mode: mode}
+}
+
+// handleProductsSold counts the items of the order. An invalid event goes to the DLQ, other failures are retried.
+// While the service is read-only the sale is held, so its offset isn't committed, and the attempt fails with
+// a retryable error when its processing timeout ends first.
+func (h *handler) handleProductsSold(ctx context.Context, evt *eventsv1.ProductsSoldEvent) error {
+	if !h.awaitWritable(ctx) {
+		return errReadOnly
+	}
+
+	items := make([]product.SoldItem, len(evt.GetItems()))
+	for i, item := range evt.GetItems() {
+		items[i] = product.SoldItem{ProductID: item.GetProductId(), Quantity: int(item.GetQuantity())}
+	}
+	err := h.recordSales.Handle(ctx, product.RecordSalesCommand{OrderID: evt.GetOrderId(), Items: items})
+	if errors.Is(err, product.ErrInvalidProductData) {
+		return fmt.Errorf("%w: %w", consumer.ErrPermanent, err)
+	}
+	return err
+}
+
+// awaitWritable waits while the service is read-only for maintenance and reports false when ctx is done first
+func (h *handler) awaitWritable(ctx context.Context) bool {
+	if !h.mode.Current().ReadOnly {
+		return true
+	}
+
+	ticker := time.NewTicker(readOnlyPollInterval)
+	defer ticker.Stop()
+	for h.mode.Current().ReadOnly {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
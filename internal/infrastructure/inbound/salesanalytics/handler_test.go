@@ -0,0 +1,80 @@
+package salesanalytics
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/consumer"
+)
+
+type fixedMode struct {
+	readOnly atomic.Bool
+}
+
+func (m *fixedMode) Current() maintenance.Mode {
+	return maintenance.Mode{ReadOnly: m.readOnly.Load()}
+}
+
+func (m *fixedMode) Set(context.Context, maintenance.SetModeCommand) (maintenance.Mode, error) {
+	return maintenance.Mode{}, errors.New("not supported")
+}
+
+func (m *fixedMode) Reload(context.Context) (bool, error) {
+	return false, nil
+}
+
+type recordingSales struct {
+	orders atomic.Int32
+	err    error
+}
+
+func (r *recordingSales) Handle(context.Context, product.RecordSalesCommand) error {
+	r.orders.Add(1)
+	return r.err
+}
+
+func soldEvent() *eventsv1.ProductsSoldEvent {
+	return &eventsv1.ProductsSoldEvent{OrderId: "order-1", Items: []*eventsv1.SoldItem{{ProductId: "product-1", Quantity: 2}}}
+}
+
+func TestHandleProductsSold_HeldWhileReadOnly(t *testing.T) {
+	sales, mode := &recordingSales{}, &fixedMode{}
+	h := newHandler(sales, mode)
+	mode.readOnly.Store(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := h.handleProductsSold(ctx, soldEvent())
+	require.ErrorIs(t, err, errReadOnly)
+	assert.NotErrorIs(t, err, consumer.ErrPermanent, "the sale is retried rather than sent to the DLQ")
+	assert.Zero(t, sales.orders.Load())
+
+	done := make(chan error, 1)
+	go func() { done <- h.handleProductsSold(context.Background(), soldEvent()) }()
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, sales.orders.Load(), "the sale waits for the mode to lift")
+
+	mode.readOnly.Store(false)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the sale wasn't counted once the service was writable")
+	}
+	assert.Equal(t, int32(1), sales.orders.Load())
+}
+
+func TestHandleProductsSold_InvalidGoesToDLQ(t *testing.T) {
+	h := newHandler(&recordingSales{err: product.ErrInvalidProductData}, &fixedMode{})
+
+	require.ErrorIs(t, h.handleProductsSold(context.Background(), soldEvent()), consumer.ErrPermanent)
+}
@@ -0,0 +1,25 @@
+package salesanalytics
+
+import (
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/consumer"
+)
+
+// consumerName is the entry of the consumer under kafka consumers in the configuration, naming its topic
+const consumerName = "order-analytics"
+
+// Module consumes the orders reported by order analytics to count the units sold of the products
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(newHandler),
+		consumer.RegisterHandlerAndConsumer(consumerName, newRouter),
+	)
+}
+
+func newRouter(h *handler, log *zap.Logger) consumer.Handler {
+	r := consumer.NewRouter(log)
+	consumer.Register(r, h.handleProductsSold)
+	return r
+}
@@ -0,0 +1,44 @@
+package sitemap
+
+import (
+	"errors"
+	"strings"
+)
+
+// maxURLsPerFile is the limit of the sitemap protocol
+const maxURLsPerFile = 50000
+
+// Config holds the sitemap endpoint configuration.
+//
+// URLs are built from the host the storefront forwards (X-Forwarded-Proto and X-Forwarded-Host),
+// so every tenant gets the sitemap of its own shop domain.
+type Config struct {
+	// ProductPath is the storefront path the product slug is appended to. Default: /products/
+	ProductPath string `koanf:"product-path"`
+	// URLsPerFile is the number of products per sitemap file, a multiple of 500. Default: 10000
+	URLsPerFile int `koanf:"urls-per-file"`
+	// SignedBy names the signing integration whose signature requests must carry, for storefronts
+	// that fetch the feed server-side; empty serves the sitemap to anyone
+	SignedBy string `koanf:"signed-by"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.ProductPath == "" {
+		c.ProductPath = "/products/"
+	}
+	if c.URLsPerFile <= 0 {
+		c.URLsPerFile = 10000
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if !strings.HasPrefix(c.ProductPath, "/") || !strings.HasSuffix(c.ProductPath, "/") {
+		return errors.New("product-path must start and end with /")
+	}
+	if c.URLsPerFile > maxURLsPerFile || c.URLsPerFile%500 != 0 {
+		return errors.New("urls-per-file must be a multiple of 500 up to 50000")
+	}
+	return nil
+}
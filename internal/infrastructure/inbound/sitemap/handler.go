@@ -0,0 +1,186 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type handler struct {
+	cfg     Config
+	sitemap product.GetSitemapQueryHandler
+	log     *zap.Logger
+}
+
+func newHandler(cfg Config, sitemap product.GetSitemapQueryHandler, log *zap.Logger) *handler {
+	return &handler{cfg: cfg, sitemap: sitemap, log: log.With(zap.String("component", "sitemap-handler"))}
+}
+
+// serveIndex lists one sitemap file per page of enabled products
+func (h *handler) serveIndex(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := h.tenantContext(w, r)
+	if !ok {
+		return
+	}
+
+	pages, err := h.sitemap.Pages(ctx, h.cfg.URLsPerFile)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	base := baseURL(r)
+	enc := newEncoder(w)
+	enc.start("sitemapindex")
+	for page := 1; page <= pages; page++ {
+		enc.element("sitemap", "loc", base.JoinPath("sitemap", "products", strconv.Itoa(page)+".xml").String())
+	}
+	enc.end("sitemapindex")
+	h.finish(enc)
+}
+
+// servePage streams the product URLs of a single sitemap file
+func (h *handler) servePage(w http.ResponseWriter, r *http.Request) {
+	file := r.PathValue("file")
+	page, err := strconv.Atoi(strings.TrimSuffix(file, ".xml"))
+	if err != nil || page < 1 || !strings.HasSuffix(file, ".xml") {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx, ok := h.tenantContext(w, r)
+	if !ok {
+		return
+	}
+
+	// A page past the last one is an empty sitemap rather than an error, the index may just be stale
+	base := baseURL(r)
+	enc := newEncoder(w)
+	err = h.sitemap.Handle(ctx, product.GetSitemapQuery{Page: page, Size: h.cfg.URLsPerFile}, func(e product.SitemapEntry) error {
+		enc.start("urlset")
+		enc.element("url",
+			"loc", base.JoinPath(h.cfg.ProductPath, e.Slug).String(),
+			"lastmod", e.ModifiedAt.UTC().Format(time.RFC3339),
+		)
+		return enc.err
+	})
+	if err != nil {
+		if !enc.started {
+			h.fail(w, err)
+			return
+		}
+		// The response is already streaming: abort it so crawlers retry instead of indexing a partial file
+		h.log.Error("sitemap aborted", zap.String("tenant", tenant.MustSlugFromContext(ctx)), zap.Int("page", page), zap.Error(err))
+		panic(http.ErrAbortHandler)
+	}
+	enc.start("urlset")
+	enc.end("urlset")
+	h.finish(enc)
+}
+
+func (h *handler) tenantContext(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	slug := r.Header.Get(tenant.TenantSlugHeader)
+	if slug == "" {
+		http.Error(w, "tenant not found in request header", http.StatusBadRequest)
+		return nil, false
+	}
+	return tenant.ContextWithSlug(r.Context(), slug), true
+}
+
+func (h *handler) fail(w http.ResponseWriter, err error) {
+	h.log.Error("failed to build sitemap", zap.Error(err))
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func (h *handler) finish(enc *encoder) {
+	if err := enc.flush(); err != nil {
+		h.log.Debug("failed to write sitemap", zap.Error(err))
+	}
+}
+
+// baseURL is the storefront origin the sitemap is served for
+func baseURL(r *http.Request) *url.URL {
+	scheme := r.Header.Get("X-Forwarded-Proto")
+	if scheme == "" {
+		scheme = "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+	}
+	host := r.Header.Get("X-Forwarded-Host")
+	if host == "" {
+		host = r.Host
+	}
+	return &url.URL{Scheme: scheme, Host: host}
+}
+
+// encoder writes the sitemap XML token by token
+type encoder struct {
+	w       http.ResponseWriter
+	enc     *xml.Encoder
+	started bool
+	err     error
+}
+
+func newEncoder(w http.ResponseWriter) *encoder {
+	return &encoder{w: w, enc: xml.NewEncoder(w)}
+}
+
+// start opens the root element once, the headers are sent with it
+func (e *encoder) start(root string) {
+	if e.started {
+		return
+	}
+	e.begin()
+	e.token(xml.StartElement{Name: xml.Name{Local: root}, Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: sitemapNamespace}}})
+}
+
+func (e *encoder) end(root string) {
+	e.token(xml.EndElement{Name: xml.Name{Local: root}})
+}
+
+// element writes an element holding text children given as name, value pairs
+func (e *encoder) element(name string, children ...string) {
+	e.token(xml.StartElement{Name: xml.Name{Local: name}})
+	for i := 0; i+1 < len(children); i += 2 {
+		e.token(xml.StartElement{Name: xml.Name{Local: children[i]}})
+		e.token(xml.CharData(children[i+1]))
+		e.token(xml.EndElement{Name: xml.Name{Local: children[i]}})
+	}
+	e.token(xml.EndElement{Name: xml.Name{Local: name}})
+}
+
+func (e *encoder) begin() {
+	e.started = true
+	e.w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	e.w.WriteHeader(http.StatusOK)
+	if _, err := e.w.Write([]byte(xml.Header)); err != nil {
+		e.err = fmt.Errorf("failed to write header: %w", err)
+	}
+}
+
+func (e *encoder) token(t xml.Token) {
+	if e.err == nil {
+		e.err = e.enc.EncodeToken(t)
+	}
+}
+
+func (e *encoder) flush() error {
+	if e.err != nil {
+		return e.err
+	}
+	return errors.Join(e.enc.Flush(), e.enc.Close())
+}
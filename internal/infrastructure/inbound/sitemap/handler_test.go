@@ -0,0 +1,124 @@
+package sitemap
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+type stubSitemap struct {
+	pages   int
+	entries []product.SitemapEntry
+	err     error
+	query   product.GetSitemapQuery
+}
+
+func (s *stubSitemap) Pages(context.Context, int) (int, error) {
+	return s.pages, s.err
+}
+
+func (s *stubSitemap) Handle(_ context.Context, query product.GetSitemapQuery, yield func(product.SitemapEntry) error) error {
+	s.query = query
+	for _, e := range s.entries {
+		if err := yield(e); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func serve(t *testing.T, sitemap *stubSitemap, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	return serveEncoded(t, sitemap, path, "")
+}
+
+func serveEncoded(t *testing.T, sitemap *stubSitemap, path, acceptEncoding string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	cfg := Config{}
+	cfg.ApplyDefaults()
+	mux := http.NewServeMux()
+	require.NoError(t, registerRoutes(mux, newHandler(cfg, sitemap, zap.NewNop()), nil, compression.Config{MinBytes: 1024}))
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(tenant.TenantSlugHeader, "shop")
+	req.Header.Set("X-Forwarded-Proto", "https")
+	req.Header.Set("X-Forwarded-Host", "shop.example.com")
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Index(t *testing.T) {
+	rec := serve(t, &stubSitemap{pages: 2}, "/sitemap/products.xml")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/xml; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`+
+		`<sitemap><loc>https://shop.example.com/sitemap/products/1.xml</loc></sitemap>`+
+		`<sitemap><loc>https://shop.example.com/sitemap/products/2.xml</loc></sitemap>`+
+		`</sitemapindex>`, rec.Body.String())
+}
+
+func TestHandler_Page(t *testing.T) {
+	modifiedAt := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	sitemap := &stubSitemap{entries: []product.SitemapEntry{{Slug: "blue-shirt", ModifiedAt: modifiedAt}}}
+
+	rec := serve(t, sitemap, "/sitemap/products/2.xml")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, product.GetSitemapQuery{Page: 2, Size: 10000}, sitemap.query)
+	assert.Contains(t, rec.Body.String(),
+		`<url><loc>https://shop.example.com/products/blue-shirt</loc><lastmod>2026-03-01T12:30:00Z</lastmod></url></urlset>`)
+
+	empty := serve(t, &stubSitemap{}, "/sitemap/products/9.xml")
+	require.Equal(t, http.StatusOK, empty.Code)
+	assert.Contains(t, empty.Body.String(), `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`)
+}
+
+func TestHandler_Compressed(t *testing.T) {
+	rec := serveEncoded(t, &stubSitemap{pages: 100}, "/sitemap/products.xml", "gzip")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	zr, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `<sitemap><loc>https://shop.example.com/sitemap/products/100.xml</loc></sitemap></sitemapindex>`)
+
+	// Below the threshold the response is sent as it is
+	small := serveEncoded(t, &stubSitemap{pages: 1}, "/sitemap/products.xml", "gzip")
+	assert.Empty(t, small.Header().Get("Content-Encoding"))
+	assert.Contains(t, small.Body.String(), "<sitemapindex")
+}
+
+func TestHandler_Errors(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, serve(t, &stubSitemap{}, "/sitemap/products/first.xml").Code)
+	assert.Equal(t, http.StatusNotFound, serve(t, &stubSitemap{}, "/sitemap/products/0.xml").Code)
+	assert.Equal(t, http.StatusInternalServerError, serve(t, &stubSitemap{err: errors.New("db down")}, "/sitemap/products/1.xml").Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/sitemap/products.xml", nil)
+	rec := httptest.NewRecorder()
+	mux := http.NewServeMux()
+	require.NoError(t, registerRoutes(mux, newHandler(Config{}, &stubSitemap{}, zap.NewNop()), nil, compression.Config{MinBytes: 1024}))
+	mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,46 @@
+package sitemap
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module serves the product sitemap as plain XML for the storefront to proxy
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newHandler,
+		),
+		fx.Invoke(registerRoutes),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "sitemap", nil)
+}
+
+func registerRoutes(mux *http.ServeMux, h *handler, verifier *signing.Verifier, compressionCfg compression.Config) error {
+	compress, err := compressionCfg.Middleware()
+	if err != nil {
+		return fmt.Errorf("sitemap: %w", err)
+	}
+	wrap := func(f http.HandlerFunc) http.Handler { return compress(f) }
+	if h.cfg.SignedBy != "" {
+		if !verifier.Knows(h.cfg.SignedBy) {
+			return fmt.Errorf("sitemap: unknown signing integration %s", h.cfg.SignedBy)
+		}
+		wrap = func(f http.HandlerFunc) http.Handler { return compress(verifier.Middleware(h.cfg.SignedBy)(f)) }
+	}
+
+	mux.Handle("GET /sitemap/products.xml", wrap(h.serveIndex))
+	mux.Handle("GET /sitemap/products/{file}", wrap(h.servePage))
+	return nil
+}
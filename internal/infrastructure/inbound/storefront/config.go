@@ -0,0 +1,64 @@
+package storefront
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const maxCacheAge = 24 * time.Hour
+
+// Config holds the public storefront API configuration.
+//
+// The API needs no token: it only serves enabled products and categories, with the fields a shop page shows,
+// so it can face the internet while the Connect API stays behind the gateway. Requests name their tenant
+// with the X-Tenant-Slug header, like the sitemap; unknown tenants get 404.
+type Config struct {
+	// Enabled serves the API under /storefront/v1/. Default: false
+	Enabled bool `koanf:"enabled"`
+	// RequestsPerSecond is the rate of requests served per client of a tenant; the others get 429. Default: 100
+	RequestsPerSecond float64 `koanf:"requests-per-second"`
+	// Burst is the number of requests served at once above the rate. Default: 200
+	Burst int `koanf:"burst"`
+	// Products is how long browsers and CDNs may keep the product responses. Default: 1m
+	Products time.Duration `koanf:"products"`
+	// Categories is how long browsers and CDNs may keep the category responses. Default: 10m
+	Categories time.Duration `koanf:"categories"`
+	// Tenants is how long the list of tenants is kept before it is fetched again. Default: 1m
+	Tenants time.Duration `koanf:"tenants"`
+	// ClientIPHeader names the header the edge proxy puts the client address in, e.g. X-Forwarded-For;
+	// its last entry is used. Empty uses the peer address. Default: empty
+	ClientIPHeader string `koanf:"client-ip-header"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.RequestsPerSecond <= 0 {
+		c.RequestsPerSecond = 100
+	}
+	if c.Burst <= 0 {
+		c.Burst = 200
+	}
+	if c.Products <= 0 {
+		c.Products = time.Minute
+	}
+	if c.Categories <= 0 {
+		c.Categories = 10 * time.Minute
+	}
+	if c.Tenants <= 0 {
+		c.Tenants = time.Minute
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.RequestsPerSecond > 100000 {
+		return errors.New("requests-per-second must be at most 100000")
+	}
+	for name, d := range map[string]time.Duration{"products": c.Products, "categories": c.Categories} {
+		if d < time.Second || d > maxCacheAge {
+			return fmt.Errorf("%s must be between 1s and %s", name, maxCacheAge)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package storefront
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// maxClients bounds the token buckets kept at once; new clients get 429 while it is reached
+const maxClients = 100000
+
+// guard resolves the tenant and applies the rate limit of the client before the routes
+func (h *handler) guard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := r.Header.Get(tenant.TenantSlugHeader)
+		if slug == "" {
+			writeError(w, http.StatusBadRequest, "tenant not found in request header")
+			return
+		}
+		known, err := h.tenants.contains(r.Context(), slug)
+		if err != nil {
+			h.log.Error("failed to fetch tenants", zap.Error(err))
+			writeError(w, http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable))
+			return
+		}
+		if !known {
+			writeError(w, http.StatusNotFound, "tenant not found")
+			return
+		}
+		if !h.limiter.allow(slug + "|" + h.clientIP(r)) {
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusTooManyRequests, "too many requests")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(tenant.ContextWithSlug(r.Context(), slug)))
+	})
+}
+
+// clientIP is the address of the client: the last entry of the header the edge proxy sets when configured,
+// the peer address otherwise
+func (h *handler) clientIP(r *http.Request) string {
+	if h.cfg.ClientIPHeader != "" {
+		if v := r.Header.Get(h.cfg.ClientIPHeader); v != "" {
+			entries := strings.Split(v, ",")
+			return strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// tenantList keeps the active tenant slugs for cfg.Tenants, so requests don't call the tenant service
+type tenantList struct {
+	cfg       Config
+	provider  tenant.SlugsProvider
+	now       func() time.Time
+	mu        sync.Mutex
+	slugs     map[string]struct{}
+	fetchedAt time.Time
+}
+
+func newTenantList(cfg Config, provider tenant.SlugsProvider) *tenantList {
+	return &tenantList{cfg: cfg, provider: provider, now: time.Now}
+}
+
+// contains reports whether slug is an active tenant. A failed refresh keeps the previous list;
+// it only fails when no list was fetched yet.
+func (l *tenantList) contains(ctx context.Context, slug string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now := l.now(); l.slugs == nil || now.Sub(l.fetchedAt) >= l.cfg.Tenants {
+		slugs, err := l.provider.GetSlugs(ctx)
+		switch {
+		case err == nil:
+			l.slugs = make(map[string]struct{}, len(slugs))
+			for _, s := range slugs {
+				l.slugs[s] = struct{}{}
+			}
+			l.fetchedAt = now
+		case l.slugs == nil:
+			return false, fmt.Errorf("failed to get tenant slugs: %w", err)
+		}
+	}
+
+	_, ok := l.slugs[slug]
+	return ok, nil
+}
+
+// clientLimiter holds a token bucket per client of a tenant, apart from the limits of the Connect API.
+// A bucket left idle until it refills is dropped, as a new one behaves the same.
+type clientLimiter struct {
+	cfg        Config
+	idle       time.Duration
+	now        func() time.Time
+	mu         sync.Mutex
+	buckets    map[string]*bucket
+	sweptAt    time.Time
+	maxClients int
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	seenAt  time.Time
+}
+
+func newClientLimiter(cfg Config) *clientLimiter {
+	return &clientLimiter{
+		cfg:        cfg,
+		idle:       max(time.Duration(float64(cfg.Burst)/cfg.RequestsPerSecond*float64(time.Second)), time.Second),
+		now:        time.Now,
+		buckets:    make(map[string]*bucket),
+		maxClients: maxClients,
+	}
+}
+
+func (l *clientLimiter) allow(key string) bool {
+	now := l.now()
+
+	l.mu.Lock()
+	if now.Sub(l.sweptAt) >= l.idle {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= l.maxClients {
+			l.mu.Unlock()
+			return false
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.cfg.RequestsPerSecond), l.cfg.Burst)}
+		l.buckets[key] = b
+	}
+	b.seenAt = now
+	l.mu.Unlock()
+
+	return b.limiter.AllowN(now, 1)
+}
+
+// sweep drops the buckets idle long enough to be full again
+func (l *clientLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.seenAt) >= l.idle {
+			delete(l.buckets, key)
+		}
+	}
+	l.sweptAt = now
+}
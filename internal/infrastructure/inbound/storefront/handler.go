@@ -0,0 +1,251 @@
+package storefront
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// pathPrefix is where the API is mounted
+const pathPrefix = "/storefront/v1/"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 50
+)
+
+// productSorts maps the sorts of the product list to the query sort and order; other sorts are rejected,
+// so the API never sorts by internal fields
+var productSorts = map[string][2]string{
+	"name":        {"name", "asc"},
+	"price":       {"price", "asc"},
+	"price-desc":  {"price", "desc"},
+	"newest":      {"createdAt", "desc"},
+	"bestsellers": {product.SortBestsellers, ""},
+	"trending":    {product.SortTrending, ""},
+}
+
+type handler struct {
+	cfg            Config
+	getBySlug      product.GetProductBySlugQueryHandler
+	listProducts   product.GetListProductsQueryHandler
+	getCategory    category.GetCategoryByIDQueryHandler
+	listCategories category.GetListCategoriesQueryHandler
+	navigation     product.GetNavigationQueryHandler
+	facets         product.GetCategoryFacetsQueryHandler
+	tenants        *tenantList
+	limiter        *clientLimiter
+	log            *zap.Logger
+}
+
+func newHandler(
+	cfg Config,
+	getBySlug product.GetProductBySlugQueryHandler,
+	listProducts product.GetListProductsQueryHandler,
+	getCategory category.GetCategoryByIDQueryHandler,
+	listCategories category.GetListCategoriesQueryHandler,
+	navigation product.GetNavigationQueryHandler,
+	facets product.GetCategoryFacetsQueryHandler,
+	slugs tenant.SlugsProvider,
+	log *zap.Logger,
+) *handler {
+	return &handler{
+		cfg:            cfg,
+		getBySlug:      getBySlug,
+		listProducts:   listProducts,
+		getCategory:    getCategory,
+		listCategories: listCategories,
+		navigation:     navigation,
+		facets:         facets,
+		tenants:        newTenantList(cfg, slugs),
+		limiter:        newClientLimiter(cfg),
+		log:            log.With(zap.String("component", "storefront-handler")),
+	}
+}
+
+// routes returns the router of the API, apart from the one of the Connect API
+func (h *handler) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+pathPrefix+"products", h.listProductsPage)
+	mux.HandleFunc("GET "+pathPrefix+"products/{slug}", h.productBySlug)
+	mux.HandleFunc("GET "+pathPrefix+"pdp/{slug}", h.productPage)
+	mux.HandleFunc("GET "+pathPrefix+"categories", h.listCategoriesPage)
+	mux.HandleFunc("GET "+pathPrefix+"categories/{id}", h.categoryByID)
+	mux.HandleFunc("GET "+pathPrefix+"category/{slug}", h.categoryPage)
+	mux.HandleFunc("GET "+pathPrefix+"navigation", h.navigationMenu)
+	return h.guard(mux)
+}
+
+// listProductsPage lists the enabled products, of a category when categoryId is set
+func (h *handler) listProductsPage(w http.ResponseWriter, r *http.Request) {
+	query, ok := productListQuery(w, r.URL.Query())
+	if !ok {
+		return
+	}
+	if categoryID := r.URL.Query().Get("categoryId"); categoryID != "" {
+		query.CategoryID = &categoryID
+	}
+
+	result, err := h.listProducts.Handle(r.Context(), query)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	keys := []string{edgecache.ListKey(r.Context(), edgecache.Product)}
+	for _, p := range result.Items {
+		keys = append(keys, edgecache.EntityKey(r.Context(), edgecache.Product, p.ID))
+	}
+	h.writeJSON(w, h.cfg.Products, keys, listView[productView]{
+		Items: lo.Map(result.Items, func(p *product.Product, _ int) productView { return toProductView(p) }),
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	})
+}
+
+// productBySlug shows an enabled product; a previous slug redirects to the current one
+func (h *handler) productBySlug(w http.ResponseWriter, r *http.Request) {
+	res, err := h.getBySlug.Handle(r.Context(), product.GetProductBySlugQuery{
+		Slug:   r.PathValue("slug"),
+		Tenant: tenant.MustSlugFromContext(r.Context()),
+	})
+	if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+		h.fail(w, err)
+		return
+	}
+	if err != nil || !res.Product.Enabled {
+		writeError(w, http.StatusNotFound, "product not found")
+		return
+	}
+
+	keys := []string{edgecache.EntityKey(r.Context(), edgecache.Product, res.Product.ID)}
+	if res.MovedTo != nil {
+		setCacheHeaders(w, h.cfg.Products, keys)
+		http.Redirect(w, r, pathPrefix+"products/"+url.PathEscape(*res.MovedTo), http.StatusMovedPermanently)
+		return
+	}
+	h.writeJSON(w, h.cfg.Products, keys, toProductView(res.Product))
+}
+
+// listCategoriesPage lists the enabled categories by name
+func (h *handler) listCategoriesPage(w http.ResponseWriter, r *http.Request) {
+	page, size, ok := pagination(w, r.URL.Query())
+	if !ok {
+		return
+	}
+
+	result, err := h.listCategories.Handle(r.Context(), category.GetListCategoriesQuery{
+		Page:    page,
+		Size:    size,
+		Enabled: lo.ToPtr(true),
+		Sort:    "name",
+		Order:   "asc",
+	})
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	h.writeJSON(w, h.cfg.Categories, []string{edgecache.ListKey(r.Context(), edgecache.Category)}, listView[categoryView]{
+		Items: lo.Map(result.Items, func(c *category.Category, _ int) categoryView { return toCategoryView(c) }),
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	})
+}
+
+// categoryByID shows an enabled category
+func (h *handler) categoryByID(w http.ResponseWriter, r *http.Request) {
+	c, err := h.getCategory.Handle(r.Context(), category.GetCategoryByIDQuery{ID: r.PathValue("id")})
+	if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+		h.fail(w, err)
+		return
+	}
+	if err != nil || !c.Enabled {
+		writeError(w, http.StatusNotFound, "category not found")
+		return
+	}
+
+	h.writeJSON(w, h.cfg.Categories, []string{edgecache.EntityKey(r.Context(), edgecache.Category, c.ID)}, toCategoryView(c))
+}
+
+// productListQuery reads the page, size and sort of a list of enabled products
+func productListQuery(w http.ResponseWriter, q url.Values) (product.GetListProductsQuery, bool) {
+	page, size, ok := pagination(w, q)
+	if !ok {
+		return product.GetListProductsQuery{}, false
+	}
+	query := product.GetListProductsQuery{Page: page, Size: size, Enabled: lo.ToPtr(true)}
+	if s := q.Get("sort"); s != "" {
+		sort, ok := productSorts[s]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "unknown sort "+s)
+			return product.GetListProductsQuery{}, false
+		}
+		query.Sort, query.Order = sort[0], sort[1]
+	}
+	return query, true
+}
+
+func pagination(w http.ResponseWriter, q url.Values) (page, size int, ok bool) {
+	page, size = 1, defaultPageSize
+	if v := q.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "page must be a positive number")
+			return 0, 0, false
+		}
+		page = n
+	}
+	if v := q.Get("size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, "size must be between 1 and "+strconv.Itoa(maxPageSize))
+			return 0, 0, false
+		}
+		size = n
+	}
+	return page, size, true
+}
+
+// writeJSON sends a public response that browsers and CDNs may keep for maxAge. The Surrogate-Key header
+// names the entities of the response, with the keys the catalog writes purge, as the Connect API does.
+func (h *handler) writeJSON(w http.ResponseWriter, maxAge time.Duration, keys []string, body any) {
+	setCacheHeaders(w, maxAge, keys)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.log.Debug("failed to write response", zap.Error(err))
+	}
+}
+
+func setCacheHeaders(w http.ResponseWriter, maxAge time.Duration, keys []string) {
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	w.Header().Add("Vary", tenant.TenantSlugHeader)
+	w.Header().Set("Surrogate-Key", strings.Join(keys, " "))
+}
+
+func (h *handler) fail(w http.ResponseWriter, err error) {
+	h.log.Error("storefront request failed", zap.Error(err))
+	writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message}) //nolint:errcheck // the client went away
+}
@@ -0,0 +1,435 @@
+package storefront
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+type stubCatalog struct {
+	products   map[string]*product.ProductBySlug
+	list       []*product.Product
+	categories []*category.Category
+	listQuery  product.GetListProductsQuery
+	menu       []product.NavigationNode
+	menuQuery  product.GetNavigationQuery
+	facets     map[string][]product.AttributeFacet
+}
+
+func (s *stubCatalog) getBySlug(_ context.Context, query product.GetProductBySlugQuery) (*product.ProductBySlug, error) {
+	p, ok := s.products[query.Slug]
+	if !ok {
+		return nil, mongo.ErrEntityNotFound
+	}
+	return p, nil
+}
+
+type slugsFunc func(context.Context) ([]string, error)
+
+func (f slugsFunc) GetSlugs(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+type getBySlugFunc func(context.Context, product.GetProductBySlugQuery) (*product.ProductBySlug, error)
+
+func (f getBySlugFunc) Handle(ctx context.Context, q product.GetProductBySlugQuery) (*product.ProductBySlug, error) {
+	return f(ctx, q)
+}
+
+type listProductsFunc func(context.Context, product.GetListProductsQuery) (*product.ListProductsResult, error)
+
+func (f listProductsFunc) Handle(ctx context.Context, q product.GetListProductsQuery) (*product.ListProductsResult, error) {
+	return f(ctx, q)
+}
+
+type getCategoryFunc func(context.Context, category.GetCategoryByIDQuery) (*category.Category, error)
+
+func (f getCategoryFunc) Handle(ctx context.Context, q category.GetCategoryByIDQuery) (*category.Category, error) {
+	return f(ctx, q)
+}
+
+type listCategoriesFunc func(context.Context, category.GetListCategoriesQuery) (*category.ListCategoriesResult, error)
+
+func (f listCategoriesFunc) Handle(ctx context.Context, q category.GetListCategoriesQuery) (*category.ListCategoriesResult, error) {
+	return f(ctx, q)
+}
+
+type navigationFunc func(context.Context, product.GetNavigationQuery) ([]product.NavigationNode, error)
+
+func (f navigationFunc) Handle(ctx context.Context, q product.GetNavigationQuery) ([]product.NavigationNode, error) {
+	return f(ctx, q)
+}
+
+type facetsFunc func(context.Context, product.GetCategoryFacetsQuery) ([]product.AttributeFacet, error)
+
+func (f facetsFunc) Handle(ctx context.Context, q product.GetCategoryFacetsQuery) ([]product.AttributeFacet, error) {
+	return f(ctx, q)
+}
+
+func newTestHandler(s *stubCatalog, cfg Config) *handler {
+	cfg.Enabled = true
+	cfg.ApplyDefaults()
+	return newHandler(cfg,
+		getBySlugFunc(s.getBySlug),
+		listProductsFunc(func(_ context.Context, q product.GetListProductsQuery) (*product.ListProductsResult, error) {
+			s.listQuery = q
+			return &product.ListProductsResult{Items: s.list, Page: q.Page, Size: q.Size, Total: int64(len(s.list))}, nil
+		}),
+		getCategoryFunc(func(_ context.Context, q category.GetCategoryByIDQuery) (*category.Category, error) {
+			for _, c := range s.categories {
+				if c.ID == q.ID {
+					return c, nil
+				}
+			}
+			return nil, mongo.ErrEntityNotFound
+		}),
+		listCategoriesFunc(func(_ context.Context, q category.GetListCategoriesQuery) (*category.ListCategoriesResult, error) {
+			return &category.ListCategoriesResult{Items: s.categories, Page: q.Page, Size: q.Size, Total: int64(len(s.categories))}, nil
+		}),
+		navigationFunc(func(_ context.Context, q product.GetNavigationQuery) ([]product.NavigationNode, error) {
+			s.menuQuery = q
+			return s.menu, nil
+		}),
+		facetsFunc(func(_ context.Context, q product.GetCategoryFacetsQuery) ([]product.AttributeFacet, error) {
+			return s.facets[q.CategoryID], nil
+		}),
+		slugsFunc(func(context.Context) ([]string, error) { return []string{"shop"}, nil }),
+		zap.NewNop(),
+	)
+}
+
+func serve(t *testing.T, h *handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(tenant.TenantSlugHeader, "shop")
+	return serveRequest(t, h, req)
+}
+
+func serveRequest(t *testing.T, h *handler, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	require.NoError(t, registerRoutes(mux, h, compression.Config{MinBytes: 1024}))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func drill(enabled bool) *product.Product {
+	return &product.Product{
+		ID: "product-1", Slug: "drill", Name: "Drill", Type: product.ProductTypePhysical, Price: 99, Quantity: 5, Reserved: 2,
+		Enabled: enabled, Metadata: map[string]string{"erp": "D-1"}, Supplier: &product.SupplierRef{SupplierID: "supplier-1"}, UnitsSold: 7,
+	}
+}
+
+func TestStorefront_ProductBySlug(t *testing.T) {
+	s := &stubCatalog{products: map[string]*product.ProductBySlug{"drill": {Product: drill(true)}}}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/products/drill")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "shop:product/product-1", rec.Header().Get("Surrogate-Key"))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "Drill", body["name"])
+	assert.InDelta(t, 3, body["availableQuantity"], 0)
+	for _, internal := range []string{"metadata", "supplierId", "unitsSold", "quantity", "views", "externalId"} {
+		assert.NotContains(t, body, internal)
+	}
+}
+
+func TestStorefront_ProductBySlugHidesDisabled(t *testing.T) {
+	s := &stubCatalog{products: map[string]*product.ProductBySlug{"drill": {Product: drill(false)}}}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/products/drill")
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+}
+
+func TestStorefront_ProductBySlugRedirectsPreviousSlug(t *testing.T) {
+	current := "drill"
+	s := &stubCatalog{products: map[string]*product.ProductBySlug{"old-drill": {Product: drill(true), MovedTo: &current}}}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/products/old-drill")
+
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/storefront/v1/products/drill", rec.Header().Get("Location"))
+}
+
+func TestStorefront_ListProducts(t *testing.T) {
+	s := &stubCatalog{list: []*product.Product{drill(true)}}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/products?categoryId=tools&sort=price-desc&page=2&size=10")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, true, *s.listQuery.Enabled, "only enabled products are listed")
+	assert.Equal(t, "tools", *s.listQuery.CategoryID)
+	assert.Equal(t, "price", s.listQuery.Sort)
+	assert.Equal(t, "desc", s.listQuery.Order)
+	assert.Equal(t, 2, s.listQuery.Page)
+	assert.Equal(t, "shop:product-list shop:product/product-1", rec.Header().Get("Surrogate-Key"))
+}
+
+func TestStorefront_BadRequests(t *testing.T) {
+	h := newTestHandler(&stubCatalog{}, Config{})
+
+	for _, path := range []string{
+		"/storefront/v1/products?sort=supplierId",
+		"/storefront/v1/products?size=500",
+		"/storefront/v1/categories?page=0",
+	} {
+		assert.Equal(t, http.StatusBadRequest, serve(t, h, path).Code, path)
+	}
+}
+
+func TestStorefront_Categories(t *testing.T) {
+	s := &stubCatalog{categories: []*category.Category{
+		{ID: "tools", Name: "Tools", Enabled: true},
+		{ID: "hidden", Name: "Hidden"},
+	}}
+	h := newTestHandler(s, Config{})
+
+	rec := serve(t, h, "/storefront/v1/categories/tools")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=600", rec.Header().Get("Cache-Control"))
+
+	assert.Equal(t, http.StatusNotFound, serve(t, h, "/storefront/v1/categories/hidden").Code)
+}
+
+func TestStorefront_RateLimit(t *testing.T) {
+	h := newTestHandler(&stubCatalog{}, Config{RequestsPerSecond: 1, Burst: 2})
+
+	assert.Equal(t, http.StatusOK, serve(t, h, "/storefront/v1/categories").Code)
+	assert.Equal(t, http.StatusOK, serve(t, h, "/storefront/v1/categories").Code)
+	rec := serve(t, h, "/storefront/v1/categories")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+}
+
+func TestStorefront_RateLimitPerClient(t *testing.T) {
+	h := newTestHandler(&stubCatalog{}, Config{RequestsPerSecond: 1, Burst: 1, ClientIPHeader: "X-Forwarded-For"})
+	from := func(ip string) int {
+		req := httptest.NewRequest(http.MethodGet, "/storefront/v1/categories", nil)
+		req.Header.Set(tenant.TenantSlugHeader, "shop")
+		req.Header.Set("X-Forwarded-For", "203.0.113.9, "+ip)
+		return serveRequest(t, h, req).Code
+	}
+
+	assert.Equal(t, http.StatusOK, from("198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, from("198.51.100.1"))
+	assert.Equal(t, http.StatusOK, from("198.51.100.2"))
+}
+
+func TestClientLimiter_DropsIdleBuckets(t *testing.T) {
+	cfg := Config{RequestsPerSecond: 1, Burst: 2}
+	now := time.Now()
+	l := newClientLimiter(cfg)
+	l.now = func() time.Time { return now }
+
+	assert.True(t, l.allow("shop|198.51.100.1"))
+	assert.True(t, l.allow("shop|198.51.100.2"))
+	assert.Len(t, l.buckets, 2)
+
+	now = now.Add(time.Second)
+	assert.True(t, l.allow("shop|198.51.100.1"))
+	now = now.Add(time.Second)
+	assert.True(t, l.allow("shop|198.51.100.1"))
+
+	assert.Len(t, l.buckets, 1, "the idle bucket is refilled, so it is dropped")
+}
+
+func TestClientLimiter_Bounded(t *testing.T) {
+	l := newClientLimiter(Config{RequestsPerSecond: 1, Burst: 1})
+	l.maxClients = 1
+
+	assert.True(t, l.allow("shop|198.51.100.1"))
+	assert.False(t, l.allow("shop|198.51.100.2"))
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestStorefront_UnknownTenant(t *testing.T) {
+	h := newTestHandler(&stubCatalog{}, Config{})
+	req := httptest.NewRequest(http.MethodGet, "/storefront/v1/categories", nil)
+	req.Header.Set(tenant.TenantSlugHeader, "nobody")
+
+	rec := serveRequest(t, h, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+}
+
+func TestTenantList_KeepsSlugsWhenRefreshFails(t *testing.T) {
+	cfg := Config{}
+	cfg.ApplyDefaults()
+	var calls int
+	fail := errors.New("tenant service down")
+	now := time.Now()
+	l := newTenantList(cfg, slugsFunc(func(context.Context) ([]string, error) {
+		calls++
+		if calls > 1 {
+			return nil, fail
+		}
+		return []string{"shop"}, nil
+	}))
+	l.now = func() time.Time { return now }
+
+	ok, err := l.contains(context.Background(), "shop")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	_, err = l.contains(context.Background(), "other")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls, "the list is kept for cfg.Tenants")
+
+	now = now.Add(cfg.Tenants)
+	ok, err = l.contains(context.Background(), "shop")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, calls)
+}
+
+func TestTenantList_FailsWithoutSlugs(t *testing.T) {
+	l := newTenantList(Config{}, slugsFunc(func(context.Context) ([]string, error) {
+		return nil, errors.New("tenant service down")
+	}))
+
+	_, err := l.contains(context.Background(), "shop")
+
+	assert.Error(t, err)
+}
+
+func TestStorefront_RequiresTenant(t *testing.T) {
+	mux := http.NewServeMux()
+	require.NoError(t, registerRoutes(mux, newTestHandler(&stubCatalog{}, Config{}), compression.Config{MinBytes: 1024}))
+	rec := httptest.NewRecorder()
+
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/storefront/v1/categories", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestStorefront_Disabled(t *testing.T) {
+	h := newTestHandler(&stubCatalog{}, Config{})
+	h.cfg.Enabled = false
+
+	assert.Equal(t, http.StatusNotFound, serve(t, h, "/storefront/v1/categories").Code)
+}
+
+func TestStorefront_ProductPage(t *testing.T) {
+	categoryID, red := "tools", "red"
+	p := drill(true)
+	p.CategoryID = &categoryID
+	p.Attributes = []product.AttributeValue{{AttributeID: "color", AttributeSlug: "color", OptionSlugValue: &red}}
+	p.CategoryPath = []product.Crumb{{CategoryID: "root", Name: "Home"}, {CategoryID: "tools", Name: "Tools"}}
+	p.AttributeDefinitions = []*attribute.Attribute{{
+		ID: "color", Slug: "color", Name: "Color", Type: attribute.AttributeTypeSingle,
+		Options: []attribute.Option{{Slug: "red", Name: "Red"}, {Slug: "blue", Name: "Blue"}, {Slug: "crimson", Name: "Crimson", Deprecated: true}},
+	}}
+	saw := drill(true)
+	saw.ID, saw.Slug = "product-2", "saw"
+	s := &stubCatalog{
+		products: map[string]*product.ProductBySlug{"drill": {Product: p}},
+		list:     []*product.Product{drill(true), saw},
+		categories: []*category.Category{{ID: "tools", Name: "Tools", Enabled: true, Attributes: []category.CategoryAttribute{
+			{AttributeID: "color", Role: category.AttributeRoleVariant},
+		}}},
+	}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/pdp/drill")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "shop:product/product-1 shop:category/tools shop:product/product-2", rec.Header().Get("Surrogate-Key"))
+	assert.Equal(t, "tools", *s.listQuery.CategoryID)
+	assert.Equal(t, product.SortBestsellers, s.listQuery.Sort)
+
+	var page pdpView
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(t, "Drill", page.Product.Name)
+	assert.Equal(t, []crumbView{{CategoryID: "root", Name: "Home"}, {CategoryID: "tools", Name: "Tools"}}, page.Breadcrumb)
+	require.Len(t, page.Definitions, 1)
+	assert.Equal(t, "single", page.Definitions[0].Type)
+	require.Len(t, page.Variants, 1)
+	assert.Equal(t, []string{"red"}, page.Variants[0].Selected)
+	assert.Equal(t, []optionView{{Slug: "red", Name: "Red"}, {Slug: "blue", Name: "Blue"}}, page.Variants[0].Options, "deprecated options are left out")
+	require.Len(t, page.Related, 1, "the product itself isn't related")
+	assert.Equal(t, "saw", page.Related[0].Slug)
+}
+
+func TestStorefront_ProductPageRedirectsPreviousSlug(t *testing.T) {
+	current := "drill"
+	s := &stubCatalog{products: map[string]*product.ProductBySlug{"old-drill": {Product: drill(true), MovedTo: &current}}}
+
+	rec := serve(t, newTestHandler(s, Config{}), "/storefront/v1/pdp/old-drill")
+
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/storefront/v1/pdp/drill", rec.Header().Get("Location"))
+}
+
+func TestStorefront_Navigation(t *testing.T) {
+	s := &stubCatalog{menu: []product.NavigationNode{{CategoryID: "tools", Name: "Tools", Slug: "tools", ProductCount: 12}}}
+	h := newTestHandler(s, Config{})
+
+	rec := serve(t, h, "/storefront/v1/navigation?depth=3")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, product.GetNavigationQuery{Depth: 3, Tenant: "shop"}, s.menuQuery)
+	assert.Equal(t, "public, max-age=600", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "shop:category-list", rec.Header().Get("Surrogate-Key"))
+	assert.JSONEq(t, `{"items":[{"id":"tools","name":"Tools","slug":"tools","productCount":12,"children":[]}]}`, rec.Body.String())
+
+	assert.Equal(t, http.StatusBadRequest, serve(t, h, "/storefront/v1/navigation?depth=9").Code)
+}
+
+func TestStorefront_CategoryPage(t *testing.T) {
+	s := &stubCatalog{
+		menu: []product.NavigationNode{
+			{CategoryID: "tools", Name: "Power Tools", Slug: "power-tools", ProductCount: 1},
+			{CategoryID: "hidden", Name: "Hidden", Slug: "hidden"},
+		},
+		categories: []*category.Category{{ID: "tools", Name: "Power Tools", Enabled: true}, {ID: "hidden", Name: "Hidden"}},
+		facets: map[string][]product.AttributeFacet{"tools": {{
+			AttributeID: "color", Slug: "color", Name: "Color", Options: []product.OptionCount{{Slug: "red", Name: "Red", Count: 1}},
+		}}},
+		list: []*product.Product{drill(true)},
+	}
+	h := newTestHandler(s, Config{})
+
+	rec := serve(t, h, "/storefront/v1/category/power-tools?sort=price&page=3&size=12")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "shop:category/tools shop:category/tools/products shop:product/product-1", rec.Header().Get("Surrogate-Key"))
+	assert.Equal(t, 1, s.listQuery.Page, "the landing page shows the first page")
+	assert.Equal(t, 12, s.listQuery.Size)
+	assert.Equal(t, "price", s.listQuery.Sort)
+	assert.Equal(t, "tools", *s.listQuery.CategoryID)
+
+	var page landingView
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(t, "Power Tools", page.Category.Name)
+	assert.Equal(t, "power-tools", page.Slug)
+	assert.Equal(t, []facetView{{AttributeID: "color", Slug: "color", Name: "Color", Options: []facetOptionView{{Slug: "red", Name: "Red", Count: 1}}}}, page.Facets)
+	require.Len(t, page.Products.Items, 1)
+	assert.Equal(t, "drill", page.Products.Items[0].Slug)
+
+	assert.Equal(t, http.StatusNotFound, serve(t, h, "/storefront/v1/category/garden").Code)
+	assert.Equal(t, http.StatusNotFound, serve(t, h, "/storefront/v1/category/hidden").Code, "the menu may lag behind a disabled category")
+}
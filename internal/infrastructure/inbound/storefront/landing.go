@@ -0,0 +1,123 @@
+package storefront
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// landingView is everything a category landing page renders, so the page takes a single request
+type landingView struct {
+	Category     categoryView          `json:"category"`
+	Slug         string                `json:"slug"`
+	ProductCount int64                 `json:"productCount"`
+	Facets       []facetView           `json:"facets"`
+	Products     listView[productView] `json:"products"`
+}
+
+type facetView struct {
+	AttributeID string            `json:"attributeId"`
+	Slug        string            `json:"slug"`
+	Name        string            `json:"name"`
+	Options     []facetOptionView `json:"options"`
+}
+
+type facetOptionView struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// categoryPage assembles the landing page of an enabled category: the category, the counts of its
+// filterable attributes and the first page of its products, with the size and sort of the product list.
+// Categories have no slug of their own, so the slug is the one of the navigation menu. The menu and the
+// facets are cached by the catalog; the response is purged by writes to the category and its products.
+func (h *handler) categoryPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query, ok := productListQuery(w, r.URL.Query())
+	if !ok {
+		return
+	}
+	tenantSlug := tenant.MustSlugFromContext(ctx)
+
+	menu, err := h.navigation.Handle(ctx, product.GetNavigationQuery{Depth: product.MaxNavigationDepth, Tenant: tenantSlug})
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	node, ok := findNode(menu, r.PathValue("slug"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "category not found")
+		return
+	}
+
+	c, err := h.getCategory.Handle(ctx, category.GetCategoryByIDQuery{ID: node.CategoryID})
+	if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+		h.fail(w, err)
+		return
+	}
+	// The menu lags behind category writes until their event drops it
+	if err != nil || !c.Enabled {
+		writeError(w, http.StatusNotFound, "category not found")
+		return
+	}
+
+	facets, err := h.facets.Handle(ctx, product.GetCategoryFacetsQuery{CategoryID: c.ID, Tenant: tenantSlug})
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	query.Page, query.CategoryID = 1, &c.ID
+	products, err := h.listProducts.Handle(ctx, query)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	keys := []string{edgecache.EntityKey(ctx, edgecache.Category, c.ID), edgecache.CategoryProductsKey(ctx, c.ID)}
+	for _, p := range products.Items {
+		keys = append(keys, edgecache.EntityKey(ctx, edgecache.Product, p.ID))
+	}
+	h.writeJSON(w, h.cfg.Products, keys, landingView{
+		Category:     toCategoryView(c),
+		Slug:         node.Slug,
+		ProductCount: node.ProductCount,
+		Facets: lo.Map(facets, func(f product.AttributeFacet, _ int) facetView {
+			return facetView{
+				AttributeID: f.AttributeID,
+				Slug:        f.Slug,
+				Name:        f.Name,
+				Options: lo.Map(f.Options, func(o product.OptionCount, _ int) facetOptionView {
+					return facetOptionView{Slug: o.Slug, Name: o.Name, Count: o.Count}
+				}),
+			}
+		}),
+		Products: listView[productView]{
+			Items: lo.Map(products.Items, func(p *product.Product, _ int) productView { return toProductView(p) }),
+			Page:  products.Page,
+			Size:  products.Size,
+			Total: products.Total,
+		},
+	})
+}
+
+// findNode looks for the category with the slug at every level of the menu
+func findNode(nodes []product.NavigationNode, slug string) (product.NavigationNode, bool) {
+	for _, n := range nodes {
+		if n.Slug == slug {
+			return n, true
+		}
+		if found, ok := findNode(n.Children, slug); ok {
+			return found, true
+		}
+	}
+	return product.NavigationNode{}, false
+}
@@ -0,0 +1,39 @@
+package storefront
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module serves the read-only public storefront API as plain JSON, apart from the Connect API
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newHandler,
+		),
+		fx.Invoke(registerRoutes),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "storefront", nil)
+}
+
+func registerRoutes(mux *http.ServeMux, h *handler, compressionCfg compression.Config) error {
+	if !h.cfg.Enabled {
+		return nil
+	}
+	compress, err := compressionCfg.Middleware()
+	if err != nil {
+		return fmt.Errorf("storefront: %w", err)
+	}
+	mux.Handle(pathPrefix, compress(h.routes()))
+	return nil
+}
@@ -0,0 +1,61 @@
+package storefront
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// defaultNavigationDepth is the depth of the menu when the request doesn't set one
+const defaultNavigationDepth = 2
+
+type navigationView struct {
+	Items []navigationNodeView `json:"items"`
+}
+
+type navigationNodeView struct {
+	ID           string               `json:"id"`
+	Name         string               `json:"name"`
+	Slug         string               `json:"slug"`
+	ProductCount int64                `json:"productCount"`
+	Children     []navigationNodeView `json:"children"`
+}
+
+// navigationMenu returns the enabled categories as a tree trimmed to the depth parameter. The menu is
+// cached by the catalog and tagged with the category list key, so category writes drop both copies.
+func (h *handler) navigationMenu(w http.ResponseWriter, r *http.Request) {
+	depth := defaultNavigationDepth
+	if v := r.URL.Query().Get("depth"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > product.MaxNavigationDepth {
+			writeError(w, http.StatusBadRequest, "depth must be between 1 and "+strconv.Itoa(product.MaxNavigationDepth))
+			return
+		}
+		depth = n
+	}
+
+	menu, err := h.navigation.Handle(r.Context(), product.GetNavigationQuery{Depth: depth, Tenant: tenant.MustSlugFromContext(r.Context())})
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	h.writeJSON(w, h.cfg.Categories, []string{edgecache.ListKey(r.Context(), edgecache.Category)}, navigationView{Items: toNavigationViews(menu)})
+}
+
+func toNavigationViews(nodes []product.NavigationNode) []navigationNodeView {
+	return lo.Map(nodes, func(n product.NavigationNode, _ int) navigationNodeView {
+		return navigationNodeView{
+			ID:           n.CategoryID,
+			Name:         n.Name,
+			Slug:         n.Slug,
+			ProductCount: n.ProductCount,
+			Children:     toNavigationViews(n.Children),
+		}
+	})
+}
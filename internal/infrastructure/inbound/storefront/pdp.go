@@ -0,0 +1,175 @@
+package storefront
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// relatedLimit is the number of related products a product page shows
+const relatedLimit = 8
+
+// pdpView is everything a product detail page renders, so the page takes a single request
+type pdpView struct {
+	Product    productView `json:"product"`
+	Breadcrumb []crumbView `json:"breadcrumb"`
+	// Variants are the attributes buyers choose the product by: the ones with the variant role in
+	// the category. The catalog doesn't group products, so these are the choices, not linked products.
+	Variants    []variantView    `json:"variants"`
+	Definitions []definitionView `json:"attributeDefinitions"`
+	Related     []productView    `json:"related"`
+}
+
+type crumbView struct {
+	CategoryID string `json:"categoryId"`
+	Name       string `json:"name"`
+}
+
+type variantView struct {
+	AttributeID string       `json:"attributeId"`
+	Selected    []string     `json:"selected"`
+	Options     []optionView `json:"options"`
+}
+
+type definitionView struct {
+	ID          string       `json:"id"`
+	Slug        string       `json:"slug"`
+	Name        string       `json:"name"`
+	Type        string       `json:"type"`
+	DisplayType string       `json:"displayType"`
+	Unit        *string      `json:"unit,omitempty"`
+	Options     []optionView `json:"options,omitempty"`
+}
+
+type optionView struct {
+	Slug      string  `json:"slug"`
+	Name      string  `json:"name"`
+	ColorCode *string `json:"colorCode,omitempty"`
+	ImageID   *string `json:"imageId,omitempty"`
+}
+
+// productPage assembles the product detail page of an enabled product. The breadcrumb and the
+// attribute definitions come from the cached embeds of the product query; the response is cached
+// like a product and purged by writes to the product, its category and the related products.
+func (h *handler) productPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	res, err := h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{
+		Slug:   r.PathValue("slug"),
+		Embed:  []product.Embed{product.EmbedCategoryPath, product.EmbedAttributeDefinitions},
+		Tenant: tenant.MustSlugFromContext(ctx),
+	})
+	if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+		h.fail(w, err)
+		return
+	}
+	if err != nil || !res.Product.Enabled {
+		writeError(w, http.StatusNotFound, "product not found")
+		return
+	}
+
+	p := res.Product
+	keys := []string{edgecache.EntityKey(ctx, edgecache.Product, p.ID)}
+	if res.MovedTo != nil {
+		setCacheHeaders(w, h.cfg.Products, keys)
+		http.Redirect(w, r, pathPrefix+"pdp/"+url.PathEscape(*res.MovedTo), http.StatusMovedPermanently)
+		return
+	}
+
+	page := pdpView{
+		Product: toProductView(p),
+		Breadcrumb: lo.Map(p.CategoryPath, func(c product.Crumb, _ int) crumbView {
+			return crumbView{CategoryID: c.CategoryID, Name: c.Name}
+		}),
+		Definitions: lo.Map(p.AttributeDefinitions, func(a *attribute.Attribute, _ int) definitionView { return toDefinitionView(a) }),
+		Variants:    []variantView{},
+		Related:     []productView{},
+	}
+
+	if p.CategoryID != nil {
+		keys = append(keys, edgecache.EntityKey(ctx, edgecache.Category, *p.CategoryID))
+
+		c, err := h.getCategory.Handle(ctx, category.GetCategoryByIDQuery{ID: *p.CategoryID})
+		if err != nil && !errors.Is(err, mongo.ErrEntityNotFound) {
+			h.fail(w, err)
+			return
+		}
+		if err == nil {
+			page.Variants = variants(c, p)
+		}
+
+		related, err := h.listProducts.Handle(ctx, product.GetListProductsQuery{
+			Page:       1,
+			Size:       relatedLimit + 1,
+			Enabled:    lo.ToPtr(true),
+			CategoryID: p.CategoryID,
+			Sort:       product.SortBestsellers,
+		})
+		if err != nil {
+			h.fail(w, err)
+			return
+		}
+		for _, rp := range related.Items {
+			if rp.ID == p.ID || len(page.Related) == relatedLimit {
+				continue
+			}
+			page.Related = append(page.Related, toProductView(rp))
+			keys = append(keys, edgecache.EntityKey(ctx, edgecache.Product, rp.ID))
+		}
+	}
+
+	h.writeJSON(w, h.cfg.Products, keys, page)
+}
+
+// variants returns the variant attributes of the category the product has a value for, in the
+// order of the category, with the options buyers can still choose
+func variants(c *category.Category, p *product.Product) []variantView {
+	defs := lo.SliceToMap(p.AttributeDefinitions, func(a *attribute.Attribute) (string, *attribute.Attribute) { return a.ID, a })
+	values := lo.SliceToMap(p.Attributes, func(v product.AttributeValue) (string, product.AttributeValue) { return v.AttributeID, v })
+
+	result := []variantView{}
+	for _, ca := range c.Attributes {
+		def, hasDef := defs[ca.AttributeID]
+		value, hasValue := values[ca.AttributeID]
+		if !ca.Role.CreatesVariants() || !hasDef || !hasValue {
+			continue
+		}
+		selected := value.OptionSlugValues
+		if value.OptionSlugValue != nil {
+			selected = []string{*value.OptionSlugValue}
+		}
+		result = append(result, variantView{
+			AttributeID: ca.AttributeID,
+			Selected:    selected,
+			Options:     toOptionViews(def.Options),
+		})
+	}
+	return result
+}
+
+func toDefinitionView(a *attribute.Attribute) definitionView {
+	return definitionView{
+		ID:          a.ID,
+		Slug:        a.Slug,
+		Name:        a.Name,
+		Type:        string(a.Type),
+		DisplayType: string(a.DisplayType),
+		Unit:        a.Unit,
+		Options:     toOptionViews(a.Options),
+	}
+}
+
+// toOptionViews leaves out deprecated options, which new products can't choose
+func toOptionViews(options []attribute.Option) []optionView {
+	return lo.FilterMap(options, func(o attribute.Option, _ int) (optionView, bool) {
+		return optionView{Slug: o.Slug, Name: o.Name, ColorCode: o.ColorCode, ImageID: o.ImageID}, !o.Deprecated
+	})
+}
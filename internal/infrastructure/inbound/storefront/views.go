@@ -0,0 +1,96 @@
+package storefront
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+// The views hold the fields a shop page shows. They are built field by field, so fields added to the
+// entities stay out of the public API until they are added here.
+
+type productView struct {
+	ID                string            `json:"id"`
+	Slug              string            `json:"slug"`
+	Name              string            `json:"name"`
+	Type              string            `json:"type"`
+	Description       *string           `json:"description,omitempty"`
+	Excerpt           *string           `json:"excerpt,omitempty"`
+	Price             float64           `json:"price"`
+	AvailableQuantity int               `json:"availableQuantity"`
+	ImageID           *string           `json:"imageId,omitempty"`
+	CategoryID        *string           `json:"categoryId,omitempty"`
+	Attributes        []attributeView   `json:"attributes"`
+	Experiments       map[string]string `json:"experiments,omitempty"`
+	ModifiedAt        time.Time         `json:"modifiedAt"`
+}
+
+type attributeView struct {
+	AttributeID      string   `json:"attributeId"`
+	AttributeSlug    string   `json:"attributeSlug"`
+	OptionSlugValue  *string  `json:"optionSlugValue,omitempty"`
+	OptionSlugValues []string `json:"optionSlugValues,omitempty"`
+	NumericValue     *float64 `json:"numericValue,omitempty"`
+	Unit             *string  `json:"unit,omitempty"`
+	TextValue        *string  `json:"textValue,omitempty"`
+	BooleanValue     *bool    `json:"booleanValue,omitempty"`
+}
+
+type categoryView struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	ImageID       *string `json:"imageId,omitempty"`
+	BannerImageID *string `json:"bannerImageId,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Template      string  `json:"template,omitempty"`
+}
+
+type listView[T any] struct {
+	Items []T   `json:"items"`
+	Page  int   `json:"page"`
+	Size  int   `json:"size"`
+	Total int64 `json:"total"`
+}
+
+func toProductView(p *product.Product) productView {
+	return productView{
+		ID:                p.ID,
+		Slug:              p.Slug,
+		Name:              p.Name,
+		Type:              string(p.Type),
+		Description:       p.Description,
+		Excerpt:           p.Excerpt,
+		Price:             p.Price,
+		AvailableQuantity: p.Available(),
+		ImageID:           p.ImageID,
+		CategoryID:        p.CategoryID,
+		Attributes: lo.Map(p.Attributes, func(v product.AttributeValue, _ int) attributeView {
+			return attributeView{
+				AttributeID:      v.AttributeID,
+				AttributeSlug:    v.AttributeSlug,
+				OptionSlugValue:  v.OptionSlugValue,
+				OptionSlugValues: v.OptionSlugValues,
+				NumericValue:     v.NumericValue,
+				Unit:             v.Unit,
+				TextValue:        v.TextValue,
+				BooleanValue:     v.BooleanValue,
+			}
+		}),
+		Experiments: p.Experiments,
+		ModifiedAt:  p.ModifiedAt,
+	}
+}
+
+func toCategoryView(c *category.Category) categoryView {
+	return categoryView{
+		ID:            c.ID,
+		Name:          c.Name,
+		ImageID:       c.Display.ImageID,
+		BannerImageID: c.Display.BannerImageID,
+		Description:   c.Display.Description,
+		Template:      string(c.Display.Template),
+	}
+}
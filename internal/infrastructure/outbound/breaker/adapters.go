@@ -0,0 +1,41 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// Dependency names, used as breaker names in metrics, logs and the configuration
+const (
+	categoryStore = "category-store"
+	mediaService  = "media-service"
+)
+
+// categoryRepository guards the category lookups of the catalog database, which product writes use
+// to check that the assigned category exists. Writes keep going to the database directly.
+type categoryRepository struct {
+	category.Repository
+	breaker *Breaker
+}
+
+func (r *categoryRepository) FindByID(ctx context.Context, id string) (*category.Category, error) {
+	return Execute(ctx, r.breaker, func() (*category.Category, error) {
+		return r.Repository.FindByID(ctx, id)
+	})
+}
+
+// imageCheckerFunc is the method shared by the category and attribute image checkers
+type imageCheckerFunc func(ctx context.Context, imageIDs []string) ([]string, error)
+
+// imageChecker guards the media service calls that verify referenced images
+type imageChecker struct {
+	next    imageCheckerFunc
+	breaker *Breaker
+}
+
+func (c *imageChecker) MissingImages(ctx context.Context, imageIDs []string) ([]string, error) {
+	return Execute(ctx, c.breaker, func() ([]string, error) {
+		return c.next(ctx, imageIDs)
+	})
+}
@@ -0,0 +1,100 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/dependency"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// minRetryAfter is suggested to rejected callers when the breaker is about to let trial calls through
+const minRetryAfter = time.Second
+
+// Breaker cuts off a dependency after FailureThreshold consecutive failures.
+//
+// While open, calls fail fast with a dependency.UnavailableError. After OpenTimeout the breaker lets
+// HalfOpenRequests trial calls through and closes when they succeed.
+type Breaker struct {
+	name     string
+	settings Settings
+	cb       *gobreaker.TwoStepCircuitBreaker[struct{}]
+	metrics  *breakerMetrics
+	logger   *zap.Logger
+	now      func() time.Time
+	// openedAt is the last time the breaker opened, in Unix nanoseconds
+	openedAt atomic.Int64
+}
+
+func newBreaker(name string, settings Settings, metrics *breakerMetrics, logger *zap.Logger, now func() time.Time) *Breaker {
+	b := &Breaker{
+		name:     name,
+		settings: settings,
+		metrics:  metrics,
+		logger:   logger.With(zap.String("dependency", name)),
+		now:      now,
+	}
+	b.cb = gobreaker.NewTwoStepCircuitBreaker[struct{}](gobreaker.Settings{
+		Name:        name,
+		MaxRequests: settings.HalfOpenRequests,
+		Timeout:     settings.OpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= settings.FailureThreshold
+		},
+		OnStateChange: b.onStateChange,
+		IsSuccessful:  isSuccessful,
+		IsExcluded:    isExcluded,
+	})
+	return b
+}
+
+// Execute calls fn unless the breaker of the dependency is open
+func Execute[T any](ctx context.Context, b *Breaker, fn func() (T, error)) (T, error) {
+	done, err := b.cb.Allow()
+	if err != nil {
+		b.metrics.recordRejected(ctx, b.name)
+		var zero T
+		return zero, &dependency.UnavailableError{Dependency: b.name, RetryAfter: b.retryAfter()}
+	}
+
+	v, err := fn()
+	done(err)
+	return v, err
+}
+
+// State returns the current state of the breaker
+func (b *Breaker) State() gobreaker.State {
+	return b.cb.State()
+}
+
+// retryAfter returns the time left until trial calls are let through
+func (b *Breaker) retryAfter() time.Duration {
+	openedAt := time.Unix(0, b.openedAt.Load())
+	return max(b.settings.OpenTimeout-b.now().Sub(openedAt), minRetryAfter)
+}
+
+func (b *Breaker) onStateChange(_ string, from, to gobreaker.State) {
+	b.metrics.recordTransition(b.name, from, to)
+	if to == gobreaker.StateOpen {
+		b.openedAt.Store(b.now().UnixNano())
+		b.logger.Warn("dependency breaker opened", zap.Stringer("from", from), zap.Duration("openTimeout", b.settings.OpenTimeout))
+		return
+	}
+	b.logger.Info("dependency breaker state changed", zap.Stringer("from", from), zap.Stringer("to", to))
+}
+
+// isSuccessful tells whether a call reached a healthy dependency; a missing entity is an answer, not a failure
+func isSuccessful(err error) bool {
+	return err == nil || errors.Is(err, mongo.ErrEntityNotFound)
+}
+
+// isExcluded leaves out calls the caller gave up on, since they say nothing about the dependency.
+// Deadlines still count, so a dependency that stopped answering opens the breaker.
+func isExcluded(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
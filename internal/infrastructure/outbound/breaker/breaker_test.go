@@ -0,0 +1,80 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/dependency"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var errStoreDown = errors.New("no reachable servers")
+
+func newTestBreaker(t *testing.T, settings Settings) *Breaker {
+	t.Helper()
+	r, err := NewRegistry(Config{Default: settings}, noop.NewMeterProvider(), zap.NewNop())
+	require.NoError(t, err)
+	return r.Breaker("test-store")
+}
+
+func call(b *Breaker, err error) error {
+	_, err = Execute(context.Background(), b, func() (struct{}, error) { return struct{}{}, err })
+	return err
+}
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b := newTestBreaker(t, Settings{FailureThreshold: 3, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+
+	for range 3 {
+		require.ErrorIs(t, call(b, errStoreDown), errStoreDown)
+	}
+
+	err := call(b, nil)
+	var unavailable *dependency.UnavailableError
+	require.ErrorAs(t, err, &unavailable)
+	assert.ErrorIs(t, err, dependency.ErrUnavailable)
+	assert.Equal(t, "test-store", unavailable.Dependency)
+	assert.Greater(t, unavailable.RetryAfter, 50*time.Second)
+	assert.Equal(t, gobreaker.StateOpen, b.State())
+}
+
+func TestBreaker_IgnoresAnswersAndCancellations(t *testing.T) {
+	b := newTestBreaker(t, Settings{FailureThreshold: 2, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+
+	for _, err := range []error{mongo.ErrEntityNotFound, context.Canceled, errStoreDown, mongo.ErrEntityNotFound, errStoreDown} {
+		_ = call(b, err) //nolint:errcheck // only the breaker state matters
+	}
+
+	assert.Equal(t, gobreaker.StateClosed, b.State())
+}
+
+func TestBreaker_ClosesAfterSuccessfulTrial(t *testing.T) {
+	b := newTestBreaker(t, Settings{FailureThreshold: 1, OpenTimeout: 20 * time.Millisecond, HalfOpenRequests: 1})
+
+	require.ErrorIs(t, call(b, errStoreDown), errStoreDown)
+	require.ErrorIs(t, call(b, nil), dependency.ErrUnavailable)
+
+	require.Eventually(t, func() bool { return b.State() == gobreaker.StateHalfOpen }, time.Second, 5*time.Millisecond)
+	require.NoError(t, call(b, nil))
+	assert.Equal(t, gobreaker.StateClosed, b.State())
+}
+
+func TestConfig_DependencyOverridesInheritDefault(t *testing.T) {
+	cfg := Config{
+		Default:      Settings{OpenTimeout: 10 * time.Second},
+		Dependencies: map[string]Settings{mediaService: {FailureThreshold: 10}},
+	}
+	cfg.ApplyDefaults()
+	require.NoError(t, cfg.Validate())
+
+	assert.Equal(t, Settings{FailureThreshold: 10, OpenTimeout: 10 * time.Second, HalfOpenRequests: 1}, cfg.settings(mediaService))
+	assert.Equal(t, Settings{FailureThreshold: 5, OpenTimeout: 10 * time.Second, HalfOpenRequests: 1}, cfg.settings(categoryStore))
+}
@@ -0,0 +1,77 @@
+package breaker
+
+import (
+	"fmt"
+	"time"
+)
+
+const maxOpenTimeout = 10 * time.Minute
+
+// Config holds the circuit breaker settings.
+//
+// Default applies to every dependency; Dependencies overrides it by dependency name, such as
+// "category-store" or "media-service". Unset fields of an override are taken from Default.
+type Config struct {
+	Default      Settings            `koanf:"default"`
+	Dependencies map[string]Settings `koanf:"dependencies"`
+}
+
+// Settings configures the breaker of one dependency
+type Settings struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker. Default: 5
+	FailureThreshold uint32 `koanf:"failure-threshold"`
+	// OpenTimeout is how long the breaker rejects calls before letting trial calls through. Default: 30s
+	OpenTimeout time.Duration `koanf:"open-timeout"`
+	// HalfOpenRequests is the number of trial calls let through after OpenTimeout.
+	// The breaker closes once they all succeed and opens again on the first failure. Default: 1
+	HalfOpenRequests uint32 `koanf:"half-open-requests"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	c.Default.applyDefaults(Settings{FailureThreshold: 5, OpenTimeout: 30 * time.Second, HalfOpenRequests: 1})
+	for name, s := range c.Dependencies {
+		s.applyDefaults(c.Default)
+		c.Dependencies[name] = s
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	for name, s := range c.Dependencies {
+		if err := s.validate(); err != nil {
+			return fmt.Errorf("dependencies.%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// settings returns the settings of a dependency
+func (c *Config) settings(name string) Settings {
+	if s, ok := c.Dependencies[name]; ok {
+		return s
+	}
+	return c.Default
+}
+
+func (s *Settings) applyDefaults(defaults Settings) {
+	if s.FailureThreshold == 0 {
+		s.FailureThreshold = defaults.FailureThreshold
+	}
+	if s.OpenTimeout <= 0 {
+		s.OpenTimeout = defaults.OpenTimeout
+	}
+	if s.HalfOpenRequests == 0 {
+		s.HalfOpenRequests = defaults.HalfOpenRequests
+	}
+}
+
+func (s *Settings) validate() error {
+	if s.OpenTimeout < time.Second || s.OpenTimeout > maxOpenTimeout {
+		return fmt.Errorf("open-timeout must be between 1s and %s", maxOpenTimeout)
+	}
+	return nil
+}
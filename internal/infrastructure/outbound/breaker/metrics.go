@@ -0,0 +1,58 @@
+package breaker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sony/gobreaker/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/Sokol111/ecommerce-catalog-service/breaker"
+
+type breakerMetrics struct {
+	rejected    metric.Int64Counter
+	transitions metric.Int64Counter
+}
+
+// newBreakerMetrics creates the breaker instruments; states reports the current state of every breaker
+func newBreakerMetrics(mp metric.MeterProvider, states func() map[string]gobreaker.State) (*breakerMetrics, error) {
+	meter := mp.Meter(meterName)
+
+	rejected, err := meter.Int64Counter("dependency.breaker.rejected",
+		metric.WithDescription("Calls rejected without reaching the dependency because its breaker is open"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rejected counter: %w", err)
+	}
+	transitions, err := meter.Int64Counter("dependency.breaker.transitions",
+		metric.WithDescription("Breaker state changes"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transitions counter: %w", err)
+	}
+
+	_, err = meter.Int64ObservableGauge("dependency.breaker.state",
+		metric.WithDescription("Breaker state per dependency: 0 closed, 1 half-open, 2 open"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			for name, state := range states() {
+				o.Observe(int64(state), metric.WithAttributes(attribute.String("dependency", name)))
+			}
+			return nil
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create state gauge: %w", err)
+	}
+
+	return &breakerMetrics{rejected: rejected, transitions: transitions}, nil
+}
+
+func (m *breakerMetrics) recordRejected(ctx context.Context, name string) {
+	m.rejected.Add(ctx, 1, metric.WithAttributes(attribute.String("dependency", name)))
+}
+
+func (m *breakerMetrics) recordTransition(name string, from, to gobreaker.State) {
+	m.transitions.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("dependency", name),
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String())))
+}
@@ -0,0 +1,42 @@
+package breaker
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module decorates the adapters of external dependencies with circuit breakers.
+// New clients take the Registry and wrap their calls in Execute with a breaker of their own.
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			NewRegistry,
+		),
+		fx.Decorate(
+			decorateCategoryRepository,
+			decorateCategoryImageChecker,
+			decorateAttributeImageChecker,
+		),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "circuit-breakers", nil)
+}
+
+func decorateCategoryRepository(next category.Repository, r *Registry) category.Repository {
+	return &categoryRepository{Repository: next, breaker: r.Breaker(categoryStore)}
+}
+
+func decorateCategoryImageChecker(next category.ImageChecker, r *Registry) category.ImageChecker {
+	return &imageChecker{next: next.MissingImages, breaker: r.Breaker(mediaService)}
+}
+
+func decorateAttributeImageChecker(next attribute.ImageChecker, r *Registry) attribute.ImageChecker {
+	return &imageChecker{next: next.MissingImages, breaker: r.Breaker(mediaService)}
+}
@@ -0,0 +1,59 @@
+package breaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// Registry holds one breaker per dependency, so the adapters of a dependency share its state
+type Registry struct {
+	cfg     Config
+	metrics *breakerMetrics
+	logger  *zap.Logger
+
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+}
+
+func NewRegistry(cfg Config, mp metric.MeterProvider, logger *zap.Logger) (*Registry, error) {
+	r := &Registry{
+		cfg:      cfg,
+		logger:   logger.With(zap.String("component", "dependency-breaker")),
+		breakers: make(map[string]*Breaker),
+	}
+
+	metrics, err := newBreakerMetrics(mp, r.states)
+	if err != nil {
+		return nil, err
+	}
+	r.metrics = metrics
+	return r, nil
+}
+
+// Breaker returns the breaker of a dependency, creating it on first use
+func (r *Registry) Breaker(name string) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.breakers[name]
+	if !ok {
+		b = newBreaker(name, r.cfg.settings(name), r.metrics, r.logger, time.Now)
+		r.breakers[name] = b
+	}
+	return b
+}
+
+func (r *Registry) states() map[string]gobreaker.State {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	states := make(map[string]gobreaker.State, len(r.breakers))
+	for name, b := range r.breakers {
+		states[name] = b.State()
+	}
+	return states
+}
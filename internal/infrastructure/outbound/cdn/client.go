@@ -0,0 +1,82 @@
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client purges the cached responses tagged with any of the surrogate keys
+type Client interface {
+	PurgeKeys(ctx context.Context, keys []string) error
+}
+
+// newClient returns the client of the configured provider, or nil when there is none
+func newClient(cfg Config, httpClient *http.Client) Client {
+	endpoint := strings.TrimRight(cfg.Endpoint, "/")
+	switch cfg.Provider {
+	case ProviderFastly:
+		return &fastlyClient{client: httpClient, url: endpoint + "/service/" + url.PathEscape(cfg.ServiceID) + "/purge", token: cfg.Token}
+	case ProviderCloudflare:
+		return &cloudflareClient{client: httpClient, url: endpoint + "/zones/" + url.PathEscape(cfg.ZoneID) + "/purge_cache", token: cfg.Token}
+	default:
+		return nil
+	}
+}
+
+// fastlyClient purges with POST /service/{id}/purge, listing the keys in the Surrogate-Key header
+type fastlyClient struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (c *fastlyClient) PurgeKeys(ctx context.Context, keys []string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to build purge request: %w", err)
+	}
+	req.Header.Set("Fastly-Key", c.token)
+	req.Header.Set("Surrogate-Key", strings.Join(keys, " "))
+	return do(c.client, req)
+}
+
+// cloudflareClient purges with POST /zones/{id}/purge_cache, listing the keys as cache tags
+type cloudflareClient struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (c *cloudflareClient) PurgeKeys(ctx context.Context, keys []string) error {
+	body, err := json.Marshal(map[string][]string{"tags": keys})
+	if err != nil {
+		return fmt.Errorf("failed to encode purge request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build purge request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	return do(c.client, req)
+}
+
+func do(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to purge: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only body
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to purge: CDN answered %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFastlyClient(t *testing.T) {
+	srv := newTestServer(t, func(_ http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/svc-1/purge", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("Fastly-Key"))
+		assert.Equal(t, "acme:product/1 acme:product-list", r.Header.Get("Surrogate-Key"))
+	})
+	client := newClient(Config{Provider: ProviderFastly, Endpoint: srv.URL + "/", ServiceID: "svc-1", Token: "secret"}, srv.Client())
+
+	require.NoError(t, client.PurgeKeys(context.Background(), []string{"acme:product/1", "acme:product-list"}))
+}
+
+func TestCloudflareClient(t *testing.T) {
+	srv := newTestServer(t, func(_ http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/zone-1/purge_cache", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"acme:attribute/7"}, body.Tags)
+	})
+	client := newClient(Config{Provider: ProviderCloudflare, Endpoint: srv.URL, ZoneID: "zone-1", Token: "secret"}, srv.Client())
+
+	require.NoError(t, client.PurgeKeys(context.Background(), []string{"acme:attribute/7"}))
+}
+
+func TestClient_UnexpectedStatus(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	client := newClient(Config{Provider: ProviderFastly, Endpoint: srv.URL, ServiceID: "svc-1", Token: "secret"}, srv.Client())
+
+	err := client.PurgeKeys(context.Background(), []string{"acme:product/1"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{name: "disabled"},
+		{name: "fastly", cfg: Config{Provider: ProviderFastly, ServiceID: "svc-1", Token: "secret"}},
+		{name: "cloudflare without zone", cfg: Config{Provider: ProviderCloudflare, Token: "secret"}, wantErr: "zone-id"},
+		{name: "without token", cfg: Config{Provider: ProviderFastly, ServiceID: "svc-1"}, wantErr: "token"},
+		{name: "unknown provider", cfg: Config{Provider: "akamai"}, wantErr: "unknown provider"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.ApplyDefaults()
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
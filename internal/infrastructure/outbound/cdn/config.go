@@ -0,0 +1,88 @@
+package cdn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Providers whose purge API is supported
+const (
+	ProviderFastly     = "fastly"
+	ProviderCloudflare = "cloudflare"
+)
+
+// Config selects the CDN whose cached catalog responses are purged on writes.
+//
+// Purges are collected for FlushInterval and sent together, which merges the keys of bulk writes and sends
+// them once the transaction that wrote the entities has committed.
+type Config struct {
+	// Provider is fastly or cloudflare. Default: none, nothing is purged
+	Provider string `koanf:"provider"`
+	// Endpoint is the base URL of the purge API. Default: the public API of the provider
+	Endpoint string `koanf:"endpoint"`
+	// ServiceID is the Fastly service caching the catalog
+	ServiceID string `koanf:"service-id"`
+	// ZoneID is the Cloudflare zone caching the catalog
+	ZoneID string `koanf:"zone-id"`
+	// Token authenticates the purge requests
+	Token string `koanf:"token"`
+	// FlushInterval is how long keys are collected before they are purged. Default: 1s
+	FlushInterval time.Duration `koanf:"flush-interval"`
+	// Timeout bounds a purge request. Default: 5s
+	Timeout time.Duration `koanf:"timeout"`
+	// MaxKeys is the number of keys purged by one request. Default: 256 for Fastly, 30 for Cloudflare
+	MaxKeys int `koanf:"max-keys"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.FlushInterval <= 0 {
+		c.FlushInterval = time.Second
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	switch c.Provider {
+	case ProviderFastly:
+		if c.Endpoint == "" {
+			c.Endpoint = "https://api.fastly.com"
+		}
+		if c.MaxKeys <= 0 {
+			c.MaxKeys = 256
+		}
+	case ProviderCloudflare:
+		if c.Endpoint == "" {
+			c.Endpoint = "https://api.cloudflare.com/client/v4"
+		}
+		if c.MaxKeys <= 0 {
+			c.MaxKeys = 30
+		}
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	switch c.Provider {
+	case "":
+		return nil
+	case ProviderFastly:
+		if c.ServiceID == "" {
+			return errors.New("service-id is required for fastly")
+		}
+	case ProviderCloudflare:
+		if c.ZoneID == "" {
+			return errors.New("zone-id is required for cloudflare")
+		}
+	default:
+		return fmt.Errorf("unknown provider %q", c.Provider)
+	}
+	if c.Token == "" {
+		return errors.New("token is required")
+	}
+	if u, err := url.Parse(c.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q", c.Endpoint)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package cdn
+
+import (
+	"net/http"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module purges the cached catalog responses from the configured CDN when entities are written
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			provideClient,
+			newPurger,
+		),
+		fx.Decorate(decoratePurger),
+		fx.Invoke(worker.RunWorker[*Purger]("cdn-purger")),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "cdn", nil)
+}
+
+func provideClient(cfg Config) Client {
+	return newClient(cfg, &http.Client{})
+}
+
+// decoratePurger replaces the default purger when a CDN is configured
+func decoratePurger(next edgecache.Purger, cfg Config, purger *Purger, logger *zap.Logger) edgecache.Purger {
+	if cfg.Provider == "" {
+		return next
+	}
+	logger.Info("purging CDN cache on writes", zap.String("provider", cfg.Provider))
+	return purger
+}
@@ -0,0 +1,95 @@
+package cdn
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxPendingKeys bounds the keys waiting for a flush; keys beyond it are dropped and their responses
+// stay cached until their max-age runs out
+const maxPendingKeys = 10000
+
+// Purger collects the surrogate keys of written entities and purges them from the CDN every flush interval
+type Purger struct {
+	client   Client
+	interval time.Duration
+	timeout  time.Duration
+	maxKeys  int
+	logger   *zap.Logger
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+}
+
+func newPurger(cfg Config, client Client, logger *zap.Logger) *Purger {
+	return &Purger{
+		client:   client,
+		interval: cfg.FlushInterval,
+		timeout:  cfg.Timeout,
+		maxKeys:  cfg.MaxKeys,
+		logger:   logger.With(zap.String("component", "cdn-purger")),
+		pending:  make(map[string]struct{}),
+	}
+}
+
+// Purge queues the keys for the next flush. It never blocks the caller.
+func (p *Purger) Purge(_ context.Context, keys []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, key := range keys {
+		if len(p.pending) >= maxPendingKeys {
+			p.logger.Warn("too many keys waiting for a purge, dropping key", zap.String("key", key))
+			continue
+		}
+		p.pending[key] = struct{}{}
+	}
+}
+
+// Run flushes the queued keys every flush interval until ctx is canceled, then flushes the last ones.
+// It returns at once when no CDN is configured.
+func (p *Purger) Run(ctx context.Context) error {
+	if p.client == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			p.flush(context.WithoutCancel(ctx))
+			return nil
+		case <-ticker.C:
+			p.flush(ctx)
+		}
+	}
+}
+
+// flush purges the queued keys, MaxKeys at a time. Keys of a failed request are not retried:
+// their responses expire with their max-age.
+func (p *Purger) flush(ctx context.Context) {
+	p.mu.Lock()
+	keys := make([]string, 0, len(p.pending))
+	for key := range p.pending {
+		keys = append(keys, key)
+	}
+	clear(p.pending)
+	p.mu.Unlock()
+
+	slices.Sort(keys)
+	for chunk := range slices.Chunk(keys, p.maxKeys) {
+		reqCtx, cancel := context.WithTimeout(ctx, p.timeout)
+		err := p.client.PurgeKeys(reqCtx, chunk)
+		cancel()
+		if err != nil {
+			p.logger.Warn("failed to purge CDN cache", zap.Strings("keys", chunk), zap.Error(err))
+			continue
+		}
+		p.logger.Debug("CDN cache purged", zap.Int("keys", len(chunk)))
+	}
+}
@@ -0,0 +1,83 @@
+package cdn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeClient struct {
+	mu    sync.Mutex
+	calls [][]string
+	err   error
+}
+
+func (c *fakeClient) PurgeKeys(_ context.Context, keys []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, keys)
+	return c.err
+}
+
+func (c *fakeClient) purged() [][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls
+}
+
+func newTestPurger(client Client, maxKeys int) *Purger {
+	return newPurger(Config{FlushInterval: 10 * time.Millisecond, Timeout: time.Second, MaxKeys: maxKeys}, client, zap.NewNop())
+}
+
+func TestPurger_MergesKeysUntilFlush(t *testing.T) {
+	client := &fakeClient{}
+	p := newTestPurger(client, 2)
+
+	p.Purge(context.Background(), []string{"product/1", "product-list"})
+	p.Purge(context.Background(), []string{"product/2", "product-list"})
+	p.flush(context.Background())
+	p.flush(context.Background())
+
+	assert.Equal(t, [][]string{{"product-list", "product/1"}, {"product/2"}}, client.purged())
+}
+
+func TestPurger_FailedPurgeIsNotRetried(t *testing.T) {
+	client := &fakeClient{err: errors.New("unavailable")}
+	p := newTestPurger(client, 10)
+
+	p.Purge(context.Background(), []string{"product/1"})
+	p.flush(context.Background())
+	p.flush(context.Background())
+
+	assert.Len(t, client.purged(), 1)
+}
+
+func TestPurger_Run(t *testing.T) {
+	client := &fakeClient{}
+	p := newTestPurger(client, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Run(ctx) }()
+
+	p.Purge(ctx, []string{"product/1"})
+	require.Eventually(t, func() bool { return len(client.purged()) == 1 }, time.Second, 5*time.Millisecond)
+
+	p.Purge(ctx, []string{"product/2"})
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, []string{"product/2"}, client.purged()[len(client.purged())-1], "pending keys are flushed on shutdown")
+}
+
+func TestPurger_RunWithoutProvider(t *testing.T) {
+	p := newTestPurger(nil, 10)
+
+	assert.NoError(t, p.Run(context.Background()))
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 
 	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
-	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type attributeEventFactory struct{}
+type attributeEventFactory struct {
+	router *topicRouter
+}
 
 // newAttributeEventFactory creates a new AttributeEventFactory
-func newAttributeEventFactory() attribute.AttributeEventFactory {
-	return &attributeEventFactory{}
+func newAttributeEventFactory(router *topicRouter) attribute.AttributeEventFactory {
+	return &attributeEventFactory{router: router}
 }
 
 func toAttributeType(t attribute.AttributeType) eventsv1.AttributeType {
@@ -42,29 +44,68 @@ func toEventOptions(options []attribute.Option) []*eventsv1.AttributeOption {
 			Name:      opt.Name,
 			ColorCode: opt.ColorCode,
 			SortOrder: int32(opt.SortOrder),
+			ImageId:   opt.ImageID,
 		}
 	})
 }
 
-func (f *attributeEventFactory) newAttributeUpdatedEvent(a *attribute.Attribute) *eventsv1.AttributeUpdatedEvent {
+func (f *attributeEventFactory) newAttributeUpdatedEvent(a *attribute.Attribute, delta attribute.OptionsDelta) *eventsv1.AttributeUpdatedEvent {
 	return &eventsv1.AttributeUpdatedEvent{
-		AttributeId: a.ID,
-		Slug:        a.Slug,
-		Name:        a.Name,
-		Type:        toAttributeType(a.Type),
-		Unit:        a.Unit,
-		Enabled:     a.Enabled,
-		Version:     int64(a.Version),
-		ModifiedAt:  timestamppb.New(a.ModifiedAt),
-		Options:     toEventOptions(a.Options),
+		AttributeId:    a.ID,
+		Slug:           a.Slug,
+		Name:           a.Name,
+		Type:           toAttributeType(a.Type),
+		Unit:           a.Unit,
+		Enabled:        a.Enabled,
+		Version:        eventVersion(a.Version),
+		ModifiedAt:     timestamppb.New(a.ModifiedAt),
+		Options:        toEventOptions(a.Options),
+		OptionsChanged: toOptionsChanged(delta),
+		DisplayType:    toAttributeFilterControl(a.DisplayType),
+		InputUnits:     toEventInputUnits(a.InputUnits),
 	}
 }
 
-func (f *attributeEventFactory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *attribute.Attribute) outbox.Message {
-	event := f.newAttributeUpdatedEvent(a)
-	return outbox.Message{
-		Event: event,
-		Key:   a.ID,
-		Topic: apiEvents.TopicFor(event),
+func toEventInputUnits(units []attribute.UnitConversion) []*eventsv1.AttributeInputUnit {
+	return lo.Map(units, func(c attribute.UnitConversion, _ int) *eventsv1.AttributeInputUnit {
+		return &eventsv1.AttributeInputUnit{Unit: c.Unit, Factor: c.Factor}
+	})
+}
+
+func (f *attributeEventFactory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *attribute.Attribute, delta attribute.OptionsDelta) outbox.Message {
+	return f.router.newOutboxMessage(ctx, f.newAttributeUpdatedEvent(a, delta), catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateAttribute,
+		AggregateID:   a.ID,
+		Version:       int64(a.Version),
+	})
+}
+
+func toAttributeFilterControl(t attribute.DisplayType) eventsv1.AttributeFilterControl {
+	switch t {
+	case attribute.DisplayTypeSwatch:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_SWATCH
+	case attribute.DisplayTypeDropdown:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_DROPDOWN
+	case attribute.DisplayTypeCheckbox:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_CHECKBOX
+	case attribute.DisplayTypeSlider:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_SLIDER
+	case attribute.DisplayTypeText:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_TEXT
+	default:
+		return eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_UNSPECIFIED
+	}
+}
+
+func toOptionsChanged(delta attribute.OptionsDelta) *eventsv1.AttributeOptionsChanged {
+	if delta.IsEmpty() {
+		return nil
+	}
+	return &eventsv1.AttributeOptionsChanged{
+		Added:   toEventOptions(delta.Added),
+		Removed: toEventOptions(delta.Removed),
+		Renamed: lo.Map(delta.Renamed, func(r attribute.OptionRename, _ int) *eventsv1.AttributeOptionRename {
+			return &eventsv1.AttributeOptionRename{Slug: r.Slug, OldName: r.OldName, NewName: r.NewName}
+		}),
 	}
 }
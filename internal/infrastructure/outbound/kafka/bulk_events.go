@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// BulkEventsConfig picks the events stored by each bulk operation, by operation name such as import-products.
+// Operations that aren't listed store the update event of every product, like single writes.
+type BulkEventsConfig struct {
+	Operations map[string]BulkOperationEvents `koanf:"operations"`
+}
+
+// BulkOperationEvents configures the events of one bulk operation, see product.BulkEvents
+type BulkOperationEvents struct {
+	PerProduct bool `koanf:"per-product"`
+	Summary    bool `koanf:"summary"`
+	Batched    bool `koanf:"batched"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *BulkEventsConfig) ApplyDefaults() {}
+
+// Validate validates the configuration
+func (c *BulkEventsConfig) Validate() error {
+	for name, events := range c.Operations {
+		if !slices.Contains(product.BulkOperations(), product.BulkOperation(name)) {
+			return fmt.Errorf("operations.%s: unknown bulk operation", name)
+		}
+		if !events.PerProduct && !events.Summary {
+			return fmt.Errorf("operations.%s: per-product or summary events must be enabled", name)
+		}
+	}
+	return nil
+}
+
+// Events implements product.BulkEventPolicy
+func (c BulkEventsConfig) Events(op product.BulkOperation) product.BulkEvents {
+	events, ok := c.Operations[string(op)]
+	if !ok {
+		return product.DefaultBulkEvents
+	}
+	return product.BulkEvents(events)
+}
+
+func provideBulkEventsConfig(k *koanf.Koanf) (BulkEventsConfig, error) {
+	return coreconfig.Load[BulkEventsConfig](k, "bulk-events", nil)
+}
+
+func provideBulkEventPolicy(cfg BulkEventsConfig) product.BulkEventPolicy {
+	return cfg
+}
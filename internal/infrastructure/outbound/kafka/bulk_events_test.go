@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestBulkEventsConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     BulkEventsConfig
+		wantErr string
+	}{
+		{name: "defaults"},
+		{name: "batched summary", cfg: BulkEventsConfig{Operations: map[string]BulkOperationEvents{
+			"import-products": {Summary: true, Batched: true},
+		}}},
+		{name: "unknown operation", cfg: BulkEventsConfig{Operations: map[string]BulkOperationEvents{
+			"delete-products": {Summary: true},
+		}}, wantErr: "unknown bulk operation"},
+		{name: "no events", cfg: BulkEventsConfig{Operations: map[string]BulkOperationEvents{
+			"merge-duplicate-attributes": {Batched: true},
+		}}, wantErr: "per-product or summary events must be enabled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestBulkEventsConfig_Events(t *testing.T) {
+	cfg := BulkEventsConfig{Operations: map[string]BulkOperationEvents{
+		"import-products": {Summary: true, Batched: true},
+	}}
+
+	assert.Equal(t, product.BulkEvents{Summary: true, Batched: true}, cfg.Events(product.BulkImportProducts))
+	assert.Equal(t, product.DefaultBulkEvents, cfg.Events(product.BulkMergeDuplicateAttributes))
+}
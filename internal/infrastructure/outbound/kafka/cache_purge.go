@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/aftercommit"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// Every write of a product, category or attribute builds its event in its transaction, so the event factories
+// are decorated to purge the CDN responses of the written entity, the lists it may appear in and, for products,
+// the responses computed from the products of their category and of the category they left.
+// The purges run once the transaction commits, see aftercommit.
+// Price changes come with an update event, which purges the product.
+
+type purgingProductFactory struct {
+	product.ProductEventFactory
+	purger edgecache.Purger
+}
+
+func (f *purgingProductFactory) NewProductUpdatedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
+	f.purge(ctx, p)
+	return f.ProductEventFactory.NewProductUpdatedOutboxMessage(ctx, p)
+}
+
+func (f *purgingProductFactory) NewProductDeletedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
+	f.purge(ctx, p)
+	return f.ProductEventFactory.NewProductDeletedOutboxMessage(ctx, p)
+}
+
+func (f *purgingProductFactory) NewBulkProductsImportedOutboxMessage(ctx context.Context, op product.BulkOperation, products []*product.Product) outbox.Message {
+	f.purge(ctx, products...)
+	return f.ProductEventFactory.NewBulkProductsImportedOutboxMessage(ctx, op, products)
+}
+
+func (f *purgingProductFactory) purge(ctx context.Context, products ...*product.Product) {
+	keys := []string{edgecache.ListKey(ctx, edgecache.Product)}
+	for _, p := range products {
+		keys = append(keys, edgecache.EntityKey(ctx, edgecache.Product, p.ID))
+		if p.CategoryID != nil {
+			keys = append(keys, edgecache.CategoryProductsKey(ctx, *p.CategoryID))
+		}
+		if previous := p.PreviousCategoryID(); previous != nil {
+			keys = append(keys, edgecache.CategoryProductsKey(ctx, *previous))
+		}
+	}
+	aftercommit.Run(ctx, func(ctx context.Context) { f.purger.Purge(ctx, keys) })
+}
+
+type purgingCategoryFactory struct {
+	category.CategoryEventFactory
+	purger     edgecache.Purger
+	navigation *product.NavigationCache
+}
+
+// decorateCategoryEventFactory purges the CDN and drops the cached navigation menu on every category event
+func decorateCategoryEventFactory(next category.CategoryEventFactory, purger edgecache.Purger, navigation *product.NavigationCache) category.CategoryEventFactory {
+	return &purgingCategoryFactory{CategoryEventFactory: next, purger: purger, navigation: navigation}
+}
+
+func (f *purgingCategoryFactory) NewCategoryUpdatedOutboxMessage(ctx context.Context, c *category.Category, attrs []*attribute.Attribute) outbox.Message {
+	slug, _ := tenant.SlugFromContext(ctx)
+	keys := []string{edgecache.EntityKey(ctx, edgecache.Category, c.ID), edgecache.ListKey(ctx, edgecache.Category)}
+	aftercommit.Run(ctx, func(ctx context.Context) {
+		f.navigation.Invalidate(slug)
+		f.purger.Purge(ctx, keys)
+	})
+	return f.CategoryEventFactory.NewCategoryUpdatedOutboxMessage(ctx, c, attrs)
+}
+
+type purgingAttributeFactory struct {
+	attribute.AttributeEventFactory
+	purger edgecache.Purger
+}
+
+func decorateAttributeEventFactory(next attribute.AttributeEventFactory, purger edgecache.Purger) attribute.AttributeEventFactory {
+	return &purgingAttributeFactory{AttributeEventFactory: next, purger: purger}
+}
+
+func (f *purgingAttributeFactory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *attribute.Attribute, delta attribute.OptionsDelta) outbox.Message {
+	keys := []string{edgecache.EntityKey(ctx, edgecache.Attribute, a.ID), edgecache.ListKey(ctx, edgecache.Attribute)}
+	aftercommit.Run(ctx, func(ctx context.Context) { f.purger.Purge(ctx, keys) })
+	return f.AttributeEventFactory.NewAttributeUpdatedOutboxMessage(ctx, a, delta)
+}
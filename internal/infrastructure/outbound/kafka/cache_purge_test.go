@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/aftercommit"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+type passThroughTxManager struct{}
+
+func (passThroughTxManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	return fn(ctx)
+}
+
+type recordingPurger struct {
+	keys [][]string
+}
+
+func (p *recordingPurger) Purge(_ context.Context, keys []string) {
+	p.keys = append(p.keys, keys)
+}
+
+func TestPurgingFactories(t *testing.T) {
+	ctx := tenant.ContextWithSlug(context.Background(), "acme")
+	router := newTopicRouter(RoutingConfig{})
+	purger := &recordingPurger{}
+	now := time.Now().UTC()
+
+	products := decorateProductEventFactory(newProductEventFactory(router), product.NewFacetCache(), purger)
+	p := product.Reconstruct("product-1", 2, "Phone", "", nil, product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil, nil, nil, nil, now, now)
+	p.CategoryID = lo.ToPtr("category-1")
+	categories := decorateCategoryEventFactory(newCategoryEventFactory(router), purger, product.NewNavigationCache())
+	tm := aftercommit.Decorate(passThroughTxManager{})
+
+	_, err := tm.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		products.NewProductUpdatedOutboxMessage(txCtx, p)
+		products.NewProductPriceChangedOutboxMessage(txCtx, p, 12)
+		categories.NewCategoryUpdatedOutboxMessage(txCtx, category.Reconstruct("category-1", 1, "Phones", true, nil, category.Display{}, now, now), nil)
+		assert.Empty(t, purger.keys, "purges wait for the commit")
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"acme:product-list", "acme:product/product-1", "acme:category/category-1/products"},
+		{"acme:category/category-1", "acme:category-list"},
+	}, purger.keys, "price changes come with an update event")
+
+	purger.keys = nil
+	_, err = tm.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		products.NewProductDeletedOutboxMessage(txCtx, p)
+		return nil, errors.New("write failed")
+	})
+	require.Error(t, err)
+	assert.Empty(t, purger.keys, "a rolled back write purges nothing")
+}
+
+func TestPurgingFactories_MovedProduct(t *testing.T) {
+	ctx := tenant.ContextWithSlug(context.Background(), "acme")
+	purger := &recordingPurger{}
+	now := time.Now().UTC()
+
+	products := decorateProductEventFactory(newProductEventFactory(newTopicRouter(RoutingConfig{})), product.NewFacetCache(), purger)
+	p := product.Reconstruct("product-1", 2, "Phone", "", nil, product.ProductTypePhysical, nil, 10, 1, nil, lo.ToPtr("category-1"), false, nil, nil, nil, nil, now, now)
+	require.NoError(t, p.Update(limits.Default().Product, "Phone", "", nil, 10, 1, nil, lo.ToPtr("category-2"), false, nil))
+	products.NewProductUpdatedOutboxMessage(ctx, p)
+
+	assert.Equal(t, [][]string{
+		{"acme:product-list", "acme:product/product-1", "acme:category/category-2/products", "acme:category/category-1/products"},
+	}, purger.keys, "the category the product left is purged as well")
+}
@@ -4,18 +4,21 @@ import (
 	"context"
 
 	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
-	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type categoryEventFactory struct{}
+type categoryEventFactory struct {
+	router *topicRouter
+}
 
 // newCategoryEventFactory creates a new CategoryEventFactory
-func newCategoryEventFactory() category.CategoryEventFactory {
-	return &categoryEventFactory{}
+func newCategoryEventFactory(router *topicRouter) category.CategoryEventFactory {
+	return &categoryEventFactory{router: router}
 }
 
 func toCategoryAttributeRole(r category.AttributeRole) eventsv1.CategoryAttributeRole {
@@ -24,43 +27,86 @@ func toCategoryAttributeRole(r category.AttributeRole) eventsv1.CategoryAttribut
 		return eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_VARIANT
 	case category.AttributeRoleSpecification:
 		return eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_SPECIFICATION
+	case category.AttributeRoleDescription:
+		return eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_DESCRIPTION
+	case category.AttributeRoleFilterOnly:
+		return eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_FILTER_ONLY
 	default:
 		return eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_UNSPECIFIED
 	}
 }
 
-// toCategoryEventAttributes converts category attributes to event attributes
-// Only immutable references and category-specific settings are included
-func toCategoryEventAttributes(categoryAttrs []category.CategoryAttribute) []*eventsv1.CategoryAttribute {
+// toCategoryEventAttributes converts category attributes to event attributes, taking the slug, name
+// and type from the attached attributes. An attribute that is missing keeps its stored slug and is
+// published without name and type; options are published with AttributeUpdated events.
+func toCategoryEventAttributes(categoryAttrs []category.CategoryAttribute, attrs []*attribute.Attribute) []*eventsv1.CategoryAttribute {
+	attrMap := lo.KeyBy(attrs, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
 	return lo.Map(categoryAttrs, func(catAttr category.CategoryAttribute, _ int) *eventsv1.CategoryAttribute {
-		return &eventsv1.CategoryAttribute{
+		eventAttr := &eventsv1.CategoryAttribute{
 			AttributeId:   catAttr.AttributeID,
 			AttributeSlug: catAttr.Slug,
 			Role:          toCategoryAttributeRole(catAttr.Role),
 			SortOrder:     int32(catAttr.SortOrder),
 			Filterable:    catAttr.Filterable,
 			Searchable:    catAttr.Searchable,
+			Required:      catAttr.Required,
 		}
+		if a, ok := attrMap[catAttr.AttributeID]; ok {
+			eventAttr.AttributeSlug = a.Slug
+			eventAttr.AttributeName = a.Name
+			eventAttr.AttributeType = toAttributeType(a.Type)
+		}
+		return eventAttr
 	})
 }
 
-func (f *categoryEventFactory) newCategoryUpdatedEvent(c *category.Category) *eventsv1.CategoryUpdatedEvent {
+func (f *categoryEventFactory) newCategoryUpdatedEvent(c *category.Category, attrs []*attribute.Attribute) *eventsv1.CategoryUpdatedEvent {
 	return &eventsv1.CategoryUpdatedEvent{
 		CategoryId: c.ID,
 		Name:       c.Name,
 		Enabled:    c.Enabled,
-		Attributes: toCategoryEventAttributes(c.Attributes),
-		Version:    int64(c.Version),
+		Attributes: toCategoryEventAttributes(c.Attributes, attrs),
+		Version:    eventVersion(c.Version),
 		CreatedAt:  timestamppb.New(c.CreatedAt),
 		ModifiedAt: timestamppb.New(c.ModifiedAt),
+		Display:    toCategoryPresentation(c.Display),
+	}
+}
+
+func (f *categoryEventFactory) NewCategoryUpdatedOutboxMessage(ctx context.Context, c *category.Category, attrs []*attribute.Attribute) outbox.Message {
+	return f.router.newOutboxMessage(ctx, f.newCategoryUpdatedEvent(c, attrs), catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateCategory,
+		AggregateID:   c.ID,
+		Version:       int64(c.Version),
+	})
+}
+
+func toCategoryPresentation(d category.Display) *eventsv1.CategoryPresentation {
+	if d.IsEmpty() {
+		return nil
+	}
+	return &eventsv1.CategoryPresentation{
+		ImageId:       d.ImageID,
+		BannerImageId: d.BannerImageID,
+		Description:   d.Description,
+		Template:      toCategoryPageTemplate(d.Template),
 	}
 }
 
-func (f *categoryEventFactory) NewCategoryUpdatedOutboxMessage(ctx context.Context, c *category.Category) outbox.Message {
-	event := f.newCategoryUpdatedEvent(c)
-	return outbox.Message{
-		Event: event,
-		Key:   c.ID,
-		Topic: apiEvents.TopicFor(event),
+func toCategoryPageTemplate(t category.DisplayTemplate) eventsv1.CategoryPageTemplate {
+	switch t {
+	case category.DisplayTemplateDefault:
+		return eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_DEFAULT
+	case category.DisplayTemplateGrid:
+		return eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_GRID
+	case category.DisplayTemplateList:
+		return eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_LIST
+	case category.DisplayTemplateLanding:
+		return eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_LANDING
+	default:
+		return eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_UNSPECIFIED
 	}
 }
@@ -0,0 +1,240 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+func TestProductEventFactory_Metadata(t *testing.T) {
+	f := newProductEventFactory(newTopicRouter(RoutingConfig{}))
+	now := time.Now().UTC()
+	p := product.Reconstruct("product-1", 4, "Phone", "", nil, product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil, nil, nil, nil, now, now)
+
+	msg := f.NewProductUpdatedOutboxMessage(context.Background(), p)
+
+	assert.Equal(t, "product-1", msg.Key)
+	assert.Equal(t, apiEvents.TopicCatalogProductEvents, msg.Topic)
+	assert.EqualValues(t, 4, msg.Event.(*eventsv1.ProductUpdatedEvent).GetVersion())
+	assert.Nil(t, msg.Event.(*eventsv1.ProductUpdatedEvent).GetFirstPublishedAt())
+
+	p.FirstPublishedAt, p.LastEnabledAt = &now, &now
+	event := f.NewProductUpdatedOutboxMessage(context.Background(), p).Event.(*eventsv1.ProductUpdatedEvent)
+	assert.Equal(t, now, event.GetFirstPublishedAt().AsTime())
+	assert.Equal(t, now, event.GetLastEnabledAt().AsTime())
+	assert.Nil(t, event.GetDiscontinuedAt())
+
+	meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateProduct, AggregateID: "product-1", Version: 4}, meta)
+	assert.Equal(t, "1", msg.Headers[catalogevents.HeaderSchemaVersion])
+
+	deleted := f.NewProductDeletedOutboxMessage(context.Background(), p)
+
+	assert.Equal(t, "product-1", deleted.Key)
+	meta, err = catalogevents.MetadataFromHeaders(deleted.Headers)
+	require.NoError(t, err)
+	assert.True(t, meta.IsDeletion())
+	assert.Equal(t, int64(5), meta.Version, "deletion continues the version sequence")
+}
+
+func TestProductEventFactory_PriceChanged(t *testing.T) {
+	f := newProductEventFactory(newTopicRouter(RoutingConfig{}))
+	now := time.Now().UTC()
+	p := product.Reconstruct("product-1", 4, "Phone", "", nil, product.ProductTypePhysical, nil, 12.5, 1, nil, nil, false, nil, nil, nil, nil, now, now)
+
+	msg := f.NewProductPriceChangedOutboxMessage(context.Background(), p, 10)
+
+	assert.Equal(t, "product-1", msg.Key)
+	assert.Equal(t, apiEvents.TopicCatalogProductPriceEvents, msg.Topic)
+	event := msg.Event.(*eventsv1.ProductPriceChangedEvent)
+	assert.InDelta(t, 10, event.GetOldPrice(), 0)
+	assert.InDelta(t, 12.5, event.GetNewPrice(), 0)
+	assert.EqualValues(t, 4, event.GetVersion())
+
+	meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateProduct, AggregateID: "product-1", Version: 4}, meta)
+}
+
+func TestProductEventFactory_BulkProductsImported(t *testing.T) {
+	f := newProductEventFactory(newTopicRouter(RoutingConfig{}))
+	now := time.Now().UTC()
+	created := product.Reconstruct("product-1", 1, "Phone", "", nil, product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil, nil, nil, nil, now, now)
+	updated := product.Reconstruct("product-2", 3, "Case", "", nil, product.ProductTypePhysical, nil, 5, 1, nil, nil, false, nil, nil, nil, nil, now, now)
+
+	msg := f.NewBulkProductsImportedOutboxMessage(context.Background(), product.BulkImportProducts, []*product.Product{created, updated})
+
+	assert.Equal(t, apiEvents.TopicCatalogProductBulkEvents, msg.Topic)
+	event := msg.Event.(*eventsv1.BulkProductsImportedEvent)
+	assert.Equal(t, "import-products", event.GetOperation())
+	assert.Equal(t, []string{"product-1"}, event.GetCreatedProductIds())
+	assert.Equal(t, []string{"product-2"}, event.GetUpdatedProductIds())
+	assert.Equal(t, event.GetBatchId(), msg.Key)
+
+	meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateProductBatch, AggregateID: event.GetBatchId(), Version: 1}, meta)
+}
+
+func TestCategoryEventFactory_Metadata(t *testing.T) {
+	now := time.Now().UTC()
+	c := category.Reconstruct("category-1", 2, "Phones", true, nil, category.Display{}, now, now)
+
+	msg := newCategoryEventFactory(newTopicRouter(RoutingConfig{})).NewCategoryUpdatedOutboxMessage(context.Background(), c, nil)
+
+	assert.Equal(t, "category-1", msg.Key)
+	assert.Equal(t, apiEvents.TopicCatalogCategoryEvents, msg.Topic)
+	assert.Nil(t, msg.Event.(*eventsv1.CategoryUpdatedEvent).GetDisplay(), "no display metadata set")
+
+	meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateCategory, AggregateID: "category-1", Version: 2}, meta)
+}
+
+func TestAttributeEventFactory_Metadata(t *testing.T) {
+	now := time.Now().UTC()
+	a := attribute.Reconstruct("attr-1", 3, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, now, now)
+
+	msg := newAttributeEventFactory(newTopicRouter(RoutingConfig{})).NewAttributeUpdatedOutboxMessage(context.Background(), a, attribute.OptionsDelta{})
+
+	assert.Equal(t, "attr-1", msg.Key)
+	assert.Equal(t, apiEvents.TopicCatalogAttributeEvents, msg.Topic)
+
+	meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateAttribute, AggregateID: "attr-1", Version: 3}, meta)
+}
+
+func TestCategoryEventFactory_EnrichesAttributes(t *testing.T) {
+	now := time.Now().UTC()
+	c := category.Reconstruct("category-1", 1, "Phones", true, []category.CategoryAttribute{
+		{AttributeID: "attr-1", Slug: "stale-slug", Role: category.AttributeRoleVariant, SortOrder: 1},
+		{AttributeID: "attr-2", Slug: "size", Role: category.AttributeRoleSpecification, SortOrder: 2, Filterable: true},
+	}, category.Display{}, now, now)
+	attrs := []*attribute.Attribute{
+		attribute.Reconstruct("attr-1", 1, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, now, now),
+	}
+
+	msg := newCategoryEventFactory(newTopicRouter(RoutingConfig{})).NewCategoryUpdatedOutboxMessage(context.Background(), c, attrs)
+
+	event, ok := msg.Event.(*eventsv1.CategoryUpdatedEvent)
+	require.True(t, ok)
+	require.Len(t, event.GetAttributes(), 2)
+	assert.Equal(t, "color", event.GetAttributes()[0].GetAttributeSlug())
+	assert.Equal(t, "Color", event.GetAttributes()[0].GetAttributeName())
+	assert.Equal(t, eventsv1.AttributeType_ATTRIBUTE_TYPE_SINGLE, event.GetAttributes()[0].GetAttributeType())
+	assert.Equal(t, eventsv1.CategoryAttributeRole_CATEGORY_ATTRIBUTE_ROLE_VARIANT, event.GetAttributes()[0].GetRole())
+	assert.Equal(t, "size", event.GetAttributes()[1].GetAttributeSlug(), "falls back to the stored slug")
+	assert.Empty(t, event.GetAttributes()[1].GetAttributeName())
+	assert.Equal(t, eventsv1.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED, event.GetAttributes()[1].GetAttributeType())
+	assert.True(t, event.GetAttributes()[1].GetFilterable())
+}
+
+func TestAttributeEventFactory_OptionsChanged(t *testing.T) {
+	now := time.Now().UTC()
+	a := attribute.Reconstruct("attr-1", 2, "Color", "color", attribute.AttributeTypeSingle, nil, true, nil, "", nil, nil, now, now)
+	delta := attribute.OptionsDelta{
+		Added:   []attribute.Option{{Name: "Black", Slug: "black"}},
+		Renamed: []attribute.OptionRename{{Slug: "red", OldName: "Red", NewName: "Crimson"}},
+	}
+
+	f := newAttributeEventFactory(newTopicRouter(RoutingConfig{}))
+	msg := f.NewAttributeUpdatedOutboxMessage(context.Background(), a, delta)
+
+	event, ok := msg.Event.(*eventsv1.AttributeUpdatedEvent)
+	require.True(t, ok)
+	change := event.GetOptionsChanged()
+	require.Len(t, change.GetAdded(), 1)
+	assert.Equal(t, "black", change.GetAdded()[0].GetSlug())
+	assert.Equal(t, "Black", change.GetAdded()[0].GetName())
+	assert.Empty(t, change.GetRemoved())
+	require.Len(t, change.GetRenamed(), 1)
+	assert.Equal(t, "red", change.GetRenamed()[0].GetSlug())
+	assert.Equal(t, "Red", change.GetRenamed()[0].GetOldName())
+	assert.Equal(t, "Crimson", change.GetRenamed()[0].GetNewName())
+
+	unchanged := f.NewAttributeUpdatedOutboxMessage(context.Background(), a, attribute.OptionsDelta{})
+	assert.Nil(t, unchanged.Event.(*eventsv1.AttributeUpdatedEvent).GetOptionsChanged())
+}
+
+func TestReservationEventFactory_Messages(t *testing.T) {
+	f := newReservationEventFactory(newTopicRouter(RoutingConfig{}))
+	now := time.Now().UTC()
+	r := reservation.Reconstruct("reservation-1", "product-1", "order-1", 2, now.Add(time.Minute), now)
+
+	reserved := f.NewStockReservedOutboxMessage(context.Background(), r)
+
+	assert.Equal(t, "reservation-1", reserved.Key)
+	assert.Equal(t, apiEvents.TopicCatalogReservationEvents, reserved.Topic)
+	event, ok := reserved.Event.(*eventsv1.StockReservedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "product-1", event.GetProductId())
+	assert.Equal(t, "order-1", event.GetOwner())
+	assert.EqualValues(t, 2, event.GetQuantity())
+	meta, err := catalogevents.MetadataFromHeaders(reserved.Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateReservation, AggregateID: "reservation-1", Version: 1}, meta)
+
+	for _, ended := range []outbox.Message{
+		f.NewStockReleasedOutboxMessage(context.Background(), r),
+		f.NewStockReservationExpiredOutboxMessage(context.Background(), r),
+	} {
+		assert.Equal(t, apiEvents.TopicCatalogReservationEvents, ended.Topic)
+		meta, err := catalogevents.MetadataFromHeaders(ended.Headers)
+		require.NoError(t, err)
+		assert.True(t, meta.IsDeletion())
+		assert.Equal(t, int64(2), meta.Version)
+	}
+	assert.IsType(t, &eventsv1.StockReleasedEvent{}, f.NewStockReleasedOutboxMessage(context.Background(), r).Event)
+	assert.IsType(t, &eventsv1.StockReservationExpiredEvent{}, f.NewStockReservationExpiredOutboxMessage(context.Background(), r).Event)
+}
+
+func TestCategoryEventFactory_Display(t *testing.T) {
+	now := time.Now().UTC()
+	imageID := "image-1"
+	c := category.Reconstruct("category-1", 1, "Phones", true, nil, category.Display{
+		ImageID:  &imageID,
+		Template: category.DisplayTemplateGrid,
+	}, now, now)
+
+	msg := newCategoryEventFactory(newTopicRouter(RoutingConfig{})).NewCategoryUpdatedOutboxMessage(context.Background(), c, nil)
+
+	display := msg.Event.(*eventsv1.CategoryUpdatedEvent).GetDisplay()
+	require.NotNil(t, display)
+	assert.Equal(t, "image-1", display.GetImageId())
+	assert.Nil(t, display.BannerImageId)
+	assert.Equal(t, eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_GRID, display.GetTemplate())
+	assert.NotContains(t, msg.Headers, "display_image_id")
+}
+
+func TestAttributeEventFactory_Display(t *testing.T) {
+	now := time.Now().UTC()
+	imageID := "image-red"
+	a := attribute.Reconstruct("attr-1", 2, "Color", "color", attribute.AttributeTypeSingle, nil, true, []attribute.Option{
+		{Name: "Red", Slug: "red", ImageID: &imageID},
+		{Name: "Blue", Slug: "blue"},
+	}, attribute.DisplayTypeSwatch, nil, nil, now, now)
+
+	msg := newAttributeEventFactory(newTopicRouter(RoutingConfig{})).NewAttributeUpdatedOutboxMessage(context.Background(), a, attribute.OptionsDelta{})
+
+	event, ok := msg.Event.(*eventsv1.AttributeUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_SWATCH, event.GetDisplayType())
+	require.Len(t, event.GetOptions(), 2)
+	assert.Equal(t, "image-red", event.GetOptions()[0].GetImageId())
+	assert.Nil(t, event.GetOptions()[1].ImageId)
+	assert.NotContains(t, msg.Headers, "display_type")
+}
@@ -0,0 +1,45 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/edgecache"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/aftercommit"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// facetInvalidatingFactory decorates the product event factory so every product event drops the cached
+// facets of its tenant once its transaction commits. Price changes always come with an update event, which
+// drops them. A bulk write configured without the update events drops them with its summary event.
+type facetInvalidatingFactory struct {
+	product.ProductEventFactory
+	facets *product.FacetCache
+}
+
+// decorateProductEventFactory drops the cached facets and purges the CDN on every product event
+func decorateProductEventFactory(next product.ProductEventFactory, facets *product.FacetCache, purger edgecache.Purger) product.ProductEventFactory {
+	next = &purgingProductFactory{ProductEventFactory: next, purger: purger}
+	return &facetInvalidatingFactory{ProductEventFactory: next, facets: facets}
+}
+
+func (f *facetInvalidatingFactory) NewProductUpdatedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
+	f.invalidate(ctx)
+	return f.ProductEventFactory.NewProductUpdatedOutboxMessage(ctx, p)
+}
+
+func (f *facetInvalidatingFactory) NewProductDeletedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
+	f.invalidate(ctx)
+	return f.ProductEventFactory.NewProductDeletedOutboxMessage(ctx, p)
+}
+
+func (f *facetInvalidatingFactory) NewBulkProductsImportedOutboxMessage(ctx context.Context, op product.BulkOperation, products []*product.Product) outbox.Message {
+	f.invalidate(ctx)
+	return f.ProductEventFactory.NewBulkProductsImportedOutboxMessage(ctx, op, products)
+}
+
+func (f *facetInvalidatingFactory) invalidate(ctx context.Context) {
+	slug, _ := tenant.SlugFromContext(ctx)
+	aftercommit.Run(ctx, func(context.Context) { f.facets.Invalidate(slug) })
+}
@@ -0,0 +1,32 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/event"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// newOutboxMessage builds the outbox message for an aggregate event.
+// The aggregate ID is the partition key so that events of one aggregate stay ordered,
+// see package catalogevents for the metadata contract.
+func (r *topicRouter) newOutboxMessage(ctx context.Context, msg proto.Message, meta catalogevents.Metadata) outbox.Message {
+	headers := meta.Headers()
+	headers[catalogevents.HeaderSchemaVersion] = strconv.Itoa(event.SchemaVersion(msg))
+
+	return outbox.Message{
+		Event:   msg,
+		Key:     meta.AggregateID,
+		Topic:   r.topicFor(ctx, msg),
+		Headers: headers,
+	}
+}
+
+// eventVersion converts an aggregate version to the event field type
+func eventVersion(version int) int32 {
+	return int32(version) //nolint:gosec // versions grow by one per change and never approach the int32 range
+}
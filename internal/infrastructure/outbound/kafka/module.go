@@ -7,9 +7,15 @@ import (
 func Module() fx.Option {
 	return fx.Options(
 		fx.Provide(
+			provideRoutingConfig,
+			newTopicRouter,
+			provideBulkEventsConfig,
+			provideBulkEventPolicy,
 			newProductEventFactory,
 			newCategoryEventFactory,
 			newAttributeEventFactory,
+			newReservationEventFactory,
 		),
+		fx.Decorate(decorateProductEventFactory, decorateCategoryEventFactory, decorateAttributeEventFactory),
 	)
 }
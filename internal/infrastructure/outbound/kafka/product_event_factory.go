@@ -4,18 +4,22 @@ import (
 	"context"
 
 	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
-	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
 	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type productEventFactory struct{}
+type productEventFactory struct {
+	router *topicRouter
+}
 
 // newProductEventFactory creates a new ProductEventFactory
-func newProductEventFactory() product.ProductEventFactory {
-	return &productEventFactory{}
+func newProductEventFactory(router *topicRouter) product.ProductEventFactory {
+	return &productEventFactory{router: router}
 }
 
 func toProductEventAttributeValue(pAttr product.AttributeValue) *eventsv1.AttributeValue {
@@ -35,6 +39,11 @@ func toProductEventAttributeValue(pAttr product.AttributeValue) *eventsv1.Attrib
 	case pAttr.BooleanValue != nil:
 		av.Value = &eventsv1.AttributeValue_BooleanValue{BooleanValue: *pAttr.BooleanValue}
 	}
+	av.Unit = pAttr.Unit
+	if pAttr.Submitted != nil {
+		av.SubmittedNumericValue = &pAttr.Submitted.Value
+		av.SubmittedUnit = &pAttr.Submitted.Unit
+	}
 	return av
 }
 
@@ -48,38 +57,95 @@ func toProductEventAttributes(productAttrs []product.AttributeValue) []*eventsv1
 }
 
 func (f *productEventFactory) newProductUpdatedEvent(p *product.Product) *eventsv1.ProductUpdatedEvent {
-	return &eventsv1.ProductUpdatedEvent{
-		ProductId:   p.ID,
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Quantity:    int32(p.Quantity),
-		Enabled:     p.Enabled,
-		Version:     int64(p.Version),
-		ImageId:     p.ImageID,
-		CategoryId:  p.CategoryID,
-		CreatedAt:   timestamppb.New(p.CreatedAt),
-		ModifiedAt:  timestamppb.New(p.ModifiedAt),
-		Attributes:  toProductEventAttributes(p.Attributes),
+	event := &eventsv1.ProductUpdatedEvent{
+		ProductId:     p.ID,
+		Name:          p.Name,
+		Slug:          p.Slug,
+		PreviousSlugs: p.SlugHistory,
+		Description:   p.Description,
+		Excerpt:       p.Excerpt,
+		Price:         p.Price,
+		Quantity:      int32(p.Quantity),
+		Enabled:       p.Enabled,
+		Version:       eventVersion(p.Version),
+		ImageId:       p.ImageID,
+		CategoryId:    p.CategoryID,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		ModifiedAt:    timestamppb.New(p.ModifiedAt),
+		Attributes:    toProductEventAttributes(p.Attributes),
+		Metadata:      p.Metadata,
+		ExternalId:    p.ExternalID,
+
+		ReplacementProductId: p.ReplacementProductID,
+	}
+	if p.FirstPublishedAt != nil {
+		event.FirstPublishedAt = timestamppb.New(*p.FirstPublishedAt)
+	}
+	if p.LastEnabledAt != nil {
+		event.LastEnabledAt = timestamppb.New(*p.LastEnabledAt)
 	}
+	if p.DiscontinuedAt != nil {
+		event.DiscontinuedAt = timestamppb.New(*p.DiscontinuedAt)
+	}
+	if p.Supplier != nil {
+		event.SupplierId = &p.Supplier.SupplierID
+		event.SupplierSku = p.Supplier.SKU
+	}
+	return event
 }
 
 func (f *productEventFactory) NewProductUpdatedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
-	event := f.newProductUpdatedEvent(p)
-	return outbox.Message{
-		Event: event,
-		Key:   p.ID,
-		Topic: apiEvents.TopicFor(event),
-	}
+	return f.router.newOutboxMessage(ctx, f.newProductUpdatedEvent(p), catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateProduct,
+		AggregateID:   p.ID,
+		Version:       int64(p.Version),
+	})
 }
 
-func (f *productEventFactory) NewProductDeletedOutboxMessage(ctx context.Context, productID string) outbox.Message {
+func (f *productEventFactory) NewProductDeletedOutboxMessage(ctx context.Context, p *product.Product) outbox.Message {
 	event := &eventsv1.ProductDeletedEvent{
-		ProductId: productID,
+		ProductId: p.ID,
 	}
-	return outbox.Message{
-		Event: event,
-		Key:   productID,
-		Topic: apiEvents.TopicFor(event),
+	return f.router.newOutboxMessage(ctx, event, catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateProduct,
+		AggregateID:   p.ID,
+		Version:       int64(p.Version) + 1,
+		Deleted:       true,
+	})
+}
+
+func (f *productEventFactory) NewProductPriceChangedOutboxMessage(ctx context.Context, p *product.Product, oldPrice float64) outbox.Message {
+	event := &eventsv1.ProductPriceChangedEvent{
+		ProductId: p.ID,
+		OldPrice:  oldPrice,
+		NewPrice:  p.Price,
+		Version:   eventVersion(p.Version),
+		ChangedAt: timestamppb.New(p.ModifiedAt),
 	}
+	return f.router.newOutboxMessage(ctx, event, catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateProduct,
+		AggregateID:   p.ID,
+		Version:       int64(p.Version),
+	})
+}
+
+func (f *productEventFactory) NewBulkProductsImportedOutboxMessage(ctx context.Context, op product.BulkOperation, products []*product.Product) outbox.Message {
+	batchID := uuid.New().String()
+	event := &eventsv1.BulkProductsImportedEvent{
+		BatchId:     batchID,
+		Operation:   string(op),
+		CommittedAt: timestamppb.New(utc.Now()),
+	}
+	for _, p := range products {
+		if p.Version == 1 {
+			event.CreatedProductIds = append(event.CreatedProductIds, p.ID)
+		} else {
+			event.UpdatedProductIds = append(event.UpdatedProductIds, p.ID)
+		}
+	}
+	return f.router.newOutboxMessage(ctx, event, catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateProductBatch,
+		AggregateID:   batchID,
+		Version:       1,
+	})
 }
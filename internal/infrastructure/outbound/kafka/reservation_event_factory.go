@@ -0,0 +1,72 @@
+package kafka
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// A reservation is never changed, so its events always carry version 1 when it is created
+// and version 2 with the deletion flag when it is released or expires.
+const (
+	reservationCreatedVersion = 1
+	reservationEndedVersion   = 2
+)
+
+type reservationEventFactory struct {
+	router *topicRouter
+}
+
+// newReservationEventFactory creates a new ReservationEventFactory
+func newReservationEventFactory(router *topicRouter) reservation.ReservationEventFactory {
+	return &reservationEventFactory{router: router}
+}
+
+func (f *reservationEventFactory) NewStockReservedOutboxMessage(ctx context.Context, r *reservation.Reservation) outbox.Message {
+	event := &eventsv1.StockReservedEvent{
+		ReservationId: r.ID,
+		ProductId:     r.ProductID,
+		Quantity:      int32(r.Quantity),
+		Owner:         r.Owner,
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+	return f.router.newOutboxMessage(ctx, event, reservationMetadata(r, reservationCreatedVersion, false))
+}
+
+func (f *reservationEventFactory) NewStockReleasedOutboxMessage(ctx context.Context, r *reservation.Reservation) outbox.Message {
+	event := &eventsv1.StockReleasedEvent{
+		ReservationId: r.ID,
+		ProductId:     r.ProductID,
+		Quantity:      int32(r.Quantity),
+		Owner:         r.Owner,
+		ReleasedAt:    timestamppb.New(utc.Now()),
+	}
+	return f.router.newOutboxMessage(ctx, event, reservationMetadata(r, reservationEndedVersion, true))
+}
+
+func (f *reservationEventFactory) NewStockReservationExpiredOutboxMessage(ctx context.Context, r *reservation.Reservation) outbox.Message {
+	event := &eventsv1.StockReservationExpiredEvent{
+		ReservationId: r.ID,
+		ProductId:     r.ProductID,
+		Quantity:      int32(r.Quantity),
+		Owner:         r.Owner,
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+	}
+	return f.router.newOutboxMessage(ctx, event, reservationMetadata(r, reservationEndedVersion, true))
+}
+
+func reservationMetadata(r *reservation.Reservation, version int64, deleted bool) catalogevents.Metadata {
+	return catalogevents.Metadata{
+		AggregateType: catalogevents.AggregateReservation,
+		AggregateID:   r.ID,
+		Version:       version,
+		Deleted:       deleted,
+	}
+}
@@ -0,0 +1,117 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/knadh/koanf/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// topicNameRegex matches the names Kafka accepts for topics, suffixes are checked as part of one
+var topicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+// RoutingConfig moves events off their default topics, so that high-volume events can be isolated
+//
+// Topics maps event types, by message name such as ProductUpdatedEvent, to the topic they are published to.
+// Events of one aggregate share a default topic to stay ordered, so they must be routed together.
+// TenantSuffixes maps tenant slugs to a suffix appended to the topic of every event of the tenant,
+// such as ".acme".
+type RoutingConfig struct {
+	Topics         map[string]string `koanf:"topics"`
+	TenantSuffixes map[string]string `koanf:"tenant-suffixes"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *RoutingConfig) ApplyDefaults() {}
+
+// Validate validates the configuration
+func (c *RoutingConfig) Validate() error {
+	defaults := defaultTopicsByName()
+	routed := make(map[string]string)
+	for name, topic := range c.Topics {
+		defaultTopic, ok := defaults[name]
+		if !ok {
+			return fmt.Errorf("topics.%s: unknown event type", name)
+		}
+		if !topicNameRegex.MatchString(topic) {
+			return fmt.Errorf("topics.%s: invalid topic name %q", name, topic)
+		}
+		routed[defaultTopic] = topic
+	}
+	// Events sharing a default topic keep sharing a topic
+	for name, defaultTopic := range defaults {
+		if topic, ok := routed[defaultTopic]; ok && c.Topics[name] != topic {
+			return fmt.Errorf("topics.%s: must be routed to %s with the other events of %s", name, topic, defaultTopic)
+		}
+	}
+
+	for slug, suffix := range c.TenantSuffixes {
+		if suffix == "" || !topicNameRegex.MatchString(suffix) {
+			return fmt.Errorf("tenant-suffixes.%s: invalid suffix %q", slug, suffix)
+		}
+	}
+	return nil
+}
+
+func provideRoutingConfig(k *koanf.Koanf) (RoutingConfig, error) {
+	return coreconfig.Load[RoutingConfig](k, "kafka-routing", nil)
+}
+
+// topicRouter picks the topic of each event, see RoutingConfig
+type topicRouter struct {
+	topics   map[protoreflect.FullName]string
+	suffixes map[string]string
+}
+
+func newTopicRouter(cfg RoutingConfig) *topicRouter {
+	topics := apiEvents.TopicsByEvent()
+	for fullName := range topics {
+		if topic, ok := cfg.Topics[string(fullName.Name())]; ok {
+			topics[fullName] = topic
+		}
+	}
+	return &topicRouter{topics: topics, suffixes: cfg.TenantSuffixes}
+}
+
+// topicFor returns the topic of the event for the tenant of the context
+func (r *topicRouter) topicFor(ctx context.Context, msg proto.Message) string {
+	topic, ok := r.topics[msg.ProtoReflect().Descriptor().FullName()]
+	if !ok {
+		// Panics like the default routing for unregistered events
+		topic = apiEvents.TopicFor(msg)
+	}
+	if slug, ok := tenant.SlugFromContext(ctx); ok {
+		topic += r.suffixes[slug]
+	}
+	return topic
+}
+
+// allTopics lists every topic events can be published to, sorted
+func (r *topicRouter) allTopics() []string {
+	var topics []string
+	for _, topic := range r.topics {
+		topics = append(topics, topic)
+		for _, suffix := range r.suffixes {
+			topics = append(topics, topic+suffix)
+		}
+	}
+	slices.Sort(topics)
+	return slices.Compact(topics)
+}
+
+// defaultTopicsByName maps the message names of the event types to their default topics
+func defaultTopicsByName() map[string]string {
+	defaults := make(map[string]string)
+	for fullName, topic := range apiEvents.TopicsByEvent() {
+		defaults[string(fullName.Name())] = topic
+	}
+	return defaults
+}
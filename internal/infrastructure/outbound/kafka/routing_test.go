@@ -0,0 +1,65 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+func TestRoutingConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     RoutingConfig
+		wantErr string
+	}{
+		{name: "defaults"},
+		{name: "routed together", cfg: RoutingConfig{
+			Topics: map[string]string{"ProductUpdatedEvent": "catalog.products", "ProductDeletedEvent": "catalog.products"},
+		}},
+		{name: "unknown event", cfg: RoutingConfig{Topics: map[string]string{"ProductCreatedEvent": "catalog.products"}},
+			wantErr: "unknown event type"},
+		{name: "invalid topic", cfg: RoutingConfig{Topics: map[string]string{"AttributeUpdatedEvent": "catalog attributes"}},
+			wantErr: "invalid topic name"},
+		{name: "split aggregate", cfg: RoutingConfig{Topics: map[string]string{"ProductUpdatedEvent": "catalog.products"}},
+			wantErr: "topics.ProductDeletedEvent: must be routed to catalog.products"},
+		{name: "invalid suffix", cfg: RoutingConfig{TenantSuffixes: map[string]string{"acme": "/acme"}},
+			wantErr: "invalid suffix"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTopicRouter(t *testing.T) {
+	r := newTopicRouter(RoutingConfig{
+		Topics:         map[string]string{"AttributeUpdatedEvent": "catalog.attributes"},
+		TenantSuffixes: map[string]string{"acme": ".acme"},
+	})
+	acme := tenant.ContextWithSlug(context.Background(), "acme")
+	other := tenant.ContextWithSlug(context.Background(), "other")
+
+	assert.Equal(t, "catalog.attributes", r.topicFor(other, &eventsv1.AttributeUpdatedEvent{}))
+	assert.Equal(t, "catalog.attributes.acme", r.topicFor(acme, &eventsv1.AttributeUpdatedEvent{}))
+	assert.Equal(t, apiEvents.TopicCatalogProductEvents, r.topicFor(context.Background(), &eventsv1.ProductUpdatedEvent{}))
+	assert.Equal(t, apiEvents.TopicCatalogProductEvents+".acme", r.topicFor(acme, &eventsv1.ProductDeletedEvent{}))
+
+	topics := r.allTopics()
+	assert.Contains(t, topics, "catalog.attributes.acme")
+	assert.Contains(t, topics, apiEvents.TopicCatalogReservationEvents)
+	assert.NotContains(t, topics, apiEvents.TopicCatalogAttributeEvents)
+	assert.Len(t, topics, 2*len(apiEvents.Topics()))
+}
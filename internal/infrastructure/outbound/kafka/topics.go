@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// TopicsConfig holds the settings used to create the catalog topics that don't exist yet
+type TopicsConfig struct {
+	// Partitions is the partition count of created topics. Default: -1 (broker default)
+	Partitions int32 `koanf:"partitions"`
+	// ReplicationFactor is the replication factor of created topics. Default: -1 (broker default)
+	ReplicationFactor int16 `koanf:"replication-factor"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *TopicsConfig) ApplyDefaults() {
+	if c.Partitions == 0 {
+		c.Partitions = -1
+	}
+	if c.ReplicationFactor == 0 {
+		c.ReplicationFactor = -1
+	}
+}
+
+// Validate validates the configuration
+func (c *TopicsConfig) Validate() error {
+	if c.Partitions < -1 || c.ReplicationFactor < -1 {
+		return errors.New("partitions and replication-factor must be positive or -1")
+	}
+	return nil
+}
+
+// TopicsModule creates every topic the service publishes to on startup, routed ones included, so that events of
+// a new topic don't depend on auto topic creation being enabled on the brokers
+func TopicsModule() fx.Option {
+	return fx.Options(
+		fx.Provide(provideTopicsConfig),
+		fx.Invoke(registerTopicProvisioning),
+	)
+}
+
+func provideTopicsConfig(k *koanf.Koanf) (TopicsConfig, error) {
+	return coreconfig.Load[TopicsConfig](k, "kafka-topics", nil)
+}
+
+func registerTopicProvisioning(lc fx.Lifecycle, client *kgo.Client, cfg TopicsConfig, router *topicRouter, log *zap.Logger) {
+	log = log.With(zap.String("component", "kafka-topics"))
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// Missing topics are logged instead of failing startup, like unreachable brokers are
+			if err := createTopics(ctx, kadm.NewClient(client), cfg, router.allTopics(), log); err != nil {
+				log.Warn("failed to create topics", zap.Error(err))
+			}
+			return nil
+		},
+	})
+}
+
+func createTopics(ctx context.Context, adm *kadm.Client, cfg TopicsConfig, topics []string, log *zap.Logger) error {
+	responses, err := adm.CreateTopics(ctx, cfg.Partitions, cfg.ReplicationFactor, nil, topics...)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, res := range responses.Sorted() {
+		switch {
+		case res.Err == nil:
+			log.Info("topic created", zap.String("topic", res.Topic))
+		case errors.Is(res.Err, kerr.TopicAlreadyExists):
+		default:
+			errs = append(errs, fmt.Errorf("topic %s: %w", res.Topic, res.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,61 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type imageChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+// newImageChecker creates an image checker backed by the media service HTTP API.
+// An image exists when GET /v1/images/{id} answers 200 and is missing when it answers 404.
+func newImageChecker(client *http.Client, baseURL string) *imageChecker {
+	return &imageChecker{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (c *imageChecker) MissingImages(ctx context.Context, imageIDs []string) ([]string, error) {
+	var missing []string
+	for _, id := range imageIDs {
+		exists, err := c.exists(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+func (c *imageChecker) exists(ctx context.Context, imageID string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/v1/images/"+url.PathEscape(imageID), http.NoBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to build media request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to get image %s: %w", imageID, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only body
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to get image %s: media service answered %d", imageID, resp.StatusCode)
+	}
+}
@@ -0,0 +1,45 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestImageChecker_MissingImages(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/images/image-1" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	missing, err := newImageChecker(srv.Client(), srv.URL+"/").MissingImages(context.Background(), []string{"image-1", "image-2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"image-2"}, missing)
+}
+
+func TestImageChecker_UnexpectedStatus(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := newImageChecker(srv.Client(), srv.URL).MissingImages(context.Background(), []string{"image-1"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
@@ -0,0 +1,41 @@
+package media
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	httpclient "github.com/Sokol111/ecommerce-commons/pkg/http/client"
+)
+
+// clientName is the media service entry under "clients" in the configuration
+const clientName = "media-service"
+
+// Module provides the media service adapters
+func Module() fx.Option {
+	return fx.Provide(
+		provideImageChecker,
+		provideCategoryImageChecker,
+		provideAttributeImageChecker,
+	)
+}
+
+func provideImageChecker(registry *httpclient.Registry) (*imageChecker, error) {
+	client, err := registry.Client(clientName)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := registry.Config(clientName)
+	if err != nil {
+		return nil, err
+	}
+	return newImageChecker(client, cfg.BaseURL), nil
+}
+
+func provideCategoryImageChecker(c *imageChecker) category.ImageChecker {
+	return c
+}
+
+func provideAttributeImageChecker(c *imageChecker) attribute.ImageChecker {
+	return c
+}
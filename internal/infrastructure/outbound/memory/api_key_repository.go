@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type apiKeyRepository struct {
+	store *Store
+}
+
+// NewAPIKeyRepository creates an in-memory apikey.Repository
+func NewAPIKeyRepository(store *Store) apikey.Repository {
+	return &apiKeyRepository{store: store}
+}
+
+func (r *apiKeyRepository) Insert(_ context.Context, k *apikey.APIKey) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.apiKeys.exists(k.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", k.ID)
+	}
+	r.store.apiKeys.put(k.ID, k)
+	return nil
+}
+
+func (r *apiKeyRepository) FindByID(_ context.Context, id string) (*apikey.APIKey, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	k, ok := r.store.apiKeys.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return k, nil
+}
+
+func (r *apiKeyRepository) FindByTenant(_ context.Context, tenant string) ([]*apikey.APIKey, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	keys := r.store.apiKeys.find(func(k *apikey.APIKey) bool { return k.Tenant == tenant })
+	slices.SortStableFunc(keys, func(a, b *apikey.APIKey) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Update(_ context.Context, k *apikey.APIKey) (*apikey.APIKey, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.apiKeys.get(k.ID)
+	if !ok || current.Version != k.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+
+	updated := cloneAPIKey(k)
+	updated.Version++
+	r.store.apiKeys.put(updated.ID, updated)
+	return cloneAPIKey(updated), nil
+}
+
+func (r *apiKeyRepository) Delete(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.apiKeys.remove(id)
+	return nil
+}
+
+func cloneAPIKey(k *apikey.APIKey) *apikey.APIKey {
+	cloned := *k
+	cloned.Permissions = slices.Clone(k.Permissions)
+	if k.ExpiresAt != nil {
+		t := *k.ExpiresAt
+		cloned.ExpiresAt = &t
+	}
+	return &cloned
+}
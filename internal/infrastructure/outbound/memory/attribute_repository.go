@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var attributeComparators = comparators[attribute.Attribute]{
+	"_id":        func(a, b *attribute.Attribute) int { return cmp.Compare(a.ID, b.ID) },
+	"name":       func(a, b *attribute.Attribute) int { return cmp.Compare(a.Name, b.Name) },
+	"slug":       func(a, b *attribute.Attribute) int { return cmp.Compare(a.Slug, b.Slug) },
+	"createdAt":  func(a, b *attribute.Attribute) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"modifiedAt": func(a, b *attribute.Attribute) int { return a.ModifiedAt.Compare(b.ModifiedAt) },
+}
+
+type attributeRepository struct {
+	store *Store
+}
+
+// NewAttributeRepository creates an in-memory attribute.Repository.
+// The unique slug index of the Mongo adapter is emulated on Insert and Update.
+func NewAttributeRepository(store *Store) attribute.Repository {
+	return &attributeRepository{store: store}
+}
+
+func (r *attributeRepository) Insert(_ context.Context, a *attribute.Attribute) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.attributes.exists(a.ID) || r.slugTaken(a.Slug, a.ID) {
+		return attribute.ErrSlugAlreadyExists
+	}
+	r.store.attributes.put(a.ID, a)
+	return nil
+}
+
+func (r *attributeRepository) FindByID(_ context.Context, id string) (*attribute.Attribute, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	a, ok := r.store.attributes.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return a, nil
+}
+
+func (r *attributeRepository) FindByIDs(_ context.Context, ids []string) ([]*attribute.Attribute, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	result := make([]*attribute.Attribute, 0, len(ids))
+	for _, id := range ids {
+		if a, ok := r.store.attributes.get(id); ok {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (r *attributeRepository) FindByIDsOrFail(ctx context.Context, ids []string) ([]*attribute.Attribute, error) {
+	attrs, err := r.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, id := range ids {
+		if !r.store.attributes.exists(id) {
+			return nil, fmt.Errorf("attribute not found: %s", id)
+		}
+	}
+	return attrs, nil
+}
+
+func (r *attributeRepository) FindBySlugs(_ context.Context, slugs []string) ([]*attribute.Attribute, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.attributes.find(func(a *attribute.Attribute) bool {
+		return slices.Contains(slugs, a.Slug)
+	}), nil
+}
+
+func (r *attributeRepository) FindByPaletteID(_ context.Context, paletteID string) ([]*attribute.Attribute, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.attributes.find(func(a *attribute.Attribute) bool {
+		return a.PaletteID != nil && *a.PaletteID == paletteID
+	}), nil
+}
+
+func (r *attributeRepository) FindList(_ context.Context, query attribute.ListQuery) (*commonsmongo.PageResult[attribute.Attribute], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.attributes.find(func(a *attribute.Attribute) bool {
+		if query.AfterID != "" && a.ID <= query.AfterID {
+			return false
+		}
+		if query.Enabled != nil && a.Enabled != *query.Enabled {
+			return false
+		}
+		if query.Type != nil && string(a.Type) != *query.Type {
+			return false
+		}
+		if query.ModifiedAfter != nil && !a.ModifiedAt.After(*query.ModifiedAfter) {
+			return false
+		}
+		return true
+	})
+
+	sortDocs(docs, attributeComparators, query.Sort, query.Order)
+	return paginate(docs, query.Page, query.Size), nil
+}
+
+func (r *attributeRepository) Update(_ context.Context, a *attribute.Attribute) (*attribute.Attribute, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.attributes.get(a.ID)
+	if !ok || current.Version != a.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+	if r.slugTaken(a.Slug, a.ID) {
+		return nil, attribute.ErrSlugAlreadyExists
+	}
+
+	updated := cloneAttribute(a)
+	updated.Version++
+	r.store.attributes.put(updated.ID, updated)
+	return cloneAttribute(updated), nil
+}
+
+func (r *attributeRepository) Exists(_ context.Context, id string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.attributes.exists(id), nil
+}
+
+// slugTaken reports whether another attribute already uses the slug; callers must hold the store lock
+func (r *attributeRepository) slugTaken(slug, exceptID string) bool {
+	return len(r.store.attributes.find(func(a *attribute.Attribute) bool {
+		return a.Slug == slug && a.ID != exceptID
+	})) > 0
+}
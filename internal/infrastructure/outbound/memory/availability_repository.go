@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type availabilityRepository struct {
+	store *Store
+}
+
+// NewAvailabilityRepository creates an in-memory availability.Repository
+func NewAvailabilityRepository(store *Store) availability.Repository {
+	return &availabilityRepository{store: store}
+}
+
+func (r *availabilityRepository) Insert(_ context.Context, s *availability.Schedule) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.schedules.exists(s.ProductID) {
+		return commonsmongo.ErrOptimisticLocking
+	}
+	r.store.schedules.put(s.ProductID, s)
+	return nil
+}
+
+func (r *availabilityRepository) FindByProductID(_ context.Context, productID string) (*availability.Schedule, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	s, ok := r.store.schedules.get(productID)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return s, nil
+}
+
+func (r *availabilityRepository) Update(_ context.Context, s *availability.Schedule) (*availability.Schedule, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.schedules.get(s.ProductID)
+	if !ok || current.Version != s.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+
+	updated := cloneSchedule(s)
+	updated.Version++
+	r.store.schedules.put(updated.ProductID, updated)
+	return cloneSchedule(updated), nil
+}
+
+func cloneSchedule(s *availability.Schedule) *availability.Schedule {
+	cloned := *s
+	cloned.WeeklySlots = slices.Clone(s.WeeklySlots)
+	cloned.Blackouts = slices.Clone(s.Blackouts)
+	return &cloned
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var categoryComparators = comparators[category.Category]{
+	"_id":        func(a, b *category.Category) int { return cmp.Compare(a.ID, b.ID) },
+	"name":       func(a, b *category.Category) int { return cmp.Compare(a.Name, b.Name) },
+	"createdAt":  func(a, b *category.Category) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"modifiedAt": func(a, b *category.Category) int { return a.ModifiedAt.Compare(b.ModifiedAt) },
+}
+
+type categoryRepository struct {
+	store *Store
+}
+
+// NewCategoryRepository creates an in-memory category.Repository
+func NewCategoryRepository(store *Store) category.Repository {
+	return &categoryRepository{store: store}
+}
+
+func (r *categoryRepository) Insert(_ context.Context, c *category.Category) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.categories.exists(c.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", c.ID)
+	}
+	r.store.categories.put(c.ID, c)
+	r.store.categoryHistory.record(c.ID, c.ModifiedAt, c)
+	return nil
+}
+
+func (r *categoryRepository) FindByID(_ context.Context, id string) (*category.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	c, ok := r.store.categories.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return c, nil
+}
+
+func (r *categoryRepository) FindAsOf(_ context.Context, id string, asOf time.Time) (*category.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	c, ok := r.store.categoryHistory.asOf(id, asOf)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return c, nil
+}
+
+func (r *categoryRepository) FindList(_ context.Context, query category.ListQuery) (*commonsmongo.PageResult[category.Category], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.categories.find(func(c *category.Category) bool {
+		if query.AfterID != "" && c.ID <= query.AfterID {
+			return false
+		}
+		if query.ModifiedAfter != nil && !c.ModifiedAt.After(*query.ModifiedAfter) {
+			return false
+		}
+		return query.Enabled == nil || c.Enabled == *query.Enabled
+	})
+
+	sortDocs(docs, categoryComparators, query.Sort, query.Order)
+	return paginate(docs, query.Page, query.Size), nil
+}
+
+func (r *categoryRepository) Update(_ context.Context, c *category.Category) (*category.Category, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.categories.get(c.ID)
+	if !ok || current.Version != c.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+
+	updated := cloneCategory(c)
+	updated.Version++
+	r.store.categories.put(updated.ID, updated)
+	r.store.categoryHistory.record(updated.ID, updated.ModifiedAt, updated)
+	return cloneCategory(updated), nil
+}
+
+func (r *categoryRepository) Exists(_ context.Context, id string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.categories.exists(id), nil
+}
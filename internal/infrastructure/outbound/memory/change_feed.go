@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+// changeFeed reads the histories of the store; the cursor is the sequence number of the last revision read
+type changeFeed struct {
+	store *Store
+}
+
+func NewChangeFeed(store *Store) change.Feed {
+	return &changeFeed{store: store}
+}
+
+func (f *changeFeed) FindAfter(_ context.Context, cursor string, limit int) ([]change.Change, error) {
+	var after int64
+	if cursor != "" {
+		var err error
+		if after, err = strconv.ParseInt(cursor, 10, 64); err != nil || after < 0 {
+			return nil, fmt.Errorf("%w: %q", change.ErrInvalidCursor, cursor)
+		}
+	}
+
+	f.store.mu.RLock()
+	entries := append(
+		f.store.productHistory.changesAfter(after, change.EntityProduct, func(p *product.Product) int { return p.Version }),
+		f.store.categoryHistory.changesAfter(after, change.EntityCategory, func(c *category.Category) int { return c.Version })...,
+	)
+	f.store.mu.RUnlock()
+
+	slices.SortFunc(entries, func(a, b sequencedChange) int { return cmp.Compare(a.seq, b.seq) })
+	entries = entries[:min(limit, len(entries))]
+
+	changes := make([]change.Change, len(entries))
+	for i, e := range entries {
+		changes[i] = e.Change
+	}
+	return changes, nil
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var commentComparators = comparators[comment.Comment]{
+	"createdAt": func(a, b *comment.Comment) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+type commentRepository struct {
+	store *Store
+}
+
+// NewCommentRepository creates an in-memory comment.Repository
+func NewCommentRepository(store *Store) comment.Repository {
+	return &commentRepository{store: store}
+}
+
+func (r *commentRepository) Insert(_ context.Context, c *comment.Comment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.comments.put(c.ID, c)
+	return nil
+}
+
+func (r *commentRepository) FindByProductID(_ context.Context, productID string, page, size int) (*commonsmongo.PageResult[comment.Comment], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.comments.find(func(c *comment.Comment) bool {
+		return c.ProductID == productID
+	})
+	// newest first, also among comments created at the same time
+	slices.Reverse(docs)
+	sortDocs(docs, commentComparators, "createdAt", "desc")
+	return paginate(docs, page, size), nil
+}
+
+func cloneComment(c *comment.Comment) *comment.Comment {
+	cloned := *c
+	return &cloned
+}
+
+func (r *commentRepository) ReplaceAuthor(_ context.Context, author, replacement string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	docs := r.store.comments.find(func(c *comment.Comment) bool { return c.Author == author })
+	for _, c := range docs {
+		c.Author = replacement
+		r.store.comments.put(c.ID, c)
+	}
+	return int64(len(docs)), nil
+}
+
+func (r *commentRepository) CountByAuthor(_ context.Context, author string) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.store.comments.find(func(c *comment.Comment) bool { return c.Author == author }))), nil
+}
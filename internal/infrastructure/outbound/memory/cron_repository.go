@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type cronRunRepository struct {
+	store *Store
+}
+
+// NewCronRunRepository creates an in-memory cron.RunRepository
+func NewCronRunRepository(store *Store) cron.RunRepository {
+	return &cronRunRepository{store: store}
+}
+
+func (r *cronRunRepository) Insert(_ context.Context, run *cron.Run) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.cronRuns.exists(run.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", run.ID)
+	}
+	r.store.cronRuns.put(run.ID, run)
+	return nil
+}
+
+func (r *cronRunRepository) Save(_ context.Context, run *cron.Run) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if !r.store.cronRuns.exists(run.ID) {
+		return mongo.ErrEntityNotFound
+	}
+	r.store.cronRuns.put(run.ID, run)
+	return nil
+}
+
+func cloneCronRun(run *cron.Run) *cron.Run {
+	cloned := *run
+	return &cloned
+}
@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"go.uber.org/fx"
+)
+
+// discardOutbox drops every message, so a long-running demo doesn't pile up events nobody consumes
+type discardOutbox struct{}
+
+func (discardOutbox) Create(context.Context, outbox.Message) (outbox.SendFunc, error) {
+	return func(context.Context) error { return nil }, nil
+}
+
+func (discardOutbox) CreateBatch(context.Context, []outbox.Message) error {
+	return nil
+}
+
+// DemoModule provides the in-memory adapters of Module with an outbox that discards the events,
+// for running the service without any infrastructure
+func DemoModule() fx.Option {
+	return fx.Options(
+		Module(),
+		fx.Decorate(
+			func(outbox.Outbox) outbox.Outbox { return discardOutbox{} },
+			func(product.BatchOutbox) product.BatchOutbox { return discardOutbox{} },
+		),
+	)
+}
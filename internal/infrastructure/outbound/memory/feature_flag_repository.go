@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+type featureFlagRepository struct {
+	store *Store
+}
+
+// NewFeatureFlagRepository creates an in-memory feature.Repository
+func NewFeatureFlagRepository(store *Store) feature.Repository {
+	return &featureFlagRepository{store: store}
+}
+
+func (r *featureFlagRepository) FindAll(_ context.Context) ([]*feature.Setting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	settings := make([]*feature.Setting, 0, len(r.store.featureFlags))
+	for _, s := range r.store.featureFlags {
+		cloned := s
+		settings = append(settings, &cloned)
+	}
+	return settings, nil
+}
+
+// SetFeatureFlag stores a flag setting the way an operator does in the database;
+// it takes effect on the next reload of the flags
+func (s *Store) SetFeatureFlag(flag feature.Flag, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.featureFlags[flag] = feature.Setting{Flag: flag, Enabled: enabled, ModifiedAt: utc.Now()}
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+)
+
+// revision is a stored state of a document; a nil doc marks its deletion
+type revision[T any] struct {
+	// seq orders the revisions of all histories of a store, as revision IDs do in MongoDB
+	seq       int64
+	validFrom time.Time
+	doc       *T
+}
+
+// history mirrors the revision collections: every write of a document appends its new state
+type history[T any] struct {
+	revisions map[string][]revision[T]
+	cloneFn   func(*T) *T
+	// seq is the last sequence number, shared by the histories of a store
+	seq *int64
+}
+
+func newHistory[T any](cloneFn func(*T) *T, seq *int64) *history[T] {
+	return &history[T]{
+		revisions: make(map[string][]revision[T]),
+		cloneFn:   cloneFn,
+		seq:       seq,
+	}
+}
+
+func (h *history[T]) record(id string, validFrom time.Time, doc *T) {
+	var stored *T
+	if doc != nil {
+		stored = h.cloneFn(doc)
+	}
+	*h.seq++
+	h.revisions[id] = append(h.revisions[id], revision[T]{seq: *h.seq, validFrom: validFrom, doc: stored})
+}
+
+// sequencedChange is a revision as read by the change feed
+type sequencedChange struct {
+	seq int64
+	change.Change
+}
+
+// changesAfter returns the revisions recorded after the given sequence number as changes of the entity
+func (h *history[T]) changesAfter(seq int64, entity change.EntityType, version func(*T) int) []sequencedChange {
+	var changes []sequencedChange
+	for id, revisions := range h.revisions {
+		for _, rev := range revisions {
+			if rev.seq <= seq {
+				continue
+			}
+			c := sequencedChange{seq: rev.seq, Change: change.Change{
+				Entity: entity, ID: id, Kind: change.KindDeleted, At: rev.validFrom, Cursor: strconv.FormatInt(rev.seq, 10),
+			}}
+			if rev.doc != nil {
+				c.Version, c.Kind = version(rev.doc), change.KindUpserted
+			}
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// asOf returns a copy of the latest state recorded at or before the given time
+func (h *history[T]) asOf(id string, at time.Time) (*T, bool) {
+	var found *revision[T]
+	for i, rev := range h.revisions[id] {
+		if !rev.validFrom.After(at) && (found == nil || !rev.validFrom.Before(found.validFrom)) {
+			found = &h.revisions[id][i]
+		}
+	}
+	if found == nil || found.doc == nil {
+		return nil, false
+	}
+	return h.cloneFn(found.doc), true
+}
+
+func (h *history[T]) clone() *history[T] {
+	cloned := newHistory(h.cloneFn, h.seq)
+	for id, revisions := range h.revisions {
+		cloned.revisions[id] = slices.Clone(revisions)
+	}
+	return cloned
+}
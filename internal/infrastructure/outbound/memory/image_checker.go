@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+)
+
+// ImageChecker is an in-memory image checker standing in for the media service.
+// Every image exists unless it was removed with RemoveImages.
+type ImageChecker struct {
+	mu      sync.RWMutex
+	missing map[string]struct{}
+}
+
+// NewImageChecker creates an in-memory image checker
+func NewImageChecker() *ImageChecker {
+	return &ImageChecker{missing: make(map[string]struct{})}
+}
+
+func provideCategoryImageChecker(c *ImageChecker) category.ImageChecker {
+	return c
+}
+
+func provideAttributeImageChecker(c *ImageChecker) attribute.ImageChecker {
+	return c
+}
+
+// RemoveImages makes the given images unknown to the media service
+func (c *ImageChecker) RemoveImages(imageIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range imageIDs {
+		c.missing[id] = struct{}{}
+	}
+}
+
+func (c *ImageChecker) MissingImages(_ context.Context, imageIDs []string) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var missing []string
+	for _, id := range imageIDs {
+		if _, ok := c.missing[id]; ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type jobRepository struct {
+	store *Store
+}
+
+// NewJobRepository creates an in-memory job.Repository
+func NewJobRepository(store *Store) job.Repository {
+	return &jobRepository{store: store}
+}
+
+func (r *jobRepository) Insert(_ context.Context, j *job.Job) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.jobs.exists(j.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", j.ID)
+	}
+	r.store.jobs.put(j.ID, j)
+	return nil
+}
+
+func (r *jobRepository) Save(_ context.Context, j *job.Job) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.jobs.put(j.ID, j)
+	return nil
+}
+
+func (r *jobRepository) FindByID(_ context.Context, id string) (*job.Job, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	j, ok := r.store.jobs.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return j, nil
+}
+
+func (r *jobRepository) FindUnfinished(_ context.Context, updatedBefore time.Time, limit int) ([]*job.Job, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	unfinished := r.store.jobs.find(func(j *job.Job) bool {
+		return !j.Finished() && j.UpdatedAt.Before(updatedBefore)
+	})
+	if len(unfinished) > limit {
+		unfinished = unfinished[:limit]
+	}
+	return unfinished, nil
+}
+
+func cloneJob(j *job.Job) *job.Job {
+	cloned := *j
+	cloned.Result = slices.Clone(j.Result)
+	cloned.ItemErrors = slices.Clone(j.ItemErrors)
+	return &cloned
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+)
+
+type lease struct {
+	owner string
+	until time.Time
+}
+
+type lock struct {
+	store *Store
+}
+
+// NewLock creates an in-memory leader.Lock
+func NewLock(store *Store) leader.Lock {
+	return &lock{store: store}
+}
+
+func (l *lock) Acquire(_ context.Context, name, owner string, until time.Time) (bool, error) {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	held, ok := l.store.locks[name]
+	if ok && held.owner != owner && held.until.After(time.Now()) {
+		return false, nil
+	}
+	l.store.locks[name] = lease{owner: owner, until: until}
+	return true, nil
+}
+
+func (l *lock) Release(_ context.Context, name, owner string) error {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	if held, ok := l.store.locks[name]; ok && held.owner == owner {
+		delete(l.store.locks, name)
+	}
+	return nil
+}
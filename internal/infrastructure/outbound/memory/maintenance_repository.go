@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type maintenanceRepository struct {
+	store *Store
+}
+
+// NewMaintenanceRepository creates an in-memory maintenance.Repository
+func NewMaintenanceRepository(store *Store) maintenance.Repository {
+	return &maintenanceRepository{store: store}
+}
+
+func (r *maintenanceRepository) Find(_ context.Context) (*maintenance.Mode, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if r.store.maintenanceMode == nil {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	mode := *r.store.maintenanceMode
+	return &mode, nil
+}
+
+func (r *maintenanceRepository) Save(_ context.Context, mode *maintenance.Mode) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *mode
+	r.store.maintenanceMode = &stored
+	return nil
+}
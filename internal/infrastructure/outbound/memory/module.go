@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"go.uber.org/fx"
+)
+
+// Module provides in-memory repositories, outbox, tx manager and media service in place of the
+// MongoDB adapters, the commons outbox and the HTTP clients, so application handlers can be tested
+// without containers. The concrete *Store, *Outbox and *ImageChecker are provided as well for assertions.
+func Module() fx.Option {
+	return fx.Provide(
+		NewStore,
+		NewProductRepository,
+		NewCategoryRepository,
+		NewAttributeRepository,
+		NewReservationRepository,
+		provideReservedStock,
+		NewAvailabilityRepository,
+		NewPaletteRepository,
+		NewSupplierRepository,
+		NewCommentRepository,
+		NewSavedViewRepository,
+		NewReplayJobRepository,
+		NewJobRepository,
+		NewCronRunRepository,
+		NewLock,
+		NewNonceStore,
+		NewFeatureFlagRepository,
+		NewMaintenanceRepository,
+		NewQuotaGuard,
+		NewAPIKeyRepository,
+		NewChangeFeed,
+		NewImageChecker,
+		provideCategoryImageChecker,
+		provideAttributeImageChecker,
+		NewOutbox,
+		provideOutbox,
+		provideBatchOutbox,
+		NewTxManager,
+	)
+}
+
+func provideOutbox(o *Outbox) outbox.Outbox {
+	return o
+}
+
+func provideBatchOutbox(o *Outbox) product.BatchOutbox {
+	return o
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/samber/lo"
+)
+
+type outboxRecord struct {
+	message outbox.Message
+	sent    bool
+}
+
+// Outbox is an in-memory outbox.Outbox that records created messages.
+// Messages created inside a rolled back transaction are discarded together with the transaction.
+type Outbox struct {
+	store   *Store
+	sendErr error
+}
+
+// NewOutbox creates an in-memory outbox backed by the store
+func NewOutbox(store *Store) *Outbox {
+	return &Outbox{store: store}
+}
+
+func (o *Outbox) Create(_ context.Context, msg outbox.Message) (outbox.SendFunc, error) {
+	o.store.mu.Lock()
+	defer o.store.mu.Unlock()
+
+	record := &outboxRecord{message: msg}
+	o.store.messages = append(o.store.messages, record)
+
+	return func(context.Context) error {
+		o.store.mu.Lock()
+		defer o.store.mu.Unlock()
+
+		if o.sendErr != nil {
+			return o.sendErr
+		}
+		record.sent = true
+		return nil
+	}, nil
+}
+
+// CreateBatch records the messages unsent, as the outbox relay sends them later
+func (o *Outbox) CreateBatch(_ context.Context, msgs []outbox.Message) error {
+	o.store.mu.Lock()
+	defer o.store.mu.Unlock()
+
+	for _, msg := range msgs {
+		o.store.messages = append(o.store.messages, &outboxRecord{message: msg})
+	}
+	return nil
+}
+
+// FailSends makes every SendFunc return err until called again with nil, simulating a broker outage.
+// Messages stay in the outbox unsent, as they would until the outbox relay picks them up.
+func (o *Outbox) FailSends(err error) {
+	o.store.mu.Lock()
+	defer o.store.mu.Unlock()
+
+	o.sendErr = err
+}
+
+// Messages returns all committed outbox messages in creation order
+func (o *Outbox) Messages() []outbox.Message {
+	o.store.mu.RLock()
+	defer o.store.mu.RUnlock()
+
+	return lo.Map(o.store.messages, func(r *outboxRecord, _ int) outbox.Message {
+		return r.message
+	})
+}
+
+// SentMessages returns committed messages whose SendFunc has been called
+func (o *Outbox) SentMessages() []outbox.Message {
+	o.store.mu.RLock()
+	defer o.store.mu.RUnlock()
+
+	sent := slices.DeleteFunc(slices.Clone(o.store.messages), func(r *outboxRecord) bool {
+		return !r.sent
+	})
+	return lo.Map(sent, func(r *outboxRecord, _ int) outbox.Message {
+		return r.message
+	})
+}
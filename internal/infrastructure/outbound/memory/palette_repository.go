@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type paletteRepository struct {
+	store *Store
+}
+
+// NewPaletteRepository creates an in-memory palette.Repository
+func NewPaletteRepository(store *Store) palette.Repository {
+	return &paletteRepository{store: store}
+}
+
+func (r *paletteRepository) Insert(_ context.Context, p *palette.Palette) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.palettes.put(p.ID, p)
+	return nil
+}
+
+func (r *paletteRepository) FindByID(_ context.Context, id string) (*palette.Palette, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	p, ok := r.store.palettes.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return p, nil
+}
+
+func (r *paletteRepository) FindAll(_ context.Context) ([]*palette.Palette, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	palettes := r.store.palettes.find(nil)
+	slices.SortFunc(palettes, func(a, b *palette.Palette) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+	return palettes, nil
+}
+
+func (r *paletteRepository) Update(_ context.Context, p *palette.Palette) (*palette.Palette, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.palettes.get(p.ID)
+	if !ok || current.Version != p.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+
+	updated := clonePalette(p)
+	updated.Version++
+	r.store.palettes.put(updated.ID, updated)
+	return clonePalette(updated), nil
+}
+
+func clonePalette(p *palette.Palette) *palette.Palette {
+	cloned := *p
+	cloned.Colors = slices.Clone(p.Colors)
+	return &cloned
+}
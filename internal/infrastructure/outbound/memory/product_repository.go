@@ -0,0 +1,515 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/samber/lo"
+)
+
+var productComparators = comparators[product.Product]{
+	"_id":          func(a, b *product.Product) int { return cmp.Compare(a.ID, b.ID) },
+	"name":         func(a, b *product.Product) int { return cmp.Compare(a.Name, b.Name) },
+	"price":        func(a, b *product.Product) int { return cmp.Compare(a.Price, b.Price) },
+	"quantity":     func(a, b *product.Product) int { return cmp.Compare(a.Quantity, b.Quantity) },
+	"createdAt":    func(a, b *product.Product) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"modifiedAt":   func(a, b *product.Product) int { return a.ModifiedAt.Compare(b.ModifiedAt) },
+	"qualityScore": func(a, b *product.Product) int { return cmp.Compare(a.QualityScore, b.QualityScore) },
+	"unitsSold":    func(a, b *product.Product) int { return cmp.Compare(a.UnitsSold, b.UnitsSold) },
+	"views":        func(a, b *product.Product) int { return cmp.Compare(a.Views, b.Views) },
+}
+
+type productRepository struct {
+	store *Store
+}
+
+// NewProductRepository creates an in-memory product.Repository
+func NewProductRepository(store *Store) product.Repository {
+	return &productRepository{store: store}
+}
+
+func (r *productRepository) Insert(_ context.Context, p *product.Product) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.products.exists(p.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", p.ID)
+	}
+	if r.slugTaken(p) {
+		return product.ErrSlugAlreadyExists
+	}
+	if r.externalIDTaken(p) {
+		return product.ErrExternalIDAlreadyExists
+	}
+	r.store.products.put(p.ID, p)
+	r.store.productHistory.record(p.ID, p.ModifiedAt, p)
+	return nil
+}
+
+func (r *productRepository) FindByID(_ context.Context, id string) (*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	p, ok := r.store.products.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return p, nil
+}
+
+func (r *productRepository) FindByIDs(_ context.Context, ids []string) ([]*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	result := make([]*product.Product, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := r.store.products.get(id); ok {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (r *productRepository) FindAsOf(_ context.Context, id string, asOf time.Time) (*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	p, ok := r.store.productHistory.asOf(id, asOf)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return p, nil
+}
+
+func (r *productRepository) FindByExternalIDs(_ context.Context, externalIDs []string) ([]*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.products.find(func(p *product.Product) bool {
+		return p.ExternalID != nil && slices.Contains(externalIDs, *p.ExternalID)
+	}), nil
+}
+
+func (r *productRepository) FindBySlug(_ context.Context, slug string) (*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.products.find(func(p *product.Product) bool {
+		return slices.Contains(p.Slugs(), slug)
+	})
+	if len(docs) == 0 {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return docs[0], nil
+}
+
+// slugTaken mirrors the unique index on the current and previous slugs of all products
+func (r *productRepository) slugTaken(p *product.Product) bool {
+	slugs := p.Slugs()
+	return len(r.store.products.find(func(other *product.Product) bool {
+		return other.ID != p.ID && slices.ContainsFunc(other.Slugs(), func(s string) bool {
+			return slices.Contains(slugs, s)
+		})
+	})) > 0
+}
+
+// externalIDTaken mirrors the unique index on the external ID
+func (r *productRepository) externalIDTaken(p *product.Product) bool {
+	if p.ExternalID == nil {
+		return false
+	}
+	return len(r.store.products.find(func(other *product.Product) bool {
+		return other.ID != p.ID && other.ExternalID != nil && *other.ExternalID == *p.ExternalID
+	})) > 0
+}
+
+func (r *productRepository) FindList(_ context.Context, query product.ListQuery) (*commonsmongo.PageResult[product.Product], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := listMatcher(query)
+	docs := r.store.products.find(matches)
+	if query.IncludeArchived {
+		docs = append(docs, r.store.archive.find(matches)...)
+	}
+
+	sortDocs(docs, productComparators, query.Sort, query.Order)
+	return paginate(docs, query.Page, query.Size), nil
+}
+
+// Stream yields copies taken at the start, so the store isn't locked while the caller writes them out
+func (r *productRepository) Stream(_ context.Context, query product.ListQuery, yield func(*product.Product) error) error {
+	r.store.mu.RLock()
+	docs := r.store.products.find(listMatcher(query))
+	r.store.mu.RUnlock()
+
+	slices.SortFunc(docs, func(a, b *product.Product) int { return cmp.Compare(a.ID, b.ID) })
+	for _, p := range docs {
+		if err := yield(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *productRepository) Sample(_ context.Context, query product.ListQuery, size int) ([]*product.Product, error) {
+	r.store.mu.RLock()
+	docs := r.store.products.find(listMatcher(query))
+	r.store.mu.RUnlock()
+
+	rand.Shuffle(len(docs), func(i, j int) { docs[i], docs[j] = docs[j], docs[i] })
+	return docs[:min(size, len(docs))], nil
+}
+
+func (r *productRepository) PriceStats(_ context.Context, categoryID string) (*product.PriceStats, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	enabled := true
+	stats := &product.PriceStats{}
+	var sum float64
+	for _, p := range r.store.products.find(listMatcher(product.ListQuery{CategoryID: &categoryID, Enabled: &enabled})) {
+		if stats.Count == 0 || p.Price < stats.Min {
+			stats.Min = p.Price
+		}
+		stats.Max = max(stats.Max, p.Price)
+		sum += p.Price
+		stats.Count++
+	}
+	if stats.Count > 0 {
+		stats.Avg = sum / float64(stats.Count)
+	}
+	return stats, nil
+}
+
+func (r *productRepository) OptionCounts(_ context.Context, categoryID string, attributeIDs []string) (map[string]map[string]int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	enabled := true
+	counts := make(map[string]map[string]int64)
+	for _, p := range r.store.products.find(listMatcher(product.ListQuery{CategoryID: &categoryID, Enabled: &enabled})) {
+		carried := make(map[[2]string]bool)
+		for _, a := range p.Attributes {
+			if !slices.Contains(attributeIDs, a.AttributeID) {
+				continue
+			}
+			options := a.OptionSlugValues
+			if a.OptionSlugValue != nil {
+				options = append(slices.Clone(options), *a.OptionSlugValue)
+			}
+			for _, o := range options {
+				carried[[2]string{a.AttributeID, o}] = true
+			}
+		}
+		for key := range carried {
+			if counts[key[0]] == nil {
+				counts[key[0]] = make(map[string]int64)
+			}
+			counts[key[0]][key[1]]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *productRepository) CountByCategory(_ context.Context) (map[string]int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	for _, p := range r.store.products.find(func(p *product.Product) bool { return p.Enabled && p.CategoryID != nil }) {
+		counts[*p.CategoryID]++
+	}
+	return counts, nil
+}
+
+func (r *productRepository) CountUncategorized(_ context.Context) ([]product.UncategorizedCount, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	bySupplier := make(map[string]*product.UncategorizedCount)
+	for _, p := range r.store.products.find(func(p *product.Product) bool { return p.CategoryID == nil }) {
+		supplierID := ""
+		if p.Supplier != nil {
+			supplierID = p.Supplier.SupplierID
+		}
+		count, ok := bySupplier[supplierID]
+		if !ok {
+			count = &product.UncategorizedCount{SupplierID: supplierID}
+			bySupplier[supplierID] = count
+		}
+		count.Products++
+		if p.Enabled {
+			count.Enabled++
+		}
+	}
+
+	counts := make([]product.UncategorizedCount, 0, len(bySupplier))
+	for _, c := range bySupplier {
+		counts = append(counts, *c)
+	}
+	return counts, nil
+}
+
+func (r *productRepository) Valuation(_ context.Context, costKey string) ([]product.CategoryValuation, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byCategory := make(map[string]*product.CategoryValuation)
+	for _, p := range r.store.products.find(func(p *product.Product) bool { return !p.IsService() }) {
+		categoryID := lo.FromPtr(p.CategoryID)
+		v, ok := byCategory[categoryID]
+		if !ok {
+			v = &product.CategoryValuation{CategoryID: categoryID}
+			if costKey != "" {
+				v.CostValue = new(float64)
+			}
+			byCategory[categoryID] = v
+		}
+		v.Products++
+		v.Units += int64(p.Quantity)
+		v.StockValue += p.Price * float64(p.Quantity)
+		if costKey == "" {
+			continue
+		}
+		if cost, err := strconv.ParseFloat(p.Metadata[costKey], 64); err == nil {
+			*v.CostValue += cost * float64(p.Quantity)
+		} else {
+			v.MissingCost++
+		}
+	}
+
+	valuations := make([]product.CategoryValuation, 0, len(byCategory))
+	for _, id := range slices.Sorted(maps.Keys(byCategory)) {
+		valuations = append(valuations, *byCategory[id])
+	}
+	return valuations, nil
+}
+
+// listMatcher mirrors the filter of a list query
+func listMatcher(query product.ListQuery) func(*product.Product) bool {
+	return func(p *product.Product) bool {
+		if query.AfterID != "" && p.ID <= query.AfterID {
+			return false
+		}
+		if query.Enabled != nil && p.Enabled != *query.Enabled {
+			return false
+		}
+		if query.CategoryID != nil && (p.CategoryID == nil || *p.CategoryID != *query.CategoryID) {
+			return false
+		}
+		if query.SupplierID != nil && (p.Supplier == nil || p.Supplier.SupplierID != *query.SupplierID) {
+			return false
+		}
+		if query.HasImage != nil && (p.ImageID != nil) != *query.HasImage {
+			return false
+		}
+		if query.HasCategory != nil && (p.CategoryID != nil) != *query.HasCategory {
+			return false
+		}
+		if query.AttributeID != nil && !hasAttributeValue(p, *query.AttributeID, query.OptionSlug) {
+			return false
+		}
+		if query.ModifiedAfter != nil && !p.ModifiedAt.After(*query.ModifiedAfter) {
+			return false
+		}
+		if query.CreatedAfter != nil && !p.CreatedAt.After(*query.CreatedAfter) {
+			return false
+		}
+		if query.MinQualityScore != nil && p.QualityScore < *query.MinQualityScore {
+			return false
+		}
+		if query.MaxQualityScore != nil && p.QualityScore > *query.MaxQualityScore {
+			return false
+		}
+		return true
+	}
+}
+
+func hasAttributeValue(p *product.Product, attributeID string, optionSlug *string) bool {
+	return slices.ContainsFunc(p.Attributes, func(a product.AttributeValue) bool {
+		if a.AttributeID != attributeID {
+			return false
+		}
+		if optionSlug == nil {
+			return true
+		}
+		return (a.OptionSlugValue != nil && *a.OptionSlugValue == *optionSlug) || slices.Contains(a.OptionSlugValues, *optionSlug)
+	})
+}
+
+func (r *productRepository) Update(_ context.Context, p *product.Product) (*product.Product, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.products.get(p.ID)
+	if !ok || current.Version != p.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+	if r.slugTaken(p) {
+		return nil, product.ErrSlugAlreadyExists
+	}
+	if r.externalIDTaken(p) {
+		return nil, product.ErrExternalIDAlreadyExists
+	}
+
+	updated := cloneProduct(p)
+	updated.Version++
+	r.store.products.put(updated.ID, updated)
+	r.store.productHistory.record(updated.ID, updated.ModifiedAt, updated)
+	return cloneProduct(updated), nil
+}
+
+func (r *productRepository) Delete(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if p, ok := r.store.products.get(id); ok {
+		r.store.products.remove(id)
+		r.store.productHistory.record(id, utc.NowAfter(p.ModifiedAt), nil)
+	}
+	return nil
+}
+
+func (r *productRepository) BulkInsert(ctx context.Context, products []*product.Product) ([]error, error) {
+	errs := make([]error, len(products))
+	for i, p := range products {
+		errs[i] = r.Insert(ctx, p)
+	}
+	return errs, nil
+}
+
+func (r *productRepository) BulkUpdate(ctx context.Context, products []*product.Product) ([]error, error) {
+	errs := make([]error, len(products))
+	for i, p := range products {
+		updated, err := r.Update(ctx, p)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		p.Version = updated.Version
+	}
+	return errs, nil
+}
+
+func (r *productRepository) FindArchivable(_ context.Context, before time.Time, limit int) ([]*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.products.find(func(p *product.Product) bool {
+		return !p.Enabled && p.ModifiedAt.Before(before)
+	})
+	slices.SortStableFunc(docs, productComparators["modifiedAt"])
+	return docs[:min(limit, len(docs))], nil
+}
+
+func (r *productRepository) Archive(_ context.Context, products []*product.Product) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, p := range products {
+		current, ok := r.store.products.get(p.ID)
+		if !ok || current.Version != p.Version {
+			return commonsmongo.ErrOptimisticLocking
+		}
+	}
+
+	now := utc.Now()
+	for _, p := range products {
+		p.ArchivedAt = &now
+		r.store.archive.put(p.ID, p)
+		r.store.products.remove(p.ID)
+		r.store.productHistory.record(p.ID, now, nil)
+	}
+	return nil
+}
+
+func (r *productRepository) FindArchivedByID(_ context.Context, id string) (*product.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	p, ok := r.store.archive.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return p, nil
+}
+
+func (r *productRepository) Restore(_ context.Context, id string) (*product.Product, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	p, ok := r.store.archive.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	p.ArchivedAt = nil
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+	if r.slugTaken(p) {
+		return nil, product.ErrSlugAlreadyExists
+	}
+	if r.externalIDTaken(p) {
+		return nil, product.ErrExternalIDAlreadyExists
+	}
+
+	r.store.products.put(p.ID, p)
+	r.store.archive.remove(id)
+	r.store.productHistory.record(p.ID, p.ModifiedAt, p)
+	return p, nil
+}
+
+func (r *productRepository) RecordSales(_ context.Context, orderID string, units map[string]int64) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.salesOrders[orderID]; ok {
+		return false, nil
+	}
+	r.store.salesOrders[orderID] = struct{}{}
+	for id, n := range units {
+		if p, ok := r.store.products.get(id); ok {
+			p.UnitsSold += n
+			r.store.products.put(id, p)
+		}
+	}
+	return true, nil
+}
+
+func (r *productRepository) RecordView(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	p, ok := r.store.products.get(id)
+	if !ok {
+		return commonsmongo.ErrEntityNotFound
+	}
+	p.Views++
+	r.store.products.put(id, p)
+	return nil
+}
+
+func (r *productRepository) DecayViews(_ context.Context, factor, minViews float64) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var trending int64
+	for _, p := range r.store.products.find(func(p *product.Product) bool { return p.Views > 0 }) {
+		p.Views *= factor
+		if p.Views < minViews {
+			p.Views = 0
+		} else {
+			trending++
+		}
+		r.store.products.put(p.ID, p)
+	}
+	return trending, nil
+}
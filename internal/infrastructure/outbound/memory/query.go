@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"slices"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// comparators maps a sort field (the BSON field name used by the Mongo adapter) to a compare function
+type comparators[T any] map[string]func(a, b *T) int
+
+// sortDocs sorts documents by the given field; unknown fields keep insertion order like Mongo does
+func sortDocs[T any](docs []*T, cmps comparators[T], field, order string) {
+	cmp, ok := cmps[field]
+	if field == "" || !ok {
+		return
+	}
+	slices.SortStableFunc(docs, func(a, b *T) int {
+		if order == "desc" {
+			return cmp(b, a)
+		}
+		return cmp(a, b)
+	})
+}
+
+// paginate applies the same defaults and page math as commonsmongo.GenericRepository.FindWithOptions
+func paginate[T any](docs []*T, page, size int) *commonsmongo.PageResult[T] {
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 10
+	}
+
+	total := len(docs)
+	start := min((page-1)*size, total)
+	end := min(start+size, total)
+
+	totalPages := total / size
+	if total%size != 0 {
+		totalPages++
+	}
+
+	return &commonsmongo.PageResult[T]{
+		Items:      docs[start:end],
+		Total:      int64(total),
+		Page:       page,
+		Size:       size,
+		TotalPages: totalPages,
+	}
+}
@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+)
+
+type quotaGuard struct{}
+
+// NewQuotaGuard creates an in-memory quota.Guard. It has nothing to lock: the in-memory transactions
+// already run one at a time.
+func NewQuotaGuard() quota.Guard {
+	return quotaGuard{}
+}
+
+func (quotaGuard) Lock(context.Context, quota.Resource) error {
+	return nil
+}
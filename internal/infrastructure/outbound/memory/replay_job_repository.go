@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type replayJobRepository struct {
+	store *Store
+}
+
+// NewReplayJobRepository creates an in-memory replay.Repository
+func NewReplayJobRepository(store *Store) replay.Repository {
+	return &replayJobRepository{store: store}
+}
+
+func (r *replayJobRepository) Begin(_ context.Context, job *replay.Status, staleBefore time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if latest := r.store.replayJob; latest != nil && latest.Running && !latest.UpdatedAt.Before(staleBefore) {
+		return replay.ErrReplayInProgress
+	}
+	r.store.replayJob = cloneReplayJob(job)
+	return nil
+}
+
+func (r *replayJobRepository) Save(_ context.Context, job *replay.Status) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.replayJob == nil || r.store.replayJob.ID != job.ID {
+		return replay.ErrReplaySuperseded
+	}
+	r.store.replayJob = cloneReplayJob(job)
+	return nil
+}
+
+func (r *replayJobRepository) FindLatest(_ context.Context) (*replay.Status, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if r.store.replayJob == nil {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return cloneReplayJob(r.store.replayJob), nil
+}
+
+func cloneReplayJob(s *replay.Status) *replay.Status {
+	cloned := *s
+	cloned.Progress = slices.Clone(s.Progress)
+	return &cloned
+}
@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestProductRepository_InsertAndFind(t *testing.T) {
+	repo := NewProductRepository(NewStore())
+	ctx := context.Background()
+
+	p, err := product.NewProduct(limits.Default().Product, "Phone", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, []product.AttributeValue{
+		{AttributeID: "attr-1", OptionSlugValues: []string{"a", "b"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.Insert(ctx, p))
+
+	// Mutating the original must not leak into the store
+	p.Name = "Changed"
+	p.Attributes[0].OptionSlugValues[0] = "changed"
+
+	found, err := repo.FindByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", found.Name)
+	assert.Equal(t, []string{"a", "b"}, found.Attributes[0].OptionSlugValues)
+
+	err = repo.Insert(ctx, p)
+	require.Error(t, err)
+
+	_, err = repo.FindByID(ctx, "missing")
+	assert.ErrorIs(t, err, commonsmongo.ErrEntityNotFound)
+}
+
+func TestProductRepository_Update_OptimisticLocking(t *testing.T) {
+	repo := NewProductRepository(NewStore())
+	ctx := context.Background()
+
+	p, err := product.NewProduct(limits.Default().Product, "Phone", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Insert(ctx, p))
+
+	updated, err := repo.Update(ctx, p)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, 1, p.Version, "input must not be mutated")
+
+	_, err = repo.Update(ctx, p)
+	assert.ErrorIs(t, err, commonsmongo.ErrOptimisticLocking)
+
+	missing := product.Reconstruct("missing", 1, "x", "", nil, product.ProductTypePhysical, nil, 0, 0, nil, nil, false, nil, nil, nil, nil, p.CreatedAt, p.ModifiedAt)
+	_, err = repo.Update(ctx, missing)
+	assert.ErrorIs(t, err, commonsmongo.ErrOptimisticLocking)
+}
+
+func TestProductRepository_FindList(t *testing.T) {
+	repo := NewProductRepository(NewStore())
+	ctx := context.Background()
+
+	for i, name := range []string{"c", "a", "b"} {
+		p, err := product.NewProduct(limits.Default().Product, name, "", product.ProductTypePhysical, nil, float64(i), 1, nil, ptr("cat-1"), false, nil)
+		require.NoError(t, err)
+		require.NoError(t, repo.Insert(ctx, p))
+	}
+	other, err := product.NewProduct(limits.Default().Product, "d", "", product.ProductTypePhysical, nil, 1, 1, nil, ptr("cat-2"), false, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Insert(ctx, other))
+
+	result, err := repo.FindList(ctx, product.ListQuery{Page: 1, Size: 2, CategoryID: ptr("cat-1"), Sort: "name", Order: "desc"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Total)
+	assert.Equal(t, 2, result.TotalPages)
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, "c", result.Items[0].Name)
+	assert.Equal(t, "b", result.Items[1].Name)
+
+	result, err = repo.FindList(ctx, product.ListQuery{Page: 3, Size: 2})
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+}
+
+func TestProductRepository_FindList_AfterID(t *testing.T) {
+	repo := NewProductRepository(NewStore())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	for _, id := range []string{"p-3", "p-1", "p-4", "p-2"} {
+		require.NoError(t, repo.Insert(ctx, product.Reconstruct(id, 1, id, "", nil, product.ProductTypePhysical, nil, 1, 1, nil, nil, false, nil, nil, nil, nil, now, now)))
+	}
+
+	first, err := repo.FindList(ctx, product.ListQuery{Size: 2, Sort: "_id"})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "p-2", first.Items[1].ID)
+
+	// A delete behind the cursor doesn't shift the next page
+	require.NoError(t, repo.Delete(ctx, "p-1"))
+
+	next, err := repo.FindList(ctx, product.ListQuery{AfterID: "p-2", Size: 2, Sort: "_id"})
+	require.NoError(t, err)
+	require.Len(t, next.Items, 2)
+	assert.Equal(t, "p-3", next.Items[0].ID)
+	assert.Equal(t, "p-4", next.Items[1].ID)
+}
+
+func TestCategoryRepository_Exists(t *testing.T) {
+	repo := NewCategoryRepository(NewStore())
+	ctx := context.Background()
+
+	c, err := category.NewCategory(limits.Default().Category, "Phones", true, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Insert(ctx, c))
+
+	exists, err := repo.Exists(ctx, c.ID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = repo.Exists(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAttributeRepository_UniqueSlug(t *testing.T) {
+	repo := NewAttributeRepository(NewStore())
+	ctx := context.Background()
+
+	first, err := attribute.NewAttribute(limits.Default().Attribute, "", "Color", "color", attribute.AttributeTypeSingle, nil, true, nil)
+	require.NoError(t, err)
+	second, err := attribute.NewAttribute(limits.Default().Attribute, "", "Colour", "color", attribute.AttributeTypeSingle, nil, true, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Insert(ctx, first))
+	assert.ErrorIs(t, repo.Insert(ctx, second), attribute.ErrSlugAlreadyExists)
+}
+
+func TestAttributeRepository_FindByIDsOrFail(t *testing.T) {
+	repo := NewAttributeRepository(NewStore())
+	ctx := context.Background()
+
+	a, err := attribute.NewAttribute(limits.Default().Attribute, "", "Color", "color", attribute.AttributeTypeSingle, nil, true, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Insert(ctx, a))
+
+	found, err := repo.FindByIDsOrFail(ctx, []string{a.ID})
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	_, err = repo.FindByIDsOrFail(ctx, []string{a.ID, "missing"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "attribute not found: missing")
+}
+
+func TestTxManager_RollsBackOnError(t *testing.T) {
+	store := NewStore()
+	repo := NewProductRepository(store)
+	ob := NewOutbox(store)
+	tx := NewTxManager(store)
+	ctx := context.Background()
+
+	p, err := product.NewProduct(limits.Default().Product, "Phone", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+
+	errBoom := errors.New("boom")
+	_, err = commonsmongo.WithTransaction(ctx, tx, func(txCtx context.Context) (any, error) {
+		require.NoError(t, repo.Insert(txCtx, p))
+		_, err := ob.Create(txCtx, outbox.Message{Key: p.ID})
+		require.NoError(t, err)
+		return nil, errBoom
+	})
+	require.ErrorIs(t, err, errBoom)
+
+	_, err = repo.FindByID(ctx, p.ID)
+	assert.ErrorIs(t, err, commonsmongo.ErrEntityNotFound)
+	assert.Empty(t, ob.Messages())
+}
+
+func TestOutbox_SentMessages(t *testing.T) {
+	ob := NewOutbox(NewStore())
+	ctx := context.Background()
+
+	send, err := ob.Create(ctx, outbox.Message{Key: "first"})
+	require.NoError(t, err)
+	_, err = ob.Create(ctx, outbox.Message{Key: "second"})
+	require.NoError(t, err)
+
+	require.NoError(t, send(ctx))
+
+	assert.Len(t, ob.Messages(), 2)
+	sent := ob.SentMessages()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "first", sent[0].Key)
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type reservationRepository struct {
+	store *Store
+}
+
+// NewReservationRepository creates an in-memory reservation.Repository.
+// Expired reservations are ignored by reads until they are removed through DeleteExpired.
+func NewReservationRepository(store *Store) reservation.Repository {
+	return &reservationRepository{store: store}
+}
+
+func provideReservedStock(repo reservation.Repository) product.ReservedStock {
+	return repo
+}
+
+func (r *reservationRepository) Insert(_ context.Context, res *reservation.Reservation) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.reservations.exists(res.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", res.ID)
+	}
+	r.store.reservations.put(res.ID, res)
+	return nil
+}
+
+func (r *reservationRepository) FindByID(_ context.Context, id string) (*reservation.Reservation, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	res, ok := r.store.reservations.get(id)
+	if !ok || res.IsExpired(time.Now()) {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return res, nil
+}
+
+func (r *reservationRepository) Delete(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.reservations.remove(id)
+	return nil
+}
+
+func (r *reservationRepository) FindExpired(_ context.Context, now time.Time, limit int) ([]*reservation.Reservation, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	expired := r.store.reservations.find(func(res *reservation.Reservation) bool {
+		return res.IsExpired(now)
+	})
+	slices.SortStableFunc(expired, func(a, b *reservation.Reservation) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return expired, nil
+}
+
+func (r *reservationRepository) DeleteExpired(_ context.Context, id string, now time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	res, ok := r.store.reservations.get(id)
+	if !ok || !res.IsExpired(now) {
+		return false, nil
+	}
+	r.store.reservations.remove(id)
+	return true, nil
+}
+
+// LockProduct is a no-op: the in-memory tx manager already runs transactions one at a time
+func (r *reservationRepository) LockProduct(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *reservationRepository) ReservedQuantities(_ context.Context, productIDs []string) (map[string]int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	ids := make(map[string]struct{}, len(productIDs))
+	for _, id := range productIDs {
+		ids[id] = struct{}{}
+	}
+
+	now := time.Now()
+	reserved := make(map[string]int)
+	for _, res := range r.store.reservations.find(nil) {
+		if _, ok := ids[res.ProductID]; ok && !res.IsExpired(now) {
+			reserved[res.ProductID] += res.Quantity
+		}
+	}
+	return reserved, nil
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var savedViewComparators = comparators[savedview.SavedView]{
+	"name": func(a, b *savedview.SavedView) int { return cmp.Compare(a.Name, b.Name) },
+}
+
+type savedViewRepository struct {
+	store *Store
+}
+
+// NewSavedViewRepository creates an in-memory savedview.Repository
+func NewSavedViewRepository(store *Store) savedview.Repository {
+	return &savedViewRepository{store: store}
+}
+
+func (r *savedViewRepository) Insert(_ context.Context, v *savedview.SavedView) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.savedViews.exists(v.ID) {
+		return fmt.Errorf("failed to insert entity: duplicate id %s", v.ID)
+	}
+	r.store.savedViews.put(v.ID, v)
+	return nil
+}
+
+func (r *savedViewRepository) FindByID(_ context.Context, id string) (*savedview.SavedView, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	v, ok := r.store.savedViews.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return v, nil
+}
+
+func (r *savedViewRepository) FindList(_ context.Context, query savedview.ListQuery) (*commonsmongo.PageResult[savedview.SavedView], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.savedViews.find(func(v *savedview.SavedView) bool {
+		if query.Owner != nil && v.Owner != *query.Owner {
+			return false
+		}
+		if query.EntityType != nil && v.EntityType != *query.EntityType {
+			return false
+		}
+		return true
+	})
+	sortDocs(docs, savedViewComparators, "name", "asc")
+	return paginate(docs, query.Page, query.Size), nil
+}
+
+func (r *savedViewRepository) Update(_ context.Context, v *savedview.SavedView) (*savedview.SavedView, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.savedViews.get(v.ID)
+	if !ok || current.Version != v.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+
+	updated := cloneSavedView(v)
+	updated.Version++
+	r.store.savedViews.put(updated.ID, updated)
+	return cloneSavedView(updated), nil
+}
+
+func (r *savedViewRepository) ReplaceOwner(_ context.Context, owner, replacement string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := utc.Now()
+	docs := r.store.savedViews.find(func(v *savedview.SavedView) bool { return v.Owner == owner })
+	for _, v := range docs {
+		v.Owner = replacement
+		v.Version++
+		v.ModifiedAt = now
+		r.store.savedViews.put(v.ID, v)
+	}
+	return int64(len(docs)), nil
+}
+
+func (r *savedViewRepository) Delete(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.savedViews.remove(id)
+	return nil
+}
+
+func cloneSavedView(v *savedview.SavedView) *savedview.SavedView {
+	cloned := *v
+	cloned.Filter = maps.Clone(v.Filter)
+	return &cloned
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+)
+
+type nonceStore struct {
+	store *Store
+}
+
+// NewNonceStore creates an in-memory signing.NonceStore
+func NewNonceStore(store *Store) signing.NonceStore {
+	return &nonceStore{store: store}
+}
+
+func (n *nonceStore) Remember(_ context.Context, key string, expiresAt time.Time) (bool, error) {
+	n.store.mu.Lock()
+	defer n.store.mu.Unlock()
+
+	now := time.Now()
+	if expires, ok := n.store.nonces[key]; ok && now.Before(expires) {
+		return false, nil
+	}
+	// Expired nonces are dropped as new ones come in, so the map doesn't outgrow the tolerance
+	for k, expires := range n.store.nonces {
+		if !now.Before(expires) {
+			delete(n.store.nonces, k)
+		}
+	}
+	n.store.nonces[key] = expiresAt
+	return true, nil
+}
@@ -0,0 +1,284 @@
+package memory
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+)
+
+// Store holds the state shared by the in-memory repositories, outbox and tx manager.
+// All documents are copied on the way in and out, so callers never share memory with the store.
+type Store struct {
+	mu   sync.RWMutex
+	txMu sync.Mutex
+
+	products     *collection[product.Product]
+	archive      *collection[product.Product]
+	categories   *collection[category.Category]
+	attributes   *collection[attribute.Attribute]
+	reservations *collection[reservation.Reservation]
+	schedules    *collection[availability.Schedule]
+	palettes     *collection[palette.Palette]
+	suppliers    *collection[supplier.Supplier]
+	comments     *collection[comment.Comment]
+	savedViews   *collection[savedview.SavedView]
+	apiKeys      *collection[apikey.APIKey]
+	messages     []*outboxRecord
+
+	productHistory  *history[product.Product]
+	categoryHistory *history[category.Category]
+	// revisionSeq numbers the revisions of both histories; it isn't rolled back, so it never repeats
+	revisionSeq int64
+
+	// replayJob is the latest replay; it is written outside of transactions and kept on rollback
+	replayJob *replay.Status
+	// jobs are saved by the workers outside of transactions and kept on rollback as well
+	jobs *collection[job.Job]
+	// cronRuns and locks are shared by all tenants and written outside of transactions too
+	cronRuns *collection[cron.Run]
+	locks    map[string]lease
+	// nonces are the signature nonces by integration and nonce, with their expiry
+	nonces map[string]time.Time
+	// featureFlags are set by operators and apply to all tenants
+	featureFlags map[feature.Flag]feature.Setting
+	// maintenanceMode is nil until the mode is set for the first time
+	maintenanceMode *maintenance.Mode
+	// salesOrders are the orders whose sales were counted, recorded with the counts in one step
+	salesOrders map[string]struct{}
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	s := &Store{
+		products:     newCollection(cloneProduct),
+		archive:      newCollection(cloneProduct),
+		categories:   newCollection(cloneCategory),
+		attributes:   newCollection(cloneAttribute),
+		reservations: newCollection(cloneReservation),
+		schedules:    newCollection(cloneSchedule),
+		palettes:     newCollection(clonePalette),
+		suppliers:    newCollection(cloneSupplier),
+		comments:     newCollection(cloneComment),
+		savedViews:   newCollection(cloneSavedView),
+		apiKeys:      newCollection(cloneAPIKey),
+
+		jobs:     newCollection(cloneJob),
+		cronRuns: newCollection(cloneCronRun),
+		locks:    make(map[string]lease),
+		nonces:   make(map[string]time.Time),
+
+		featureFlags: make(map[feature.Flag]feature.Setting),
+		salesOrders:  make(map[string]struct{}),
+	}
+	s.productHistory = newHistory(cloneProduct, &s.revisionSeq)
+	s.categoryHistory = newHistory(cloneCategory, &s.revisionSeq)
+	return s
+}
+
+// Reset removes all documents and outbox messages
+func (s *Store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.products = newCollection(cloneProduct)
+	s.archive = newCollection(cloneProduct)
+	s.categories = newCollection(cloneCategory)
+	s.attributes = newCollection(cloneAttribute)
+	s.reservations = newCollection(cloneReservation)
+	s.schedules = newCollection(cloneSchedule)
+	s.palettes = newCollection(clonePalette)
+	s.suppliers = newCollection(cloneSupplier)
+	s.comments = newCollection(cloneComment)
+	s.savedViews = newCollection(cloneSavedView)
+	s.apiKeys = newCollection(cloneAPIKey)
+	s.messages = nil
+	s.productHistory = newHistory(cloneProduct, &s.revisionSeq)
+	s.categoryHistory = newHistory(cloneCategory, &s.revisionSeq)
+	s.replayJob = nil
+	s.jobs = newCollection(cloneJob)
+	s.cronRuns = newCollection(cloneCronRun)
+	s.locks = make(map[string]lease)
+	s.nonces = make(map[string]time.Time)
+	s.featureFlags = make(map[feature.Flag]feature.Setting)
+	s.maintenanceMode = nil
+	s.salesOrders = make(map[string]struct{})
+}
+
+type snapshot struct {
+	products     *collection[product.Product]
+	archive      *collection[product.Product]
+	categories   *collection[category.Category]
+	attributes   *collection[attribute.Attribute]
+	reservations *collection[reservation.Reservation]
+	schedules    *collection[availability.Schedule]
+	palettes     *collection[palette.Palette]
+	suppliers    *collection[supplier.Supplier]
+	comments     *collection[comment.Comment]
+	savedViews   *collection[savedview.SavedView]
+	apiKeys      *collection[apikey.APIKey]
+	messages     []*outboxRecord
+
+	productHistory  *history[product.Product]
+	categoryHistory *history[category.Category]
+}
+
+func (s *Store) snapshot() snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return snapshot{
+		products:     s.products.clone(),
+		archive:      s.archive.clone(),
+		categories:   s.categories.clone(),
+		attributes:   s.attributes.clone(),
+		reservations: s.reservations.clone(),
+		schedules:    s.schedules.clone(),
+		palettes:     s.palettes.clone(),
+		suppliers:    s.suppliers.clone(),
+		comments:     s.comments.clone(),
+		savedViews:   s.savedViews.clone(),
+		apiKeys:      s.apiKeys.clone(),
+		messages:     slices.Clone(s.messages),
+
+		productHistory:  s.productHistory.clone(),
+		categoryHistory: s.categoryHistory.clone(),
+	}
+}
+
+func (s *Store) restore(snap snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.products = snap.products
+	s.archive = snap.archive
+	s.categories = snap.categories
+	s.attributes = snap.attributes
+	s.reservations = snap.reservations
+	s.schedules = snap.schedules
+	s.palettes = snap.palettes
+	s.suppliers = snap.suppliers
+	s.comments = snap.comments
+	s.savedViews = snap.savedViews
+	s.apiKeys = snap.apiKeys
+	s.messages = snap.messages
+	s.productHistory = snap.productHistory
+	s.categoryHistory = snap.categoryHistory
+}
+
+// collection is an insertion-ordered set of documents keyed by ID
+type collection[T any] struct {
+	docs    map[string]*T
+	order   []string
+	cloneFn func(*T) *T
+}
+
+func newCollection[T any](cloneFn func(*T) *T) *collection[T] {
+	return &collection[T]{
+		docs:    make(map[string]*T),
+		cloneFn: cloneFn,
+	}
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	doc, ok := c.docs[id]
+	if !ok {
+		return nil, false
+	}
+	return c.cloneFn(doc), true
+}
+
+func (c *collection[T]) exists(id string) bool {
+	_, ok := c.docs[id]
+	return ok
+}
+
+func (c *collection[T]) put(id string, doc *T) {
+	if _, ok := c.docs[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.docs[id] = c.cloneFn(doc)
+}
+
+func (c *collection[T]) remove(id string) {
+	if _, ok := c.docs[id]; !ok {
+		return
+	}
+	delete(c.docs, id)
+	c.order = slices.DeleteFunc(c.order, func(existing string) bool {
+		return existing == id
+	})
+}
+
+// find returns copies of all documents matching the filter in insertion order
+func (c *collection[T]) find(filter func(*T) bool) []*T {
+	result := make([]*T, 0, len(c.order))
+	for _, id := range c.order {
+		doc := c.docs[id]
+		if filter == nil || filter(doc) {
+			result = append(result, c.cloneFn(doc))
+		}
+	}
+	return result
+}
+
+func (c *collection[T]) clone() *collection[T] {
+	cloned := newCollection(c.cloneFn)
+	for _, id := range c.order {
+		cloned.put(id, c.docs[id])
+	}
+	return cloned
+}
+
+func cloneProduct(p *product.Product) *product.Product {
+	cloned := *p
+	cloned.SlugHistory = slices.Clone(p.SlugHistory)
+	cloned.Metadata = maps.Clone(p.Metadata)
+	cloned.Experiments = maps.Clone(p.Experiments)
+	if p.Supplier != nil {
+		supplier := *p.Supplier
+		cloned.Supplier = &supplier
+	}
+	if p.Attributes != nil {
+		cloned.Attributes = make([]product.AttributeValue, len(p.Attributes))
+		for i, a := range p.Attributes {
+			a.OptionSlugValues = slices.Clone(a.OptionSlugValues)
+			cloned.Attributes[i] = a
+		}
+	}
+	return &cloned
+}
+
+func cloneCategory(c *category.Category) *category.Category {
+	cloned := *c
+	cloned.Attributes = slices.Clone(c.Attributes)
+	return &cloned
+}
+
+func cloneAttribute(a *attribute.Attribute) *attribute.Attribute {
+	cloned := *a
+	cloned.Options = slices.Clone(a.Options)
+	cloned.InputUnits = slices.Clone(a.InputUnits)
+	return &cloned
+}
+
+func cloneReservation(r *reservation.Reservation) *reservation.Reservation {
+	cloned := *r
+	return &cloned
+}
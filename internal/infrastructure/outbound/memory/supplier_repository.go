@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+var supplierComparators = comparators[supplier.Supplier]{
+	"_id":          func(a, b *supplier.Supplier) int { return cmp.Compare(a.ID, b.ID) },
+	"name":         func(a, b *supplier.Supplier) int { return cmp.Compare(a.Name, b.Name) },
+	"code":         func(a, b *supplier.Supplier) int { return cmp.Compare(a.Code, b.Code) },
+	"leadTimeDays": func(a, b *supplier.Supplier) int { return cmp.Compare(a.LeadTimeDays, b.LeadTimeDays) },
+	"createdAt":    func(a, b *supplier.Supplier) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"modifiedAt":   func(a, b *supplier.Supplier) int { return a.ModifiedAt.Compare(b.ModifiedAt) },
+}
+
+type supplierRepository struct {
+	store *Store
+}
+
+// NewSupplierRepository creates an in-memory supplier.Repository.
+// The unique code index of the Mongo adapter is emulated on Insert and Update.
+func NewSupplierRepository(store *Store) supplier.Repository {
+	return &supplierRepository{store: store}
+}
+
+func (r *supplierRepository) Insert(_ context.Context, s *supplier.Supplier) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.suppliers.exists(s.ID) || r.codeTaken(s.Code, s.ID) {
+		return supplier.ErrCodeAlreadyExists
+	}
+	r.store.suppliers.put(s.ID, s)
+	return nil
+}
+
+func (r *supplierRepository) FindByID(_ context.Context, id string) (*supplier.Supplier, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	s, ok := r.store.suppliers.get(id)
+	if !ok {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return s, nil
+}
+
+func (r *supplierRepository) FindList(_ context.Context, query supplier.ListQuery) (*commonsmongo.PageResult[supplier.Supplier], error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	docs := r.store.suppliers.find(func(s *supplier.Supplier) bool {
+		return query.ModifiedAfter == nil || s.ModifiedAt.After(*query.ModifiedAfter)
+	})
+	sortDocs(docs, supplierComparators, query.Sort, query.Order)
+	return paginate(docs, query.Page, query.Size), nil
+}
+
+func (r *supplierRepository) Update(_ context.Context, s *supplier.Supplier) (*supplier.Supplier, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	current, ok := r.store.suppliers.get(s.ID)
+	if !ok || current.Version != s.Version {
+		return nil, commonsmongo.ErrOptimisticLocking
+	}
+	if r.codeTaken(s.Code, s.ID) {
+		return nil, supplier.ErrCodeAlreadyExists
+	}
+
+	updated := cloneSupplier(s)
+	updated.Version++
+	r.store.suppliers.put(updated.ID, updated)
+	return cloneSupplier(updated), nil
+}
+
+func (r *supplierRepository) Delete(_ context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.suppliers.remove(id)
+	return nil
+}
+
+func (r *supplierRepository) Exists(_ context.Context, id string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.suppliers.exists(id), nil
+}
+
+// codeTaken reports whether another supplier already uses the code; callers must hold the store lock
+func (r *supplierRepository) codeTaken(code, exceptID string) bool {
+	return len(r.store.suppliers.find(func(s *supplier.Supplier) bool {
+		return s.Code == code && s.ID != exceptID
+	})) > 0
+}
+
+func cloneSupplier(s *supplier.Supplier) *supplier.Supplier {
+	cloned := *s
+	return &cloned
+}
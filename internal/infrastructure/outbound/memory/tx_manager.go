@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/aftercommit"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type txManager struct {
+	store *Store
+}
+
+// NewTxManager creates a tx manager that serializes transactions and restores
+// the store snapshot when the transaction function fails. The work deferred to the commit runs once the
+// transaction function succeeds.
+func NewTxManager(store *Store) commonsmongo.TxManager {
+	return aftercommit.Decorate(&txManager{store: store})
+}
+
+func (t *txManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	t.store.txMu.Lock()
+	defer t.store.txMu.Unlock()
+
+	snap := t.store.snapshot()
+
+	result, err := fn(ctx)
+	if err != nil {
+		t.store.restore(snap)
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return result, nil
+}
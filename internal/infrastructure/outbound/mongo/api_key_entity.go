@@ -0,0 +1,18 @@
+package mongo
+
+import (
+	"time"
+)
+
+// apiKeyEntity represents the MongoDB document structure.
+// Only the hash of the secret is stored, the key itself is shown once.
+type apiKeyEntity struct {
+	ID          string     `bson:"_id"`
+	Version     int        `bson:"version"`
+	Tenant      string     `bson:"tenant"`
+	Name        string     `bson:"name"`
+	Permissions []string   `bson:"permissions"`
+	SecretHash  string     `bson:"secretHash"`
+	CreatedAt   time.Time  `bson:"createdAt"`
+	ExpiresAt   *time.Time `bson:"expiresAt,omitempty"`
+}
@@ -0,0 +1,54 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+)
+
+type apiKeyMapper struct{}
+
+func newAPIKeyMapper() *apiKeyMapper {
+	return &apiKeyMapper{}
+}
+
+func (m *apiKeyMapper) ToEntity(k *apikey.APIKey) *apiKeyEntity {
+	return &apiKeyEntity{
+		ID:          k.ID,
+		Version:     k.Version,
+		Tenant:      k.Tenant,
+		Name:        k.Name,
+		Permissions: k.Permissions,
+		SecretHash:  k.SecretHash,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+}
+
+func (m *apiKeyMapper) ToDomain(e *apiKeyEntity) *apikey.APIKey {
+	expiresAt := e.ExpiresAt
+	if expiresAt != nil {
+		t := expiresAt.UTC()
+		expiresAt = &t
+	}
+	return apikey.Reconstruct(
+		e.ID,
+		e.Version,
+		e.Tenant,
+		e.Name,
+		e.Permissions,
+		e.SecretHash,
+		e.CreatedAt.UTC(),
+		expiresAt,
+	)
+}
+
+func (m *apiKeyMapper) GetID(e *apiKeyEntity) string {
+	return e.ID
+}
+
+func (m *apiKeyMapper) GetVersion(e *apiKeyEntity) int {
+	return e.Version
+}
+
+func (m *apiKeyMapper) SetVersion(e *apiKeyEntity, version int) {
+	e.Version = version
+}
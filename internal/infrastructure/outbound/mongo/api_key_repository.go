@@ -0,0 +1,36 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const apiKeyCollection = "api_key"
+
+type apiKeyRepository struct {
+	*commonsmongo.GenericRepository[apikey.APIKey, apiKeyEntity]
+}
+
+// newAPIKeyRepository stores the keys in the service database rather than the tenant ones:
+// a key is looked up before the tenant of the request is known, the key tells it
+func newAPIKeyRepository(m commonsmongo.Mongo, mapper *apiKeyMapper) (apikey.Repository, error) {
+	genericRepo, err := commonsmongo.NewGenericRepository(m, apiKeyCollection, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *apiKeyRepository) FindByTenant(ctx context.Context, tenant string) ([]*apikey.APIKey, error) {
+	return r.FindAllWithFilter(ctx,
+		bson.D{{Key: "tenant", Value: tenant}},
+		bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}},
+	)
+}
@@ -9,19 +9,32 @@ type optionEntity struct {
 	Name      string  `bson:"name"`
 	Slug      string  `bson:"slug"`
 	ColorCode *string `bson:"colorCode,omitempty"`
+	ImageID   *string `bson:"imageId,omitempty"`
 	SortOrder int     `bson:"sortOrder"`
+	// Deprecation fields are missing on options that were never deprecated
+	Deprecated      bool    `bson:"deprecated,omitempty"`
+	ReplacementSlug *string `bson:"replacementSlug,omitempty"`
+}
+
+// unitConversionEntity represents an embedded input unit of an attribute in MongoDB
+type unitConversionEntity struct {
+	Unit   string  `bson:"unit"`
+	Factor float64 `bson:"factor"`
 }
 
 // attributeEntity represents the MongoDB document structure
 type attributeEntity struct {
-	ID         string         `bson:"_id"`
-	Version    int            `bson:"version"`
-	Name       string         `bson:"name"`
-	Slug       string         `bson:"slug"`
-	Type       string         `bson:"type"`
-	Unit       *string        `bson:"unit,omitempty"`
-	Enabled    bool           `bson:"enabled"`
-	Options    []optionEntity `bson:"options,omitempty"`
-	CreatedAt  time.Time      `bson:"createdAt"`
-	ModifiedAt time.Time      `bson:"modifiedAt"`
+	ID          string                 `bson:"_id"`
+	Version     int                    `bson:"version"`
+	Name        string                 `bson:"name"`
+	Slug        string                 `bson:"slug"`
+	Type        string                 `bson:"type"`
+	DisplayType string                 `bson:"displayType,omitempty"`
+	PaletteID   *string                `bson:"paletteId,omitempty"`
+	Unit        *string                `bson:"unit,omitempty"`
+	InputUnits  []unitConversionEntity `bson:"inputUnits,omitempty"`
+	Enabled     bool                   `bson:"enabled"`
+	Options     []optionEntity         `bson:"options,omitempty"`
+	CreatedAt   time.Time              `bson:"createdAt"`
+	ModifiedAt  time.Time              `bson:"modifiedAt"`
 }
@@ -18,21 +18,28 @@ func (m *attributeMapper) ToEntity(a *attribute.Attribute) *attributeEntity {
 			Name:      opt.Name,
 			Slug:      opt.Slug,
 			ColorCode: opt.ColorCode,
+			ImageID:   opt.ImageID,
 			SortOrder: opt.SortOrder,
+
+			Deprecated:      opt.Deprecated,
+			ReplacementSlug: opt.ReplacementSlug,
 		}
 	})
 
 	return &attributeEntity{
-		ID:         a.ID,
-		Version:    a.Version,
-		Name:       a.Name,
-		Slug:       a.Slug,
-		Type:       string(a.Type),
-		Unit:       a.Unit,
-		Enabled:    a.Enabled,
-		Options:    options,
-		CreatedAt:  a.CreatedAt,
-		ModifiedAt: a.ModifiedAt,
+		ID:          a.ID,
+		Version:     a.Version,
+		Name:        a.Name,
+		Slug:        a.Slug,
+		Type:        string(a.Type),
+		DisplayType: string(a.DisplayType),
+		PaletteID:   a.PaletteID,
+		Unit:        a.Unit,
+		InputUnits:  lo.Map(a.InputUnits, func(c attribute.UnitConversion, _ int) unitConversionEntity { return unitConversionEntity(c) }),
+		Enabled:     a.Enabled,
+		Options:     options,
+		CreatedAt:   a.CreatedAt,
+		ModifiedAt:  a.ModifiedAt,
 	}
 }
 
@@ -42,7 +49,11 @@ func (m *attributeMapper) ToDomain(e *attributeEntity) *attribute.Attribute {
 			Name:      opt.Name,
 			Slug:      opt.Slug,
 			ColorCode: opt.ColorCode,
+			ImageID:   opt.ImageID,
 			SortOrder: opt.SortOrder,
+
+			Deprecated:      opt.Deprecated,
+			ReplacementSlug: opt.ReplacementSlug,
 		}
 	})
 
@@ -55,6 +66,9 @@ func (m *attributeMapper) ToDomain(e *attributeEntity) *attribute.Attribute {
 		e.Unit,
 		e.Enabled,
 		options,
+		attribute.DisplayType(e.DisplayType),
+		e.PaletteID,
+		lo.Map(e.InputUnits, func(c unitConversionEntity, _ int) attribute.UnitConversion { return attribute.UnitConversion(c) }),
 		e.CreatedAt.UTC(),
 		e.ModifiedAt.UTC(),
 	)
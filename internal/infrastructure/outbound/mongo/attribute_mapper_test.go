@@ -31,6 +31,9 @@ func TestAttributeMapper_ToEntity(t *testing.T) {
 				{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000"), SortOrder: 1},
 				{Name: "Blue", Slug: "blue", ColorCode: ptr("#0000FF"), SortOrder: 2},
 			},
+			"",
+			nil,
+			nil,
 			now,
 			now,
 		)
@@ -68,6 +71,9 @@ func TestAttributeMapper_ToEntity(t *testing.T) {
 			ptr("kg"),
 			false,
 			nil,
+			"",
+			nil,
+			nil,
 			now,
 			now,
 		)
@@ -91,6 +97,9 @@ func TestAttributeMapper_ToEntity(t *testing.T) {
 			nil,
 			true,
 			nil,
+			"",
+			nil,
+			nil,
 			now,
 			now,
 		)
@@ -133,6 +142,7 @@ func TestAttributeMapper_ToDomain(t *testing.T) {
 		assert.Equal(t, "Size", domain.Name)
 		assert.Equal(t, "size", domain.Slug)
 		assert.Equal(t, attribute.AttributeTypeMultiple, domain.Type)
+		assert.Equal(t, attribute.DisplayTypeCheckbox, domain.DisplayType, "documents without a display type get the default")
 		assert.Equal(t, ptr("cm"), domain.Unit)
 		assert.True(t, domain.Enabled)
 		assert.Equal(t, now.UTC(), domain.CreatedAt)
@@ -231,9 +241,12 @@ func TestAttributeMapper_RoundTrip(t *testing.T) {
 			nil,
 			true,
 			[]attribute.Option{
-				{Name: "Cotton", Slug: "cotton", ColorCode: nil, SortOrder: 1},
-				{Name: "Polyester", Slug: "polyester", ColorCode: ptr("#123456"), SortOrder: 2},
+				{Name: "Cotton", Slug: "cotton", ColorCode: nil, ImageID: ptr("image-cotton"), SortOrder: 1},
+				{Name: "Polyester", Slug: "polyester", ColorCode: ptr("#123456"), SortOrder: 2, Deprecated: true, ReplacementSlug: ptr("cotton")},
 			},
+			attribute.DisplayTypeSwatch,
+			nil,
+			nil,
 			now,
 			now,
 		)
@@ -246,6 +259,7 @@ func TestAttributeMapper_RoundTrip(t *testing.T) {
 		assert.Equal(t, original.Name, restored.Name)
 		assert.Equal(t, original.Slug, restored.Slug)
 		assert.Equal(t, original.Type, restored.Type)
+		assert.Equal(t, attribute.DisplayTypeSwatch, restored.DisplayType)
 		assert.Equal(t, original.Unit, restored.Unit)
 		assert.Equal(t, original.Enabled, restored.Enabled)
 		assert.Equal(t, original.CreatedAt, restored.CreatedAt)
@@ -256,7 +270,10 @@ func TestAttributeMapper_RoundTrip(t *testing.T) {
 			assert.Equal(t, opt.Name, restored.Options[i].Name)
 			assert.Equal(t, opt.Slug, restored.Options[i].Slug)
 			assert.Equal(t, opt.ColorCode, restored.Options[i].ColorCode)
+			assert.Equal(t, opt.ImageID, restored.Options[i].ImageID)
 			assert.Equal(t, opt.SortOrder, restored.Options[i].SortOrder)
+			assert.Equal(t, opt.Deprecated, restored.Options[i].Deprecated)
+			assert.Equal(t, opt.ReplacementSlug, restored.Options[i].ReplacementSlug)
 		}
 	})
 }
@@ -39,6 +39,12 @@ func (r *attributeRepository) FindList(ctx context.Context, query attribute.List
 	if query.Type != nil {
 		filter = append(filter, bson.E{Key: "type", Value: *query.Type})
 	}
+	if query.AfterID != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: query.AfterID}}})
+	}
+	if query.ModifiedAfter != nil {
+		filter = append(filter, bson.E{Key: "modifiedAt", Value: bson.D{{Key: "$gt", Value: *query.ModifiedAfter}}})
+	}
 
 	var sortBson bson.D
 	if query.Sort != "" {
@@ -88,6 +94,20 @@ func (r *attributeRepository) FindByIDsOrFail(ctx context.Context, ids []string)
 	return attrs, nil
 }
 
+func (r *attributeRepository) FindBySlugs(ctx context.Context, slugs []string) ([]*attribute.Attribute, error) {
+	if len(slugs) == 0 {
+		return []*attribute.Attribute{}, nil
+	}
+
+	filter := bson.D{{Key: "slug", Value: bson.D{{Key: "$in", Value: slugs}}}}
+	return r.FindAllWithFilter(ctx, filter, nil)
+}
+
+func (r *attributeRepository) FindByPaletteID(ctx context.Context, paletteID string) ([]*attribute.Attribute, error) {
+	filter := bson.D{{Key: "paletteId", Value: paletteID}}
+	return r.FindAllWithFilter(ctx, filter, nil)
+}
+
 // Override Insert to handle duplicate slug error
 func (r *attributeRepository) Insert(ctx context.Context, a *attribute.Attribute) error {
 	err := r.GenericRepository.Insert(ctx, a)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ func TestAttributeRepository_Insert(t *testing.T) {
 	ctx := context.Background()
 
 	attr, err := attribute.NewAttribute(
+		limits.Default().Attribute,
 		uuid.New().String(),
 		"Color",
 		"color",
@@ -52,6 +54,7 @@ func TestAttributeRepository_Insert_DuplicateSlug(t *testing.T) {
 	ctx := context.Background()
 
 	attr1, err := attribute.NewAttribute(
+		limits.Default().Attribute,
 		uuid.New().String(),
 		"Size",
 		"size",
@@ -63,6 +66,7 @@ func TestAttributeRepository_Insert_DuplicateSlug(t *testing.T) {
 	require.NoError(t, err)
 
 	attr2, err := attribute.NewAttribute(
+		limits.Default().Attribute,
 		uuid.New().String(),
 		"Size 2",
 		"size", // Same slug
@@ -88,6 +92,7 @@ func TestAttributeRepository_Update(t *testing.T) {
 	ctx := context.Background()
 
 	attr, err := attribute.NewAttribute(
+		limits.Default().Attribute,
 		uuid.New().String(),
 		"Material",
 		"material",
@@ -102,7 +107,7 @@ func TestAttributeRepository_Update(t *testing.T) {
 	require.NoError(t, err)
 
 	// Update using domain method (modifies in place)
-	err = attr.Update("Material Type", ptrI("kg"), true, nil)
+	err = attr.Update(limits.Default().Attribute, "Material Type", ptrI("kg"), true, nil)
 	require.NoError(t, err)
 
 	result, err := testAttributeRepo.Update(ctx, attr)
@@ -122,6 +127,7 @@ func TestAttributeRepository_FindByID(t *testing.T) {
 	ctx := context.Background()
 
 	attr, err := attribute.NewAttribute(
+		limits.Default().Attribute,
 		uuid.New().String(),
 		"Weight",
 		"weight",
@@ -151,9 +157,9 @@ func TestAttributeRepository_FindByIDs(t *testing.T) {
 
 	ctx := context.Background()
 
-	attr1, _ := attribute.NewAttribute(uuid.New().String(), "Attr1", "attr1", attribute.AttributeTypeText, nil, true, nil)
-	attr2, _ := attribute.NewAttribute(uuid.New().String(), "Attr2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
-	attr3, _ := attribute.NewAttribute(uuid.New().String(), "Attr3", "attr3", attribute.AttributeTypeBoolean, nil, true, nil)
+	attr1, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attr1", "attr1", attribute.AttributeTypeText, nil, true, nil)
+	attr2, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attr2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
+	attr3, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attr3", "attr3", attribute.AttributeTypeBoolean, nil, true, nil)
 
 	require.NoError(t, testAttributeRepo.Insert(ctx, attr1))
 	require.NoError(t, testAttributeRepo.Insert(ctx, attr2))
@@ -177,8 +183,8 @@ func TestAttributeRepository_FindByIDsOrFail(t *testing.T) {
 
 	ctx := context.Background()
 
-	attr1, _ := attribute.NewAttribute(uuid.New().String(), "Attr1", "attr1", attribute.AttributeTypeText, nil, true, nil)
-	attr2, _ := attribute.NewAttribute(uuid.New().String(), "Attr2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
+	attr1, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attr1", "attr1", attribute.AttributeTypeText, nil, true, nil)
+	attr2, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attr2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
 
 	require.NoError(t, testAttributeRepo.Insert(ctx, attr1))
 	require.NoError(t, testAttributeRepo.Insert(ctx, attr2))
@@ -201,9 +207,9 @@ func TestAttributeRepository_FindList(t *testing.T) {
 	ctx := context.Background()
 
 	// Create test attributes
-	attr1, _ := attribute.NewAttribute(uuid.New().String(), "Attribute 1", "attr1", attribute.AttributeTypeText, nil, true, nil)
-	attr2, _ := attribute.NewAttribute(uuid.New().String(), "Attribute 2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
-	attr3, _ := attribute.NewAttribute(uuid.New().String(), "Attribute 3", "attr3", attribute.AttributeTypeRange, nil, false, nil)
+	attr1, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attribute 1", "attr1", attribute.AttributeTypeText, nil, true, nil)
+	attr2, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attribute 2", "attr2", attribute.AttributeTypeSingle, nil, true, nil)
+	attr3, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Attribute 3", "attr3", attribute.AttributeTypeRange, nil, false, nil)
 
 	// Add delay to ensure different createdAt times
 	require.NoError(t, testAttributeRepo.Insert(ctx, attr1))
@@ -263,7 +269,7 @@ func TestAttributeRepository_Exists(t *testing.T) {
 
 	ctx := context.Background()
 
-	attr, _ := attribute.NewAttribute(uuid.New().String(), "Unique", "unique-slug", attribute.AttributeTypeText, nil, true, nil)
+	attr, _ := attribute.NewAttribute(limits.Default().Attribute, uuid.New().String(), "Unique", "unique-slug", attribute.AttributeTypeText, nil, true, nil)
 
 	// Should not exist initially
 	exists, err := testAttributeRepo.Exists(ctx, attr.ID)
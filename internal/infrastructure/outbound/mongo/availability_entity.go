@@ -0,0 +1,28 @@
+package mongo
+
+import (
+	"time"
+)
+
+// weeklySlotEntity stores the slot bounds in minutes since midnight
+type weeklySlotEntity struct {
+	Weekday int `bson:"weekday"`
+	Start   int `bson:"start"`
+	End     int `bson:"end"`
+}
+
+type blackoutEntity struct {
+	Date   string `bson:"date"`
+	Reason string `bson:"reason,omitempty"`
+}
+
+// availabilityScheduleEntity represents the MongoDB document structure, keyed by product ID
+type availabilityScheduleEntity struct {
+	ID          string             `bson:"_id"`
+	Version     int                `bson:"version"`
+	Timezone    string             `bson:"timezone"`
+	WeeklySlots []weeklySlotEntity `bson:"weeklySlots,omitempty"`
+	Blackouts   []blackoutEntity   `bson:"blackouts,omitempty"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	ModifiedAt  time.Time          `bson:"modifiedAt"`
+}
@@ -0,0 +1,64 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+)
+
+type availabilityMapper struct{}
+
+func newAvailabilityMapper() *availabilityMapper {
+	return &availabilityMapper{}
+}
+
+func (m *availabilityMapper) ToEntity(s *availability.Schedule) *availabilityScheduleEntity {
+	return &availabilityScheduleEntity{
+		ID:       s.ProductID,
+		Version:  s.Version,
+		Timezone: s.Timezone,
+		WeeklySlots: lo.Map(s.WeeklySlots, func(slot availability.WeeklySlot, _ int) weeklySlotEntity {
+			return weeklySlotEntity{Weekday: int(slot.Weekday), Start: int(slot.Start), End: int(slot.End)}
+		}),
+		Blackouts: lo.Map(s.Blackouts, func(b availability.Blackout, _ int) blackoutEntity {
+			return blackoutEntity{Date: b.Date.Format(availability.DateLayout), Reason: b.Reason}
+		}),
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+func (m *availabilityMapper) ToDomain(e *availabilityScheduleEntity) *availability.Schedule {
+	return availability.Reconstruct(
+		e.ID,
+		e.Version,
+		e.Timezone,
+		lo.Map(e.WeeklySlots, func(slot weeklySlotEntity, _ int) availability.WeeklySlot {
+			return availability.WeeklySlot{
+				Weekday: time.Weekday(slot.Weekday),
+				Start:   availability.TimeOfDay(slot.Start),
+				End:     availability.TimeOfDay(slot.End),
+			}
+		}),
+		lo.Map(e.Blackouts, func(b blackoutEntity, _ int) availability.Blackout {
+			date, _ := time.Parse(availability.DateLayout, b.Date) //nolint:errcheck // written by ToEntity
+			return availability.Blackout{Date: date, Reason: b.Reason}
+		}),
+		e.CreatedAt.UTC(),
+		e.ModifiedAt.UTC(),
+	)
+}
+
+func (m *availabilityMapper) GetID(e *availabilityScheduleEntity) string {
+	return e.ID
+}
+
+func (m *availabilityMapper) GetVersion(e *availabilityScheduleEntity) int {
+	return e.Version
+}
+
+func (m *availabilityMapper) SetVersion(e *availabilityScheduleEntity, version int) {
+	e.Version = version
+}
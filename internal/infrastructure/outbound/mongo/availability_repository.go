@@ -0,0 +1,45 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type availabilityRepository struct {
+	*commonsmongo.GenericRepository[availability.Schedule, availabilityScheduleEntity]
+}
+
+func newAvailabilityRepository(admin commonsmongo.Admin, mapper *availabilityMapper, resolver commonsmongo.DatabaseResolver) (availability.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "availability_schedule",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &availabilityRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *availabilityRepository) FindByProductID(ctx context.Context, productID string) (*availability.Schedule, error) {
+	return r.FindByID(ctx, productID)
+}
+
+// Override Insert: a concurrent create of the same product's schedule is a version conflict
+func (r *availabilityRepository) Insert(ctx context.Context, s *availability.Schedule) error {
+	err := r.GenericRepository.Insert(ctx, s)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return commonsmongo.ErrOptimisticLocking
+		}
+		return err
+	}
+	return nil
+}
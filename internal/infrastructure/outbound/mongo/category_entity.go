@@ -12,6 +12,15 @@ type categoryAttributeEntity struct {
 	SortOrder   int    `bson:"sortOrder"`
 	Filterable  bool   `bson:"filterable"`
 	Searchable  bool   `bson:"searchable"`
+	Required    bool   `bson:"required,omitempty"`
+}
+
+// categoryDisplayEntity represents embedded storefront display metadata in MongoDB
+type categoryDisplayEntity struct {
+	ImageID       *string `bson:"imageId,omitempty"`
+	BannerImageID *string `bson:"bannerImageId,omitempty"`
+	Description   *string `bson:"description,omitempty"`
+	Template      string  `bson:"template,omitempty"`
 }
 
 // categoryEntity represents the MongoDB document structure
@@ -21,6 +30,7 @@ type categoryEntity struct {
 	Name       string                    `bson:"name"`
 	Enabled    bool                      `bson:"enabled"`
 	Attributes []categoryAttributeEntity `bson:"attributes,omitempty"`
+	Display    *categoryDisplayEntity    `bson:"display,omitempty"`
 	CreatedAt  time.Time                 `bson:"createdAt"`
 	ModifiedAt time.Time                 `bson:"modifiedAt"`
 }
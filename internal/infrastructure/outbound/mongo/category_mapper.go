@@ -18,6 +18,7 @@ func (m *categoryMapper) ToEntity(c *category.Category) *categoryEntity {
 		Name:       c.Name,
 		Enabled:    c.Enabled,
 		Attributes: m.attributesToEntities(c.Attributes),
+		Display:    m.displayToEntity(c.Display),
 		CreatedAt:  c.CreatedAt,
 		ModifiedAt: c.ModifiedAt,
 	}
@@ -30,6 +31,7 @@ func (m *categoryMapper) ToDomain(e *categoryEntity) *category.Category {
 		e.Name,
 		e.Enabled,
 		m.attributesToDomain(e.Attributes),
+		m.displayToDomain(e.Display),
 		e.CreatedAt.UTC(),
 		e.ModifiedAt.UTC(),
 	)
@@ -51,6 +53,7 @@ func mapCategoryAttributeToEntity(attr category.CategoryAttribute, _ int) catego
 		SortOrder:   attr.SortOrder,
 		Filterable:  attr.Filterable,
 		Searchable:  attr.Searchable,
+		Required:    attr.Required,
 	}
 }
 
@@ -70,6 +73,33 @@ func mapCategoryAttributeToDomain(attr categoryAttributeEntity, _ int) category.
 		SortOrder:   attr.SortOrder,
 		Filterable:  attr.Filterable,
 		Searchable:  attr.Searchable,
+		Required:    attr.Required,
+	}
+}
+
+func (m *categoryMapper) displayToEntity(d category.Display) *categoryDisplayEntity {
+	if d.IsEmpty() {
+		return nil
+	}
+
+	return &categoryDisplayEntity{
+		ImageID:       d.ImageID,
+		BannerImageID: d.BannerImageID,
+		Description:   d.Description,
+		Template:      string(d.Template),
+	}
+}
+
+func (m *categoryMapper) displayToDomain(e *categoryDisplayEntity) category.Display {
+	if e == nil {
+		return category.Display{}
+	}
+
+	return category.Display{
+		ImageID:       e.ImageID,
+		BannerImageID: e.BannerImageID,
+		Description:   e.Description,
+		Template:      category.DisplayTemplate(e.Template),
 	}
 }
 
@@ -38,6 +38,7 @@ func TestCategoryMapper_ToEntity(t *testing.T) {
 					Searchable:  false,
 				},
 			},
+			category.Display{},
 			now,
 			now,
 		)
@@ -71,6 +72,7 @@ func TestCategoryMapper_ToEntity(t *testing.T) {
 			"Books",
 			false,
 			nil,
+			category.Display{},
 			now,
 			now,
 		)
@@ -92,6 +94,7 @@ func TestCategoryMapper_ToEntity(t *testing.T) {
 			"Clothing",
 			true,
 			[]category.CategoryAttribute{},
+			category.Display{},
 			now,
 			now,
 		)
@@ -248,6 +251,12 @@ func TestCategoryMapper_RoundTrip(t *testing.T) {
 					Searchable:  true,
 				},
 			},
+			category.Display{
+				ImageID:       ptr("image-1"),
+				BannerImageID: ptr("banner-1"),
+				Description:   ptr("<p>Cars and parts</p>"),
+				Template:      category.DisplayTemplateLanding,
+			},
 			now,
 			now,
 		)
@@ -261,6 +270,7 @@ func TestCategoryMapper_RoundTrip(t *testing.T) {
 		assert.Equal(t, original.Enabled, restored.Enabled)
 		assert.Equal(t, original.CreatedAt, restored.CreatedAt)
 		assert.Equal(t, original.ModifiedAt, restored.ModifiedAt)
+		assert.Equal(t, original.Display, restored.Display)
 
 		require.Len(t, restored.Attributes, len(original.Attributes))
 		for i, attr := range original.Attributes {
@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"time"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
@@ -10,6 +11,7 @@ import (
 
 type categoryRepository struct {
 	*commonsmongo.GenericRepository[category.Category, categoryEntity]
+	revisions *revisionStore[category.Category, categoryEntity]
 }
 
 func newCategoryRepository(admin commonsmongo.Admin, mapper *categoryMapper, resolver commonsmongo.DatabaseResolver) (category.Repository, error) {
@@ -22,17 +24,53 @@ func newCategoryRepository(admin commonsmongo.Admin, mapper *categoryMapper, res
 		return nil, err
 	}
 
+	revisions, err := newRevisionStore(admin, "category", mapper, func(e *categoryEntity) time.Time { return e.ModifiedAt }, resolver)
+	if err != nil {
+		return nil, err
+	}
+
 	return &categoryRepository{
 		GenericRepository: genericRepo,
+		revisions:         revisions,
 	}, nil
 }
 
+// Override Insert to record the revision
+func (r *categoryRepository) Insert(ctx context.Context, c *category.Category) error {
+	if err := r.GenericRepository.Insert(ctx, c); err != nil {
+		return err
+	}
+	return r.revisions.record(ctx, c)
+}
+
+// Override Update to record the revision
+func (r *categoryRepository) Update(ctx context.Context, c *category.Category) (*category.Category, error) {
+	result, err := r.GenericRepository.Update(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.revisions.record(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *categoryRepository) FindAsOf(ctx context.Context, id string, asOf time.Time) (*category.Category, error) {
+	return r.revisions.findAsOf(ctx, id, asOf)
+}
+
 func (r *categoryRepository) FindList(ctx context.Context, query category.ListQuery) (*commonsmongo.PageResult[category.Category], error) {
 	// Build filter
 	filter := bson.D{}
 	if query.Enabled != nil {
 		filter = append(filter, bson.E{Key: "enabled", Value: *query.Enabled})
 	}
+	if query.AfterID != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: query.AfterID}}})
+	}
+	if query.ModifiedAfter != nil {
+		filter = append(filter, bson.E{Key: "modifiedAt", Value: bson.D{{Key: "$gt", Value: *query.ModifiedAfter}}})
+	}
 
 	// Build sort
 	var sortBson bson.D
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ func TestCategoryRepository_Insert(t *testing.T) {
 	ctx := context.Background()
 
 	cat, err := category.NewCategory(
+		limits.Default().Category,
 		"Electronics",
 		true,
 		[]category.CategoryAttribute{
@@ -47,6 +49,7 @@ func TestCategoryRepository_Update(t *testing.T) {
 	ctx := context.Background()
 
 	cat, err := category.NewCategory(
+		limits.Default().Category,
 		"Clothing",
 		true,
 		nil,
@@ -57,7 +60,7 @@ func TestCategoryRepository_Update(t *testing.T) {
 	require.NoError(t, err)
 
 	// Update using domain method (modifies in place)
-	err = cat.Update("Apparel", false, nil)
+	err = cat.Update(limits.Default().Category, "Apparel", false, nil)
 	require.NoError(t, err)
 
 	result, err := testCategoryRepo.Update(ctx, cat)
@@ -77,6 +80,7 @@ func TestCategoryRepository_FindByID(t *testing.T) {
 	ctx := context.Background()
 
 	cat, err := category.NewCategory(
+		limits.Default().Category,
 		"Books",
 		true,
 		nil,
@@ -103,9 +107,9 @@ func TestCategoryRepository_FindList(t *testing.T) {
 	ctx := context.Background()
 
 	// Create test categories
-	cat1, _ := category.NewCategory("Category 1", true, nil)
-	cat2, _ := category.NewCategory("Category 2", true, nil)
-	cat3, _ := category.NewCategory("Category 3", false, nil)
+	cat1, _ := category.NewCategory(limits.Default().Category, "Category 1", true, nil)
+	cat2, _ := category.NewCategory(limits.Default().Category, "Category 2", true, nil)
+	cat3, _ := category.NewCategory(limits.Default().Category, "Category 3", false, nil)
 
 	// Add delay to ensure different createdAt times
 	require.NoError(t, testCategoryRepo.Insert(ctx, cat1))
@@ -152,7 +156,7 @@ func TestCategoryRepository_Exists(t *testing.T) {
 
 	ctx := context.Background()
 
-	cat, _ := category.NewCategory("Test Category", true, nil)
+	cat, _ := category.NewCategory(limits.Default().Category, "Test Category", true, nil)
 
 	// Should not exist initially
 	exists, err := testCategoryRepo.Exists(ctx, cat.ID)
@@ -0,0 +1,132 @@
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// changeSettleDelay holds recent revisions back from the change feed. Revision IDs are generated by the
+// service before the write commits, so a transaction committing late, or an instance with a clock behind,
+// can add a revision below one already read; waiting past the longest transaction keeps readers from skipping it.
+const changeSettleDelay = 2 * time.Minute
+
+// changeFeed reads the revision collections in ID order, which is commit order once revisions settled
+type changeFeed struct {
+	products   *revisionStore[product.Product, productEntity]
+	categories *revisionStore[category.Category, categoryEntity]
+	settle     time.Duration
+}
+
+func newChangeFeed(
+	admin commonsmongo.Admin,
+	productMapper *productMapper,
+	categoryMapper *categoryMapper,
+	resolver commonsmongo.DatabaseResolver,
+) (change.Feed, error) {
+	products, err := newRevisionStore(admin, "product", productMapper, func(e *productEntity) time.Time { return e.ModifiedAt }, resolver)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := newRevisionStore(admin, "category", categoryMapper, func(e *categoryEntity) time.Time { return e.ModifiedAt }, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return &changeFeed{products: products, categories: categories, settle: changeSettleDelay}, nil
+}
+
+// changeEntity is the part of a revision the feed reads, without the state
+type changeEntity struct {
+	ID        bson.ObjectID `bson:"_id"`
+	EntityID  string        `bson:"entityId"`
+	Version   int           `bson:"version"`
+	ValidFrom time.Time     `bson:"validFrom"`
+	Deleted   bool          `bson:"deleted"`
+}
+
+type feedEntry struct {
+	entity change.EntityType
+	changeEntity
+}
+
+// FindAfter takes the cursor as the hex ID of the last revision read
+func (f *changeFeed) FindAfter(ctx context.Context, cursor string, limit int) ([]change.Change, error) {
+	after := bson.NilObjectID
+	if cursor != "" {
+		id, err := bson.ObjectIDFromHex(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", change.ErrInvalidCursor, cursor)
+		}
+		after = id
+	}
+	before := bson.NewObjectIDFromTimestamp(time.Now().Add(-f.settle))
+
+	products, err := findChanges(ctx, f.products.Collection(ctx), change.EntityProduct, after, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := findChanges(ctx, f.categories.Collection(ctx), change.EntityCategory, after, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := append(products, categories...)
+	slices.SortFunc(entries, func(a, b feedEntry) int { return bytes.Compare(a.ID[:], b.ID[:]) })
+	entries = entries[:min(limit, len(entries))]
+
+	changes := make([]change.Change, len(entries))
+	for i, e := range entries {
+		changes[i] = change.Change{
+			Entity:  e.entity,
+			ID:      e.EntityID,
+			Version: e.Version,
+			Kind:    change.KindUpserted,
+			At:      e.ValidFrom,
+			Cursor:  e.ID.Hex(),
+		}
+		if e.Deleted {
+			changes[i].Kind = change.KindDeleted
+		}
+	}
+	return changes, nil
+}
+
+func findChanges(
+	ctx context.Context,
+	coll *mongo.Collection,
+	entity change.EntityType,
+	after, before bson.ObjectID,
+	limit int,
+) ([]feedEntry, error) {
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$gt", Value: after}, {Key: "$lt", Value: before}}}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.D{{Key: "state", Value: 0}})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s changes: %w", entity, err)
+	}
+	var entities []changeEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode %s changes: %w", entity, err)
+	}
+
+	entries := make([]feedEntry, len(entities))
+	for i, e := range entities {
+		entries[i] = feedEntry{entity: entity, changeEntity: e}
+	}
+	return entries, nil
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestChangeFeed_FindAfter(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, "product_revision")
+	cleanupCollection(t, "category")
+	cleanupCollection(t, "category_revision")
+
+	ctx := context.Background()
+
+	feed, err := newChangeFeed(testMongo, newProductMapper(ProductConfig{}), newCategoryMapper(), func(context.Context) string { return testDBName })
+	require.NoError(t, err)
+
+	cat, err := category.NewCategory(limits.Default().Category, "Shoes "+uuid.New().String(), true, nil)
+	require.NoError(t, err)
+	require.NoError(t, testCategoryRepo.Insert(ctx, cat))
+	prod, err := product.NewProduct(limits.Default().Product, "Sneaker", uuid.New().String(), product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, prod))
+	require.NoError(t, testProductRepo.Delete(ctx, prod.ID))
+
+	// Fresh revisions are held back until they settled
+	changes, err := feed.FindAfter(ctx, "", 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	feed.(*changeFeed).settle = -time.Minute
+	changes, err = feed.FindAfter(ctx, "", 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, change.EntityCategory, changes[0].Entity)
+	assert.Equal(t, cat.ID, changes[0].ID)
+	assert.Equal(t, change.KindUpserted, changes[1].Kind)
+	assert.Equal(t, prod.ID, changes[1].ID)
+	assert.Equal(t, 1, changes[1].Version)
+
+	rest, err := feed.FindAfter(ctx, changes[1].Cursor, 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, change.KindDeleted, rest[0].Kind)
+	assert.Equal(t, prod.ID, rest[0].ID)
+
+	_, err = feed.FindAfter(ctx, "not-a-cursor", 10)
+	require.ErrorIs(t, err, change.ErrInvalidCursor)
+}
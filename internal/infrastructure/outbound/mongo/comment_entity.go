@@ -0,0 +1,14 @@
+package mongo
+
+import (
+	"time"
+)
+
+// commentEntity represents the MongoDB document structure
+type commentEntity struct {
+	ID        string    `bson:"_id"`
+	ProductID string    `bson:"productId"`
+	Author    string    `bson:"author"`
+	Text      string    `bson:"text"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
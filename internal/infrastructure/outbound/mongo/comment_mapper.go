@@ -0,0 +1,36 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+)
+
+type commentMapper struct{}
+
+func newCommentMapper() *commentMapper {
+	return &commentMapper{}
+}
+
+func (m *commentMapper) ToEntity(c *comment.Comment) *commentEntity {
+	return &commentEntity{
+		ID:        c.ID,
+		ProductID: c.ProductID,
+		Author:    c.Author,
+		Text:      c.Text,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (m *commentMapper) ToDomain(e *commentEntity) *comment.Comment {
+	return comment.Reconstruct(e.ID, e.ProductID, e.Author, e.Text, e.CreatedAt.UTC())
+}
+
+func (m *commentMapper) GetID(e *commentEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: comments are never updated, so they carry no version
+func (m *commentMapper) GetVersion(_ *commentEntity) int {
+	return 0
+}
+
+func (m *commentMapper) SetVersion(_ *commentEntity, _ int) {}
@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type commentRepository struct {
+	*commonsmongo.GenericRepository[comment.Comment, commentEntity]
+}
+
+func newCommentRepository(admin commonsmongo.Admin, mapper *commentMapper, resolver commonsmongo.DatabaseResolver) (comment.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "product_comment",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *commentRepository) FindByProductID(ctx context.Context, productID string, page, size int) (*commonsmongo.PageResult[comment.Comment], error) {
+	return r.FindWithOptions(ctx, commonsmongo.QueryOptions{
+		Filter: bson.D{{Key: "productId", Value: productID}},
+		Page:   page,
+		Size:   size,
+		Sort:   bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+}
+
+func (r *commentRepository) ReplaceAuthor(ctx context.Context, author, replacement string) (int64, error) {
+	res, err := r.Collection(ctx).UpdateMany(ctx,
+		bson.D{{Key: "author", Value: author}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "author", Value: replacement}}}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to replace comment author: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
+
+func (r *commentRepository) CountByAuthor(ctx context.Context, author string) (int64, error) {
+	count, err := r.Collection(ctx).CountDocuments(ctx, bson.D{{Key: "author", Value: author}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count comments: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,26 @@
+package mongo
+
+import (
+	"github.com/knadh/koanf/v2"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// ProductConfig holds optional rules on stored products
+type ProductConfig struct {
+	// UniqueNamesPerCategory rejects a product whose name, ignoring case and surrounding spaces,
+	// is already taken by another product of the same category. Default: false
+	UniqueNamesPerCategory bool `koanf:"unique-names-per-category"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *ProductConfig) ApplyDefaults() {}
+
+// Validate validates the configuration
+func (c *ProductConfig) Validate() error {
+	return nil
+}
+
+func provideProductConfig(k *koanf.Koanf) (ProductConfig, error) {
+	return coreconfig.Load[ProductConfig](k, "products", nil)
+}
@@ -0,0 +1,18 @@
+package mongo
+
+import (
+	"time"
+)
+
+// cronRunEntity represents the MongoDB document structure.
+// Runs are shared by all tenants and expire through a TTL index on startedAt.
+type cronRunEntity struct {
+	ID          string     `bson:"_id"`
+	Task        string     `bson:"task"`
+	Owner       string     `bson:"owner"`
+	ScheduledAt time.Time  `bson:"scheduledAt"`
+	Status      string     `bson:"status"`
+	Error       string     `bson:"error,omitempty"`
+	StartedAt   time.Time  `bson:"startedAt"`
+	FinishedAt  *time.Time `bson:"finishedAt,omitempty"`
+}
@@ -0,0 +1,48 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+)
+
+type cronRunMapper struct{}
+
+func newCronRunMapper() *cronRunMapper {
+	return &cronRunMapper{}
+}
+
+func (m *cronRunMapper) ToEntity(r *cron.Run) *cronRunEntity {
+	return &cronRunEntity{
+		ID:          r.ID,
+		Task:        r.Task,
+		Owner:       r.Owner,
+		ScheduledAt: r.ScheduledAt,
+		Status:      string(r.Status),
+		Error:       r.Error,
+		StartedAt:   r.StartedAt,
+		FinishedAt:  r.FinishedAt,
+	}
+}
+
+func (m *cronRunMapper) ToDomain(e *cronRunEntity) *cron.Run {
+	return &cron.Run{
+		ID:          e.ID,
+		Task:        e.Task,
+		Owner:       e.Owner,
+		ScheduledAt: e.ScheduledAt.UTC(),
+		Status:      cron.RunStatus(e.Status),
+		Error:       e.Error,
+		StartedAt:   e.StartedAt.UTC(),
+		FinishedAt:  utcPtr(e.FinishedAt),
+	}
+}
+
+func (m *cronRunMapper) GetID(e *cronRunEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: runs are saved by the replica running them only, so they carry no version
+func (m *cronRunMapper) GetVersion(_ *cronRunEntity) int {
+	return 0
+}
+
+func (m *cronRunMapper) SetVersion(_ *cronRunEntity, _ int) {}
@@ -0,0 +1,63 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/cron"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const (
+	cronRunCollection = "cron_run"
+
+	// cronRunTTL is how long the run history is kept
+	cronRunTTL = 30 * 24 * time.Hour
+)
+
+type cronRunRepository struct {
+	*commonsmongo.GenericRepository[cron.Run, cronRunEntity]
+}
+
+// newCronRunRepository stores the runs in the service database rather than the tenant ones, tasks span all tenants.
+// Tenant migrations don't reach that database, so the TTL index is ensured on start like the outbox does.
+func newCronRunRepository(lc fx.Lifecycle, m commonsmongo.Mongo, mapper *cronRunMapper) (cron.RunRepository, error) {
+	genericRepo, err := commonsmongo.NewGenericRepository(m, cronRunCollection, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			_, err := m.GetCollection(cronRunCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "startedAt", Value: 1}},
+				Options: options.Index().SetName("cron_run_startedAt_ttl_v1").SetExpireAfterSeconds(int32(cronRunTTL.Seconds())),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to ensure cron run indexes: %w", err)
+			}
+			return nil
+		},
+	})
+
+	return &cronRunRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *cronRunRepository) Save(ctx context.Context, run *cron.Run) error {
+	res, err := r.Collection(ctx).ReplaceOne(ctx, bson.D{{Key: "_id", Value: run.ID}}, r.Mapper().ToEntity(run))
+	if err != nil {
+		return fmt.Errorf("failed to save cron run %s: %w", run.ID, err)
+	}
+	if res.MatchedCount == 0 {
+		return commonsmongo.ErrEntityNotFound
+	}
+	return nil
+}
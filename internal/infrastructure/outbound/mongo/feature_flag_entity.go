@@ -0,0 +1,13 @@
+package mongo
+
+import (
+	"time"
+)
+
+// featureFlagEntity represents the MongoDB document structure.
+// Flags apply to every tenant, the flag name is the document ID.
+type featureFlagEntity struct {
+	ID         string    `bson:"_id"`
+	Enabled    bool      `bson:"enabled"`
+	ModifiedAt time.Time `bson:"modifiedAt"`
+}
@@ -0,0 +1,38 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+)
+
+type featureFlagMapper struct{}
+
+func newFeatureFlagMapper() *featureFlagMapper {
+	return &featureFlagMapper{}
+}
+
+func (m *featureFlagMapper) ToEntity(s *feature.Setting) *featureFlagEntity {
+	return &featureFlagEntity{
+		ID:         string(s.Flag),
+		Enabled:    s.Enabled,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+func (m *featureFlagMapper) ToDomain(e *featureFlagEntity) *feature.Setting {
+	return &feature.Setting{
+		Flag:       feature.Flag(e.ID),
+		Enabled:    e.Enabled,
+		ModifiedAt: e.ModifiedAt.UTC(),
+	}
+}
+
+func (m *featureFlagMapper) GetID(e *featureFlagEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: flags are set by operators directly in the database, so they carry no version
+func (m *featureFlagMapper) GetVersion(_ *featureFlagEntity) int {
+	return 0
+}
+
+func (m *featureFlagMapper) SetVersion(_ *featureFlagEntity, _ int) {}
@@ -0,0 +1,25 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const featureFlagCollection = "feature_flag"
+
+type featureFlagRepository struct {
+	*commonsmongo.GenericRepository[feature.Setting, featureFlagEntity]
+}
+
+// newFeatureFlagRepository stores the flags in the service database rather than the tenant ones,
+// a flag applies to the whole environment
+func newFeatureFlagRepository(m commonsmongo.Mongo, mapper *featureFlagMapper) (feature.Repository, error) {
+	genericRepo, err := commonsmongo.NewGenericRepository(m, featureFlagCollection, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &featureFlagRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
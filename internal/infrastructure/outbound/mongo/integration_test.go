@@ -9,12 +9,15 @@ import (
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	mongooptions "go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	"github.com/Sokol111/ecommerce-commons/pkg/testutil/container"
 )
@@ -25,9 +28,11 @@ var (
 	testMongo          commonsmongo.Admin
 
 	// Repositories for tests
-	testAttributeRepo attribute.Repository
-	testCategoryRepo  category.Repository
-	testProductRepo   product.Repository
+	testAttributeRepo   attribute.Repository
+	testCategoryRepo    category.Repository
+	testProductRepo     product.Repository
+	testReservationRepo reservation.Repository
+	testReplayJobRepo   replay.Repository
 )
 
 const testDBName = "catalog_test"
@@ -58,11 +63,21 @@ func TestMain(m *testing.M) {
 		log.Fatalf("failed to create category repository: %v", err)
 	}
 
-	testProductRepo, err = newProductRepository(testMongo, newProductMapper(), resolver)
+	testProductRepo, err = newProductRepository(testMongo, newProductMapper(ProductConfig{}), resolver)
 	if err != nil {
 		log.Fatalf("failed to create product repository: %v", err)
 	}
 
+	testReservationRepo, err = newReservationRepository(testMongo, newReservationMapper(), resolver)
+	if err != nil {
+		log.Fatalf("failed to create reservation repository: %v", err)
+	}
+
+	testReplayJobRepo, err = newReplayJobRepository(testMongo, newReplayJobMapper(), resolver)
+	if err != nil {
+		log.Fatalf("failed to create replay job repository: %v", err)
+	}
+
 	// Create indexes
 	if err := createIndexes(context.Background()); err != nil {
 		log.Fatalf("failed to create indexes: %v", err)
@@ -107,10 +122,46 @@ func createIndexes(ctx context.Context) error {
 		return err
 	}
 
+	// Product unique name per category index, only covers documents carrying nameKey
+	_, err = testDatabase.Collection("product").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "categoryId", Value: 1}, {Key: "nameKey", Value: 1}},
+		Options: mongooptions.Index().
+			SetName(productNameIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "nameKey", Value: bson.D{{Key: "$exists", Value: true}}}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Product unique slug index across current and previous slugs
+	_, err = testDatabase.Collection("product").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "slugs", Value: 1}},
+		Options: mongooptions.Index().
+			SetName(productSlugIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "slugs", Value: bson.D{{Key: "$exists", Value: true}}}}),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Product unique external ID index, only covers imported products
+	_, err = testDatabase.Collection("product").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "externalId", Value: 1}},
+		Options: mongooptions.Index().
+			SetName(productExternalIDIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "externalId", Value: bson.D{{Key: "$exists", Value: true}}}}),
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func cleanupCollection(t *testing.T, collectionName string) {
+func cleanupCollection(t testing.TB, collectionName string) {
 	t.Helper()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -0,0 +1,29 @@
+package mongo
+
+import (
+	"time"
+)
+
+// jobEntity represents the MongoDB document structure.
+// Finished jobs expire through a TTL index on finishedAt.
+type jobEntity struct {
+	ID         string               `bson:"_id"`
+	Type       string               `bson:"type"`
+	Status     string               `bson:"status"`
+	Done       int64                `bson:"done"`
+	Total      int64                `bson:"total"`
+	Failed     int64                `bson:"failed"`
+	ItemErrors []jobItemErrorEntity `bson:"itemErrors,omitempty"`
+	Result     string               `bson:"result,omitempty"`
+	Error      string               `bson:"error,omitempty"`
+	CreatedAt  time.Time            `bson:"createdAt"`
+	UpdatedAt  time.Time            `bson:"updatedAt"`
+	StartedAt  *time.Time           `bson:"startedAt,omitempty"`
+	FinishedAt *time.Time           `bson:"finishedAt,omitempty"`
+}
+
+type jobItemErrorEntity struct {
+	Index int64  `bson:"index"`
+	Item  string `bson:"item"`
+	Error string `bson:"error"`
+}
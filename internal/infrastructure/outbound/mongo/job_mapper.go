@@ -0,0 +1,79 @@
+package mongo
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+)
+
+type jobMapper struct{}
+
+func newJobMapper() *jobMapper {
+	return &jobMapper{}
+}
+
+func (m *jobMapper) ToEntity(j *job.Job) *jobEntity {
+	return &jobEntity{
+		ID:     j.ID,
+		Type:   string(j.Type),
+		Status: string(j.Status),
+		Done:   j.Done,
+		Total:  j.Total,
+		Failed: j.Failed,
+		ItemErrors: lo.Map(j.ItemErrors, func(ie job.ItemError, _ int) jobItemErrorEntity {
+			return jobItemErrorEntity{Index: ie.Index, Item: ie.Item, Error: ie.Error}
+		}),
+		Result:     string(j.Result),
+		Error:      j.Error,
+		CreatedAt:  j.CreatedAt,
+		UpdatedAt:  j.UpdatedAt,
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.FinishedAt,
+	}
+}
+
+func (m *jobMapper) ToDomain(e *jobEntity) *job.Job {
+	j := &job.Job{
+		ID:         e.ID,
+		Type:       job.Type(e.Type),
+		Status:     job.Status(e.Status),
+		Done:       e.Done,
+		Total:      e.Total,
+		Failed:     e.Failed,
+		Error:      e.Error,
+		CreatedAt:  e.CreatedAt.UTC(),
+		UpdatedAt:  e.UpdatedAt.UTC(),
+		StartedAt:  utcPtr(e.StartedAt),
+		FinishedAt: utcPtr(e.FinishedAt),
+	}
+	if len(e.ItemErrors) > 0 {
+		j.ItemErrors = lo.Map(e.ItemErrors, func(ie jobItemErrorEntity, _ int) job.ItemError {
+			return job.ItemError{Index: ie.Index, Item: ie.Item, Error: ie.Error}
+		})
+	}
+	if e.Result != "" {
+		j.Result = json.RawMessage(e.Result)
+	}
+	return j
+}
+
+func (m *jobMapper) GetID(e *jobEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: jobs are saved by their worker only, so they carry no version
+func (m *jobMapper) GetVersion(_ *jobEntity) int {
+	return 0
+}
+
+func (m *jobMapper) SetVersion(_ *jobEntity, _ int) {}
+
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.UTC())
+}
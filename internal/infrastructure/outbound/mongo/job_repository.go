@@ -0,0 +1,64 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type jobRepository struct {
+	*commonsmongo.GenericRepository[job.Job, jobEntity]
+}
+
+func newJobRepository(admin commonsmongo.Admin, mapper *jobMapper, resolver commonsmongo.DatabaseResolver) (job.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "job",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jobRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *jobRepository) Save(ctx context.Context, j *job.Job) error {
+	if _, err := r.Collection(ctx).ReplaceOne(ctx, bson.D{{Key: "_id", Value: j.ID}}, r.Mapper().ToEntity(j)); err != nil {
+		return fmt.Errorf("failed to save job %s: %w", j.ID, err)
+	}
+	return nil
+}
+
+func (r *jobRepository) FindUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]*job.Job, error) {
+	cursor, err := r.Collection(ctx).Find(ctx,
+		bson.D{
+			{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{string(job.StatusQueued), string(job.StatusRunning)}}}},
+			{Key: "updatedAt", Value: bson.D{{Key: "$lt", Value: updatedBefore}}},
+		},
+		options.Find().SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unfinished jobs: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }() //nolint:errcheck // Best effort cleanup
+
+	var entities []jobEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode unfinished jobs: %w", err)
+	}
+
+	result := make([]*job.Job, 0, len(entities))
+	for i := range entities {
+		result = append(result, r.Mapper().ToDomain(&entities[i]))
+	}
+	return result, nil
+}
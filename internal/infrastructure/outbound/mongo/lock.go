@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const lockCollection = "lock"
+
+// lockEntity is a lease keyed by the lock name
+type lockEntity struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+type lock struct {
+	coll *mongo.Collection
+}
+
+// newLock keeps the leases in the service database, they span all tenants. A TTL index removes the leases
+// of replicas that died; expired leases are taken over before that anyway.
+func newLock(lc fx.Lifecycle, m commonsmongo.Mongo) leader.Lock {
+	l := &lock{coll: m.GetCollection(lockCollection)}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			_, err := l.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("lock_expiresAt_ttl_v1").SetExpireAfterSeconds(0),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to ensure lock indexes: %w", err)
+			}
+			return nil
+		},
+	})
+
+	return l
+}
+
+// Acquire takes over the lease if it expired or is already held by owner. Otherwise the filter doesn't match
+// and the upsert collides with the existing document, which makes the check and the write atomic.
+func (l *lock) Acquire(ctx context.Context, name, owner string, until time.Time) (bool, error) {
+	_, err := l.coll.ReplaceOne(ctx,
+		bson.D{
+			{Key: "_id", Value: name},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "owner", Value: owner}},
+				bson.D{{Key: "expiresAt", Value: bson.D{{Key: "$lte", Value: utc.Now()}}}},
+			}},
+		},
+		lockEntity{ID: name, Owner: owner, ExpiresAt: until.UTC()},
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+	return true, nil
+}
+
+func (l *lock) Release(ctx context.Context, name, owner string) error {
+	if _, err := l.coll.DeleteOne(ctx, bson.D{{Key: "_id", Value: name}, {Key: "owner", Value: owner}}); err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestLock_Lease(t *testing.T) {
+	cleanupCollection(t, lockCollection)
+
+	ctx := context.Background()
+	lock := newLock(fxtest.NewLifecycle(t), testMongo)
+	now := time.Now().UTC()
+
+	acquired, err := lock.Acquire(ctx, "leader:cron", "replica-a", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = lock.Acquire(ctx, "leader:cron", "replica-b", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, acquired, "the lease is held by another replica")
+
+	acquired, err = lock.Acquire(ctx, "leader:cron", "replica-a", now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, acquired, "the owner renews its lease")
+
+	acquired, err = lock.Acquire(ctx, "leader:other", "replica-b", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, acquired, "locks are independent")
+
+	// Releasing for another owner leaves the lease alone
+	require.NoError(t, lock.Release(ctx, "leader:cron", "replica-b"))
+	acquired, err = lock.Acquire(ctx, "leader:cron", "replica-b", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	require.NoError(t, lock.Release(ctx, "leader:cron", "replica-a"))
+	acquired, err = lock.Acquire(ctx, "leader:cron", "replica-b", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, acquired, "a released lease is taken over right away")
+
+	// An expired lease is taken over even before the TTL index removes it
+	acquired, err = lock.Acquire(ctx, "leader:expired", "replica-a", now.Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, err = lock.Acquire(ctx, "leader:expired", "replica-b", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -0,0 +1,70 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const (
+	maintenanceCollection = "maintenance"
+	// maintenanceModeID is the ID of the single document holding the mode
+	maintenanceModeID = "mode"
+)
+
+type maintenanceModeEntity struct {
+	ID         string        `bson:"_id"`
+	ReadOnly   bool          `bson:"readOnly"`
+	Reason     string        `bson:"reason,omitempty"`
+	RetryAfter time.Duration `bson:"retryAfter,omitempty"`
+	ModifiedAt time.Time     `bson:"modifiedAt"`
+}
+
+type maintenanceRepository struct {
+	coll *mongo.Collection
+}
+
+// newMaintenanceRepository keeps the mode in the service database, it applies to all tenants
+func newMaintenanceRepository(m commonsmongo.Mongo) maintenance.Repository {
+	return &maintenanceRepository{coll: m.GetCollection(maintenanceCollection)}
+}
+
+func (r *maintenanceRepository) Find(ctx context.Context) (*maintenance.Mode, error) {
+	var e maintenanceModeEntity
+	if err := r.coll.FindOne(ctx, bson.D{{Key: "_id", Value: maintenanceModeID}}).Decode(&e); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, commonsmongo.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get maintenance mode: %w", err)
+	}
+
+	return &maintenance.Mode{
+		ReadOnly:   e.ReadOnly,
+		Reason:     e.Reason,
+		RetryAfter: e.RetryAfter,
+		ModifiedAt: e.ModifiedAt.UTC(),
+	}, nil
+}
+
+func (r *maintenanceRepository) Save(ctx context.Context, mode *maintenance.Mode) error {
+	e := maintenanceModeEntity{
+		ID:         maintenanceModeID,
+		ReadOnly:   mode.ReadOnly,
+		Reason:     mode.Reason,
+		RetryAfter: mode.RetryAfter,
+		ModifiedAt: mode.ModifiedAt,
+	}
+	_, err := r.coll.ReplaceOne(ctx, bson.D{{Key: "_id", Value: maintenanceModeID}}, e, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save maintenance mode: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+const benchAttributeCount = 20
+
+func benchProduct() *product.Product {
+	attrs := make([]product.AttributeValue, benchAttributeCount)
+	for i := range attrs {
+		attrs[i] = product.AttributeValue{
+			AttributeID:      fmt.Sprintf("attr-%d", i),
+			AttributeSlug:    fmt.Sprintf("slug-%d", i),
+			OptionSlugValues: []string{"a", "b", "c"},
+		}
+	}
+	now := time.Now().UTC()
+	return product.Reconstruct("prod-1", 3, "Phone", "", nil, product.ProductTypePhysical, ptr("description"), 999.99, 10, ptr("image-1"), ptr("category-1"), true, attrs, nil, nil, nil, now, now)
+}
+
+func benchCategory() *category.Category {
+	attrs := make([]category.CategoryAttribute, benchAttributeCount)
+	for i := range attrs {
+		attrs[i] = category.CategoryAttribute{
+			AttributeID: fmt.Sprintf("attr-%d", i),
+			Slug:        fmt.Sprintf("slug-%d", i),
+			Role:        category.AttributeRoleSpecification,
+			SortOrder:   i,
+			Filterable:  true,
+		}
+	}
+	now := time.Now().UTC()
+	return category.Reconstruct("category-1", 3, "Phones", true, attrs, category.Display{}, now, now)
+}
+
+func benchAttribute() *attribute.Attribute {
+	options := make([]attribute.Option, benchAttributeCount)
+	for i := range options {
+		options[i] = attribute.Option{
+			Name:      fmt.Sprintf("Option %d", i),
+			Slug:      fmt.Sprintf("option-%d", i),
+			SortOrder: i,
+		}
+	}
+	now := time.Now().UTC()
+	return attribute.Reconstruct("attr-1", 3, "Color", "color", attribute.AttributeTypeMultiple, nil, true, options, "", nil, nil, now, now)
+}
+
+func BenchmarkProductMapper_ToEntity(b *testing.B) {
+	mapper := newProductMapper(ProductConfig{})
+	p := benchProduct()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToEntity(p)
+	}
+}
+
+func BenchmarkProductMapper_ToDomain(b *testing.B) {
+	mapper := newProductMapper(ProductConfig{})
+	e := mapper.ToEntity(benchProduct())
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToDomain(e)
+	}
+}
+
+func BenchmarkCategoryMapper_ToEntity(b *testing.B) {
+	mapper := newCategoryMapper()
+	c := benchCategory()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToEntity(c)
+	}
+}
+
+func BenchmarkCategoryMapper_ToDomain(b *testing.B) {
+	mapper := newCategoryMapper()
+	e := mapper.ToEntity(benchCategory())
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToDomain(e)
+	}
+}
+
+func BenchmarkAttributeMapper_ToEntity(b *testing.B) {
+	mapper := newAttributeMapper()
+	a := benchAttribute()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToEntity(a)
+	}
+}
+
+func BenchmarkAttributeMapper_ToDomain(b *testing.B) {
+	mapper := newAttributeMapper()
+	e := mapper.ToEntity(benchAttribute())
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = mapper.ToDomain(e)
+	}
+}
@@ -6,12 +6,46 @@ import (
 
 // Module provides MongoDB infrastructure dependencies
 func Module() fx.Option {
-	return fx.Provide(
-		newProductMapper,
-		newProductRepository,
-		newCategoryMapper,
-		newCategoryRepository,
-		newAttributeMapper,
-		newAttributeRepository,
+	return fx.Options(
+		fx.Provide(
+			provideProductConfig,
+			provideTransactionsConfig,
+			newProductMapper,
+			newProductRepository,
+			newCategoryMapper,
+			newCategoryRepository,
+			newAttributeMapper,
+			newAttributeRepository,
+			newReservationMapper,
+			newReservationRepository,
+			provideReservedStock,
+			newAvailabilityMapper,
+			newAvailabilityRepository,
+			newPaletteMapper,
+			newPaletteRepository,
+			newSupplierMapper,
+			newSupplierRepository,
+			newCommentMapper,
+			newCommentRepository,
+			newSavedViewMapper,
+			newSavedViewRepository,
+			newReplayJobMapper,
+			newReplayJobRepository,
+			newJobMapper,
+			newJobRepository,
+			newCronRunMapper,
+			newCronRunRepository,
+			newLock,
+			newNonceStore,
+			newFeatureFlagMapper,
+			newFeatureFlagRepository,
+			newMaintenanceRepository,
+			newQuotaGuard,
+			newAPIKeyMapper,
+			newAPIKeyRepository,
+			newChangeFeed,
+			newBatchOutbox,
+		),
+		fx.Decorate(decorateTxManager),
 	)
 }
@@ -0,0 +1,88 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/kafkaproto"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/serde"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// outboxCollection is the collection of the commons outbox, which spans all tenants
+const outboxCollection = "outbox"
+
+// outboxBatchEntity mirrors the outbox documents of commons v0.8.5, whose outbox only inserts one at a time
+type outboxBatchEntity struct {
+	ID               string            `bson:"_id"`
+	Payload          []byte            `bson:"payload"`
+	Key              string            `bson:"key"`
+	Topic            string            `bson:"topic"`
+	Headers          map[string]string `bson:"headers,omitempty"`
+	Status           string            `bson:"status"`
+	CreatedAt        time.Time         `bson:"createdAt"`
+	LockExpiresAt    time.Time         `bson:"lockExpiresAt"`
+	NextAttemptAfter time.Time         `bson:"nextAttemptAfter"`
+	AttemptsToSend   int32             `bson:"attemptsToSend"`
+}
+
+type batchOutbox struct {
+	coll       *mongo.Collection
+	headers    kafkaproto.HeaderPopulator
+	serializer serde.Serializer
+}
+
+func newBatchOutbox(m commonsmongo.Mongo, headers kafkaproto.HeaderPopulator, serializer serde.Serializer) product.BatchOutbox {
+	return &batchOutbox{coll: m.GetCollection(outboxCollection), headers: headers, serializer: serializer}
+}
+
+// CreateBatch inserts the messages with the headers the commons outbox adds. They are due right away,
+// so the outbox poller relays them with its next fetches.
+func (o *batchOutbox) CreateBatch(ctx context.Context, msgs []outbox.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	now := utc.Now()
+	docs := make([]any, len(msgs))
+	for i, msg := range msgs {
+		headers := maps.Clone(msg.Headers)
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		id := o.headers.PopulateHeaders(msg.Event, headers)
+		otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(headers))
+		headers = tenant.SaveToHeaders(ctx, headers)
+
+		payload, err := o.serializer.Serialize(msg.Event)
+		if err != nil {
+			return fmt.Errorf("failed to serialize outbox message: %w", err)
+		}
+		docs[i] = outboxBatchEntity{
+			ID:               id,
+			Payload:          payload,
+			Key:              msg.Key,
+			Topic:            msg.Topic,
+			Headers:          headers,
+			Status:           outbox.StatusProcessing,
+			CreatedAt:        now,
+			LockExpiresAt:    now,
+			NextAttemptAfter: now,
+		}
+	}
+
+	if _, err := o.coll.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to insert outbox messages: %w", err)
+	}
+	return nil
+}
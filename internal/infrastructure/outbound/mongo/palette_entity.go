@@ -0,0 +1,20 @@
+package mongo
+
+import (
+	"time"
+)
+
+type paletteColorEntity struct {
+	Name string `bson:"name"`
+	Code string `bson:"code"`
+}
+
+// paletteEntity represents the MongoDB document structure
+type paletteEntity struct {
+	ID         string               `bson:"_id"`
+	Version    int                  `bson:"version"`
+	Name       string               `bson:"name"`
+	Colors     []paletteColorEntity `bson:"colors,omitempty"`
+	CreatedAt  time.Time            `bson:"createdAt"`
+	ModifiedAt time.Time            `bson:"modifiedAt"`
+}
@@ -0,0 +1,45 @@
+package mongo
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+)
+
+type paletteMapper struct{}
+
+func newPaletteMapper() *paletteMapper {
+	return &paletteMapper{}
+}
+
+func (m *paletteMapper) ToEntity(p *palette.Palette) *paletteEntity {
+	return &paletteEntity{
+		ID:      p.ID,
+		Version: p.Version,
+		Name:    p.Name,
+		Colors: lo.Map(p.Colors, func(c palette.Color, _ int) paletteColorEntity {
+			return paletteColorEntity(c)
+		}),
+		CreatedAt:  p.CreatedAt,
+		ModifiedAt: p.ModifiedAt,
+	}
+}
+
+func (m *paletteMapper) ToDomain(e *paletteEntity) *palette.Palette {
+	colors := lo.Map(e.Colors, func(c paletteColorEntity, _ int) palette.Color {
+		return palette.Color(c)
+	})
+	return palette.Reconstruct(e.ID, e.Version, e.Name, colors, e.CreatedAt.UTC(), e.ModifiedAt.UTC())
+}
+
+func (m *paletteMapper) GetID(e *paletteEntity) string {
+	return e.ID
+}
+
+func (m *paletteMapper) GetVersion(e *paletteEntity) int {
+	return e.Version
+}
+
+func (m *paletteMapper) SetVersion(e *paletteEntity, version int) {
+	e.Version = version
+}
@@ -0,0 +1,33 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type paletteRepository struct {
+	*commonsmongo.GenericRepository[palette.Palette, paletteEntity]
+}
+
+func newPaletteRepository(admin commonsmongo.Admin, mapper *paletteMapper, resolver commonsmongo.DatabaseResolver) (palette.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "palette",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paletteRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *paletteRepository) FindAll(ctx context.Context) ([]*palette.Palette, error) {
+	return r.FindAllWithFilter(ctx, bson.D{}, bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+}
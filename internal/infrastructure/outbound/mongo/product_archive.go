@@ -0,0 +1,153 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// productArchiveCollection holds the archived products, next to the product collection of each tenant
+const productArchiveCollection = "product_archive"
+
+func (r *productRepository) FindArchivable(ctx context.Context, before time.Time, limit int) ([]*product.Product, error) {
+	filter := bson.D{{Key: "enabled", Value: false}, {Key: "modifiedAt", Value: bson.D{{Key: "$lt", Value: before}}}}
+	opts := options.Find().SetSort(bson.D{{Key: "modifiedAt", Value: 1}}).SetLimit(int64(limit))
+
+	cursor, err := r.Collection(ctx).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archivable products: %w", err)
+	}
+	var entities []productEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode archivable products: %w", err)
+	}
+	return lo.Map(entities, func(e productEntity, _ int) *product.Product { return r.Mapper().ToDomain(&e) }), nil
+}
+
+// Archive inserts the products into the archive and deletes them from the catalog by ID and version,
+// so a product changed since it was read is left in place; run it in a transaction to roll back the inserts then.
+// The history records the archived products as deleted.
+func (r *productRepository) Archive(ctx context.Context, products []*product.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	now := utc.Now()
+	docs := make([]any, len(products))
+	versions := make(bson.A, len(products))
+	for i, p := range products {
+		e := r.Mapper().ToEntity(p)
+		e.ArchivedAt = &now
+		docs[i] = e
+		versions[i] = bson.D{{Key: "_id", Value: p.ID}, {Key: "version", Value: p.Version}}
+	}
+
+	if _, err := r.archive.Collection(ctx).InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to insert archived products: %w", err)
+	}
+
+	res, err := r.Collection(ctx).DeleteMany(ctx, bson.D{{Key: "$or", Value: versions}})
+	if err != nil {
+		return fmt.Errorf("failed to delete archived products: %w", err)
+	}
+	if res.DeletedCount != int64(len(products)) {
+		return commonsmongo.ErrOptimisticLocking
+	}
+
+	if err := r.revisions.recordDeletions(ctx, lo.Map(products, func(p *product.Product, _ int) string { return p.ID }), now); err != nil {
+		return err
+	}
+
+	for _, p := range products {
+		p.ArchivedAt = &now
+	}
+	return nil
+}
+
+func (r *productRepository) FindArchivedByID(ctx context.Context, id string) (*product.Product, error) {
+	return r.archive.FindByID(ctx, id)
+}
+
+func (r *productRepository) Restore(ctx context.Context, id string) (*product.Product, error) {
+	p, err := r.archive.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	p.ArchivedAt = nil
+	p.ModifiedAt = utc.NowAfter(p.ModifiedAt)
+
+	if err := r.GenericRepository.Insert(ctx, p); err != nil {
+		return nil, mapProductDuplicateKey(err)
+	}
+	if err := r.archive.Delete(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to delete archived product: %w", err)
+	}
+	if err := r.revisions.record(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// findListWithArchive pages through the products matching the filter in both the catalog and the archive
+func (r *productRepository) findListWithArchive(ctx context.Context, filter, sort bson.D, page, size int) (*commonsmongo.PageResult[product.Product], error) {
+	// Same defaults as FindWithOptions
+	page = max(page, 1)
+	if size < 1 {
+		size = 10
+	}
+	if len(sort) == 0 {
+		sort = bson.D{{Key: "_id", Value: 1}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$unionWith", Value: bson.D{
+			{Key: "coll", Value: productArchiveCollection},
+			{Key: "pipeline", Value: bson.A{bson.D{{Key: "$match", Value: filter}}}},
+		}}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "items", Value: bson.A{
+				bson.D{{Key: "$sort", Value: sort}},
+				bson.D{{Key: "$skip", Value: (page - 1) * size}},
+				bson.D{{Key: "$limit", Value: size}},
+			}},
+			{Key: "total", Value: bson.A{bson.D{{Key: "$count", Value: "count"}}}},
+		}}},
+	}
+
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query products: %w", err)
+	}
+	var facets []struct {
+		Items []productEntity `bson:"items"`
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &facets); err != nil {
+		return nil, fmt.Errorf("failed to decode products: %w", err)
+	}
+
+	result := &commonsmongo.PageResult[product.Product]{Items: []*product.Product{}, Page: page, Size: size}
+	if len(facets) == 0 {
+		return result, nil
+	}
+	for i := range facets[0].Items {
+		result.Items = append(result.Items, r.Mapper().ToDomain(&facets[0].Items[i]))
+	}
+	if len(facets[0].Total) > 0 {
+		result.Total = facets[0].Total[0].Count
+	}
+	result.TotalPages = int((result.Total + int64(size) - 1) / int64(size))
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// bulkWriteChunkSize bounds the number of writes sent in one bulkWrite command
+const bulkWriteChunkSize = 500
+
+func (r *productRepository) BulkInsert(ctx context.Context, products []*product.Product) ([]error, error) {
+	errs := make([]error, len(products))
+	for start := 0; start < len(products); start += bulkWriteChunkSize {
+		chunk := products[start:min(start+bulkWriteChunkSize, len(products))]
+
+		models := make([]mongo.WriteModel, len(chunk))
+		for i, p := range chunk {
+			models[i] = mongo.NewInsertOneModel().SetDocument(r.Mapper().ToEntity(p))
+		}
+		if _, err := r.bulkWrite(ctx, models, errs[start:start+len(chunk)]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.revisions.recordAll(ctx, written(products, errs)); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+func (r *productRepository) BulkUpdate(ctx context.Context, products []*product.Product) ([]error, error) {
+	errs := make([]error, len(products))
+	for start := 0; start < len(products); start += bulkWriteChunkSize {
+		chunk := products[start:min(start+bulkWriteChunkSize, len(products))]
+		if err := r.bulkUpdateChunk(ctx, chunk, errs[start:start+len(chunk)]); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, p := range products {
+		if errs[i] == nil {
+			p.Version++
+		}
+	}
+	if err := r.revisions.recordAll(ctx, written(products, errs)); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+// bulkUpdateChunk replaces the products whose stored version matches.
+// A replacement whose filter matches nothing is no write error, so the versions are read first
+// to tell which products conflict; in a transaction a concurrent change in between aborts it instead.
+func (r *productRepository) bulkUpdateChunk(ctx context.Context, products []*product.Product, errs []error) error {
+	versions, err := r.findVersions(ctx, lo.Map(products, func(p *product.Product, _ int) string { return p.ID }))
+	if err != nil {
+		return err
+	}
+
+	var models []mongo.WriteModel
+	var indexes []int
+	for i, p := range products {
+		if v, ok := versions[p.ID]; !ok || v != p.Version {
+			errs[i] = commonsmongo.ErrOptimisticLocking
+			continue
+		}
+		entity := r.Mapper().ToEntity(p)
+		r.Mapper().SetVersion(entity, p.Version+1)
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: p.ID}, {Key: "version", Value: p.Version}}).
+			SetReplacement(entity))
+		indexes = append(indexes, i)
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	writeErrs := make([]error, len(models))
+	res, err := r.bulkWrite(ctx, models, writeErrs)
+	if err != nil {
+		return err
+	}
+	for j, err := range writeErrs {
+		errs[indexes[j]] = err
+	}
+	if res.MatchedCount < int64(lo.CountBy(writeErrs, func(err error) bool { return err == nil })) {
+		return fmt.Errorf("failed to update products: %w", commonsmongo.ErrOptimisticLocking)
+	}
+	return nil
+}
+
+// findVersions returns the stored version of the products with the given IDs that exist
+func (r *productRepository) findVersions(ctx context.Context, ids []string) (map[string]int, error) {
+	cursor, err := r.Collection(ctx).Find(ctx,
+		bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}},
+		options.Find().SetProjection(bson.D{{Key: "version", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find product versions: %w", err)
+	}
+	var stored []struct {
+		ID      string `bson:"_id"`
+		Version int    `bson:"version"`
+	}
+	if err := cursor.All(ctx, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode product versions: %w", err)
+	}
+
+	versions := make(map[string]int, len(stored))
+	for _, s := range stored {
+		versions[s.ID] = s.Version
+	}
+	return versions, nil
+}
+
+// bulkWrite runs an unordered bulkWrite and stores the error of each failed write in errs, by model index
+func (r *productRepository) bulkWrite(ctx context.Context, models []mongo.WriteModel, errs []error) (*mongo.BulkWriteResult, error) {
+	res, err := r.Collection(ctx).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			errs[writeErr.Index] = mapProductDuplicateKey(writeErr.WriteError)
+		}
+		return res, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write products: %w", err)
+	}
+	return res, nil
+}
+
+// written returns the products whose write succeeded
+func written(products []*product.Product, errs []error) []*product.Product {
+	return lo.Filter(products, func(_ *product.Product, i int) bool { return errs[i] == nil })
+}
@@ -13,20 +13,49 @@ type productAttributeEntity struct {
 	NumericValue     *float64 `bson:"numericValue,omitempty"`
 	TextValue        *string  `bson:"textValue,omitempty"`
 	BooleanValue     *bool    `bson:"booleanValue,omitempty"`
+	Unit             *string  `bson:"unit,omitempty"`
+	SubmittedValue   *float64 `bson:"submittedValue,omitempty"`
+	SubmittedUnit    *string  `bson:"submittedUnit,omitempty"`
 }
 
 // productEntity represents the MongoDB document structure
 type productEntity struct {
-	ID          string                   `bson:"_id"`
-	Version     int                      `bson:"version"`
-	Name        string                   `bson:"name"`
+	ID          string   `bson:"_id"`
+	Version     int      `bson:"version"`
+	Name        string   `bson:"name"`
+	NameKey     *string  `bson:"nameKey,omitempty"`
+	Slug        string   `bson:"slug,omitempty"`
+	SlugHistory []string `bson:"slugHistory,omitempty"`
+	// Slugs holds the current and previous slugs for lookups and the unique index across both
+	Slugs       []string                 `bson:"slugs,omitempty"`
+	Type        string                   `bson:"type,omitempty"`
 	Description *string                  `bson:"description,omitempty"`
+	Excerpt     *string                  `bson:"excerpt,omitempty"`
 	Price       float64                  `bson:"price"`
 	Quantity    int                      `bson:"quantity"`
 	ImageID     *string                  `bson:"imageId,omitempty"`
 	CategoryID  *string                  `bson:"categoryId,omitempty"`
 	Enabled     bool                     `bson:"enabled"`
 	Attributes  []productAttributeEntity `bson:"attributes,omitempty"`
-	CreatedAt   time.Time                `bson:"createdAt"`
-	ModifiedAt  time.Time                `bson:"modifiedAt"`
+	Metadata    map[string]string        `bson:"metadata,omitempty"`
+	Experiments map[string]string        `bson:"experiments,omitempty"`
+	SupplierID  *string                  `bson:"supplierId,omitempty"`
+	SupplierSKU *string                  `bson:"supplierSku,omitempty"`
+	ExternalID  *string                  `bson:"externalId,omitempty"`
+	// QualityScore is stored for filtering and sorting; documents written before scoring have none and read as 0
+	QualityScore int `bson:"qualityScore"`
+	// UnitsSold is only incremented by RecordSales; documents written before sales were counted have none
+	UnitsSold int64 `bson:"unitsSold"`
+	// Views is only changed by RecordView and DecayViews
+	Views      float64   `bson:"views"`
+	CreatedAt  time.Time `bson:"createdAt"`
+	ModifiedAt time.Time `bson:"modifiedAt"`
+	// Lifecycle timestamps, missing until the product is enabled or discontinued
+	FirstPublishedAt *time.Time `bson:"firstPublishedAt,omitempty"`
+	LastEnabledAt    *time.Time `bson:"lastEnabledAt,omitempty"`
+	DiscontinuedAt   *time.Time `bson:"discontinuedAt,omitempty"`
+	// ReplacementProductID is only set on discontinued products
+	ReplacementProductID *string `bson:"replacementProductId,omitempty"`
+	// ArchivedAt is only set on documents of the archive collection
+	ArchivedAt *time.Time `bson:"archivedAt,omitempty"`
 }
@@ -1,38 +1,69 @@
 package mongo
 
 import (
+	"strings"
+
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
 	"github.com/samber/lo"
 )
 
-type productMapper struct{}
+type productMapper struct {
+	uniqueNames bool
+}
 
-func newProductMapper() *productMapper {
-	return &productMapper{}
+func newProductMapper(cfg ProductConfig) *productMapper {
+	return &productMapper{uniqueNames: cfg.UniqueNamesPerCategory}
 }
 
 func (m *productMapper) ToEntity(p *product.Product) *productEntity {
-	return &productEntity{
-		ID:          p.ID,
-		Version:     p.Version,
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Quantity:    p.Quantity,
-		ImageID:     p.ImageID,
-		CategoryID:  p.CategoryID,
-		Enabled:     p.Enabled,
-		Attributes:  m.attributesToEntities(p.Attributes),
-		CreatedAt:   p.CreatedAt,
-		ModifiedAt:  p.ModifiedAt,
+	e := &productEntity{
+		ID:           p.ID,
+		Version:      p.Version,
+		Name:         p.Name,
+		NameKey:      m.nameKey(p),
+		Slug:         p.Slug,
+		SlugHistory:  p.SlugHistory,
+		Slugs:        p.Slugs(),
+		Type:         string(p.Type),
+		Description:  p.Description,
+		Excerpt:      p.Excerpt,
+		Price:        p.Price,
+		Quantity:     p.Quantity,
+		ImageID:      p.ImageID,
+		CategoryID:   p.CategoryID,
+		Enabled:      p.Enabled,
+		Attributes:   m.attributesToEntities(p.Attributes),
+		Metadata:     p.Metadata,
+		Experiments:  p.Experiments,
+		ExternalID:   p.ExternalID,
+		QualityScore: p.QualityScore,
+		UnitsSold:    p.UnitsSold,
+		Views:        p.Views,
+		CreatedAt:    p.CreatedAt,
+		ModifiedAt:   p.ModifiedAt,
+		ArchivedAt:   p.ArchivedAt,
+
+		FirstPublishedAt: p.FirstPublishedAt,
+		LastEnabledAt:    p.LastEnabledAt,
+		DiscontinuedAt:   p.DiscontinuedAt,
+
+		ReplacementProductID: p.ReplacementProductID,
+	}
+	if p.Supplier != nil {
+		e.SupplierID = &p.Supplier.SupplierID
+		e.SupplierSKU = p.Supplier.SKU
 	}
+	return e
 }
 
 func (m *productMapper) ToDomain(e *productEntity) *product.Product {
-	return product.Reconstruct(
+	p := product.Reconstruct(
 		e.ID,
 		e.Version,
 		e.Name,
+		e.Slug,
+		e.SlugHistory,
+		m.typeToDomain(e.Type),
 		e.Description,
 		e.Price,
 		e.Quantity,
@@ -40,9 +71,51 @@ func (m *productMapper) ToDomain(e *productEntity) *product.Product {
 		e.CategoryID,
 		e.Enabled,
 		m.attributesToDomain(e.Attributes),
+		e.Metadata,
+		m.supplierToDomain(e.SupplierID, e.SupplierSKU),
+		e.ExternalID,
 		e.CreatedAt.UTC(),
 		e.ModifiedAt.UTC(),
 	)
+	p.Excerpt = e.Excerpt
+	p.QualityScore = e.QualityScore
+	p.Experiments = e.Experiments
+	p.UnitsSold = e.UnitsSold
+	p.Views = e.Views
+	p.FirstPublishedAt = utcPtr(e.FirstPublishedAt)
+	p.LastEnabledAt = utcPtr(e.LastEnabledAt)
+	p.DiscontinuedAt = utcPtr(e.DiscontinuedAt)
+	p.ReplacementProductID = e.ReplacementProductID
+	if e.ArchivedAt != nil {
+		archivedAt := e.ArchivedAt.UTC()
+		p.ArchivedAt = &archivedAt
+	}
+	return p
+}
+
+// nameKey is the key the unique name index of a category is built on. It is only stored while
+// the rule is enabled, documents without it are left out of the partial index.
+func (m *productMapper) nameKey(p *product.Product) *string {
+	if !m.uniqueNames || p.CategoryID == nil {
+		return nil
+	}
+	key := strings.ToLower(strings.TrimSpace(p.Name))
+	return &key
+}
+
+// typeToDomain treats documents stored before product types were introduced as physical products
+func (m *productMapper) typeToDomain(t string) product.ProductType {
+	if t == "" {
+		return product.ProductTypePhysical
+	}
+	return product.ProductType(t)
+}
+
+func (m *productMapper) supplierToDomain(supplierID, sku *string) *product.SupplierRef {
+	if supplierID == nil {
+		return nil
+	}
+	return &product.SupplierRef{SupplierID: *supplierID, SKU: sku}
 }
 
 func (m *productMapper) GetID(e *productEntity) string {
@@ -66,7 +139,7 @@ func (m *productMapper) attributesToEntities(attrs []product.AttributeValue) []p
 }
 
 func mapProductAttributeToEntity(attr product.AttributeValue, _ int) productAttributeEntity {
-	return productAttributeEntity{
+	e := productAttributeEntity{
 		AttributeID:      attr.AttributeID,
 		AttributeSlug:    attr.AttributeSlug,
 		OptionSlugValue:  attr.OptionSlugValue,
@@ -74,7 +147,13 @@ func mapProductAttributeToEntity(attr product.AttributeValue, _ int) productAttr
 		NumericValue:     attr.NumericValue,
 		TextValue:        attr.TextValue,
 		BooleanValue:     attr.BooleanValue,
+		Unit:             attr.Unit,
 	}
+	if attr.Submitted != nil {
+		e.SubmittedValue = &attr.Submitted.Value
+		e.SubmittedUnit = &attr.Submitted.Unit
+	}
+	return e
 }
 
 func (m *productMapper) attributesToDomain(entities []productAttributeEntity) []product.AttributeValue {
@@ -86,7 +165,7 @@ func (m *productMapper) attributesToDomain(entities []productAttributeEntity) []
 }
 
 func mapProductAttributeToDomain(e productAttributeEntity, _ int) product.AttributeValue {
-	return product.AttributeValue{
+	v := product.AttributeValue{
 		AttributeID:      e.AttributeID,
 		AttributeSlug:    e.AttributeSlug,
 		OptionSlugValue:  e.OptionSlugValue,
@@ -94,5 +173,10 @@ func mapProductAttributeToDomain(e productAttributeEntity, _ int) product.Attrib
 		NumericValue:     e.NumericValue,
 		TextValue:        e.TextValue,
 		BooleanValue:     e.BooleanValue,
+		Unit:             e.Unit,
+	}
+	if e.SubmittedValue != nil && e.SubmittedUnit != nil {
+		v.Submitted = &product.SubmittedValue{Value: *e.SubmittedValue, Unit: *e.SubmittedUnit}
 	}
+	return v
 }
@@ -19,7 +19,7 @@ func ptrBool(v bool) *bool {
 }
 
 func TestProductMapper_ToEntity(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	t.Run("maps all fields correctly", func(t *testing.T) {
 		now := time.Now().UTC()
@@ -27,6 +27,9 @@ func TestProductMapper_ToEntity(t *testing.T) {
 			"prod-123",
 			2,
 			"iPhone 15 Pro",
+			"",
+			nil,
+			product.ProductTypePhysical,
 			ptr("Latest iPhone model"),
 			999.99,
 			50,
@@ -47,6 +50,8 @@ func TestProductMapper_ToEntity(t *testing.T) {
 					NumericValue: ptrFloat64(187.5),
 				},
 			},
+			nil,
+			nil, nil,
 			now,
 			now,
 		)
@@ -83,6 +88,9 @@ func TestProductMapper_ToEntity(t *testing.T) {
 			"prod-456",
 			1,
 			"Simple Product",
+			"",
+			nil,
+			product.ProductTypePhysical,
 			nil,
 			10.0,
 			100,
@@ -90,6 +98,8 @@ func TestProductMapper_ToEntity(t *testing.T) {
 			nil,
 			false,
 			nil,
+			nil,
+			nil, nil,
 			now,
 			now,
 		)
@@ -112,6 +122,9 @@ func TestProductMapper_ToEntity(t *testing.T) {
 			"prod-789",
 			1,
 			"Test Product",
+			"",
+			nil,
+			product.ProductTypePhysical,
 			nil,
 			50.0,
 			10,
@@ -125,6 +138,8 @@ func TestProductMapper_ToEntity(t *testing.T) {
 				{AttributeID: "text", TextValue: ptr("Some text value")},
 				{AttributeID: "boolean", BooleanValue: ptrBool(true)},
 			},
+			nil,
+			nil, nil,
 			now,
 			now,
 		)
@@ -140,8 +155,18 @@ func TestProductMapper_ToEntity(t *testing.T) {
 	})
 }
 
+func TestProductMapper_NameKey(t *testing.T) {
+	p := product.Reconstruct("prod-1", 1, "  Blue Shirt ", "", nil, product.ProductTypePhysical, nil, 10, 1, nil, ptr("cat-shirts"), false, nil, nil, nil, nil, time.Now(), time.Now())
+
+	assert.Nil(t, newProductMapper(ProductConfig{}).ToEntity(p).NameKey, "rule disabled")
+	assert.Equal(t, ptr("blue shirt"), newProductMapper(ProductConfig{UniqueNamesPerCategory: true}).ToEntity(p).NameKey)
+
+	p.CategoryID = nil
+	assert.Nil(t, newProductMapper(ProductConfig{UniqueNamesPerCategory: true}).ToEntity(p).NameKey, "uncategorized products are not checked")
+}
+
 func TestProductMapper_ToDomain(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	t.Run("maps all fields correctly", func(t *testing.T) {
 		now := time.Now().UTC()
@@ -149,6 +174,7 @@ func TestProductMapper_ToDomain(t *testing.T) {
 			ID:          "prod-123",
 			Version:     5,
 			Name:        "MacBook Pro",
+			Type:        "service",
 			Description: ptr("Professional laptop"),
 			Price:       2499.99,
 			Quantity:    25,
@@ -170,6 +196,7 @@ func TestProductMapper_ToDomain(t *testing.T) {
 		assert.Equal(t, "prod-123", domain.ID)
 		assert.Equal(t, 5, domain.Version)
 		assert.Equal(t, "MacBook Pro", domain.Name)
+		assert.Equal(t, product.ProductTypeService, domain.Type)
 		assert.Equal(t, ptr("Professional laptop"), domain.Description)
 		assert.Equal(t, float64(2499.99), domain.Price)
 		assert.Equal(t, 25, domain.Quantity)
@@ -201,6 +228,7 @@ func TestProductMapper_ToDomain(t *testing.T) {
 
 		require.NotNil(t, domain)
 		assert.Equal(t, "prod-456", domain.ID)
+		assert.Equal(t, product.ProductTypePhysical, domain.Type, "documents without a type are physical products")
 		assert.Nil(t, domain.Description)
 		assert.Nil(t, domain.ImageID)
 		assert.Nil(t, domain.CategoryID)
@@ -230,7 +258,7 @@ func TestProductMapper_ToDomain(t *testing.T) {
 }
 
 func TestProductMapper_GetID(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	entity := &productEntity{ID: "product-id-xyz"}
 
@@ -238,7 +266,7 @@ func TestProductMapper_GetID(t *testing.T) {
 }
 
 func TestProductMapper_GetVersion(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	entity := &productEntity{Version: 12}
 
@@ -246,7 +274,7 @@ func TestProductMapper_GetVersion(t *testing.T) {
 }
 
 func TestProductMapper_SetVersion(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	entity := &productEntity{Version: 1}
 
@@ -256,7 +284,7 @@ func TestProductMapper_SetVersion(t *testing.T) {
 }
 
 func TestProductMapper_RoundTrip(t *testing.T) {
-	mapper := newProductMapper()
+	mapper := newProductMapper(ProductConfig{})
 
 	t.Run("domain -> entity -> domain preserves all data", func(t *testing.T) {
 		now := time.Now().UTC().Truncate(time.Millisecond)
@@ -264,6 +292,9 @@ func TestProductMapper_RoundTrip(t *testing.T) {
 			"prod-roundtrip",
 			3,
 			"Samsung Galaxy S24",
+			"",
+			nil,
+			product.ProductTypePhysical,
 			ptr("Flagship smartphone"),
 			899.99,
 			100,
@@ -277,9 +308,17 @@ func TestProductMapper_RoundTrip(t *testing.T) {
 				{AttributeID: "notes", TextValue: ptr("Includes charger")},
 				{AttributeID: "5g", BooleanValue: ptrBool(true)},
 			},
+			map[string]string{"erp.code": "SM-S921"},
+			nil, nil,
 			now,
 			now,
 		)
+		original.Excerpt = ptr("Flagship smartphone")
+		original.QualityScore = 85
+		original.FirstPublishedAt = &now
+		original.LastEnabledAt = &now
+		original.DiscontinuedAt = &now
+		original.ReplacementProductID = ptr("product-2")
 
 		entity := mapper.ToEntity(original)
 		restored := mapper.ToDomain(entity)
@@ -288,6 +327,12 @@ func TestProductMapper_RoundTrip(t *testing.T) {
 		assert.Equal(t, original.Version, restored.Version)
 		assert.Equal(t, original.Name, restored.Name)
 		assert.Equal(t, original.Description, restored.Description)
+		assert.Equal(t, original.Excerpt, restored.Excerpt)
+		assert.Equal(t, original.QualityScore, restored.QualityScore)
+		assert.Equal(t, original.FirstPublishedAt, restored.FirstPublishedAt)
+		assert.Equal(t, original.LastEnabledAt, restored.LastEnabledAt)
+		assert.Equal(t, original.DiscontinuedAt, restored.DiscontinuedAt)
+		assert.Equal(t, original.ReplacementProductID, restored.ReplacementProductID)
 		assert.Equal(t, original.Price, restored.Price)
 		assert.Equal(t, original.Quantity, restored.Quantity)
 		assert.Equal(t, original.ImageID, restored.ImageID)
@@ -295,6 +340,7 @@ func TestProductMapper_RoundTrip(t *testing.T) {
 		assert.Equal(t, original.Enabled, restored.Enabled)
 		assert.Equal(t, original.CreatedAt, restored.CreatedAt)
 		assert.Equal(t, original.ModifiedAt, restored.ModifiedAt)
+		assert.Equal(t, original.Metadata, restored.Metadata)
 
 		require.Len(t, restored.Attributes, len(original.Attributes))
 		for i, attr := range original.Attributes {
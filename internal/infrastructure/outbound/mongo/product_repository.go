@@ -2,14 +2,34 @@ package mongo
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// streamBatchSize is the number of products a stream cursor fetches per round trip
+const streamBatchSize = 500
+
+const (
+	// productNameIndex enforces unique names within a category, see ProductConfig.UniqueNamesPerCategory
+	productNameIndex = "product_categoryId_nameKey_unique_v1"
+	// productSlugIndex keeps current and previous slugs from resolving to more than one product
+	productSlugIndex = "product_slugs_unique_v1"
+	// productExternalIDIndex keeps an imported feed key from matching more than one product
+	productExternalIDIndex = "product_externalId_unique_v1"
 )
 
 type productRepository struct {
 	*commonsmongo.GenericRepository[product.Product, productEntity]
+	revisions *revisionStore[product.Product, productEntity]
+	archive   *commonsmongo.GenericRepository[product.Product, productEntity]
 }
 
 func newProductRepository(admin commonsmongo.Admin, mapper *productMapper, resolver commonsmongo.DatabaseResolver) (product.Repository, error) {
@@ -22,20 +42,52 @@ func newProductRepository(admin commonsmongo.Admin, mapper *productMapper, resol
 		return nil, err
 	}
 
+	revisions, err := newRevisionStore(admin, "product", mapper, func(e *productEntity) time.Time { return e.ModifiedAt }, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := commonsmongo.NewTenantRepository(admin, productArchiveCollection, mapper, resolver)
+	if err != nil {
+		return nil, err
+	}
+
 	return &productRepository{
 		GenericRepository: genericRepo,
+		revisions:         revisions,
+		archive:           archive,
 	}, nil
 }
 
-func (r *productRepository) FindList(ctx context.Context, query product.ListQuery) (*commonsmongo.PageResult[product.Product], error) {
-	filter := bson.D{}
-	if query.Enabled != nil {
-		filter = append(filter, bson.E{Key: "enabled", Value: *query.Enabled})
+func (r *productRepository) FindAsOf(ctx context.Context, id string, asOf time.Time) (*product.Product, error) {
+	return r.revisions.findAsOf(ctx, id, asOf)
+}
+
+func (r *productRepository) FindByIDs(ctx context.Context, ids []string) ([]*product.Product, error) {
+	if len(ids) == 0 {
+		return []*product.Product{}, nil
 	}
-	if query.CategoryID != nil {
-		filter = append(filter, bson.E{Key: "categoryId", Value: *query.CategoryID})
+
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}
+	return r.FindAllWithFilter(ctx, filter, nil)
+}
+
+func (r *productRepository) FindByExternalIDs(ctx context.Context, externalIDs []string) ([]*product.Product, error) {
+	if len(externalIDs) == 0 {
+		return []*product.Product{}, nil
 	}
 
+	filter := bson.D{{Key: "externalId", Value: bson.D{{Key: "$in", Value: externalIDs}}}}
+	return r.FindAllWithFilter(ctx, filter, nil)
+}
+
+func (r *productRepository) FindBySlug(ctx context.Context, slug string) (*product.Product, error) {
+	return r.FindOneByFilter(ctx, bson.D{{Key: "slugs", Value: slug}})
+}
+
+func (r *productRepository) FindList(ctx context.Context, query product.ListQuery) (*commonsmongo.PageResult[product.Product], error) {
+	filter := productListFilter(query)
+
 	var sortBson bson.D
 	if query.Sort != "" {
 		sortOrder := 1 // asc
@@ -45,6 +97,10 @@ func (r *productRepository) FindList(ctx context.Context, query product.ListQuer
 		sortBson = bson.D{{Key: query.Sort, Value: sortOrder}}
 	}
 
+	if query.IncludeArchived {
+		return r.findListWithArchive(ctx, filter, sortBson, query.Page, query.Size)
+	}
+
 	opts := commonsmongo.QueryOptions{
 		Filter: filter,
 		Page:   query.Page,
@@ -54,3 +110,369 @@ func (r *productRepository) FindList(ctx context.Context, query product.ListQuer
 
 	return r.FindWithOptions(ctx, opts)
 }
+
+func (r *productRepository) Stream(ctx context.Context, query product.ListQuery, yield func(*product.Product) error) error {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetBatchSize(streamBatchSize)
+	cursor, err := r.Collection(ctx).Find(ctx, productListFilter(query), opts)
+	if err != nil {
+		return fmt.Errorf("failed to query products: %w", err)
+	}
+	defer cursor.Close(ctx) //nolint:errcheck // closing a drained or failed cursor has nothing to report
+
+	for cursor.Next(ctx) {
+		var e productEntity
+		if err := cursor.Decode(&e); err != nil {
+			return fmt.Errorf("failed to decode product: %w", err)
+		}
+		if err := yield(r.Mapper().ToDomain(&e)); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("failed to read products: %w", err)
+	}
+	return nil
+}
+
+func (r *productRepository) Sample(ctx context.Context, query product.ListQuery, size int) ([]*product.Product, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: productListFilter(query)}},
+		{{Key: "$sample", Value: bson.D{{Key: "size", Value: size}}}},
+	}
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample products: %w", err)
+	}
+	var entities []productEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode sampled products: %w", err)
+	}
+
+	// $sample may pick a document twice when it runs on a random cursor over a large collection
+	seen := make(map[string]bool, len(entities))
+	products := make([]*product.Product, 0, len(entities))
+	for i := range entities {
+		if seen[entities[i].ID] {
+			continue
+		}
+		seen[entities[i].ID] = true
+		products = append(products, r.Mapper().ToDomain(&entities[i]))
+	}
+	return products, nil
+}
+
+func (r *productRepository) PriceStats(ctx context.Context, categoryID string) (*product.PriceStats, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "categoryId", Value: categoryID}, {Key: "enabled", Value: true}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "min", Value: bson.D{{Key: "$min", Value: "$price"}}},
+			{Key: "max", Value: bson.D{{Key: "$max", Value: "$price"}}},
+			{Key: "avg", Value: bson.D{{Key: "$avg", Value: "$price"}}},
+		}}},
+	}
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate product prices: %w", err)
+	}
+	var groups []struct {
+		Count int64   `bson:"count"`
+		Min   float64 `bson:"min"`
+		Max   float64 `bson:"max"`
+		Avg   float64 `bson:"avg"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode product prices: %w", err)
+	}
+
+	stats := &product.PriceStats{}
+	if len(groups) > 0 {
+		stats.Count, stats.Min, stats.Max, stats.Avg = groups[0].Count, groups[0].Min, groups[0].Max, groups[0].Avg
+	}
+	return stats, nil
+}
+
+func (r *productRepository) OptionCounts(ctx context.Context, categoryID string, attributeIDs []string) (map[string]map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "categoryId", Value: categoryID}, {Key: "enabled", Value: true}}}},
+		{{Key: "$unwind", Value: "$attributes"}},
+		{{Key: "$match", Value: bson.D{{Key: "attributes.attributeId", Value: bson.D{{Key: "$in", Value: attributeIDs}}}}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "attributeId", Value: "$attributes.attributeId"},
+			{Key: "options", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$ifNull", Value: bson.A{"$attributes.optionSlugValue", false}}},
+					bson.A{"$attributes.optionSlugValue"},
+					bson.A{},
+				}}},
+				bson.D{{Key: "$ifNull", Value: bson.A{"$attributes.optionSlugValues", bson.A{}}}},
+			}}}},
+		}}},
+		{{Key: "$unwind", Value: "$options"}},
+		// A product repeating an option, as stored before duplicates were merged, counts once
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: bson.D{
+			{Key: "product", Value: "$_id"},
+			{Key: "attributeId", Value: "$attributeId"},
+			{Key: "option", Value: "$options"},
+		}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "attributeId", Value: "$_id.attributeId"}, {Key: "option", Value: "$_id.option"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate attribute options: %w", err)
+	}
+	var groups []struct {
+		ID struct {
+			AttributeID string `bson:"attributeId"`
+			Option      string `bson:"option"`
+		} `bson:"_id"`
+		Count int64 `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode attribute options: %w", err)
+	}
+
+	counts := make(map[string]map[string]int64)
+	for _, g := range groups {
+		if counts[g.ID.AttributeID] == nil {
+			counts[g.ID.AttributeID] = make(map[string]int64)
+		}
+		counts[g.ID.AttributeID][g.ID.Option] = g.Count
+	}
+	return counts, nil
+}
+
+func (r *productRepository) CountByCategory(ctx context.Context) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "enabled", Value: true}, {Key: "categoryId", Value: bson.D{{Key: "$ne", Value: nil}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$categoryId"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate product counts: %w", err)
+	}
+	var groups []struct {
+		CategoryID string `bson:"_id"`
+		Count      int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode product counts: %w", err)
+	}
+
+	counts := make(map[string]int64, len(groups))
+	for _, g := range groups {
+		counts[g.CategoryID] = g.Count
+	}
+	return counts, nil
+}
+
+func (r *productRepository) CountUncategorized(ctx context.Context) ([]product.UncategorizedCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "categoryId", Value: nil}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$supplierId", ""}}}},
+			{Key: "products", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "enabled", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{"$enabled", 1, 0}}}}}},
+		}}},
+	}
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate uncategorized products: %w", err)
+	}
+	var groups []struct {
+		SupplierID string `bson:"_id"`
+		Products   int64  `bson:"products"`
+		Enabled    int64  `bson:"enabled"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode uncategorized products: %w", err)
+	}
+
+	counts := make([]product.UncategorizedCount, len(groups))
+	for i, g := range groups {
+		counts[i] = product.UncategorizedCount{SupplierID: g.SupplierID, Products: g.Products, Enabled: g.Enabled}
+	}
+	return counts, nil
+}
+
+func (r *productRepository) Valuation(ctx context.Context, costKey string) ([]product.CategoryValuation, error) {
+	group := bson.D{
+		{Key: "_id", Value: "$categoryId"},
+		{Key: "products", Value: bson.D{{Key: "$sum", Value: 1}}},
+		{Key: "units", Value: bson.D{{Key: "$sum", Value: "$quantity"}}},
+		{Key: "stockValue", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$multiply", Value: bson.A{"$price", "$quantity"}}}}}},
+	}
+	pipeline := mongo.Pipeline{
+		// Products stored before types default to physical
+		{{Key: "$match", Value: bson.D{{Key: "type", Value: bson.D{{Key: "$ne", Value: string(product.ProductTypeService)}}}}}},
+	}
+	if costKey != "" {
+		// $getField reads keys with dots, such as erp.cost, as a single field
+		pipeline = append(pipeline, bson.D{{Key: "$set", Value: bson.D{{Key: "cost", Value: bson.D{{Key: "$convert", Value: bson.D{
+			{Key: "input", Value: bson.D{{Key: "$getField", Value: bson.D{{Key: "field", Value: costKey}, {Key: "input", Value: "$metadata"}}}}},
+			{Key: "to", Value: "double"},
+			{Key: "onError", Value: nil},
+			{Key: "onNull", Value: nil},
+		}}}}}}})
+		group = append(group,
+			bson.E{Key: "costValue", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$multiply", Value: bson.A{"$cost", "$quantity"}}}}}},
+			bson.E{Key: "missingCost", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{"$cost", nil}}}, 1, 0,
+			}}}}}},
+		)
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: group}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	)
+
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate product stock: %w", err)
+	}
+	var groups []struct {
+		CategoryID  *string `bson:"_id"`
+		Products    int64   `bson:"products"`
+		Units       int64   `bson:"units"`
+		StockValue  float64 `bson:"stockValue"`
+		CostValue   float64 `bson:"costValue"`
+		MissingCost int64   `bson:"missingCost"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode product stock: %w", err)
+	}
+
+	valuations := make([]product.CategoryValuation, len(groups))
+	for i, g := range groups {
+		valuations[i] = product.CategoryValuation{
+			CategoryID:  lo.FromPtr(g.CategoryID),
+			Products:    g.Products,
+			Units:       g.Units,
+			StockValue:  g.StockValue,
+			MissingCost: g.MissingCost,
+		}
+		if costKey != "" {
+			valuations[i].CostValue = &g.CostValue
+		}
+	}
+	return valuations, nil
+}
+
+// productListFilter matches the products of a list query
+func productListFilter(query product.ListQuery) bson.D {
+	filter := bson.D{}
+	if query.Enabled != nil {
+		filter = append(filter, bson.E{Key: "enabled", Value: *query.Enabled})
+	}
+	if query.CategoryID != nil {
+		filter = append(filter, bson.E{Key: "categoryId", Value: *query.CategoryID})
+	}
+	if query.SupplierID != nil {
+		filter = append(filter, bson.E{Key: "supplierId", Value: *query.SupplierID})
+	}
+	if query.HasImage != nil {
+		if *query.HasImage {
+			filter = append(filter, bson.E{Key: "imageId", Value: bson.D{{Key: "$ne", Value: nil}}})
+		} else {
+			filter = append(filter, bson.E{Key: "imageId", Value: nil})
+		}
+	}
+	if query.HasCategory != nil {
+		if *query.HasCategory {
+			filter = append(filter, bson.E{Key: "categoryId", Value: bson.D{{Key: "$ne", Value: nil}}})
+		} else {
+			filter = append(filter, bson.E{Key: "categoryId", Value: nil})
+		}
+	}
+	if query.AttributeID != nil {
+		filter = append(filter, attributeValueFilter(*query.AttributeID, query.OptionSlug))
+	}
+	if query.AfterID != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: query.AfterID}}})
+	}
+	if query.ModifiedAfter != nil {
+		filter = append(filter, bson.E{Key: "modifiedAt", Value: bson.D{{Key: "$gt", Value: *query.ModifiedAfter}}})
+	}
+	if query.CreatedAfter != nil {
+		filter = append(filter, bson.E{Key: "createdAt", Value: bson.D{{Key: "$gt", Value: *query.CreatedAfter}}})
+	}
+	if query.MinQualityScore != nil || query.MaxQualityScore != nil {
+		bounds := bson.D{}
+		if query.MinQualityScore != nil {
+			bounds = append(bounds, bson.E{Key: "$gte", Value: *query.MinQualityScore})
+		}
+		if query.MaxQualityScore != nil {
+			bounds = append(bounds, bson.E{Key: "$lte", Value: *query.MaxQualityScore})
+		}
+		filter = append(filter, bson.E{Key: "qualityScore", Value: bounds})
+	}
+	return filter
+}
+
+// Override Insert to handle duplicate name and slug errors and record the revision
+// attributeValueFilter matches the products with a value for the attribute picking the option, if given,
+// as their single or one of their multiple options
+func attributeValueFilter(attributeID string, optionSlug *string) bson.E {
+	if optionSlug == nil {
+		return bson.E{Key: "attributes.attributeId", Value: attributeID}
+	}
+	return bson.E{Key: "attributes", Value: bson.D{{Key: "$elemMatch", Value: bson.D{
+		{Key: "attributeId", Value: attributeID},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "optionSlugValue", Value: *optionSlug}},
+			bson.D{{Key: "optionSlugValues", Value: *optionSlug}},
+		}},
+	}}}}
+}
+
+func (r *productRepository) Insert(ctx context.Context, p *product.Product) error {
+	if err := r.GenericRepository.Insert(ctx, p); err != nil {
+		return mapProductDuplicateKey(err)
+	}
+	return r.revisions.record(ctx, p)
+}
+
+// Override Update to handle duplicate name and slug errors and record the revision
+func (r *productRepository) Update(ctx context.Context, p *product.Product) (*product.Product, error) {
+	result, err := r.GenericRepository.Update(ctx, p)
+	if err != nil {
+		return nil, mapProductDuplicateKey(err)
+	}
+	if err := r.revisions.record(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Override Delete to record the deletion in the revision history
+func (r *productRepository) Delete(ctx context.Context, id string) error {
+	if err := r.GenericRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.revisions.recordDeletion(ctx, id)
+}
+
+// mapProductDuplicateKey tells name, slug and external ID conflicts from other duplicate keys, such as a retried create with the same ID
+func mapProductDuplicateKey(err error) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	switch {
+	case strings.Contains(err.Error(), productNameIndex):
+		return product.ErrNameAlreadyExists
+	case strings.Contains(err.Error(), productSlugIndex):
+		return product.ErrSlugAlreadyExists
+	case strings.Contains(err.Error(), productExternalIDIndex):
+		return product.ErrExternalIDAlreadyExists
+	default:
+		return err
+	}
+}
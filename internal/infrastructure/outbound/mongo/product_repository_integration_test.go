@@ -4,9 +4,13 @@ package mongo
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	"github.com/google/uuid"
@@ -22,7 +26,10 @@ func TestProductRepository_Insert(t *testing.T) {
 	categoryID := uuid.New().String()
 	imageID := uuid.New().String()
 	prod, err := product.NewProduct(
+		limits.Default().Product,
 		"Test Product",
+		"",
+		product.ProductTypePhysical,
 		ptrI("A test product description"),
 		99.99,
 		10,
@@ -56,7 +63,10 @@ func TestProductRepository_Update(t *testing.T) {
 	ctx := context.Background()
 
 	prod, err := product.NewProduct(
+		limits.Default().Product,
 		"Original Name",
+		"",
+		product.ProductTypePhysical,
 		nil,
 		10.00,
 		5,
@@ -73,7 +83,7 @@ func TestProductRepository_Update(t *testing.T) {
 	// Update using domain method (modifies in place) - enable product requires image and category
 	imageID := uuid.New().String()
 	categoryID := uuid.New().String()
-	err = prod.Update("Updated Name", ptrI("New description"), 20.00, 15, &imageID, &categoryID, true, nil)
+	err = prod.Update(limits.Default().Product, "Updated Name", "", ptrI("New description"), 20.00, 15, &imageID, &categoryID, true, nil)
 	require.NoError(t, err)
 
 	result, err := testProductRepo.Update(ctx, prod)
@@ -94,7 +104,10 @@ func TestProductRepository_FindByID(t *testing.T) {
 	ctx := context.Background()
 
 	prod, err := product.NewProduct(
+		limits.Default().Product,
 		"Find Me",
+		"",
+		product.ProductTypePhysical,
 		nil,
 		5.00,
 		1,
@@ -128,9 +141,9 @@ func TestProductRepository_FindList(t *testing.T) {
 	imageID := uuid.New().String()
 
 	// Create test products
-	prod1, _ := product.NewProduct("Product 1", nil, 10.00, 1, nil, nil, false, nil)
-	prod2, _ := product.NewProduct("Product 2", nil, 20.00, 2, &imageID, &categoryID, true, nil)
-	prod3, _ := product.NewProduct("Product 3", nil, 30.00, 3, &imageID, &categoryID, true, nil)
+	prod1, _ := product.NewProduct(limits.Default().Product, "Product 1", "", product.ProductTypePhysical, nil, 10.00, 1, nil, nil, false, nil)
+	prod2, _ := product.NewProduct(limits.Default().Product, "Product 2", "", product.ProductTypePhysical, nil, 20.00, 2, &imageID, &categoryID, true, nil)
+	prod3, _ := product.NewProduct(limits.Default().Product, "Product 3", "", product.ProductTypePhysical, nil, 30.00, 3, &imageID, &categoryID, true, nil)
 
 	// Add delay to ensure different createdAt times
 	require.NoError(t, testProductRepo.Insert(ctx, prod1))
@@ -182,3 +195,539 @@ func TestProductRepository_FindList(t *testing.T) {
 	assert.Len(t, result.Items, 1)
 	assert.Equal(t, 2, result.Page)
 }
+
+func TestProductRepository_FindList_ByAttributeValue(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	insert := func(attrs ...product.AttributeValue) *product.Product {
+		prod, err := product.NewProduct(limits.Default().Product, uuid.New().String(), "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, attrs)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+		return prod
+	}
+	red, blue := "red", "blue"
+	single := insert(product.AttributeValue{AttributeID: "color", OptionSlugValue: &red})
+	multiple := insert(product.AttributeValue{AttributeID: "color", OptionSlugValues: []string{"blue", "red"}})
+	other := insert(product.AttributeValue{AttributeID: "color", OptionSlugValue: &blue})
+	// Another attribute picking the option doesn't match
+	mixed := insert(product.AttributeValue{AttributeID: "color", OptionSlugValue: &blue}, product.AttributeValue{AttributeID: "fit", OptionSlugValue: &red})
+	insert(product.AttributeValue{AttributeID: "fit", OptionSlugValue: &red})
+
+	ids := func(query product.ListQuery) []string {
+		query.Sort, query.Size = "_id", 10
+		result, err := testProductRepo.FindList(ctx, query)
+		require.NoError(t, err)
+		return productIDs(result.Items...)
+	}
+	color := "color"
+	sorted := func(ps ...*product.Product) []string {
+		ids := productIDs(ps...)
+		slices.Sort(ids)
+		return ids
+	}
+
+	assert.Equal(t, sorted(single, multiple), ids(product.ListQuery{AttributeID: &color, OptionSlug: &red}))
+	assert.Len(t, ids(product.ListQuery{AttributeID: &color}), 4)
+	assert.Equal(t, sorted(multiple, other, mixed), ids(product.ListQuery{AttributeID: &color, OptionSlug: &blue}))
+}
+
+func productIDs(products ...*product.Product) []string {
+	ids := make([]string, len(products))
+	for i, p := range products {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestProductRepository_Stream(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+
+	categoryID := uuid.New().String()
+	var inCategory []string
+	for i := range 5 {
+		var category *string
+		if i%2 == 0 {
+			category = &categoryID
+		}
+		p, err := product.NewProduct(limits.Default().Product, fmt.Sprintf("Product %d", i), "", product.ProductTypePhysical, nil, 10, 1, nil, category, false, nil)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, p))
+		if category != nil {
+			inCategory = append(inCategory, p.ID)
+		}
+	}
+	slices.Sort(inCategory)
+
+	var streamed []string
+	err := testProductRepo.Stream(ctx, product.ListQuery{CategoryID: &categoryID}, func(p *product.Product) error {
+		streamed = append(streamed, p.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, inCategory, streamed)
+
+	stop := errors.New("stop")
+	err = testProductRepo.Stream(ctx, product.ListQuery{}, func(*product.Product) error { return stop })
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestProductRepository_Sample(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	categoryID := uuid.New().String()
+	for i := range 12 {
+		var cat *string
+		if i%3 == 0 {
+			cat = &categoryID
+		}
+		prod, err := product.NewProduct(limits.Default().Product, fmt.Sprintf("Sample %02d", i), "", product.ProductTypePhysical, nil, 10, 1, nil, cat, false, nil)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+
+	sample, err := testProductRepo.Sample(ctx, product.ListQuery{}, 5)
+	require.NoError(t, err)
+	assert.Len(t, sample, 5)
+
+	sample, err = testProductRepo.Sample(ctx, product.ListQuery{CategoryID: &categoryID}, 10)
+	require.NoError(t, err)
+	require.Len(t, sample, 4)
+	for _, p := range sample {
+		assert.Equal(t, categoryID, *p.CategoryID)
+	}
+}
+
+func TestProductRepository_PriceStats(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	categoryID, imageID := uuid.New().String(), uuid.New().String()
+	for i, price := range []float64{15, 5, 40, 1000} {
+		prod, err := product.NewProduct(limits.Default().Product, fmt.Sprintf("Priced %02d", i), "", product.ProductTypePhysical, nil, price, 1, &imageID, &categoryID, price != 1000, nil)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+
+	stats, err := testProductRepo.PriceStats(ctx, categoryID)
+	require.NoError(t, err)
+	assert.Equal(t, &product.PriceStats{Count: 3, Min: 5, Max: 40, Avg: 20}, stats, "disabled products are left out")
+
+	stats, err = testProductRepo.PriceStats(ctx, uuid.New().String())
+	require.NoError(t, err)
+	assert.Equal(t, &product.PriceStats{}, stats)
+}
+
+func TestProductRepository_OptionCounts(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	categoryID, imageID := uuid.New().String(), uuid.New().String()
+	insert := func(enabled bool, attrs ...product.AttributeValue) {
+		prod, err := product.NewProduct(limits.Default().Product, uuid.New().String(), "", product.ProductTypePhysical, nil, 10, 1, &imageID, &categoryID, enabled, attrs)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+	red, blue := "red", "blue"
+	insert(true, product.AttributeValue{AttributeID: "color", OptionSlugValue: &red}, product.AttributeValue{AttributeID: "size", OptionSlugValues: []string{"s", "m"}})
+	// A legacy product repeating a value counts once
+	insert(true, product.AttributeValue{AttributeID: "color", OptionSlugValue: &red}, product.AttributeValue{AttributeID: "color", OptionSlugValue: &red})
+	insert(false, product.AttributeValue{AttributeID: "color", OptionSlugValue: &blue})
+	insert(true, product.AttributeValue{AttributeID: "fit", OptionSlugValue: &red})
+
+	counts, err := testProductRepo.OptionCounts(ctx, categoryID, []string{"color", "size"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]int64{
+		"color": {"red": 2},
+		"size":  {"s": 1, "m": 1},
+	}, counts)
+}
+
+func TestProductRepository_CountByCategory(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	tools, garden, imageID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	insert := func(categoryID *string, enabled bool) {
+		prod, err := product.NewProduct(limits.Default().Product, uuid.New().String(), "", product.ProductTypePhysical, nil, 10, 1, &imageID, categoryID, enabled, nil)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+	insert(&tools, true)
+	insert(&tools, true)
+	insert(&tools, false)
+	insert(&garden, false)
+	insert(nil, true)
+
+	counts, err := testProductRepo.CountByCategory(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{tools: 2}, counts)
+}
+
+func TestProductRepository_CountUncategorized(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	tools, imageID := uuid.New().String(), uuid.New().String()
+	insert := func(categoryID *string, supplierID string, enabled bool) {
+		prod, err := product.NewProduct(limits.Default().Product, uuid.New().String(), "", product.ProductTypePhysical, nil, 10, 1, &imageID, categoryID, enabled, nil)
+		require.NoError(t, err)
+		if supplierID != "" {
+			require.NoError(t, prod.ChangeSupplier(&product.SupplierRef{SupplierID: supplierID}))
+		}
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+	insert(&tools, "acme", true)
+	insert(nil, "acme", true)
+	insert(nil, "acme", false)
+	insert(nil, "", true)
+
+	counts, err := testProductRepo.CountUncategorized(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []product.UncategorizedCount{
+		{SupplierID: "acme", Products: 2, Enabled: 1},
+		{SupplierID: "", Products: 1, Enabled: 1},
+	}, counts)
+
+	noCategory := false
+	result, err := testProductRepo.FindList(ctx, product.ListQuery{HasCategory: &noCategory, Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Total)
+}
+
+func TestProductRepository_Valuation(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	categoryID := uuid.New().String()
+	insert := func(productType product.ProductType, price float64, quantity int, categoryID *string, cost string) {
+		prod, err := product.NewProduct(limits.Default().Product, uuid.New().String(), "", productType, nil, price, quantity, nil, categoryID, false, nil)
+		require.NoError(t, err)
+		if cost != "" {
+			require.NoError(t, prod.ChangeMetadata(limits.Default().Product, map[string]string{"erp.cost": cost}))
+		}
+		require.NoError(t, testProductRepo.Insert(ctx, prod))
+	}
+	insert(product.ProductTypePhysical, 20, 3, &categoryID, "12.5")
+	insert(product.ProductTypePhysical, 50, 2, &categoryID, "n/a")
+	insert(product.ProductTypeService, 100, 1, &categoryID, "")
+	insert(product.ProductTypePhysical, 25, 4, nil, "25")
+
+	valuations, err := testProductRepo.Valuation(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []product.CategoryValuation{
+		{Products: 1, Units: 4, StockValue: 100},
+		{CategoryID: categoryID, Products: 2, Units: 5, StockValue: 160},
+	}, valuations)
+
+	valuations, err = testProductRepo.Valuation(ctx, "erp.cost")
+	require.NoError(t, err)
+	uncategorizedCost, categoryCost := 100.0, 37.5
+	assert.Equal(t, []product.CategoryValuation{
+		{Products: 1, Units: 4, StockValue: 100, CostValue: &uncategorizedCost},
+		{CategoryID: categoryID, Products: 2, Units: 5, StockValue: 160, CostValue: &categoryCost, MissingCost: 1},
+	}, valuations)
+}
+
+func TestProductRepository_UniqueNamesPerCategory(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+	resolver := func(_ context.Context) string { return testDBName }
+	repo, err := newProductRepository(testMongo, newProductMapper(ProductConfig{UniqueNamesPerCategory: true}), resolver)
+	require.NoError(t, err)
+
+	shirts, hats := uuid.New().String(), uuid.New().String()
+	newProduct := func(name string, categoryID *string) *product.Product {
+		p, err := product.NewProduct(limits.Default().Product, name, uuid.New().String(), product.ProductTypePhysical, nil, 10, 1, nil, categoryID, false, nil)
+		require.NoError(t, err)
+		return p
+	}
+
+	first := newProduct("Blue Shirt", &shirts)
+	require.NoError(t, repo.Insert(ctx, first))
+
+	err = repo.Insert(ctx, newProduct("blue shirt ", &shirts))
+	require.ErrorIs(t, err, product.ErrNameAlreadyExists)
+
+	require.NoError(t, repo.Insert(ctx, newProduct("Blue Shirt", &hats)), "other categories may reuse the name")
+	require.NoError(t, repo.Insert(ctx, newProduct("Blue Shirt", nil)), "uncategorized products are not checked")
+	require.NoError(t, testProductRepo.Insert(ctx, newProduct("Blue Shirt", &shirts)), "products saved while the rule is disabled are not checked")
+
+	second := newProduct("Red Shirt", &shirts)
+	require.NoError(t, repo.Insert(ctx, second))
+	second.Name = "BLUE SHIRT"
+	_, err = repo.Update(ctx, second)
+	require.ErrorIs(t, err, product.ErrNameAlreadyExists)
+
+	third := newProduct("Green Shirt", &shirts)
+	require.NoError(t, repo.Insert(ctx, third))
+	err = repo.Insert(ctx, third)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, product.ErrNameAlreadyExists, "a duplicate ID is not a name conflict")
+}
+
+func TestProductRepository_FindBySlug(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+
+	prod, err := product.NewProduct(limits.Default().Product, "Blue Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, prod))
+
+	require.NoError(t, prod.Update(limits.Default().Product, "Navy Shirt", "", nil, 10, 1, nil, nil, false, nil))
+	updated, err := testProductRepo.Update(ctx, prod)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blue-shirt"}, updated.SlugHistory)
+
+	for _, slug := range []string{"navy-shirt", "blue-shirt"} {
+		found, err := testProductRepo.FindBySlug(ctx, slug)
+		require.NoError(t, err)
+		assert.Equal(t, prod.ID, found.ID)
+		assert.Equal(t, "navy-shirt", found.Slug)
+	}
+
+	_, err = testProductRepo.FindBySlug(ctx, "red-shirt")
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+
+	twin, err := product.NewProduct(limits.Default().Product, "Blue Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	err = testProductRepo.Insert(ctx, twin)
+	require.ErrorIs(t, err, product.ErrSlugAlreadyExists, "previous slugs stay reserved")
+}
+
+func TestProductRepository_FindByExternalIDs(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+
+	prod, err := product.NewProduct(limits.Default().Product, "Blue Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, prod.ChangeExternalID(ptrI("ERP-1")))
+	require.NoError(t, testProductRepo.Insert(ctx, prod))
+
+	plain, err := product.NewProduct(limits.Default().Product, "Red Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, plain), "products without external ID don't conflict")
+
+	found, err := testProductRepo.FindByExternalIDs(ctx, []string{"ERP-1", "ERP-2"})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, prod.ID, found[0].ID)
+	assert.Equal(t, "ERP-1", *found[0].ExternalID)
+
+	twin, err := product.NewProduct(limits.Default().Product, "Green Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, twin.ChangeExternalID(ptrI("ERP-1")))
+	err = testProductRepo.Insert(ctx, twin)
+	require.ErrorIs(t, err, product.ErrExternalIDAlreadyExists)
+}
+
+func TestProductRepository_FindAsOf(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, "product_revision")
+
+	ctx := context.Background()
+
+	prod, err := product.NewProduct(limits.Default().Product, "Blue Shirt", uuid.New().String(), product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, prod))
+	beforeUpdate := time.Now().UTC()
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, prod.Update(limits.Default().Product, "Navy Shirt", "", nil, 12, 1, nil, nil, true, nil))
+	_, err = testProductRepo.Update(ctx, prod)
+	require.NoError(t, err)
+	beforeDelete := time.Now().UTC()
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, testProductRepo.Delete(ctx, prod.ID))
+
+	_, err = testProductRepo.FindAsOf(ctx, prod.ID, prod.CreatedAt.Add(-time.Second))
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound, "not created yet")
+
+	past, err := testProductRepo.FindAsOf(ctx, prod.ID, beforeUpdate)
+	require.NoError(t, err)
+	assert.Equal(t, "Blue Shirt", past.Name)
+	assert.Equal(t, 1, past.Version)
+
+	past, err = testProductRepo.FindAsOf(ctx, prod.ID, beforeDelete)
+	require.NoError(t, err)
+	assert.Equal(t, "Navy Shirt", past.Name)
+	assert.Equal(t, 2, past.Version)
+
+	_, err = testProductRepo.FindAsOf(ctx, prod.ID, time.Now().UTC())
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound, "deleted")
+}
+
+func TestProductRepository_BulkInsert(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, "product_revision")
+
+	ctx := context.Background()
+
+	var products []*product.Product
+	for _, name := range []string{"Red Shirt", "Blue Shirt", "Red Shirt", "Green Shirt"} {
+		p, err := product.NewProduct(limits.Default().Product, name, "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+		require.NoError(t, err)
+		products = append(products, p)
+	}
+
+	errs, err := testProductRepo.BulkInsert(ctx, products)
+	require.NoError(t, err)
+	require.Len(t, errs, 4)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	require.ErrorIs(t, errs[2], product.ErrSlugAlreadyExists)
+	assert.NoError(t, errs[3], "unordered writes go on after a failure")
+
+	_, err = testProductRepo.FindByID(ctx, products[3].ID)
+	require.NoError(t, err)
+	_, err = testProductRepo.FindByID(ctx, products[2].ID)
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+
+	past, err := testProductRepo.FindAsOf(ctx, products[1].ID, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, "Blue Shirt", past.Name)
+}
+
+func TestProductRepository_BulkUpdate(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, "product_revision")
+
+	ctx := context.Background()
+
+	var products []*product.Product
+	for _, name := range []string{"Red Shirt", "Blue Shirt"} {
+		p, err := product.NewProduct(limits.Default().Product, name, "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+		require.NoError(t, err)
+		require.NoError(t, testProductRepo.Insert(ctx, p))
+		products = append(products, p)
+	}
+	missing, err := product.NewProduct(limits.Default().Product, "Green Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+
+	stale := *products[1]
+	stale.Version = 7
+	products[0].Price = 12
+	errs, err := testProductRepo.BulkUpdate(ctx, []*product.Product{products[0], &stale, missing})
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], mongo.ErrOptimisticLocking)
+	require.ErrorIs(t, errs[2], mongo.ErrOptimisticLocking)
+	assert.Equal(t, 2, products[0].Version, "the version of updated products is incremented")
+
+	stored, err := testProductRepo.FindByID(ctx, products[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Version)
+	assert.Equal(t, float64(12), stored.Price)
+
+	untouched, err := testProductRepo.FindByID(ctx, products[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, untouched.Version)
+}
+
+func TestProductRepository_ArchiveAndRestore(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, productArchiveCollection)
+
+	ctx := context.Background()
+
+	longAgo := time.Now().UTC().AddDate(-1, 0, 0)
+	stale := product.Reconstruct(uuid.New().String(), 1, "Old Shirt", "old-shirt", nil, product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil, nil, nil, nil, longAgo, longAgo)
+	require.NoError(t, testProductRepo.Insert(ctx, stale))
+	recent, err := product.NewProduct(limits.Default().Product, "New Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, recent))
+
+	found, err := testProductRepo.FindArchivable(ctx, time.Now().UTC().AddDate(0, -6, 0), 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, stale.ID, found[0].ID)
+
+	changed := *found[0]
+	changed.Version = 2
+	require.ErrorIs(t, testProductRepo.Archive(ctx, []*product.Product{&changed}), mongo.ErrOptimisticLocking)
+	cleanupCollection(t, productArchiveCollection)
+
+	require.NoError(t, testProductRepo.Archive(ctx, found))
+	_, err = testProductRepo.FindByID(ctx, stale.ID)
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+	archived, err := testProductRepo.FindArchivedByID(ctx, stale.ID)
+	require.NoError(t, err)
+	require.NotNil(t, archived.ArchivedAt)
+
+	list, err := testProductRepo.FindList(ctx, product.ListQuery{Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.Total)
+	list, err = testProductRepo.FindList(ctx, product.ListQuery{Page: 1, Size: 10, IncludeArchived: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, list.Total)
+	assert.Len(t, list.Items, 2)
+
+	restored, err := testProductRepo.Restore(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Nil(t, restored.ArchivedAt)
+	_, err = testProductRepo.FindByID(ctx, stale.ID)
+	require.NoError(t, err)
+	_, err = testProductRepo.FindArchivedByID(ctx, stale.ID)
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProductRepository_RecordSales(t *testing.T) {
+	cleanupCollection(t, "product")
+	cleanupCollection(t, productSalesOrderCollection)
+
+	ctx := context.Background()
+
+	p, err := product.NewProduct(limits.Default().Product, "Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, p))
+
+	recorded, err := testProductRepo.RecordSales(ctx, "order-1", map[string]int64{p.ID: 2, uuid.New().String(): 1})
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = testProductRepo.RecordSales(ctx, "order-1", map[string]int64{p.ID: 2})
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	found, err := testProductRepo.FindByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, found.UnitsSold)
+}
+
+func TestProductRepository_RecordAndDecayViews(t *testing.T) {
+	cleanupCollection(t, "product")
+
+	ctx := context.Background()
+
+	p, err := product.NewProduct(limits.Default().Product, "Shirt", "", product.ProductTypePhysical, nil, 10, 1, nil, nil, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, testProductRepo.Insert(ctx, p))
+
+	for range 4 {
+		require.NoError(t, testProductRepo.RecordView(ctx, p.ID))
+	}
+	require.ErrorIs(t, testProductRepo.RecordView(ctx, uuid.New().String()), mongo.ErrEntityNotFound)
+
+	trending, err := testProductRepo.DecayViews(ctx, 0.5, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, trending)
+	found, err := testProductRepo.FindByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 2, found.Views, 0.001)
+	assert.Equal(t, p.Version, found.Version)
+
+	trending, err = testProductRepo.DecayViews(ctx, 0.25, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, trending)
+	found, err = testProductRepo.FindByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Zero(t, found.Views)
+}
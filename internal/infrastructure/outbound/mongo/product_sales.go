@@ -0,0 +1,48 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+)
+
+// productSalesOrderCollection remembers the orders whose sales were counted, next to the product collection of
+// each tenant; a TTL index forgets them after 30 days, longer than any redelivery
+const productSalesOrderCollection = "product_sales_order"
+
+type salesOrderEntity struct {
+	ID         string    `bson:"_id"`
+	RecordedAt time.Time `bson:"recordedAt"`
+}
+
+// RecordSales inserts the order before counting, so a redelivered order stops at the duplicate key;
+// run it in a transaction so a failed count doesn't leave the order recorded
+func (r *productRepository) RecordSales(ctx context.Context, orderID string, units map[string]int64) (bool, error) {
+	orders := r.Collection(ctx).Database().Collection(productSalesOrderCollection)
+	if _, err := orders.InsertOne(ctx, salesOrderEntity{ID: orderID, RecordedAt: utc.Now()}); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record order: %w", err)
+	}
+	if len(units) == 0 {
+		return true, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(units))
+	for id, n := range units {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: id}}).
+			SetUpdate(bson.D{{Key: "$inc", Value: bson.D{{Key: "unitsSold", Value: n}}}}))
+	}
+	if _, err := r.Collection(ctx).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return false, fmt.Errorf("failed to count sales: %w", err)
+	}
+	return true, nil
+}
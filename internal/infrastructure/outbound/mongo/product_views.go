@@ -0,0 +1,41 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func (r *productRepository) RecordView(ctx context.Context, id string) error {
+	res, err := r.Collection(ctx).UpdateOne(ctx, bson.D{{Key: "_id", Value: id}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "views", Value: 1}}}})
+	if err != nil {
+		return fmt.Errorf("failed to count view: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return commonsmongo.ErrEntityNotFound
+	}
+	return nil
+}
+
+// DecayViews scales and resets the views with one pipeline update, so a product doesn't fall below
+// minViews between two writes
+func (r *productRepository) DecayViews(ctx context.Context, factor, minViews float64) (int64, error) {
+	decayed := bson.D{{Key: "$multiply", Value: bson.A{"$views", factor}}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.D{{Key: "views", Value: bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$lt", Value: bson.A{decayed, minViews}}}, 0, decayed,
+	}}}}}}}}
+	if _, err := r.Collection(ctx).UpdateMany(ctx, bson.D{{Key: "views", Value: bson.D{{Key: "$gt", Value: 0}}}}, update); err != nil {
+		return 0, fmt.Errorf("failed to decay views: %w", err)
+	}
+
+	trending, err := r.Collection(ctx).CountDocuments(ctx, bson.D{{Key: "views", Value: bson.D{{Key: "$gt", Value: 0}}}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count trending products: %w", err)
+	}
+	return trending, nil
+}
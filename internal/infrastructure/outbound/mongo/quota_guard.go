@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// quotaLockEntity is the lock of a resource in the tenant database; its version counts the checks that took it
+type quotaLockEntity struct {
+	ID      string `bson:"_id"`
+	Version int    `bson:"version"`
+}
+
+type quotaLockMapper struct{}
+
+func (quotaLockMapper) ToEntity(e *quotaLockEntity) *quotaLockEntity { return e }
+func (quotaLockMapper) ToDomain(e *quotaLockEntity) *quotaLockEntity { return e }
+func (quotaLockMapper) GetID(e *quotaLockEntity) string              { return e.ID }
+func (quotaLockMapper) GetVersion(e *quotaLockEntity) int            { return e.Version }
+func (quotaLockMapper) SetVersion(e *quotaLockEntity, version int)   { e.Version = version }
+
+type quotaGuard struct {
+	*commonsmongo.GenericRepository[quotaLockEntity, quotaLockEntity]
+}
+
+func newQuotaGuard(admin commonsmongo.Admin, resolver commonsmongo.DatabaseResolver) (quota.Guard, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository[quotaLockEntity, quotaLockEntity](
+		admin, "quota_lock",
+		quotaLockMapper{},
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotaGuard{GenericRepository: genericRepo}, nil
+}
+
+// Lock increments the version of the lock document of the resource, upserting it on the first check. Two transactions
+// writing the same document make the later one fail with a transient write conflict, which the transaction
+// manager retries.
+func (g *quotaGuard) Lock(ctx context.Context, r quota.Resource) error {
+	_, err := g.Collection(ctx).UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: string(r)}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock quota of %s: %w", r, err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+)
+
+func TestQuotaGuard_Lock(t *testing.T) {
+	ctx := context.Background()
+	guard, err := newQuotaGuard(testMongo, func(_ context.Context) string { return testDBName })
+	require.NoError(t, err)
+	coll := guard.(*quotaGuard).Collection(ctx) //nolint:forcetypeassert // the constructor returns a *quotaGuard
+	_, err = coll.DeleteMany(ctx, bson.D{})
+	require.NoError(t, err)
+
+	require.NoError(t, guard.Lock(ctx, quota.Products), "the first lock creates the document")
+	require.NoError(t, guard.Lock(ctx, quota.Products))
+	require.NoError(t, guard.Lock(ctx, quota.Categories))
+
+	var e quotaLockEntity
+	require.NoError(t, coll.FindOne(ctx, bson.D{{Key: "_id", Value: string(quota.Products)}}).Decode(&e))
+	assert.Equal(t, 2, e.Version)
+	count, err := coll.CountDocuments(ctx, bson.D{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count, "one document per resource")
+}
@@ -0,0 +1,24 @@
+package mongo
+
+import (
+	"time"
+)
+
+type replayProgressEntity struct {
+	Target    string `bson:"target"`
+	Total     int64  `bson:"total"`
+	Published int64  `bson:"published"`
+}
+
+// replayJobEntity represents the MongoDB document structure.
+// Each tenant database keeps a single document with the latest replay, RunID identifies the run it describes.
+type replayJobEntity struct {
+	ID         string                 `bson:"_id"`
+	RunID      string                 `bson:"runId"`
+	Running    bool                   `bson:"running"`
+	StartedAt  time.Time              `bson:"startedAt"`
+	UpdatedAt  time.Time              `bson:"updatedAt"`
+	FinishedAt *time.Time             `bson:"finishedAt,omitempty"`
+	Progress   []replayProgressEntity `bson:"progress"`
+	Error      string                 `bson:"error,omitempty"`
+}
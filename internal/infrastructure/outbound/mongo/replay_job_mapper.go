@@ -0,0 +1,61 @@
+package mongo
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+)
+
+// latestReplayJobID is the ID of the document holding the latest replay of a tenant
+const latestReplayJobID = "latest"
+
+type replayJobMapper struct{}
+
+func newReplayJobMapper() *replayJobMapper {
+	return &replayJobMapper{}
+}
+
+func (m *replayJobMapper) ToEntity(s *replay.Status) *replayJobEntity {
+	return &replayJobEntity{
+		ID:         latestReplayJobID,
+		RunID:      s.ID,
+		Running:    s.Running,
+		StartedAt:  s.StartedAt,
+		UpdatedAt:  s.UpdatedAt,
+		FinishedAt: s.FinishedAt,
+		Progress: lo.Map(s.Progress, func(p replay.Progress, _ int) replayProgressEntity {
+			return replayProgressEntity{Target: string(p.Target), Total: p.Total, Published: p.Published}
+		}),
+		Error: s.Error,
+	}
+}
+
+func (m *replayJobMapper) ToDomain(e *replayJobEntity) *replay.Status {
+	finishedAt := e.FinishedAt
+	if finishedAt != nil {
+		finishedAt = lo.ToPtr(finishedAt.UTC())
+	}
+
+	return &replay.Status{
+		ID:         e.RunID,
+		Running:    e.Running,
+		StartedAt:  e.StartedAt.UTC(),
+		UpdatedAt:  e.UpdatedAt.UTC(),
+		FinishedAt: finishedAt,
+		Progress: lo.Map(e.Progress, func(p replayProgressEntity, _ int) replay.Progress {
+			return replay.Progress{Target: replay.Target(p.Target), Total: p.Total, Published: p.Published}
+		}),
+		Error: e.Error,
+	}
+}
+
+func (m *replayJobMapper) GetID(e *replayJobEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: runs are told apart by RunID instead of a version
+func (m *replayJobMapper) GetVersion(_ *replayJobEntity) int {
+	return 0
+}
+
+func (m *replayJobMapper) SetVersion(_ *replayJobEntity, _ int) {}
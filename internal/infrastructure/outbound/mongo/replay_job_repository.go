@@ -0,0 +1,74 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type replayJobRepository struct {
+	*commonsmongo.GenericRepository[replay.Status, replayJobEntity]
+}
+
+func newReplayJobRepository(admin commonsmongo.Admin, mapper *replayJobMapper, resolver commonsmongo.DatabaseResolver) (replay.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "replay_job",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayJobRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+// Begin replaces the latest replay if it finished or went stale. Otherwise the filter doesn't match
+// and the upsert collides with the existing document, which makes the check and the write atomic.
+func (r *replayJobRepository) Begin(ctx context.Context, job *replay.Status, staleBefore time.Time) error {
+	_, err := r.Collection(ctx).ReplaceOne(ctx,
+		bson.D{
+			{Key: "_id", Value: latestReplayJobID},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "running", Value: false}},
+				bson.D{{Key: "updatedAt", Value: bson.D{{Key: "$lt", Value: staleBefore}}}},
+			}},
+		},
+		r.Mapper().ToEntity(job),
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return replay.ErrReplayInProgress
+	}
+	if err != nil {
+		return fmt.Errorf("failed to begin replay: %w", err)
+	}
+	return nil
+}
+
+func (r *replayJobRepository) Save(ctx context.Context, job *replay.Status) error {
+	res, err := r.Collection(ctx).ReplaceOne(ctx,
+		bson.D{{Key: "_id", Value: latestReplayJobID}, {Key: "runId", Value: job.ID}},
+		r.Mapper().ToEntity(job),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save replay %s: %w", job.ID, err)
+	}
+	if res.MatchedCount == 0 {
+		return replay.ErrReplaySuperseded
+	}
+	return nil
+}
+
+func (r *replayJobRepository) FindLatest(ctx context.Context) (*replay.Status, error) {
+	return r.FindByID(ctx, latestReplayJobID)
+}
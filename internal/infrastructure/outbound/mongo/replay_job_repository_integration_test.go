@@ -0,0 +1,49 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayJobRepository_Lifecycle(t *testing.T) {
+	cleanupCollection(t, "replay_job")
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	_, err := testReplayJobRepo.FindLatest(ctx)
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+
+	first := &replay.Status{ID: "run-1", Running: true, StartedAt: now, UpdatedAt: now, Progress: []replay.Progress{{Target: replay.TargetProducts}}}
+	require.NoError(t, testReplayJobRepo.Begin(ctx, first, now.Add(-time.Minute)))
+
+	second := &replay.Status{ID: "run-2", Running: true, StartedAt: now, UpdatedAt: now}
+	require.ErrorIs(t, testReplayJobRepo.Begin(ctx, second, now.Add(-time.Minute)), replay.ErrReplayInProgress)
+
+	first.Progress[0] = replay.Progress{Target: replay.TargetProducts, Total: 10, Published: 4}
+	require.NoError(t, testReplayJobRepo.Save(ctx, first))
+
+	found, err := testReplayJobRepo.FindLatest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, first, found)
+
+	// The first run stopped saving, so it is taken over and can no longer report progress
+	require.NoError(t, testReplayJobRepo.Begin(ctx, second, now.Add(time.Second)))
+	require.ErrorIs(t, testReplayJobRepo.Save(ctx, first), replay.ErrReplaySuperseded)
+
+	finishedAt := now.Add(time.Second)
+	second.Running = false
+	second.FinishedAt = &finishedAt
+	require.NoError(t, testReplayJobRepo.Save(ctx, second))
+
+	third := &replay.Status{ID: "run-3", Running: true, StartedAt: now, UpdatedAt: now}
+	require.NoError(t, testReplayJobRepo.Begin(ctx, third, now.Add(-time.Minute)), "a finished replay doesn't block the next one")
+}
@@ -0,0 +1,69 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+const benchProductCount = 1000
+
+func seedBenchProducts(b *testing.B) []string {
+	b.Helper()
+	cleanupCollection(b, "product")
+
+	ctx := context.Background()
+	categoryIDs := []string{"bench-category-1", "bench-category-2", "bench-category-3"}
+	for i := range benchProductCount {
+		p, err := product.NewProduct(
+			limits.Default().Product,
+			fmt.Sprintf("Bench Product %04d", i),
+			"",
+			product.ProductTypePhysical,
+			nil,
+			float64(i%500)+0.99,
+			i%50,
+			nil,
+			&categoryIDs[i%len(categoryIDs)],
+			false,
+			[]product.AttributeValue{{AttributeID: "attr-color", OptionSlugValue: ptrI("red")}},
+		)
+		require.NoError(b, err)
+		require.NoError(b, testProductRepo.Insert(ctx, p))
+	}
+	return categoryIDs
+}
+
+func BenchmarkProductRepository_FindList(b *testing.B) {
+	categoryIDs := seedBenchProducts(b)
+	ctx := context.Background()
+
+	benchmarks := []struct {
+		name  string
+		query product.ListQuery
+	}{
+		{name: "first page", query: product.ListQuery{Page: 1, Size: 20}},
+		{name: "deep page", query: product.ListQuery{Page: 40, Size: 20}},
+		{name: "by category", query: product.ListQuery{Page: 1, Size: 20, CategoryID: &categoryIDs[0]}},
+		{name: "sorted by price", query: product.ListQuery{Page: 1, Size: 20, Sort: "price", Order: "desc"}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, err := testProductRepo.FindList(ctx, bm.query)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
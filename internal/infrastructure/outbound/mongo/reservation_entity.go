@@ -0,0 +1,16 @@
+package mongo
+
+import (
+	"time"
+)
+
+// reservationEntity represents the MongoDB document structure.
+// Expired documents are removed by the reservation expiry worker, which announces each of them.
+type reservationEntity struct {
+	ID        string    `bson:"_id"`
+	ProductID string    `bson:"productId"`
+	Owner     string    `bson:"owner"`
+	Quantity  int       `bson:"quantity"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
@@ -0,0 +1,44 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+)
+
+type reservationMapper struct{}
+
+func newReservationMapper() *reservationMapper {
+	return &reservationMapper{}
+}
+
+func (m *reservationMapper) ToEntity(r *reservation.Reservation) *reservationEntity {
+	return &reservationEntity{
+		ID:        r.ID,
+		ProductID: r.ProductID,
+		Owner:     r.Owner,
+		Quantity:  r.Quantity,
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (m *reservationMapper) ToDomain(e *reservationEntity) *reservation.Reservation {
+	return reservation.Reconstruct(
+		e.ID,
+		e.ProductID,
+		e.Owner,
+		e.Quantity,
+		e.ExpiresAt.UTC(),
+		e.CreatedAt.UTC(),
+	)
+}
+
+func (m *reservationMapper) GetID(e *reservationEntity) string {
+	return e.ID
+}
+
+// GetVersion returns 0: reservations are never updated, so they carry no version
+func (m *reservationMapper) GetVersion(_ *reservationEntity) int {
+	return 0
+}
+
+func (m *reservationMapper) SetVersion(_ *reservationEntity, _ int) {}
@@ -0,0 +1,128 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// lockIDPrefix marks the per-product lock documents stored next to the reservations.
+// They have no productId or expiresAt, so reservation queries ignore them.
+const lockIDPrefix = "lock:"
+
+type reservationRepository struct {
+	*commonsmongo.GenericRepository[reservation.Reservation, reservationEntity]
+}
+
+func newReservationRepository(admin commonsmongo.Admin, mapper *reservationMapper, resolver commonsmongo.DatabaseResolver) (reservation.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "reservation",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservationRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+// provideReservedStock exposes the reservation repository to the product queries
+func provideReservedStock(repo reservation.Repository) product.ReservedStock {
+	return repo
+}
+
+// FindByID skips reservations that expired but haven't been removed by the expiry worker yet
+func (r *reservationRepository) FindByID(ctx context.Context, id string) (*reservation.Reservation, error) {
+	return r.FindOneByFilter(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "expiresAt", Value: bson.D{{Key: "$gt", Value: utc.Now()}}},
+	})
+}
+
+func (r *reservationRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*reservation.Reservation, error) {
+	cursor, err := r.Collection(ctx).Find(ctx,
+		bson.D{{Key: "expiresAt", Value: bson.D{{Key: "$lte", Value: now}}}},
+		options.Find().SetSort(bson.D{{Key: "expiresAt", Value: 1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired reservations: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }() //nolint:errcheck // Best effort cleanup
+
+	var entities []reservationEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode expired reservations: %w", err)
+	}
+
+	result := make([]*reservation.Reservation, 0, len(entities))
+	for i := range entities {
+		result = append(result, r.Mapper().ToDomain(&entities[i]))
+	}
+	return result, nil
+}
+
+func (r *reservationRepository) DeleteExpired(ctx context.Context, id string, now time.Time) (bool, error) {
+	res, err := r.Collection(ctx).DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "expiresAt", Value: bson.D{{Key: "$lte", Value: now}}},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reservation %s: %w", id, err)
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (r *reservationRepository) LockProduct(ctx context.Context, productID string) error {
+	_, err := r.Collection(ctx).UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: lockIDPrefix + productID}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "seq", Value: 1}}}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock product %s: %w", productID, err)
+	}
+	return nil
+}
+
+func (r *reservationRepository) ReservedQuantities(ctx context.Context, productIDs []string) (map[string]int, error) {
+	pipeline := bson.A{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "productId", Value: bson.D{{Key: "$in", Value: productIDs}}},
+			{Key: "expiresAt", Value: bson.D{{Key: "$gt", Value: utc.Now()}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$productId"},
+			{Key: "quantity", Value: bson.D{{Key: "$sum", Value: "$quantity"}}},
+		}}},
+	}
+
+	cursor, err := r.Collection(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate reservations: %w", err)
+	}
+
+	var rows []struct {
+		ProductID string `bson:"_id"`
+		Quantity  int    `bson:"quantity"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode reservations: %w", err)
+	}
+
+	reserved := make(map[string]int, len(rows))
+	for _, row := range rows {
+		reserved[row.ProductID] = row.Quantity
+	}
+	return reserved, nil
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationRepository_ReservedQuantities(t *testing.T) {
+	cleanupCollection(t, "reservation")
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	reservations := []*reservation.Reservation{
+		reservation.Reconstruct("r-1", "product-1", "order-1", 2, now.Add(time.Minute), now),
+		reservation.Reconstruct("r-2", "product-1", "order-1", 3, now.Add(time.Minute), now),
+		reservation.Reconstruct("r-3", "product-2", "order-1", 1, now.Add(time.Minute), now),
+		reservation.Reconstruct("r-4", "product-1", "order-1", 7, now.Add(-time.Minute), now), // expired
+	}
+	for _, r := range reservations {
+		require.NoError(t, testReservationRepo.Insert(ctx, r))
+	}
+	require.NoError(t, testReservationRepo.LockProduct(ctx, "product-1"))
+
+	reserved, err := testReservationRepo.ReservedQuantities(ctx, []string{"product-1", "product-3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"product-1": 5}, reserved)
+}
+
+func TestReservationRepository_FindByIDSkipsExpired(t *testing.T) {
+	cleanupCollection(t, "reservation")
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	require.NoError(t, testReservationRepo.Insert(ctx, reservation.Reconstruct("active", "product-1", "order-1", 1, now.Add(time.Minute), now)))
+	require.NoError(t, testReservationRepo.Insert(ctx, reservation.Reconstruct("expired", "product-1", "order-1", 1, now.Add(-time.Minute), now)))
+
+	found, err := testReservationRepo.FindByID(ctx, "active")
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", found.ProductID)
+
+	_, err = testReservationRepo.FindByID(ctx, "expired")
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+
+	require.NoError(t, testReservationRepo.Delete(ctx, "active"))
+	_, err = testReservationRepo.FindByID(ctx, "active")
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestReservationRepository_Expiry(t *testing.T) {
+	cleanupCollection(t, "reservation")
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	require.NoError(t, testReservationRepo.Insert(ctx, reservation.Reconstruct("active", "product-1", "order-1", 1, now.Add(time.Minute), now)))
+	require.NoError(t, testReservationRepo.Insert(ctx, reservation.Reconstruct("older", "product-1", "order-2", 1, now.Add(-2*time.Minute), now)))
+	require.NoError(t, testReservationRepo.Insert(ctx, reservation.Reconstruct("newer", "product-1", "order-3", 1, now.Add(-time.Minute), now)))
+	require.NoError(t, testReservationRepo.LockProduct(ctx, "product-1"))
+
+	expired, err := testReservationRepo.FindExpired(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+	assert.Equal(t, "older", expired[0].ID)
+	assert.Equal(t, "order-2", expired[0].Owner)
+	assert.Equal(t, "newer", expired[1].ID)
+
+	deleted, err := testReservationRepo.DeleteExpired(ctx, "older", now)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = testReservationRepo.DeleteExpired(ctx, "older", now)
+	require.NoError(t, err)
+	assert.False(t, deleted, "already removed")
+
+	deleted, err = testReservationRepo.DeleteExpired(ctx, "active", now)
+	require.NoError(t, err)
+	assert.False(t, deleted, "not expired yet")
+}
@@ -0,0 +1,165 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// revisionEntity is a stored state of an aggregate, valid from ValidFrom until the next revision.
+// A deletion is recorded as a revision without state.
+type revisionEntity[E any] struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	EntityID  string        `bson:"entityId"`
+	Version   int           `bson:"version"`
+	ValidFrom time.Time     `bson:"validFrom"`
+	Deleted   bool          `bson:"deleted,omitempty"`
+	State     *E            `bson:"state,omitempty"`
+}
+
+// revisionMapper wraps the mapper of an aggregate to store its states as revisions
+type revisionMapper[D any, E any] struct {
+	mapper    commonsmongo.EntityMapper[D, E]
+	validFrom func(*E) time.Time
+}
+
+func (m *revisionMapper[D, E]) ToEntity(d *D) *revisionEntity[E] {
+	state := m.mapper.ToEntity(d)
+	return &revisionEntity[E]{
+		EntityID:  m.mapper.GetID(state),
+		Version:   m.mapper.GetVersion(state),
+		ValidFrom: m.validFrom(state),
+		State:     state,
+	}
+}
+
+func (m *revisionMapper[D, E]) ToDomain(e *revisionEntity[E]) *D {
+	return m.mapper.ToDomain(e.State)
+}
+
+func (m *revisionMapper[D, E]) GetID(e *revisionEntity[E]) string {
+	return e.EntityID
+}
+
+func (m *revisionMapper[D, E]) GetVersion(e *revisionEntity[E]) int {
+	return e.Version
+}
+
+func (m *revisionMapper[D, E]) SetVersion(e *revisionEntity[E], version int) {
+	e.Version = version
+}
+
+// revisionStore keeps the history of an aggregate in a "<collection>_revision" collection.
+// Repositories record a revision next to every write, in the same transaction.
+type revisionStore[D any, E any] struct {
+	*commonsmongo.GenericRepository[D, revisionEntity[E]]
+}
+
+func newRevisionStore[D any, E any](
+	admin commonsmongo.Admin,
+	collectionName string,
+	mapper commonsmongo.EntityMapper[D, E],
+	validFrom func(*E) time.Time,
+	resolver commonsmongo.DatabaseResolver,
+) (*revisionStore[D, E], error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, collectionName+"_revision",
+		&revisionMapper[D, E]{mapper: mapper, validFrom: validFrom},
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revisionStore[D, E]{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+// record stores the given state of an aggregate
+func (s *revisionStore[D, E]) record(ctx context.Context, d *D) error {
+	if err := s.Insert(ctx, d); err != nil {
+		return fmt.Errorf("failed to record revision: %w", err)
+	}
+	return nil
+}
+
+// recordAll stores the given states of several aggregates
+func (s *revisionStore[D, E]) recordAll(ctx context.Context, ds []*D) error {
+	if len(ds) == 0 {
+		return nil
+	}
+	revisions := make([]*revisionEntity[E], len(ds))
+	for i, d := range ds {
+		revisions[i] = s.Mapper().ToEntity(d)
+	}
+	if _, err := s.Collection(ctx).InsertMany(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record revisions: %w", err)
+	}
+	return nil
+}
+
+// recordDeletion stores that the aggregate no longer exists from now on. The deletion comes after
+// the last revision even when the clock of this replica is behind the one that recorded it.
+func (s *revisionStore[D, E]) recordDeletion(ctx context.Context, id string) error {
+	var last revisionEntity[E]
+	err := s.Collection(ctx).FindOne(ctx,
+		bson.D{{Key: "entityId", Value: id}},
+		options.FindOne().SetSort(bson.D{{Key: "validFrom", Value: -1}}).SetProjection(bson.D{{Key: "validFrom", Value: 1}}),
+	).Decode(&last)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("failed to find revision: %w", err)
+	}
+
+	_, err = s.Collection(ctx).InsertOne(ctx, revisionEntity[E]{EntityID: id, ValidFrom: utc.NowAfter(last.ValidFrom), Deleted: true})
+	if err != nil {
+		return fmt.Errorf("failed to record revision: %w", err)
+	}
+	return nil
+}
+
+// recordDeletions stores that the aggregates no longer exist from the given time on
+func (s *revisionStore[D, E]) recordDeletions(ctx context.Context, ids []string, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	revisions := make([]revisionEntity[E], len(ids))
+	for i, id := range ids {
+		revisions[i] = revisionEntity[E]{EntityID: id, ValidFrom: at, Deleted: true}
+	}
+	if _, err := s.Collection(ctx).InsertMany(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record revisions: %w", err)
+	}
+	return nil
+}
+
+// findAsOf returns the state of the aggregate at the given time.
+// It returns commonsmongo.ErrEntityNotFound if the aggregate didn't exist at that time.
+func (s *revisionStore[D, E]) findAsOf(ctx context.Context, id string, asOf time.Time) (*D, error) {
+	var rev revisionEntity[E]
+	err := s.Collection(ctx).FindOne(ctx,
+		bson.D{
+			{Key: "entityId", Value: id},
+			{Key: "validFrom", Value: bson.D{{Key: "$lte", Value: asOf}}},
+		},
+		options.FindOne().SetSort(bson.D{{Key: "validFrom", Value: -1}, {Key: "_id", Value: -1}}),
+	).Decode(&rev)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	if rev.Deleted {
+		return nil, commonsmongo.ErrEntityNotFound
+	}
+	return s.Mapper().ToDomain(&rev), nil
+}
@@ -0,0 +1,19 @@
+package mongo
+
+import (
+	"time"
+)
+
+// savedViewEntity represents the MongoDB document structure
+type savedViewEntity struct {
+	ID         string            `bson:"_id"`
+	Version    int               `bson:"version"`
+	Name       string            `bson:"name"`
+	Owner      string            `bson:"owner"`
+	EntityType string            `bson:"entityType"`
+	Filter     map[string]string `bson:"filter,omitempty"`
+	Sort       string            `bson:"sort,omitempty"`
+	Order      string            `bson:"order,omitempty"`
+	CreatedAt  time.Time         `bson:"createdAt"`
+	ModifiedAt time.Time         `bson:"modifiedAt"`
+}
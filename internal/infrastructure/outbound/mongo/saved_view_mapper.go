@@ -0,0 +1,53 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+)
+
+type savedViewMapper struct{}
+
+func newSavedViewMapper() *savedViewMapper {
+	return &savedViewMapper{}
+}
+
+func (m *savedViewMapper) ToEntity(v *savedview.SavedView) *savedViewEntity {
+	return &savedViewEntity{
+		ID:         v.ID,
+		Version:    v.Version,
+		Name:       v.Name,
+		Owner:      v.Owner,
+		EntityType: string(v.EntityType),
+		Filter:     v.Filter,
+		Sort:       v.Sort,
+		Order:      v.Order,
+		CreatedAt:  v.CreatedAt,
+		ModifiedAt: v.ModifiedAt,
+	}
+}
+
+func (m *savedViewMapper) ToDomain(e *savedViewEntity) *savedview.SavedView {
+	return savedview.Reconstruct(
+		e.ID,
+		e.Version,
+		e.Name,
+		e.Owner,
+		savedview.EntityType(e.EntityType),
+		e.Filter,
+		e.Sort,
+		e.Order,
+		e.CreatedAt.UTC(),
+		e.ModifiedAt.UTC(),
+	)
+}
+
+func (m *savedViewMapper) GetID(e *savedViewEntity) string {
+	return e.ID
+}
+
+func (m *savedViewMapper) GetVersion(e *savedViewEntity) int {
+	return e.Version
+}
+
+func (m *savedViewMapper) SetVersion(e *savedViewEntity, version int) {
+	e.Version = version
+}
@@ -0,0 +1,62 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/utc"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type savedViewRepository struct {
+	*commonsmongo.GenericRepository[savedview.SavedView, savedViewEntity]
+}
+
+func newSavedViewRepository(admin commonsmongo.Admin, mapper *savedViewMapper, resolver commonsmongo.DatabaseResolver) (savedview.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "saved_view",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedViewRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *savedViewRepository) FindList(ctx context.Context, query savedview.ListQuery) (*commonsmongo.PageResult[savedview.SavedView], error) {
+	filter := bson.D{}
+	if query.Owner != nil {
+		filter = append(filter, bson.E{Key: "owner", Value: *query.Owner})
+	}
+	if query.EntityType != nil {
+		filter = append(filter, bson.E{Key: "entityType", Value: string(*query.EntityType)})
+	}
+
+	return r.FindWithOptions(ctx, commonsmongo.QueryOptions{
+		Filter: filter,
+		Page:   query.Page,
+		Size:   query.Size,
+		Sort:   bson.D{{Key: "name", Value: 1}},
+	})
+}
+
+func (r *savedViewRepository) ReplaceOwner(ctx context.Context, owner, replacement string) (int64, error) {
+	res, err := r.Collection(ctx).UpdateMany(ctx,
+		bson.D{{Key: "owner", Value: owner}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "owner", Value: replacement}, {Key: "modifiedAt", Value: utc.Now()}}},
+			{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to replace saved view owner: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
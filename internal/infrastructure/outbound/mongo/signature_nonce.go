@@ -0,0 +1,60 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+const signatureNonceCollection = "signature_nonce"
+
+// signatureNonceEntity is keyed by the integration and nonce, so the unique _id index rejects a second insert
+type signatureNonceEntity struct {
+	ID        string    `bson:"_id"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+type nonceStore struct {
+	coll *mongo.Collection
+}
+
+// newNonceStore keeps the nonces in the service database, where all replicas see them. A TTL index removes
+// them once they expire, twice the signing tolerance after they were accepted.
+func newNonceStore(lc fx.Lifecycle, m commonsmongo.Mongo) signing.NonceStore {
+	s := &nonceStore{coll: m.GetCollection(signatureNonceCollection)}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			_, err := s.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("signature_nonce_expiresAt_ttl_v1").SetExpireAfterSeconds(0),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to ensure signature nonce indexes: %w", err)
+			}
+			return nil
+		},
+	})
+
+	return s
+}
+
+// Remember inserts the nonce; a duplicate key means it was accepted already, by this or another replica
+func (s *nonceStore) Remember(ctx context.Context, key string, expiresAt time.Time) (bool, error) {
+	_, err := s.coll.InsertOne(ctx, signatureNonceEntity{ID: key, ExpiresAt: expiresAt.UTC()})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to remember nonce: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,35 @@
+//go:build integration
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestNonceStore_Remember(t *testing.T) {
+	cleanupCollection(t, signatureNonceCollection)
+
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+	// Two stores stand for two replicas sharing the database
+	first := newNonceStore(fxtest.NewLifecycle(t), testMongo)
+	second := newNonceStore(fxtest.NewLifecycle(t), testMongo)
+
+	fresh, err := first.Remember(ctx, "storefront|abc", expiresAt)
+	require.NoError(t, err)
+	assert.True(t, fresh)
+
+	fresh, err = second.Remember(ctx, "storefront|abc", expiresAt)
+	require.NoError(t, err)
+	assert.False(t, fresh, "the nonce was accepted by another replica")
+
+	fresh, err = second.Remember(ctx, "erp|abc", expiresAt)
+	require.NoError(t, err)
+	assert.True(t, fresh, "nonces are kept per integration")
+}
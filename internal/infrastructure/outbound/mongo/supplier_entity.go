@@ -0,0 +1,23 @@
+package mongo
+
+import (
+	"time"
+)
+
+type supplierContactEntity struct {
+	Name  *string `bson:"name,omitempty"`
+	Email *string `bson:"email,omitempty"`
+	Phone *string `bson:"phone,omitempty"`
+}
+
+// supplierEntity represents the MongoDB document structure
+type supplierEntity struct {
+	ID           string                `bson:"_id"`
+	Version      int                   `bson:"version"`
+	Name         string                `bson:"name"`
+	Code         string                `bson:"code"`
+	Contact      supplierContactEntity `bson:"contact"`
+	LeadTimeDays int                   `bson:"leadTimeDays"`
+	CreatedAt    time.Time             `bson:"createdAt"`
+	ModifiedAt   time.Time             `bson:"modifiedAt"`
+}
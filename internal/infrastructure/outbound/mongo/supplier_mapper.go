@@ -0,0 +1,40 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+)
+
+type supplierMapper struct{}
+
+func newSupplierMapper() *supplierMapper {
+	return &supplierMapper{}
+}
+
+func (m *supplierMapper) ToEntity(s *supplier.Supplier) *supplierEntity {
+	return &supplierEntity{
+		ID:           s.ID,
+		Version:      s.Version,
+		Name:         s.Name,
+		Code:         s.Code,
+		Contact:      supplierContactEntity(s.Contact),
+		LeadTimeDays: s.LeadTimeDays,
+		CreatedAt:    s.CreatedAt,
+		ModifiedAt:   s.ModifiedAt,
+	}
+}
+
+func (m *supplierMapper) ToDomain(e *supplierEntity) *supplier.Supplier {
+	return supplier.Reconstruct(e.ID, e.Version, e.Name, e.Code, supplier.Contact(e.Contact), e.LeadTimeDays, e.CreatedAt.UTC(), e.ModifiedAt.UTC())
+}
+
+func (m *supplierMapper) GetID(e *supplierEntity) string {
+	return e.ID
+}
+
+func (m *supplierMapper) GetVersion(e *supplierEntity) int {
+	return e.Version
+}
+
+func (m *supplierMapper) SetVersion(e *supplierEntity, version int) {
+	e.Version = version
+}
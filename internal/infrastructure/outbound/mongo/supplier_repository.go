@@ -0,0 +1,78 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type supplierRepository struct {
+	*commonsmongo.GenericRepository[supplier.Supplier, supplierEntity]
+}
+
+func newSupplierRepository(admin commonsmongo.Admin, mapper *supplierMapper, resolver commonsmongo.DatabaseResolver) (supplier.Repository, error) {
+	genericRepo, err := commonsmongo.NewTenantRepository(
+		admin, "supplier",
+		mapper,
+		resolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &supplierRepository{
+		GenericRepository: genericRepo,
+	}, nil
+}
+
+func (r *supplierRepository) FindList(ctx context.Context, query supplier.ListQuery) (*commonsmongo.PageResult[supplier.Supplier], error) {
+	filter := bson.D{}
+	if query.ModifiedAfter != nil {
+		filter = append(filter, bson.E{Key: "modifiedAt", Value: bson.D{{Key: "$gt", Value: *query.ModifiedAfter}}})
+	}
+
+	var sortBson bson.D
+	if query.Sort != "" {
+		sortOrder := 1 // asc
+		if query.Order == "desc" {
+			sortOrder = -1
+		}
+		sortBson = bson.D{{Key: query.Sort, Value: sortOrder}}
+	}
+
+	opts := commonsmongo.QueryOptions{
+		Filter: filter,
+		Page:   query.Page,
+		Size:   query.Size,
+		Sort:   sortBson,
+	}
+
+	return r.FindWithOptions(ctx, opts)
+}
+
+// Override Insert to handle duplicate code error
+func (r *supplierRepository) Insert(ctx context.Context, s *supplier.Supplier) error {
+	err := r.GenericRepository.Insert(ctx, s)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return supplier.ErrCodeAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// Override Update to handle duplicate code error
+func (r *supplierRepository) Update(ctx context.Context, s *supplier.Supplier) (*supplier.Supplier, error) {
+	result, err := r.GenericRepository.Update(ctx, s)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, supplier.ErrCodeAlreadyExists
+		}
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/aftercommit"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// Transaction modes
+const (
+	// TransactionsEnabled runs the writes of a command and their outbox messages in one transaction
+	TransactionsEnabled = "enabled"
+	// TransactionsDisabled runs them one after the other, for a standalone Mongo without a replica set
+	TransactionsDisabled = "disabled"
+)
+
+// TransactionsConfig selects how the writes of a command are grouped
+type TransactionsConfig struct {
+	// Mode is enabled or disabled. Disabled only suits local and development setups: a failed command
+	// can leave part of its writes stored, and an outbox message is lost if the service stops between
+	// a write and the message. Default: enabled
+	Mode string `koanf:"mode"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *TransactionsConfig) ApplyDefaults() {
+	if c.Mode == "" {
+		c.Mode = TransactionsEnabled
+	}
+}
+
+// Validate validates the configuration
+func (c *TransactionsConfig) Validate() error {
+	if c.Mode != TransactionsEnabled && c.Mode != TransactionsDisabled {
+		return fmt.Errorf("mode: must be %s or %s, got %q", TransactionsEnabled, TransactionsDisabled, c.Mode)
+	}
+	return nil
+}
+
+func provideTransactionsConfig(k *koanf.Koanf) (TransactionsConfig, error) {
+	return coreconfig.Load[TransactionsConfig](k, "mongo-transactions", nil)
+}
+
+// decorateTxManager replaces the transactions of the commons tx manager when they are disabled,
+// and runs the work deferred to their commit
+func decorateTxManager(cfg TransactionsConfig, next commonsmongo.TxManager, log *zap.Logger) commonsmongo.TxManager {
+	if cfg.Mode == TransactionsEnabled {
+		return aftercommit.Decorate(next)
+	}
+	log.Warn("mongo transactions are disabled: a failed command can leave part of its writes stored, "+
+		"and outbox messages can be lost if the service stops between a write and its message",
+		zap.String("component", "tx-manager"))
+	return aftercommit.Decorate(nonTransactionalTxManager{})
+}
+
+// nonTransactionalTxManager runs the function without a session, so each write commits on its own
+type nonTransactionalTxManager struct{}
+
+func (nonTransactionalTxManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	result, err := fn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("write failed: %w", err)
+	}
+	return result, nil
+}
+
+// RollsBack tells callers that writes made before an error stay stored
+func (nonTransactionalTxManager) RollsBack() bool {
+	return false
+}
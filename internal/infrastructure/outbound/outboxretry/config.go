@@ -0,0 +1,59 @@
+package outboxretry
+
+import (
+	"errors"
+	"time"
+)
+
+// Config holds the in-process retry queue configuration.
+//
+// Retries are only a fast path: a message that was not handed over to the sender is picked up by
+// the outbox fetcher once its lock expires (10s after creation), so the total retry window should
+// stay below that to avoid sending the same message twice.
+type Config struct {
+	// QueueSize is the maximum number of pending retries. Default: 1000
+	QueueSize int `koanf:"queue-size"`
+	// MaxAttempts is the number of retries per message before leaving it to the fetcher. Default: 3
+	MaxAttempts int `koanf:"max-attempts"`
+	// InitialBackoff is the delay before the first retry, doubled on every attempt. Default: 500ms
+	InitialBackoff time.Duration `koanf:"initial-backoff"`
+	// MaxBackoff caps the delay between retries. Default: 4s
+	MaxBackoff time.Duration `koanf:"max-backoff"`
+	// Concurrency is the number of retries sent in parallel. Default: 4
+	Concurrency int `koanf:"concurrency"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.QueueSize <= 0 {
+		c.QueueSize = 1000
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 3
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = 500 * time.Millisecond
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 4 * time.Second
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = 4
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.InitialBackoff > c.MaxBackoff {
+		return errors.New("initial-backoff must not exceed max-backoff")
+	}
+	return nil
+}
+
+func (c *Config) backoff(attempt int) time.Duration {
+	d := c.InitialBackoff
+	for i := 1; i < attempt && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxBackoff)
+}
@@ -0,0 +1,72 @@
+package outboxretry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/Sokol111/ecommerce-catalog-service/outboxretry"
+
+// Drop reasons reported in the outbox.retry.dropped counter
+const (
+	dropReasonQueueFull = "queue_full"
+	dropReasonExhausted = "attempts_exhausted"
+	dropReasonShutdown  = "shutdown"
+	dropReasonNotLeader = "not_leader"
+)
+
+type queueMetrics struct {
+	enqueued  metric.Int64Counter
+	succeeded metric.Int64Counter
+	failed    metric.Int64Counter
+	dropped   metric.Int64Counter
+}
+
+func newQueueMetrics(mp metric.MeterProvider, depth func() int) (*queueMetrics, error) {
+	meter := mp.Meter(meterName)
+
+	enqueued, err := meter.Int64Counter("outbox.retry.enqueued",
+		metric.WithDescription("Outbox messages queued for retry after a failed immediate send"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create enqueued counter: %w", err)
+	}
+	succeeded, err := meter.Int64Counter("outbox.retry.succeeded",
+		metric.WithDescription("Outbox messages handed over to the sender by a retry"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create succeeded counter: %w", err)
+	}
+	failed, err := meter.Int64Counter("outbox.retry.failed",
+		metric.WithDescription("Failed retry attempts"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create failed counter: %w", err)
+	}
+	dropped, err := meter.Int64Counter("outbox.retry.dropped",
+		metric.WithDescription("Outbox messages left to the outbox fetcher without being retried"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dropped counter: %w", err)
+	}
+
+	_, err = meter.Int64ObservableGauge("outbox.retry.queue_depth",
+		metric.WithDescription("Number of outbox messages waiting for retry"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(depth()))
+			return nil
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue depth gauge: %w", err)
+	}
+
+	return &queueMetrics{
+		enqueued:  enqueued,
+		succeeded: succeeded,
+		failed:    failed,
+		dropped:   dropped,
+	}, nil
+}
+
+func (m *queueMetrics) recordDropped(ctx context.Context, reason string) {
+	m.dropped.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+}
@@ -0,0 +1,25 @@
+package outboxretry
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/worker"
+)
+
+// Module decorates the outbox with the in-process retry queue for failed immediate sends
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			newQueue,
+		),
+		fx.Decorate(newRetryingOutbox),
+		fx.Invoke(worker.RunWorker[*Queue]("outbox-retry-queue")),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "outbox-retry", nil)
+}
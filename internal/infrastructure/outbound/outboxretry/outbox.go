@@ -0,0 +1,35 @@
+package outboxretry
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// retryingOutbox decorates the outbox so failed immediate sends are queued for retry.
+//
+// A send that failed and was handed to the queue is reported as successful: the queue owns
+// the message from then on and logs the outcome, so callers don't need to.
+type retryingOutbox struct {
+	next  outbox.Outbox
+	queue *Queue
+}
+
+func newRetryingOutbox(next outbox.Outbox, queue *Queue) outbox.Outbox {
+	return &retryingOutbox{next: next, queue: queue}
+}
+
+func (o *retryingOutbox) Create(ctx context.Context, msg outbox.Message) (outbox.SendFunc, error) {
+	send, err := o.next.Create(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		if err := send(ctx); err != nil {
+			o.queue.Enqueue(ctx, msg.Key, send, err)
+			return nil
+		}
+		return nil
+	}, nil
+}
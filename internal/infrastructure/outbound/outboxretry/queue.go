@@ -0,0 +1,292 @@
+package outboxretry
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/leader"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+const (
+	// sendTimeout bounds a single retry attempt so a stuck send only holds one worker
+	sendTimeout = 2 * time.Second
+	// readOnlyPollInterval is how often the held queue checks whether the service left the read-only mode
+	readOnlyPollInterval = time.Second
+)
+
+type retryItem struct {
+	key      string
+	send     outbox.SendFunc
+	attempts int
+	notAfter time.Time
+}
+
+// retryHeap orders pending retries by due time so a message waiting for a long backoff
+// never delays one that is due earlier
+type retryHeap []*retryItem
+
+func (h retryHeap) Len() int           { return len(h) }
+func (h retryHeap) Less(i, j int) bool { return h[i].notAfter.Before(h[j].notAfter) }
+func (h retryHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *retryHeap) Push(x any)        { *h = append(*h, x.(*retryItem)) } //nolint:forcetypeassert // only retry items are pushed
+func (h *retryHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
+
+// Queue retries immediate outbox sends that failed after the transaction commit.
+//
+// The queue is bounded and best-effort: items that don't fit, run out of attempts or are still
+// pending on shutdown are dropped. That is safe because the message is already persisted in the
+// outbox collection and the outbox fetcher delivers it once its lock expires.
+//
+// Due retries are sent by up to Concurrency workers, so a message whose send keeps failing or
+// hangs does not hold back the messages queued behind it.
+//
+// Retries run on the replica leading the queue only; the other replicas leave their failed sends
+// to the outbox fetcher right away. While the service is read-only for maintenance, the queue holds
+// its retries, as a successful send marks the outbox message in the database.
+type Queue struct {
+	cfg     Config
+	elector leader.Elector
+	mode    maintenance.Service
+	metrics *queueMetrics
+	logger  *zap.Logger
+	now     func() time.Time
+	leading atomic.Bool
+
+	mu       sync.Mutex
+	pending  retryHeap
+	inFlight int
+	wake     chan struct{}
+}
+
+func newQueue(cfg Config, elector leader.Elector, mode maintenance.Service, mp metric.MeterProvider, logger *zap.Logger) (*Queue, error) {
+	q := &Queue{
+		cfg:     cfg,
+		elector: elector,
+		mode:    mode,
+		logger:  logger.With(zap.String("component", "outbox-retry-queue")),
+		now:     time.Now,
+		wake:    make(chan struct{}, 1),
+	}
+
+	m, err := newQueueMetrics(mp, q.Len)
+	if err != nil {
+		return nil, err
+	}
+	q.metrics = m
+
+	return q, nil
+}
+
+// Len returns the number of messages waiting for retry or being retried
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.pending) + q.inFlight
+}
+
+// Enqueue schedules a retry of the send that failed with cause. It never blocks the caller.
+func (q *Queue) Enqueue(ctx context.Context, key string, send outbox.SendFunc, cause error) {
+	if !q.leading.Load() {
+		q.logger.Debug("replica doesn't lead the retries, leaving message to outbox fetcher", zap.String("key", key), zap.Error(cause))
+		q.metrics.recordDropped(ctx, dropReasonNotLeader)
+		return
+	}
+
+	item := &retryItem{
+		key:      key,
+		send:     send,
+		notAfter: q.now().Add(q.cfg.backoff(1)),
+	}
+
+	q.mu.Lock()
+	if len(q.pending)+q.inFlight >= q.cfg.QueueSize {
+		q.mu.Unlock()
+		q.logger.Warn("retry queue is full, leaving message to outbox fetcher", zap.String("key", key), zap.Error(cause))
+		q.metrics.recordDropped(ctx, dropReasonQueueFull)
+		return
+	}
+	heap.Push(&q.pending, item)
+	q.mu.Unlock()
+
+	q.logger.Debug("outbox message queued for retry", zap.String("key", key), zap.Error(cause))
+	q.metrics.enqueued.Add(ctx, 1)
+	q.signal()
+}
+
+// Run processes queued retries whenever this replica leads them until ctx is canceled
+func (q *Queue) Run(ctx context.Context) error {
+	q.elector.Run(ctx, "outbox-retry", q.process)
+	return nil
+}
+
+// process retries the queued sends until ctx is canceled, which drops the pending ones
+func (q *Queue) process(ctx context.Context) {
+	q.leading.Store(true)
+	workers := make(chan struct{}, q.cfg.Concurrency)
+	var wg sync.WaitGroup
+	defer func() {
+		q.leading.Store(false)
+		wg.Wait()
+		q.drain()
+	}()
+
+	for {
+		if !q.awaitWritable(ctx) {
+			return
+		}
+		item, wait := q.next()
+		if item == nil {
+			if !q.sleep(ctx, wait) {
+				return
+			}
+			continue
+		}
+
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+			q.requeue(item)
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-workers }()
+			q.retry(ctx, item)
+		}()
+	}
+}
+
+// awaitWritable waits while the service is read-only for maintenance and reports false when ctx is done first
+func (q *Queue) awaitWritable(ctx context.Context) bool {
+	if !q.mode.Current().ReadOnly {
+		return true
+	}
+	q.logger.Info("service is read-only, holding retries")
+
+	ticker := time.NewTicker(readOnlyPollInterval)
+	defer ticker.Stop()
+	for q.mode.Current().ReadOnly {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
+
+// next takes the earliest due item, or reports how long to wait for one (zero when the queue is empty)
+func (q *Queue) next() (*retryItem, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 {
+		return nil, 0
+	}
+	if wait := q.pending[0].notAfter.Sub(q.now()); wait > 0 {
+		return nil, wait
+	}
+	item := heap.Pop(&q.pending).(*retryItem) //nolint:forcetypeassert // only retry items are pushed
+	q.inFlight++
+	return item, 0
+}
+
+// sleep waits for the given duration (or a new item when zero) and reports false on shutdown
+func (q *Queue) sleep(ctx context.Context, wait time.Duration) bool {
+	var timeout <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-q.wake:
+		return true
+	case <-timeout:
+		return true
+	}
+}
+
+func (q *Queue) retry(ctx context.Context, item *retryItem) {
+	item.attempts++
+
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	err := item.send(sendCtx)
+	cancel()
+
+	if err == nil {
+		q.done()
+		q.metrics.succeeded.Add(ctx, 1)
+		q.logger.Debug("outbox message sent on retry", zap.String("key", item.key), zap.Int("attempt", item.attempts))
+		return
+	}
+
+	q.metrics.failed.Add(ctx, 1)
+	if item.attempts >= q.cfg.MaxAttempts {
+		q.done()
+		q.logger.Warn("outbox retry attempts exhausted, leaving message to outbox fetcher",
+			zap.String("key", item.key), zap.Int("attempts", item.attempts), zap.Error(err))
+		q.metrics.recordDropped(ctx, dropReasonExhausted)
+		return
+	}
+
+	item.notAfter = q.now().Add(q.cfg.backoff(item.attempts + 1))
+	q.requeue(item)
+}
+
+// requeue puts an in-flight item back, it already holds its place in the queue
+func (q *Queue) requeue(item *retryItem) {
+	q.mu.Lock()
+	q.inFlight--
+	heap.Push(&q.pending, item)
+	q.mu.Unlock()
+
+	q.signal()
+}
+
+func (q *Queue) done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.inFlight--
+}
+
+func (q *Queue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// drain discards pending retries on shutdown or when the replica stops leading them,
+// the outbox fetcher picks them up once their lock expires
+func (q *Queue) drain() {
+	q.mu.Lock()
+	dropped := len(q.pending)
+	q.pending = nil
+	q.mu.Unlock()
+
+	for range dropped {
+		q.metrics.recordDropped(context.Background(), dropReasonShutdown)
+	}
+}
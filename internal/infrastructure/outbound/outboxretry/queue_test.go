@@ -0,0 +1,288 @@
+package outboxretry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/maintenance"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+var errBrokerDown = errors.New("broker unavailable")
+
+// flakySend simulates a broker outage that lasts for the first failures calls
+type flakySend struct {
+	failures int32
+	calls    atomic.Int32
+}
+
+func (f *flakySend) send(_ context.Context) error {
+	if f.calls.Add(1) <= f.failures {
+		return errBrokerDown
+	}
+	return nil
+}
+
+type fakeOutbox struct {
+	send outbox.SendFunc
+}
+
+func (o *fakeOutbox) Create(_ context.Context, _ outbox.Message) (outbox.SendFunc, error) {
+	return o.send, nil
+}
+
+func testConfig() Config {
+	cfg := Config{QueueSize: 10, MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	cfg.ApplyDefaults()
+	return cfg
+}
+
+// soleElector makes this replica the leader until ctx is done
+type soleElector struct{}
+
+func (soleElector) Run(ctx context.Context, _ string, fn func(ctx context.Context)) {
+	fn(ctx)
+}
+
+func (soleElector) Owner() string {
+	return "test"
+}
+
+// fixedMode is a maintenance mode that is never stored or reloaded
+type fixedMode struct {
+	readOnly atomic.Bool
+}
+
+func (m *fixedMode) Current() maintenance.Mode {
+	return maintenance.Mode{ReadOnly: m.readOnly.Load()}
+}
+
+func (m *fixedMode) Set(context.Context, maintenance.SetModeCommand) (maintenance.Mode, error) {
+	return maintenance.Mode{}, errors.New("not supported")
+}
+
+func (m *fixedMode) Reload(context.Context) (bool, error) {
+	return false, nil
+}
+
+// newTestQueue returns a queue of the leading replica; tests may enqueue before it runs
+func newTestQueue(t *testing.T, cfg Config) *Queue {
+	t.Helper()
+	q, err := newQueue(cfg, soleElector{}, &fixedMode{}, noop.NewMeterProvider(), zap.NewNop())
+	require.NoError(t, err)
+	q.leading.Store(true)
+	return q
+}
+
+func runQueue(t *testing.T, q *Queue) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = q.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestRetryingOutbox_RecoversAfterShortOutage(t *testing.T) {
+	q := newTestQueue(t, testConfig())
+	runQueue(t, q)
+
+	flaky := &flakySend{failures: 2}
+	ob := newRetryingOutbox(&fakeOutbox{send: flaky.send}, q)
+
+	send, err := ob.Create(context.Background(), outbox.Message{Key: "product-1"})
+	require.NoError(t, err)
+
+	// The immediate send fails, the queue takes the message over and retries it in the background
+	require.NoError(t, send(context.Background()))
+
+	assert.Eventually(t, func() bool { return flaky.calls.Load() == 3 }, time.Second, time.Millisecond)
+	assert.Never(t, func() bool { return flaky.calls.Load() > 3 }, 20*time.Millisecond, time.Millisecond)
+}
+
+func TestRetryingOutbox_SuccessfulSendIsNotQueued(t *testing.T) {
+	q := newTestQueue(t, testConfig())
+
+	flaky := &flakySend{}
+	ob := newRetryingOutbox(&fakeOutbox{send: flaky.send}, q)
+
+	send, err := ob.Create(context.Background(), outbox.Message{Key: "product-1"})
+	require.NoError(t, err)
+	require.NoError(t, send(context.Background()))
+
+	assert.Zero(t, q.Len())
+}
+
+func TestQueue_GivesUpDuringLongOutage(t *testing.T) {
+	q := newTestQueue(t, testConfig())
+	runQueue(t, q)
+
+	flaky := &flakySend{failures: 100}
+	q.Enqueue(context.Background(), "product-1", flaky.send, errBrokerDown)
+
+	assert.Eventually(t, func() bool { return flaky.calls.Load() == 3 }, time.Second, time.Millisecond)
+	assert.Never(t, func() bool { return flaky.calls.Load() > 3 }, 20*time.Millisecond, time.Millisecond)
+	assert.Zero(t, q.Len())
+}
+
+func TestQueue_LaterBackoffDoesNotBlockEarlierRetry(t *testing.T) {
+	q := newTestQueue(t, testConfig())
+
+	// The first message was queued while the clock was an hour ahead, so it is due much later
+	q.now = func() time.Time { return time.Now().Add(time.Hour) }
+	late := &flakySend{}
+	q.Enqueue(context.Background(), "product-1", late.send, errBrokerDown)
+
+	q.now = time.Now
+	early := &flakySend{}
+	q.Enqueue(context.Background(), "product-2", early.send, errBrokerDown)
+
+	runQueue(t, q)
+
+	assert.Eventually(t, func() bool { return early.calls.Load() == 1 }, time.Second, time.Millisecond)
+	assert.Zero(t, late.calls.Load())
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestQueue_HangingSendDoesNotBlockOthers(t *testing.T) {
+	q := newTestQueue(t, testConfig())
+	runQueue(t, q)
+
+	release := make(chan struct{})
+	defer close(release)
+	hanging := func(ctx context.Context) error {
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return errBrokerDown
+	}
+	q.Enqueue(context.Background(), "product-1", hanging, errBrokerDown)
+
+	flaky := &flakySend{}
+	q.Enqueue(context.Background(), "product-2", flaky.send, errBrokerDown)
+
+	assert.Eventually(t, func() bool { return flaky.calls.Load() == 1 }, 500*time.Millisecond, time.Millisecond)
+}
+
+func TestQueue_DropsWhenFull(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	cfg := testConfig()
+	cfg.QueueSize = 2
+
+	q, err := newQueue(cfg, soleElector{}, &fixedMode{}, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)), zap.NewNop())
+	require.NoError(t, err)
+	q.leading.Store(true)
+
+	flaky := &flakySend{failures: 100}
+	for range 3 {
+		q.Enqueue(context.Background(), "product-1", flaky.send, errBrokerDown)
+	}
+
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, int64(2), counterValue(t, reader, "outbox.retry.enqueued"))
+	assert.Equal(t, int64(1), counterValue(t, reader, "outbox.retry.dropped"))
+}
+
+func TestQueue_DrainsOnShutdown(t *testing.T) {
+	cfg := testConfig()
+	cfg.InitialBackoff = time.Hour
+	cfg.MaxBackoff = time.Hour
+	q := newTestQueue(t, cfg)
+
+	flaky := &flakySend{failures: 100}
+	q.Enqueue(context.Background(), "product-1", flaky.send, errBrokerDown)
+	q.Enqueue(context.Background(), "product-2", flaky.send, errBrokerDown)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- q.Run(ctx) }()
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("queue did not stop")
+	}
+	assert.Zero(t, q.Len())
+	assert.Zero(t, flaky.calls.Load())
+}
+
+func TestQueue_HoldsRetriesWhileReadOnly(t *testing.T) {
+	mode := &fixedMode{}
+	mode.readOnly.Store(true)
+	q, err := newQueue(testConfig(), soleElector{}, mode, noop.NewMeterProvider(), zap.NewNop())
+	require.NoError(t, err)
+	q.leading.Store(true)
+
+	flaky := &flakySend{}
+	q.Enqueue(context.Background(), "product-1", flaky.send, errBrokerDown)
+	runQueue(t, q)
+
+	assert.Never(t, func() bool { return flaky.calls.Load() > 0 }, 50*time.Millisecond, time.Millisecond)
+	assert.Equal(t, 1, q.Len())
+
+	mode.readOnly.Store(false)
+	assert.Eventually(t, func() bool { return flaky.calls.Load() == 1 }, 2*readOnlyPollInterval, time.Millisecond)
+}
+
+func TestQueue_FollowerLeavesSendsToFetcher(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	q, err := newQueue(testConfig(), soleElector{}, &fixedMode{}, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)), zap.NewNop())
+	require.NoError(t, err)
+
+	flaky := &flakySend{failures: 100}
+	q.Enqueue(context.Background(), "product-1", flaky.send, errBrokerDown)
+
+	assert.Zero(t, q.Len())
+	assert.Equal(t, int64(1), counterValue(t, reader, "outbox.retry.dropped"))
+}
+
+func TestConfig_Backoff(t *testing.T) {
+	cfg := Config{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, cfg.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, cfg.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, cfg.backoff(3))
+	assert.Equal(t, 300*time.Millisecond, cfg.backoff(64))
+}
+
+func counterValue(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var total int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			for _, dp := range sum.DataPoints {
+				total += dp.Value
+			}
+		}
+	}
+	return total
+}
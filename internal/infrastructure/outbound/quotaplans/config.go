@@ -0,0 +1,43 @@
+package quotaplans
+
+import "fmt"
+
+// Config holds the plans of the tenants.
+//
+// Plans names the limits of each plan; Tenants assigns plans by tenant slug, and tenants left out
+// get DefaultPlan. Without a default plan, tenants left out are unlimited.
+type Config struct {
+	Plans map[string]Plan `koanf:"plans"`
+	// Tenants maps tenant slugs to plan names
+	Tenants map[string]string `koanf:"tenants"`
+	// DefaultPlan is the plan of tenants left out of Tenants
+	DefaultPlan string `koanf:"default-plan"`
+}
+
+// Plan is the maximum number of items of each kind a tenant can store; zero or unset means unlimited
+type Plan struct {
+	MaxProducts   int `koanf:"max-products"`
+	MaxCategories int `koanf:"max-categories"`
+	MaxAttributes int `koanf:"max-attributes"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields; plans have none
+func (c *Config) ApplyDefaults() {}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if _, ok := c.Plans[c.DefaultPlan]; c.DefaultPlan != "" && !ok {
+		return fmt.Errorf("default-plan: unknown plan %s", c.DefaultPlan)
+	}
+	for slug, name := range c.Tenants {
+		if _, ok := c.Plans[name]; !ok {
+			return fmt.Errorf("tenants.%s: unknown plan %s", slug, name)
+		}
+	}
+	for name, p := range c.Plans {
+		if p.MaxProducts < 0 || p.MaxCategories < 0 || p.MaxAttributes < 0 {
+			return fmt.Errorf("plans.%s: limits can't be negative", name)
+		}
+	}
+	return nil
+}
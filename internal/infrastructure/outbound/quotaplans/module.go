@@ -0,0 +1,20 @@
+package quotaplans
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module provides the tenant plans the quotas are enforced with, read from the configuration
+func Module() fx.Option {
+	return fx.Provide(
+		provideConfig,
+		newPlans,
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "quotas", nil)
+}
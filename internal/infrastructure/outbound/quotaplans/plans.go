@@ -0,0 +1,33 @@
+package quotaplans
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+// plans resolves the limits of the tenant of the request from the configured plans
+type plans struct {
+	cfg Config
+}
+
+func newPlans(cfg Config) quota.Plans {
+	return &plans{cfg: cfg}
+}
+
+func (p *plans) Limits(ctx context.Context) quota.Limits {
+	name := p.cfg.DefaultPlan
+	if slug, ok := tenant.SlugFromContext(ctx); ok {
+		if assigned, ok := p.cfg.Tenants[slug]; ok {
+			name = assigned
+		}
+	}
+
+	plan := p.cfg.Plans[name]
+	return quota.Limits{
+		MaxProducts:   plan.MaxProducts,
+		MaxCategories: plan.MaxCategories,
+		MaxAttributes: plan.MaxAttributes,
+	}
+}
@@ -0,0 +1,50 @@
+package quotaplans
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-commons/pkg/tenant"
+)
+
+func testConfig() Config {
+	return Config{
+		Plans: map[string]Plan{
+			"free": {MaxProducts: 100, MaxCategories: 10, MaxAttributes: 20},
+			"pro":  {MaxProducts: 10000},
+		},
+		Tenants:     map[string]string{"acme": "pro"},
+		DefaultPlan: "free",
+	}
+}
+
+func TestPlans_Limits(t *testing.T) {
+	p := newPlans(testConfig())
+
+	assert.Equal(t, quota.Limits{MaxProducts: 10000}, p.Limits(tenant.ContextWithSlug(context.Background(), "acme")))
+	assert.Equal(t, quota.Limits{MaxProducts: 100, MaxCategories: 10, MaxAttributes: 20},
+		p.Limits(tenant.ContextWithSlug(context.Background(), "other")), "tenants left out get the default plan")
+
+	assert.Equal(t, quota.Limits{}, newPlans(Config{}).Limits(context.Background()), "no plans means unlimited")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := testConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg = testConfig()
+	cfg.DefaultPlan = "gold"
+	require.ErrorContains(t, cfg.Validate(), "default-plan")
+
+	cfg = testConfig()
+	cfg.Tenants["globex"] = "gold"
+	require.ErrorContains(t, cfg.Validate(), "tenants.globex")
+
+	cfg = testConfig()
+	cfg.Plans["free"] = Plan{MaxProducts: -1}
+	require.ErrorContains(t, cfg.Validate(), "plans.free")
+}
@@ -0,0 +1,29 @@
+package sourcecatalog
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	httpclient "github.com/Sokol111/ecommerce-commons/pkg/http/client"
+)
+
+// clientName is the entry of the catalog API of the source environment under "clients" in the configuration.
+// It is only configured in environments seeded from another one, such as staging from production.
+const clientName = "source-catalog"
+
+// Module provides the source environment of envsync
+func Module() fx.Option {
+	return fx.Provide(provideSource)
+}
+
+func provideSource(registry *httpclient.Registry) envsync.Source {
+	client, err := registry.Client(clientName)
+	if err != nil {
+		return unconfiguredSource{}
+	}
+	cfg, err := registry.Config(clientName)
+	if err != nil {
+		return unconfiguredSource{}
+	}
+	return newSource(client, cfg.BaseURL)
+}
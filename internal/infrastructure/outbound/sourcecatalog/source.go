@@ -0,0 +1,195 @@
+package sourcecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// listPageSize is the page size of the product lists read from the source
+const listPageSize = 100
+
+type source struct {
+	taxonomy catalogv1connect.TaxonomyServiceClient
+	products catalogv1connect.ProductServiceClient
+}
+
+// newSource creates a source backed by the Connect API of another catalog environment.
+// The client of the registry sends the tenant of the context and, when configured, an M2M token.
+func newSource(client *http.Client, baseURL string) *source {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &source{
+		taxonomy: catalogv1connect.NewTaxonomyServiceClient(client, baseURL),
+		products: catalogv1connect.NewProductServiceClient(client, baseURL),
+	}
+}
+
+func (s *source) Taxonomy(ctx context.Context, categoryIDs []string) (*taxonomy.Bundle, error) {
+	resp, err := s.taxonomy.ExportTaxonomy(ctx, connect.NewRequest(&catalogv1.ExportTaxonomyRequest{CategoryIds: categoryIDs}))
+	if err != nil {
+		return nil, sourceError("export taxonomy", err)
+	}
+	return decodeBundle(resp.Msg.GetBundle())
+}
+
+func (s *source) Attributes(ctx context.Context, ids []string) (*taxonomy.Bundle, error) {
+	resp, err := s.taxonomy.ExportAttributes(ctx, connect.NewRequest(&catalogv1.ExportAttributesRequest{Ids: ids}))
+	if err != nil {
+		return nil, sourceError("export attributes", err)
+	}
+	return decodeBundle(resp.Msg.GetBundle())
+}
+
+func (s *source) Products(ctx context.Context, ids []string) ([]product.CreateProductCommand, error) {
+	cmds := make([]product.CreateProductCommand, len(ids))
+	for i, id := range ids {
+		resp, err := s.products.GetProductById(ctx, connect.NewRequest(&catalogv1.GetProductByIdRequest{Id: id}))
+		if err != nil {
+			return nil, sourceError("get product "+id, err)
+		}
+		cmd, err := toCreateProductCommand(resp.Msg.GetProduct())
+		if err != nil {
+			return nil, err
+		}
+		cmds[i] = cmd
+	}
+	return cmds, nil
+}
+
+func (s *source) ProductsInCategory(ctx context.Context, categoryID string, limit int) ([]product.CreateProductCommand, error) {
+	var cmds []product.CreateProductCommand
+	for page := int32(1); len(cmds) < limit; page++ {
+		resp, err := s.products.GetProductList(ctx, connect.NewRequest(&catalogv1.GetProductListRequest{
+			Page:       page,
+			Size:       listPageSize,
+			CategoryId: &categoryID,
+		}))
+		if err != nil {
+			return nil, sourceError("list products of category "+categoryID, err)
+		}
+		for _, p := range resp.Msg.GetItems() {
+			cmd, err := toCreateProductCommand(p)
+			if err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, cmd)
+		}
+		if len(resp.Msg.GetItems()) < listPageSize {
+			break
+		}
+	}
+	if len(cmds) > limit {
+		cmds = cmds[:limit]
+	}
+	return cmds, nil
+}
+
+// sourceError maps an error of the source API to mongo.ErrEntityNotFound or envsync.ErrSourceUnavailable
+func sourceError(op string, err error) error {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return fmt.Errorf("%w: %s: %w", mongo.ErrEntityNotFound, op, err)
+	}
+	return fmt.Errorf("%w: failed to %s: %w", envsync.ErrSourceUnavailable, op, err)
+}
+
+func decodeBundle(data string) (*taxonomy.Bundle, error) {
+	var bundle taxonomy.Bundle
+	if err := json.Unmarshal([]byte(data), &bundle); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode bundle: %w", envsync.ErrSourceUnavailable, err)
+	}
+	return &bundle, nil
+}
+
+func toCreateProductCommand(p *catalogv1.Product) (product.CreateProductCommand, error) {
+	id, err := uuid.Parse(p.GetId())
+	if err != nil {
+		return product.CreateProductCommand{}, fmt.Errorf("%w: invalid product ID %q: %w", envsync.ErrSourceUnavailable, p.GetId(), err)
+	}
+
+	cmd := product.CreateProductCommand{
+		ID:          &id,
+		Name:        p.GetName(),
+		Slug:        p.GetSlug(),
+		Type:        toProductType(p.GetType()),
+		Description: p.Description,
+		Price:       p.GetPrice(),
+		Quantity:    int(p.GetQuantity()),
+		ImageID:     p.ImageId,
+		CategoryID:  p.CategoryId,
+		Enabled:     p.GetEnabled(),
+		Attributes:  lo.Map(p.GetAttributes(), func(v *catalogv1.AttributeValue, _ int) product.AttributeValue { return toAttributeValue(v) }),
+		Metadata:    p.GetMetadata(),
+		ExternalID:  p.ExternalId,
+	}
+	if p.SupplierId != nil {
+		cmd.Supplier = &product.SupplierRef{SupplierID: p.GetSupplierId(), SKU: p.SupplierSku}
+	}
+	return cmd, nil
+}
+
+func toProductType(t catalogv1.ProductType) string {
+	switch t {
+	case catalogv1.ProductType_PRODUCT_TYPE_PHYSICAL:
+		return string(product.ProductTypePhysical)
+	case catalogv1.ProductType_PRODUCT_TYPE_SERVICE:
+		return string(product.ProductTypeService)
+	default:
+		return ""
+	}
+}
+
+// toAttributeValue returns the value as it was submitted, so a converted numeric value is converted again
+func toAttributeValue(v *catalogv1.AttributeValue) product.AttributeValue {
+	av := product.AttributeValue{AttributeID: v.GetAttributeId()}
+	switch value := v.Value.(type) {
+	case *catalogv1.AttributeValue_OptionSlugValue:
+		av.OptionSlugValue = &value.OptionSlugValue
+	case *catalogv1.AttributeValue_OptionSlugValues:
+		if value.OptionSlugValues != nil {
+			av.OptionSlugValues = value.OptionSlugValues.Values
+		}
+	case *catalogv1.AttributeValue_NumericValue:
+		av.NumericValue = &value.NumericValue
+		if v.SubmittedNumericValue != nil && v.SubmittedUnit != nil {
+			av.NumericValue = v.SubmittedNumericValue
+			av.Submitted = &product.SubmittedValue{Value: v.GetSubmittedNumericValue(), Unit: v.GetSubmittedUnit()}
+		}
+	case *catalogv1.AttributeValue_TextValue:
+		av.TextValue = &value.TextValue
+	case *catalogv1.AttributeValue_BooleanValue:
+		av.BooleanValue = &value.BooleanValue
+	}
+	return av
+}
+
+// unconfiguredSource fails every read, for environments without a source-catalog client
+type unconfiguredSource struct{}
+
+func (unconfiguredSource) Taxonomy(context.Context, []string) (*taxonomy.Bundle, error) {
+	return nil, envsync.ErrSourceNotConfigured
+}
+
+func (unconfiguredSource) Attributes(context.Context, []string) (*taxonomy.Bundle, error) {
+	return nil, envsync.ErrSourceNotConfigured
+}
+
+func (unconfiguredSource) Products(context.Context, []string) ([]product.CreateProductCommand, error) {
+	return nil, envsync.ErrSourceNotConfigured
+}
+
+func (unconfiguredSource) ProductsInCategory(context.Context, string, int) ([]product.CreateProductCommand, error) {
+	return nil, envsync.ErrSourceNotConfigured
+}
@@ -0,0 +1,116 @@
+package sourcecatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	catalogv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1"
+	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// fakeProducts serves the products of one category, listed in pages
+type fakeProducts struct {
+	catalogv1connect.UnimplementedProductServiceHandler
+	products []*catalogv1.Product
+}
+
+func (f *fakeProducts) GetProductById(_ context.Context, req *connect.Request[catalogv1.GetProductByIdRequest]) (*connect.Response[catalogv1.GetProductByIdResponse], error) {
+	for _, p := range f.products {
+		if p.GetId() == req.Msg.GetId() {
+			return connect.NewResponse(&catalogv1.GetProductByIdResponse{Product: p}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+}
+
+func (f *fakeProducts) GetProductList(_ context.Context, req *connect.Request[catalogv1.GetProductListRequest]) (*connect.Response[catalogv1.GetProductListResponse], error) {
+	size := int(req.Msg.GetSize())
+	from := min((int(req.Msg.GetPage())-1)*size, len(f.products))
+	to := min(from+size, len(f.products))
+	return connect.NewResponse(&catalogv1.GetProductListResponse{Items: f.products[from:to]}), nil
+}
+
+func newTestSource(t *testing.T, products *fakeProducts) *source {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(catalogv1connect.NewProductServiceHandler(products))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return newSource(srv.Client(), srv.URL+"/")
+}
+
+func sourceProduct(name string) *catalogv1.Product {
+	return &catalogv1.Product{Id: uuid.NewString(), Name: name, Slug: name, Type: catalogv1.ProductType_PRODUCT_TYPE_PHYSICAL, Price: 10}
+}
+
+func TestSource_Products(t *testing.T) {
+	p := sourceProduct("drill")
+	categoryID, submitted, unit := "category-1", 500.0, "g"
+	p.CategoryId = &categoryID
+	p.Attributes = []*catalogv1.AttributeValue{
+		{AttributeId: "weight", Value: &catalogv1.AttributeValue_NumericValue{NumericValue: 0.5}, SubmittedNumericValue: &submitted, SubmittedUnit: &unit},
+		{AttributeId: "color", Value: &catalogv1.AttributeValue_OptionSlugValue{OptionSlugValue: "red"}},
+	}
+	s := newTestSource(t, &fakeProducts{products: []*catalogv1.Product{p}})
+
+	cmds, err := s.Products(context.Background(), []string{p.GetId()})
+
+	require.NoError(t, err)
+	require.Len(t, cmds, 1)
+	cmd := cmds[0]
+	assert.Equal(t, p.GetId(), cmd.ID.String())
+	assert.Equal(t, "drill", cmd.Name)
+	assert.Equal(t, "physical", cmd.Type)
+	assert.Equal(t, "category-1", *cmd.CategoryID)
+	require.Len(t, cmd.Attributes, 2)
+	assert.Equal(t, 500.0, *cmd.Attributes[0].NumericValue, "the submitted value is converted again")
+	assert.Equal(t, "g", cmd.Attributes[0].Submitted.Unit)
+	assert.Equal(t, "red", *cmd.Attributes[1].OptionSlugValue)
+}
+
+func TestSource_ProductsNotFound(t *testing.T) {
+	s := newTestSource(t, &fakeProducts{})
+
+	_, err := s.Products(context.Background(), []string{uuid.NewString()})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestSource_ProductsInCategory(t *testing.T) {
+	products := make([]*catalogv1.Product, 250)
+	for i := range products {
+		products[i] = sourceProduct(fmt.Sprintf("product-%d", i))
+	}
+	s := newTestSource(t, &fakeProducts{products: products})
+
+	all, err := s.ProductsInCategory(context.Background(), "category-1", 1000)
+	require.NoError(t, err)
+	assert.Len(t, all, 250, "pages are read until a short one")
+
+	limited, err := s.ProductsInCategory(context.Background(), "category-1", 120)
+	require.NoError(t, err)
+	assert.Len(t, limited, 120)
+}
+
+func TestSource_Unavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := newSource(srv.Client(), srv.URL).Taxonomy(context.Background(), []string{"category-1"})
+
+	require.ErrorIs(t, err, envsync.ErrSourceUnavailable)
+}
@@ -0,0 +1,23 @@
+package preflight
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+)
+
+// Module checks the configuration before any other module runs; list it first
+func Module() fx.Option {
+	return fx.Module("preflight", fx.Invoke(report))
+}
+
+// report prints the problems as is next to the JSON logs, where its lines would be escaped
+func report(k *koanf.Koanf) error {
+	if err := Check(k); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	return nil
+}
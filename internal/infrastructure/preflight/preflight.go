@@ -0,0 +1,141 @@
+// Package preflight checks the whole configuration before the application is built, so that a misconfigured
+// deployment fails with one report listing every problem instead of the first error of a provider.
+package preflight
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/knadh/koanf/v2"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/cronrunner"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/featureflags"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/maintenancemode"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/reservationexpiry"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/sitemap"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/storefront"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/breaker"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/cdn"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/kafka"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/mongo"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/outboxretry"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/quotaplans"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/signing"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/validationlimits"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+	grpcclient "github.com/Sokol111/ecommerce-commons/pkg/grpc/client"
+	httpclient "github.com/Sokol111/ecommerce-commons/pkg/http/client"
+	"github.com/Sokol111/ecommerce-commons/pkg/http/server"
+	kafkaconfig "github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/config"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/token"
+	"github.com/Sokol111/ecommerce-commons/pkg/security/validation"
+)
+
+// requiredClients are the HTTP clients the service calls, configured under clients
+var requiredClients = []string{"media-service"}
+
+// check loads one configuration section as its module does
+type check struct {
+	key  string
+	load func(k *koanf.Koanf, key string) error
+}
+
+// checks lists the configuration sections of the service and of the commons modules it runs.
+// There is no schema registry setting to check: events are serialized with the descriptors compiled in.
+// The request timeouts and cache-control ages are left to the connect module: importing it would register
+// the API descriptors next to the event ones, whose names clash.
+var checks = []check{
+	{"mongo", load[commonsmongo.Config]},
+	{"kafka", load[kafkaconfig.Config]},
+	{"outbox", load[outbox.Config]},
+	{"security.jwks", load[validation.Config]},
+	{"security.client-credentials", load[token.Config]},
+	{"tenant.grpc", func(k *koanf.Koanf, key string) error {
+		_, err := grpcclient.LoadConfig(k, key)
+		return err
+	}},
+	{"signing", load[signing.Config]},
+	{"products", load[mongo.ProductConfig]},
+	{"mongo-transactions", load[mongo.TransactionsConfig]},
+	{"kafka-routing", load[kafka.RoutingConfig]},
+	{"kafka-topics", load[kafka.TopicsConfig]},
+	{"bulk-events", load[kafka.BulkEventsConfig]},
+	{"outbox-retry", load[outboxretry.Config]},
+	{"circuit-breakers", load[breaker.Config]},
+	{"cdn", load[cdn.Config]},
+	{"quotas", load[quotaplans.Config]},
+	{"compression", load[compression.Config]},
+	{"sitemap", load[sitemap.Config]},
+	{"storefront", load[storefront.Config]},
+	{"maintenance", load[maintenancemode.Config]},
+	{"feature-flags", load[featureflags.Config]},
+	{"cron", load[cronrunner.Config]},
+	{"reservation-expiry", load[reservationexpiry.Config]},
+	{"validation", load[validationlimits.Config]},
+}
+
+func load[T any, PT interface {
+	*T
+	coreconfig.Configurable
+}](k *koanf.Koanf, key string) error {
+	_, err := coreconfig.Load[T, PT](k, key, nil)
+	return err
+}
+
+// Check validates every configuration section, the HTTP clients and the server port,
+// and returns one error listing all the problems found
+func Check(k *koanf.Koanf) error {
+	var problems []string
+	for _, c := range checks {
+		if err := c.load(k, c.key); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	problems = append(problems, checkClients(k)...)
+	if err := checkPort(k); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New("invalid configuration, " + strconv.Itoa(len(problems)) + " problem(s):\n  - " +
+		strings.Join(problems, "\n  - "))
+}
+
+// checkClients requires the clients the service calls and validates every configured one
+func checkClients(k *koanf.Koanf) []string {
+	var problems []string
+	configured := k.MapKeys("clients")
+	for _, name := range requiredClients {
+		if !k.Exists("clients." + name) {
+			problems = append(problems, fmt.Sprintf("missing clients.%s config: base-url is required", name))
+		}
+	}
+	for _, name := range configured {
+		if err := load[httpclient.Config](k, "clients."+name); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	return problems
+}
+
+// checkPort makes sure the server port is free, so that a second instance on the same host fails
+// before connecting to Mongo and Kafka
+func checkPort(k *koanf.Koanf) error {
+	cfg, err := coreconfig.Load[server.Config](k, "server", nil)
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Port))
+	if err != nil {
+		return fmt.Errorf("server port %d is not available: %w", cfg.Port, err)
+	}
+	return ln.Close()
+}
@@ -0,0 +1,68 @@
+package preflight
+
+import (
+	"net"
+	"testing"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validConfig sets the required settings, with the server on a free port
+func validConfig(t *testing.T) *koanf.Koanf {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	k := koanf.New(".")
+	for key, value := range map[string]any{
+		"server.port":                    port,
+		"mongo.host":                     "localhost",
+		"mongo.port":                     27017,
+		"mongo.database":                 "catalog",
+		"kafka.brokers":                  "localhost:9092",
+		"security.jwks.jwks-url":         "http://localhost:3001/oidc/jwks",
+		"tenant.grpc.address":            "localhost:9090",
+		"clients.media-service.base-url": "http://localhost:8083",
+	} {
+		require.NoError(t, k.Set(key, value))
+	}
+	return k
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check(validConfig(t)))
+}
+
+func TestCheck_ReportsEveryProblem(t *testing.T) {
+	k := validConfig(t)
+	k.Delete("kafka")
+	k.Delete("clients")
+	require.NoError(t, k.Set("signing.tolerance", "1s"))
+
+	err := Check(k)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 problem(s)")
+	assert.Contains(t, err.Error(), "kafka brokers cannot be empty")
+	assert.Contains(t, err.Error(), "missing clients.media-service config")
+	assert.Contains(t, err.Error(), "invalid signing config")
+}
+
+func TestCheck_PortInUse(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	k := validConfig(t)
+	require.NoError(t, k.Set("server.port", ln.Addr().(*net.TCPAddr).Port))
+
+	err = Check(k)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not available")
+}
@@ -0,0 +1,52 @@
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// minSecretLength keeps secrets at 256 bits, the size of the HMAC-SHA256 key
+const minSecretLength = 32
+
+// Config holds the shared secrets of the integrations that sign requests.
+//
+// Each integration lists its secrets, any of which verifies, so a secret is rotated by adding the new one,
+// waiting for the integration to sign with it and then removing the old one.
+type Config struct {
+	// Tolerance is how far the timestamp of a signed request may be from the clock;
+	// nonces are remembered twice as long to reject replays. Default: 5m
+	Tolerance time.Duration `koanf:"tolerance"`
+	// Integrations holds the secrets by integration name
+	Integrations map[string]Integration `koanf:"integrations"`
+}
+
+// Integration is the party at the other end of the signed requests
+type Integration struct {
+	Secrets []string `koanf:"secrets"`
+}
+
+// ApplyDefaults sets default values for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	if c.Tolerance <= 0 {
+		c.Tolerance = 5 * time.Minute
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.Tolerance < 10*time.Second || c.Tolerance > time.Hour {
+		return errors.New("tolerance must be between 10s and 1h")
+	}
+	for name, i := range c.Integrations {
+		if len(i.Secrets) == 0 {
+			return fmt.Errorf("integrations.%s: at least one secret is required", name)
+		}
+		for _, s := range i.Secrets {
+			if len(s) < minSecretLength {
+				return fmt.Errorf("integrations.%s: secrets must be at least %d characters", name, minSecretLength)
+			}
+		}
+	}
+	return nil
+}
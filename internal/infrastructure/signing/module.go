@@ -0,0 +1,21 @@
+package signing
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module provides the verifier of incoming integration requests. The NonceStore is provided by the persistence
+// module in use.
+func Module() fx.Option {
+	return fx.Provide(
+		provideConfig,
+		newVerifier,
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "signing", nil)
+}
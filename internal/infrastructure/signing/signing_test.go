@@ -0,0 +1,194 @@
+package signing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	oldSecret = "0123456789abcdef0123456789abcdef"
+	newSecret = "fedcba9876543210fedcba9876543210"
+)
+
+func testConfig(secrets ...string) Config {
+	cfg := Config{Integrations: map[string]Integration{"storefront": {Secrets: secrets}}}
+	cfg.ApplyDefaults()
+	return cfg
+}
+
+// sharedNonces is a NonceStore shared by verifiers the way replicas share the database
+type sharedNonces struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+	err  error
+}
+
+func newSharedNonces() *sharedNonces {
+	return &sharedNonces{seen: make(map[string]time.Time)}
+}
+
+func (n *sharedNonces) Remember(_ context.Context, key string, expiresAt time.Time) (bool, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.err != nil {
+		return false, n.err
+	}
+	if _, ok := n.seen[key]; ok {
+		return false, nil
+	}
+	n.seen[key] = expiresAt
+	return true, nil
+}
+
+func testVerifier(cfg Config) *Verifier {
+	return newVerifier(cfg, newSharedNonces(), zap.NewNop())
+}
+
+// sign signs the request the way integrations do
+func sign(t *testing.T, req *http.Request, secret string, at time.Time) {
+	t.Helper()
+
+	body, restored, err := readBody(req.Body)
+	require.NoError(t, err)
+	req.Body = restored
+
+	nonce := make([]byte, 16)
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	timestamp, encodedNonce := strconv.FormatInt(at.Unix(), 10), hex.EncodeToString(nonce)
+
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderNonce, encodedNonce)
+	req.Header.Set(HeaderSignature, hex.EncodeToString(signature(secret, timestamp, encodedNonce, req.Method, req.URL.RequestURI(), body)))
+}
+
+func signedRequest(t *testing.T, secret, body string) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/callbacks/stock?source=erp", strings.NewReader(body))
+	sign(t, req, secret, time.Now())
+	return req
+}
+
+func TestVerify(t *testing.T) {
+	v := testVerifier(testConfig(oldSecret))
+
+	req := signedRequest(t, oldSecret, `{"sku":"A1"}`)
+	require.NoError(t, v.Verify(req, "storefront"))
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"sku":"A1"}`, string(body), "the body is restored for the handler")
+}
+
+func TestVerify_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(*http.Request)
+		want   error
+	}{
+		{name: "unsigned", tamper: func(r *http.Request) { r.Header.Del(HeaderSignature) }, want: ErrMissingSignature},
+		{name: "tampered body", tamper: func(r *http.Request) { r.Body = io.NopCloser(strings.NewReader(`{"sku":"B2"}`)) }, want: ErrInvalidSignature},
+		{name: "tampered query", tamper: func(r *http.Request) { r.URL.RawQuery = "source=other" }, want: ErrInvalidSignature},
+		{name: "tampered timestamp", tamper: func(r *http.Request) {
+			r.Header.Set(HeaderTimestamp, strconv.FormatInt(time.Now().Unix()+1, 10))
+		}, want: ErrInvalidSignature},
+		{name: "malformed signature", tamper: func(r *http.Request) { r.Header.Set(HeaderSignature, "zz") }, want: ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := testVerifier(testConfig(oldSecret))
+			req := signedRequest(t, oldSecret, `{"sku":"A1"}`)
+			tt.tamper(req)
+			require.ErrorIs(t, v.Verify(req, "storefront"), tt.want)
+		})
+	}
+}
+
+func TestVerify_StaleAndReplayed(t *testing.T) {
+	v := testVerifier(testConfig(oldSecret))
+
+	stale := httptest.NewRequest(http.MethodPost, "/callbacks/stock", nil)
+	sign(t, stale, oldSecret, time.Now().Add(-10*time.Minute))
+	require.ErrorIs(t, v.Verify(stale, "storefront"), ErrStaleSignature)
+
+	req := signedRequest(t, oldSecret, "")
+	replay := req.Clone(req.Context())
+	require.NoError(t, v.Verify(req, "storefront"))
+	require.ErrorIs(t, v.Verify(replay, "storefront"), ErrReplayedSignature)
+}
+
+func TestVerify_ReplayedToAnotherReplica(t *testing.T) {
+	nonces := newSharedNonces()
+	first := newVerifier(testConfig(oldSecret), nonces, zap.NewNop())
+	second := newVerifier(testConfig(oldSecret), nonces, zap.NewNop())
+
+	req := signedRequest(t, oldSecret, "")
+	replay := req.Clone(req.Context())
+	require.NoError(t, first.Verify(req, "storefront"))
+	require.ErrorIs(t, second.Verify(replay, "storefront"), ErrReplayedSignature)
+}
+
+func TestVerify_SecretRotation(t *testing.T) {
+	v := testVerifier(testConfig(newSecret, oldSecret))
+
+	require.NoError(t, v.Verify(signedRequest(t, oldSecret, ""), "storefront"), "the old secret verifies until it is removed")
+	require.NoError(t, v.Verify(signedRequest(t, newSecret, ""), "storefront"))
+	require.ErrorIs(t, v.Verify(signedRequest(t, strings.Repeat("x", 32), ""), "storefront"), ErrInvalidSignature)
+}
+
+func TestMiddleware(t *testing.T) {
+	nonces := newSharedNonces()
+	v := newVerifier(testConfig(oldSecret), nonces, zap.NewNop())
+	var served string
+	srv := httptest.NewServer(v.Middleware("storefront")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body) //nolint:errcheck // test handler
+		served = string(body)
+	})))
+	defer srv.Close()
+
+	send := func(signed bool, body string) int {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/callbacks", strings.NewReader(body))
+		require.NoError(t, err)
+		if signed {
+			sign(t, req, oldSecret, time.Now())
+		}
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close() //nolint:errcheck // test
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, send(false, `{}`))
+
+	assert.Equal(t, http.StatusOK, send(true, `{"ok":true}`))
+	assert.JSONEq(t, `{"ok":true}`, served)
+
+	nonces.err = errors.New("connection refused")
+	assert.Equal(t, http.StatusServiceUnavailable, send(true, `{}`), "a store failure isn't blamed on the signature")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := testConfig(oldSecret)
+	require.NoError(t, valid.Validate())
+
+	short := testConfig("short")
+	require.Error(t, short.Validate())
+
+	none := testConfig()
+	require.Error(t, none.Validate())
+}
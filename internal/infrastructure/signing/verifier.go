@@ -0,0 +1,131 @@
+package signing
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxNonceLength bounds the nonces remembered for replay protection
+const maxNonceLength = 64
+
+// NonceStore remembers the nonces of accepted requests, shared by all replicas so a replay to any of them is caught
+type NonceStore interface {
+	// Remember records the key until expiresAt and reports whether it wasn't recorded already
+	Remember(ctx context.Context, key string, expiresAt time.Time) (bool, error)
+}
+
+// Verifier checks the signatures of incoming requests, such as callbacks and feed requests
+type Verifier struct {
+	cfg    Config
+	nonces NonceStore
+	now    func() time.Time
+	log    *zap.Logger
+}
+
+func newVerifier(cfg Config, nonces NonceStore, log *zap.Logger) *Verifier {
+	return &Verifier{
+		cfg:    cfg,
+		nonces: nonces,
+		now:    time.Now,
+		log:    log.With(zap.String("component", "signature-verifier")),
+	}
+}
+
+// Verify checks the signature of the request against the secrets of the integration and reads and restores its body.
+// A request is accepted once: its nonce is rejected until its timestamp falls out of the tolerance.
+func (v *Verifier) Verify(r *http.Request, integration string) error {
+	i, ok := v.cfg.Integrations[integration]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownIntegration, integration)
+	}
+
+	timestamp, nonce, sig := r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderNonce), r.Header.Get(HeaderSignature)
+	if timestamp == "" || nonce == "" || sig == "" {
+		return ErrMissingSignature
+	}
+	if len(nonce) > maxNonceLength {
+		return fmt.Errorf("%w: nonce is too long", ErrInvalidSignature)
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed timestamp", ErrInvalidSignature)
+	}
+	now := v.now()
+	if d := now.Sub(time.Unix(unix, 0)); d > v.cfg.Tolerance || d < -v.cfg.Tolerance {
+		return ErrStaleSignature
+	}
+
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	body, restored, err := readBody(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = restored
+
+	if !v.matches(i.Secrets, got, timestamp, nonce, r.Method, r.URL.RequestURI(), body) {
+		return ErrInvalidSignature
+	}
+	return v.remember(r.Context(), integration+"|"+nonce, now)
+}
+
+func (v *Verifier) matches(secrets []string, got []byte, timestamp, nonce, method, uri string, body []byte) bool {
+	for _, secret := range secrets {
+		if hmac.Equal(got, signature(secret, timestamp, nonce, method, uri, body)) {
+			return true
+		}
+	}
+	return false
+}
+
+// remember records the nonce, failing if it was seen; nonces are kept for twice the tolerance,
+// the span of timestamps a request is accepted with
+func (v *Verifier) remember(ctx context.Context, key string, now time.Time) error {
+	fresh, err := v.nonces.Remember(ctx, key, now.Add(2*v.cfg.Tolerance))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errNonceStore, err)
+	}
+	if !fresh {
+		return ErrReplayedSignature
+	}
+	return nil
+}
+
+// Knows reports whether the integration is configured, so routes can fail at startup rather than per request
+func (v *Verifier) Knows(integration string) bool {
+	_, ok := v.cfg.Integrations[integration]
+	return ok
+}
+
+// Middleware rejects requests not signed by the integration with 401 Unauthorized
+func (v *Verifier) Middleware(integration string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := v.Verify(r, integration); err != nil {
+				v.log.Warn("rejected request with invalid signature", zap.String("integration", integration),
+					zap.String("path", r.URL.Path), zap.Error(err))
+				status := http.StatusUnauthorized
+				switch {
+				case errors.Is(err, ErrUnknownIntegration):
+					status = http.StatusInternalServerError
+				case errors.Is(err, errNonceStore):
+					status = http.StatusServiceUnavailable
+				}
+				http.Error(w, http.StatusText(status), status)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package validationlimits
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+)
+
+const (
+	// minProductSlugLength keeps room for a derived slug next to the ID suffix of a taken one
+	minProductSlugLength = 32
+	// minAttributeSlugLength keeps room for a derived slug next to its -2, -3, ... suffix
+	minAttributeSlugLength = 10
+)
+
+// Config holds the sizes the catalog aggregates are validated against; unset ones keep the
+// defaults of limits.Default
+type Config struct {
+	Product   ProductConfig   `koanf:"product"`
+	Category  CategoryConfig  `koanf:"category"`
+	Attribute AttributeConfig `koanf:"attribute"`
+}
+
+type ProductConfig struct {
+	NameLength          int `koanf:"name-length"`
+	SlugLength          int `koanf:"slug-length"`
+	AttributeValues     int `koanf:"attribute-values"`
+	MetadataKeys        int `koanf:"metadata-keys"`
+	MetadataValueLength int `koanf:"metadata-value-length"`
+	ImportItems         int `koanf:"import-items"`
+}
+
+type CategoryConfig struct {
+	NameLength int `koanf:"name-length"`
+}
+
+type AttributeConfig struct {
+	NameLength       int `koanf:"name-length"`
+	SlugLength       int `koanf:"slug-length"`
+	Options          int `koanf:"options"`
+	OptionNameLength int `koanf:"option-name-length"`
+	OptionSlugLength int `koanf:"option-slug-length"`
+	InputUnits       int `koanf:"input-units"`
+}
+
+// ApplyDefaults sets the default limits for unset configuration fields
+func (c *Config) ApplyDefaults() {
+	d := limits.Default()
+	orDefault(&c.Product.NameLength, d.Product.NameLength)
+	orDefault(&c.Product.SlugLength, d.Product.SlugLength)
+	orDefault(&c.Product.AttributeValues, d.Product.AttributeValues)
+	orDefault(&c.Product.MetadataKeys, d.Product.MetadataKeys)
+	orDefault(&c.Product.MetadataValueLength, d.Product.MetadataValueLength)
+	orDefault(&c.Product.ImportItems, d.Product.ImportItems)
+	orDefault(&c.Category.NameLength, d.Category.NameLength)
+	orDefault(&c.Attribute.NameLength, d.Attribute.NameLength)
+	orDefault(&c.Attribute.SlugLength, d.Attribute.SlugLength)
+	orDefault(&c.Attribute.Options, d.Attribute.Options)
+	orDefault(&c.Attribute.OptionNameLength, d.Attribute.OptionNameLength)
+	orDefault(&c.Attribute.OptionSlugLength, d.Attribute.OptionSlugLength)
+	orDefault(&c.Attribute.InputUnits, d.Attribute.InputUnits)
+}
+
+func orDefault(v *int, d int) {
+	if *v == 0 {
+		*v = d
+	}
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	var errs []error
+	for _, f := range []struct {
+		name  string
+		value int
+	}{
+		{"product.name-length", c.Product.NameLength},
+		{"product.slug-length", c.Product.SlugLength},
+		{"product.attribute-values", c.Product.AttributeValues},
+		{"product.metadata-keys", c.Product.MetadataKeys},
+		{"product.metadata-value-length", c.Product.MetadataValueLength},
+		{"product.import-items", c.Product.ImportItems},
+		{"category.name-length", c.Category.NameLength},
+		{"attribute.name-length", c.Attribute.NameLength},
+		{"attribute.slug-length", c.Attribute.SlugLength},
+		{"attribute.options", c.Attribute.Options},
+		{"attribute.option-name-length", c.Attribute.OptionNameLength},
+		{"attribute.option-slug-length", c.Attribute.OptionSlugLength},
+		{"attribute.input-units", c.Attribute.InputUnits},
+	} {
+		if f.value < 0 {
+			errs = append(errs, fmt.Errorf("%s can't be negative", f.name))
+		}
+	}
+	if c.Product.SlugLength < minProductSlugLength {
+		errs = append(errs, fmt.Errorf("product.slug-length must be at least %d", minProductSlugLength))
+	}
+	if c.Attribute.SlugLength < minAttributeSlugLength {
+		errs = append(errs, fmt.Errorf("attribute.slug-length must be at least %d", minAttributeSlugLength))
+	}
+	return errors.Join(errs...)
+}
+
+// limits returns the limits the configuration sets
+func (c *Config) limits() limits.Limits {
+	return limits.Limits{
+		Product: limits.Product{
+			NameLength:          c.Product.NameLength,
+			SlugLength:          c.Product.SlugLength,
+			AttributeValues:     c.Product.AttributeValues,
+			MetadataKeys:        c.Product.MetadataKeys,
+			MetadataValueLength: c.Product.MetadataValueLength,
+			ImportItems:         c.Product.ImportItems,
+		},
+		Category: limits.Category{
+			NameLength: c.Category.NameLength,
+		},
+		Attribute: limits.Attribute{
+			NameLength:       c.Attribute.NameLength,
+			SlugLength:       c.Attribute.SlugLength,
+			Options:          c.Attribute.Options,
+			OptionNameLength: c.Attribute.OptionNameLength,
+			OptionSlugLength: c.Attribute.OptionSlugLength,
+			InputUnits:       c.Attribute.InputUnits,
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package validationlimits
+
+import (
+	"testing"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+func TestConfig_Defaults(t *testing.T) {
+	cfg, err := coreconfig.Load[Config](koanf.New("."), "validation", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, limits.Default(), cfg.limits())
+}
+
+func TestConfig_Overrides(t *testing.T) {
+	k := koanf.New(".")
+	require.NoError(t, k.Set("validation.product.name-length", 1000))
+	require.NoError(t, k.Set("validation.attribute.options", 5000))
+	require.NoError(t, k.Set("validation.product.attribute-values", 300))
+
+	cfg, err := coreconfig.Load[Config](k, "validation", nil)
+	require.NoError(t, err)
+
+	l := cfg.limits()
+	assert.Equal(t, 1000, l.Product.NameLength)
+	assert.Equal(t, 5000, l.Attribute.Options)
+	assert.Equal(t, 300, l.Product.AttributeValues)
+	assert.Equal(t, limits.Default().Attribute.SlugLength, l.Attribute.SlugLength, "unset limits keep their default")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	k := koanf.New(".")
+	require.NoError(t, k.Set("validation.category.name-length", -1))
+	require.NoError(t, k.Set("validation.product.slug-length", 16))
+	require.NoError(t, k.Set("validation.attribute.slug-length", 5))
+
+	_, err := coreconfig.Load[Config](k, "validation", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "category.name-length can't be negative")
+	assert.Contains(t, err.Error(), "product.slug-length must be at least 32")
+	assert.Contains(t, err.Error(), "attribute.slug-length must be at least 10")
+}
@@ -0,0 +1,29 @@
+// Package validationlimits applies the configured validation limits of the catalog aggregates, so a
+// marketplace can accept longer names or more options than a small shop without changing the domain.
+package validationlimits
+
+import (
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	coreconfig "github.com/Sokol111/ecommerce-commons/pkg/core/config"
+)
+
+// Module provides the limits the aggregates are validated against
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			provideConfig,
+			provideLimits,
+		),
+	)
+}
+
+func provideConfig(k *koanf.Koanf) (Config, error) {
+	return coreconfig.Load[Config](k, "validation", nil)
+}
+
+func provideLimits(cfg Config) limits.Limits {
+	return cfg.limits()
+}
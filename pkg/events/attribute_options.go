@@ -0,0 +1,22 @@
+package events
+
+import (
+	"slices"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+)
+
+// AffectedOptionSlugs returns every option slug touched by the options_changed part of an
+// AttributeUpdated event, sorted and without duplicates. Consumers caching product facets only
+// need to invalidate entries for these options; an event without options_changed returns nil.
+func AffectedOptionSlugs(change *eventsv1.AttributeOptionsChanged) []string {
+	var slugs []string
+	for _, opt := range slices.Concat(change.GetAdded(), change.GetRemoved()) {
+		slugs = append(slugs, opt.GetSlug())
+	}
+	for _, r := range change.GetRenamed() {
+		slugs = append(slugs, r.GetSlug())
+	}
+	slices.Sort(slugs)
+	return slices.Compact(slugs)
+}
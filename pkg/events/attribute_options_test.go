@@ -0,0 +1,23 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+)
+
+func TestAffectedOptionSlugs(t *testing.T) {
+	change := &eventsv1.AttributeOptionsChanged{
+		Added:   []*eventsv1.AttributeOption{{Slug: "white"}, {Slug: "black"}},
+		Removed: []*eventsv1.AttributeOption{{Slug: "green"}},
+		Renamed: []*eventsv1.AttributeOptionRename{{Slug: "red", OldName: "Red", NewName: "Crimson"}, {Slug: "black"}},
+	}
+
+	assert.Equal(t, []string{"black", "green", "red", "white"}, AffectedOptionSlugs(change))
+}
+
+func TestAffectedOptionSlugs_NoChange(t *testing.T) {
+	assert.Nil(t, AffectedOptionSlugs(nil))
+}
@@ -0,0 +1,152 @@
+// Package events describes the metadata the catalog service attaches to every published event
+// and provides helpers that consumers can use to process catalog events idempotently.
+//
+// Partition key: every event is keyed by the ID of the aggregate it describes (product, category
+// or attribute), so all events of a single aggregate land on the same partition and are consumed
+// in the order they were committed.
+//
+// Sequence: the aggregate version starts at 1 on creation and is incremented by exactly one on
+// every committed change; optimistic locking guarantees that no two changes share a version.
+// A consumer that has applied version N of an aggregate can therefore skip any event with a version
+// less than or equal to N, which makes outbox redeliveries harmless.
+//
+// Deletion events continue the sequence with the last version plus one and set the aggregate_deleted
+// header. A consumer keeps the deletion version as a tombstone, so an older update redelivered after
+// the deletion is recognised as stale and doesn't bring the aggregate back. Aggregate IDs are never
+// reused, a deleted aggregate is not recreated.
+//
+// Replay: an operator can republish the current state of every aggregate to rebuild read models.
+// Replayed events carry the stored version, which the consumer may already have applied, and set
+// the replay header. IsStale and VersionTracker accept a replayed event whose version equals the
+// last applied one, so the read model is overwritten with the same state, and still reject replayed
+// events older than it: a change committed while the replay was running wins over the replayed copy.
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// Message header keys set on every catalog event
+const (
+	HeaderAggregateType    = "aggregate_type"
+	HeaderAggregateID      = "aggregate_id"
+	HeaderAggregateVersion = "aggregate_version"
+	HeaderSchemaVersion    = "schema_version"
+	HeaderAggregateDeleted = "aggregate_deleted"
+	HeaderReplay           = "replay"
+)
+
+// Aggregate types used in the aggregate_type header
+const (
+	AggregateProduct     = "product"
+	AggregateCategory    = "category"
+	AggregateAttribute   = "attribute"
+	AggregateReservation = "reservation"
+	// AggregateProductBatch is a bulk product write summarized by one event, which always has version 1
+	AggregateProductBatch = "product-batch"
+)
+
+// ErrMissingMetadata is returned when an event doesn't carry the aggregate headers
+var ErrMissingMetadata = errors.New("event metadata headers are missing")
+
+// Metadata identifies the aggregate change an event was produced for
+type Metadata struct {
+	AggregateType string
+	AggregateID   string
+	Version       int64
+	Deleted       bool
+	Replay        bool
+}
+
+// Headers returns the metadata encoded as message headers
+func (m Metadata) Headers() map[string]string {
+	headers := map[string]string{
+		HeaderAggregateType:    m.AggregateType,
+		HeaderAggregateID:      m.AggregateID,
+		HeaderAggregateVersion: strconv.FormatInt(m.Version, 10),
+	}
+	if m.Deleted {
+		headers[HeaderAggregateDeleted] = "true"
+	}
+	if m.Replay {
+		headers[HeaderReplay] = "true"
+	}
+	return headers
+}
+
+// IsDeletion reports whether the event removes the aggregate
+func (m Metadata) IsDeletion() bool {
+	return m.Deleted
+}
+
+// MetadataFromHeaders decodes the metadata from message headers
+func MetadataFromHeaders(headers map[string]string) (Metadata, error) {
+	aggregateType, id := headers[HeaderAggregateType], headers[HeaderAggregateID]
+	rawVersion, ok := headers[HeaderAggregateVersion]
+	if aggregateType == "" || id == "" || !ok {
+		return Metadata{}, ErrMissingMetadata
+	}
+
+	version, err := strconv.ParseInt(rawVersion, 10, 64)
+	if err != nil || version < 1 {
+		return Metadata{}, fmt.Errorf("invalid %s header %q", HeaderAggregateVersion, rawVersion)
+	}
+
+	deleted := false
+	if raw, ok := headers[HeaderAggregateDeleted]; ok {
+		if deleted, err = strconv.ParseBool(raw); err != nil {
+			return Metadata{}, fmt.Errorf("invalid %s header %q", HeaderAggregateDeleted, raw)
+		}
+	}
+
+	replay := false
+	if raw, ok := headers[HeaderReplay]; ok {
+		if replay, err = strconv.ParseBool(raw); err != nil {
+			return Metadata{}, fmt.Errorf("invalid %s header %q", HeaderReplay, raw)
+		}
+	}
+
+	return Metadata{AggregateType: aggregateType, AggregateID: id, Version: version, Deleted: deleted, Replay: replay}, nil
+}
+
+// IsStale reports whether an event is already covered by the last applied version of its aggregate.
+// Consumers that persist the applied version alongside their read model should use it inside
+// the same transaction as the update, and keep the version of a deleted aggregate as a tombstone.
+// A replayed event is only stale when a newer version has been applied.
+func IsStale(m Metadata, lastApplied int64) bool {
+	if m.Replay {
+		return m.Version < lastApplied
+	}
+	return m.Version <= lastApplied
+}
+
+// VersionTracker remembers the last applied version per aggregate in memory.
+// It suits consumers whose state is rebuilt from the topic on start; durable consumers
+// should store the version with their data and use IsStale instead.
+type VersionTracker struct {
+	mu       sync.Mutex
+	versions map[string]int64
+}
+
+// NewVersionTracker creates an empty tracker
+func NewVersionTracker() *VersionTracker {
+	return &VersionTracker{versions: make(map[string]int64)}
+}
+
+// Observe records the event and reports whether it should be processed.
+// Stale events and redeliveries return false. The version of a deletion stays as a tombstone,
+// so updates that were redelivered after it are rejected as well.
+func (t *VersionTracker) Observe(m Metadata) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := m.AggregateType + "/" + m.AggregateID
+	if IsStale(m, t.versions[key]) {
+		return false
+	}
+	t.versions[key] = m.Version
+	return true
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadata_HeadersRoundTrip(t *testing.T) {
+	m := Metadata{AggregateType: AggregateProduct, AggregateID: "product-1", Version: 7}
+
+	decoded, err := MetadataFromHeaders(m.Headers())
+	require.NoError(t, err)
+	assert.Equal(t, m, decoded)
+}
+
+func TestMetadata_DeletionHeadersRoundTrip(t *testing.T) {
+	m := Metadata{AggregateType: AggregateProduct, AggregateID: "product-1", Version: 8, Deleted: true}
+
+	decoded, err := MetadataFromHeaders(m.Headers())
+	require.NoError(t, err)
+	assert.Equal(t, m, decoded)
+	assert.True(t, decoded.IsDeletion())
+}
+
+func TestMetadata_ReplayHeadersRoundTrip(t *testing.T) {
+	m := Metadata{AggregateType: AggregateCategory, AggregateID: "category-1", Version: 3, Replay: true}
+
+	decoded, err := MetadataFromHeaders(m.Headers())
+	require.NoError(t, err)
+	assert.Equal(t, m, decoded)
+	assert.Equal(t, "true", m.Headers()[HeaderReplay])
+}
+
+func TestMetadataFromHeaders_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{name: "missing headers", headers: map[string]string{}},
+		{name: "missing id", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateVersion: "1"}},
+		{name: "missing version", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p"}},
+		{name: "non-numeric version", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p", HeaderAggregateVersion: "x"}},
+		{name: "negative version", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p", HeaderAggregateVersion: "-1"}},
+		{name: "zero version", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p", HeaderAggregateVersion: "0"}},
+		{name: "invalid deleted flag", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p", HeaderAggregateVersion: "1", HeaderAggregateDeleted: "yes"}},
+		{name: "invalid replay flag", headers: map[string]string{HeaderAggregateType: AggregateProduct, HeaderAggregateID: "p", HeaderAggregateVersion: "1", HeaderReplay: "yes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MetadataFromHeaders(tt.headers)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	assert.False(t, IsStale(Metadata{Version: 3}, 2))
+	assert.True(t, IsStale(Metadata{Version: 2}, 2))
+	assert.True(t, IsStale(Metadata{Version: 1}, 2))
+	assert.True(t, IsStale(Metadata{Version: 2, Deleted: true}, 2), "redelivered deletion")
+	assert.False(t, IsStale(Metadata{Version: 2, Replay: true}, 2), "replay of the applied version")
+	assert.True(t, IsStale(Metadata{Version: 1, Replay: true}, 2), "replay overtaken by a newer change")
+}
+
+func TestVersionTracker_Observe(t *testing.T) {
+	tracker := NewVersionTracker()
+	v := func(version int64) Metadata {
+		return Metadata{AggregateType: AggregateCategory, AggregateID: "category-1", Version: version}
+	}
+
+	assert.True(t, tracker.Observe(v(1)))
+	assert.True(t, tracker.Observe(v(3)))
+	assert.False(t, tracker.Observe(v(3)), "redelivery")
+	assert.False(t, tracker.Observe(v(2)), "out of order")
+
+	other := Metadata{AggregateType: AggregateAttribute, AggregateID: "category-1", Version: 1}
+	assert.True(t, tracker.Observe(other), "versions are tracked per aggregate type")
+
+	deleted := v(4)
+	deleted.Deleted = true
+	assert.True(t, tracker.Observe(deleted))
+	assert.False(t, tracker.Observe(v(3)), "update redelivered after deletion")
+	assert.False(t, tracker.Observe(deleted), "redelivered deletion")
+
+	applied := Metadata{AggregateType: AggregateCategory, AggregateID: "category-2", Version: 5}
+	assert.True(t, tracker.Observe(applied))
+	replayed := applied
+	replayed.Replay = true
+	assert.True(t, tracker.Observe(replayed), "replay of the applied version")
+	replayed.Version = 4
+	assert.False(t, tracker.Observe(replayed), "replay older than the applied version")
+}
@@ -0,0 +1,84 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+)
+
+func TestAPIKey_CreateAndAuthenticate(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createKey.Handle(ctx, apikey.CreateAPIKeyCommand{Tenant: "acme", Name: "ERP feed", Permissions: []string{"products:write"}})
+	require.NoError(t, err)
+
+	k, err := h.authenticate.Authenticate(ctx, created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", k.Tenant)
+	assert.Equal(t, []string{"products:write"}, k.Permissions)
+
+	_, err = h.authenticate.Authenticate(ctx, created.Key+"x")
+	require.ErrorIs(t, err, apikey.ErrInvalidAPIKey, "a wrong secret is rejected")
+	_, err = h.authenticate.Authenticate(ctx, apikey.Prefix+"unknown.secret")
+	require.ErrorIs(t, err, apikey.ErrInvalidAPIKey, "an unknown key is rejected like a wrong secret")
+
+	keys, err := h.listKeys.Handle(ctx, apikey.GetAPIKeyListQuery{Tenant: "acme"})
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, created.APIKey.ID, keys[0].ID)
+
+	keys, err = h.listKeys.Handle(ctx, apikey.GetAPIKeyListQuery{Tenant: "globex"})
+	require.NoError(t, err)
+	assert.Empty(t, keys, "keys are listed per tenant")
+}
+
+func TestAPIKey_RotateWithGracePeriod(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	old, err := h.createKey.Handle(ctx, apikey.CreateAPIKeyCommand{Tenant: "acme", Name: "ERP feed", Permissions: []string{"products:write"}})
+	require.NoError(t, err)
+
+	rotated, err := h.rotateKey.Handle(ctx, apikey.RotateAPIKeyCommand{Tenant: "acme", ID: old.APIKey.ID, GracePeriod: time.Hour})
+	require.NoError(t, err)
+	assert.NotEqual(t, old.Key, rotated.Key)
+	assert.Equal(t, "ERP feed", rotated.APIKey.Name)
+	assert.Equal(t, []string{"products:write"}, rotated.APIKey.Permissions)
+
+	_, err = h.authenticate.Authenticate(ctx, rotated.Key)
+	require.NoError(t, err)
+	k, err := h.authenticate.Authenticate(ctx, old.Key)
+	require.NoError(t, err, "the old key works during the grace period")
+	require.NotNil(t, k.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *k.ExpiresAt, time.Minute)
+
+	_, err = h.rotateKey.Handle(ctx, apikey.RotateAPIKeyCommand{Tenant: "globex", ID: rotated.APIKey.ID})
+	require.ErrorIs(t, err, apikey.ErrAPIKeyNotFound, "keys of other tenants can't be rotated")
+
+	again, err := h.rotateKey.Handle(ctx, apikey.RotateAPIKeyCommand{Tenant: "acme", ID: rotated.APIKey.ID})
+	require.NoError(t, err)
+	_, err = h.authenticate.Authenticate(ctx, rotated.Key)
+	require.ErrorIs(t, err, apikey.ErrInvalidAPIKey, "without a grace period the old key stops at once")
+	_, err = h.authenticate.Authenticate(ctx, again.Key)
+	require.NoError(t, err)
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createKey.Handle(ctx, apikey.CreateAPIKeyCommand{Tenant: "acme", Name: "ERP feed", Permissions: []string{"products:read"}})
+	require.NoError(t, err)
+
+	err = h.revokeKey.Handle(ctx, apikey.RevokeAPIKeyCommand{Tenant: "globex", ID: created.APIKey.ID})
+	require.ErrorIs(t, err, apikey.ErrAPIKeyNotFound, "keys of other tenants can't be revoked")
+
+	require.NoError(t, h.revokeKey.Handle(ctx, apikey.RevokeAPIKeyCommand{Tenant: "acme", ID: created.APIKey.ID}))
+	_, err = h.authenticate.Authenticate(ctx, created.Key)
+	require.ErrorIs(t, err, apikey.ErrInvalidAPIKey)
+}
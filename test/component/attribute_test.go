@@ -0,0 +1,157 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+)
+
+func TestAttribute_CreateAndUpdate(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created := h.givenAttribute(t, "color", "red", "blue")
+
+	createdEvent := sentEvent[*eventsv1.AttributeUpdatedEvent](t, h, 0)
+	assert.Equal(t, "color", createdEvent.GetSlug())
+	assert.Len(t, createdEvent.GetOptions(), 2)
+
+	updated, err := h.updateAttribute.Handle(ctx, attribute.UpdateAttributeCommand{
+		ID:      created.ID,
+		Version: created.Version,
+		Name:    "Colour",
+		Enabled: true,
+		Options: []attribute.OptionInput{{Name: "Red", Slug: "red"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	updatedEvent := sentEvent[*eventsv1.AttributeUpdatedEvent](t, h, 1)
+	assert.Equal(t, "Colour", updatedEvent.GetName())
+	assert.EqualValues(t, 2, updatedEvent.GetVersion())
+	assert.Len(t, updatedEvent.GetOptions(), 1)
+}
+
+func TestAttribute_Create_DuplicateSlugRollsBack(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	h.givenAttribute(t, "color")
+
+	_, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name: "Colour",
+		Slug: "color",
+		Type: string(attribute.AttributeTypeSingle),
+	})
+	require.ErrorIs(t, err, attribute.ErrSlugAlreadyExists)
+
+	list, err := h.attributeRepo.FindList(ctx, attribute.ListQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), list.Total)
+	assert.Len(t, h.outbox.Messages(), 1)
+}
+
+func TestAttribute_Create_DerivesSlug(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	h.givenAttribute(t, "color")
+
+	for _, want := range []string{"color-2", "color-3"} {
+		a, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+			Name: "Color",
+			Type: string(attribute.AttributeTypeSingle),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, a.Slug)
+	}
+}
+
+func TestAttribute_Create_InvalidData(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.createAttribute.Handle(testCtx(), attribute.CreateAttributeCommand{
+		Name: "Color",
+		Slug: "Not A Slug",
+		Type: string(attribute.AttributeTypeSingle),
+	})
+	require.ErrorIs(t, err, attribute.ErrInvalidAttributeData)
+	assert.Empty(t, h.outbox.Messages())
+}
+
+func TestAttribute_SetDisplay(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created := h.givenAttribute(t, "fabric", "denim", "linen")
+	assert.Equal(t, attribute.DisplayTypeDropdown, created.DisplayType)
+
+	updated, err := h.setAttrDisplay.Handle(ctx, attribute.SetAttributeDisplayCommand{
+		ID:           created.ID,
+		Version:      created.Version,
+		DisplayType:  string(attribute.DisplayTypeSwatch),
+		OptionImages: map[string]string{"denim": "image-denim", "linen": "image-linen"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	displayEvent := sentEvent[*eventsv1.AttributeUpdatedEvent](t, h, 1)
+	assert.Equal(t, eventsv1.AttributeFilterControl_ATTRIBUTE_FILTER_CONTROL_SWATCH, displayEvent.GetDisplayType())
+	require.Len(t, displayEvent.GetOptions(), 2)
+	assert.Equal(t, "image-denim", displayEvent.GetOptions()[0].GetImageId())
+	assert.Equal(t, "image-linen", displayEvent.GetOptions()[1].GetImageId())
+	assert.Nil(t, displayEvent.GetOptionsChanged())
+
+	// Renaming options keeps their swatch images
+	renamed, err := h.updateAttribute.Handle(ctx, attribute.UpdateAttributeCommand{
+		ID:      created.ID,
+		Version: updated.Version,
+		Name:    "Fabric",
+		Enabled: true,
+		Options: []attribute.OptionInput{{Name: "Raw denim", Slug: "denim"}, {Name: "Linen", Slug: "linen"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, attribute.DisplayTypeSwatch, renamed.DisplayType)
+	assert.Equal(t, ptr("image-denim"), renamed.Options[0].ImageID)
+
+	renamedEvent := sentEvent[*eventsv1.AttributeUpdatedEvent](t, h, 2)
+	require.Len(t, renamedEvent.GetOptionsChanged().GetRenamed(), 2)
+	assert.Equal(t, "denim", renamedEvent.GetOptionsChanged().GetRenamed()[0].GetSlug())
+	assert.Equal(t, "denim", renamedEvent.GetOptionsChanged().GetRenamed()[0].GetOldName())
+	assert.Equal(t, "Raw denim", renamedEvent.GetOptionsChanged().GetRenamed()[0].GetNewName())
+	assert.Empty(t, renamedEvent.GetOptionsChanged().GetAdded())
+}
+
+func TestAttribute_SetDisplay_IncompatibleType(t *testing.T) {
+	h := newHarness(t)
+
+	created := h.givenAttribute(t, "color", "red")
+
+	_, err := h.setAttrDisplay.Handle(testCtx(), attribute.SetAttributeDisplayCommand{
+		ID:          created.ID,
+		Version:     created.Version,
+		DisplayType: string(attribute.DisplayTypeSlider),
+	})
+	require.ErrorIs(t, err, attribute.ErrInvalidAttributeData)
+	assert.Len(t, h.outbox.Messages(), 1)
+}
+
+func TestAttribute_SetDisplay_UnknownImage(t *testing.T) {
+	h := newHarness(t)
+
+	created := h.givenAttribute(t, "fabric", "denim")
+	h.images.RemoveImages("image-missing")
+
+	_, err := h.setAttrDisplay.Handle(testCtx(), attribute.SetAttributeDisplayCommand{
+		ID:           created.ID,
+		Version:      created.Version,
+		DisplayType:  string(attribute.DisplayTypeSwatch),
+		OptionImages: map[string]string{"denim": "image-missing"},
+	})
+	require.ErrorIs(t, err, attribute.ErrImageNotFound)
+	assert.Len(t, h.outbox.Messages(), 1)
+}
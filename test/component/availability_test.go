@@ -0,0 +1,87 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestAvailability_SetAndQuery(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	service, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Haircut", Type: string(product.ProductTypeService), Price: 30, Quantity: 1})
+	require.NoError(t, err)
+
+	created, err := h.setSchedule.Handle(ctx, availability.SetScheduleCommand{
+		ProductID: service.ID,
+		Timezone:  "Europe/Kyiv",
+		WeeklySlots: []availability.WeeklySlotInput{
+			{Weekday: "monday", Start: "09:00", End: "12:00"},
+			{Weekday: "monday", Start: "13:00", End: "18:00"},
+		},
+		Blackouts: []availability.BlackoutInput{{Date: "2026-03-16", Reason: "holiday"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Version)
+
+	// 2026-03-09 and 2026-03-16 are Mondays
+	result, err := h.getAvailability.Handle(ctx, availability.GetAvailabilityQuery{
+		ProductID: service.ID,
+		From:      "2026-03-09",
+		To:        "2026-03-16",
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Days, 8)
+
+	monday := result.Days[0]
+	require.Len(t, monday.TimeSlots, 2)
+	assert.Equal(t, time.Date(2026, 3, 9, 7, 0, 0, 0, time.UTC), monday.TimeSlots[0].Start.UTC())
+	assert.Empty(t, result.Days[1].TimeSlots)
+
+	holiday := result.Days[7]
+	assert.True(t, holiday.Blackout)
+	assert.Equal(t, "holiday", holiday.Reason)
+	assert.Empty(t, holiday.TimeSlots)
+
+	_, err = h.setSchedule.Handle(ctx, availability.SetScheduleCommand{ProductID: service.ID, Timezone: "UTC"})
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+
+	updated, err := h.setSchedule.Handle(ctx, availability.SetScheduleCommand{
+		ProductID: service.ID,
+		Version:   created.Version,
+		Timezone:  "UTC",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+	assert.Empty(t, updated.WeeklySlots)
+}
+
+func TestAvailability_UnknownProduct(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	_, err := h.setSchedule.Handle(ctx, availability.SetScheduleCommand{ProductID: "missing", Timezone: "UTC"})
+	require.ErrorIs(t, err, availability.ErrProductNotFound)
+
+	_, err = h.getAvailability.Handle(ctx, availability.GetAvailabilityQuery{ProductID: "missing", From: "2026-03-09", To: "2026-03-10"})
+	require.ErrorIs(t, err, availability.ErrScheduleNotFound)
+}
+
+func TestAvailability_PhysicalProductRejected(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	phone, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	assert.Equal(t, product.ProductTypePhysical, phone.Type)
+
+	_, err = h.setSchedule.Handle(ctx, availability.SetScheduleCommand{ProductID: phone.ID, Timezone: "UTC"})
+	require.ErrorIs(t, err, availability.ErrNotServiceProduct)
+}
@@ -0,0 +1,78 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+)
+
+func TestCategoryTemplate_Apply(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	brand, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name: "Brand", Slug: "brand", Type: string(attribute.AttributeTypeText), Enabled: true,
+	})
+	require.NoError(t, err)
+	sentBefore := len(h.outbox.SentMessages())
+
+	result, err := h.applyTemplate.Handle(ctx, categorytemplate.ApplyTemplateCommand{Template: "fashion", Enabled: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fashion", result.Category.Name)
+	require.Len(t, result.Category.Attributes, 5)
+	assert.Equal(t, 4, result.CreatedAttributes, "the existing brand attribute is reused")
+	assert.Equal(t, brand.ID, result.Attributes[3].ID)
+	assert.Equal(t, category.AttributeRoleVariant, result.Category.Attributes[0].Role)
+	assert.True(t, result.Category.Attributes[0].Required)
+
+	sent := h.outbox.SentMessages()[sentBefore:]
+	require.Len(t, sent, 5)
+	assert.IsType(t, &eventsv1.AttributeUpdatedEvent{}, sent[0].Event)
+	categoryEvent := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore+4)
+	assert.Equal(t, result.Category.ID, categoryEvent.GetCategoryId())
+	assert.Equal(t, "color", categoryEvent.GetAttributes()[0].GetAttributeSlug())
+
+	// A second vertical shares brand and color with fashion
+	electronics, err := h.applyTemplate.Handle(ctx, categorytemplate.ApplyTemplateCommand{Template: "electronics", CategoryName: "Phones"})
+	require.NoError(t, err)
+	assert.Equal(t, "Phones", electronics.Category.Name)
+	assert.Equal(t, 4, electronics.CreatedAttributes)
+}
+
+func TestCategoryTemplate_Apply_RollsBack(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	// An existing free-text color can't be a variant, so nothing of the template is stored
+	_, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name: "Color", Slug: "color", Type: string(attribute.AttributeTypeText), Enabled: true,
+	})
+	require.NoError(t, err)
+
+	_, err = h.applyTemplate.Handle(ctx, categorytemplate.ApplyTemplateCommand{Template: "fashion"})
+	require.ErrorIs(t, err, category.ErrInvalidCategoryData)
+
+	attrs, err := h.attributeRepo.FindList(ctx, attribute.ListQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), attrs.Total)
+	categories, err := h.categoryRepo.FindList(ctx, category.ListQuery{})
+	require.NoError(t, err)
+	assert.Zero(t, categories.Total)
+	assert.Len(t, h.outbox.Messages(), 1)
+}
+
+func TestCategoryTemplate_Apply_UnknownTemplate(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.applyTemplate.Handle(testCtx(), categorytemplate.ApplyTemplateCommand{Template: "furniture"})
+
+	require.ErrorIs(t, err, categorytemplate.ErrTemplateNotFound)
+	assert.Empty(t, h.outbox.Messages())
+}
@@ -0,0 +1,177 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestCategory_CreateAndUpdate(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	size := h.givenAttribute(t, "size", "s", "m")
+	sentBefore := len(h.outbox.SentMessages())
+
+	created := h.givenCategory(t, "Shirts", color)
+	assert.Equal(t, 1, created.Version)
+
+	createdEvent := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore)
+	require.Len(t, createdEvent.GetAttributes(), 1)
+	assert.Equal(t, "color", createdEvent.GetAttributes()[0].GetAttributeSlug())
+	assert.Equal(t, color.Name, createdEvent.GetAttributes()[0].GetAttributeName())
+	assert.Equal(t, eventsv1.AttributeType_ATTRIBUTE_TYPE_SINGLE, createdEvent.GetAttributes()[0].GetAttributeType())
+
+	updated, err := h.updateCategory.Handle(ctx, category.UpdateCategoryCommand{
+		ID:      created.ID,
+		Version: created.Version,
+		Name:    "T-Shirts",
+		Enabled: true,
+		Attributes: []category.CategoryAttributeInput{
+			{AttributeID: color.ID, Role: string(category.AttributeRoleVariant)},
+			{AttributeID: size.ID, Role: string(category.AttributeRoleVariant)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	updatedEvent := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore+1)
+	assert.EqualValues(t, 2, updatedEvent.GetVersion())
+	assert.Equal(t, "T-Shirts", updatedEvent.GetName())
+	require.Len(t, updatedEvent.GetAttributes(), 2)
+	assert.Equal(t, size.Name, updatedEvent.GetAttributes()[1].GetAttributeName())
+
+	_, err = h.updateCategory.Handle(ctx, category.UpdateCategoryCommand{ID: created.ID, Version: created.Version, Name: "Stale"})
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+}
+
+func TestCategory_Create_UnknownAttribute(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	_, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{
+		Name:       "Shirts",
+		Attributes: []category.CategoryAttributeInput{{AttributeID: "missing"}},
+	})
+	require.Error(t, err)
+
+	list, err := h.categoryRepo.FindList(ctx, category.ListQuery{})
+	require.NoError(t, err)
+	assert.Zero(t, list.Total)
+	assert.Empty(t, h.outbox.Messages())
+}
+
+func TestCategory_SetDisplay(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created := h.givenCategory(t, "Phones")
+	sentBefore := len(h.outbox.SentMessages())
+
+	updated, err := h.setDisplay.Handle(ctx, category.SetCategoryDisplayCommand{
+		ID:          created.ID,
+		Version:     created.Version,
+		ImageID:     ptr("image-1"),
+		Description: ptr("<p>All phones</p>"),
+		Template:    string(category.DisplayTemplateGrid),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	event := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore)
+	assert.EqualValues(t, 2, event.GetVersion())
+	assert.Equal(t, "image-1", event.GetDisplay().GetImageId())
+	assert.Equal(t, "<p>All phones</p>", event.GetDisplay().GetDescription())
+	assert.Equal(t, eventsv1.CategoryPageTemplate_CATEGORY_PAGE_TEMPLATE_GRID, event.GetDisplay().GetTemplate())
+	assert.Nil(t, event.GetDisplay().BannerImageId)
+
+	// A regular update keeps the display metadata
+	renamed, err := h.updateCategory.Handle(ctx, category.UpdateCategoryCommand{ID: created.ID, Version: updated.Version, Name: "Smartphones", Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, updated.Display, renamed.Display)
+}
+
+func TestCategory_SetDisplay_UnknownImage(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created := h.givenCategory(t, "Phones")
+	sentBefore := len(h.outbox.Messages())
+	h.images.RemoveImages("banner-1")
+
+	_, err := h.setDisplay.Handle(ctx, category.SetCategoryDisplayCommand{
+		ID:            created.ID,
+		Version:       created.Version,
+		BannerImageID: ptr("banner-1"),
+	})
+	require.ErrorIs(t, err, category.ErrImageNotFound)
+
+	stored, err := h.categoryRepo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Display.IsEmpty())
+	assert.Len(t, h.outbox.Messages(), sentBefore)
+}
+
+func TestCategory_Facets(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red", "blue", "green")
+	material := h.givenAttribute(t, "material", "cotton")
+	shirts, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{
+		Name:    "Shirts",
+		Enabled: true,
+		Attributes: []category.CategoryAttributeInput{
+			{AttributeID: material.ID, Role: string(category.AttributeRoleSpecification), SortOrder: 1},
+			{AttributeID: color.ID, Role: string(category.AttributeRoleSpecification), SortOrder: 0, Filterable: true},
+		},
+	})
+	require.NoError(t, err)
+
+	newShirt := func(name, colorSlug string, enabled bool) *product.Product {
+		p, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+			Name: name, Price: 10, Quantity: 1, ImageID: ptr("image-1"), CategoryID: &shirts.ID, Enabled: enabled,
+			Attributes: []product.AttributeValue{
+				{AttributeID: color.ID, OptionSlugValue: ptr(colorSlug)},
+				{AttributeID: material.ID, OptionSlugValue: ptr("cotton")},
+			},
+		})
+		require.NoError(t, err)
+		return p
+	}
+	first := newShirt("Shirt 1", "red", true)
+	newShirt("Shirt 2", "red", true)
+	newShirt("Shirt 3", "blue", false)
+
+	facets, err := h.facets.Handle(ctx, product.GetCategoryFacetsQuery{CategoryID: shirts.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []product.AttributeFacet{{
+		AttributeID: color.ID, Slug: "color", Name: "color",
+		Options: []product.OptionCount{{Slug: "red", Name: "red", Count: 2}, {Slug: "blue", Name: "blue", Count: 0}, {Slug: "green", Name: "green", Count: 0}},
+	}}, facets, "only filterable attributes and enabled products are counted")
+
+	// The product event drops the cached counts
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID: first.ID, Version: first.Version, Name: first.Name, Price: 10, Quantity: 1, ImageID: first.ImageID,
+		CategoryID: &shirts.ID, Enabled: true, Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("green")},
+			{AttributeID: material.ID, OptionSlugValue: ptr("cotton")},
+		},
+	})
+	require.NoError(t, err)
+
+	facets, err = h.facets.Handle(ctx, product.GetCategoryFacetsQuery{CategoryID: shirts.ID})
+	require.NoError(t, err)
+	require.Len(t, facets, 1)
+	assert.Equal(t, []product.OptionCount{{Slug: "red", Name: "red", Count: 1}, {Slug: "blue", Name: "blue", Count: 0}, {Slug: "green", Name: "green", Count: 1}}, facets[0].Options)
+
+	_, err = h.facets.Handle(ctx, product.GetCategoryFacetsQuery{CategoryID: "missing"})
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
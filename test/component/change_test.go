@@ -0,0 +1,84 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestChanges_CommitOrder(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	shoes, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Shoes", Enabled: true})
+	require.NoError(t, err)
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Sneaker", Price: 50, Quantity: 1, CategoryID: &shoes.ID})
+	require.NoError(t, err)
+	updated, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{ID: created.ID, Version: created.Version, Name: "Sneaker 2", Price: 55, Quantity: 1, CategoryID: &shoes.ID})
+	require.NoError(t, err)
+	require.NoError(t, h.deleteProduct.Handle(ctx, product.DeleteProductCommand{ID: created.ID}))
+
+	first, err := h.getChanges.Handle(ctx, change.GetChangesQuery{Limit: 2})
+	require.NoError(t, err)
+	assert.True(t, first.HasMore)
+	second, err := h.getChanges.Handle(ctx, change.GetChangesQuery{After: first.Cursor, Limit: 2})
+	require.NoError(t, err)
+	assert.False(t, second.HasMore)
+
+	type entry struct {
+		entity  change.EntityType
+		id      string
+		version int
+		kind    change.Kind
+	}
+	var got []entry
+	for _, c := range append(first.Changes, second.Changes...) {
+		got = append(got, entry{c.Entity, c.ID, c.Version, c.Kind})
+	}
+	assert.Equal(t, []entry{
+		{change.EntityCategory, shoes.ID, shoes.Version, change.KindUpserted},
+		{change.EntityProduct, created.ID, created.Version, change.KindUpserted},
+		{change.EntityProduct, created.ID, updated.Version, change.KindUpserted},
+		{change.EntityProduct, created.ID, 0, change.KindDeleted},
+	}, got)
+
+	// A reader that caught up polls with its cursor until something changes
+	caughtUp, err := h.getChanges.Handle(ctx, change.GetChangesQuery{After: second.Cursor})
+	require.NoError(t, err)
+	assert.Empty(t, caughtUp.Changes)
+	assert.Equal(t, second.Cursor, caughtUp.Cursor)
+
+	_, err = h.getChanges.Handle(ctx, change.GetChangesQuery{After: "not-a-cursor"})
+	require.ErrorIs(t, err, change.ErrInvalidCursor)
+}
+
+func TestProduct_ModifiedAfter(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	old, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Old Lamp", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	since := time.Now().UTC()
+	time.Sleep(time.Millisecond)
+	recent, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "New Lamp", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+
+	list, err := h.productRepo.FindList(ctx, product.ListQuery{Page: 1, Size: 10, ModifiedAfter: &since})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, recent.ID, list.Items[0].ID)
+
+	var streamed []string
+	err = h.streamProducts.Handle(ctx, product.StreamProductsQuery{ModifiedAfter: &old.ModifiedAt}, func(p *product.Product) error {
+		streamed = append(streamed, p.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{recent.ID}, streamed)
+}
@@ -0,0 +1,44 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestComment_AddAndList(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	p, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Anvil", Price: 50, Quantity: 2})
+	require.NoError(t, err)
+	sent := len(h.outbox.SentMessages())
+
+	for _, text := range []string{"Photo is blurry", "Price checked with purchasing"} {
+		_, err := h.addComment.Handle(ctx, comment.AddCommentCommand{ProductID: p.ID, Author: "Maria", Text: text})
+		require.NoError(t, err)
+	}
+
+	// Comments are internal and publish no events
+	assert.Len(t, h.outbox.SentMessages(), sent)
+
+	list, err := h.listComments.Handle(ctx, comment.GetCommentListQuery{ProductID: p.ID, Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), list.Total)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "Price checked with purchasing", list.Items[0].Text)
+	assert.Equal(t, "Photo is blurry", list.Items[1].Text)
+	assert.Equal(t, "Maria", list.Items[0].Author)
+}
+
+func TestComment_Add_UnknownProduct(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.addComment.Handle(testCtx(), comment.AddCommentCommand{ProductID: "missing", Author: "Maria", Text: "text"})
+
+	require.ErrorIs(t, err, comment.ErrProductNotFound)
+}
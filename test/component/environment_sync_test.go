@@ -0,0 +1,150 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// harnessSource reads another harness the way the source-catalog client reads another environment
+type harnessSource struct {
+	env *harness
+}
+
+func (s *harnessSource) Taxonomy(ctx context.Context, categoryIDs []string) (*taxonomy.Bundle, error) {
+	if s.env == nil {
+		return nil, envsync.ErrSourceNotConfigured
+	}
+	return s.env.exportTaxonomy.Handle(ctx, taxonomy.ExportTaxonomyQuery{CategoryIDs: categoryIDs})
+}
+
+func (s *harnessSource) Attributes(ctx context.Context, ids []string) (*taxonomy.Bundle, error) {
+	if s.env == nil {
+		return nil, envsync.ErrSourceNotConfigured
+	}
+	return s.env.exportAttrs.Handle(ctx, taxonomy.ExportAttributesQuery{IDs: ids})
+}
+
+func (s *harnessSource) Products(ctx context.Context, ids []string) ([]product.CreateProductCommand, error) {
+	if s.env == nil {
+		return nil, envsync.ErrSourceNotConfigured
+	}
+	cmds := make([]product.CreateProductCommand, len(ids))
+	for i, id := range ids {
+		p, err := s.env.productRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %w", id, err)
+		}
+		cmds[i] = toSourceProduct(p)
+	}
+	return cmds, nil
+}
+
+func (s *harnessSource) ProductsInCategory(ctx context.Context, categoryID string, limit int) ([]product.CreateProductCommand, error) {
+	if s.env == nil {
+		return nil, envsync.ErrSourceNotConfigured
+	}
+	page, err := s.env.productRepo.FindList(ctx, product.ListQuery{Page: 1, Size: limit, CategoryID: &categoryID})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(page.Items, func(p *product.Product, _ int) product.CreateProductCommand { return toSourceProduct(p) }), nil
+}
+
+func toSourceProduct(p *product.Product) product.CreateProductCommand {
+	id := uuid.MustParse(p.ID)
+	return product.CreateProductCommand{
+		ID:          &id,
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Type:        string(p.Type),
+		Description: p.Description,
+		Price:       p.Price,
+		Quantity:    p.Quantity,
+		ImageID:     p.ImageID,
+		CategoryID:  p.CategoryID,
+		Enabled:     p.Enabled,
+		Attributes:  p.Attributes,
+		Metadata:    p.Metadata,
+		Supplier:    p.Supplier,
+		ExternalID:  p.ExternalID,
+	}
+}
+
+func TestEnvironmentSync_CopiesProductsWithDependencies(t *testing.T) {
+	ctx := testCtx()
+	source := newHarness(t)
+	color := source.givenAttribute(t, "color", "red", "blue")
+	finish := source.givenAttribute(t, "finish", "matte", "gloss")
+	tools := source.givenCategory(t, "Tools", color)
+	drill, err := source.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Drill",
+		Price:      99,
+		Quantity:   3,
+		ImageID:    ptr("image-1"),
+		CategoryID: &tools.ID,
+		Enabled:    true,
+		Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("red")},
+			{AttributeID: finish.ID, OptionSlugValue: ptr("matte")},
+		},
+	})
+	require.NoError(t, err)
+
+	h := newHarness(t)
+	h.source.env = source
+	localColor := h.givenAttribute(t, "color", "red")
+
+	res, err := h.syncEnv.Handle(ctx, envsync.SyncCommand{ProductIDs: []string{drill.ID}})
+	require.NoError(t, err)
+
+	changes := lo.SliceToMap(res.Taxonomy, func(c taxonomy.Change) (string, taxonomy.Change) { return c.Key, c })
+	assert.Equal(t, taxonomy.ImportUpdated, changes["color"].Action, "the stored attribute with the slug is updated")
+	assert.Equal(t, localColor.ID, changes["color"].ID)
+	assert.Equal(t, taxonomy.ImportCreated, changes["finish"].Action, "an attribute used outside the category is copied")
+	assert.Equal(t, finish.ID, changes["finish"].ID)
+	assert.Equal(t, taxonomy.ImportCreated, changes["Tools"].Action)
+	assert.Equal(t, tools.ID, changes["Tools"].ID)
+
+	require.Len(t, res.Products, 1)
+	require.NoError(t, res.Products[0].Err)
+	assert.Equal(t, product.ImportCreated, res.Products[0].Action)
+	copied, err := h.productRepo.FindByID(ctx, drill.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Drill", copied.Name)
+	assert.Equal(t, tools.ID, *copied.CategoryID)
+	assert.Equal(t, []string{localColor.ID, finish.ID},
+		lo.Map(copied.Attributes, func(v product.AttributeValue, _ int) string { return v.AttributeID }),
+		"values move to the ID the attribute is stored under")
+
+	res, err = h.syncEnv.Handle(ctx, envsync.SyncCommand{ProductCategoryIDs: []string{tools.ID}})
+	require.NoError(t, err)
+	require.Len(t, res.Products, 1)
+	require.NoError(t, res.Products[0].Err)
+	assert.Equal(t, product.ImportUpdated, res.Products[0].Action, "a product copied again is overwritten")
+}
+
+func TestEnvironmentSync_Errors(t *testing.T) {
+	ctx := testCtx()
+	h := newHarness(t)
+
+	_, err := h.syncEnv.Handle(ctx, envsync.SyncCommand{})
+	require.ErrorIs(t, err, envsync.ErrInvalidSyncRequest)
+
+	_, err = h.syncEnv.Handle(ctx, envsync.SyncCommand{CategoryIDs: []string{uuid.NewString()}})
+	require.ErrorIs(t, err, envsync.ErrSourceNotConfigured)
+
+	h.source.env = newHarness(t)
+	_, err = h.syncEnv.Handle(ctx, envsync.SyncCommand{ProductIDs: []string{uuid.NewString()}})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
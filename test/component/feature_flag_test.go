@@ -0,0 +1,99 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+// enableFlag stores a flag setting and reloads the flags, like the reload worker does
+func (h *harness) enableFlag(t *testing.T, flag feature.Flag, enabled bool) {
+	t.Helper()
+
+	h.store.SetFeatureFlag(flag, enabled)
+	_, err := h.flags.Reload(testCtx())
+	require.NoError(t, err)
+}
+
+func TestFeatureFlag_StrictAttributeValidation(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	material := h.givenAttribute(t, "material", "cotton")
+	shirts := h.givenCategory(t, "Shirts", color)
+
+	cmd := product.CreateProductCommand{
+		Name:       "Shirt",
+		Price:      20,
+		Quantity:   1,
+		CategoryID: &shirts.ID,
+		Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("red")},
+			{AttributeID: material.ID, OptionSlugValue: ptr("cotton")},
+		},
+	}
+
+	// Values of attributes the category doesn't use are accepted until the flag is enabled
+	_, err := h.createProduct.Handle(ctx, cmd)
+	require.NoError(t, err)
+
+	h.enableFlag(t, feature.StrictAttributeValidation, true)
+	cmd.Name = "Shirt 2"
+	_, err = h.createProduct.Handle(ctx, cmd)
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+	assert.Contains(t, err.Error(), "material")
+
+	cmd.Attributes = cmd.Attributes[:1]
+	_, err = h.createProduct.Handle(ctx, cmd)
+	require.NoError(t, err)
+
+	h.enableFlag(t, feature.StrictAttributeValidation, false)
+	cmd.Name = "Shirt 3"
+	cmd.CategoryID = nil
+	_, err = h.createProduct.Handle(ctx, cmd)
+	require.NoError(t, err)
+}
+
+func TestFeatureFlag_PriceChangedEvents(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone", Price: 100, Quantity: 1})
+	require.NoError(t, err)
+
+	update := func(p *product.Product, price float64) *product.Product {
+		updated, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+			ID: p.ID, Version: p.Version, Name: p.Name, Price: price, Quantity: p.Quantity,
+		})
+		require.NoError(t, err)
+		return updated
+	}
+
+	sentBefore := len(h.outbox.SentMessages())
+	updated := update(created, 110)
+	assert.Len(t, h.outbox.SentMessages(), sentBefore+1, "no price event while the flag is disabled")
+
+	h.enableFlag(t, feature.PriceChangedEvents, true)
+	sentBefore = len(h.outbox.SentMessages())
+	updated = update(updated, 120)
+
+	require.Len(t, h.outbox.SentMessages(), sentBefore+2)
+	sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore)
+	priceChanged := sentEvent[*eventsv1.ProductPriceChangedEvent](t, h, sentBefore+1)
+	assert.InDelta(t, 110, priceChanged.GetOldPrice(), 0)
+	assert.InDelta(t, 120, priceChanged.GetNewPrice(), 0)
+	assert.EqualValues(t, updated.Version, priceChanged.GetVersion())
+	assert.Equal(t, apiEvents.TopicCatalogProductPriceEvents, h.outbox.SentMessages()[sentBefore+1].Topic)
+
+	// Other changes don't announce a price
+	sentBefore = len(h.outbox.SentMessages())
+	update(updated, 120)
+	assert.Len(t, h.outbox.SentMessages(), sentBefore+1)
+}
@@ -0,0 +1,32 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/fixtures"
+)
+
+func TestFixtures_SeedCannedCatalog(t *testing.T) {
+	ctx := testCtx()
+	h := newHarness(t)
+	f, err := fixtures.Load("")
+	require.NoError(t, err)
+
+	require.NoError(t, fixtures.NewSeeder(h.importTaxonomy, h.createProduct).Seed(ctx, f))
+
+	page, err := h.productRepo.FindList(ctx, product.ListQuery{Page: 1, Size: 100})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, len(f.Products))
+
+	drill, err := h.productRepo.FindBySlug(ctx, "cordless-drill-18v")
+	require.NoError(t, err)
+	assert.True(t, drill.Enabled)
+	assert.Equal(t, f.Products[0].ID.String(), drill.ID, "products keep the ID of the fixtures")
+	require.Len(t, drill.Attributes, 3)
+	assert.Equal(t, "color", drill.Attributes[0].AttributeSlug)
+	assert.Equal(t, "blue", *drill.Attributes[0].OptionSlugValue)
+}
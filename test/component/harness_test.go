@@ -0,0 +1,298 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/apikey"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/availability"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/change"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/envsync"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/privacy"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/kafka"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/memory"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+)
+
+// harness assembles the real application handlers and event factories on top of the in-memory infrastructure
+type harness struct {
+	store  *memory.Store
+	outbox *memory.Outbox
+	images *memory.ImageChecker
+
+	productRepo   product.Repository
+	categoryRepo  category.Repository
+	attributeRepo attribute.Repository
+	reservations  reservation.Repository
+
+	createProduct   product.CreateProductCommandHandler
+	updateProduct   product.UpdateProductCommandHandler
+	deleteProduct   product.DeleteProductCommandHandler
+	mergeAttrs      product.MergeDuplicateAttributesCommandHandler
+	importProducts  product.ImportProductsCommandHandler
+	mergeProducts   product.MergeProductsCommandHandler
+	archiveProducts product.ArchiveProductsCommandHandler
+	restoreProduct  product.RestoreProductCommandHandler
+	discontinue     product.DiscontinueProductCommandHandler
+	createCategory  category.CreateCategoryCommandHandler
+	updateCategory  category.UpdateCategoryCommandHandler
+	setDisplay      category.SetCategoryDisplayCommandHandler
+	createAttribute attribute.CreateAttributeCommandHandler
+	updateAttribute attribute.UpdateAttributeCommandHandler
+	setAttrDisplay  attribute.SetAttributeDisplayCommandHandler
+	deprecateOption attribute.DeprecateOptionCommandHandler
+	applyTemplate   categorytemplate.ApplyTemplateCommandHandler
+	exportAttrs     taxonomy.ExportAttributesQueryHandler
+	importAttrs     taxonomy.ImportAttributesCommandHandler
+	exportTaxonomy  taxonomy.ExportTaxonomyQueryHandler
+	importTaxonomy  taxonomy.ImportTaxonomyCommandHandler
+	syncEnv         envsync.SyncCommandHandler
+	createPalette   palette.CreatePaletteCommandHandler
+	updatePalette   palette.UpdatePaletteCommandHandler
+	createSupplier  supplier.CreateSupplierCommandHandler
+	deleteSupplier  supplier.DeleteSupplierCommandHandler
+	addComment      comment.AddCommentCommandHandler
+	createView      savedview.CreateSavedViewCommandHandler
+
+	getProduct     product.GetProductByIDQueryHandler
+	getBySlug      product.GetProductBySlugQueryHandler
+	streamProducts product.StreamProductsQueryHandler
+	listProducts   product.GetListProductsQueryHandler
+	sampleProducts product.SampleProductsQueryHandler
+	attrProducts   product.GetAttributeProductsQueryHandler
+	uncategorized  product.GetUncategorizedReportQueryHandler
+	checkProduct   product.CheckCategoryProductQueryHandler
+	priceStats     product.GetCategoryPriceStatsQueryHandler
+	facets         product.GetCategoryFacetsQueryHandler
+	listComments   comment.GetCommentListQueryHandler
+	executeView    savedview.ExecuteSavedViewQueryHandler
+	reserveStock   reservation.ReserveStockCommandHandler
+	releaseStock   reservation.ReleaseStockCommandHandler
+	expireStock    reservation.ExpireReservationsCommandHandler
+
+	setSchedule     availability.SetScheduleCommandHandler
+	getAvailability availability.GetAvailabilityQueryHandler
+
+	replay     replay.Service
+	replayJobs replay.Repository
+
+	startMerge     product.StartMergeDuplicateAttributesCommandHandler
+	startResync    product.StartResyncAttributeSlugsCommandHandler
+	findDuplicates product.FindDuplicateProductsQueryHandler
+	startValuation product.StartInventoryValuationCommandHandler
+	getValuation   product.GetInventoryValuationReportQueryHandler
+	getJob         job.GetJobByIDQueryHandler
+	watchJob       job.WatchJobQueryHandler
+	jobRepo        job.Repository
+	failStale      job.FailStaleJobsCommandHandler
+
+	flags *feature.Registry
+
+	// plans holds the limits of the tenant, unlimited unless a test sets them
+	plans *testPlans
+	// source is the environment SyncFromSource copies from, unset unless a test sets one
+	source   *harnessSource
+	getUsage quota.GetUsageQueryHandler
+
+	createKey    apikey.CreateAPIKeyCommandHandler
+	rotateKey    apikey.RotateAPIKeyCommandHandler
+	revokeKey    apikey.RevokeAPIKeyCommandHandler
+	listKeys     apikey.GetAPIKeyListQueryHandler
+	authenticate apikey.Authenticator
+
+	scrubUser privacy.ScrubUserCommandHandler
+
+	getChanges change.GetChangesQueryHandler
+}
+
+type testPlans struct {
+	limits quota.Limits
+}
+
+func (p *testPlans) Limits(context.Context) quota.Limits {
+	return p.limits
+}
+
+// newHarness starts the application on the in-memory adapters; opts can decorate its dependencies
+func newHarness(t *testing.T, opts ...fx.Option) *harness {
+	t.Helper()
+
+	h := &harness{plans: &testPlans{}, source: &harnessSource{}}
+	app := fxtest.New(t,
+		fx.NopLogger,
+		memory.Module(),
+		kafka.Module(),
+		application.Module(),
+		// Empty configuration, for the defaults of the modules that load theirs
+		fx.Supply(koanf.New(".")),
+		fx.Supply(feature.Defaults{}),
+		fx.Supply(limits.Default()),
+		fx.Supply(zap.NewNop()),
+		fx.Provide(func() quota.Plans { return h.plans }),
+		fx.Provide(func() envsync.Source { return h.source }),
+		fx.Populate(
+			&h.store,
+			&h.outbox,
+			&h.images,
+			&h.productRepo,
+			&h.categoryRepo,
+			&h.attributeRepo,
+			&h.reservations,
+			&h.createProduct,
+			&h.updateProduct,
+			&h.deleteProduct,
+			&h.mergeAttrs,
+			&h.importProducts,
+			&h.mergeProducts,
+			&h.archiveProducts,
+			&h.restoreProduct,
+			&h.discontinue,
+			&h.createCategory,
+			&h.updateCategory,
+			&h.setDisplay,
+			&h.createAttribute,
+			&h.updateAttribute,
+			&h.setAttrDisplay,
+			&h.deprecateOption,
+			&h.applyTemplate,
+			&h.exportAttrs,
+			&h.importAttrs,
+			&h.exportTaxonomy,
+			&h.importTaxonomy,
+			&h.syncEnv,
+			&h.createPalette,
+			&h.updatePalette,
+			&h.createSupplier,
+			&h.deleteSupplier,
+			&h.addComment,
+			&h.createView,
+			&h.getProduct,
+			&h.getBySlug,
+			&h.streamProducts,
+			&h.listProducts,
+			&h.sampleProducts,
+			&h.attrProducts,
+			&h.uncategorized,
+			&h.checkProduct,
+			&h.priceStats,
+			&h.facets,
+			&h.listComments,
+			&h.executeView,
+			&h.reserveStock,
+			&h.releaseStock,
+			&h.expireStock,
+			&h.setSchedule,
+			&h.getAvailability,
+			&h.replay,
+			&h.replayJobs,
+			&h.startMerge,
+			&h.startResync,
+			&h.findDuplicates,
+			&h.startValuation,
+			&h.getValuation,
+			&h.getJob,
+			&h.watchJob,
+			&h.jobRepo,
+			&h.failStale,
+			&h.flags,
+			&h.getUsage,
+			&h.createKey,
+			&h.rotateKey,
+			&h.revokeKey,
+			&h.listKeys,
+			&h.authenticate,
+			&h.scrubUser,
+			&h.getChanges,
+		),
+		fx.Options(opts...),
+	)
+	app.RequireStart()
+	t.Cleanup(app.RequireStop)
+
+	return h
+}
+
+func testCtx() context.Context {
+	return logger.With(context.Background(), zap.NewNop())
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// sentEvent returns the event of the i-th sent outbox message, asserting its type
+func sentEvent[E proto.Message](t *testing.T, h *harness, i int) E {
+	t.Helper()
+
+	sent := h.outbox.SentMessages()
+	require.Greater(t, len(sent), i, "expected at least %d sent messages", i+1)
+
+	event, ok := sent[i].Event.(E)
+	require.True(t, ok, "unexpected event type %T", sent[i].Event)
+	return event
+}
+
+// givenAttribute creates a single-choice attribute with the given option slugs
+func (h *harness) givenAttribute(t *testing.T, slug string, optionSlugs ...string) *attribute.Attribute {
+	t.Helper()
+
+	options := make([]attribute.OptionInput, len(optionSlugs))
+	for i, s := range optionSlugs {
+		options[i] = attribute.OptionInput{Name: s, Slug: s, SortOrder: i}
+	}
+
+	a, err := h.createAttribute.Handle(testCtx(), attribute.CreateAttributeCommand{
+		Name:    slug,
+		Slug:    slug,
+		Type:    string(attribute.AttributeTypeSingle),
+		Enabled: true,
+		Options: options,
+	})
+	require.NoError(t, err)
+	return a
+}
+
+// givenCategory creates an enabled category with the given attributes as specifications
+func (h *harness) givenCategory(t *testing.T, name string, attrs ...*attribute.Attribute) *category.Category {
+	t.Helper()
+
+	inputs := make([]category.CategoryAttributeInput, len(attrs))
+	for i, a := range attrs {
+		inputs[i] = category.CategoryAttributeInput{
+			AttributeID: a.ID,
+			Role:        string(category.AttributeRoleSpecification),
+			SortOrder:   i,
+		}
+	}
+
+	c, err := h.createCategory.Handle(testCtx(), category.CreateCategoryCommand{
+		Name:       name,
+		Enabled:    true,
+		Attributes: inputs,
+	})
+	require.NoError(t, err)
+	return c
+}
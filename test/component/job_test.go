@@ -0,0 +1,210 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/job"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+)
+
+func TestJob_MergeDuplicateAttributesRunsInBackground(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red", "blue")
+	now := time.Now().UTC()
+	legacy := product.Reconstruct("product-legacy", 1, "Shirt", "", nil, product.ProductTypePhysical, nil, 20, 1, nil, nil, false, []product.AttributeValue{
+		{AttributeID: color.ID, AttributeSlug: "color", OptionSlugValue: ptr("red")},
+		{AttributeID: color.ID, AttributeSlug: "color", OptionSlugValue: ptr("blue")},
+	}, nil, nil, nil, now, now)
+	require.NoError(t, h.productRepo.Insert(ctx, legacy))
+
+	started, err := h.startMerge.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	require.NoError(t, err)
+	assert.Equal(t, job.TypeMergeDuplicateAttributes, started.Type)
+
+	var finished *job.Job
+	require.Eventually(t, func() bool {
+		finished, err = h.getJob.Handle(ctx, job.GetJobByIDQuery{ID: started.ID})
+		return err == nil && finished.Finished()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, job.StatusSucceeded, finished.Status)
+	assert.JSONEq(t, `{"merged":1}`, string(finished.Result))
+	assert.Equal(t, int64(1), finished.Done)
+	assert.Equal(t, int64(1), finished.Total)
+	assert.NotNil(t, finished.FinishedAt)
+
+	stored, err := h.productRepo.FindByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.Len(t, stored.Attributes, 1)
+}
+
+func TestJob_WatchEndsWithFinishedEvent(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	_, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Hat", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+
+	started, err := h.startMerge.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	require.NoError(t, err)
+
+	watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	events, err := h.watchJob.Handle(watchCtx, job.WatchJobQuery{ID: started.ID})
+	require.NoError(t, err)
+
+	var received []job.Event
+	for e := range events {
+		received = append(received, e)
+	}
+
+	require.NotEmpty(t, received)
+	last := received[len(received)-1]
+	assert.True(t, last.Finished(), "the stream ends with the outcome of the job")
+	assert.Equal(t, job.StatusSucceeded, last.Status)
+	assert.Equal(t, 100, last.Percent())
+	assert.JSONEq(t, `{"merged":0}`, string(last.Result))
+	for _, e := range received[:len(received)-1] {
+		assert.False(t, e.Finished())
+	}
+}
+
+func TestJob_InventoryValuation(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	for _, cmd := range []product.CreateProductCommand{
+		{Name: "Desk lamp", Price: 20, Quantity: 3, CategoryID: &lamps.ID, Metadata: map[string]string{"erp.cost": "12.5"}},
+		{Name: "Floor lamp", Price: 50, Quantity: 2, CategoryID: &lamps.ID},
+		{Name: "Gift card", Price: 25, Quantity: 4, Metadata: map[string]string{"erp.cost": "25"}},
+		{Name: "Assembly", Type: string(product.ProductTypeService), Price: 100, Quantity: 1, CategoryID: &lamps.ID},
+	} {
+		_, err := h.createProduct.Handle(ctx, cmd)
+		require.NoError(t, err)
+	}
+
+	_, err = h.startValuation.Handle(ctx, product.StartInventoryValuationCommand{CostKey: "erp cost"})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+
+	started, err := h.startValuation.Handle(ctx, product.StartInventoryValuationCommand{CostKey: "erp.cost"})
+	require.NoError(t, err)
+	assert.Equal(t, job.TypeInventoryValuation, started.Type)
+
+	_, err = h.getValuation.Handle(ctx, product.GetInventoryValuationReportQuery{JobID: started.ID})
+	if err != nil {
+		require.ErrorIs(t, err, job.ErrJobNotSucceeded, "the report is only available once the job has succeeded")
+	}
+
+	var report *product.InventoryValuationResult
+	require.Eventually(t, func() bool {
+		report, err = h.getValuation.Handle(ctx, product.GetInventoryValuationReportQuery{JobID: started.ID})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "erp.cost", report.CostKey)
+	assert.Equal(t, []product.CategoryValuation{
+		{Products: 1, Units: 4, StockValue: 100, CostValue: ptr(100.0)},
+		{CategoryID: lamps.ID, CategoryName: "Lamps", Products: 2, Units: 5, StockValue: 160, CostValue: ptr(37.5), MissingCost: 1},
+	}, report.Categories, "services have no stock")
+	assert.Equal(t, product.CategoryValuation{Products: 3, Units: 9, StockValue: 260, CostValue: ptr(137.5), MissingCost: 1}, report.Total)
+
+	dups, err := h.startMerge.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	require.NoError(t, err)
+	_, err = h.getValuation.Handle(ctx, product.GetInventoryValuationReportQuery{JobID: dups.ID})
+	assert.ErrorIs(t, err, job.ErrJobNotFound, "other jobs have no valuation report")
+}
+
+func TestJob_ResyncAttributeSlugs(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	now := time.Now().UTC()
+	stale := category.Reconstruct("category-stale", 1, "Shirts", true, []category.CategoryAttribute{
+		{AttributeID: color.ID, Slug: "colour", Role: category.AttributeRoleVariant},
+	}, category.Display{}, now, now)
+	require.NoError(t, h.categoryRepo.Insert(ctx, stale))
+	legacy := product.Reconstruct("product-stale", 1, "Shirt", "", nil, product.ProductTypePhysical, nil, 20, 1, nil, nil, false, []product.AttributeValue{
+		{AttributeID: color.ID, AttributeSlug: "colour", OptionSlugValue: ptr("red")},
+	}, nil, nil, nil, now, now)
+	require.NoError(t, h.productRepo.Insert(ctx, legacy))
+	current, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Hat",
+		Price:      10,
+		Quantity:   1,
+		Attributes: []product.AttributeValue{{AttributeID: color.ID, OptionSlugValue: ptr("red")}},
+	})
+	require.NoError(t, err)
+
+	started, err := h.startResync.Handle(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, job.TypeResyncAttributeSlugs, started.Type)
+
+	var finished *job.Job
+	require.Eventually(t, func() bool {
+		finished, err = h.getJob.Handle(ctx, job.GetJobByIDQuery{ID: started.ID})
+		return err == nil && finished.Finished()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, job.StatusSucceeded, finished.Status)
+	assert.JSONEq(t, `{"categories":1,"products":1}`, string(finished.Result))
+	assert.Equal(t, int64(2), finished.Total)
+
+	storedCategory, err := h.categoryRepo.FindByID(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "color", storedCategory.Attributes[0].Slug)
+	assert.Equal(t, 2, storedCategory.Version)
+
+	storedProduct, err := h.productRepo.FindByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "color", storedProduct.Attributes[0].AttributeSlug)
+
+	untouched, err := h.productRepo.FindByID(ctx, current.ID)
+	require.NoError(t, err)
+	assert.Equal(t, current.Version, untouched.Version, "products with current slugs aren't rewritten")
+}
+
+func TestJob_NotFound(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.getJob.Handle(testCtx(), job.GetJobByIDQuery{ID: "missing"})
+	assert.ErrorIs(t, err, job.ErrJobNotFound)
+
+	_, err = h.watchJob.Handle(testCtx(), job.WatchJobQuery{ID: "missing"})
+	assert.ErrorIs(t, err, job.ErrJobNotFound)
+}
+
+func TestJob_FailStaleJobs(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	longAgo := time.Now().UTC().Add(-time.Hour)
+	stale := &job.Job{ID: "job-stale", Type: job.TypeMergeDuplicateAttributes, Status: job.StatusRunning, CreatedAt: longAgo, UpdatedAt: longAgo}
+	require.NoError(t, h.jobRepo.Insert(ctx, stale))
+	alive := &job.Job{ID: "job-alive", Type: job.TypeMergeDuplicateAttributes, Status: job.StatusRunning, CreatedAt: longAgo, UpdatedAt: time.Now().UTC()}
+	require.NoError(t, h.jobRepo.Insert(ctx, alive))
+
+	count, err := h.failStale.Handle(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	stored, err := h.jobRepo.FindByID(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusFailed, stored.Status)
+	assert.Equal(t, "job was interrupted", stored.Error)
+	assert.NotNil(t, stored.FinishedAt, "the job now expires like the others")
+
+	stored, err = h.jobRepo.FindByID(ctx, alive.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusRunning, stored.Status)
+}
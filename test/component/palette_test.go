@@ -0,0 +1,90 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/palette"
+)
+
+func TestPalette_BindToSwatchAttribute(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	p, err := h.createPalette.Handle(ctx, palette.CreatePaletteCommand{
+		Name:   "Brand colors",
+		Colors: []palette.Color{{Name: "Red", Code: "#ff0000"}, {Name: "Blue", Code: "#0000FF"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "#FF0000", p.Colors[0].Code)
+
+	created, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name:    "Color",
+		Slug:    "color",
+		Type:    string(attribute.AttributeTypeSingle),
+		Enabled: true,
+		Options: []attribute.OptionInput{
+			{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000")},
+			{Name: "Blue", Slug: "blue", ColorCode: ptr("#0000ff")},
+		},
+	})
+	require.NoError(t, err)
+
+	updated, err := h.setAttrDisplay.Handle(ctx, attribute.SetAttributeDisplayCommand{
+		ID:          created.ID,
+		Version:     created.Version,
+		DisplayType: string(attribute.DisplayTypeSwatch),
+		PaletteID:   &p.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &p.ID, updated.PaletteID)
+
+	// Removing a color still used by the bound attribute is rejected
+	_, err = h.updatePalette.Handle(ctx, palette.UpdatePaletteCommand{
+		ID:      p.ID,
+		Version: p.Version,
+		Name:    p.Name,
+		Colors:  []palette.Color{{Name: "Red", Code: "#FF0000"}},
+	})
+	var inUse *palette.ColorsInUseError
+	require.ErrorAs(t, err, &inUse)
+	assert.Equal(t, []string{"#0000FF"}, inUse.Codes)
+
+	// Options outside the palette can't be added while it is bound
+	_, err = h.updateAttribute.Handle(ctx, attribute.UpdateAttributeCommand{
+		ID:      created.ID,
+		Version: updated.Version,
+		Name:    "Color",
+		Enabled: true,
+		Options: []attribute.OptionInput{
+			{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000")},
+			{Name: "Green", Slug: "green", ColorCode: ptr("#00FF00")},
+		},
+	})
+	require.ErrorIs(t, err, attribute.ErrInvalidAttributeData)
+}
+
+func TestPalette_SetDisplay_UnknownPalette(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name:    "Color",
+		Slug:    "color",
+		Type:    string(attribute.AttributeTypeSingle),
+		Options: []attribute.OptionInput{{Name: "Red", Slug: "red", ColorCode: ptr("#FF0000")}},
+	})
+	require.NoError(t, err)
+
+	_, err = h.setAttrDisplay.Handle(ctx, attribute.SetAttributeDisplayCommand{
+		ID:          created.ID,
+		Version:     created.Version,
+		DisplayType: string(attribute.DisplayTypeSwatch),
+		PaletteID:   ptr("missing"),
+	})
+	require.ErrorIs(t, err, attribute.ErrPaletteNotFound)
+	assert.Len(t, h.outbox.Messages(), 1)
+}
@@ -0,0 +1,55 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/comment"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/privacy"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+)
+
+func TestPrivacy_ScrubUser(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	p, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Anvil", Price: 50, Quantity: 2})
+	require.NoError(t, err)
+	for _, author := range []string{"maria", "maria", "olena"} {
+		_, err := h.addComment.Handle(ctx, comment.AddCommentCommand{ProductID: p.ID, Author: author, Text: "Checked"})
+		require.NoError(t, err)
+	}
+	view, err := h.createView.Handle(ctx, savedview.CreateSavedViewCommand{
+		Name: "Mine", Owner: "maria", EntityType: savedview.EntityTypeProduct,
+	})
+	require.NoError(t, err)
+
+	report, err := h.scrubUser.Handle(ctx, privacy.ScrubUserCommand{UserID: "maria"})
+	require.NoError(t, err)
+	assert.True(t, report.Verified)
+	assert.Equal(t, []privacy.CollectionReport{
+		{Collection: "product_comment", Scrubbed: 2},
+		{Collection: "saved_view", Scrubbed: 1},
+	}, report.Collections)
+
+	list, err := h.listComments.Handle(ctx, comment.GetCommentListQuery{ProductID: p.ID, Page: 1, Size: 10})
+	require.NoError(t, err)
+	authors := []string{list.Items[0].Author, list.Items[1].Author, list.Items[2].Author}
+	assert.ElementsMatch(t, []string{privacy.ErasedUser, privacy.ErasedUser, "olena"}, authors)
+
+	// The view is kept for its other users, with a new version
+	result, err := h.executeView.Handle(ctx, savedview.ExecuteSavedViewQuery{ID: view.ID, Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, privacy.ErasedUser, result.View.Owner)
+	assert.Equal(t, view.Version+1, result.View.Version)
+
+	// Scrubbing again finds nothing
+	report, err = h.scrubUser.Handle(ctx, privacy.ScrubUserCommand{UserID: "maria"})
+	require.NoError(t, err)
+	assert.True(t, report.Verified)
+	assert.Zero(t, report.Collections[0].Scrubbed)
+	assert.Zero(t, report.Collections[1].Scrubbed)
+}
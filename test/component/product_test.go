@@ -0,0 +1,1043 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/kafka"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+func TestProduct_CreateUpdateDelete(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red", "blue")
+	phones := h.givenCategory(t, "Phones", color)
+	sentBefore := len(h.outbox.SentMessages())
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Phone",
+		Price:      100,
+		Quantity:   5,
+		ImageID:    ptr("image-1"),
+		CategoryID: &phones.ID,
+		Enabled:    true,
+		Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("red")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Version)
+	assert.Equal(t, "color", created.Attributes[0].AttributeSlug)
+
+	createdEvent := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore)
+	assert.Equal(t, created.ID, createdEvent.GetProductId())
+	assert.EqualValues(t, 1, createdEvent.GetVersion())
+	assert.Equal(t, "color", createdEvent.GetAttributes()[0].GetAttributeSlug())
+	assert.Equal(t, apiEvents.TopicCatalogProductEvents, h.outbox.SentMessages()[sentBefore].Topic)
+	assert.Equal(t, created.ID, h.outbox.SentMessages()[sentBefore].Key)
+
+	updated, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:         created.ID,
+		Version:    created.Version,
+		Name:       "Phone 2",
+		Price:      120,
+		Quantity:   5,
+		ImageID:    ptr("image-1"),
+		CategoryID: &phones.ID,
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	updatedEvent := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore+1)
+	assert.EqualValues(t, 2, updatedEvent.GetVersion())
+	assert.Equal(t, "Phone 2", updatedEvent.GetName())
+
+	stored, err := h.productRepo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Version)
+	assert.InDelta(t, 120.0, stored.Price, 0.001)
+
+	require.NoError(t, h.deleteProduct.Handle(ctx, product.DeleteProductCommand{ID: created.ID}))
+
+	deletedEvent := sentEvent[*eventsv1.ProductDeletedEvent](t, h, sentBefore+2)
+	assert.Equal(t, created.ID, deletedEvent.GetProductId())
+	deletedMeta, err := catalogevents.MetadataFromHeaders(h.outbox.SentMessages()[sentBefore+2].Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateProduct, AggregateID: created.ID, Version: 3, Deleted: true}, deletedMeta)
+
+	_, err = h.productRepo.FindByID(ctx, created.ID)
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_GetAsOf(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	updated, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{ID: created.ID, Version: created.Version, Name: "Phone 2", Price: 12, Quantity: 1})
+	require.NoError(t, err)
+	require.NoError(t, h.deleteProduct.Handle(ctx, product.DeleteProductCommand{ID: created.ID}))
+
+	past, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID, AsOf: &created.ModifiedAt})
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", past.Name)
+	assert.InDelta(t, 10.0, past.Price, 0.001)
+
+	past, err = h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID, AsOf: &updated.ModifiedAt})
+	require.NoError(t, err)
+	assert.Equal(t, "Phone 2", past.Name)
+	assert.Equal(t, 2, past.Version)
+
+	beforeCreate := created.CreatedAt.Add(-time.Nanosecond)
+	_, err = h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID, AsOf: &beforeCreate})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+
+	// Writes within a millisecond of each other are stamped a millisecond apart, ahead of the clock
+	later := time.Now().UTC().Add(time.Second)
+	_, err = h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID, AsOf: &later})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound, "deleted products have no current state")
+}
+
+func TestProduct_Delete_NotFound(t *testing.T) {
+	h := newHarness(t)
+	sentBefore := len(h.outbox.SentMessages())
+
+	err := h.deleteProduct.Handle(testCtx(), product.DeleteProductCommand{ID: "missing"})
+
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+	assert.Len(t, h.outbox.SentMessages(), sentBefore)
+}
+
+func TestProduct_Update_StaleVersion(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	sentBefore := len(h.outbox.SentMessages())
+
+	cmd := product.UpdateProductCommand{ID: created.ID, Version: created.Version, Name: "Phone 2", Price: 10, Quantity: 1}
+	_, err = h.updateProduct.Handle(ctx, cmd)
+	require.NoError(t, err)
+
+	_, err = h.updateProduct.Handle(ctx, cmd)
+	require.ErrorIs(t, err, mongo.ErrOptimisticLocking)
+
+	assert.Len(t, h.outbox.SentMessages(), sentBefore+1)
+}
+
+func TestProduct_Create_ValidationFailures(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	tests := []struct {
+		name    string
+		cmd     product.CreateProductCommand
+		wantErr error
+	}{
+		{
+			name:    "enabled without image",
+			cmd:     product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1, CategoryID: nil, Enabled: true},
+			wantErr: product.ErrInvalidProductData,
+		},
+		{
+			name:    "unknown category",
+			cmd:     product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1, CategoryID: ptr("missing")},
+			wantErr: product.ErrCategoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.createProduct.Handle(ctx, tt.cmd)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	list, err := h.productRepo.FindList(ctx, product.ListQuery{})
+	require.NoError(t, err)
+	assert.Zero(t, list.Total)
+	assert.Empty(t, h.outbox.Messages())
+}
+
+func TestProduct_Create_BrokerOutage(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	h.outbox.FailSends(errors.New("broker unavailable"))
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+
+	_, err = h.productRepo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+
+	// The event is committed with the product and left for the outbox relay
+	require.Len(t, h.outbox.Messages(), 1)
+	assert.Equal(t, created.ID, h.outbox.Messages()[0].Key)
+	assert.Empty(t, h.outbox.SentMessages())
+}
+
+func TestProduct_EnableRequiresCategoryAttributes(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	size := h.givenAttribute(t, "size", "m")
+	shirts, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{
+		Name:    "Shirts",
+		Enabled: true,
+		Attributes: []category.CategoryAttributeInput{
+			{AttributeID: color.ID, Role: string(category.AttributeRoleVariant), Required: true},
+			{AttributeID: size.ID, Role: string(category.AttributeRoleVariant), Required: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// Drafts may be incomplete
+	draft, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Shirt",
+		Price:      20,
+		Quantity:   1,
+		ImageID:    ptr("image-1"),
+		CategoryID: &shirts.ID,
+		Attributes: []product.AttributeValue{{AttributeID: color.ID, OptionSlugValue: ptr("red")}},
+	})
+	require.NoError(t, err)
+
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:         draft.ID,
+		Version:    draft.Version,
+		Name:       draft.Name,
+		Price:      draft.Price,
+		Quantity:   draft.Quantity,
+		ImageID:    draft.ImageID,
+		CategoryID: draft.CategoryID,
+		Enabled:    true,
+		Attributes: draft.Attributes,
+	})
+	var missing *product.MissingAttributesError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"size"}, missing.Slugs)
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+
+	stored, err := h.productRepo.FindByID(ctx, draft.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Enabled)
+}
+
+func TestCategory_CheckProduct(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	size := h.givenAttribute(t, "size", "m")
+	fit := h.givenAttribute(t, "fit", "slim")
+	shirts, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{
+		Name:    "Shirts",
+		Enabled: true,
+		Attributes: []category.CategoryAttributeInput{
+			{AttributeID: color.ID, Role: string(category.AttributeRoleVariant), Required: true},
+			{AttributeID: size.ID, Role: string(category.AttributeRoleVariant)},
+		},
+	})
+	require.NoError(t, err)
+
+	check, err := h.checkProduct.Handle(ctx, product.CheckCategoryProductQuery{
+		CategoryID: shirts.ID,
+		Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("blue")},
+			{AttributeID: fit.ID, OptionSlugValue: ptr("slim")},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, check.OK())
+	assert.Equal(t, []product.MissingAttribute{
+		{AttributeID: size.ID, Slug: "size", Role: category.AttributeRoleVariant},
+	}, check.Missing)
+	require.Len(t, check.Invalid, 2)
+	assert.Equal(t, product.ProblemUnknownOption, check.Invalid[0].Reason)
+	assert.Equal(t, product.ProblemNotInCategory, check.Invalid[1].Reason)
+
+	check, err = h.checkProduct.Handle(ctx, product.CheckCategoryProductQuery{
+		CategoryID: shirts.ID,
+		Attributes: []product.AttributeValue{
+			{AttributeID: color.ID, OptionSlugValue: ptr("red")},
+			{AttributeID: size.ID, OptionSlugValue: ptr("m")},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, check.OK())
+}
+
+func TestProduct_MergeDuplicateAttributes(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red", "blue")
+	now := time.Now().UTC()
+	// Stored before duplicates were rejected
+	legacy := product.Reconstruct("product-legacy", 1, "Shirt", "", nil, product.ProductTypePhysical, nil, 20, 1, nil, nil, false, []product.AttributeValue{
+		{AttributeID: color.ID, AttributeSlug: "color", OptionSlugValue: ptr("red")},
+		{AttributeID: color.ID, AttributeSlug: "color", OptionSlugValue: ptr("blue")},
+	}, nil, nil, nil, now, now)
+	require.NoError(t, h.productRepo.Insert(ctx, legacy))
+	clean, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Hat",
+		Price:      10,
+		Quantity:   1,
+		Attributes: []product.AttributeValue{{AttributeID: color.ID, OptionSlugValue: ptr("red")}},
+	})
+	require.NoError(t, err)
+	sent := len(h.outbox.SentMessages())
+
+	merged, err := h.mergeAttrs.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, merged)
+
+	stored, err := h.productRepo.FindByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.Attributes, 1)
+	assert.Equal(t, ptr("blue"), stored.Attributes[0].OptionSlugValue)
+	assert.Equal(t, 2, stored.Version)
+
+	require.Len(t, h.outbox.SentMessages(), sent+1)
+	event := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sent)
+	assert.Equal(t, legacy.ID, event.GetProductId())
+
+	untouched, err := h.productRepo.FindByID(ctx, clean.ID)
+	require.NoError(t, err)
+	assert.Equal(t, clean.Version, untouched.Version)
+
+	merged, err = h.mergeAttrs.Handle(ctx, product.MergeDuplicateAttributesCommand{})
+	require.NoError(t, err)
+	assert.Zero(t, merged, "a second run has nothing left to merge")
+}
+
+func TestProduct_ListByAttributeValue(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red", "blue")
+	create := func(name, option string) *product.Product {
+		p, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+			Name:       name,
+			Price:      10,
+			Quantity:   1,
+			Attributes: []product.AttributeValue{{AttributeID: color.ID, OptionSlugValue: &option}},
+		})
+		require.NoError(t, err)
+		return p
+	}
+	red := create("Red Hat", "red")
+	create("Blue Hat", "blue")
+	_, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Plain Hat", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+
+	result, err := h.attrProducts.Handle(ctx, product.GetAttributeProductsQuery{AttributeID: color.ID, OptionSlug: ptr("red")})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, red.ID, result.Items[0].ID)
+
+	result, err = h.attrProducts.Handle(ctx, product.GetAttributeProductsQuery{AttributeID: color.ID, Size: 1})
+	require.NoError(t, err)
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, int64(2), result.Total)
+
+	_, err = h.attrProducts.Handle(ctx, product.GetAttributeProductsQuery{AttributeID: "missing"})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_SlugRedirects(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Blue Shirt", Price: 20, Quantity: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "blue-shirt", created.Slug)
+
+	renamed, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:       created.ID,
+		Version:  created.Version,
+		Name:     "Navy Shirt",
+		Price:    created.Price,
+		Quantity: created.Quantity,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "navy-shirt", renamed.Slug)
+
+	updatedEvent := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, 1)
+	assert.Equal(t, "navy-shirt", updatedEvent.GetSlug())
+	assert.Equal(t, []string{"blue-shirt"}, updatedEvent.GetPreviousSlugs())
+
+	current, err := h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{Slug: "navy-shirt"})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, current.Product.ID)
+	assert.Nil(t, current.MovedTo)
+
+	moved, err := h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{Slug: "blue-shirt"})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, moved.Product.ID)
+	assert.Equal(t, ptr("navy-shirt"), moved.MovedTo)
+
+	// A derived slug still resolving to another product gets the ID appended
+	twin, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Blue Shirt", Price: 20, Quantity: 1})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(twin.Slug, "blue-shirt-"), twin.Slug)
+
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Shirt", Slug: "blue-shirt", Price: 20, Quantity: 1})
+	require.ErrorIs(t, err, product.ErrSlugAlreadyExists)
+
+	_, err = h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{Slug: "red-shirt"})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_Import(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	phones := h.givenCategory(t, "Phones")
+	existing, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone X", Price: 100, Quantity: 1})
+	require.NoError(t, err)
+	sent := len(h.outbox.SentMessages())
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone Y", Price: 200, Quantity: 2, CategoryID: &phones.ID},
+		{Name: "Phone Z", Price: 300, Quantity: 3, CategoryID: ptr("missing")},
+		// Claims the slug of the existing product
+		{Name: "Phone Z", Slug: existing.Slug, Price: 300, Quantity: 3},
+		{Name: "Phone W", Price: 400, Quantity: 4},
+		// Derives the slug of the previous one, which is only found on insert
+		{Name: "Phone W", Price: 500, Quantity: 5},
+	}})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, "phone-y", results[0].Product.Slug)
+	require.ErrorIs(t, results[1].Err, product.ErrCategoryNotFound)
+	require.ErrorIs(t, results[2].Err, product.ErrSlugAlreadyExists)
+	require.NoError(t, results[3].Err)
+	require.ErrorIs(t, results[4].Err, product.ErrSlugAlreadyExists)
+
+	for _, r := range []product.ImportResult{results[0], results[3]} {
+		stored, err := h.productRepo.FindByID(ctx, r.Product.ID)
+		require.NoError(t, err)
+		assert.Equal(t, r.Product.Name, stored.Name)
+	}
+	require.Len(t, h.outbox.SentMessages(), sent+2, "only the created products publish events")
+	assert.Equal(t, results[0].Product.ID, sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sent).GetProductId())
+}
+
+func TestProduct_ImportBatchedSummary(t *testing.T) {
+	h := newHarness(t, fx.Decorate(func(product.BulkEventPolicy) product.BulkEventPolicy {
+		return kafka.BulkEventsConfig{Operations: map[string]kafka.BulkOperationEvents{
+			"import-products": {Summary: true, Batched: true},
+		}}
+	}))
+	ctx := testCtx()
+
+	existing, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone X", Price: 100, Quantity: 1, ExternalID: ptr("x")})
+	require.NoError(t, err)
+	created := len(h.outbox.Messages())
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone X", Price: 150, Quantity: 1, ExternalID: ptr("x")},
+		{Name: "Phone Y", Price: 200, Quantity: 2},
+		{Name: "Phone Z", Price: 300, Quantity: 3},
+	}})
+	require.NoError(t, err)
+	for _, r := range results {
+		require.NoError(t, r.Err)
+	}
+
+	msgs := h.outbox.Messages()[created:]
+	require.Len(t, msgs, 2, "one summary per bulk write instead of an event per product")
+	assert.Len(t, h.outbox.SentMessages(), created, "batched events are left to the outbox relay")
+
+	updates := msgs[0].Event.(*eventsv1.BulkProductsImportedEvent)
+	assert.Equal(t, string(product.BulkImportProducts), updates.GetOperation())
+	assert.Equal(t, []string{existing.ID}, updates.GetUpdatedProductIds())
+	assert.Empty(t, updates.GetCreatedProductIds())
+	inserts := msgs[1].Event.(*eventsv1.BulkProductsImportedEvent)
+	assert.Equal(t, []string{results[1].Product.ID, results[2].Product.ID}, inserts.GetCreatedProductIds())
+}
+
+func TestProduct_ImportReportsWarnings(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	phones, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Phones", Enabled: true})
+	require.NoError(t, err)
+	description := "<p>" + strings.Repeat("A phone with a long lasting battery. ", 3) + "</p>"
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone Y", Description: &description, Price: 200, Quantity: 2, ImageID: ptr("image-1"), CategoryID: &phones.ID},
+		{Name: "Phone Z", Description: ptr("<p>Cheap</p>"), Price: 0, Quantity: 3},
+	}})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.NoError(t, results[0].Err)
+	assert.Empty(t, results[0].Warnings)
+	require.NoError(t, results[1].Err, "warnings don't stop the import")
+	assert.Equal(t, []product.WarningCode{
+		product.WarningDescriptionShort, product.WarningImageMissing, product.WarningCategoryMissing, product.WarningPriceZero,
+	}, lo.Map(results[1].Warnings, func(w product.Warning, _ int) product.WarningCode { return w.Code }))
+}
+
+func TestProduct_ImportUpsertsByExternalID(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone Y", Price: 200, Quantity: 2, ExternalID: ptr("ERP-1")},
+		{Name: "Phone Z", Price: 300, Quantity: 3, ExternalID: ptr("ERP-2")},
+	}})
+	require.NoError(t, err)
+	for _, r := range results {
+		require.NoError(t, r.Err)
+		assert.Equal(t, product.ImportCreated, r.Action)
+	}
+	first, second := results[0].Product, results[1].Product
+	sent := len(h.outbox.SentMessages())
+
+	results, err = h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		// Overwrites the stored product whatever its version
+		{Name: "Phone Y", Price: 250, Quantity: 2, ExternalID: ptr("ERP-1")},
+		{Name: "Phone Z", Price: 300, Quantity: 3, ExternalID: ptr("ERP-2")},
+		{Name: "Phone Y2", Price: 250, Quantity: 2, ExternalID: ptr("ERP-1")},
+		{Name: "Phone W", Price: 400, Quantity: 4, ExternalID: ptr("ERP-3")},
+	}})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, product.ImportUpdated, results[0].Action)
+	assert.Equal(t, first.ID, results[0].Product.ID)
+	assert.Equal(t, first.Version+1, results[0].Product.Version)
+	assert.Equal(t, first.Slug, results[0].Product.Slug)
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, product.ImportUnchanged, results[1].Action)
+	assert.Equal(t, second.Version, results[1].Product.Version)
+	require.ErrorIs(t, results[2].Err, product.ErrInvalidProductData, "repeats an external ID of the import")
+	require.NoError(t, results[3].Err)
+	assert.Equal(t, product.ImportCreated, results[3].Action)
+
+	stored, err := h.productRepo.FindByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 250.0, stored.Price, 0.001)
+	require.Len(t, h.outbox.SentMessages(), sent+2, "only the updated and the created product publish events")
+
+	results, err = h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{ID: ptr(uuid.New()), Name: "Phone Z", Price: 300, Quantity: 3, ExternalID: ptr("ERP-2")},
+		{Name: "Phone V", Type: "service", Price: 300, Quantity: 3, ExternalID: ptr("ERP-3")},
+	}})
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, product.ErrExternalIDAlreadyExists, "names another product")
+	require.ErrorIs(t, results[1].Err, product.ErrInvalidProductData, "changes the type")
+
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone U", Price: 100, Quantity: 1, ExternalID: ptr("ERP-3")})
+	require.ErrorIs(t, err, product.ErrExternalIDAlreadyExists)
+}
+
+func TestProduct_FindAndMergeDuplicates(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	acme, err := h.createSupplier.Handle(ctx, supplier.CreateSupplierCommand{Name: "Acme", Code: "ACME"})
+	require.NoError(t, err)
+	phones := h.givenCategory(t, "Phones")
+
+	original, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone X", Price: 100, Quantity: 1, CategoryID: &phones.ID})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	copied, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "phone  x!", Price: 100, Quantity: 1, CategoryID: &phones.ID, ExternalID: ptr("ERP-1")})
+	require.NoError(t, err)
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone X", Price: 100, Quantity: 1})
+	require.NoError(t, err, "same name in another category")
+	first, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Case", Price: 5, Quantity: 1, Supplier: &product.SupplierRef{SupplierID: acme.ID, SKU: ptr("C-1")}})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	second, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Cover", Price: 5, Quantity: 1, Supplier: &product.SupplierRef{SupplierID: acme.ID, SKU: ptr("C-1")}})
+	require.NoError(t, err)
+
+	found, err := h.findDuplicates.Handle(ctx, product.FindDuplicateProductsQuery{})
+	require.NoError(t, err)
+	assert.False(t, found.Truncated)
+	require.Len(t, found.Groups, 2)
+	assert.Equal(t, product.DuplicateSameName, found.Groups[0].Reason)
+	assert.Equal(t, []string{original.ID, copied.ID}, lo.Map(found.Groups[0].Products, func(d product.DuplicateProduct, _ int) string { return d.ID }))
+	assert.Equal(t, product.DuplicateSameSupplierSKU, found.Groups[1].Reason)
+	assert.Equal(t, []string{first.ID, second.ID}, lo.Map(found.Groups[1].Products, func(d product.DuplicateProduct, _ int) string { return d.ID }))
+
+	sent := len(h.outbox.SentMessages())
+	kept, err := h.mergeProducts.Handle(ctx, product.MergeProductsCommand{KeepID: original.ID, DuplicateIDs: []string{copied.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, original.Version+1, kept.Version)
+	assert.Equal(t, []string{copied.Slug}, kept.SlugHistory)
+	assert.Equal(t, ptr("ERP-1"), kept.ExternalID)
+	require.Len(t, h.outbox.SentMessages(), sent+2, "the duplicate is deleted and the kept product updated")
+
+	_, err = h.productRepo.FindByID(ctx, copied.ID)
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+	redirected, err := h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{Slug: copied.Slug})
+	require.NoError(t, err)
+	assert.Equal(t, original.ID, redirected.Product.ID)
+
+	_, err = h.mergeProducts.Handle(ctx, product.MergeProductsCommand{KeepID: first.ID, DuplicateIDs: []string{first.ID}})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+	_, err = h.mergeProducts.Handle(ctx, product.MergeProductsCommand{KeepID: first.ID, DuplicateIDs: []string{copied.ID}})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_ArchiveAndRestore(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	longAgo := time.Now().UTC().AddDate(0, -7, 0)
+	stale := product.Reconstruct("product-stale", 3, "Old Shirt", "old-shirt", nil, product.ProductTypePhysical, nil, 20, 1, nil, nil, false, nil, nil, nil, ptr("ERP-1"), longAgo, longAgo)
+	require.NoError(t, h.productRepo.Insert(ctx, stale))
+	_, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "New Shirt", Price: 20, Quantity: 1})
+	require.NoError(t, err, "recently disabled products stay")
+	sent := len(h.outbox.SentMessages())
+
+	archived, err := h.archiveProducts.Handle(ctx, product.ArchiveProductsCommand{DisabledFor: 180 * 24 * time.Hour, BatchSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, archived)
+	assert.Len(t, h.outbox.SentMessages(), sent, "archiving doesn't change the product")
+
+	_, err = h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: stale.ID})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+	found, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: stale.ID, IncludeArchived: true})
+	require.NoError(t, err)
+	require.NotNil(t, found.ArchivedAt)
+	assert.Equal(t, stale.Version, found.Version)
+
+	list, err := h.productRepo.FindList(ctx, product.ListQuery{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.Total)
+	list, err = h.productRepo.FindList(ctx, product.ListQuery{IncludeArchived: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, list.Total)
+
+	archived, err = h.archiveProducts.Handle(ctx, product.ArchiveProductsCommand{DisabledFor: 180 * 24 * time.Hour, BatchSize: 10})
+	require.NoError(t, err)
+	assert.Zero(t, archived, "a second run has nothing left to archive")
+
+	restored, err := h.restoreProduct.Handle(ctx, product.RestoreProductCommand{ID: stale.ID})
+	require.NoError(t, err)
+	assert.Nil(t, restored.ArchivedAt)
+	assert.False(t, restored.Enabled)
+	assert.True(t, restored.ModifiedAt.After(longAgo), "the next run leaves the restored product alone")
+	stored, err := h.productRepo.FindByID(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ptr("ERP-1"), stored.ExternalID)
+
+	_, err = h.restoreProduct.Handle(ctx, product.RestoreProductCommand{ID: stale.ID})
+	require.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_RestoreConflictsWithTakenSlug(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	longAgo := time.Now().UTC().AddDate(-1, 0, 0)
+	stale := product.Reconstruct("product-stale", 1, "Old Shirt", "old-shirt", nil, product.ProductTypePhysical, nil, 20, 1, nil, nil, false, nil, nil, nil, nil, longAgo, longAgo)
+	require.NoError(t, h.productRepo.Insert(ctx, stale))
+	_, err := h.archiveProducts.Handle(ctx, product.ArchiveProductsCommand{DisabledFor: 180 * 24 * time.Hour, BatchSize: 10})
+	require.NoError(t, err)
+
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Old Shirt", Slug: "old-shirt", Price: 20, Quantity: 1})
+	require.NoError(t, err, "archived products free their slug")
+
+	_, err = h.restoreProduct.Handle(ctx, product.RestoreProductCommand{ID: stale.ID})
+	require.ErrorIs(t, err, product.ErrSlugAlreadyExists)
+	found, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: stale.ID, IncludeArchived: true})
+	require.NoError(t, err)
+	assert.NotNil(t, found.ArchivedAt, "a failed restore leaves the product archived")
+}
+
+func TestProduct_Discontinue(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	old, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name: "Desk Lamp", Price: 10, Quantity: 1, ImageID: ptr("image-1"), CategoryID: &lamps.ID, Enabled: true,
+	})
+	require.NoError(t, err)
+	successor, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Desk Lamp 2", Price: 12, Quantity: 1})
+	require.NoError(t, err)
+
+	_, err = h.discontinue.Handle(ctx, product.DiscontinueProductCommand{ID: old.ID, Version: old.Version, ReplacementProductID: ptr("missing")})
+	require.ErrorIs(t, err, product.ErrReplacementNotFound)
+	_, err = h.discontinue.Handle(ctx, product.DiscontinueProductCommand{ID: old.ID, Version: old.Version, ReplacementProductID: &old.ID})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+
+	sentBefore := len(h.outbox.SentMessages())
+	discontinued, err := h.discontinue.Handle(ctx, product.DiscontinueProductCommand{ID: old.ID, Version: old.Version, ReplacementProductID: &successor.ID})
+	require.NoError(t, err)
+	assert.False(t, discontinued.Enabled)
+	require.NotNil(t, discontinued.DiscontinuedAt)
+	assert.Equal(t, &successor.ID, discontinued.ReplacementProductID)
+
+	event := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore)
+	assert.Equal(t, successor.ID, event.GetReplacementProductId())
+	assert.NotNil(t, event.GetDiscontinuedAt())
+
+	// The successor can't be replaced by the discontinued product
+	_, err = h.discontinue.Handle(ctx, product.DiscontinueProductCommand{ID: successor.ID, Version: successor.Version, ReplacementProductID: &old.ID})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID: old.ID, Version: discontinued.Version, Name: old.Name, Price: 10, Quantity: 1, ImageID: ptr("image-1"), CategoryID: &lamps.ID, Enabled: true,
+	})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+}
+
+func TestProduct_DescriptionXSSDoesNotReachEvents(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+	payloads := []string{
+		`<script>alert(1)</script>`,
+		`<img src=x onerror=alert(1)>`,
+		`<a href="javascript:alert(1)">deal</a>`,
+		`<svg onload=alert(1)>`,
+		`<iframe src="https://evil.example.com"></iframe>`,
+		`<p style="background:url(javascript:alert(1))">x</p>`,
+	}
+	sentBefore := len(h.outbox.SentMessages())
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name: "Phone", Price: 100, Quantity: 1,
+		Description: ptr("<p>Great phone</p>" + strings.Join(payloads, "")),
+	})
+	require.NoError(t, err)
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID: created.ID, Version: created.Version, Name: "Phone", Price: 100, Quantity: 1,
+		Description: ptr(strings.Join(payloads, "") + "<p>Still great</p>"),
+	})
+	require.NoError(t, err)
+
+	for i, want := range []struct{ text, excerpt string }{
+		{text: "Great phone", excerpt: "Great phone deal x"},
+		{text: "Still great", excerpt: "deal x Still great"},
+	} {
+		event := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore+i)
+		for _, unsafe := range []string{"<script", "onerror", "onload", "javascript:", "<iframe", "<svg", "style="} {
+			assert.NotContains(t, event.GetDescription(), unsafe)
+		}
+		assert.Contains(t, event.GetDescription(), want.text)
+		assert.Equal(t, want.excerpt, event.GetExcerpt())
+	}
+
+	stored, err := h.productRepo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, *stored.Description, "<script")
+}
+
+func TestProduct_Stream(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	hardware, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Hardware", Enabled: true})
+	require.NoError(t, err)
+	// More than one enrichment batch
+	for i := range 130 {
+		cmd := product.CreateProductCommand{Name: fmt.Sprintf("Bolt %03d", i), Price: 1, Quantity: 10}
+		if i%10 != 0 {
+			cmd.CategoryID = &hardware.ID
+		}
+		_, err := h.createProduct.Handle(ctx, cmd)
+		require.NoError(t, err)
+	}
+
+	var ids []string
+	err = h.streamProducts.Handle(ctx, product.StreamProductsQuery{CategoryID: &hardware.ID}, func(p *product.Product) error {
+		assert.Equal(t, hardware.ID, *p.CategoryID)
+		ids = append(ids, p.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, ids, 117)
+	assert.True(t, slices.IsSorted(ids))
+
+	// A failing consumer stops the stream
+	stop := errors.New("consumer gone")
+	streamed := 0
+	err = h.streamProducts.Handle(ctx, product.StreamProductsQuery{}, func(*product.Product) error {
+		streamed++
+		return stop
+	})
+	require.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, streamed)
+}
+
+func TestProduct_EmbedCategoryPath(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	shoes, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Shoes", Enabled: true})
+	require.NoError(t, err)
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Sneaker", Price: 50, Quantity: 1, CategoryID: &shoes.ID})
+	require.NoError(t, err)
+
+	plain, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID})
+	require.NoError(t, err)
+	assert.Empty(t, plain.CategoryPath)
+
+	found, err := h.getBySlug.Handle(ctx, product.GetProductBySlugQuery{Slug: created.Slug, Embed: []product.Embed{product.EmbedCategoryPath}, Tenant: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, []product.Crumb{{CategoryID: shoes.ID, Name: "Shoes"}}, found.Product.CategoryPath)
+}
+
+func TestProduct_EmbedAttributeDefinitions(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color, err := h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{
+		Name: "Color", Slug: "color", Type: string(attribute.AttributeTypeSingle), Enabled: true,
+		Options: []attribute.OptionInput{{Name: "Red", Slug: "red"}, {Name: "Blue", Slug: "blue"}},
+	})
+	require.NoError(t, err)
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name: "Scarf", Price: 10, Quantity: 1,
+		Attributes: []product.AttributeValue{{AttributeID: color.ID, OptionSlugValue: ptr("red")}},
+	})
+	require.NoError(t, err)
+
+	found, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID, Embed: []product.Embed{product.EmbedAttributeDefinitions}, Tenant: "acme"})
+	require.NoError(t, err)
+	require.Len(t, found.AttributeDefinitions, 1)
+	assert.Equal(t, "color", found.AttributeDefinitions[0].Slug)
+	assert.Len(t, found.AttributeDefinitions[0].Options, 2)
+}
+
+func TestProduct_NewArrivals(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	longAgo := time.Now().UTC().AddDate(0, -2, 0)
+	old := product.Reconstruct(uuid.New().String(), 1, "Old Lamp", "old-lamp", nil, product.ProductTypePhysical, nil, 10, 1, nil, nil, true, nil, nil, nil, nil, longAgo, longAgo)
+	require.NoError(t, h.productRepo.Insert(ctx, old))
+	first, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Desk Lamp", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	second, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Floor Lamp", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+
+	result, err := h.listProducts.Handle(ctx, product.GetListProductsQuery{Page: 1, Size: 10, Sort: "name", Recent: product.RecentCreated, RecentDays: 30})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, result.Total)
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, []string{second.ID, first.ID}, []string{result.Items[0].ID, result.Items[1].ID})
+
+	_, err = h.listProducts.Handle(ctx, product.GetListProductsQuery{Recent: product.RecentModified})
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+}
+
+func TestProduct_Uncategorized(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	acme, err := h.createSupplier.Handle(ctx, supplier.CreateSupplierCommand{Name: "Acme", Code: "acme"})
+	require.NoError(t, err)
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	for _, cmd := range []product.CreateProductCommand{
+		{Name: "Desk Lamp", Price: 10, Quantity: 1, CategoryID: &lamps.ID, Supplier: &product.SupplierRef{SupplierID: acme.ID}},
+		{Name: "Floor Lamp", Price: 10, Quantity: 1, Supplier: &product.SupplierRef{SupplierID: acme.ID}},
+		{Name: "Wall Lamp", Price: 10, Quantity: 1, Supplier: &product.SupplierRef{SupplierID: acme.ID}},
+		{Name: "Bulb", Price: 10, Quantity: 1},
+	} {
+		_, err := h.createProduct.Handle(ctx, cmd)
+		require.NoError(t, err)
+	}
+
+	uncategorized, err := h.listProducts.Handle(ctx, product.GetListProductsQuery{HasCategory: ptr(false), Sort: "name"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bulb", "Floor Lamp", "Wall Lamp"}, lo.Map(uncategorized.Items, func(p *product.Product, _ int) string { return p.Name }))
+
+	report, err := h.uncategorized.Handle(ctx, product.GetUncategorizedReportQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), report.Products)
+	assert.Equal(t, []product.UncategorizedCount{
+		{SupplierID: acme.ID, Products: 2},
+		{SupplierID: "", Products: 1},
+	}, report.Suppliers)
+}
+
+func TestProduct_Sample(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	for i := range 20 {
+		cmd := product.CreateProductCommand{Name: fmt.Sprintf("Lamp %02d", i), Price: 10, Quantity: 1}
+		if i%2 == 0 {
+			cmd.CategoryID = &lamps.ID
+		}
+		_, err := h.createProduct.Handle(ctx, cmd)
+		require.NoError(t, err)
+	}
+
+	sample, err := h.sampleProducts.Handle(ctx, product.SampleProductsQuery{Size: 4, CategoryID: &lamps.ID})
+	require.NoError(t, err)
+	require.Len(t, sample, 4)
+	ids := map[string]bool{}
+	for _, p := range sample {
+		assert.Equal(t, lamps.ID, *p.CategoryID)
+		ids[p.ID] = true
+	}
+	assert.Len(t, ids, 4, "no product is picked twice")
+
+	// Fewer products match than requested
+	sample, err = h.sampleProducts.Handle(ctx, product.SampleProductsQuery{Size: 50, CategoryID: &lamps.ID})
+	require.NoError(t, err)
+	assert.Len(t, sample, 10)
+
+	sample, err = h.sampleProducts.Handle(ctx, product.SampleProductsQuery{})
+	require.NoError(t, err)
+	assert.Len(t, sample, 10, "default size")
+}
+
+func TestProduct_QualityScore(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	description := "<p>" + strings.Repeat("Warm light for reading. ", 10) + "</p>"
+	complete, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name: "Desk Lamp", Price: 10, Quantity: 1, Description: &description, ImageID: ptr("image-1"), CategoryID: &lamps.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, product.MaxQualityScore, complete.QualityScore)
+	bare, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Floor Lamp", Price: 10, Quantity: 1})
+	require.NoError(t, err)
+	assert.Zero(t, bare.QualityScore)
+
+	result, err := h.listProducts.Handle(ctx, product.GetListProductsQuery{Page: 1, Size: 10, MaxQualityScore: ptr(50)})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, bare.ID, result.Items[0].ID)
+
+	// Updates recalculate the score
+	updated, err := h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID: bare.ID, Version: bare.Version, Name: bare.Name, Price: 10, Quantity: 1, ImageID: ptr("image-2"), CategoryID: &lamps.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 70, updated.QualityScore)
+
+	result, err = h.listProducts.Handle(ctx, product.GetListProductsQuery{Page: 1, Size: 10, MinQualityScore: ptr(50), Sort: "qualityScore", Order: "asc"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, []string{bare.ID, complete.ID}, []string{result.Items[0].ID, result.Items[1].ID})
+}
+
+func TestCategory_PriceStats(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	lamps, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Lamps", Enabled: true})
+	require.NoError(t, err)
+	for i, price := range []float64{12.5, 7.5, 99} {
+		_, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+			Name: fmt.Sprintf("Lamp %d", i), Price: price, Quantity: 1, ImageID: ptr("image-1"), CategoryID: &lamps.ID, Enabled: price < 99,
+		})
+		require.NoError(t, err)
+	}
+
+	stats, err := h.priceStats.Handle(ctx, product.GetCategoryPriceStatsQuery{CategoryID: lamps.ID, Tenant: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, &product.PriceStats{Count: 2, Min: 7.5, Max: 12.5, Avg: 10}, stats)
+
+	_, err = h.priceStats.Handle(ctx, product.GetCategoryPriceStatsQuery{CategoryID: "missing", Tenant: "acme"})
+	assert.ErrorIs(t, err, mongo.ErrEntityNotFound)
+}
+
+func TestProduct_ValidationHooks(t *testing.T) {
+	retailPrice := product.ValidationHookFunc(func(_ context.Context, p *product.Product, _ *category.Category) ([]product.RuleViolation, error) {
+		if cents := int(math.Round(p.Price*100)) % 100; cents != 99 {
+			return []product.RuleViolation{{Rule: "retail-price", Field: "price", Message: "price must end in .99"}}, nil
+		}
+		return nil, nil
+	})
+	ageRestriction := product.ValidationHookFunc(func(_ context.Context, p *product.Product, c *category.Category) ([]product.RuleViolation, error) {
+		if c == nil || c.Name != "Wine" || len(p.Attributes) > 0 {
+			return nil, nil
+		}
+		return []product.RuleViolation{{Rule: "age-restriction", Field: "attributes.age", Message: "wine needs an age restriction"}}, nil
+	})
+	h := newHarness(t, fx.Provide(
+		fx.Annotate(func() product.ValidationHook { return retailPrice }, fx.ResultTags(`group:"product_validation_hook"`)),
+		fx.Annotate(func() product.ValidationHook { return ageRestriction }, fx.ResultTags(`group:"product_validation_hook"`)),
+	))
+	ctx := testCtx()
+
+	age := h.givenAttribute(t, "age", "18")
+	wine, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Wine", Enabled: true})
+	require.NoError(t, err)
+
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Merlot", Price: 20, Quantity: 1, CategoryID: &wine.ID})
+	var violations *product.RuleViolationsError
+	require.ErrorAs(t, err, &violations)
+	require.ErrorIs(t, err, product.ErrInvalidProductData)
+	assert.ElementsMatch(t, []string{"retail-price", "age-restriction"},
+		lo.Map(violations.Violations, func(v product.RuleViolation, _ int) string { return v.Rule }),
+		"every hook is run")
+
+	p, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Merlot",
+		Price:      19.99,
+		Quantity:   1,
+		CategoryID: &wine.ID,
+		Attributes: []product.AttributeValue{{AttributeID: age.ID, OptionSlugValue: ptr("18")}},
+	})
+	require.NoError(t, err)
+
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:         p.ID,
+		Version:    p.Version,
+		Name:       p.Name,
+		Price:      25,
+		Quantity:   p.Quantity,
+		CategoryID: p.CategoryID,
+		Attributes: p.Attributes,
+	})
+	require.ErrorAs(t, err, &violations)
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Cola", Price: 1.99, Quantity: 1},
+		{Name: "Water", Price: 1, Quantity: 1},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	require.ErrorAs(t, results[1].Err, &violations)
+}
@@ -0,0 +1,81 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/categorytemplate"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+)
+
+func TestQuota_ProductCreates(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+	h.plans.limits = quota.Limits{MaxProducts: 2}
+
+	first, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone X", Price: 100, Quantity: 1, ExternalID: ptr("ERP-1")})
+	require.NoError(t, err)
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone X2", Price: 120, Quantity: 1, ExternalID: ptr("ERP-1")},
+		{Name: "Phone Y", Price: 200, Quantity: 1},
+		{Name: "Phone Z", Price: 300, Quantity: 1},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err, "updates don't count against the quota")
+	assert.Equal(t, product.ImportUpdated, results[0].Action)
+	require.ErrorIs(t, results[1].Err, quota.ErrExceeded, "the creates would take the tenant past its limit")
+	require.ErrorIs(t, results[2].Err, quota.ErrExceeded)
+
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone Y", Price: 200, Quantity: 1})
+	require.NoError(t, err)
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone Z", Price: 300, Quantity: 1})
+	var exceeded *quota.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, quota.Products, exceeded.Resource)
+	assert.Equal(t, 2, exceeded.Limit)
+
+	require.NoError(t, h.deleteProduct.Handle(ctx, product.DeleteProductCommand{ID: first.ID}))
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Phone Z", Price: 300, Quantity: 1})
+	require.NoError(t, err, "deletes free the quota")
+
+	usage, err := h.getUsage.Handle(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, quota.Usage{Resource: quota.Products, Used: 2, Limit: 2}, usage[0])
+}
+
+func TestQuota_CategoriesAndAttributes(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+	h.plans.limits = quota.Limits{MaxCategories: 1, MaxAttributes: 3}
+
+	h.givenAttribute(t, "color", "red")
+	h.givenCategory(t, "Phones")
+
+	_, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Tablets", Enabled: true})
+	require.ErrorIs(t, err, quota.ErrExceeded)
+
+	h.plans.limits.MaxCategories = 0
+	_, err = h.applyTemplate.Handle(ctx, categorytemplate.ApplyTemplateCommand{Template: "fashion", Enabled: true})
+	require.ErrorIs(t, err, quota.ErrExceeded, "the template creates more attributes than are left")
+
+	_, err = h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{Name: "Size", Slug: "size", Type: string(attribute.AttributeTypeText), Enabled: true})
+	require.NoError(t, err)
+	_, err = h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{Name: "Brand", Slug: "brand", Type: string(attribute.AttributeTypeText), Enabled: true})
+	require.NoError(t, err)
+	_, err = h.createAttribute.Handle(ctx, attribute.CreateAttributeCommand{Name: "Material", Slug: "material", Type: string(attribute.AttributeTypeText), Enabled: true})
+	require.ErrorIs(t, err, quota.ErrExceeded)
+
+	usage, err := h.getUsage.Handle(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []quota.Usage{
+		{Resource: quota.Products},
+		{Resource: quota.Categories, Used: 1},
+		{Resource: quota.Attributes, Used: 3, Limit: 3},
+	}, usage)
+}
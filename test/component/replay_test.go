@@ -0,0 +1,99 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/replay"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+)
+
+func TestReplay_RepublishesCurrentState(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	color := h.givenAttribute(t, "color", "red")
+	phones := h.givenCategory(t, "Phones", color)
+	for _, name := range []string{"Phone", "Phone 2", "Phone 3"} {
+		_, err := h.createProduct.Handle(ctx, product.CreateProductCommand{Name: name, Price: 10, Quantity: 1, CategoryID: &phones.ID})
+		require.NoError(t, err)
+	}
+	sentBefore := len(h.outbox.SentMessages())
+
+	_, err := h.replay.Start(ctx, replay.StartReplayCommand{RatePerSecond: 1000})
+	require.NoError(t, err)
+
+	var status replay.Status
+	require.Eventually(t, func() bool {
+		status, _, _ = h.replay.Status(ctx)
+		return !status.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Empty(t, status.Error)
+	assert.Equal(t, []replay.Progress{
+		{Target: replay.TargetAttributes, Total: 1, Published: 1},
+		{Target: replay.TargetCategories, Total: 1, Published: 1},
+		{Target: replay.TargetProducts, Total: 3, Published: 3},
+	}, status.Progress)
+
+	require.Len(t, h.outbox.SentMessages(), sentBefore+5)
+	sentEvent[*eventsv1.AttributeUpdatedEvent](t, h, sentBefore)
+	categoryEvent := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore+1)
+	assert.Equal(t, "color", categoryEvent.GetAttributes()[0].GetAttributeSlug())
+	sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sentBefore+2)
+
+	for _, msg := range h.outbox.SentMessages()[sentBefore:] {
+		meta, err := catalogevents.MetadataFromHeaders(msg.Headers)
+		require.NoError(t, err)
+		assert.True(t, meta.Replay)
+		assert.Equal(t, int64(1), meta.Version, "replays keep the stored version")
+	}
+	meta, err := catalogevents.MetadataFromHeaders(h.outbox.SentMessages()[0].Headers)
+	require.NoError(t, err)
+	assert.False(t, meta.Replay)
+}
+
+func TestReplay_RejectsConcurrentRun(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	for _, slug := range []string{"color", "size", "material"} {
+		h.givenAttribute(t, slug)
+	}
+
+	_, err := h.replay.Start(ctx, replay.StartReplayCommand{Targets: []replay.Target{replay.TargetAttributes}, RatePerSecond: 1})
+	require.NoError(t, err)
+
+	_, err = h.replay.Start(ctx, replay.StartReplayCommand{})
+	require.ErrorIs(t, err, replay.ErrReplayInProgress)
+}
+
+func TestReplay_TakesOverStaleRun(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	// A replica died in the middle of a replay and stopped reporting progress
+	lastSeen := time.Now().UTC().Add(-10 * time.Minute)
+	require.NoError(t, h.replayJobs.Begin(ctx, &replay.Status{ID: "dead-run", Running: true, StartedAt: lastSeen, UpdatedAt: lastSeen}, lastSeen))
+
+	status, ok, err := h.replay.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.False(t, status.Running)
+	assert.NotEmpty(t, status.Error)
+
+	started, err := h.replay.Start(ctx, replay.StartReplayCommand{})
+	require.NoError(t, err)
+	assert.NotEqual(t, "dead-run", started.ID)
+
+	require.Eventually(t, func() bool {
+		status, _, _ = h.replay.Status(ctx)
+		return status.ID == started.ID && !status.Running
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, status.Error)
+}
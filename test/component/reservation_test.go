@@ -0,0 +1,138 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	apiEvents "github.com/Sokol111/ecommerce-catalog-service-api/pkg/events"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/reservation"
+	catalogevents "github.com/Sokol111/ecommerce-catalog-service/pkg/events"
+)
+
+func TestReservation_ReserveAndRelease(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	phones := h.givenCategory(t, "Phones")
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Phone",
+		Price:      10,
+		Quantity:   5,
+		ImageID:    ptr("image-1"),
+		CategoryID: &phones.ID,
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	sentBefore := len(h.outbox.SentMessages())
+
+	res, err := h.reserveStock.Handle(ctx, reservation.ReserveStockCommand{ProductID: created.ID, Owner: "order-1", Quantity: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, apiEvents.TopicCatalogReservationEvents, h.outbox.SentMessages()[sentBefore].Topic)
+	reserved := sentEvent[*eventsv1.StockReservedEvent](t, h, sentBefore)
+	assert.Equal(t, created.ID, reserved.GetProductId())
+	assert.Equal(t, "order-1", reserved.GetOwner())
+	assert.EqualValues(t, 3, reserved.GetQuantity())
+
+	available, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 5, available.Quantity)
+	assert.Equal(t, 3, available.Reserved)
+	assert.Equal(t, 2, available.Available())
+
+	_, err = h.reserveStock.Handle(ctx, reservation.ReserveStockCommand{ProductID: created.ID, Owner: "order-1", Quantity: 3})
+	require.ErrorIs(t, err, reservation.ErrInsufficientStock)
+
+	err = h.releaseStock.Handle(ctx, reservation.ReleaseStockCommand{ID: res.ID, Owner: "order-2"})
+	require.ErrorIs(t, err, reservation.ErrNotReservationOwner)
+
+	require.NoError(t, h.releaseStock.Handle(ctx, reservation.ReleaseStockCommand{ID: res.ID, Owner: "order-1"}))
+	released := sentEvent[*eventsv1.StockReleasedEvent](t, h, sentBefore+1)
+	assert.Equal(t, res.ID, released.GetReservationId())
+	meta, err := catalogevents.MetadataFromHeaders(h.outbox.SentMessages()[sentBefore+1].Headers)
+	require.NoError(t, err)
+	assert.True(t, meta.IsDeletion())
+
+	available, err = h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID})
+	require.NoError(t, err)
+	assert.Zero(t, available.Reserved)
+	assert.Equal(t, 5, available.Available())
+
+	err = h.releaseStock.Handle(ctx, reservation.ReleaseStockCommand{ID: res.ID, Owner: "order-1"})
+	require.ErrorIs(t, err, reservation.ErrReservationNotFound)
+}
+
+func TestReservation_UpdateWhileReservedKeepsStock(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	phones := h.givenCategory(t, "Phones")
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:       "Phone",
+		Price:      10,
+		Quantity:   5,
+		ImageID:    ptr("image-1"),
+		CategoryID: &phones.ID,
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+
+	_, err = h.reserveStock.Handle(ctx, reservation.ReserveStockCommand{ProductID: created.ID, Owner: "order-1", Quantity: 3})
+	require.NoError(t, err)
+
+	// An admin form loads the product and saves it back with a new price only
+	loaded, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID})
+	require.NoError(t, err)
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:         loaded.ID,
+		Version:    loaded.Version,
+		Name:       loaded.Name,
+		Price:      12,
+		Quantity:   loaded.Quantity,
+		ImageID:    loaded.ImageID,
+		CategoryID: loaded.CategoryID,
+		Enabled:    loaded.Enabled,
+	})
+	require.NoError(t, err)
+
+	stored, err := h.productRepo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, stored.Quantity)
+
+	reloaded, err := h.getProduct.Handle(ctx, product.GetProductByIDQuery{ID: created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 5, reloaded.Quantity)
+	assert.Equal(t, 2, reloaded.Available())
+}
+
+func TestReservation_ExpiryIsAnnounced(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	now := time.Now().UTC()
+	expired := reservation.Reconstruct("reservation-1", "product-1", "order-1", 2, now.Add(-time.Minute), now.Add(-time.Hour))
+	require.NoError(t, h.reservations.Insert(ctx, expired))
+	active := reservation.Reconstruct("reservation-2", "product-1", "order-2", 1, now.Add(time.Minute), now)
+	require.NoError(t, h.reservations.Insert(ctx, active))
+
+	count, err := h.expireStock.Handle(ctx, reservation.ExpireReservationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	event := sentEvent[*eventsv1.StockReservationExpiredEvent](t, h, 0)
+	assert.Equal(t, "reservation-1", event.GetReservationId())
+	assert.Equal(t, "order-1", event.GetOwner())
+	meta, err := catalogevents.MetadataFromHeaders(h.outbox.SentMessages()[0].Headers)
+	require.NoError(t, err)
+	assert.Equal(t, catalogevents.Metadata{AggregateType: catalogevents.AggregateReservation, AggregateID: "reservation-1", Version: 2, Deleted: true}, meta)
+
+	count, err = h.expireStock.Handle(ctx, reservation.ExpireReservationsCommand{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Len(t, h.outbox.SentMessages(), 1)
+}
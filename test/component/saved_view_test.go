@@ -0,0 +1,62 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/savedview"
+)
+
+func TestSavedView_ExecuteProductView(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	electronics, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Electronics", Enabled: true})
+	require.NoError(t, err)
+	garden, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{Name: "Garden", Enabled: true})
+	require.NoError(t, err)
+
+	for _, cmd := range []product.CreateProductCommand{
+		{Name: "Radio", Price: 30, Quantity: 1, CategoryID: &electronics.ID},
+		{Name: "Phone", Price: 300, Quantity: 1, CategoryID: &electronics.ID, ImageID: ptr("image-phone")},
+		{Name: "Kettle", Price: 20, Quantity: 1, CategoryID: &electronics.ID},
+		{Name: "Rake", Price: 10, Quantity: 1, CategoryID: &garden.ID},
+	} {
+		_, err := h.createProduct.Handle(ctx, cmd)
+		require.NoError(t, err)
+	}
+
+	view, err := h.createView.Handle(ctx, savedview.CreateSavedViewCommand{
+		Name:       "Products missing images in Electronics",
+		Owner:      "maria",
+		EntityType: savedview.EntityTypeProduct,
+		Filter: map[string]string{
+			savedview.FilterCategoryID: electronics.ID,
+			savedview.FilterHasImage:   "false",
+		},
+		Sort:  "name",
+		Order: "asc",
+	})
+	require.NoError(t, err)
+
+	result, err := h.executeView.Handle(ctx, savedview.ExecuteSavedViewQuery{ID: view.ID, Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Nil(t, result.Categories)
+	require.NotNil(t, result.Products)
+	assert.Equal(t, int64(2), result.Products.Total)
+	require.Len(t, result.Products.Items, 2)
+	assert.Equal(t, "Kettle", result.Products.Items[0].Name)
+	assert.Equal(t, "Radio", result.Products.Items[1].Name)
+}
+
+func TestSavedView_ExecuteUnknownView(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.executeView.Handle(testCtx(), savedview.ExecuteSavedViewQuery{ID: "missing"})
+
+	require.ErrorIs(t, err, savedview.ErrSavedViewNotFound)
+}
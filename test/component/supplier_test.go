@@ -0,0 +1,84 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/supplier"
+)
+
+func TestSupplier_LinkProducts(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	acme, err := h.createSupplier.Handle(ctx, supplier.CreateSupplierCommand{
+		Name:         "Acme",
+		Code:         "acme",
+		Contact:      supplier.Contact{Email: ptr("orders@acme.test")},
+		LeadTimeDays: 14,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ACME", acme.Code)
+
+	created, err := h.createProduct.Handle(ctx, product.CreateProductCommand{
+		Name:     "Anvil",
+		Price:    50,
+		Quantity: 2,
+		Supplier: &product.SupplierRef{SupplierID: acme.ID, SKU: ptr("AN-1")},
+	})
+	require.NoError(t, err)
+	_, err = h.createProduct.Handle(ctx, product.CreateProductCommand{Name: "Rope", Price: 5, Quantity: 2})
+	require.NoError(t, err)
+
+	event := sentEvent[*eventsv1.ProductUpdatedEvent](t, h, 0)
+	assert.Equal(t, acme.ID, event.GetSupplierId())
+	assert.Equal(t, "AN-1", event.GetSupplierSku())
+
+	list, err := h.productRepo.FindList(ctx, product.ListQuery{SupplierID: &acme.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, created.ID, list.Items[0].ID)
+
+	// Linked products keep the supplier
+	err = h.deleteSupplier.Handle(ctx, supplier.DeleteSupplierCommand{ID: acme.ID})
+	require.ErrorIs(t, err, supplier.ErrSupplierInUse)
+
+	_, err = h.updateProduct.Handle(ctx, product.UpdateProductCommand{
+		ID:       created.ID,
+		Version:  created.Version,
+		Name:     created.Name,
+		Price:    created.Price,
+		Quantity: created.Quantity,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, h.deleteSupplier.Handle(ctx, supplier.DeleteSupplierCommand{ID: acme.ID}))
+}
+
+func TestSupplier_UnknownSupplier(t *testing.T) {
+	h := newHarness(t)
+
+	_, err := h.createProduct.Handle(testCtx(), product.CreateProductCommand{
+		Name:     "Anvil",
+		Price:    50,
+		Quantity: 2,
+		Supplier: &product.SupplierRef{SupplierID: "missing"},
+	})
+	require.ErrorIs(t, err, product.ErrSupplierNotFound)
+	assert.Empty(t, h.outbox.Messages())
+}
+
+func TestSupplier_DuplicateCode(t *testing.T) {
+	h := newHarness(t)
+	ctx := testCtx()
+
+	_, err := h.createSupplier.Handle(ctx, supplier.CreateSupplierCommand{Name: "Acme", Code: "ACME"})
+	require.NoError(t, err)
+
+	_, err = h.createSupplier.Handle(ctx, supplier.CreateSupplierCommand{Name: "Acme Europe", Code: "acme"})
+	require.ErrorIs(t, err, supplier.ErrCodeAlreadyExists)
+}
@@ -0,0 +1,195 @@
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/attribute"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/category"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/taxonomy"
+)
+
+// givenExportedSizes exports a size attribute with a deprecated option, bound to a category whose ID
+// the test can create in the target environment
+func givenExportedSizes(t *testing.T) (*taxonomy.Bundle, *category.Category) {
+	t.Helper()
+
+	source := newHarness(t)
+	ctx := testCtx()
+	size := source.givenAttribute(t, "size", "s", "m", "medium")
+	_, err := source.deprecateOption.Handle(ctx, attribute.DeprecateOptionCommand{
+		ID: size.ID, Version: size.Version, OptionSlug: "medium", Deprecated: true, ReplacementSlug: ptr("m"),
+	})
+	require.NoError(t, err)
+	source.givenAttribute(t, "material", "cotton")
+	shirts := source.givenCategory(t, "Shirts", size)
+
+	bundle, err := source.exportAttrs.Handle(ctx, taxonomy.ExportAttributesQuery{IDs: []string{size.ID}, IncludeBindings: true})
+	require.NoError(t, err)
+
+	// The bundle travels as JSON
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	var decoded taxonomy.Bundle
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return &decoded, shirts
+}
+
+func TestTaxonomy_ExportImportAttributes(t *testing.T) {
+	bundle, shirts := givenExportedSizes(t)
+	require.Len(t, bundle.Attributes, 1)
+	require.Len(t, bundle.Attributes[0].Bindings, 1)
+	assert.Equal(t, "Shirts", bundle.Attributes[0].Bindings[0].CategoryName)
+
+	h := newHarness(t)
+	ctx := testCtx()
+	shirtsID := uuid.MustParse(shirts.ID)
+	_, err := h.createCategory.Handle(ctx, category.CreateCategoryCommand{ID: &shirtsID, Name: "Shirts", Enabled: true})
+	require.NoError(t, err)
+	bundle.Attributes[0].Bindings = append(bundle.Attributes[0].Bindings, taxonomy.BindingEntry{
+		CategoryID: uuid.NewString(), CategoryName: "Pants", Usage: taxonomy.Usage{Role: string(category.AttributeRoleSpecification)},
+	})
+	sentBefore := len(h.outbox.SentMessages())
+
+	results, err := h.importAttrs.Handle(ctx, taxonomy.ImportAttributesCommand{Bundle: *bundle, Conflict: taxonomy.ConflictSkip})
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, taxonomy.ImportCreated, results[0].Action)
+	size := results[0].Attribute
+	assert.Equal(t, bundle.Attributes[0].ID, size.ID, "the ID of the source is kept")
+	medium, _ := size.FindOption("medium")
+	assert.True(t, medium.Deprecated)
+	assert.Equal(t, ptr("m"), medium.ReplacementSlug)
+	assert.Equal(t, 1, results[0].BoundCategories)
+	assert.Equal(t, []string{bundle.Attributes[0].Bindings[1].CategoryID}, results[0].MissingCategories)
+
+	stored, err := h.categoryRepo.FindByID(ctx, shirts.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.Attributes, 1)
+	assert.Equal(t, size.ID, stored.Attributes[0].AttributeID)
+
+	sent := h.outbox.SentMessages()[sentBefore:]
+	require.Len(t, sent, 2)
+	assert.IsType(t, &eventsv1.AttributeUpdatedEvent{}, sent[0].Event)
+	categoryEvent := sentEvent[*eventsv1.CategoryUpdatedEvent](t, h, sentBefore+1)
+	assert.Equal(t, "size", categoryEvent.GetAttributes()[0].GetAttributeSlug())
+}
+
+func TestTaxonomy_ImportAttributes_Conflicts(t *testing.T) {
+	bundle, _ := givenExportedSizes(t)
+	bundle.Attributes[0].Name = "Size (EU)"
+
+	tests := []struct {
+		conflict taxonomy.ConflictStrategy
+		action   taxonomy.ImportAction
+		name     string
+		slug     string
+	}{
+		{conflict: taxonomy.ConflictSkip, action: taxonomy.ImportSkipped, name: "size", slug: "size"},
+		{conflict: taxonomy.ConflictOverwrite, action: taxonomy.ImportUpdated, name: "Size (EU)", slug: "size"},
+		{conflict: taxonomy.ConflictRename, action: taxonomy.ImportRenamed, name: "Size (EU)", slug: "size-3"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.conflict), func(t *testing.T) {
+			h := newHarness(t)
+			ctx := testCtx()
+			existing := h.givenAttribute(t, "size", "s", "m")
+			h.givenAttribute(t, "size-2", "s")
+
+			results, err := h.importAttrs.Handle(ctx, taxonomy.ImportAttributesCommand{Bundle: *bundle, Conflict: tt.conflict})
+			require.NoError(t, err)
+
+			require.Len(t, results, 1)
+			assert.Equal(t, tt.action, results[0].Action)
+			assert.Equal(t, tt.name, results[0].Attribute.Name)
+			assert.Equal(t, tt.slug, results[0].Attribute.Slug)
+			assert.Equal(t, tt.conflict != taxonomy.ConflictRename, results[0].Attribute.ID == existing.ID)
+			assert.Equal(t, tt.action != taxonomy.ImportSkipped, len(results[0].Attribute.Options) == 3)
+		})
+	}
+}
+
+func TestTaxonomy_ImportAttributes_RollsBack(t *testing.T) {
+	bundle, _ := givenExportedSizes(t)
+	bundle.Attributes = append(bundle.Attributes, taxonomy.AttributeEntry{Name: "Color", Slug: "color", Type: "text"})
+
+	h := newHarness(t)
+	ctx := testCtx()
+	h.givenAttribute(t, "color", "red")
+	attrsBefore := len(h.outbox.Messages())
+
+	// A stored attribute can't change its type, so the size created before is rolled back
+	_, err := h.importAttrs.Handle(ctx, taxonomy.ImportAttributesCommand{Bundle: *bundle, Conflict: taxonomy.ConflictOverwrite})
+	require.ErrorIs(t, err, attribute.ErrInvalidAttributeData)
+
+	attrs, err := h.attributeRepo.FindList(ctx, attribute.ListQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), attrs.Total)
+	assert.Len(t, h.outbox.Messages(), attrsBefore)
+
+	bundle.Version = 2
+	_, err = h.importAttrs.Handle(ctx, taxonomy.ImportAttributesCommand{Bundle: *bundle, Conflict: taxonomy.ConflictSkip})
+	require.ErrorIs(t, err, taxonomy.ErrInvalidBundle)
+}
+
+func TestTaxonomy_ImportTaxonomy(t *testing.T) {
+	source := newHarness(t)
+	ctx := testCtx()
+	size := source.givenAttribute(t, "size", "s", "m")
+	material := source.givenAttribute(t, "material", "cotton")
+	shirts := source.givenCategory(t, "Shirts", size, material)
+	source.givenCategory(t, "Pants", size)
+
+	bundle, err := source.exportTaxonomy.Handle(ctx, taxonomy.ExportTaxonomyQuery{CategoryIDs: []string{shirts.ID}})
+	require.NoError(t, err)
+	require.Len(t, bundle.Categories, 1)
+	require.Len(t, bundle.Attributes, 2, "the attributes of the exported categories")
+
+	h := newHarness(t)
+	preview, err := h.importTaxonomy.Handle(ctx, taxonomy.ImportTaxonomyCommand{Bundle: *bundle, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, []taxonomy.ImportAction{taxonomy.ImportCreated, taxonomy.ImportCreated, taxonomy.ImportCreated},
+		lo.Map(preview, func(c taxonomy.Change, _ int) taxonomy.ImportAction { return c.Action }))
+	assert.Equal(t, taxonomy.EntityCategory, preview[2].Entity)
+	assert.Empty(t, h.outbox.Messages(), "a dry run stores nothing")
+
+	changes, err := h.importTaxonomy.Handle(ctx, taxonomy.ImportTaxonomyCommand{Bundle: *bundle})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	stored, err := h.categoryRepo.FindByID(ctx, shirts.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{size.ID, material.ID},
+		lo.Map(stored.Attributes, func(ca category.CategoryAttribute, _ int) string { return ca.AttributeID }))
+	assert.Len(t, h.outbox.SentMessages(), 3)
+
+	bundle.Categories[0].Name = "T-shirts"
+	bundle.Attributes[1].Options = append(bundle.Attributes[1].Options, taxonomy.OptionEntry{Name: "L", Slug: "l"})
+	preview, err = h.importTaxonomy.Handle(ctx, taxonomy.ImportTaxonomyCommand{Bundle: *bundle, DryRun: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, taxonomy.Change{Entity: taxonomy.EntityAttribute, ID: material.ID, Key: "material", Action: taxonomy.ImportUnchanged}, preview[0])
+	assert.Equal(t, taxonomy.Change{Entity: taxonomy.EntityAttribute, ID: size.ID, Key: "size", Action: taxonomy.ImportUpdated, Fields: []string{"options"}}, preview[1])
+	assert.Equal(t, taxonomy.Change{Entity: taxonomy.EntityCategory, ID: shirts.ID, Key: "T-shirts", Action: taxonomy.ImportUpdated, Fields: []string{"name"}}, preview[2])
+}
+
+func TestTaxonomy_ImportTaxonomy_UnknownAttribute(t *testing.T) {
+	h := newHarness(t)
+	bundle := taxonomy.Bundle{
+		Version: taxonomy.BundleVersion,
+		Categories: []taxonomy.CategoryEntry{{
+			ID: uuid.NewString(), Name: "Shirts",
+			Attributes: []taxonomy.CategoryAttributeEntry{{AttributeSlug: "size", Usage: taxonomy.Usage{Role: string(category.AttributeRoleSpecification)}}},
+		}},
+	}
+
+	_, err := h.importTaxonomy.Handle(testCtx(), taxonomy.ImportTaxonomyCommand{Bundle: bundle, DryRun: true})
+
+	require.ErrorIs(t, err, taxonomy.ErrInvalidBundle)
+}
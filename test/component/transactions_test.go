@@ -0,0 +1,54 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	eventsv1 "github.com/Sokol111/ecommerce-catalog-service-api/gen/events/catalog/v1"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/product"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+// noRollbackTxManager keeps the writes made before an error, like the tx manager of a standalone Mongo
+type noRollbackTxManager struct{}
+
+func (noRollbackTxManager) WithTransaction(ctx context.Context, fn func(txCtx context.Context) (any, error)) (any, error) {
+	return fn(ctx)
+}
+
+func (noRollbackTxManager) RollsBack() bool {
+	return false
+}
+
+func TestProduct_ImportWithoutTransactions(t *testing.T) {
+	h := newHarness(t, fx.Decorate(func(commonsmongo.TxManager) commonsmongo.TxManager {
+		return noRollbackTxManager{}
+	}))
+	ctx := testCtx()
+	sent := len(h.outbox.SentMessages())
+
+	results, err := h.importProducts.Handle(ctx, product.ImportProductsCommand{Products: []product.CreateProductCommand{
+		{Name: "Phone W", Price: 400, Quantity: 4},
+		// Derives the slug of the previous one, which is only found on insert
+		{Name: "Phone W", Price: 500, Quantity: 5},
+		{Name: "Phone Y", Price: 200, Quantity: 2},
+	}})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Err)
+	require.ErrorIs(t, results[1].Err, product.ErrSlugAlreadyExists)
+	require.NoError(t, results[2].Err)
+
+	for _, r := range []product.ImportResult{results[0], results[2]} {
+		stored, err := h.productRepo.FindByID(ctx, r.Product.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stored.Version)
+	}
+	require.Len(t, h.outbox.SentMessages(), sent+2, "the written products publish their events once")
+	assert.Equal(t, results[2].Product.ID, sentEvent[*eventsv1.ProductUpdatedEvent](t, h, sent+1).GetProductId())
+}
@@ -17,6 +17,10 @@ import (
 
 	catalogv1connect "github.com/Sokol111/ecommerce-catalog-service-api/gen/connect/catalog/v1/catalogv1connect"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/application"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/feature"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/limits"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/application/quota"
+	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/compression"
 	internalconnect "github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/inbound/connect"
 	"github.com/Sokol111/ecommerce-catalog-service/internal/infrastructure/outbound/mongo"
 	commons_core "github.com/Sokol111/ecommerce-commons/pkg/core"
@@ -143,6 +147,10 @@ func startApp(ctx context.Context) {
 		// Application modules
 		mongo.Module(),
 		application.Module(),
+		fx.Supply(feature.Defaults{}),
+		fx.Supply(limits.Default()),
+		fx.Provide(func() quota.Plans { return quota.Static(quota.Limits{}) }),
+		compression.Module(),
 		internalconnect.Module(),
 	)
 